@@ -1,8 +1,10 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	tea "github.com/charmbracelet/bubbletea"
 
@@ -11,26 +13,72 @@ import (
 )
 
 func main() {
+	launch, err := parseArgs()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
 	cfg, err := app.LoadConfig()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 		os.Exit(1)
 	}
+	launch.Apply(cfg)
 
 	application, err := ui.NewApp(cfg)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error initializing app: %v\n", err)
 		os.Exit(1)
 	}
+	application.SetLaunch(launch)
+
+	// Bubbletea restores the terminal after a panic, the guard leaves a report behind
+	guard := ui.NewCrashGuard(application, filepath.Join(cfg.ConfigDir, "crashes"))
 
 	p := tea.NewProgram(
-		application,
+		guard,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
 
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running app: %v\n", err)
+		if report := guard.ReportPath(); report != "" {
+			fmt.Fprintf(os.Stderr, "Crash report written to %s\n", report)
+		}
 		os.Exit(1)
 	}
 }
+
+// parseArgs reads where to start from the flags and an optional awstui:// link, the
+// flags taking precedence over the link
+func parseArgs() (app.Launch, error) {
+	var flags app.Launch
+	flag.StringVar(&flags.Profile, "profile", "", "AWS profile to start with")
+	flag.StringVar(&flags.Region, "region", "", "AWS region to start in")
+	flag.StringVar(&flags.View, "view", "", "view to open, as a command without the colon, e.g. ecs")
+	flag.StringVar(&flags.Filter, "filter", "", "search to apply to the view, as typed after /")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [%s://<view>[/<args>][?profile=&region=&filter=]]\n\n",
+			filepath.Base(os.Args[0]), app.LaunchScheme)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var launch app.Launch
+	switch flag.NArg() {
+	case 0:
+	case 1:
+		link, err := app.ParseLaunchURL(flag.Arg(0))
+		if err != nil {
+			return app.Launch{}, err
+		}
+		launch = link
+	default:
+		return app.Launch{}, fmt.Errorf("expected at most one %s:// link, got %d arguments", app.LaunchScheme, flag.NArg())
+	}
+
+	launch = launch.Merge(flags)
+	return launch, launch.Validate()
+}
@@ -0,0 +1,65 @@
+package apigateway
+
+import (
+	"time"
+)
+
+// API protocols, REST APIs come from API Gateway v1 and the others from v2
+const (
+	ProtocolREST      = "REST"
+	ProtocolHTTP      = "HTTP"
+	ProtocolWebSocket = "WEBSOCKET"
+)
+
+// API represents a REST, HTTP or WebSocket API
+type API struct {
+	APIID        string
+	Name         string
+	Protocol     string
+	Description  string
+	Endpoint     string // Only set for HTTP and WebSocket APIs, REST endpoints are per stage
+	EndpointType string
+	Version      string
+	CreatedTime  time.Time
+	Tags         map[string]string
+}
+
+// Stage represents a deployment stage of an API
+type Stage struct {
+	StageName    string
+	DeploymentID string
+	Description  string
+	AutoDeploy   bool
+	Variables    map[string]string
+	CreatedTime  time.Time
+	LastUpdated  time.Time
+}
+
+// Route represents a route of an HTTP API, or a method on a REST API resource
+type Route struct {
+	RouteID           string // Route ID for HTTP APIs, resource ID for REST APIs
+	Method            string
+	Path              string
+	AuthorizationType string
+	APIKeyRequired    bool
+	Integration       *Integration
+}
+
+// Integration is the backend a route sends requests to
+type Integration struct {
+	IntegrationID  string
+	Type           string
+	URI            string
+	Method         string
+	ConnectionType string
+	TimeoutMillis  int32
+}
+
+// InvokeResult is the response of a test invocation
+type InvokeResult struct {
+	Status  int
+	Latency time.Duration
+	Headers map[string]string
+	Body    string
+	Log     string // Execution log, only returned for REST APIs
+}
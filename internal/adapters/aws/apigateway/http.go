@@ -0,0 +1,284 @@
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+// invokeBodyLimit caps how much of a response body is read by Invoke
+const invokeBodyLimit = 1024 * 1024
+
+// HTTPAPIsClient wraps the API Gateway v2 client for HTTP and WebSocket APIs
+type HTTPAPIsClient struct {
+	client *apigatewayv2.Client
+	http   *http.Client
+}
+
+// NewHTTPAPIsClient creates a new HTTP APIs client
+func NewHTTPAPIsClient(client *apigatewayv2.Client) *HTTPAPIsClient {
+	return &HTTPAPIsClient{
+		client: client,
+		http:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// ListAPIs lists all HTTP and WebSocket APIs
+func (c *HTTPAPIsClient) ListAPIs(ctx context.Context) ([]API, error) {
+	var apis []API
+	var nextToken *string
+
+	for {
+		output, err := c.client.GetApis(ctx, &apigatewayv2.GetApisInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list HTTP APIs: %w", err)
+		}
+
+		for _, api := range output.Items {
+			apis = append(apis, convertHTTPAPI(api))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return apis, nil
+}
+
+// GetAPI gets a single HTTP or WebSocket API by ID
+func (c *HTTPAPIsClient) GetAPI(ctx context.Context, apiID string) (*API, error) {
+	output, err := c.client.GetApi(ctx, &apigatewayv2.GetApiInput{
+		ApiId: aws.String(apiID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API %s: %w", apiID, err)
+	}
+
+	api := convertHTTPAPI(types.Api{
+		ApiId:        output.ApiId,
+		Name:         output.Name,
+		ProtocolType: output.ProtocolType,
+		ApiEndpoint:  output.ApiEndpoint,
+		Description:  output.Description,
+		CreatedDate:  output.CreatedDate,
+		Version:      output.Version,
+		Tags:         output.Tags,
+	})
+	return &api, nil
+}
+
+// ListStages lists the stages of an HTTP or WebSocket API
+func (c *HTTPAPIsClient) ListStages(ctx context.Context, apiID string) ([]Stage, error) {
+	var stages []Stage
+	var nextToken *string
+
+	for {
+		output, err := c.client.GetStages(ctx, &apigatewayv2.GetStagesInput{
+			ApiId:     aws.String(apiID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list stages of %s: %w", apiID, err)
+		}
+
+		for _, s := range output.Items {
+			stage := Stage{
+				StageName:    aws.ToString(s.StageName),
+				DeploymentID: aws.ToString(s.DeploymentId),
+				Description:  aws.ToString(s.Description),
+				AutoDeploy:   aws.ToBool(s.AutoDeploy),
+				Variables:    s.StageVariables,
+			}
+			if s.CreatedDate != nil {
+				stage.CreatedTime = *s.CreatedDate
+			}
+			if s.LastUpdatedDate != nil {
+				stage.LastUpdated = *s.LastUpdatedDate
+			}
+			stages = append(stages, stage)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return stages, nil
+}
+
+// ListRoutes lists the routes of an HTTP or WebSocket API with their integrations, sorted by path and method
+func (c *HTTPAPIsClient) ListRoutes(ctx context.Context, apiID string) ([]Route, error) {
+	integrations, err := c.listIntegrations(ctx, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []Route
+	var nextToken *string
+
+	for {
+		output, err := c.client.GetRoutes(ctx, &apigatewayv2.GetRoutesInput{
+			ApiId:     aws.String(apiID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list routes of %s: %w", apiID, err)
+		}
+
+		for _, r := range output.Items {
+			method, path := splitRouteKey(aws.ToString(r.RouteKey))
+			route := Route{
+				RouteID:           aws.ToString(r.RouteId),
+				Method:            method,
+				Path:              path,
+				AuthorizationType: string(r.AuthorizationType),
+				APIKeyRequired:    aws.ToBool(r.ApiKeyRequired),
+			}
+
+			// Targets are of the form integrations/<integration-id>
+			target := aws.ToString(r.Target)
+			if integration, ok := integrations[strings.TrimPrefix(target, "integrations/")]; ok {
+				route.Integration = integration
+			}
+
+			routes = append(routes, route)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	return routes, nil
+}
+
+func (c *HTTPAPIsClient) listIntegrations(ctx context.Context, apiID string) (map[string]*Integration, error) {
+	integrations := make(map[string]*Integration)
+	var nextToken *string
+
+	for {
+		output, err := c.client.GetIntegrations(ctx, &apigatewayv2.GetIntegrationsInput{
+			ApiId:     aws.String(apiID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list integrations of %s: %w", apiID, err)
+		}
+
+		for _, i := range output.Items {
+			integration := &Integration{
+				IntegrationID:  aws.ToString(i.IntegrationId),
+				Type:           string(i.IntegrationType),
+				URI:            aws.ToString(i.IntegrationUri),
+				Method:         aws.ToString(i.IntegrationMethod),
+				ConnectionType: string(i.ConnectionType),
+				TimeoutMillis:  aws.ToInt32(i.TimeoutInMillis),
+			}
+			integrations[integration.IntegrationID] = integration
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return integrations, nil
+}
+
+// Invoke sends a real request to a deployed HTTP API stage. Unlike REST test invocations
+// this goes through the route's authorizer, so protected routes answer 401 or 403.
+func (c *HTTPAPIsClient) Invoke(ctx context.Context, endpoint, stage, method, path string) (*InvokeResult, error) {
+	url := strings.TrimSuffix(endpoint, "/")
+	// The $default stage is served from the root of the endpoint
+	if stage != "" && stage != "$default" {
+		url += "/" + stage
+	}
+	url += path
+
+	if method == "ANY" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request for %s: %w", url, err)
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke %s %s: %w", method, url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, invokeBodyLimit))
+	latency := time.Since(start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %w", url, err)
+	}
+
+	headers := make(map[string]string, len(resp.Header))
+	for name := range resp.Header {
+		headers[name] = resp.Header.Get(name)
+	}
+
+	return &InvokeResult{
+		Status:  resp.StatusCode,
+		Latency: latency,
+		Headers: headers,
+		Body:    string(body),
+	}, nil
+}
+
+// splitRouteKey splits a route key like "GET /items/{id}" into its method and path.
+// Keys without a method, such as $default or WebSocket route keys, match any method.
+func splitRouteKey(routeKey string) (string, string) {
+	if method, path, ok := strings.Cut(routeKey, " "); ok {
+		return method, path
+	}
+	return "ANY", routeKey
+}
+
+func convertHTTPAPI(api types.Api) API {
+	result := API{
+		APIID:       aws.ToString(api.ApiId),
+		Name:        aws.ToString(api.Name),
+		Protocol:    string(api.ProtocolType),
+		Description: aws.ToString(api.Description),
+		Endpoint:    aws.ToString(api.ApiEndpoint),
+		Version:     aws.ToString(api.Version),
+		Tags:        api.Tags,
+	}
+
+	if api.CreatedDate != nil {
+		result.CreatedTime = *api.CreatedDate
+	}
+
+	if result.Tags == nil {
+		result.Tags = make(map[string]string)
+	}
+
+	return result
+}
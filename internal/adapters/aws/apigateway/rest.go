@@ -0,0 +1,204 @@
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+)
+
+// RestAPIsClient wraps the API Gateway client for REST APIs
+type RestAPIsClient struct {
+	client *apigateway.Client
+}
+
+// NewRestAPIsClient creates a new REST APIs client
+func NewRestAPIsClient(client *apigateway.Client) *RestAPIsClient {
+	return &RestAPIsClient{client: client}
+}
+
+// ListAPIs lists all REST APIs
+func (c *RestAPIsClient) ListAPIs(ctx context.Context) ([]API, error) {
+	var apis []API
+	var position *string
+
+	for {
+		output, err := c.client.GetRestApis(ctx, &apigateway.GetRestApisInput{
+			Limit:    aws.Int32(500),
+			Position: position,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list REST APIs: %w", err)
+		}
+
+		for _, api := range output.Items {
+			apis = append(apis, convertRestAPI(api))
+		}
+
+		if output.Position == nil {
+			break
+		}
+		position = output.Position
+	}
+
+	return apis, nil
+}
+
+// GetAPI gets a single REST API by ID
+func (c *RestAPIsClient) GetAPI(ctx context.Context, apiID string) (*API, error) {
+	output, err := c.client.GetRestApi(ctx, &apigateway.GetRestApiInput{
+		RestApiId: aws.String(apiID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get REST API %s: %w", apiID, err)
+	}
+
+	api := convertRestAPI(types.RestApi{
+		Id:                    output.Id,
+		Name:                  output.Name,
+		Description:           output.Description,
+		CreatedDate:           output.CreatedDate,
+		EndpointConfiguration: output.EndpointConfiguration,
+		Version:               output.Version,
+		Tags:                  output.Tags,
+	})
+	return &api, nil
+}
+
+// ListStages lists the stages of a REST API
+func (c *RestAPIsClient) ListStages(ctx context.Context, apiID string) ([]Stage, error) {
+	output, err := c.client.GetStages(ctx, &apigateway.GetStagesInput{
+		RestApiId: aws.String(apiID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stages of %s: %w", apiID, err)
+	}
+
+	stages := make([]Stage, 0, len(output.Item))
+	for _, s := range output.Item {
+		stage := Stage{
+			StageName:    aws.ToString(s.StageName),
+			DeploymentID: aws.ToString(s.DeploymentId),
+			Description:  aws.ToString(s.Description),
+			Variables:    s.Variables,
+		}
+		if s.CreatedDate != nil {
+			stage.CreatedTime = *s.CreatedDate
+		}
+		if s.LastUpdatedDate != nil {
+			stage.LastUpdated = *s.LastUpdatedDate
+		}
+		stages = append(stages, stage)
+	}
+
+	return stages, nil
+}
+
+// ListRoutes lists every method of every resource of a REST API, sorted by path and method
+func (c *RestAPIsClient) ListRoutes(ctx context.Context, apiID string) ([]Route, error) {
+	var routes []Route
+	var position *string
+
+	for {
+		output, err := c.client.GetResources(ctx, &apigateway.GetResourcesInput{
+			RestApiId: aws.String(apiID),
+			Embed:     []string{"methods"},
+			Limit:     aws.Int32(500),
+			Position:  position,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources of %s: %w", apiID, err)
+		}
+
+		for _, resource := range output.Items {
+			for method, m := range resource.ResourceMethods {
+				route := Route{
+					RouteID:           aws.ToString(resource.Id),
+					Method:            method,
+					Path:              aws.ToString(resource.Path),
+					AuthorizationType: aws.ToString(m.AuthorizationType),
+					APIKeyRequired:    aws.ToBool(m.ApiKeyRequired),
+				}
+				if i := m.MethodIntegration; i != nil {
+					route.Integration = &Integration{
+						Type:           string(i.Type),
+						URI:            aws.ToString(i.Uri),
+						Method:         aws.ToString(i.HttpMethod),
+						ConnectionType: string(i.ConnectionType),
+						TimeoutMillis:  i.TimeoutInMillis,
+					}
+				}
+				routes = append(routes, route)
+			}
+		}
+
+		if output.Position == nil {
+			break
+		}
+		position = output.Position
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	return routes, nil
+}
+
+// TestInvoke simulates a call to a REST API method without deploying it, bypassing authorization
+func (c *RestAPIsClient) TestInvoke(ctx context.Context, apiID, resourceID, method, path string) (*InvokeResult, error) {
+	output, err := c.client.TestInvokeMethod(ctx, &apigateway.TestInvokeMethodInput{
+		RestApiId:           aws.String(apiID),
+		ResourceId:          aws.String(resourceID),
+		HttpMethod:          aws.String(method),
+		PathWithQueryString: aws.String(path),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to test invoke %s %s: %w", method, path, err)
+	}
+
+	return &InvokeResult{
+		Status:  int(output.Status),
+		Latency: time.Duration(output.Latency) * time.Millisecond,
+		Headers: output.Headers,
+		Body:    aws.ToString(output.Body),
+		Log:     aws.ToString(output.Log),
+	}, nil
+}
+
+func convertRestAPI(api types.RestApi) API {
+	result := API{
+		APIID:       aws.ToString(api.Id),
+		Name:        aws.ToString(api.Name),
+		Protocol:    ProtocolREST,
+		Description: aws.ToString(api.Description),
+		Version:     aws.ToString(api.Version),
+		Tags:        api.Tags,
+	}
+
+	if api.CreatedDate != nil {
+		result.CreatedTime = *api.CreatedDate
+	}
+
+	if api.EndpointConfiguration != nil {
+		endpointTypes := make([]string, 0, len(api.EndpointConfiguration.Types))
+		for _, t := range api.EndpointConfiguration.Types {
+			endpointTypes = append(endpointTypes, string(t))
+		}
+		result.EndpointType = strings.Join(endpointTypes, ",")
+	}
+
+	if result.Tags == nil {
+		result.Tags = make(map[string]string)
+	}
+
+	return result
+}
@@ -0,0 +1,274 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+)
+
+// GroupsClient wraps the Auto Scaling client for group operations
+type GroupsClient struct {
+	client *autoscaling.Client
+}
+
+// NewGroupsClient creates a new Auto Scaling groups client
+func NewGroupsClient(client *autoscaling.Client) *GroupsClient {
+	return &GroupsClient{client: client}
+}
+
+// Group represents an Auto Scaling group
+type Group struct {
+	Name              string
+	ARN               string
+	DesiredCapacity   int32
+	MinSize           int32
+	MaxSize           int32
+	HealthCheckType   string
+	LaunchTemplate    string // Template name and version, or the launch configuration name
+	AvailabilityZones []string
+	Subnets           string
+	TargetGroupARNs   []string
+	Status            string
+	Instances         []Instance
+	Tags              map[string]string
+	CreatedTime       time.Time
+}
+
+// InService returns the number of instances in the InService lifecycle state
+func (g Group) InService() int {
+	count := 0
+	for _, inst := range g.Instances {
+		if inst.LifecycleState == string(types.LifecycleStateInService) {
+			count++
+		}
+	}
+	return count
+}
+
+// Instance is an instance in an Auto Scaling group
+type Instance struct {
+	InstanceID           string
+	InstanceType         string
+	AvailabilityZone     string
+	LifecycleState       string
+	HealthStatus         string
+	ProtectedFromScaleIn bool
+}
+
+// Policy is a scaling policy of an Auto Scaling group
+type Policy struct {
+	Name    string
+	Type    string
+	Summary string
+	Enabled bool
+}
+
+// InstanceRefresh is an instance refresh of an Auto Scaling group
+type InstanceRefresh struct {
+	ID                 string
+	Status             string
+	StatusReason       string
+	PercentageComplete int32
+	StartTime          time.Time
+}
+
+// ListGroups lists all Auto Scaling groups
+func (c *GroupsClient) ListGroups(ctx context.Context) ([]Group, error) {
+	var groups []Group
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(c.client, &autoscaling.DescribeAutoScalingGroupsInput{})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe auto scaling groups: %w", err)
+		}
+
+		for _, g := range output.AutoScalingGroups {
+			groups = append(groups, convertGroup(g))
+		}
+	}
+
+	return groups, nil
+}
+
+// GetGroup gets a single Auto Scaling group by name
+func (c *GroupsClient) GetGroup(ctx context.Context, name string) (*Group, error) {
+	output, err := c.client.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe auto scaling group: %w", err)
+	}
+
+	if len(output.AutoScalingGroups) == 0 {
+		return nil, fmt.Errorf("auto scaling group not found: %s", name)
+	}
+
+	group := convertGroup(output.AutoScalingGroups[0])
+	return &group, nil
+}
+
+// ListPolicies lists the scaling policies of a group
+func (c *GroupsClient) ListPolicies(ctx context.Context, name string) ([]Policy, error) {
+	var policies []Policy
+	paginator := autoscaling.NewDescribePoliciesPaginator(c.client, &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String(name),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe scaling policies: %w", err)
+		}
+
+		for _, p := range output.ScalingPolicies {
+			policies = append(policies, Policy{
+				Name:    aws.ToString(p.PolicyName),
+				Type:    aws.ToString(p.PolicyType),
+				Summary: summarizePolicy(p),
+				Enabled: p.Enabled == nil || *p.Enabled,
+			})
+		}
+	}
+
+	return policies, nil
+}
+
+// LatestInstanceRefresh returns the most recent instance refresh of a group, or nil if it
+// has none
+func (c *GroupsClient) LatestInstanceRefresh(ctx context.Context, name string) (*InstanceRefresh, error) {
+	output, err := c.client.DescribeInstanceRefreshes(ctx, &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int32(1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe instance refreshes: %w", err)
+	}
+
+	if len(output.InstanceRefreshes) == 0 {
+		return nil, nil
+	}
+
+	r := output.InstanceRefreshes[0]
+	refresh := &InstanceRefresh{
+		ID:                 aws.ToString(r.InstanceRefreshId),
+		Status:             string(r.Status),
+		StatusReason:       aws.ToString(r.StatusReason),
+		PercentageComplete: aws.ToInt32(r.PercentageComplete),
+	}
+	if r.StartTime != nil {
+		refresh.StartTime = *r.StartTime
+	}
+	return refresh, nil
+}
+
+// SetDesiredCapacity sets the desired capacity of a group, honoring its cooldown
+func (c *GroupsClient) SetDesiredCapacity(ctx context.Context, name string, desired int32) error {
+	_, err := c.client.SetDesiredCapacity(ctx, &autoscaling.SetDesiredCapacityInput{
+		AutoScalingGroupName: aws.String(name),
+		DesiredCapacity:      aws.Int32(desired),
+		HonorCooldown:        aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set desired capacity: %w", err)
+	}
+	return nil
+}
+
+// StartInstanceRefresh starts a rolling replacement of a group's instances with the default
+// preferences and returns the refresh ID
+func (c *GroupsClient) StartInstanceRefresh(ctx context.Context, name string) (string, error) {
+	output, err := c.client.StartInstanceRefresh(ctx, &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start instance refresh: %w", err)
+	}
+	return aws.ToString(output.InstanceRefreshId), nil
+}
+
+// EnterStandby moves an instance to standby. With decrement the desired capacity is lowered
+// so no replacement is launched.
+func (c *GroupsClient) EnterStandby(ctx context.Context, name, instanceID string, decrement bool) error {
+	_, err := c.client.EnterStandby(ctx, &autoscaling.EnterStandbyInput{
+		AutoScalingGroupName:           aws.String(name),
+		InstanceIds:                    []string{instanceID},
+		ShouldDecrementDesiredCapacity: aws.Bool(decrement),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to move %s to standby: %w", instanceID, err)
+	}
+	return nil
+}
+
+// summarizePolicy describes a scaling policy in one line
+func summarizePolicy(p types.ScalingPolicy) string {
+	switch {
+	case p.TargetTrackingConfiguration != nil:
+		tt := p.TargetTrackingConfiguration
+		metric := "custom metric"
+		if tt.PredefinedMetricSpecification != nil {
+			metric = string(tt.PredefinedMetricSpecification.PredefinedMetricType)
+		}
+		return fmt.Sprintf("%s at %g", metric, aws.ToFloat64(tt.TargetValue))
+	case p.ScalingAdjustment != nil:
+		return fmt.Sprintf("%s %d", aws.ToString(p.AdjustmentType), aws.ToInt32(p.ScalingAdjustment))
+	case len(p.StepAdjustments) > 0:
+		return fmt.Sprintf("%s, %d steps", aws.ToString(p.AdjustmentType), len(p.StepAdjustments))
+	case p.PredictiveScalingConfiguration != nil:
+		return "predictive, " + string(p.PredictiveScalingConfiguration.Mode)
+	}
+	return aws.ToString(p.PolicyType)
+}
+
+func convertGroup(g types.AutoScalingGroup) Group {
+	group := Group{
+		Name:              aws.ToString(g.AutoScalingGroupName),
+		ARN:               aws.ToString(g.AutoScalingGroupARN),
+		DesiredCapacity:   aws.ToInt32(g.DesiredCapacity),
+		MinSize:           aws.ToInt32(g.MinSize),
+		MaxSize:           aws.ToInt32(g.MaxSize),
+		HealthCheckType:   aws.ToString(g.HealthCheckType),
+		AvailabilityZones: g.AvailabilityZones,
+		Subnets:           aws.ToString(g.VPCZoneIdentifier),
+		TargetGroupARNs:   g.TargetGroupARNs,
+		Status:            aws.ToString(g.Status),
+		Tags:              make(map[string]string),
+	}
+
+	switch {
+	case g.LaunchTemplate != nil:
+		group.LaunchTemplate = fmt.Sprintf("%s (%s)", aws.ToString(g.LaunchTemplate.LaunchTemplateName), aws.ToString(g.LaunchTemplate.Version))
+	case g.MixedInstancesPolicy != nil && g.MixedInstancesPolicy.LaunchTemplate != nil &&
+		g.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification != nil:
+		spec := g.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+		group.LaunchTemplate = fmt.Sprintf("%s (%s, mixed)", aws.ToString(spec.LaunchTemplateName), aws.ToString(spec.Version))
+	default:
+		group.LaunchTemplate = aws.ToString(g.LaunchConfigurationName)
+	}
+
+	if g.CreatedTime != nil {
+		group.CreatedTime = *g.CreatedTime
+	}
+
+	for _, inst := range g.Instances {
+		group.Instances = append(group.Instances, Instance{
+			InstanceID:           aws.ToString(inst.InstanceId),
+			InstanceType:         aws.ToString(inst.InstanceType),
+			AvailabilityZone:     aws.ToString(inst.AvailabilityZone),
+			LifecycleState:       string(inst.LifecycleState),
+			HealthStatus:         aws.ToString(inst.HealthStatus),
+			ProtectedFromScaleIn: aws.ToBool(inst.ProtectedFromScaleIn),
+		})
+	}
+
+	for _, tag := range g.Tags {
+		group.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return group
+}
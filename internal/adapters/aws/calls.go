@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// Call is an AWS API call that may have changed something, reported once it finished
+type Call struct {
+	Time      time.Time
+	Profile   string
+	Account   string // Empty until the account of the profile is known
+	Region    string
+	Service   string // e.g. "EC2"
+	Operation string // e.g. "StopInstances"
+	Resource  string // ARN the call names, or else its ID or name
+	Err       error
+}
+
+// CallRecorder is told of each call that may have changed something, read-only calls
+// aren't reported. It runs on the goroutine making the call.
+type CallRecorder func(Call)
+
+// readOnlyPrefixes start the names of operations that only read
+var readOnlyPrefixes = []string{
+	"Describe", "List", "Get", "Head", "Lookup", "Search", "Select", "Scan", "Query",
+	"BatchGet", "Filter", "Estimate", "Simulate", "Decode", "Preview", "Validate",
+	"Generate", "Verify", "Test",
+}
+
+// readOnlyOperations are the other operations that don't change anything
+var readOnlyOperations = map[string]bool{
+	"StartQuery":    true, // Logs Insights
+	"StopQuery":     true,
+	"StartLiveTail": true,
+}
+
+// unrecordedServices sign in and hand out credentials rather than change resources
+var unrecordedServices = map[string]bool{
+	"STS":      true,
+	"SSO":      true,
+	"SSO OIDC": true,
+}
+
+// SetCallRecorder reports the calls that may change something to record, for the audit
+// log. It applies to the clients already created too; nil stops reporting.
+func (cm *ClientManager) SetCallRecorder(record CallRecorder) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.callRecorder = record
+}
+
+// isReadOnlyCall reports whether a call only reads, by its operation name
+func isReadOnlyCall(service, operation string) bool {
+	if unrecordedServices[service] || readOnlyOperations[operation] {
+		return true
+	}
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// callRecorderMiddleware reports the calls made with a profile's config once they finished
+func (cm *ClientManager) callRecorderMiddleware(profile string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("CallRecorder",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				service := awsmiddleware.GetServiceID(ctx)
+				operation := awsmiddleware.GetOperationName(ctx)
+				if isReadOnlyCall(service, operation) || isDryRun(in.Parameters) {
+					return next.HandleInitialize(ctx, in)
+				}
+
+				out, metadata, err := next.HandleInitialize(ctx, in)
+
+				cm.mu.RLock()
+				record := cm.callRecorder
+				account := ""
+				if cm.profile == profile {
+					account = cm.accountID
+				}
+				cm.mu.RUnlock()
+
+				if record != nil {
+					record(Call{
+						Time:      time.Now(),
+						Profile:   profile,
+						Account:   account,
+						Region:    awsmiddleware.GetRegion(ctx),
+						Service:   service,
+						Operation: operation,
+						Resource:  callResource(in.Parameters),
+						Err:       err,
+					})
+				}
+				return out, metadata, err
+			}), middleware.After)
+	}
+}
+
+// isDryRun reports whether an EC2 call only checks permissions, changing nothing
+func isDryRun(input interface{}) bool {
+	v := structValue(input)
+	if !v.IsValid() {
+		return false
+	}
+	field := v.FieldByName("DryRun")
+	if !field.IsValid() || field.Kind() != reflect.Pointer || field.IsNil() {
+		return false
+	}
+	dryRun, ok := field.Elem().Interface().(bool)
+	return ok && dryRun
+}
+
+// callResource picks what a call acts on out of its input: the first ARN, else an S3
+// bucket and key, else the first field naming an ID or name
+func callResource(input interface{}) string {
+	v := structValue(input)
+	if !v.IsValid() {
+		return ""
+	}
+
+	if bucket := fieldText(v.FieldByName("Bucket")); bucket != "" {
+		if key := fieldText(v.FieldByName("Key")); key != "" {
+			return "s3://" + bucket + "/" + key
+		}
+		return "s3://" + bucket
+	}
+
+	fallback := ""
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		name := t.Field(i).Name
+		value := fieldText(v.Field(i))
+		if value == "" {
+			continue
+		}
+		if strings.HasSuffix(name, "Arn") || strings.HasSuffix(name, "ARN") {
+			return value
+		}
+		if fallback == "" && isIdentifierField(name) {
+			fallback = value
+		}
+	}
+	return fallback
+}
+
+func isIdentifierField(name string) bool {
+	for _, suffix := range []string{"Id", "Ids", "Identifier", "Name", "Names"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// structValue dereferences a call's input down to its struct
+func structValue(input interface{}) reflect.Value {
+	v := reflect.ValueOf(input)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v
+}
+
+// fieldText reads a string, string pointer or string slice field
+func fieldText(field reflect.Value) string {
+	if !field.IsValid() {
+		return ""
+	}
+	switch field.Kind() {
+	case reflect.Pointer:
+		if field.IsNil() || field.Elem().Kind() != reflect.String {
+			return ""
+		}
+		return field.Elem().String()
+	case reflect.String:
+		return field.String()
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return ""
+		}
+		values := make([]string, field.Len())
+		for i := range values {
+			values[i] = field.Index(i).String()
+		}
+		return strings.Join(values, ", ")
+	}
+	return ""
+}
@@ -4,20 +4,42 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/oam"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/aws/support"
 )
 
 // ClientManager manages AWS service clients with profile/region switching
@@ -28,6 +50,19 @@ type ClientManager struct {
 	region        string
 	accountID     string
 
+	// Set while a role assumed from the TUI is in use instead of the profile's credentials
+	assumedRole *AssumedRole
+	baseConfig  aws.Config // The profile's own config, roles are assumed from it
+
+	// Asks for MFA codes of assume-role profiles with an mfa_serial
+	mfaToken MFATokenFunc
+
+	// Longest an API call may take, 0 for no limit
+	requestTimeout time.Duration
+
+	// Told of the calls that may change something, nil if none is set
+	callRecorder CallRecorder
+
 	// Lazily initialized service clients
 	iamClient      *iam.Client
 	ec2Client      *ec2.Client
@@ -40,6 +75,25 @@ type ClientManager struct {
 	s3Client       *s3.Client
 	logsClient     *cloudwatchlogs.Client
 	dynamodbClient *dynamodb.Client
+	ceClient       *costexplorer.Client
+	elbClient      *elbv2.Client
+	route53Client  *route53.Client
+	mqClient       *mq.Client
+	ibClient       *imagebuilder.Client
+	cwClient       *cloudwatch.Client
+	cpClient       *codepipeline.Client
+	cfnClient      *cloudformation.Client
+	apigwClient    *apigateway.Client
+	apigwv2Client  *apigatewayv2.Client
+	asgClient      *autoscaling.Client
+	oamClient      *oam.Client
+	connectClient  *connect.Client
+	pinpointClient *pinpoint.Client
+	configClient   *configservice.Client
+	shClient       *securityhub.Client
+	supportClient  *support.Client
+	orgsClient     *organizations.Client
+	taggingClient  *resourcegroupstaggingapi.Client
 }
 
 // NewClientManager creates a new AWS client manager
@@ -49,13 +103,87 @@ func NewClientManager() *ClientManager {
 	}
 }
 
+// PreparedContext is a profile and region with its config loaded, ready to be made current
+// with Activate
+type PreparedContext struct {
+	profile     string
+	region      string
+	accountID   string // Set once the credentials were checked
+	config      aws.Config
+	baseConfig  aws.Config
+	assumedRole *AssumedRole
+}
+
+// Profile returns the prepared profile
+func (p *PreparedContext) Profile() string { return p.profile }
+
+// Region returns the prepared region
+func (p *PreparedContext) Region() string { return p.region }
+
+// AccountID returns the account the prepared credentials belong to
+func (p *PreparedContext) AccountID() string { return p.accountID }
+
 // Configure initializes the client manager with a specific profile and region
 func (cm *ClientManager) Configure(ctx context.Context, profile, region string) error {
+	prepared, err := cm.loadContext(ctx, profile, region)
+	if err != nil {
+		return err
+	}
+	cm.Activate(prepared)
+	return nil
+}
+
+// Prepare loads a profile and region and checks its credentials with GetCallerIdentity.
+// The current context and its clients stay in use meanwhile; cancel ctx to abandon it.
+func (cm *ClientManager) Prepare(ctx context.Context, profile, region string) (*PreparedContext, error) {
+	prepared, err := cm.loadContext(ctx, profile, region)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := sts.NewFromConfig(prepared.config).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, fmt.Errorf("AWS credentials not configured or invalid. Profile: '%s'. Error: %w", profile, err)
+	}
+	prepared.accountID = aws.ToString(result.Account)
+	return prepared, nil
+}
+
+// Activate makes a prepared context current, dropping the clients of the previous one
+func (cm *ClientManager) Activate(prepared *PreparedContext) {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
+	cm.baseConfig = prepared.baseConfig
+	cm.currentConfig = prepared.config
+	cm.assumedRole = prepared.assumedRole
+	cm.profile = prepared.profile
+	cm.region = prepared.region
+	cm.resetClients()
+	cm.accountID = prepared.accountID
+}
+
+// loadContext loads the config of a profile and region. The lock isn't held meanwhile, as
+// credential providers such as SSO or credential_process can take a while.
+func (cm *ClientManager) loadContext(ctx context.Context, profile, region string) (*PreparedContext, error) {
+	cm.mu.RLock()
+	currentProfile := cm.profile
+	assumedRole := cm.assumedRole
+	mfaToken := cm.mfaToken
+	requestTimeout := cm.requestTimeout
+	cm.mu.RUnlock()
+
 	opts := []func(*config.LoadOptions) error{}
 
+	if mfaToken != nil {
+		opts = append(opts, config.WithAssumeRoleCredentialOptions(mfaTokenOption(mfaToken)))
+	}
+
+	opts = append(opts, config.WithAPIOptions([]func(*middleware.Stack) error{cm.callRecorderMiddleware(profile)}))
+	if requestTimeout > 0 {
+		opts = append(opts, config.WithAPIOptions([]func(*middleware.Stack) error{requestTimeoutMiddleware(requestTimeout)}))
+	}
+
 	if region != "" {
 		opts = append(opts, config.WithRegion(region))
 	}
@@ -66,20 +194,37 @@ func (cm *ClientManager) Configure(ctx context.Context, profile, region string)
 
 	cfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
-		return fmt.Errorf("failed to load AWS config for profile '%s': %w. Check your ~/.aws/config and ~/.aws/credentials files, or set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables", profile, err)
+		return nil, fmt.Errorf("failed to load AWS config for profile '%s': %w. Check your ~/.aws/config and ~/.aws/credentials files, or set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables", profile, err)
+	}
+
+	prepared := &PreparedContext{
+		profile:    profile,
+		config:     cfg,
+		baseConfig: cfg,
+	}
+
+	// An assumed role survives region switches, but not a change of profile
+	if assumedRole != nil && profile == currentProfile {
+		prepared.config, err = assumeRoleConfig(ctx, cfg, *assumedRole)
+		if err != nil {
+			return nil, err
+		}
+		prepared.assumedRole = assumedRole
 	}
 
-	cm.currentConfig = cfg
-	cm.profile = profile
 	if region != "" {
-		cm.region = region
+		prepared.region = region
 	} else if cfg.Region != "" {
-		cm.region = cfg.Region
+		prepared.region = cfg.Region
 	} else {
-		cm.region = "us-east-1" // Fallback
+		prepared.region = "us-east-1" // Fallback
 	}
 
-	// Reset cached clients so they get recreated with new config
+	return prepared, nil
+}
+
+// resetClients drops the cached clients so they get recreated with the current config
+func (cm *ClientManager) resetClients() {
 	cm.iamClient = nil
 	cm.ec2Client = nil
 	cm.kmsClient = nil
@@ -91,28 +236,111 @@ func (cm *ClientManager) Configure(ctx context.Context, profile, region string)
 	cm.s3Client = nil
 	cm.logsClient = nil
 	cm.dynamodbClient = nil
+	cm.ceClient = nil
+	cm.elbClient = nil
+	cm.route53Client = nil
+	cm.mqClient = nil
+	cm.ibClient = nil
+	cm.cwClient = nil
+	cm.cpClient = nil
+	cm.cfnClient = nil
+	cm.apigwClient = nil
+	cm.apigwv2Client = nil
+	cm.asgClient = nil
+	cm.oamClient = nil
+	cm.connectClient = nil
+	cm.pinpointClient = nil
+	cm.configClient = nil
+	cm.shClient = nil
+	cm.supportClient = nil
+	cm.orgsClient = nil
+	cm.taggingClient = nil
 	cm.accountID = ""
-
-	return nil
 }
 
-// GetAccountID returns the current AWS account ID
+// GetAccountID returns the current AWS account ID. The lock isn't held during the call to
+// STS, so other clients stay usable while it runs.
 func (cm *ClientManager) GetAccountID(ctx context.Context) (string, error) {
 	cm.mu.Lock()
-	defer cm.mu.Unlock()
-
 	if cm.accountID != "" {
+		defer cm.mu.Unlock()
 		return cm.accountID, nil
 	}
+	client := cm.getSTS()
+	profile := cm.profile
+	cm.mu.Unlock()
+
+	result, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("AWS credentials not configured or invalid. Profile: '%s'. Error: %w", profile, err)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	// Only cache it if the context didn't change meanwhile
+	if cm.stsClient == client {
+		cm.accountID = aws.ToString(result.Account)
+	}
+	return aws.ToString(result.Account), nil
+}
 
+// CallerARN returns the ARN of the user or role the current credentials act as
+func (cm *ClientManager) CallerARN(ctx context.Context) (string, error) {
+	cm.mu.Lock()
 	client := cm.getSTS()
+	cm.mu.Unlock()
+
 	result, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
-		return "", fmt.Errorf("AWS credentials not configured or invalid. Profile: '%s'. Error: %w", cm.profile, err)
+		return "", err
+	}
+	return aws.ToString(result.Arn), nil
+}
+
+// ResolveAccount returns the account ID and alias of a profile's credentials without
+// switching to it. The alias is empty if the account has none or listing it isn't
+// allowed. Profiles needing an MFA code fail rather than ask for one.
+func (cm *ClientManager) ResolveAccount(ctx context.Context, profile string) (accountID, alias string, err error) {
+	cm.mu.RLock()
+	region := cm.region
+	requestTimeout := cm.requestTimeout
+	cm.mu.RUnlock()
+
+	opts := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if requestTimeout > 0 {
+		opts = append(opts, config.WithAPIOptions([]func(*middleware.Stack) error{requestTimeoutMiddleware(requestTimeout)}))
+	}
+	if profile != "" && profile != "default" {
+		opts = append(opts, config.WithSharedConfigProfile(profile))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load AWS config for profile '%s': %w", profile, err)
 	}
 
-	cm.accountID = aws.ToString(result.Account)
-	return cm.accountID, nil
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", "", err
+	}
+	accountID = aws.ToString(identity.Account)
+
+	if aliases, err := iam.NewFromConfig(cfg).ListAccountAliases(ctx, &iam.ListAccountAliasesInput{}); err == nil && len(aliases.AccountAliases) > 0 {
+		alias = aliases.AccountAliases[0]
+	}
+	return accountID, alias, nil
+}
+
+// InvalidateCredentials drops the cached credentials of the current context, so the next
+// call loads them again, as needed once an expired SSO session was logged in to again.
+// Clients created before keep working, as they share the cache.
+func (cm *ClientManager) InvalidateCredentials() {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	for _, cfg := range []aws.Config{cm.currentConfig, cm.baseConfig} {
+		if cache, ok := cfg.Credentials.(*aws.CredentialsCache); ok {
+			cache.Invalidate()
+		}
+	}
 }
 
 // SwitchProfile changes the AWS profile while keeping the same region
@@ -209,6 +437,20 @@ func (cm *ClientManager) RDS() *rds.Client {
 	return cm.rdsClient
 }
 
+// RDSForRegion returns an RDS client for the given region, used to copy snapshots to it
+func (cm *ClientManager) RDSForRegion(region string) *rds.Client {
+	if region == "" || region == cm.Region() {
+		return cm.RDS()
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	return rds.NewFromConfig(cm.currentConfig, func(o *rds.Options) {
+		o.Region = region
+	})
+}
+
 // ECS returns the ECS client (lazily initialized)
 func (cm *ClientManager) ECS() *ecs.Client {
 	cm.mu.Lock()
@@ -253,6 +495,21 @@ func (cm *ClientManager) CloudWatchLogs() *cloudwatchlogs.Client {
 	return cm.logsClient
 }
 
+// CloudWatchLogsForRegion returns a CloudWatch Logs client for the given region, which
+// may differ from the current one (e.g. the awslogs-region of a container)
+func (cm *ClientManager) CloudWatchLogsForRegion(region string) *cloudwatchlogs.Client {
+	if region == "" || region == cm.Region() {
+		return cm.CloudWatchLogs()
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	return cloudwatchlogs.NewFromConfig(cm.currentConfig, func(o *cloudwatchlogs.Options) {
+		o.Region = region
+	})
+}
+
 // STS returns the STS client (lazily initialized)
 func (cm *ClientManager) STS() *sts.Client {
 	cm.mu.Lock()
@@ -279,6 +536,219 @@ func (cm *ClientManager) DynamoDB() *dynamodb.Client {
 	return cm.dynamodbClient
 }
 
+// CostExplorer returns the Cost Explorer client (lazily initialized).
+// Cost Explorer is only served from us-east-1 regardless of the current region.
+func (cm *ClientManager) CostExplorer() *costexplorer.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.ceClient == nil {
+		cm.ceClient = costexplorer.NewFromConfig(cm.currentConfig, func(o *costexplorer.Options) {
+			o.Region = "us-east-1"
+		})
+	}
+	return cm.ceClient
+}
+
+// ELBv2 returns the Elastic Load Balancing v2 client (lazily initialized)
+func (cm *ClientManager) ELBv2() *elbv2.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.elbClient == nil {
+		cm.elbClient = elbv2.NewFromConfig(cm.currentConfig)
+	}
+	return cm.elbClient
+}
+
+// Route53 returns the Route 53 client (lazily initialized)
+func (cm *ClientManager) Route53() *route53.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.route53Client == nil {
+		cm.route53Client = route53.NewFromConfig(cm.currentConfig)
+	}
+	return cm.route53Client
+}
+
+// MQ returns the Amazon MQ client (lazily initialized)
+func (cm *ClientManager) MQ() *mq.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.mqClient == nil {
+		cm.mqClient = mq.NewFromConfig(cm.currentConfig)
+	}
+	return cm.mqClient
+}
+
+// ImageBuilder returns the EC2 Image Builder client (lazily initialized)
+func (cm *ClientManager) ImageBuilder() *imagebuilder.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.ibClient == nil {
+		cm.ibClient = imagebuilder.NewFromConfig(cm.currentConfig)
+	}
+	return cm.ibClient
+}
+
+// CloudWatch returns the CloudWatch client for alarms and metrics (lazily initialized)
+func (cm *ClientManager) CloudWatch() *cloudwatch.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.cwClient == nil {
+		cm.cwClient = cloudwatch.NewFromConfig(cm.currentConfig)
+	}
+	return cm.cwClient
+}
+
+// CodePipeline returns the CodePipeline client (lazily initialized)
+func (cm *ClientManager) CodePipeline() *codepipeline.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.cpClient == nil {
+		cm.cpClient = codepipeline.NewFromConfig(cm.currentConfig)
+	}
+	return cm.cpClient
+}
+
+// CloudFormation returns the CloudFormation client (lazily initialized)
+func (cm *ClientManager) CloudFormation() *cloudformation.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.cfnClient == nil {
+		cm.cfnClient = cloudformation.NewFromConfig(cm.currentConfig)
+	}
+	return cm.cfnClient
+}
+
+// APIGateway returns the API Gateway client for REST APIs (lazily initialized)
+func (cm *ClientManager) APIGateway() *apigateway.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.apigwClient == nil {
+		cm.apigwClient = apigateway.NewFromConfig(cm.currentConfig)
+	}
+	return cm.apigwClient
+}
+
+// APIGatewayV2 returns the API Gateway client for HTTP and WebSocket APIs (lazily initialized)
+func (cm *ClientManager) APIGatewayV2() *apigatewayv2.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.apigwv2Client == nil {
+		cm.apigwv2Client = apigatewayv2.NewFromConfig(cm.currentConfig)
+	}
+	return cm.apigwv2Client
+}
+
+// AutoScaling returns the Auto Scaling client (lazily initialized)
+func (cm *ClientManager) AutoScaling() *autoscaling.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.asgClient == nil {
+		cm.asgClient = autoscaling.NewFromConfig(cm.currentConfig)
+	}
+	return cm.asgClient
+}
+
+// OAM returns the CloudWatch Observability Access Manager client (lazily initialized)
+func (cm *ClientManager) OAM() *oam.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.oamClient == nil {
+		cm.oamClient = oam.NewFromConfig(cm.currentConfig)
+	}
+	return cm.oamClient
+}
+
+// Connect returns the Amazon Connect client (lazily initialized)
+func (cm *ClientManager) Connect() *connect.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.connectClient == nil {
+		cm.connectClient = connect.NewFromConfig(cm.currentConfig)
+	}
+	return cm.connectClient
+}
+
+// Pinpoint returns the Amazon Pinpoint client (lazily initialized)
+func (cm *ClientManager) Pinpoint() *pinpoint.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.pinpointClient == nil {
+		cm.pinpointClient = pinpoint.NewFromConfig(cm.currentConfig)
+	}
+	return cm.pinpointClient
+}
+
+// ConfigService returns the AWS Config client (lazily initialized)
+func (cm *ClientManager) ConfigService() *configservice.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.configClient == nil {
+		cm.configClient = configservice.NewFromConfig(cm.currentConfig)
+	}
+	return cm.configClient
+}
+
+// SecurityHub returns the Security Hub client (lazily initialized)
+func (cm *ClientManager) SecurityHub() *securityhub.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.shClient == nil {
+		cm.shClient = securityhub.NewFromConfig(cm.currentConfig)
+	}
+	return cm.shClient
+}
+
+// Support returns the AWS Support client (lazily initialized). It always calls us-east-1,
+// the only region Support is served from.
+func (cm *ClientManager) Support() *support.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.supportClient == nil {
+		cm.supportClient = support.NewClient(cm.currentConfig)
+	}
+	return cm.supportClient
+}
+
+// Organizations returns the AWS Organizations client (lazily initialized)
+func (cm *ClientManager) Organizations() *organizations.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.orgsClient == nil {
+		cm.orgsClient = organizations.NewFromConfig(cm.currentConfig)
+	}
+	return cm.orgsClient
+}
+
+// Tagging returns the Resource Groups Tagging API client (lazily initialized)
+func (cm *ClientManager) Tagging() *resourcegroupstaggingapi.Client {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.taggingClient == nil {
+		cm.taggingClient = resourcegroupstaggingapi.NewFromConfig(cm.currentConfig)
+	}
+	return cm.taggingClient
+}
+
 // ValidateCredentials checks if the current credentials are valid
 func (cm *ClientManager) ValidateCredentials(ctx context.Context) error {
 	client := cm.STS()
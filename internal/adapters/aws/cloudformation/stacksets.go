@@ -0,0 +1,337 @@
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// Identities a stack set call can be made as. Service-managed stack sets are only visible
+// to a delegated administrator account when called as DELEGATED_ADMIN.
+const (
+	CallAsSelf           = "SELF"
+	CallAsDelegatedAdmin = "DELEGATED_ADMIN"
+)
+
+// StackSetsClient wraps the CloudFormation client for stack set operations
+type StackSetsClient struct {
+	client *cloudformation.Client
+}
+
+// NewStackSetsClient creates a new CloudFormation stack sets client
+func NewStackSetsClient(client *cloudformation.Client) *StackSetsClient {
+	return &StackSetsClient{client: client}
+}
+
+// StackSetSummary represents a stack set as returned by listing
+type StackSetSummary struct {
+	StackSetID      string
+	StackSetName    string
+	Description     string
+	Status          string
+	PermissionModel string
+	DriftStatus     string
+	LastDriftCheck  time.Time
+	AutoDeployment  bool
+	CallAs          string // Identity the stack set was listed as, needed for later calls
+}
+
+// StackSet represents a fully described stack set
+type StackSet struct {
+	StackSetSummary
+	StackSetARN           string
+	AdministrationRoleARN string
+	ExecutionRoleName     string
+	Capabilities          []string
+	Regions               []string
+	OrganizationalUnitIDs []string
+	Parameters            map[string]string
+	Tags                  map[string]string
+	Drift                 *StackSetDrift
+}
+
+// StackSetDrift holds the instance counts from the last drift detection of a stack set
+type StackSetDrift struct {
+	DetectionStatus string
+	DriftStatus     string
+	LastCheck       time.Time
+	Total           int32
+	InSync          int32
+	Drifted         int32
+	Failed          int32
+	InProgress      int32
+}
+
+// StackInstance represents a stack set instance in one account and region
+type StackInstance struct {
+	Account              string
+	Region               string
+	Status               string
+	DetailedStatus       string
+	StatusReason         string
+	DriftStatus          string
+	LastDriftCheck       time.Time
+	StackID              string
+	OrganizationalUnitID string
+	LastOperationID      string
+	Parameters           map[string]string // Parameter overrides, only set when described
+}
+
+// StackSetOperation represents a create, update or delete operation on a stack set
+type StackSetOperation struct {
+	OperationID  string
+	Action       string
+	Status       string
+	StatusReason string
+	CreatedTime  time.Time
+	EndTime      time.Time
+}
+
+// ListStackSets lists active stack sets as the given identity
+func (c *StackSetsClient) ListStackSets(ctx context.Context, callAs string) ([]StackSetSummary, error) {
+	var stackSets []StackSetSummary
+	var nextToken *string
+
+	for {
+		output, err := c.client.ListStackSets(ctx, &cloudformation.ListStackSetsInput{
+			Status:    types.StackSetStatusActive,
+			CallAs:    types.CallAs(callAs),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list stack sets: %w", err)
+		}
+
+		for _, summary := range output.Summaries {
+			stackSets = append(stackSets, convertStackSetSummary(summary, callAs))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return stackSets, nil
+}
+
+// DescribeStackSet gets a single stack set by name
+func (c *StackSetsClient) DescribeStackSet(ctx context.Context, name, callAs string) (*StackSet, error) {
+	output, err := c.client.DescribeStackSet(ctx, &cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(name),
+		CallAs:       types.CallAs(callAs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe stack set %s: %w", name, err)
+	}
+
+	if output.StackSet == nil {
+		return nil, fmt.Errorf("stack set %s not found", name)
+	}
+
+	ss := output.StackSet
+	result := &StackSet{
+		StackSetSummary: StackSetSummary{
+			StackSetID:      aws.ToString(ss.StackSetId),
+			StackSetName:    aws.ToString(ss.StackSetName),
+			Description:     aws.ToString(ss.Description),
+			Status:          string(ss.Status),
+			PermissionModel: string(ss.PermissionModel),
+			CallAs:          callAs,
+		},
+		StackSetARN:           aws.ToString(ss.StackSetARN),
+		AdministrationRoleARN: aws.ToString(ss.AdministrationRoleARN),
+		ExecutionRoleName:     aws.ToString(ss.ExecutionRoleName),
+		Regions:               ss.Regions,
+		OrganizationalUnitIDs: ss.OrganizationalUnitIds,
+		Parameters:            make(map[string]string),
+		Tags:                  make(map[string]string),
+	}
+
+	if ss.AutoDeployment != nil {
+		result.AutoDeployment = aws.ToBool(ss.AutoDeployment.Enabled)
+	}
+
+	for _, capability := range ss.Capabilities {
+		result.Capabilities = append(result.Capabilities, string(capability))
+	}
+
+	for _, param := range ss.Parameters {
+		result.Parameters[aws.ToString(param.ParameterKey)] = aws.ToString(param.ParameterValue)
+	}
+
+	for _, tag := range ss.Tags {
+		result.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	if d := ss.StackSetDriftDetectionDetails; d != nil {
+		result.DriftStatus = string(d.DriftStatus)
+		result.Drift = &StackSetDrift{
+			DetectionStatus: string(d.DriftDetectionStatus),
+			DriftStatus:     string(d.DriftStatus),
+			Total:           aws.ToInt32(d.TotalStackInstancesCount),
+			InSync:          aws.ToInt32(d.InSyncStackInstancesCount),
+			Drifted:         aws.ToInt32(d.DriftedStackInstancesCount),
+			Failed:          aws.ToInt32(d.FailedStackInstancesCount),
+			InProgress:      aws.ToInt32(d.InProgressStackInstancesCount),
+		}
+		if d.LastDriftCheckTimestamp != nil {
+			result.Drift.LastCheck = *d.LastDriftCheckTimestamp
+			result.LastDriftCheck = *d.LastDriftCheckTimestamp
+		}
+	}
+
+	return result, nil
+}
+
+// ListStackInstances lists the instances of a stack set across all accounts and regions
+func (c *StackSetsClient) ListStackInstances(ctx context.Context, stackSetName, callAs string) ([]StackInstance, error) {
+	var instances []StackInstance
+	var nextToken *string
+
+	for {
+		output, err := c.client.ListStackInstances(ctx, &cloudformation.ListStackInstancesInput{
+			StackSetName: aws.String(stackSetName),
+			CallAs:       types.CallAs(callAs),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list stack instances of %s: %w", stackSetName, err)
+		}
+
+		for _, summary := range output.Summaries {
+			instances = append(instances, convertStackInstanceSummary(summary))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return instances, nil
+}
+
+// DescribeStackInstance gets the stack instance of a stack set in one account and region
+func (c *StackSetsClient) DescribeStackInstance(ctx context.Context, stackSetName, account, region, callAs string) (*StackInstance, error) {
+	output, err := c.client.DescribeStackInstance(ctx, &cloudformation.DescribeStackInstanceInput{
+		StackSetName:         aws.String(stackSetName),
+		StackInstanceAccount: aws.String(account),
+		StackInstanceRegion:  aws.String(region),
+		CallAs:               types.CallAs(callAs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe stack instance %s/%s: %w", account, region, err)
+	}
+
+	if output.StackInstance == nil {
+		return nil, fmt.Errorf("stack instance %s/%s not found", account, region)
+	}
+
+	si := output.StackInstance
+	result := &StackInstance{
+		Account:              aws.ToString(si.Account),
+		Region:               aws.ToString(si.Region),
+		Status:               string(si.Status),
+		StatusReason:         aws.ToString(si.StatusReason),
+		DriftStatus:          string(si.DriftStatus),
+		StackID:              aws.ToString(si.StackId),
+		OrganizationalUnitID: aws.ToString(si.OrganizationalUnitId),
+		LastOperationID:      aws.ToString(si.LastOperationId),
+		Parameters:           make(map[string]string),
+	}
+
+	if si.StackInstanceStatus != nil {
+		result.DetailedStatus = string(si.StackInstanceStatus.DetailedStatus)
+	}
+
+	if si.LastDriftCheckTimestamp != nil {
+		result.LastDriftCheck = *si.LastDriftCheckTimestamp
+	}
+
+	for _, param := range si.ParameterOverrides {
+		result.Parameters[aws.ToString(param.ParameterKey)] = aws.ToString(param.ParameterValue)
+	}
+
+	return result, nil
+}
+
+// ListStackSetOperations lists the most recent operations on a stack set, newest first
+func (c *StackSetsClient) ListStackSetOperations(ctx context.Context, stackSetName, callAs string, limit int32) ([]StackSetOperation, error) {
+	output, err := c.client.ListStackSetOperations(ctx, &cloudformation.ListStackSetOperationsInput{
+		StackSetName: aws.String(stackSetName),
+		CallAs:       types.CallAs(callAs),
+		MaxResults:   aws.Int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operations of %s: %w", stackSetName, err)
+	}
+
+	operations := make([]StackSetOperation, 0, len(output.Summaries))
+	for _, op := range output.Summaries {
+		operation := StackSetOperation{
+			OperationID:  aws.ToString(op.OperationId),
+			Action:       string(op.Action),
+			Status:       string(op.Status),
+			StatusReason: aws.ToString(op.StatusReason),
+		}
+		if op.CreationTimestamp != nil {
+			operation.CreatedTime = *op.CreationTimestamp
+		}
+		if op.EndTimestamp != nil {
+			operation.EndTime = *op.EndTimestamp
+		}
+		operations = append(operations, operation)
+	}
+
+	return operations, nil
+}
+
+func convertStackSetSummary(summary types.StackSetSummary, callAs string) StackSetSummary {
+	result := StackSetSummary{
+		StackSetID:      aws.ToString(summary.StackSetId),
+		StackSetName:    aws.ToString(summary.StackSetName),
+		Description:     aws.ToString(summary.Description),
+		Status:          string(summary.Status),
+		PermissionModel: string(summary.PermissionModel),
+		DriftStatus:     string(summary.DriftStatus),
+		CallAs:          callAs,
+	}
+
+	if summary.LastDriftCheckTimestamp != nil {
+		result.LastDriftCheck = *summary.LastDriftCheckTimestamp
+	}
+
+	if summary.AutoDeployment != nil {
+		result.AutoDeployment = aws.ToBool(summary.AutoDeployment.Enabled)
+	}
+
+	return result
+}
+
+func convertStackInstanceSummary(summary types.StackInstanceSummary) StackInstance {
+	result := StackInstance{
+		Account:              aws.ToString(summary.Account),
+		Region:               aws.ToString(summary.Region),
+		Status:               string(summary.Status),
+		StatusReason:         aws.ToString(summary.StatusReason),
+		DriftStatus:          string(summary.DriftStatus),
+		StackID:              aws.ToString(summary.StackId),
+		OrganizationalUnitID: aws.ToString(summary.OrganizationalUnitId),
+		LastOperationID:      aws.ToString(summary.LastOperationId),
+	}
+
+	if summary.StackInstanceStatus != nil {
+		result.DetailedStatus = string(summary.StackInstanceStatus.DetailedStatus)
+	}
+
+	if summary.LastDriftCheckTimestamp != nil {
+		result.LastDriftCheck = *summary.LastDriftCheckTimestamp
+	}
+
+	return result
+}
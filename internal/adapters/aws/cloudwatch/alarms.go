@@ -0,0 +1,90 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// AlarmsClient wraps the CloudWatch client for alarms
+type AlarmsClient struct {
+	client *cloudwatch.Client
+}
+
+// NewAlarmsClient creates a new CloudWatch alarms client
+func NewAlarmsClient(client *cloudwatch.Client) *AlarmsClient {
+	return &AlarmsClient{client: client}
+}
+
+// Alarm represents a CloudWatch metric or composite alarm
+type Alarm struct {
+	Name        string
+	State       string // OK, ALARM or INSUFFICIENT_DATA
+	Reason      string
+	Metric      string // Namespace/MetricName, empty for composite alarms
+	UpdatedTime time.Time
+}
+
+// ListAlarms lists the metric and composite alarms in a state, all alarms if state is
+// empty, most recently changed first
+func (c *AlarmsClient) ListAlarms(ctx context.Context, state string) ([]Alarm, error) {
+	var alarms []Alarm
+	var nextToken *string
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm, types.AlarmTypeCompositeAlarm},
+	}
+	if state != "" {
+		input.StateValue = types.StateValue(state)
+	}
+
+	for {
+		input.NextToken = nextToken
+		output, err := c.client.DescribeAlarms(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alarms: %w", err)
+		}
+
+		for _, a := range output.MetricAlarms {
+			alarm := Alarm{
+				Name:   aws.ToString(a.AlarmName),
+				State:  string(a.StateValue),
+				Reason: aws.ToString(a.StateReason),
+			}
+			if a.MetricName != nil {
+				alarm.Metric = aws.ToString(a.Namespace) + "/" + aws.ToString(a.MetricName)
+			}
+			if a.StateUpdatedTimestamp != nil {
+				alarm.UpdatedTime = *a.StateUpdatedTimestamp
+			}
+			alarms = append(alarms, alarm)
+		}
+		for _, a := range output.CompositeAlarms {
+			alarm := Alarm{
+				Name:   aws.ToString(a.AlarmName),
+				State:  string(a.StateValue),
+				Reason: aws.ToString(a.StateReason),
+			}
+			if a.StateUpdatedTimestamp != nil {
+				alarm.UpdatedTime = *a.StateUpdatedTimestamp
+			}
+			alarms = append(alarms, alarm)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	sort.Slice(alarms, func(i, j int) bool {
+		return alarms[i].UpdatedTime.After(alarms[j].UpdatedTime)
+	})
+
+	return alarms, nil
+}
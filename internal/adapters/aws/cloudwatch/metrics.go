@@ -0,0 +1,119 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// MetricsClient wraps the CloudWatch client for metric data
+type MetricsClient struct {
+	client *cloudwatch.Client
+}
+
+// NewMetricsClient creates a new CloudWatch metrics client
+func NewMetricsClient(client *cloudwatch.Client) *MetricsClient {
+	return &MetricsClient{client: client}
+}
+
+// MetricQuery identifies a metric statistic over a time range
+type MetricQuery struct {
+	Namespace  string
+	MetricName string
+	Dimensions map[string]string
+	Stat       string // e.g. Average, Sum, Maximum or p99
+	Period     time.Duration
+	Start      time.Time
+	End        time.Time
+}
+
+// Datapoint is one value of a metric series
+type Datapoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// GetSeries gets the datapoints of a metric statistic, oldest first. Periods without
+// data are left out.
+func (c *MetricsClient) GetSeries(ctx context.Context, q MetricQuery) ([]Datapoint, error) {
+	series, err := c.GetSeriesBatch(ctx, []MetricQuery{q})
+	if err != nil {
+		return nil, err
+	}
+	return series[0], nil
+}
+
+// GetSeriesBatch gets the datapoints of several metric statistics in one call, in the
+// order of the queries. The queries share the time range of the first.
+func (c *MetricsClient) GetSeriesBatch(ctx context.Context, queries []MetricQuery) ([][]Datapoint, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(queries[0].Start),
+		EndTime:           aws.Time(queries[0].End),
+		ScanBy:            types.ScanByTimestampAscending,
+		MetricDataQueries: make([]types.MetricDataQuery, 0, len(queries)),
+	}
+	for i, q := range queries {
+		dimensions := make([]types.Dimension, 0, len(q.Dimensions))
+		for name, value := range q.Dimensions {
+			dimensions = append(dimensions, types.Dimension{Name: aws.String(name), Value: aws.String(value)})
+		}
+		input.MetricDataQueries = append(input.MetricDataQueries, types.MetricDataQuery{
+			Id: aws.String(fmt.Sprintf("m%d", i)),
+			MetricStat: &types.MetricStat{
+				Metric: &types.Metric{
+					Namespace:  aws.String(q.Namespace),
+					MetricName: aws.String(q.MetricName),
+					Dimensions: dimensions,
+				},
+				Period: aws.Int32(int32(q.Period.Seconds())),
+				Stat:   aws.String(q.Stat),
+			},
+		})
+	}
+
+	series := make([][]Datapoint, len(queries))
+	for {
+		output, err := c.client.GetMetricData(ctx, input)
+		if err != nil {
+			if len(queries) == 1 {
+				return nil, fmt.Errorf("failed to get %s/%s: %w", queries[0].Namespace, queries[0].MetricName, err)
+			}
+			return nil, fmt.Errorf("failed to get %d metrics: %w", len(queries), err)
+		}
+
+		for _, result := range output.MetricDataResults {
+			var i int
+			if _, err := fmt.Sscanf(aws.ToString(result.Id), "m%d", &i); err != nil || i >= len(series) {
+				continue
+			}
+			for j, ts := range result.Timestamps {
+				if j < len(result.Values) {
+					series[i] = append(series[i], Datapoint{Timestamp: ts, Value: result.Values[j]})
+				}
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	// Pages are ordered by timestamp, but not across them
+	for _, points := range series {
+		sort.Slice(points, func(i, j int) bool {
+			return points[i].Timestamp.Before(points[j].Timestamp)
+		})
+	}
+
+	return series, nil
+}
@@ -0,0 +1,60 @@
+package codepipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+)
+
+// PipelinesClient wraps the CodePipeline client
+type PipelinesClient struct {
+	client *codepipeline.Client
+}
+
+// NewPipelinesClient creates a new CodePipeline client
+func NewPipelinesClient(client *codepipeline.Client) *PipelinesClient {
+	return &PipelinesClient{client: client}
+}
+
+// Stage is the state of a pipeline stage in its latest execution
+type Stage struct {
+	Name        string
+	Status      string // e.g. InProgress, Succeeded or Failed, empty if it never ran
+	ExecutionID string
+	UpdatedTime time.Time // Latest change of any of the stage's actions
+	Revision    string    // Source revision summary, for source stages
+}
+
+// GetPipelineState gets the state of each stage of a pipeline, in pipeline order
+func (c *PipelinesClient) GetPipelineState(ctx context.Context, name string) ([]Stage, error) {
+	output, err := c.client.GetPipelineState(ctx, &codepipeline.GetPipelineStateInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get state of pipeline %s: %w", name, err)
+	}
+
+	stages := make([]Stage, 0, len(output.StageStates))
+	for _, s := range output.StageStates {
+		stage := Stage{Name: aws.ToString(s.StageName)}
+		if e := s.LatestExecution; e != nil {
+			stage.Status = string(e.Status)
+			stage.ExecutionID = aws.ToString(e.PipelineExecutionId)
+		}
+		for _, action := range s.ActionStates {
+			if action.LatestExecution != nil && action.LatestExecution.LastStatusChange != nil &&
+				action.LatestExecution.LastStatusChange.After(stage.UpdatedTime) {
+				stage.UpdatedTime = *action.LatestExecution.LastStatusChange
+			}
+			if action.CurrentRevision != nil && stage.Revision == "" {
+				stage.Revision = aws.ToString(action.CurrentRevision.RevisionId)
+			}
+		}
+		stages = append(stages, stage)
+	}
+
+	return stages, nil
+}
@@ -0,0 +1,148 @@
+package configservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+)
+
+// ErrNotRecorded is returned when AWS Config has no record of a resource, because the
+// recorder is off or doesn't record its type
+var ErrNotRecorded = errors.New("AWS Config hasn't recorded this resource")
+
+var errNoRecorder = errors.New("AWS Config isn't recording in this region")
+
+// HistoryClient wraps the AWS Config client for configuration history
+type HistoryClient struct {
+	client *configservice.Client
+}
+
+// NewHistoryClient creates a new configuration history client
+func NewHistoryClient(client *configservice.Client) *HistoryClient {
+	return &HistoryClient{client: client}
+}
+
+// RecordedResource is a resource as AWS Config knows it
+type RecordedResource struct {
+	Type    string // e.g. AWS::EC2::Instance
+	ID      string // The ID Config records it under, which isn't always the console's
+	Name    string
+	Deleted *time.Time
+}
+
+// ConfigurationItem is a snapshot of a resource's configuration
+type ConfigurationItem struct {
+	CaptureTime   time.Time
+	Status        string // OK, ResourceDiscovered, ResourceDeleted...
+	StateID       string
+	Configuration string // JSON, empty for deleted resources
+	Supplementary map[string]string
+	Tags          map[string]string
+	RelatedEvents []string // CloudTrail event IDs of the change
+}
+
+// FindResource looks up the resource Config records under one of the types, first by the
+// given IDs, then by name. IAM users and roles, RDS instances and a few others are
+// recorded under an internal ID, so the name is what usually matches them.
+func (c *HistoryClient) FindResource(ctx context.Context, resourceTypes, ids []string, name string) (*RecordedResource, error) {
+	// An ID in a form Config doesn't expect can fail validation, the name may still match
+	var lookupErr error
+	for _, resourceType := range resourceTypes {
+		input := &configservice.ListDiscoveredResourcesInput{
+			ResourceType:            types.ResourceType(resourceType),
+			IncludeDeletedResources: true,
+		}
+		if len(ids) > 0 {
+			input.ResourceIds = ids
+			found, err := c.findResource(ctx, input)
+			if found != nil {
+				return found, nil
+			}
+			if errors.Is(err, errNoRecorder) {
+				return nil, err
+			}
+			lookupErr = err
+			input.ResourceIds = nil
+		}
+		if name != "" {
+			input.ResourceName = aws.String(name)
+			found, err := c.findResource(ctx, input)
+			if err != nil || found != nil {
+				return found, err
+			}
+		}
+	}
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+	return nil, ErrNotRecorded
+}
+
+func (c *HistoryClient) findResource(ctx context.Context, input *configservice.ListDiscoveredResourcesInput) (*RecordedResource, error) {
+	output, err := c.client.ListDiscoveredResources(ctx, input)
+	if err != nil {
+		var noRecorder *types.NoAvailableConfigurationRecorderException
+		if errors.As(err, &noRecorder) {
+			return nil, errNoRecorder
+		}
+		return nil, fmt.Errorf("failed to look up %s in AWS Config: %w", input.ResourceType, err)
+	}
+
+	// Prefer a resource that still exists over a deleted one of the same name
+	var found *RecordedResource
+	for _, identifier := range output.ResourceIdentifiers {
+		resource := &RecordedResource{
+			Type:    string(identifier.ResourceType),
+			ID:      aws.ToString(identifier.ResourceId),
+			Name:    aws.ToString(identifier.ResourceName),
+			Deleted: identifier.ResourceDeletionTime,
+		}
+		if resource.Deleted == nil {
+			return resource, nil
+		}
+		if found == nil {
+			found = resource
+		}
+	}
+	return found, nil
+}
+
+// History gets up to limit configuration items of a resource, newest first
+func (c *HistoryClient) History(ctx context.Context, resource *RecordedResource, limit int) ([]ConfigurationItem, error) {
+	var items []ConfigurationItem
+
+	paginator := configservice.NewGetResourceConfigHistoryPaginator(c.client, &configservice.GetResourceConfigHistoryInput{
+		ResourceType:       types.ResourceType(resource.Type),
+		ResourceId:         aws.String(resource.ID),
+		ChronologicalOrder: types.ChronologicalOrderReverse,
+		Limit:              int32(min(limit, 100)),
+	})
+	for paginator.HasMorePages() && len(items) < limit {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get configuration history: %w", err)
+		}
+
+		for _, item := range page.ConfigurationItems {
+			items = append(items, ConfigurationItem{
+				CaptureTime:   aws.ToTime(item.ConfigurationItemCaptureTime),
+				Status:        string(item.ConfigurationItemStatus),
+				StateID:       aws.ToString(item.ConfigurationStateId),
+				Configuration: aws.ToString(item.Configuration),
+				Supplementary: item.SupplementaryConfiguration,
+				Tags:          item.Tags,
+				RelatedEvents: item.RelatedEvents,
+			})
+		}
+	}
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
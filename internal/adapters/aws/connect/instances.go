@@ -0,0 +1,133 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+)
+
+// InstancesClient wraps the Amazon Connect client for instance operations
+type InstancesClient struct {
+	client *connect.Client
+}
+
+// NewInstancesClient creates a new Amazon Connect instances client
+func NewInstancesClient(client *connect.Client) *InstancesClient {
+	return &InstancesClient{client: client}
+}
+
+// Instance represents an Amazon Connect contact center instance
+type Instance struct {
+	ID                     string
+	ARN                    string
+	Alias                  string
+	Status                 string
+	StatusReason           string // Only set by DescribeInstance, why creation failed
+	IdentityManagementType string
+	InboundCallsEnabled    bool
+	OutboundCallsEnabled   bool
+	AccessURL              string
+	ServiceRole            string
+	CreatedTime            time.Time
+	Tags                   map[string]string
+}
+
+// ListInstances lists all instances
+func (c *InstancesClient) ListInstances(ctx context.Context) ([]Instance, error) {
+	var instances []Instance
+
+	paginator := connect.NewListInstancesPaginator(c.client, &connect.ListInstancesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Connect instances: %w", err)
+		}
+
+		for _, summary := range output.InstanceSummaryList {
+			instances = append(instances, convertInstanceSummary(summary))
+		}
+	}
+
+	return instances, nil
+}
+
+// DescribeInstance gets a single instance by ID
+func (c *InstancesClient) DescribeInstance(ctx context.Context, instanceID string) (*Instance, error) {
+	output, err := c.client.DescribeInstance(ctx, &connect.DescribeInstanceInput{
+		InstanceId: aws.String(instanceID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe Connect instance %s: %w", instanceID, err)
+	}
+	if output.Instance == nil {
+		return nil, fmt.Errorf("Connect instance %s not found", instanceID)
+	}
+
+	inst := output.Instance
+	result := &Instance{
+		ID:                     aws.ToString(inst.Id),
+		ARN:                    aws.ToString(inst.Arn),
+		Alias:                  aws.ToString(inst.InstanceAlias),
+		Status:                 string(inst.InstanceStatus),
+		IdentityManagementType: string(inst.IdentityManagementType),
+		InboundCallsEnabled:    aws.ToBool(inst.InboundCallsEnabled),
+		OutboundCallsEnabled:   aws.ToBool(inst.OutboundCallsEnabled),
+		AccessURL:              aws.ToString(inst.InstanceAccessUrl),
+		ServiceRole:            aws.ToString(inst.ServiceRole),
+		Tags:                   inst.Tags,
+	}
+	if inst.CreatedTime != nil {
+		result.CreatedTime = *inst.CreatedTime
+	}
+	if inst.StatusReason != nil {
+		result.StatusReason = aws.ToString(inst.StatusReason.Message)
+	}
+
+	return result, nil
+}
+
+// ListInstanceAttributes returns the features turned on or off for an instance, such as
+// CONTACT_LENS or AUTO_RESOLVE_BEST_VOICES, keyed by attribute type
+func (c *InstancesClient) ListInstanceAttributes(ctx context.Context, instanceID string) (map[string]string, error) {
+	attributes := make(map[string]string)
+
+	paginator := connect.NewListInstanceAttributesPaginator(c.client, &connect.ListInstanceAttributesInput{
+		InstanceId: aws.String(instanceID),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attributes of Connect instance %s: %w", instanceID, err)
+		}
+
+		for _, attr := range output.Attributes {
+			attributes[string(attr.AttributeType)] = aws.ToString(attr.Value)
+		}
+	}
+
+	return attributes, nil
+}
+
+func convertInstanceSummary(summary types.InstanceSummary) Instance {
+	result := Instance{
+		ID:                     aws.ToString(summary.Id),
+		ARN:                    aws.ToString(summary.Arn),
+		Alias:                  aws.ToString(summary.InstanceAlias),
+		Status:                 string(summary.InstanceStatus),
+		IdentityManagementType: string(summary.IdentityManagementType),
+		InboundCallsEnabled:    aws.ToBool(summary.InboundCallsEnabled),
+		OutboundCallsEnabled:   aws.ToBool(summary.OutboundCallsEnabled),
+		AccessURL:              aws.ToString(summary.InstanceAccessUrl),
+		ServiceRole:            aws.ToString(summary.ServiceRole),
+	}
+
+	if summary.CreatedTime != nil {
+		result.CreatedTime = *summary.CreatedTime
+	}
+
+	return result
+}
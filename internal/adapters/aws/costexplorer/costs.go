@@ -0,0 +1,143 @@
+package costexplorer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+)
+
+// CostsClient wraps the Cost Explorer client
+type CostsClient struct {
+	client *costexplorer.Client
+}
+
+// NewCostsClient creates a new Cost Explorer client
+func NewCostsClient(client *costexplorer.Client) *CostsClient {
+	return &CostsClient{client: client}
+}
+
+// CostEntry represents the spend for a single group (service or tag value)
+type CostEntry struct {
+	Key       string
+	Amount    float64
+	Unit      string
+	Estimated bool
+	Start     string
+	End       string
+}
+
+// MonthToDate returns the current month-to-date period as Cost Explorer dates
+func MonthToDate(now time.Time) (string, string) {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	// The end date is exclusive, so on the 1st include today
+	if !end.After(start) {
+		end = start.AddDate(0, 0, 1)
+	}
+	return start.Format("2006-01-02"), end.Format("2006-01-02")
+}
+
+// GetCostByService returns month-to-date unblended cost grouped by service
+func (c *CostsClient) GetCostByService(ctx context.Context) ([]CostEntry, error) {
+	return c.getCost(ctx, types.GroupDefinition{
+		Type: types.GroupDefinitionTypeDimension,
+		Key:  aws.String("SERVICE"),
+	})
+}
+
+// GetCostByTag returns month-to-date unblended cost grouped by the values of a tag key
+func (c *CostsClient) GetCostByTag(ctx context.Context, tagKey string) ([]CostEntry, error) {
+	entries, err := c.getCost(ctx, types.GroupDefinition{
+		Type: types.GroupDefinitionTypeTag,
+		Key:  aws.String(tagKey),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Tag group keys come back as "key$value"
+	for i := range entries {
+		value := strings.TrimPrefix(entries[i].Key, tagKey+"$")
+		if value == "" {
+			value = "(untagged)"
+		}
+		entries[i].Key = value
+	}
+
+	return entries, nil
+}
+
+func (c *CostsClient) getCost(ctx context.Context, groupBy types.GroupDefinition) ([]CostEntry, error) {
+	start, end := MonthToDate(time.Now().UTC())
+
+	totals := make(map[string]*CostEntry)
+	var nextToken *string
+
+	for {
+		output, err := c.client.GetCostAndUsage(ctx, &costexplorer.GetCostAndUsageInput{
+			TimePeriod: &types.DateInterval{
+				Start: aws.String(start),
+				End:   aws.String(end),
+			},
+			Granularity:   types.GranularityMonthly,
+			Metrics:       []string{"UnblendedCost"},
+			GroupBy:       []types.GroupDefinition{groupBy},
+			NextPageToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cost and usage: %w", err)
+		}
+
+		for _, result := range output.ResultsByTime {
+			for _, group := range result.Groups {
+				if len(group.Keys) == 0 {
+					continue
+				}
+				key := group.Keys[0]
+				metric, ok := group.Metrics["UnblendedCost"]
+				if !ok {
+					continue
+				}
+
+				amount, _ := strconv.ParseFloat(aws.ToString(metric.Amount), 64)
+
+				entry, ok := totals[key]
+				if !ok {
+					entry = &CostEntry{
+						Key:   key,
+						Unit:  aws.ToString(metric.Unit),
+						Start: start,
+						End:   end,
+					}
+					totals[key] = entry
+				}
+				entry.Amount += amount
+				entry.Estimated = entry.Estimated || result.Estimated
+			}
+		}
+
+		if output.NextPageToken == nil {
+			break
+		}
+		nextToken = output.NextPageToken
+	}
+
+	entries := make([]CostEntry, 0, len(totals))
+	for _, entry := range totals {
+		entries = append(entries, *entry)
+	}
+
+	// Highest spend first
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Amount > entries[j].Amount
+	})
+
+	return entries, nil
+}
@@ -157,16 +157,7 @@ func ItemToMap(item Item) map[string]interface{} {
 	return result
 }
 
-// KeyToMap converts a DynamoDB key to a plain map for serialization
-func KeyToMap(key map[string]types.AttributeValue) (map[string]interface{}, error) {
-	result := make(map[string]interface{})
-	for k, v := range key {
-		result[k] = AttributeValueToInterface(v)
-	}
-	return result, nil
-}
-
-// MapToKey converts a plain map back to a DynamoDB key
+// MapToKey converts a plain map to a DynamoDB key, guessing the types of its values
 func MapToKey(keyMap map[string]interface{}) (map[string]types.AttributeValue, error) {
 	key := make(map[string]types.AttributeValue)
 	for k, v := range keyMap {
@@ -0,0 +1,315 @@
+package dynamodb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// AttributeTypes are the DynamoDB attribute types, in the order the item editor cycles them
+var AttributeTypes = []string{"S", "N", "B", "BOOL", "NULL", "SS", "NS", "BS", "M", "L"}
+
+// MarshalItemJSON writes an item as DynamoDB JSON, the format of the AWS CLI, in which
+// every value names its type, such as {"id": {"S": "a"}, "count": {"N": "3"}}. Attribute
+// names are sorted.
+func MarshalItemJSON(item map[string]types.AttributeValue, indent bool) ([]byte, error) {
+	value := make(map[string]interface{}, len(item))
+	for name, av := range item {
+		v, err := attributeJSON(av)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", name, err)
+		}
+		value[name] = v
+	}
+	if indent {
+		return json.MarshalIndent(value, "", "  ")
+	}
+	return json.Marshal(value)
+}
+
+// UnmarshalItemJSON reads an item written as DynamoDB JSON, checking every value is of
+// one known type and well formed for it
+func UnmarshalItemJSON(data []byte) (map[string]types.AttributeValue, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("an item must be a JSON object of attributes: %w", err)
+	}
+	item := make(map[string]types.AttributeValue, len(raw))
+	for name, value := range raw {
+		if name == "" {
+			return nil, fmt.Errorf("attribute names can't be empty")
+		}
+		av, err := parseAttributeJSON(name, value)
+		if err != nil {
+			return nil, err
+		}
+		item[name] = av
+	}
+	return item, nil
+}
+
+// AttributeText is an attribute's type and its value as edited in a single line: strings,
+// numbers and booleans as they are, binary as base64, sets as JSON arrays of strings, and
+// maps and lists as DynamoDB JSON of their contents
+func AttributeText(av types.AttributeValue) (string, string) {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberS:
+		return "S", v.Value
+	case *types.AttributeValueMemberN:
+		return "N", v.Value
+	case *types.AttributeValueMemberB:
+		return "B", base64.StdEncoding.EncodeToString(v.Value)
+	case *types.AttributeValueMemberBOOL:
+		return "BOOL", fmt.Sprintf("%t", v.Value)
+	case *types.AttributeValueMemberNULL:
+		return "NULL", ""
+	}
+
+	typ, value := "", interface{}(nil)
+	if encoded, err := attributeJSON(av); err == nil {
+		for t, v := range encoded {
+			typ, value = t, v
+		}
+	}
+	text, _ := json.Marshal(value)
+	return typ, string(text)
+}
+
+// ParseAttribute reads an attribute of a type from its text, as AttributeText writes it
+func ParseAttribute(name, typ, text string) (types.AttributeValue, error) {
+	var value []byte
+	switch typ {
+	case "S", "N", "B":
+		value, _ = json.Marshal(text)
+	case "BOOL":
+		text = strings.TrimSpace(text)
+		if text != "true" && text != "false" {
+			return nil, fmt.Errorf("%s: a BOOL is true or false", name)
+		}
+		value = []byte(text)
+	case "NULL":
+		value = []byte("true")
+	default:
+		value = []byte(text)
+	}
+
+	raw, _ := json.Marshal(map[string]json.RawMessage{typ: value})
+	return parseAttributeJSON(name, raw)
+}
+
+// attributeJSON converts an attribute value to its DynamoDB JSON, {"TYPE": value}
+func attributeJSON(av types.AttributeValue) (map[string]interface{}, error) {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberS:
+		return map[string]interface{}{"S": v.Value}, nil
+	case *types.AttributeValueMemberN:
+		return map[string]interface{}{"N": v.Value}, nil
+	case *types.AttributeValueMemberB:
+		return map[string]interface{}{"B": base64.StdEncoding.EncodeToString(v.Value)}, nil
+	case *types.AttributeValueMemberBOOL:
+		return map[string]interface{}{"BOOL": v.Value}, nil
+	case *types.AttributeValueMemberNULL:
+		return map[string]interface{}{"NULL": true}, nil
+	case *types.AttributeValueMemberSS:
+		return map[string]interface{}{"SS": v.Value}, nil
+	case *types.AttributeValueMemberNS:
+		return map[string]interface{}{"NS": v.Value}, nil
+	case *types.AttributeValueMemberBS:
+		encoded := make([]string, len(v.Value))
+		for i, b := range v.Value {
+			encoded[i] = base64.StdEncoding.EncodeToString(b)
+		}
+		return map[string]interface{}{"BS": encoded}, nil
+	case *types.AttributeValueMemberM:
+		m := make(map[string]interface{}, len(v.Value))
+		for name, member := range v.Value {
+			encoded, err := attributeJSON(member)
+			if err != nil {
+				return nil, err
+			}
+			m[name] = encoded
+		}
+		return map[string]interface{}{"M": m}, nil
+	case *types.AttributeValueMemberL:
+		l := make([]interface{}, len(v.Value))
+		for i, member := range v.Value {
+			encoded, err := attributeJSON(member)
+			if err != nil {
+				return nil, err
+			}
+			l[i] = encoded
+		}
+		return map[string]interface{}{"L": l}, nil
+	}
+	return nil, fmt.Errorf("unsupported attribute value %T", av)
+}
+
+// parseAttributeJSON reads {"TYPE": value}, naming the attribute by its path in errors
+func parseAttributeJSON(path string, raw json.RawMessage) (types.AttributeValue, error) {
+	var typed map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &typed); err != nil || len(typed) != 1 {
+		return nil, fmt.Errorf("%s: a value must be an object with one type, such as {\"S\": \"text\"}", path)
+	}
+	var typ string
+	var value json.RawMessage
+	for typ, value = range typed {
+	}
+
+	switch typ {
+	case "S":
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			return nil, fmt.Errorf("%s: an S value is a JSON string", path)
+		}
+		return &types.AttributeValueMemberS{Value: s}, nil
+
+	case "N":
+		n, err := parseNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return &types.AttributeValueMemberN{Value: n}, nil
+
+	case "B":
+		b, err := parseBinary(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return &types.AttributeValueMemberB{Value: b}, nil
+
+	case "BOOL":
+		var b bool
+		if err := json.Unmarshal(value, &b); err != nil {
+			return nil, fmt.Errorf("%s: a BOOL value is true or false", path)
+		}
+		return &types.AttributeValueMemberBOOL{Value: b}, nil
+
+	case "NULL":
+		var b bool
+		if err := json.Unmarshal(value, &b); err != nil || !b {
+			return nil, fmt.Errorf("%s: a NULL value is written {\"NULL\": true}", path)
+		}
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+
+	case "SS", "NS", "BS":
+		var members []json.RawMessage
+		if err := json.Unmarshal(value, &members); err != nil {
+			return nil, fmt.Errorf("%s: a %s value is a JSON array", path, typ)
+		}
+		if len(members) == 0 {
+			return nil, fmt.Errorf("%s: sets can't be empty, remove the attribute instead", path)
+		}
+		var strs []string
+		var bins [][]byte
+		for i, member := range members {
+			var s string
+			var err error
+			switch typ {
+			case "SS":
+				if json.Unmarshal(member, &s) != nil {
+					err = fmt.Errorf("an SS value holds strings")
+				}
+			case "NS":
+				s, err = parseNumber(member)
+			case "BS":
+				var b []byte
+				if b, err = parseBinary(member); err == nil {
+					s = string(b)
+					bins = append(bins, b)
+				}
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", path, i, err)
+			}
+			if slices.Contains(strs, s) {
+				return nil, fmt.Errorf("%s[%d]: sets can't hold duplicates", path, i)
+			}
+			strs = append(strs, s)
+		}
+		switch typ {
+		case "SS":
+			return &types.AttributeValueMemberSS{Value: strs}, nil
+		case "NS":
+			return &types.AttributeValueMemberNS{Value: strs}, nil
+		}
+		return &types.AttributeValueMemberBS{Value: bins}, nil
+
+	case "M":
+		var members map[string]json.RawMessage
+		if err := json.Unmarshal(value, &members); err != nil {
+			return nil, fmt.Errorf("%s: an M value is a JSON object of typed values", path)
+		}
+		names := make([]string, 0, len(members))
+		for name := range members {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		m := make(map[string]types.AttributeValue, len(members))
+		for _, name := range names {
+			av, err := parseAttributeJSON(path+"."+name, members[name])
+			if err != nil {
+				return nil, err
+			}
+			m[name] = av
+		}
+		return &types.AttributeValueMemberM{Value: m}, nil
+
+	case "L":
+		var members []json.RawMessage
+		if err := json.Unmarshal(value, &members); err != nil {
+			return nil, fmt.Errorf("%s: an L value is a JSON array of typed values", path)
+		}
+		l := make([]types.AttributeValue, 0, len(members))
+		for i, member := range members {
+			av, err := parseAttributeJSON(fmt.Sprintf("%s[%d]", path, i), member)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, av)
+		}
+		return &types.AttributeValueMemberL{Value: l}, nil
+	}
+
+	return nil, fmt.Errorf("%s: unknown type %q, use one of %s", path, typ, strings.Join(AttributeTypes, ", "))
+}
+
+// parseNumber reads a number, written as a string as DynamoDB JSON does, or as a bare
+// JSON number
+func parseNumber(value json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		var n json.Number
+		dec := json.NewDecoder(bytes.NewReader(value))
+		dec.UseNumber()
+		if dec.Decode(&n) != nil {
+			return "", fmt.Errorf("an N value is a number written as a string")
+		}
+		s = n.String()
+	}
+	s = strings.TrimSpace(s)
+	// big.Float also reads hexadecimal, underscores and Inf, which DynamoDB doesn't
+	if _, ok := new(big.Float).SetString(s); !ok || strings.Trim(s, "0123456789+-.eE") != "" {
+		return "", fmt.Errorf("%q is not a number", s)
+	}
+	return s, nil
+}
+
+// parseBinary reads binary data written as a base64 string
+func parseBinary(value json.RawMessage) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return nil, fmt.Errorf("binary values are base64 strings")
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("binary values are base64 strings: %w", err)
+	}
+	return b, nil
+}
@@ -26,6 +26,8 @@ type Table struct {
 	ItemCount            int64
 	TableSizeBytes       int64
 	BillingModeSummary   string
+	ProvisionedThroughput *ProvisionedThroughput
+	LastSwitchToOnDemand  time.Time
 	KeySchema            []KeySchemaElement
 	AttributeDefinitions []AttributeDefinition
 	GlobalSecondaryIndexes []GlobalSecondaryIndex
@@ -60,8 +62,33 @@ type LocalSecondaryIndex struct {
 }
 
 type ProvisionedThroughput struct {
-	ReadCapacityUnits  int64
-	WriteCapacityUnits int64
+	ReadCapacityUnits      int64
+	WriteCapacityUnits     int64
+	NumberOfDecreasesToday int64
+	LastDecreaseDateTime   time.Time
+}
+
+// CapacityUpdate is a change to the billing mode or provisioned capacity of a table. For
+// provisioned tables, every index needs its capacity when switching from on-demand, and
+// only the changed ones otherwise.
+type CapacityUpdate struct {
+	TableName   string
+	BillingMode string // PAY_PER_REQUEST or PROVISIONED
+	Table       *ProvisionedThroughput
+	Indexes     map[string]ProvisionedThroughput
+}
+
+// convertThroughput converts provisioned throughput, nil for on-demand tables and indexes
+func convertThroughput(pt *types.ProvisionedThroughputDescription) *ProvisionedThroughput {
+	if pt == nil {
+		return nil
+	}
+	return &ProvisionedThroughput{
+		ReadCapacityUnits:      aws.ToInt64(pt.ReadCapacityUnits),
+		WriteCapacityUnits:     aws.ToInt64(pt.WriteCapacityUnits),
+		NumberOfDecreasesToday: aws.ToInt64(pt.NumberOfDecreasesToday),
+		LastDecreaseDateTime:   aws.ToTime(pt.LastDecreaseDateTime),
+	}
 }
 
 func (c *TablesClient) ListTables(ctx context.Context) ([]Table, error) {
@@ -107,9 +134,11 @@ func (c *TablesClient) GetTable(ctx context.Context, tableName string) (*Table,
 
 	if tableDesc.BillingModeSummary != nil {
 		table.BillingModeSummary = string(tableDesc.BillingModeSummary.BillingMode)
+		table.LastSwitchToOnDemand = aws.ToTime(tableDesc.BillingModeSummary.LastUpdateToPayPerRequestDateTime)
 	} else {
 		table.BillingModeSummary = "PROVISIONED"
 	}
+	table.ProvisionedThroughput = convertThroughput(tableDesc.ProvisionedThroughput)
 
 	for _, ks := range tableDesc.KeySchema {
 		table.KeySchema = append(table.KeySchema, KeySchemaElement{
@@ -142,12 +171,7 @@ func (c *TablesClient) GetTable(ctx context.Context, tableName string) (*Table,
 			index.Projection = string(gsi.Projection.ProjectionType)
 		}
 
-		if gsi.ProvisionedThroughput != nil {
-			index.ProvisionedThroughput = &ProvisionedThroughput{
-				ReadCapacityUnits:  aws.ToInt64(gsi.ProvisionedThroughput.ReadCapacityUnits),
-				WriteCapacityUnits: aws.ToInt64(gsi.ProvisionedThroughput.WriteCapacityUnits),
-			}
-		}
+		index.ProvisionedThroughput = convertThroughput(gsi.ProvisionedThroughput)
 
 		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, index)
 	}
@@ -212,6 +236,19 @@ func (c *TablesClient) UpdateTableTags(ctx context.Context, tableArn string, tag
 	return nil
 }
 
+// RemoveTableTags removes tags from a table by key
+func (c *TablesClient) RemoveTableTags(ctx context.Context, tableArn string, keys []string) error {
+	_, err := c.client.UntagResource(ctx, &dynamodb.UntagResourceInput{
+		ResourceArn: aws.String(tableArn),
+		TagKeys:     keys,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove table tags: %w", err)
+	}
+
+	return nil
+}
+
 func (c *TablesClient) DeleteTable(ctx context.Context, tableName string) error {
 	_, err := c.client.DeleteTable(ctx, &dynamodb.DeleteTableInput{
 		TableName: aws.String(tableName),
@@ -222,3 +259,37 @@ func (c *TablesClient) DeleteTable(ctx context.Context, tableName string) error
 
 	return nil
 }
+
+// UpdateCapacity switches a table's billing mode or changes its provisioned capacity and
+// that of its global secondary indexes
+func (c *TablesClient) UpdateCapacity(ctx context.Context, update CapacityUpdate) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName:   aws.String(update.TableName),
+		BillingMode: types.BillingMode(update.BillingMode),
+	}
+
+	if update.Table != nil {
+		input.ProvisionedThroughput = &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(update.Table.ReadCapacityUnits),
+			WriteCapacityUnits: aws.Int64(update.Table.WriteCapacityUnits),
+		}
+	}
+
+	for name, throughput := range update.Indexes {
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, types.GlobalSecondaryIndexUpdate{
+			Update: &types.UpdateGlobalSecondaryIndexAction{
+				IndexName: aws.String(name),
+				ProvisionedThroughput: &types.ProvisionedThroughput{
+					ReadCapacityUnits:  aws.Int64(throughput.ReadCapacityUnits),
+					WriteCapacityUnits: aws.Int64(throughput.WriteCapacityUnits),
+				},
+			},
+		})
+	}
+
+	if _, err := c.client.UpdateTable(ctx, input); err != nil {
+		return fmt.Errorf("failed to update capacity of table %s: %w", update.TableName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// FlowLog represents a VPC flow log publishing to CloudWatch Logs
+type FlowLog struct {
+	FlowLogID    string
+	ResourceID   string // VPC, subnet or ENI the flow log captures
+	LogGroupName string
+	TrafficType  string
+	Status       string
+}
+
+// ListCloudWatchFlowLogs lists the flow logs of the given VPCs, subnets or ENIs that
+// publish to CloudWatch Logs. Flow logs to S3 or Firehose are left out.
+func (c *NetworkInterfacesClient) ListCloudWatchFlowLogs(ctx context.Context, resourceIDs []string) ([]FlowLog, error) {
+	var flowLogs []FlowLog
+	var nextToken *string
+
+	for {
+		output, err := c.client.DescribeFlowLogs(ctx, &ec2.DescribeFlowLogsInput{
+			Filter: []types.Filter{
+				{
+					Name:   aws.String("resource-id"),
+					Values: resourceIDs,
+				},
+				{
+					Name:   aws.String("log-destination-type"),
+					Values: []string{string(types.LogDestinationTypeCloudWatchLogs)},
+				},
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe flow logs: %w", err)
+		}
+
+		for _, fl := range output.FlowLogs {
+			flowLogs = append(flowLogs, FlowLog{
+				FlowLogID:    aws.ToString(fl.FlowLogId),
+				ResourceID:   aws.ToString(fl.ResourceId),
+				LogGroupName: aws.ToString(fl.LogGroupName),
+				TrafficType:  string(fl.TrafficType),
+				Status:       aws.ToString(fl.FlowLogStatus),
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return flowLogs, nil
+}
@@ -2,12 +2,17 @@ package ec2
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 )
 
 // InstancesClient wraps the EC2 client for instance operations
@@ -38,15 +43,59 @@ type Instance struct {
 	SecurityGroups   []string
 	IAMRole          string
 	Tags             map[string]string
+
+	// Instance metadata service options
+	MetadataEndpoint string // enabled or disabled
+	MetadataTokens   string // required for IMDSv2 only, optional if IMDSv1 is allowed
+	MetadataHopLimit int32
+}
+
+// AllowsIMDSv1 reports whether the instance metadata service answers requests without a token
+func (i Instance) AllowsIMDSv1() bool {
+	return i.MetadataEndpoint != "disabled" && i.MetadataTokens == "optional"
 }
 
 // ListInstances lists all EC2 instances
 func (c *InstancesClient) ListInstances(ctx context.Context) ([]Instance, error) {
+	return c.describeInstances(ctx, nil)
+}
+
+// instanceStates are the values of the instance-state-name filter
+var instanceStates = []string{"pending", "running", "shutting-down", "terminated", "stopping", "stopped"}
+
+// SearchInstances lists the instances matching a search, with the DescribeInstances
+// filter its form suggests: an instance ID prefix (i-...), a state, a private IP prefix,
+// or otherwise part of the Name tag. Filter values are case-sensitive, so the Name tag is
+// matched as typed, in lower case, capitalized and in upper case.
+func (c *InstancesClient) SearchInstances(ctx context.Context, query string) ([]Instance, error) {
+	var filter types.Filter
+	switch {
+	case strings.HasPrefix(query, "i-"):
+		filter = types.Filter{Name: aws.String("instance-id"), Values: []string{query + "*"}}
+	case slices.Contains(instanceStates, strings.ToLower(query)):
+		filter = types.Filter{Name: aws.String("instance-state-name"), Values: []string{strings.ToLower(query)}}
+	case strings.Trim(query, "0123456789.") == "":
+		filter = types.Filter{Name: aws.String("private-ip-address"), Values: []string{query + "*"}}
+	default:
+		values := []string{"*" + query + "*"}
+		for _, variant := range []string{strings.ToLower(query), strings.ToUpper(query[:1]) + query[1:], strings.ToUpper(query)} {
+			if value := "*" + variant + "*"; !slices.Contains(values, value) {
+				values = append(values, value)
+			}
+		}
+		filter = types.Filter{Name: aws.String("tag:Name"), Values: values}
+	}
+	return c.describeInstances(ctx, []types.Filter{filter})
+}
+
+// describeInstances lists the instances matching filters, every instance for none
+func (c *InstancesClient) describeInstances(ctx context.Context, filters []types.Filter) ([]Instance, error) {
 	var instances []Instance
 	var nextToken *string
 
 	for {
 		input := &ec2.DescribeInstancesInput{
+			Filters:   filters,
 			NextToken: nextToken,
 		}
 
@@ -107,6 +156,47 @@ func (c *InstancesClient) GetInstanceStatus(ctx context.Context, instanceID stri
 	return &output.InstanceStatuses[0], nil
 }
 
+// GetConsoleOutput gets the latest serial console output of an instance, decoded, and
+// when it was captured. Nitro instances return what is buffered now, others what was
+// captured at the last boot or shutdown.
+func (c *InstancesClient) GetConsoleOutput(ctx context.Context, instanceID string) (string, time.Time, error) {
+	output, err := c.client.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(instanceID),
+		Latest:     aws.Bool(true),
+	})
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() == "UnsupportedOperation" {
+		// Only Nitro instances return the latest output
+		output, err = c.client.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{InstanceId: aws.String(instanceID)})
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get console output of %s: %w", instanceID, err)
+	}
+
+	text, err := base64.StdEncoding.DecodeString(aws.ToString(output.Output))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode console output of %s: %w", instanceID, err)
+	}
+	return string(text), aws.ToTime(output.Timestamp), nil
+}
+
+// GetConsoleScreenshot gets a JPG screenshot of an instance's console
+func (c *InstancesClient) GetConsoleScreenshot(ctx context.Context, instanceID string) ([]byte, error) {
+	output, err := c.client.GetConsoleScreenshot(ctx, &ec2.GetConsoleScreenshotInput{
+		InstanceId: aws.String(instanceID),
+		WakeUp:     aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get console screenshot of %s: %w", instanceID, err)
+	}
+
+	image, err := base64.StdEncoding.DecodeString(aws.ToString(output.ImageData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode console screenshot of %s: %w", instanceID, err)
+	}
+	return image, nil
+}
+
 func convertInstance(inst types.Instance) Instance {
 	result := Instance{
 		InstanceID:   aws.ToString(inst.InstanceId),
@@ -139,6 +229,12 @@ func convertInstance(inst types.Instance) Instance {
 		result.IAMRole = aws.ToString(inst.IamInstanceProfile.Arn)
 	}
 
+	if inst.MetadataOptions != nil {
+		result.MetadataEndpoint = string(inst.MetadataOptions.HttpEndpoint)
+		result.MetadataTokens = string(inst.MetadataOptions.HttpTokens)
+		result.MetadataHopLimit = aws.ToInt32(inst.MetadataOptions.HttpPutResponseHopLimit)
+	}
+
 	// Extract security groups
 	for _, sg := range inst.SecurityGroups {
 		sgName := aws.ToString(sg.GroupName)
@@ -193,6 +289,55 @@ func (c *InstancesClient) RebootInstance(ctx context.Context, instanceID string)
 	return nil
 }
 
+// TerminateInstance terminates an EC2 instance
+func (c *InstancesClient) TerminateInstance(ctx context.Context, instanceID string) error {
+	_, err := c.client.TerminateInstances(ctx, &ec2.TerminateInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to terminate instance: %w", err)
+	}
+	return nil
+}
+
+// RequireIMDSv2 makes the instance metadata service of an instance require session tokens,
+// turning off IMDSv1, with the given response hop limit. With dryRun only the permission to
+// do so is checked.
+func (c *InstancesClient) RequireIMDSv2(ctx context.Context, instanceID string, hopLimit int32, dryRun bool) error {
+	_, err := c.client.ModifyInstanceMetadataOptions(ctx, &ec2.ModifyInstanceMetadataOptionsInput{
+		InstanceId:              aws.String(instanceID),
+		HttpTokens:              types.HttpTokensStateRequired,
+		HttpEndpoint:            types.InstanceMetadataEndpointStateEnabled,
+		HttpPutResponseHopLimit: aws.Int32(hopLimit),
+		DryRun:                  aws.Bool(dryRun),
+	})
+
+	// A dry run that would have succeeded fails with DryRunOperation
+	var apiErr smithy.APIError
+	if dryRun && errors.As(err, &apiErr) && apiErr.ErrorCode() == "DryRunOperation" {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to modify metadata options of %s: %w", instanceID, err)
+	}
+	return nil
+}
+
+// GetTerminationProtection reports whether termination protection is enabled on an instance
+func (c *InstancesClient) GetTerminationProtection(ctx context.Context, instanceID string) (bool, error) {
+	output, err := c.client.DescribeInstanceAttribute(ctx, &ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  types.InstanceAttributeNameDisableApiTermination,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get termination protection: %w", err)
+	}
+	if output.DisableApiTermination == nil {
+		return false, nil
+	}
+	return aws.ToBool(output.DisableApiTermination.Value), nil
+}
+
 // GetInstanceConnectionInfo retrieves connection information for an instance
 func (c *InstancesClient) GetInstanceConnectionInfo(ctx context.Context, instanceID string) (map[string]interface{}, error) {
 	input := &ec2.DescribeInstancesInput{
@@ -0,0 +1,324 @@
+package ec2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Tags EC2 sets on instances launched from a launch template
+const (
+	LaunchTemplateIDTag      = "aws:ec2launchtemplate:id"
+	LaunchTemplateVersionTag = "aws:ec2launchtemplate:version"
+)
+
+// LaunchTemplatesClient wraps the EC2 client for launch template operations
+type LaunchTemplatesClient struct {
+	client *ec2.Client
+}
+
+// NewLaunchTemplatesClient creates a new launch templates client
+func NewLaunchTemplatesClient(client *ec2.Client) *LaunchTemplatesClient {
+	return &LaunchTemplatesClient{client: client}
+}
+
+// LaunchTemplate represents an EC2 launch template
+type LaunchTemplate struct {
+	TemplateID     string
+	Name           string
+	DefaultVersion int64
+	LatestVersion  int64
+	CreatedBy      string
+	CreatedAt      time.Time
+	Tags           map[string]string
+}
+
+// LaunchTemplateVersion represents a version of a launch template with the settings it
+// launches instances with
+type LaunchTemplateVersion struct {
+	TemplateID   string
+	TemplateName string
+	Version      int64
+	Description  string
+	Default      bool
+	CreatedBy    string
+	CreatedAt    time.Time
+
+	ImageID            string
+	InstanceType       string
+	KeyName            string
+	IAMInstanceProfile string
+	SecurityGroups     []string // IDs and names
+	NetworkInterfaces  []string // Summaries such as "0: subnet-1 sg-1,sg-2 public IP"
+	BlockDevices       []string // Summaries such as "/dev/xvda: gp3 20 GiB encrypted"
+	MetadataTokens     string
+	MetadataHopLimit   int32
+	Monitoring         bool
+	EBSOptimized       bool
+	SpotInstances      bool
+	TagSpecifications  map[string]map[string]string // Tags by resource type
+	UserData           string                       // Decoded
+}
+
+// ListLaunchTemplates lists the launch templates of the region
+func (c *LaunchTemplatesClient) ListLaunchTemplates(ctx context.Context) ([]LaunchTemplate, error) {
+	var templates []LaunchTemplate
+	var nextToken *string
+
+	for {
+		output, err := c.client.DescribeLaunchTemplates(ctx, &ec2.DescribeLaunchTemplatesInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe launch templates: %w", err)
+		}
+
+		for _, lt := range output.LaunchTemplates {
+			templates = append(templates, convertLaunchTemplate(lt))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return templates, nil
+}
+
+// GetLaunchTemplate gets a launch template by ID
+func (c *LaunchTemplatesClient) GetLaunchTemplate(ctx context.Context, templateID string) (*LaunchTemplate, error) {
+	output, err := c.client.DescribeLaunchTemplates(ctx, &ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []string{templateID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe launch template %s: %w", templateID, err)
+	}
+	if len(output.LaunchTemplates) == 0 {
+		return nil, fmt.Errorf("launch template %s not found", templateID)
+	}
+
+	lt := convertLaunchTemplate(output.LaunchTemplates[0])
+	return &lt, nil
+}
+
+// ListVersions lists the versions of a launch template, newest first
+func (c *LaunchTemplatesClient) ListVersions(ctx context.Context, templateID string) ([]LaunchTemplateVersion, error) {
+	var versions []LaunchTemplateVersion
+	var nextToken *string
+
+	for {
+		output, err := c.client.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(templateID),
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe versions of launch template %s: %w", templateID, err)
+		}
+
+		for _, v := range output.LaunchTemplateVersions {
+			versions = append(versions, convertLaunchTemplateVersion(v))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version > versions[j].Version
+	})
+	return versions, nil
+}
+
+// GetVersion gets a version of a launch template. The version is a number, or $Latest
+// or $Default.
+func (c *LaunchTemplatesClient) GetVersion(ctx context.Context, templateID, version string) (*LaunchTemplateVersion, error) {
+	output, err := c.client.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(templateID),
+		Versions:         []string{version},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe version %s of launch template %s: %w", version, templateID, err)
+	}
+	if len(output.LaunchTemplateVersions) == 0 {
+		return nil, fmt.Errorf("version %s of launch template %s not found", version, templateID)
+	}
+
+	v := convertLaunchTemplateVersion(output.LaunchTemplateVersions[0])
+	return &v, nil
+}
+
+// GetUserData gets the decoded user data of an instance, empty when it has none
+func (c *InstancesClient) GetUserData(ctx context.Context, instanceID string) (string, error) {
+	output, err := c.client.DescribeInstanceAttribute(ctx, &ec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  types.InstanceAttributeNameUserData,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get user data of %s: %w", instanceID, err)
+	}
+	if output.UserData == nil {
+		return "", nil
+	}
+
+	userData, err := DecodeUserData(aws.ToString(output.UserData.Value))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode user data of %s: %w", instanceID, err)
+	}
+	return userData, nil
+}
+
+// DecodeUserData decodes base64 user data, decompressing it when it was gzipped as
+// cloud-init accepts
+func DecodeUserData(encoded string) (string, error) {
+	if encoded == "" {
+		return "", nil
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return "", err
+		}
+		defer reader.Close()
+		if data, err = io.ReadAll(reader); err != nil {
+			return "", err
+		}
+	}
+	return string(data), nil
+}
+
+func convertLaunchTemplate(lt types.LaunchTemplate) LaunchTemplate {
+	tags := make(map[string]string, len(lt.Tags))
+	for _, tag := range lt.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return LaunchTemplate{
+		TemplateID:     aws.ToString(lt.LaunchTemplateId),
+		Name:           aws.ToString(lt.LaunchTemplateName),
+		DefaultVersion: aws.ToInt64(lt.DefaultVersionNumber),
+		LatestVersion:  aws.ToInt64(lt.LatestVersionNumber),
+		CreatedBy:      aws.ToString(lt.CreatedBy),
+		CreatedAt:      aws.ToTime(lt.CreateTime),
+		Tags:           tags,
+	}
+}
+
+func convertLaunchTemplateVersion(v types.LaunchTemplateVersion) LaunchTemplateVersion {
+	version := LaunchTemplateVersion{
+		TemplateID:   aws.ToString(v.LaunchTemplateId),
+		TemplateName: aws.ToString(v.LaunchTemplateName),
+		Version:      aws.ToInt64(v.VersionNumber),
+		Description:  aws.ToString(v.VersionDescription),
+		Default:      aws.ToBool(v.DefaultVersion),
+		CreatedBy:    aws.ToString(v.CreatedBy),
+		CreatedAt:    aws.ToTime(v.CreateTime),
+	}
+
+	data := v.LaunchTemplateData
+	if data == nil {
+		return version
+	}
+
+	version.ImageID = aws.ToString(data.ImageId)
+	version.InstanceType = string(data.InstanceType)
+	version.KeyName = aws.ToString(data.KeyName)
+	version.EBSOptimized = aws.ToBool(data.EbsOptimized)
+	version.SecurityGroups = append(append([]string{}, data.SecurityGroupIds...), data.SecurityGroups...)
+
+	if profile := data.IamInstanceProfile; profile != nil {
+		version.IAMInstanceProfile = aws.ToString(profile.Arn)
+		if version.IAMInstanceProfile == "" {
+			version.IAMInstanceProfile = aws.ToString(profile.Name)
+		}
+	}
+	if data.Monitoring != nil {
+		version.Monitoring = aws.ToBool(data.Monitoring.Enabled)
+	}
+	if data.MetadataOptions != nil {
+		version.MetadataTokens = string(data.MetadataOptions.HttpTokens)
+		version.MetadataHopLimit = aws.ToInt32(data.MetadataOptions.HttpPutResponseHopLimit)
+	}
+	if data.InstanceMarketOptions != nil {
+		version.SpotInstances = data.InstanceMarketOptions.MarketType == types.MarketTypeSpot
+	}
+
+	for _, ni := range data.NetworkInterfaces {
+		summary := strconv.Itoa(int(aws.ToInt32(ni.DeviceIndex))) + ":"
+		if ni.SubnetId != nil {
+			summary += " " + aws.ToString(ni.SubnetId)
+		}
+		for i, group := range ni.Groups {
+			if i == 0 {
+				summary += " " + group
+			} else {
+				summary += "," + group
+			}
+		}
+		if aws.ToBool(ni.AssociatePublicIpAddress) {
+			summary += " public IP"
+		}
+		version.NetworkInterfaces = append(version.NetworkInterfaces, summary)
+	}
+
+	for _, bd := range data.BlockDeviceMappings {
+		summary := aws.ToString(bd.DeviceName) + ":"
+		switch {
+		case bd.Ebs != nil:
+			if bd.Ebs.VolumeType != "" {
+				summary += " " + string(bd.Ebs.VolumeType)
+			}
+			if bd.Ebs.VolumeSize != nil {
+				summary += fmt.Sprintf(" %d GiB", aws.ToInt32(bd.Ebs.VolumeSize))
+			}
+			if aws.ToBool(bd.Ebs.Encrypted) {
+				summary += " encrypted"
+			}
+			if bd.Ebs.SnapshotId != nil {
+				summary += " from " + aws.ToString(bd.Ebs.SnapshotId)
+			}
+		case bd.VirtualName != nil:
+			summary += " " + aws.ToString(bd.VirtualName)
+		case bd.NoDevice != nil:
+			summary += " suppressed"
+		}
+		version.BlockDevices = append(version.BlockDevices, summary)
+	}
+
+	if len(data.TagSpecifications) > 0 {
+		version.TagSpecifications = make(map[string]map[string]string, len(data.TagSpecifications))
+		for _, spec := range data.TagSpecifications {
+			tags := make(map[string]string, len(spec.Tags))
+			for _, tag := range spec.Tags {
+				tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			version.TagSpecifications[string(spec.ResourceType)] = tags
+		}
+	}
+
+	// User data that isn't valid base64 is shown as it was given
+	userData, err := DecodeUserData(aws.ToString(data.UserData))
+	if err != nil {
+		userData = aws.ToString(data.UserData)
+	}
+	version.UserData = userData
+
+	return version
+}
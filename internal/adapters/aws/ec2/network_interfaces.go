@@ -0,0 +1,310 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// NetworkInterfacesClient wraps the EC2 client for ENI and Elastic IP operations
+type NetworkInterfacesClient struct {
+	client *ec2.Client
+}
+
+// NewNetworkInterfacesClient creates a new network interfaces client
+func NewNetworkInterfacesClient(client *ec2.Client) *NetworkInterfacesClient {
+	return &NetworkInterfacesClient{client: client}
+}
+
+// NetworkInterface represents an elastic network interface
+type NetworkInterface struct {
+	NetworkInterfaceID string
+	Name               string
+	Description        string
+	InterfaceType      string
+	Status             string
+	VpcID              string
+	SubnetID           string
+	AvailabilityZone   string
+	PrivateIP          string
+	PrivateIPs         []string
+	PublicIP           string
+	MacAddress         string
+	AttachmentID       string
+	InstanceID         string
+	RequesterID        string
+	RequesterManaged   bool
+	OwnerID            string
+	SecurityGroups     []string
+	Tags               map[string]string
+}
+
+// Address represents an Elastic IP address
+type Address struct {
+	AllocationID       string
+	AssociationID      string
+	PublicIP           string
+	PrivateIP          string
+	InstanceID         string
+	NetworkInterfaceID string
+	Domain             string
+	Name               string
+	Tags               map[string]string
+}
+
+// ListNetworkInterfaces lists ENIs, optionally filtered by VPC
+func (c *NetworkInterfacesClient) ListNetworkInterfaces(ctx context.Context, vpcID string) ([]NetworkInterface, error) {
+	var filters []types.Filter
+	if vpcID != "" {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("vpc-id"),
+			Values: []string{vpcID},
+		})
+	}
+	return c.describeNetworkInterfaces(ctx, filters)
+}
+
+// ListSecurityGroupInterfaces lists the ENIs a security group is attached to
+func (c *NetworkInterfacesClient) ListSecurityGroupInterfaces(ctx context.Context, groupID string) ([]NetworkInterface, error) {
+	return c.describeNetworkInterfaces(ctx, []types.Filter{
+		{
+			Name:   aws.String("group-id"),
+			Values: []string{groupID},
+		},
+	})
+}
+
+// GetNetworkInterface gets a single ENI by ID
+func (c *NetworkInterfacesClient) GetNetworkInterface(ctx context.Context, eniID string) (*NetworkInterface, error) {
+	output, err := c.client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []string{eniID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe network interface: %w", err)
+	}
+
+	if len(output.NetworkInterfaces) == 0 {
+		return nil, fmt.Errorf("network interface not found: %s", eniID)
+	}
+
+	eni := convertNetworkInterface(output.NetworkInterfaces[0])
+	return &eni, nil
+}
+
+// ListInstanceNetworkInterfaces lists the ENIs attached to an instance
+func (c *NetworkInterfacesClient) ListInstanceNetworkInterfaces(ctx context.Context, instanceID string) ([]NetworkInterface, error) {
+	return c.describeNetworkInterfaces(ctx, []types.Filter{
+		{
+			Name:   aws.String("attachment.instance-id"),
+			Values: []string{instanceID},
+		},
+	})
+}
+
+// FindNetworkInterfacesByIP finds ENIs that own the given private or public IP
+func (c *NetworkInterfacesClient) FindNetworkInterfacesByIP(ctx context.Context, ip string) ([]NetworkInterface, error) {
+	seen := make(map[string]bool)
+	var result []NetworkInterface
+
+	// Private and public addresses are separate filters and can't be OR'd in one call
+	for _, filterName := range []string{"addresses.private-ip-address", "association.public-ip"} {
+		enis, err := c.describeNetworkInterfaces(ctx, []types.Filter{
+			{
+				Name:   aws.String(filterName),
+				Values: []string{ip},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, eni := range enis {
+			if seen[eni.NetworkInterfaceID] {
+				continue
+			}
+			seen[eni.NetworkInterfaceID] = true
+			result = append(result, eni)
+		}
+	}
+
+	return result, nil
+}
+
+// FindNetworkInterfacesByDescription finds ENIs whose description contains the given text
+func (c *NetworkInterfacesClient) FindNetworkInterfacesByDescription(ctx context.Context, text string) ([]NetworkInterface, error) {
+	return c.describeNetworkInterfaces(ctx, []types.Filter{
+		{
+			Name:   aws.String("description"),
+			Values: []string{"*" + text + "*"},
+		},
+	})
+}
+
+func (c *NetworkInterfacesClient) describeNetworkInterfaces(ctx context.Context, filters []types.Filter) ([]NetworkInterface, error) {
+	var enis []NetworkInterface
+	var nextToken *string
+
+	for {
+		output, err := c.client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+			Filters:   filters,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe network interfaces: %w", err)
+		}
+
+		for _, eni := range output.NetworkInterfaces {
+			enis = append(enis, convertNetworkInterface(eni))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return enis, nil
+}
+
+// ListAddresses lists all Elastic IP addresses
+func (c *NetworkInterfacesClient) ListAddresses(ctx context.Context) ([]Address, error) {
+	output, err := c.client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe addresses: %w", err)
+	}
+
+	addresses := make([]Address, 0, len(output.Addresses))
+	for _, addr := range output.Addresses {
+		addresses = append(addresses, convertAddress(addr))
+	}
+
+	return addresses, nil
+}
+
+// FindAddressesByIP finds Elastic IPs whose public or associated private IP matches
+func (c *NetworkInterfacesClient) FindAddressesByIP(ctx context.Context, ip string) ([]Address, error) {
+	addresses, err := c.ListAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Address
+	for _, addr := range addresses {
+		if addr.PublicIP == ip || addr.PrivateIP == ip {
+			result = append(result, addr)
+		}
+	}
+
+	return result, nil
+}
+
+// Owner describes what an ENI is attached to, based on its type, attachment and description
+func (e NetworkInterface) Owner() string {
+	if e.InstanceID != "" {
+		return e.InstanceID
+	}
+
+	switch e.InterfaceType {
+	case "nat_gateway":
+		return "NAT Gateway"
+	case "vpc_endpoint", "gateway_load_balancer_endpoint":
+		return "VPC Endpoint"
+	case "lambda":
+		return "Lambda"
+	case "transit_gateway":
+		return "Transit Gateway"
+	}
+
+	// Managed ENIs carry the owning resource in the description
+	switch {
+	case strings.HasPrefix(e.Description, "ELB "):
+		return strings.TrimPrefix(e.Description, "ELB ")
+	case strings.HasPrefix(e.Description, "AWS Lambda VPC ENI"):
+		return "Lambda: " + strings.TrimSpace(strings.TrimPrefix(e.Description, "AWS Lambda VPC ENI-"))
+	case strings.HasPrefix(e.Description, "RDSNetworkInterface"):
+		return "RDS"
+	case strings.HasPrefix(e.Description, "arn:aws:ecs:"):
+		return "ECS task"
+	case e.Description != "":
+		return e.Description
+	}
+
+	if e.RequesterID != "" {
+		return "Requester: " + e.RequesterID
+	}
+	return "-"
+}
+
+func convertNetworkInterface(eni types.NetworkInterface) NetworkInterface {
+	result := NetworkInterface{
+		NetworkInterfaceID: aws.ToString(eni.NetworkInterfaceId),
+		Description:        aws.ToString(eni.Description),
+		InterfaceType:      string(eni.InterfaceType),
+		Status:             string(eni.Status),
+		VpcID:              aws.ToString(eni.VpcId),
+		SubnetID:           aws.ToString(eni.SubnetId),
+		AvailabilityZone:   aws.ToString(eni.AvailabilityZone),
+		PrivateIP:          aws.ToString(eni.PrivateIpAddress),
+		MacAddress:         aws.ToString(eni.MacAddress),
+		RequesterID:        aws.ToString(eni.RequesterId),
+		RequesterManaged:   aws.ToBool(eni.RequesterManaged),
+		OwnerID:            aws.ToString(eni.OwnerId),
+		Tags:               make(map[string]string),
+	}
+
+	if eni.Association != nil {
+		result.PublicIP = aws.ToString(eni.Association.PublicIp)
+	}
+
+	if eni.Attachment != nil {
+		result.AttachmentID = aws.ToString(eni.Attachment.AttachmentId)
+		result.InstanceID = aws.ToString(eni.Attachment.InstanceId)
+	}
+
+	for _, addr := range eni.PrivateIpAddresses {
+		result.PrivateIPs = append(result.PrivateIPs, aws.ToString(addr.PrivateIpAddress))
+	}
+
+	for _, sg := range eni.Groups {
+		result.SecurityGroups = append(result.SecurityGroups, aws.ToString(sg.GroupId))
+	}
+
+	for _, tag := range eni.TagSet {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		result.Tags[key] = value
+		if key == "Name" {
+			result.Name = value
+		}
+	}
+
+	return result
+}
+
+func convertAddress(addr types.Address) Address {
+	result := Address{
+		AllocationID:       aws.ToString(addr.AllocationId),
+		AssociationID:      aws.ToString(addr.AssociationId),
+		PublicIP:           aws.ToString(addr.PublicIp),
+		PrivateIP:          aws.ToString(addr.PrivateIpAddress),
+		InstanceID:         aws.ToString(addr.InstanceId),
+		NetworkInterfaceID: aws.ToString(addr.NetworkInterfaceId),
+		Domain:             string(addr.Domain),
+		Tags:               make(map[string]string),
+	}
+
+	for _, tag := range addr.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		result.Tags[key] = value
+		if key == "Name" {
+			result.Name = value
+		}
+	}
+
+	return result
+}
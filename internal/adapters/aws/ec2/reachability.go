@@ -0,0 +1,251 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// reachabilityPollInterval is how often a running analysis is checked for its result
+const reachabilityPollInterval = 3 * time.Second
+
+// ReachabilityClient wraps the EC2 client for VPC Reachability Analyzer operations
+type ReachabilityClient struct {
+	client *ec2.Client
+}
+
+// NewReachabilityClient creates a new Reachability Analyzer client
+func NewReachabilityClient(client *ec2.Client) *ReachabilityClient {
+	return &ReachabilityClient{client: client}
+}
+
+// ReachabilityAnalysis is the result of analyzing a path between two resources
+type ReachabilityAnalysis struct {
+	PathID        string
+	AnalysisID    string
+	Source        string // The endpoints the path was created with, after resolving load balancers
+	Destination   string
+	Status        string
+	StatusMessage string
+	Reachable     bool
+	Hops          []string                  // Components of the forward path, in order
+	Explanations  []ReachabilityExplanation // Why the destination can't be reached
+}
+
+// ReachabilityExplanation is a reason the destination can't be reached, naming the
+// component that blocks the path, such as a security group, network ACL or route table
+type ReachabilityExplanation struct {
+	Code      string
+	Kind      string
+	Component string
+	Direction string
+	Detail    string // The rule or route involved, when there is one
+}
+
+// AnalyzePath creates a TCP path from source to destination, analyzes it and waits for the
+// result. Load balancers are given by ARN and analyzed through one of their network
+// interfaces. The path is kept, tagged as created by aws-tui, so the analysis can also be
+// opened in the console.
+func (c *ReachabilityClient) AnalyzePath(ctx context.Context, source, destination string) (*ReachabilityAnalysis, error) {
+	source, err := c.resolveEndpoint(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	destination, err = c.resolveEndpoint(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := c.client.CreateNetworkInsightsPath(ctx, &ec2.CreateNetworkInsightsPathInput{
+		Source:      aws.String(source),
+		Destination: aws.String(destination),
+		Protocol:    types.ProtocolTcp,
+		TagSpecifications: []types.TagSpecification{
+			{
+				ResourceType: types.ResourceTypeNetworkInsightsPath,
+				Tags: []types.Tag{
+					{Key: aws.String("Name"), Value: aws.String(fmt.Sprintf("%s to %s", source, destination))},
+					{Key: aws.String("CreatedBy"), Value: aws.String("aws-tui")},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network insights path: %w", err)
+	}
+	pathID := aws.ToString(path.NetworkInsightsPath.NetworkInsightsPathId)
+
+	started, err := c.client.StartNetworkInsightsAnalysis(ctx, &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: aws.String(pathID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start network insights analysis: %w", err)
+	}
+	analysisID := aws.ToString(started.NetworkInsightsAnalysis.NetworkInsightsAnalysisId)
+
+	for {
+		output, err := c.client.DescribeNetworkInsightsAnalyses(ctx, &ec2.DescribeNetworkInsightsAnalysesInput{
+			NetworkInsightsAnalysisIds: []string{analysisID},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe network insights analysis: %w", err)
+		}
+		if len(output.NetworkInsightsAnalyses) == 0 {
+			return nil, fmt.Errorf("network insights analysis not found: %s", analysisID)
+		}
+
+		analysis := output.NetworkInsightsAnalyses[0]
+		if analysis.Status != types.AnalysisStatusRunning {
+			result := convertReachabilityAnalysis(analysis)
+			result.Source, result.Destination = source, destination
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("analysis %s still running: %w", analysisID, ctx.Err())
+		case <-time.After(reachabilityPollInterval):
+		}
+	}
+}
+
+func convertReachabilityAnalysis(analysis types.NetworkInsightsAnalysis) *ReachabilityAnalysis {
+	result := &ReachabilityAnalysis{
+		PathID:        aws.ToString(analysis.NetworkInsightsPathId),
+		AnalysisID:    aws.ToString(analysis.NetworkInsightsAnalysisId),
+		Status:        string(analysis.Status),
+		StatusMessage: aws.ToString(analysis.StatusMessage),
+		Reachable:     aws.ToBool(analysis.NetworkPathFound),
+	}
+
+	for _, hop := range analysis.ForwardPathComponents {
+		label := analysisComponentLabel(hop.Component)
+		if hop.Component != nil {
+			label = analysisComponentKind(aws.ToString(hop.Component.Id), aws.ToString(hop.Component.Arn)) + " " + label
+		}
+		result.Hops = append(result.Hops, label)
+	}
+
+	for _, explanation := range analysis.Explanations {
+		result.Explanations = append(result.Explanations, convertExplanation(explanation))
+	}
+
+	return result
+}
+
+// convertExplanation picks the component that blocks the path out of an explanation,
+// preferring the security group, network ACL or route table over the resource they apply to
+func convertExplanation(explanation types.Explanation) ReachabilityExplanation {
+	blocker := explanation.Component
+	var detail string
+	switch {
+	case explanation.SecurityGroup != nil:
+		blocker = explanation.SecurityGroup
+	case len(explanation.SecurityGroups) > 0:
+		blocker = &explanation.SecurityGroups[0]
+	case explanation.Acl != nil:
+		blocker = explanation.Acl
+		if rule := explanation.AclRule; rule != nil {
+			detail = fmt.Sprintf("rule %d %s %s", aws.ToInt32(rule.RuleNumber), aws.ToString(rule.RuleAction), aws.ToString(rule.Cidr))
+		}
+	case explanation.RouteTable != nil:
+		blocker = explanation.RouteTable
+		if route := explanation.RouteTableRoute; route != nil {
+			detail = fmt.Sprintf("route %s", aws.ToString(route.DestinationCidr))
+		}
+	case explanation.SubnetRouteTable != nil:
+		blocker = explanation.SubnetRouteTable
+	}
+
+	result := ReachabilityExplanation{
+		Code:      aws.ToString(explanation.ExplanationCode),
+		Component: analysisComponentLabel(blocker),
+		Direction: aws.ToString(explanation.Direction),
+		Detail:    detail,
+	}
+	if blocker != nil {
+		result.Kind = analysisComponentKind(aws.ToString(blocker.Id), aws.ToString(blocker.Arn))
+	}
+	return result
+}
+
+// componentKinds names the kinds of components by the prefix of their IDs
+var componentKinds = []struct {
+	prefix string
+	kind   string
+}{
+	{"sg-", "security group"},
+	{"acl-", "network ACL"},
+	{"rtb-", "route table"},
+	{"igw-", "internet gateway"},
+	{"nat-", "NAT gateway"},
+	{"eni-", "network interface"},
+	{"subnet-", "subnet"},
+	{"vpc-", "VPC"},
+	{"vpce-", "VPC endpoint"},
+	{"pcx-", "peering connection"},
+	{"tgw-attach-", "transit gateway attachment"},
+	{"tgw-", "transit gateway"},
+	{"vgw-", "VPN gateway"},
+	{"i-", "instance"},
+}
+
+// analysisComponentKind describes what kind of resource a component is
+func analysisComponentKind(id, arn string) string {
+	if strings.Contains(arn, ":loadbalancer/") {
+		return "load balancer"
+	}
+	if strings.Contains(arn, ":listener/") {
+		return "listener"
+	}
+	if strings.Contains(arn, ":targetgroup/") {
+		return "target group"
+	}
+	for _, k := range componentKinds {
+		if strings.HasPrefix(id, k.prefix) {
+			return k.kind
+		}
+	}
+	return "component"
+}
+
+// resolveEndpoint returns a network interface of a load balancer given by ARN, as paths
+// are analyzed between network interfaces, instances and gateways. Other endpoints are
+// returned as they are.
+func (c *ReachabilityClient) resolveEndpoint(ctx context.Context, endpoint string) (string, error) {
+	_, name, ok := strings.Cut(endpoint, ":loadbalancer/")
+	if !ok {
+		return endpoint, nil
+	}
+
+	// Load balancer interfaces are described as "ELB app/name/id" or "ELB net/name/id"
+	output, err := c.client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("description"), Values: []string{"ELB " + name}},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to find network interfaces of load balancer: %w", err)
+	}
+	if len(output.NetworkInterfaces) == 0 {
+		return "", fmt.Errorf("load balancer %s has no network interfaces", name)
+	}
+	return aws.ToString(output.NetworkInterfaces[0].NetworkInterfaceId), nil
+}
+
+// analysisComponentLabel names a component by ID, with its name when it has one
+func analysisComponentLabel(component *types.AnalysisComponent) string {
+	if component == nil {
+		return "-"
+	}
+	id := aws.ToString(component.Id)
+	if name := aws.ToString(component.Name); name != "" && name != id {
+		return fmt.Sprintf("%s (%s)", id, name)
+	}
+	return id
+}
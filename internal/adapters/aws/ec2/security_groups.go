@@ -3,6 +3,7 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -29,6 +30,10 @@ type SecurityGroup struct {
 	InboundRules  []SecurityGroupRule
 	OutboundRules []SecurityGroupRule
 	Tags          map[string]string
+
+	// Other groups the rules allow traffic from or to. A group can't be deleted while
+	// another group's rules refer to it.
+	ReferencedGroupIDs []string
 }
 
 // SecurityGroupRule represents an inbound or outbound rule
@@ -122,6 +127,42 @@ func (c *SecurityGroupsClient) GetSecurityGroupRules(ctx context.Context, groupI
 	return rules, nil
 }
 
+// FindReferencingGroups lists the groups whose inbound or outbound rules refer to a group
+func (c *SecurityGroupsClient) FindReferencingGroups(ctx context.Context, groupID string) ([]string, error) {
+	var ids []string
+	for _, filter := range []string{"ip-permission.group-id", "egress.ip-permission.group-id"} {
+		output, err := c.client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+			Filters: []types.Filter{
+				{
+					Name:   aws.String(filter),
+					Values: []string{groupID},
+				},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find groups referring to %s: %w", groupID, err)
+		}
+		for _, sg := range output.SecurityGroups {
+			id := aws.ToString(sg.GroupId)
+			if id != groupID && !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// DeleteSecurityGroup deletes a security group
+func (c *SecurityGroupsClient) DeleteSecurityGroup(ctx context.Context, groupID string) error {
+	_, err := c.client.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{
+		GroupId: aws.String(groupID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete security group %s: %w", groupID, err)
+	}
+	return nil
+}
+
 func convertSecurityGroup(sg types.SecurityGroup) SecurityGroup {
 	result := SecurityGroup{
 		GroupID:     aws.ToString(sg.GroupId),
@@ -140,16 +181,30 @@ func convertSecurityGroup(sg types.SecurityGroup) SecurityGroup {
 	// Convert inbound rules
 	for _, perm := range sg.IpPermissions {
 		result.InboundRules = append(result.InboundRules, convertPermission(perm))
+		result.ReferencedGroupIDs = appendGroupPairs(result.ReferencedGroupIDs, perm, result.GroupID)
 	}
 
 	// Convert outbound rules
 	for _, perm := range sg.IpPermissionsEgress {
 		result.OutboundRules = append(result.OutboundRules, convertPermission(perm))
+		result.ReferencedGroupIDs = appendGroupPairs(result.ReferencedGroupIDs, perm, result.GroupID)
 	}
 
 	return result
 }
 
+// appendGroupPairs adds the groups a rule refers to, other than the group itself
+func appendGroupPairs(ids []string, perm types.IpPermission, self string) []string {
+	for _, pair := range perm.UserIdGroupPairs {
+		id := aws.ToString(pair.GroupId)
+		if id == "" || id == self || slices.Contains(ids, id) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func convertPermission(perm types.IpPermission) SecurityGroupRule {
 	rule := SecurityGroupRule{
 		Protocol: aws.ToString(perm.IpProtocol),
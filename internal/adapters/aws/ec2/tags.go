@@ -0,0 +1,69 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// TagsClient reads and changes the tags of any EC2 resource by its ID, such as an
+// instance, security group, VPC or subnet
+type TagsClient struct {
+	client *ec2.Client
+}
+
+// NewTagsClient creates a new EC2 tags client
+func NewTagsClient(client *ec2.Client) *TagsClient {
+	return &TagsClient{client: client}
+}
+
+// Tags gets the tags of a resource
+func (c *TagsClient) Tags(ctx context.Context, resourceID string) (map[string]string, error) {
+	tags := make(map[string]string)
+	paginator := ec2.NewDescribeTagsPaginator(c.client, &ec2.DescribeTagsInput{
+		Filters: []types.Filter{{Name: aws.String("resource-id"), Values: []string{resourceID}}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags of %s: %w", resourceID, err)
+		}
+		for _, tag := range page.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+// UpdateTags adds or changes the tags in set and removes the keys in remove
+func (c *TagsClient) UpdateTags(ctx context.Context, resourceID string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		tags := make([]types.Tag, 0, len(set))
+		for key, value := range set {
+			tags = append(tags, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+		if _, err := c.client.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: []string{resourceID},
+			Tags:      tags,
+		}); err != nil {
+			return fmt.Errorf("failed to tag %s: %w", resourceID, err)
+		}
+	}
+
+	if len(remove) > 0 {
+		tags := make([]types.Tag, 0, len(remove))
+		for _, key := range remove {
+			tags = append(tags, types.Tag{Key: aws.String(key)})
+		}
+		if _, err := c.client.DeleteTags(ctx, &ec2.DeleteTagsInput{
+			Resources: []string{resourceID},
+			Tags:      tags,
+		}); err != nil {
+			return fmt.Errorf("failed to remove tags from %s: %w", resourceID, err)
+		}
+	}
+	return nil
+}
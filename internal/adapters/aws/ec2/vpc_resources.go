@@ -0,0 +1,275 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// RouteTable represents a VPC route table
+type RouteTable struct {
+	RouteTableID string
+	Name         string
+	VpcID        string
+	Main         bool
+	SubnetIDs    []string // Explicitly associated subnets
+	Routes       []Route
+	OwnerID      string
+	Tags         map[string]string
+}
+
+// Route is a single entry of a route table
+type Route struct {
+	Destination string
+	Target      string
+	State       string
+	Origin      string
+}
+
+// NatGateway represents a NAT gateway
+type NatGateway struct {
+	NatGatewayID     string
+	Name             string
+	VpcID            string
+	SubnetID         string
+	State            string
+	ConnectivityType string
+	PublicIP         string
+	PrivateIP        string
+	CreatedAt        time.Time
+	Tags             map[string]string
+}
+
+// InternetGateway represents an internet gateway
+type InternetGateway struct {
+	InternetGatewayID string
+	Name              string
+	VpcIDs            []string // Attached VPCs, at most one in practice
+	State             string   // Attachment state, "detached" if not attached
+	OwnerID           string
+	Tags              map[string]string
+}
+
+// vpcFilter returns a filter on the given field for a VPC, or none if vpcID is empty
+func vpcFilter(field, vpcID string) []types.Filter {
+	if vpcID == "" {
+		return nil
+	}
+	return []types.Filter{
+		{
+			Name:   aws.String(field),
+			Values: []string{vpcID},
+		},
+	}
+}
+
+// ListRouteTables lists route tables, optionally filtered by VPC
+func (c *VPCsClient) ListRouteTables(ctx context.Context, vpcID string) ([]RouteTable, error) {
+	var tables []RouteTable
+	var nextToken *string
+
+	for {
+		output, err := c.client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
+			Filters:   vpcFilter("vpc-id", vpcID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe route tables: %w", err)
+		}
+
+		for _, table := range output.RouteTables {
+			tables = append(tables, convertRouteTable(table))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return tables, nil
+}
+
+// ListNatGateways lists NAT gateways, optionally filtered by VPC
+func (c *VPCsClient) ListNatGateways(ctx context.Context, vpcID string) ([]NatGateway, error) {
+	var gateways []NatGateway
+	var nextToken *string
+
+	for {
+		output, err := c.client.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{
+			Filter:    vpcFilter("vpc-id", vpcID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe NAT gateways: %w", err)
+		}
+
+		for _, gateway := range output.NatGateways {
+			gateways = append(gateways, convertNatGateway(gateway))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return gateways, nil
+}
+
+// ListInternetGateways lists internet gateways, optionally filtered by attached VPC
+func (c *VPCsClient) ListInternetGateways(ctx context.Context, vpcID string) ([]InternetGateway, error) {
+	var gateways []InternetGateway
+	var nextToken *string
+
+	for {
+		output, err := c.client.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{
+			Filters:   vpcFilter("attachment.vpc-id", vpcID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe internet gateways: %w", err)
+		}
+
+		for _, gateway := range output.InternetGateways {
+			gateways = append(gateways, convertInternetGateway(gateway))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return gateways, nil
+}
+
+func convertRouteTable(table types.RouteTable) RouteTable {
+	result := RouteTable{
+		RouteTableID: aws.ToString(table.RouteTableId),
+		VpcID:        aws.ToString(table.VpcId),
+		OwnerID:      aws.ToString(table.OwnerId),
+		Tags:         make(map[string]string),
+	}
+
+	for _, assoc := range table.Associations {
+		if aws.ToBool(assoc.Main) {
+			result.Main = true
+		}
+		if assoc.SubnetId != nil {
+			result.SubnetIDs = append(result.SubnetIDs, aws.ToString(assoc.SubnetId))
+		}
+	}
+
+	for _, route := range table.Routes {
+		result.Routes = append(result.Routes, convertRoute(route))
+	}
+
+	for _, tag := range table.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		result.Tags[key] = value
+		if key == "Name" {
+			result.Name = value
+		}
+	}
+
+	return result
+}
+
+func convertRoute(route types.Route) Route {
+	result := Route{
+		State:  string(route.State),
+		Origin: string(route.Origin),
+	}
+
+	switch {
+	case route.DestinationCidrBlock != nil:
+		result.Destination = aws.ToString(route.DestinationCidrBlock)
+	case route.DestinationIpv6CidrBlock != nil:
+		result.Destination = aws.ToString(route.DestinationIpv6CidrBlock)
+	case route.DestinationPrefixListId != nil:
+		result.Destination = aws.ToString(route.DestinationPrefixListId)
+	}
+
+	// Only one target is set per route
+	for _, target := range []*string{
+		route.GatewayId,
+		route.NatGatewayId,
+		route.TransitGatewayId,
+		route.VpcPeeringConnectionId,
+		route.NetworkInterfaceId,
+		route.InstanceId,
+		route.EgressOnlyInternetGatewayId,
+		route.LocalGatewayId,
+		route.CarrierGatewayId,
+		route.CoreNetworkArn,
+	} {
+		if target != nil {
+			result.Target = aws.ToString(target)
+			break
+		}
+	}
+
+	return result
+}
+
+func convertNatGateway(gateway types.NatGateway) NatGateway {
+	result := NatGateway{
+		NatGatewayID:     aws.ToString(gateway.NatGatewayId),
+		VpcID:            aws.ToString(gateway.VpcId),
+		SubnetID:         aws.ToString(gateway.SubnetId),
+		State:            string(gateway.State),
+		ConnectivityType: string(gateway.ConnectivityType),
+		Tags:             make(map[string]string),
+	}
+
+	if gateway.CreateTime != nil {
+		result.CreatedAt = *gateway.CreateTime
+	}
+
+	if len(gateway.NatGatewayAddresses) > 0 {
+		result.PublicIP = aws.ToString(gateway.NatGatewayAddresses[0].PublicIp)
+		result.PrivateIP = aws.ToString(gateway.NatGatewayAddresses[0].PrivateIp)
+	}
+
+	for _, tag := range gateway.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		result.Tags[key] = value
+		if key == "Name" {
+			result.Name = value
+		}
+	}
+
+	return result
+}
+
+func convertInternetGateway(gateway types.InternetGateway) InternetGateway {
+	result := InternetGateway{
+		InternetGatewayID: aws.ToString(gateway.InternetGatewayId),
+		State:             "detached",
+		OwnerID:           aws.ToString(gateway.OwnerId),
+		Tags:              make(map[string]string),
+	}
+
+	for _, attachment := range gateway.Attachments {
+		result.VpcIDs = append(result.VpcIDs, aws.ToString(attachment.VpcId))
+		result.State = string(attachment.State)
+	}
+
+	for _, tag := range gateway.Tags {
+		key := aws.ToString(tag.Key)
+		value := aws.ToString(tag.Value)
+		result.Tags[key] = value
+		if key == "Name" {
+			result.Name = value
+		}
+	}
+
+	return result
+}
@@ -36,6 +36,7 @@ type VPC struct {
 // Subnet represents a VPC subnet
 type Subnet struct {
 	SubnetID         string
+	SubnetARN        string
 	Name             string
 	VpcID            string
 	CidrBlock        string
@@ -188,6 +189,7 @@ func convertVPC(vpc types.Vpc) VPC {
 func convertSubnet(subnet types.Subnet) Subnet {
 	result := Subnet{
 		SubnetID:         aws.ToString(subnet.SubnetId),
+		SubnetARN:        aws.ToString(subnet.SubnetArn),
 		VpcID:            aws.ToString(subnet.VpcId),
 		CidrBlock:        aws.ToString(subnet.CidrBlock),
 		AvailabilityZone: aws.ToString(subnet.AvailabilityZone),
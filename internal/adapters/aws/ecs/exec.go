@@ -0,0 +1,52 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+// ExecSession is the Session Manager session of an ECS Exec command. The JSON is what
+// the session-manager-plugin takes as its first argument.
+type ExecSession struct {
+	SessionID  string `json:"sessionId"`
+	StreamURL  string `json:"streamUrl"`
+	TokenValue string `json:"tokenValue"`
+
+	// Session Manager target of the container, ecs:<cluster>_<task ID>_<runtime ID>
+	Target string `json:"-"`
+}
+
+// ExecuteCommand runs command interactively in a container of a task, returning the
+// session to join for its terminal
+func (c *TasksClient) ExecuteCommand(ctx context.Context, clusterARN, taskARN, container, runtimeID, command string) (*ExecSession, error) {
+	output, err := c.client.ExecuteCommand(ctx, &ecs.ExecuteCommandInput{
+		Cluster:     aws.String(clusterARN),
+		Task:        aws.String(taskARN),
+		Container:   aws.String(container),
+		Command:     aws.String(command),
+		Interactive: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute command in %s: %w", container, err)
+	}
+	if output.Session == nil {
+		return nil, fmt.Errorf("ECS started no session for %s", container)
+	}
+
+	return &ExecSession{
+		SessionID:  aws.ToString(output.Session.SessionId),
+		StreamURL:  aws.ToString(output.Session.StreamUrl),
+		TokenValue: aws.ToString(output.Session.TokenValue),
+		Target:     fmt.Sprintf("ecs:%s_%s_%s", lastARNPart(clusterARN), lastARNPart(taskARN), runtimeID),
+	}, nil
+}
+
+// lastARNPart returns the part of an ARN after its last slash, a cluster's name or a
+// task's ID
+func lastARNPart(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
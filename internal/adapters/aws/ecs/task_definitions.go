@@ -0,0 +1,226 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+// TaskDefinitionsClient wraps the ECS client for task definition operations
+type TaskDefinitionsClient struct {
+	client *ecs.Client
+}
+
+// NewTaskDefinitionsClient creates a new ECS task definitions client
+func NewTaskDefinitionsClient(client *ecs.Client) *TaskDefinitionsClient {
+	return &TaskDefinitionsClient{client: client}
+}
+
+// TaskDefinitionRevision is a single revision of a task definition family as returned by listing
+type TaskDefinitionRevision struct {
+	ARN      string
+	Family   string
+	Revision int
+	Status   string
+}
+
+// TaskDefinition represents a fully described task definition
+type TaskDefinition struct {
+	ARN                     string
+	Family                  string
+	Revision                int
+	Status                  string
+	NetworkMode             string
+	RequiresCompatibilities []string
+	CPU                     string
+	Memory                  string
+	TaskRoleARN             string
+	ExecutionRoleARN        string
+	RegisteredAt            string
+	RegisteredBy            string
+	DeregisteredAt          string
+	ContainerDefinitions    []ContainerDefinition
+	Tags                    map[string]string
+}
+
+// ContainerDefinition represents a container in a task definition
+type ContainerDefinition struct {
+	Name              string
+	Image             string
+	CPU               int32
+	Memory            int32
+	MemoryReservation int32
+	Essential         bool
+	PortMappings      []string
+	Environment       map[string]string
+	Secrets           map[string]string // Name to ValueFrom
+	Command           []string
+	EntryPoint        []string
+	LogDriver         string
+	LogOptions        map[string]string
+}
+
+// ListRevisions lists active and inactive revisions of a family, newest first
+func (c *TaskDefinitionsClient) ListRevisions(ctx context.Context, family string) ([]TaskDefinitionRevision, error) {
+	var revisions []TaskDefinitionRevision
+
+	// The status filter is exclusive, so active and inactive revisions are listed separately
+	for _, status := range []types.TaskDefinitionStatus{types.TaskDefinitionStatusActive, types.TaskDefinitionStatusInactive} {
+		var nextToken *string
+		for {
+			output, err := c.client.ListTaskDefinitions(ctx, &ecs.ListTaskDefinitionsInput{
+				FamilyPrefix: aws.String(family),
+				Status:       status,
+				Sort:         types.SortOrderDesc,
+				NextToken:    nextToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list task definitions: %w", err)
+			}
+
+			for _, arn := range output.TaskDefinitionArns {
+				revFamily, revision := ParseTaskDefinitionARN(arn)
+				// FamilyPrefix also matches longer family names
+				if revFamily != family {
+					continue
+				}
+				revisions = append(revisions, TaskDefinitionRevision{
+					ARN:      arn,
+					Family:   revFamily,
+					Revision: revision,
+					Status:   string(status),
+				})
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+			nextToken = output.NextToken
+		}
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+
+	return revisions, nil
+}
+
+// DescribeTaskDefinition gets a task definition by ARN or "family:revision"
+func (c *TaskDefinitionsClient) DescribeTaskDefinition(ctx context.Context, taskDefinition string) (*TaskDefinition, error) {
+	output, err := c.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinition),
+		Include:        []types.TaskDefinitionField{types.TaskDefinitionFieldTags},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe task definition: %w", err)
+	}
+
+	if output.TaskDefinition == nil {
+		return nil, fmt.Errorf("task definition not found: %s", taskDefinition)
+	}
+
+	td := convertTaskDefinition(*output.TaskDefinition, output.Tags)
+	return &td, nil
+}
+
+// ParseTaskDefinitionARN extracts the family and revision from a task definition ARN or
+// "family:revision" string, e.g. "arn:aws:ecs:us-east-1:123456789012:task-definition/web:42"
+func ParseTaskDefinitionARN(arn string) (family string, revision int) {
+	name := arn
+	if idx := strings.LastIndex(arn, "/"); idx >= 0 {
+		name = arn[idx+1:]
+	}
+
+	idx := strings.LastIndex(name, ":")
+	if idx < 0 {
+		return name, 0
+	}
+
+	revision, err := strconv.Atoi(name[idx+1:])
+	if err != nil {
+		return name, 0
+	}
+	return name[:idx], revision
+}
+
+func convertTaskDefinition(td types.TaskDefinition, tags []types.Tag) TaskDefinition {
+	result := TaskDefinition{
+		ARN:              aws.ToString(td.TaskDefinitionArn),
+		Family:           aws.ToString(td.Family),
+		Revision:         int(td.Revision),
+		Status:           string(td.Status),
+		NetworkMode:      string(td.NetworkMode),
+		CPU:              aws.ToString(td.Cpu),
+		Memory:           aws.ToString(td.Memory),
+		TaskRoleARN:      aws.ToString(td.TaskRoleArn),
+		ExecutionRoleARN: aws.ToString(td.ExecutionRoleArn),
+		RegisteredBy:     aws.ToString(td.RegisteredBy),
+		Tags:             make(map[string]string),
+	}
+
+	if td.RegisteredAt != nil {
+		result.RegisteredAt = td.RegisteredAt.Format("2006-01-02 15:04:05")
+	}
+	if td.DeregisteredAt != nil {
+		result.DeregisteredAt = td.DeregisteredAt.Format("2006-01-02 15:04:05")
+	}
+
+	for _, compat := range td.RequiresCompatibilities {
+		result.RequiresCompatibilities = append(result.RequiresCompatibilities, string(compat))
+	}
+
+	for _, cd := range td.ContainerDefinitions {
+		result.ContainerDefinitions = append(result.ContainerDefinitions, convertContainerDefinition(cd))
+	}
+
+	for _, tag := range tags {
+		result.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return result
+}
+
+func convertContainerDefinition(cd types.ContainerDefinition) ContainerDefinition {
+	result := ContainerDefinition{
+		Name:              aws.ToString(cd.Name),
+		Image:             aws.ToString(cd.Image),
+		CPU:               cd.Cpu,
+		Memory:            aws.ToInt32(cd.Memory),
+		MemoryReservation: aws.ToInt32(cd.MemoryReservation),
+		Essential:         aws.ToBool(cd.Essential),
+		Command:           cd.Command,
+		EntryPoint:        cd.EntryPoint,
+		Environment:       make(map[string]string),
+		Secrets:           make(map[string]string),
+	}
+
+	for _, pm := range cd.PortMappings {
+		mapping := fmt.Sprintf("%d/%s", aws.ToInt32(pm.ContainerPort), pm.Protocol)
+		if hostPort := aws.ToInt32(pm.HostPort); hostPort != 0 && hostPort != aws.ToInt32(pm.ContainerPort) {
+			mapping = fmt.Sprintf("%d:%s", hostPort, mapping)
+		}
+		result.PortMappings = append(result.PortMappings, mapping)
+	}
+
+	for _, env := range cd.Environment {
+		result.Environment[aws.ToString(env.Name)] = aws.ToString(env.Value)
+	}
+
+	for _, secret := range cd.Secrets {
+		result.Secrets[aws.ToString(secret.Name)] = aws.ToString(secret.ValueFrom)
+	}
+
+	if cd.LogConfiguration != nil {
+		result.LogDriver = string(cd.LogConfiguration.LogDriver)
+		result.LogOptions = cd.LogConfiguration.Options
+	}
+
+	return result
+}
@@ -29,6 +29,8 @@ type Task struct {
 	LaunchType           string
 	PlatformVersion      string
 	EnableExecuteCommand bool
+	Group                string // service:<name> for tasks of a service
+	StoppedReason        string
 	Containers           []Container
 	CreatedAt            string
 	StartedAt            string
@@ -122,6 +124,20 @@ func (c *TasksClient) GetTask(ctx context.Context, clusterARN, taskARN string) (
 	return &task, nil
 }
 
+// StopTask stops a task, recording reason in its details. A task of a service is then
+// replaced by the service.
+func (c *TasksClient) StopTask(ctx context.Context, clusterARN, taskARN, reason string) error {
+	_, err := c.client.StopTask(ctx, &ecs.StopTaskInput{
+		Cluster: aws.String(clusterARN),
+		Task:    aws.String(taskARN),
+		Reason:  aws.String(reason),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop task %s: %w", taskARN, err)
+	}
+	return nil
+}
+
 func convertTask(task types.Task) Task {
 	result := Task{
 		TaskARN:              aws.ToString(task.TaskArn),
@@ -132,6 +148,8 @@ func convertTask(task types.Task) Task {
 		LaunchType:           string(task.LaunchType),
 		PlatformVersion:      aws.ToString(task.PlatformVersion),
 		EnableExecuteCommand: task.EnableExecuteCommand,
+		Group:                aws.ToString(task.Group),
+		StoppedReason:        aws.ToString(task.StoppedReason),
 		Containers:           make([]Container, 0, len(task.Containers)),
 		Tags:                 make(map[string]string),
 	}
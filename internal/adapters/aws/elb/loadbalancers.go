@@ -0,0 +1,151 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// LoadBalancersClient wraps the ELBv2 client for load balancer operations
+type LoadBalancersClient struct {
+	client *elbv2.Client
+}
+
+// NewLoadBalancersClient creates a new load balancers client
+func NewLoadBalancersClient(client *elbv2.Client) *LoadBalancersClient {
+	return &LoadBalancersClient{client: client}
+}
+
+// LoadBalancer represents an Application, Network or Gateway load balancer
+type LoadBalancer struct {
+	ARN                   string
+	Name                  string
+	DNSName               string
+	Type                  string
+	Scheme                string
+	State                 string
+	VpcID                 string
+	IPAddressType         string
+	CanonicalHostedZoneID string
+	AvailabilityZones     []string
+	SecurityGroups        []string
+	CreatedTime           time.Time
+}
+
+// ListLoadBalancers lists all load balancers
+func (c *LoadBalancersClient) ListLoadBalancers(ctx context.Context) ([]LoadBalancer, error) {
+	var lbs []LoadBalancer
+	var marker *string
+
+	for {
+		output, err := c.client.DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe load balancers: %w", err)
+		}
+
+		for _, lb := range output.LoadBalancers {
+			lbs = append(lbs, convertLoadBalancer(lb))
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return lbs, nil
+}
+
+// GetLoadBalancer gets a single load balancer by ARN
+func (c *LoadBalancersClient) GetLoadBalancer(ctx context.Context, arn string) (*LoadBalancer, error) {
+	output, err := c.client.DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: []string{arn},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe load balancer: %w", err)
+	}
+
+	if len(output.LoadBalancers) == 0 {
+		return nil, fmt.Errorf("load balancer not found: %s", arn)
+	}
+
+	lb := convertLoadBalancer(output.LoadBalancers[0])
+	return &lb, nil
+}
+
+func convertLoadBalancer(lb types.LoadBalancer) LoadBalancer {
+	result := LoadBalancer{
+		ARN:                   aws.ToString(lb.LoadBalancerArn),
+		Name:                  aws.ToString(lb.LoadBalancerName),
+		DNSName:               aws.ToString(lb.DNSName),
+		Type:                  string(lb.Type),
+		Scheme:                string(lb.Scheme),
+		VpcID:                 aws.ToString(lb.VpcId),
+		IPAddressType:         string(lb.IpAddressType),
+		CanonicalHostedZoneID: aws.ToString(lb.CanonicalHostedZoneId),
+		SecurityGroups:        lb.SecurityGroups,
+	}
+
+	if lb.State != nil {
+		result.State = string(lb.State.Code)
+	}
+
+	if lb.CreatedTime != nil {
+		result.CreatedTime = *lb.CreatedTime
+	}
+
+	for _, az := range lb.AvailabilityZones {
+		result.AvailabilityZones = append(result.AvailabilityZones, aws.ToString(az.ZoneName))
+	}
+
+	return result
+}
+
+// Tags gets the tags of a load balancer or target group
+func (c *LoadBalancersClient) Tags(ctx context.Context, arn string) (map[string]string, error) {
+	output, err := c.client.DescribeTags(ctx, &elbv2.DescribeTagsInput{
+		ResourceArns: []string{arn},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	tags := make(map[string]string)
+	for _, description := range output.TagDescriptions {
+		for _, tag := range description.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+// UpdateTags adds or changes the tags in set on a load balancer or target group and
+// removes the keys in remove
+func (c *LoadBalancersClient) UpdateTags(ctx context.Context, arn string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		tags := make([]types.Tag, 0, len(set))
+		for key, value := range set {
+			tags = append(tags, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+		if _, err := c.client.AddTags(ctx, &elbv2.AddTagsInput{
+			ResourceArns: []string{arn},
+			Tags:         tags,
+		}); err != nil {
+			return fmt.Errorf("failed to add tags: %w", err)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := c.client.RemoveTags(ctx, &elbv2.RemoveTagsInput{
+			ResourceArns: []string{arn},
+			TagKeys:      remove,
+		}); err != nil {
+			return fmt.Errorf("failed to remove tags: %w", err)
+		}
+	}
+	return nil
+}
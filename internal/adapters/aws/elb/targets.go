@@ -0,0 +1,234 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// Listener represents a load balancer listener
+type Listener struct {
+	ARN            string
+	Port           int32
+	Protocol       string
+	SslPolicy      string
+	Certificates   []string
+	DefaultActions []string // Summaries such as "forward: web-tg"
+}
+
+// TargetGroup represents a target group
+type TargetGroup struct {
+	ARN                 string
+	Name                string
+	Protocol            string
+	Port                int32
+	TargetType          string
+	VpcID               string
+	HealthCheckProtocol string
+	HealthCheckPath     string
+	LoadBalancerARNs    []string
+}
+
+// TargetHealth is a registered target and its health in a target group
+type TargetHealth struct {
+	TargetID         string
+	Port             int32
+	AvailabilityZone string
+	State            string
+	Reason           string
+	Description      string
+}
+
+// ListListeners lists the listeners of a load balancer
+func (c *LoadBalancersClient) ListListeners(ctx context.Context, lbARN string) ([]Listener, error) {
+	var listeners []Listener
+	var marker *string
+
+	for {
+		output, err := c.client.DescribeListeners(ctx, &elbv2.DescribeListenersInput{
+			LoadBalancerArn: aws.String(lbARN),
+			Marker:          marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe listeners: %w", err)
+		}
+
+		for _, l := range output.Listeners {
+			listener := Listener{
+				ARN:       aws.ToString(l.ListenerArn),
+				Port:      aws.ToInt32(l.Port),
+				Protocol:  string(l.Protocol),
+				SslPolicy: aws.ToString(l.SslPolicy),
+			}
+			for _, cert := range l.Certificates {
+				listener.Certificates = append(listener.Certificates, aws.ToString(cert.CertificateArn))
+			}
+			for _, action := range l.DefaultActions {
+				listener.DefaultActions = append(listener.DefaultActions, summarizeAction(action))
+			}
+			listeners = append(listeners, listener)
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return listeners, nil
+}
+
+// ListTargetGroups lists the target groups of a load balancer, or every target group if
+// lbARN is empty
+func (c *LoadBalancersClient) ListTargetGroups(ctx context.Context, lbARN string) ([]TargetGroup, error) {
+	var groups []TargetGroup
+	var marker *string
+
+	for {
+		input := &elbv2.DescribeTargetGroupsInput{Marker: marker}
+		if lbARN != "" {
+			input.LoadBalancerArn = aws.String(lbARN)
+		}
+
+		output, err := c.client.DescribeTargetGroups(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe target groups: %w", err)
+		}
+
+		for _, tg := range output.TargetGroups {
+			groups = append(groups, convertTargetGroup(tg))
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return groups, nil
+}
+
+// GetTargetGroup gets a single target group by ARN
+func (c *LoadBalancersClient) GetTargetGroup(ctx context.Context, arn string) (*TargetGroup, error) {
+	output, err := c.client.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{arn},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe target group: %w", err)
+	}
+
+	if len(output.TargetGroups) == 0 {
+		return nil, fmt.Errorf("target group not found: %s", arn)
+	}
+
+	tg := convertTargetGroup(output.TargetGroups[0])
+	return &tg, nil
+}
+
+// DescribeTargetHealth lists the targets registered with a target group and their health
+func (c *LoadBalancersClient) DescribeTargetHealth(ctx context.Context, tgARN string) ([]TargetHealth, error) {
+	output, err := c.client.DescribeTargetHealth(ctx, &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(tgARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe target health: %w", err)
+	}
+
+	targets := make([]TargetHealth, 0, len(output.TargetHealthDescriptions))
+	for _, desc := range output.TargetHealthDescriptions {
+		var target TargetHealth
+		if desc.Target != nil {
+			target.TargetID = aws.ToString(desc.Target.Id)
+			target.Port = aws.ToInt32(desc.Target.Port)
+			target.AvailabilityZone = aws.ToString(desc.Target.AvailabilityZone)
+		}
+		if desc.TargetHealth != nil {
+			target.State = string(desc.TargetHealth.State)
+			target.Reason = string(desc.TargetHealth.Reason)
+			target.Description = aws.ToString(desc.TargetHealth.Description)
+		}
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+// RegisterTarget registers a target with a target group. A port of 0 uses the target group's port.
+func (c *LoadBalancersClient) RegisterTarget(ctx context.Context, tgARN, targetID string, port int32) error {
+	_, err := c.client.RegisterTargets(ctx, &elbv2.RegisterTargetsInput{
+		TargetGroupArn: aws.String(tgARN),
+		Targets:        []types.TargetDescription{targetDescription(targetID, port)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register target %s: %w", targetID, err)
+	}
+	return nil
+}
+
+// DeregisterTarget deregisters a target from a target group, draining its connections first
+func (c *LoadBalancersClient) DeregisterTarget(ctx context.Context, tgARN, targetID string, port int32) error {
+	_, err := c.client.DeregisterTargets(ctx, &elbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String(tgARN),
+		Targets:        []types.TargetDescription{targetDescription(targetID, port)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to deregister target %s: %w", targetID, err)
+	}
+	return nil
+}
+
+// TargetGroupNameFromARN returns the name in a target group ARN,
+// arn:aws:elasticloadbalancing:region:account:targetgroup/name/id
+func TargetGroupNameFromARN(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) >= 3 {
+		return parts[len(parts)-2]
+	}
+	return arn
+}
+
+func targetDescription(targetID string, port int32) types.TargetDescription {
+	target := types.TargetDescription{Id: aws.String(targetID)}
+	if port > 0 {
+		target.Port = aws.Int32(port)
+	}
+	return target
+}
+
+// summarizeAction describes a listener action in one line
+func summarizeAction(action types.Action) string {
+	switch {
+	case action.TargetGroupArn != nil:
+		return "forward: " + TargetGroupNameFromARN(aws.ToString(action.TargetGroupArn))
+	case action.ForwardConfig != nil:
+		names := make([]string, 0, len(action.ForwardConfig.TargetGroups))
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			names = append(names, fmt.Sprintf("%s (%d)", TargetGroupNameFromARN(aws.ToString(tg.TargetGroupArn)), aws.ToInt32(tg.Weight)))
+		}
+		return "forward: " + strings.Join(names, ", ")
+	case action.RedirectConfig != nil:
+		rc := action.RedirectConfig
+		return fmt.Sprintf("redirect: %s:%s (%s)", aws.ToString(rc.Protocol), aws.ToString(rc.Port), rc.StatusCode)
+	case action.FixedResponseConfig != nil:
+		return "fixed-response: " + aws.ToString(action.FixedResponseConfig.StatusCode)
+	}
+	return string(action.Type)
+}
+
+func convertTargetGroup(tg types.TargetGroup) TargetGroup {
+	return TargetGroup{
+		ARN:                 aws.ToString(tg.TargetGroupArn),
+		Name:                aws.ToString(tg.TargetGroupName),
+		Protocol:            string(tg.Protocol),
+		Port:                aws.ToInt32(tg.Port),
+		TargetType:          string(tg.TargetType),
+		VpcID:               aws.ToString(tg.VpcId),
+		HealthCheckProtocol: string(tg.HealthCheckProtocol),
+		HealthCheckPath:     aws.ToString(tg.HealthCheckPath),
+		LoadBalancerARNs:    tg.LoadBalancerArns,
+	}
+}
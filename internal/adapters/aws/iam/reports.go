@@ -0,0 +1,264 @@
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// reportPollInterval is how often a report being generated is checked for completion
+const reportPollInterval = 2 * time.Second
+
+// ReportsClient wraps the IAM client for the credential report and access advisor
+type ReportsClient struct {
+	client *iam.Client
+}
+
+// NewReportsClient creates a new IAM reports client
+func NewReportsClient(client *iam.Client) *ReportsClient {
+	return &ReportsClient{client: client}
+}
+
+// AccessKeyReport is the credential report's entry for one of a user's two access keys
+type AccessKeyReport struct {
+	Active          bool
+	LastRotated     time.Time
+	LastUsed        time.Time
+	LastUsedRegion  string
+	LastUsedService string
+}
+
+// CredentialReportEntry is a row of the credential report. Zero times mean the report has
+// no date, such as a password that was never used.
+type CredentialReportEntry struct {
+	User                string
+	ARN                 string
+	Created             time.Time
+	PasswordEnabled     bool
+	PasswordLastUsed    time.Time
+	PasswordLastChanged time.Time
+	PasswordNextRotate  time.Time
+	MFAActive           bool
+	AccessKeys          [2]AccessKeyReport
+	Fields              map[string]string // Every column as reported
+}
+
+// IsRoot reports whether the entry is the account's root user
+func (e CredentialReportEntry) IsRoot() bool {
+	return e.User == "<root_account>"
+}
+
+// CredentialReport is a parsed credential report
+type CredentialReport struct {
+	Generated time.Time
+	Entries   []CredentialReportEntry
+}
+
+// ServiceLastAccessed is the access advisor's entry for a service a principal can use
+type ServiceLastAccessed struct {
+	ServiceName       string
+	Namespace         string
+	LastAuthenticated time.Time // Zero if never used within the tracking period
+	Region            string
+	Entity            string
+	Actions           []ActionLastAccessed
+}
+
+// ActionLastAccessed is the last use of a tracked action of a service
+type ActionLastAccessed struct {
+	Action       string
+	LastAccessed time.Time
+	Region       string
+}
+
+// CredentialReport generates the credential report, or reuses one from the last four hours
+// as IAM does, waits for it and parses it
+func (c *ReportsClient) CredentialReport(ctx context.Context) (*CredentialReport, error) {
+	for {
+		output, err := c.client.GenerateCredentialReport(ctx, &iam.GenerateCredentialReportInput{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate credential report: %w", err)
+		}
+		if output.State == types.ReportStateTypeComplete {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("credential report still generating: %w", ctx.Err())
+		case <-time.After(reportPollInterval):
+		}
+	}
+
+	output, err := c.client.GetCredentialReport(ctx, &iam.GetCredentialReportInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential report: %w", err)
+	}
+
+	entries, err := parseCredentialReport(output.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &CredentialReport{Entries: entries}
+	if output.GeneratedTime != nil {
+		report.Generated = *output.GeneratedTime
+	}
+	return report, nil
+}
+
+// ServicesLastAccessed runs the access advisor for a user, role, group or policy ARN, waits
+// for it and returns the services its policies allow, with the tracked actions used where
+// IAM reports them
+func (c *ReportsClient) ServicesLastAccessed(ctx context.Context, arn string) ([]ServiceLastAccessed, error) {
+	job, err := c.client.GenerateServiceLastAccessedDetails(ctx, &iam.GenerateServiceLastAccessedDetailsInput{
+		Arn:         aws.String(arn),
+		Granularity: types.AccessAdvisorUsageGranularityTypeActionLevel,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start access advisor report: %w", err)
+	}
+	jobID := aws.ToString(job.JobId)
+
+	var services []ServiceLastAccessed
+	var marker *string
+	for {
+		output, err := c.client.GetServiceLastAccessedDetails(ctx, &iam.GetServiceLastAccessedDetailsInput{
+			JobId:  aws.String(jobID),
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access advisor report: %w", err)
+		}
+
+		switch output.JobStatus {
+		case types.JobStatusTypeInProgress:
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("access advisor report still running: %w", ctx.Err())
+			case <-time.After(reportPollInterval):
+			}
+			continue
+		case types.JobStatusTypeFailed:
+			message := "unknown error"
+			if output.Error != nil {
+				message = aws.ToString(output.Error.Message)
+			}
+			return nil, fmt.Errorf("access advisor report failed: %s", message)
+		}
+
+		for _, s := range output.ServicesLastAccessed {
+			services = append(services, convertServiceLastAccessed(s))
+		}
+
+		if !output.IsTruncated {
+			return services, nil
+		}
+		marker = output.Marker
+	}
+}
+
+func convertServiceLastAccessed(s types.ServiceLastAccessed) ServiceLastAccessed {
+	service := ServiceLastAccessed{
+		ServiceName: aws.ToString(s.ServiceName),
+		Namespace:   aws.ToString(s.ServiceNamespace),
+		Region:      aws.ToString(s.LastAuthenticatedRegion),
+		Entity:      aws.ToString(s.LastAuthenticatedEntity),
+	}
+	if s.LastAuthenticated != nil {
+		service.LastAuthenticated = *s.LastAuthenticated
+	}
+
+	for _, a := range s.TrackedActionsLastAccessed {
+		action := ActionLastAccessed{
+			Action: aws.ToString(a.ActionName),
+			Region: aws.ToString(a.LastAccessedRegion),
+		}
+		if a.LastAccessedTime != nil {
+			action.LastAccessed = *a.LastAccessedTime
+		}
+		service.Actions = append(service.Actions, action)
+	}
+
+	return service
+}
+
+// parseCredentialReport parses the report's CSV, whose first row names the columns
+func parseCredentialReport(content []byte) ([]CredentialReportEntry, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse credential report: %w", err)
+	}
+
+	var entries []CredentialReportEntry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse credential report: %w", err)
+		}
+
+		fields := make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				fields[name] = record[i]
+			}
+		}
+
+		entry := CredentialReportEntry{
+			User:                fields["user"],
+			ARN:                 fields["arn"],
+			Created:             reportTime(fields["user_creation_time"]),
+			PasswordEnabled:     fields["password_enabled"] == "true",
+			PasswordLastUsed:    reportTime(fields["password_last_used"]),
+			PasswordLastChanged: reportTime(fields["password_last_changed"]),
+			PasswordNextRotate:  reportTime(fields["password_next_rotation"]),
+			MFAActive:           fields["mfa_active"] == "true",
+			Fields:              fields,
+		}
+		for i := range entry.AccessKeys {
+			prefix := fmt.Sprintf("access_key_%d_", i+1)
+			entry.AccessKeys[i] = AccessKeyReport{
+				Active:          fields[prefix+"active"] == "true",
+				LastRotated:     reportTime(fields[prefix+"last_rotated"]),
+				LastUsed:        reportTime(fields[prefix+"last_used_date"]),
+				LastUsedRegion:  reportValue(fields[prefix+"last_used_region"]),
+				LastUsedService: reportValue(fields[prefix+"last_used_service"]),
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// reportTime parses a report date, which is N/A, no_information or not_supported when
+// there is none
+func reportTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// reportValue drops the report's placeholders for missing values
+func reportValue(value string) string {
+	switch value {
+	case "N/A", "no_information", "not_supported":
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,165 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// serviceLinkedRolePath is the path of roles created and deleted by AWS services
+const serviceLinkedRolePath = "/aws-service-role/"
+
+// RolesClient wraps the IAM client for role usage and cleanup
+type RolesClient struct {
+	client *iam.Client
+}
+
+// NewRolesClient creates a new IAM roles client
+func NewRolesClient(client *iam.Client) *RolesClient {
+	return &RolesClient{client: client}
+}
+
+// Role is a role with when it was last used. LastUsed is zero for a role that was never
+// used, or not within the period IAM tracks.
+type Role struct {
+	Name           string
+	ARN            string
+	Path           string
+	Description    string
+	CreateDate     time.Time
+	LastUsed       time.Time
+	LastUsedRegion string
+}
+
+// ServiceLinked reports whether the role belongs to an AWS service, which deletes it
+func (r Role) ServiceLinked() bool {
+	return strings.HasPrefix(r.Path, serviceLinkedRolePath)
+}
+
+// RoleDependencies are what has to be removed from a role before it can be deleted
+type RoleDependencies struct {
+	InstanceProfiles []string
+	AttachedPolicies []string // ARNs
+	InlinePolicies   []string
+}
+
+// ListRoles lists every role. ListRoles leaves out when a role was last used, which
+// GetRole has.
+func (c *RolesClient) ListRoles(ctx context.Context) ([]Role, error) {
+	var roles []Role
+	paginator := iam.NewListRolesPaginator(c.client, &iam.ListRolesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list roles: %w", err)
+		}
+		for _, role := range output.Roles {
+			roles = append(roles, Role{
+				Name:        aws.ToString(role.RoleName),
+				ARN:         aws.ToString(role.Arn),
+				Path:        aws.ToString(role.Path),
+				Description: aws.ToString(role.Description),
+				CreateDate:  aws.ToTime(role.CreateDate),
+			})
+		}
+	}
+	return roles, nil
+}
+
+// GetRole gets a role with when it was last used
+func (c *RolesClient) GetRole(ctx context.Context, name string) (*Role, error) {
+	output, err := c.client.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(name)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role %s: %w", name, err)
+	}
+
+	role := output.Role
+	result := &Role{
+		Name:        aws.ToString(role.RoleName),
+		ARN:         aws.ToString(role.Arn),
+		Path:        aws.ToString(role.Path),
+		Description: aws.ToString(role.Description),
+		CreateDate:  aws.ToTime(role.CreateDate),
+	}
+	if role.RoleLastUsed != nil {
+		result.LastUsed = aws.ToTime(role.RoleLastUsed.LastUsedDate)
+		result.LastUsedRegion = aws.ToString(role.RoleLastUsed.Region)
+	}
+	return result, nil
+}
+
+// GetRoleDependencies lists the instance profiles and policies of a role
+func (c *RolesClient) GetRoleDependencies(ctx context.Context, name string) (*RoleDependencies, error) {
+	deps := &RoleDependencies{}
+
+	profiles := iam.NewListInstanceProfilesForRolePaginator(c.client, &iam.ListInstanceProfilesForRoleInput{RoleName: aws.String(name)})
+	for profiles.HasMorePages() {
+		output, err := profiles.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instance profiles of %s: %w", name, err)
+		}
+		for _, profile := range output.InstanceProfiles {
+			deps.InstanceProfiles = append(deps.InstanceProfiles, aws.ToString(profile.InstanceProfileName))
+		}
+	}
+
+	attached := iam.NewListAttachedRolePoliciesPaginator(c.client, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(name)})
+	for attached.HasMorePages() {
+		output, err := attached.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list policies attached to %s: %w", name, err)
+		}
+		for _, policy := range output.AttachedPolicies {
+			deps.AttachedPolicies = append(deps.AttachedPolicies, aws.ToString(policy.PolicyArn))
+		}
+	}
+
+	inline := iam.NewListRolePoliciesPaginator(c.client, &iam.ListRolePoliciesInput{RoleName: aws.String(name)})
+	for inline.HasMorePages() {
+		output, err := inline.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list inline policies of %s: %w", name, err)
+		}
+		deps.InlinePolicies = append(deps.InlinePolicies, output.PolicyNames...)
+	}
+
+	return deps, nil
+}
+
+// DeleteRole detaches a role's managed policies, deletes its inline policies and then
+// the role. Roles in an instance profile are refused, as instances may still use them.
+func (c *RolesClient) DeleteRole(ctx context.Context, name string) error {
+	deps, err := c.GetRoleDependencies(ctx, name)
+	if err != nil {
+		return err
+	}
+	if len(deps.InstanceProfiles) > 0 {
+		return fmt.Errorf("role %s is in instance profile %s", name, strings.Join(deps.InstanceProfiles, ", "))
+	}
+
+	for _, arn := range deps.AttachedPolicies {
+		if _, err := c.client.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+			RoleName:  aws.String(name),
+			PolicyArn: aws.String(arn),
+		}); err != nil {
+			return fmt.Errorf("failed to detach %s from %s: %w", arn, name, err)
+		}
+	}
+	for _, policy := range deps.InlinePolicies {
+		if _, err := c.client.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(name),
+			PolicyName: aws.String(policy),
+		}); err != nil {
+			return fmt.Errorf("failed to delete inline policy %s of %s: %w", policy, name, err)
+		}
+	}
+
+	if _, err := c.client.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String(name)}); err != nil {
+		return fmt.Errorf("failed to delete role %s: %w", name, err)
+	}
+	return nil
+}
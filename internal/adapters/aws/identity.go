@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// CallerIdentity is who the current credentials act as and where they came from
+type CallerIdentity struct {
+	Account string
+	ARN     string
+	UserID  string
+
+	// How the credentials were resolved, e.g. [profile's SSO session, SSO], and the
+	// provider that returned them
+	Chain  []string
+	Source string
+
+	// When the credentials expire, zero if they don't
+	Expires time.Time
+
+	// Role assumed from the TUI, nil for the profile's own credentials, and how the
+	// profile's credentials it was assumed with were resolved
+	AssumedRole *AssumedRole
+	BaseChain   []string
+}
+
+// credentialSourceNames describes the steps of a credential chain
+var credentialSourceNames = map[aws.CredentialSource]string{
+	aws.CredentialSourceCode:                 "credentials set in code",
+	aws.CredentialSourceEnvVars:              "environment variables",
+	aws.CredentialSourceEnvVarsSTSWebIDToken: "web identity token from the environment",
+	aws.CredentialSourceSTSAssumeRole:        "STS AssumeRole",
+	aws.CredentialSourceSTSAssumeRoleSaml:    "STS AssumeRoleWithSAML",
+	aws.CredentialSourceSTSAssumeRoleWebID:   "STS AssumeRoleWithWebIdentity",
+	aws.CredentialSourceSTSFederationToken:   "STS GetFederationToken",
+	aws.CredentialSourceSTSSessionToken:      "STS GetSessionToken",
+	aws.CredentialSourceProfile:              "profile's static keys",
+	aws.CredentialSourceProfileSourceProfile: "source_profile",
+	aws.CredentialSourceProfileNamedProvider: "profile's credential_source",
+	aws.CredentialSourceProfileSTSWebIDToken: "profile's web identity token",
+	aws.CredentialSourceProfileSSO:           "profile's SSO session",
+	aws.CredentialSourceSSO:                  "SSO",
+	aws.CredentialSourceProfileSSOLegacy:     "profile's SSO start URL",
+	aws.CredentialSourceSSOLegacy:            "SSO",
+	aws.CredentialSourceProfileProcess:       "profile's credential_process",
+	aws.CredentialSourceProcess:              "credential_process",
+	aws.CredentialSourceHTTP:                 "container credentials endpoint",
+	aws.CredentialSourceIMDS:                 "EC2 instance role",
+	aws.CredentialSourceProfileLogin:         "profile's aws login session",
+	aws.CredentialSourceLogin:                "aws login",
+}
+
+// credentialChain describes how a provider resolves its credentials, nil if it doesn't say
+func credentialChain(provider aws.CredentialsProvider) []string {
+	sources, ok := provider.(interface{ ProviderSources() []aws.CredentialSource })
+	if !ok {
+		return nil
+	}
+	var chain []string
+	for _, source := range sources.ProviderSources() {
+		if name, ok := credentialSourceNames[source]; ok {
+			chain = append(chain, name)
+		}
+	}
+	return chain
+}
+
+// Identity returns who the current credentials act as, from GetCallerIdentity, and how
+// they were resolved
+func (cm *ClientManager) Identity(ctx context.Context) (*CallerIdentity, error) {
+	cm.mu.Lock()
+	client := cm.getSTS()
+	credentials := cm.currentConfig.Credentials
+	baseCredentials := cm.baseConfig.Credentials
+	assumedRole := cm.assumedRole
+	cm.mu.Unlock()
+
+	if credentials == nil {
+		return nil, fmt.Errorf("no AWS credentials loaded, press p to select a profile")
+	}
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+	result, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &CallerIdentity{
+		Account:     aws.ToString(result.Account),
+		ARN:         aws.ToString(result.Arn),
+		UserID:      aws.ToString(result.UserId),
+		Chain:       credentialChain(credentials),
+		Source:      creds.Source,
+		AssumedRole: assumedRole,
+	}
+	if creds.CanExpire {
+		identity.Expires = creds.Expires
+	}
+	if assumedRole != nil && baseCredentials != nil {
+		identity.BaseChain = credentialChain(baseCredentials)
+	}
+	return identity, nil
+}
+
+// CredentialsExpiry returns when the credentials in use expire, zero if they don't. It
+// may refresh them first, as any call would.
+func (cm *ClientManager) CredentialsExpiry(ctx context.Context) (time.Time, error) {
+	cm.mu.RLock()
+	credentials := cm.currentConfig.Credentials
+	cm.mu.RUnlock()
+
+	if credentials == nil {
+		return time.Time{}, nil
+	}
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !creds.CanExpire {
+		return time.Time{}, nil
+	}
+	return creds.Expires, nil
+}
@@ -0,0 +1,252 @@
+package imagebuilder
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+)
+
+// PipelinesClient wraps the EC2 Image Builder client for pipeline operations
+type PipelinesClient struct {
+	client *imagebuilder.Client
+}
+
+// NewPipelinesClient creates a new Image Builder pipelines client
+func NewPipelinesClient(client *imagebuilder.Client) *PipelinesClient {
+	return &PipelinesClient{client: client}
+}
+
+// Pipeline represents an Image Builder image pipeline
+type Pipeline struct {
+	ARN                 string
+	Name                string
+	Description         string
+	Platform            string
+	Status              string // ENABLED or DISABLED
+	LastRunStatus       string // Status of the image built by the last run, e.g. AVAILABLE or FAILED
+	ConsecutiveFailures int32
+	Schedule            string
+	StartCondition      string
+	RecipeARN           string // Image or container recipe
+	InfrastructureARN   string
+	DistributionARN     string
+	ExecutionRole       string
+	EnhancedMetadata    bool
+	CreatedTime         time.Time
+	LastRunTime         time.Time
+	NextRunTime         time.Time
+	Tags                map[string]string
+}
+
+// Image is an image build, one per pipeline run
+type Image struct {
+	ARN         string
+	Name        string
+	Version     string
+	Status      string
+	Reason      string // Why the build failed, if it did
+	Pipeline    string
+	CreatedTime time.Time
+	AMIs        []AMI
+	Containers  []string // Image URIs, for container pipelines
+}
+
+// AMI is an AMI a build distributed to a region
+type AMI struct {
+	ImageID   string
+	Name      string
+	Region    string
+	AccountID string
+	Status    string
+}
+
+// ListPipelines lists all image pipelines
+func (c *PipelinesClient) ListPipelines(ctx context.Context) ([]Pipeline, error) {
+	var pipelines []Pipeline
+	var nextToken *string
+
+	for {
+		output, err := c.client.ListImagePipelines(ctx, &imagebuilder.ListImagePipelinesInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list image pipelines: %w", err)
+		}
+
+		for _, p := range output.ImagePipelineList {
+			pipelines = append(pipelines, convertPipeline(p))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return pipelines, nil
+}
+
+// GetPipeline gets a single pipeline by ARN
+func (c *PipelinesClient) GetPipeline(ctx context.Context, arn string) (*Pipeline, error) {
+	output, err := c.client.GetImagePipeline(ctx, &imagebuilder.GetImagePipelineInput{
+		ImagePipelineArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image pipeline %s: %w", arn, err)
+	}
+	if output.ImagePipeline == nil {
+		return nil, fmt.Errorf("image pipeline %s not found", arn)
+	}
+
+	pipeline := convertPipeline(*output.ImagePipeline)
+	return &pipeline, nil
+}
+
+// ListImages lists the images a pipeline built, newest first, stopping after limit
+// images unless it is 0
+func (c *PipelinesClient) ListImages(ctx context.Context, pipelineARN string, limit int) ([]Image, error) {
+	var images []Image
+	var nextToken *string
+
+	for {
+		output, err := c.client.ListImagePipelineImages(ctx, &imagebuilder.ListImagePipelineImagesInput{
+			ImagePipelineArn: aws.String(pipelineARN),
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list images of %s: %w", pipelineARN, err)
+		}
+
+		for _, summary := range output.ImageSummaryList {
+			images = append(images, convertImage(summary.Arn, summary.Name, summary.Version, summary.State,
+				summary.DateCreated, summary.OutputResources, nil))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	// The API doesn't promise an order
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].CreatedTime.After(images[j].CreatedTime)
+	})
+	if limit > 0 && len(images) > limit {
+		images = images[:limit]
+	}
+
+	return images, nil
+}
+
+// GetImage gets a single image build by its build version ARN
+func (c *PipelinesClient) GetImage(ctx context.Context, arn string) (*Image, error) {
+	output, err := c.client.GetImage(ctx, &imagebuilder.GetImageInput{
+		ImageBuildVersionArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image %s: %w", arn, err)
+	}
+	if output.Image == nil {
+		return nil, fmt.Errorf("image %s not found", arn)
+	}
+
+	img := output.Image
+	image := convertImage(img.Arn, img.Name, img.Version, img.State, img.DateCreated, img.OutputResources, img.SourcePipelineName)
+	return &image, nil
+}
+
+// StartExecution starts a run of a pipeline and returns the ARN of the image it builds
+func (c *PipelinesClient) StartExecution(ctx context.Context, pipelineARN string) (string, error) {
+	output, err := c.client.StartImagePipelineExecution(ctx, &imagebuilder.StartImagePipelineExecutionInput{
+		ImagePipelineArn: aws.String(pipelineARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start image pipeline %s: %w", pipelineARN, err)
+	}
+	return aws.ToString(output.ImageBuildVersionArn), nil
+}
+
+func convertPipeline(p types.ImagePipeline) Pipeline {
+	result := Pipeline{
+		ARN:                 aws.ToString(p.Arn),
+		Name:                aws.ToString(p.Name),
+		Description:         aws.ToString(p.Description),
+		Platform:            string(p.Platform),
+		Status:              string(p.Status),
+		LastRunStatus:       string(p.LastRunStatus),
+		ConsecutiveFailures: aws.ToInt32(p.ConsecutiveFailures),
+		RecipeARN:           aws.ToString(p.ImageRecipeArn),
+		InfrastructureARN:   aws.ToString(p.InfrastructureConfigurationArn),
+		DistributionARN:     aws.ToString(p.DistributionConfigurationArn),
+		ExecutionRole:       aws.ToString(p.ExecutionRole),
+		EnhancedMetadata:    aws.ToBool(p.EnhancedImageMetadataEnabled),
+		CreatedTime:         parseDate(p.DateCreated),
+		LastRunTime:         parseDate(p.DateLastRun),
+		NextRunTime:         parseDate(p.DateNextRun),
+		Tags:                p.Tags,
+	}
+	if result.RecipeARN == "" {
+		result.RecipeARN = aws.ToString(p.ContainerRecipeArn)
+	}
+	if s := p.Schedule; s != nil {
+		result.Schedule = aws.ToString(s.ScheduleExpression)
+		if tz := aws.ToString(s.Timezone); tz != "" && result.Schedule != "" {
+			result.Schedule += " " + tz
+		}
+		result.StartCondition = string(s.PipelineExecutionStartCondition)
+	}
+	if result.Tags == nil {
+		result.Tags = make(map[string]string)
+	}
+	return result
+}
+
+func convertImage(arn, name, version *string, state *types.ImageState, created *string, outputs *types.OutputResources, pipeline *string) Image {
+	image := Image{
+		ARN:         aws.ToString(arn),
+		Name:        aws.ToString(name),
+		Version:     aws.ToString(version),
+		Pipeline:    aws.ToString(pipeline),
+		CreatedTime: parseDate(created),
+	}
+	if state != nil {
+		image.Status = string(state.Status)
+		image.Reason = aws.ToString(state.Reason)
+	}
+	if outputs != nil {
+		for _, ami := range outputs.Amis {
+			converted := AMI{
+				ImageID:   aws.ToString(ami.Image),
+				Name:      aws.ToString(ami.Name),
+				Region:    aws.ToString(ami.Region),
+				AccountID: aws.ToString(ami.AccountId),
+			}
+			if ami.State != nil {
+				converted.Status = string(ami.State.Status)
+			}
+			image.AMIs = append(image.AMIs, converted)
+		}
+		for _, container := range outputs.Containers {
+			image.Containers = append(image.Containers, container.ImageUris...)
+		}
+	}
+	return image
+}
+
+// parseDate parses the ISO 8601 dates Image Builder returns as strings
+func parseDate(s *string) time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
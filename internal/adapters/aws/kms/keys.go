@@ -34,9 +34,29 @@ type Key struct {
 	CreationDate  time.Time
 	Enabled       bool
 	CustomerOwned bool
+	DeletionDate  time.Time // Set while the key is pending deletion
 	Tags          map[string]string
 }
 
+// Rotation is the automatic rotation configuration of a key
+type Rotation struct {
+	Enabled          bool
+	PeriodInDays     int32
+	NextRotationDate time.Time
+}
+
+// Grant is a grant allowing a principal to use a key
+type Grant struct {
+	GrantID           string
+	Name              string
+	GranteePrincipal  string
+	RetiringPrincipal string
+	IssuingAccount    string
+	Operations        []string
+	Constraints       map[string]interface{}
+	CreationDate      time.Time
+}
+
 // ListKeys lists all KMS keys with their aliases
 func (c *KeysClient) ListKeys(ctx context.Context) ([]Key, error) {
 	// First, get all aliases to map them to keys
@@ -151,10 +171,76 @@ func (c *KeysClient) GetKey(ctx context.Context, keyID string) (*Key, error) {
 	if metadata.CreationDate != nil {
 		key.CreationDate = *metadata.CreationDate
 	}
+	if metadata.DeletionDate != nil {
+		key.DeletionDate = *metadata.DeletionDate
+	}
 
 	return key, nil
 }
 
+// ListAliases lists every alias pointing at a key
+func (c *KeysClient) ListAliases(ctx context.Context, keyID string) ([]string, error) {
+	var aliases []string
+	paginator := kms.NewListAliasesPaginator(c.client, &kms.ListAliasesInput{
+		KeyId: aws.String(keyID),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list aliases: %w", err)
+		}
+		for _, alias := range output.Aliases {
+			aliases = append(aliases, aws.ToString(alias.AliasName))
+		}
+	}
+
+	return aliases, nil
+}
+
+// ListGrants lists the grants of a key
+func (c *KeysClient) ListGrants(ctx context.Context, keyID string) ([]Grant, error) {
+	var grants []Grant
+	paginator := kms.NewListGrantsPaginator(c.client, &kms.ListGrantsInput{
+		KeyId: aws.String(keyID),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list grants: %w", err)
+		}
+
+		for _, g := range output.Grants {
+			grant := Grant{
+				GrantID:           aws.ToString(g.GrantId),
+				Name:              aws.ToString(g.Name),
+				GranteePrincipal:  aws.ToString(g.GranteePrincipal),
+				RetiringPrincipal: aws.ToString(g.RetiringPrincipal),
+				IssuingAccount:    aws.ToString(g.IssuingAccount),
+			}
+			for _, op := range g.Operations {
+				grant.Operations = append(grant.Operations, string(op))
+			}
+			if g.Constraints != nil {
+				grant.Constraints = make(map[string]interface{})
+				if len(g.Constraints.EncryptionContextEquals) > 0 {
+					grant.Constraints["EncryptionContextEquals"] = g.Constraints.EncryptionContextEquals
+				}
+				if len(g.Constraints.EncryptionContextSubset) > 0 {
+					grant.Constraints["EncryptionContextSubset"] = g.Constraints.EncryptionContextSubset
+				}
+			}
+			if g.CreationDate != nil {
+				grant.CreationDate = *g.CreationDate
+			}
+			grants = append(grants, grant)
+		}
+	}
+
+	return grants, nil
+}
+
 // GetKeyPolicy gets the key policy for a KMS key
 func (c *KeysClient) GetKeyPolicy(ctx context.Context, keyID string) (string, error) {
 	output, err := c.client.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{
@@ -169,15 +255,94 @@ func (c *KeysClient) GetKeyPolicy(ctx context.Context, keyID string) (string, er
 }
 
 // GetKeyRotationStatus gets the rotation status for a KMS key
-func (c *KeysClient) GetKeyRotationStatus(ctx context.Context, keyID string) (bool, error) {
+func (c *KeysClient) GetKeyRotationStatus(ctx context.Context, keyID string) (*Rotation, error) {
 	output, err := c.client.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	})
 	if err != nil {
-		return false, err // Rotation status not available for all key types
+		return nil, err // Rotation status not available for all key types
+	}
+
+	rotation := &Rotation{
+		Enabled:      output.KeyRotationEnabled,
+		PeriodInDays: aws.ToInt32(output.RotationPeriodInDays),
+	}
+	if output.NextRotationDate != nil {
+		rotation.NextRotationDate = *output.NextRotationDate
+	}
+	return rotation, nil
+}
+
+// EnableKeyRotation turns on automatic rotation of a key, once a year
+func (c *KeysClient) EnableKeyRotation(ctx context.Context, keyID string) error {
+	_, err := c.client.EnableKeyRotation(ctx, &kms.EnableKeyRotationInput{
+		KeyId:                aws.String(keyID),
+		RotationPeriodInDays: aws.Int32(365),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enable key rotation: %w", err)
+	}
+	return nil
+}
+
+// DisableKeyRotation turns off automatic rotation of a key
+func (c *KeysClient) DisableKeyRotation(ctx context.Context, keyID string) error {
+	_, err := c.client.DisableKeyRotation(ctx, &kms.DisableKeyRotationInput{
+		KeyId: aws.String(keyID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to disable key rotation: %w", err)
+	}
+	return nil
+}
+
+// ScheduleKeyDeletion schedules a key for deletion after a waiting period of 7 to 30 days
+// and returns the deletion date
+func (c *KeysClient) ScheduleKeyDeletion(ctx context.Context, keyID string, pendingWindowDays int32) (time.Time, error) {
+	output, err := c.client.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(keyID),
+		PendingWindowInDays: aws.Int32(pendingWindowDays),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to schedule key deletion: %w", err)
+	}
+	return aws.ToTime(output.DeletionDate), nil
+}
+
+// CancelKeyDeletion cancels a scheduled deletion. The key is left disabled.
+func (c *KeysClient) CancelKeyDeletion(ctx context.Context, keyID string) error {
+	_, err := c.client.CancelKeyDeletion(ctx, &kms.CancelKeyDeletionInput{
+		KeyId: aws.String(keyID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel key deletion: %w", err)
 	}
+	return nil
+}
 
-	return output.KeyRotationEnabled, nil
+// UpdateTags adds or changes the tags in set on a key and removes the keys in remove
+func (c *KeysClient) UpdateTags(ctx context.Context, keyID string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		tags := make([]types.Tag, 0, len(set))
+		for key, value := range set {
+			tags = append(tags, types.Tag{TagKey: aws.String(key), TagValue: aws.String(value)})
+		}
+		if _, err := c.client.TagResource(ctx, &kms.TagResourceInput{
+			KeyId: aws.String(keyID),
+			Tags:  tags,
+		}); err != nil {
+			return fmt.Errorf("failed to tag key %s: %w", keyID, err)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := c.client.UntagResource(ctx, &kms.UntagResourceInput{
+			KeyId:   aws.String(keyID),
+			TagKeys: remove,
+		}); err != nil {
+			return fmt.Errorf("failed to remove tags from key %s: %w", keyID, err)
+		}
+	}
+	return nil
 }
 
 func (c *KeysClient) getAliasMap(ctx context.Context) (map[string]string, error) {
@@ -2,6 +2,7 @@ package lambda
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"time"
 
@@ -90,6 +91,45 @@ func (c *FunctionsClient) GetFunction(ctx context.Context, functionName string)
 	return &fn, nil
 }
 
+// InvokeResult is the outcome of a synchronous invocation
+type InvokeResult struct {
+	StatusCode      int32
+	FunctionError   string // Set when the function itself failed, e.g. Unhandled
+	ExecutedVersion string
+	Payload         string
+	LogTail         string // Last 4 KB of the execution log
+	Duration        time.Duration
+}
+
+// Invoke invokes a function synchronously with a JSON payload and returns its response and log tail
+func (c *FunctionsClient) Invoke(ctx context.Context, functionName, payload string) (*InvokeResult, error) {
+	start := time.Now()
+	output, err := c.client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: types.InvocationTypeRequestResponse,
+		LogType:        types.LogTypeTail,
+		Payload:        []byte(payload),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke function %s: %w", functionName, err)
+	}
+
+	result := &InvokeResult{
+		StatusCode:      output.StatusCode,
+		FunctionError:   aws.ToString(output.FunctionError),
+		ExecutedVersion: aws.ToString(output.ExecutedVersion),
+		Payload:         string(output.Payload),
+		Duration:        time.Since(start),
+	}
+	if output.LogResult != nil {
+		if logs, err := base64.StdEncoding.DecodeString(*output.LogResult); err == nil {
+			result.LogTail = string(logs)
+		}
+	}
+
+	return result, nil
+}
+
 func convertFunction(fn types.FunctionConfiguration) Function {
 	return convertFunctionConfig(fn)
 }
@@ -135,3 +175,24 @@ func convertFunctionConfig(fn types.FunctionConfiguration) Function {
 
 	return result
 }
+
+// UpdateTags adds or changes the tags in set on a function and removes the keys in remove
+func (c *FunctionsClient) UpdateTags(ctx context.Context, functionARN string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		if _, err := c.client.TagResource(ctx, &lambda.TagResourceInput{
+			Resource: aws.String(functionARN),
+			Tags:     set,
+		}); err != nil {
+			return fmt.Errorf("failed to tag function: %w", err)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := c.client.UntagResource(ctx, &lambda.UntagResourceInput{
+			Resource: aws.String(functionARN),
+			TagKeys:  remove,
+		}); err != nil {
+			return fmt.Errorf("failed to remove tags from function: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+// VersionsClient wraps the Lambda client for version, alias and concurrency operations
+type VersionsClient struct {
+	client *lambda.Client
+}
+
+// NewVersionsClient creates a new Lambda versions client
+func NewVersionsClient(client *lambda.Client) *VersionsClient {
+	return &VersionsClient{client: client}
+}
+
+// FunctionVersion represents a published version of a function, or $LATEST
+type FunctionVersion struct {
+	Version      string
+	FunctionARN  string
+	Description  string
+	Runtime      string
+	CodeSha256   string
+	CodeSize     int64
+	MemorySize   int32
+	State        string
+	LastModified time.Time
+}
+
+// Alias represents a function alias and the version(s) it points to
+type Alias struct {
+	Name            string
+	AliasARN        string
+	FunctionVersion string
+	Description     string
+	RoutingWeights  map[string]float64 // Additional versions receiving a share of traffic
+}
+
+// ProvisionedConcurrency represents the provisioned concurrency of a version or alias
+type ProvisionedConcurrency struct {
+	Qualifier    string
+	Requested    int32
+	Allocated    int32
+	Available    int32
+	Status       string
+	StatusReason string
+	LastModified time.Time
+}
+
+// AccountConcurrency holds the account-wide concurrency limits in the region
+type AccountConcurrency struct {
+	Limit      int32
+	Unreserved int32
+}
+
+// ListVersions lists all versions of a function, including $LATEST
+func (c *VersionsClient) ListVersions(ctx context.Context, functionName string) ([]FunctionVersion, error) {
+	var versions []FunctionVersion
+	var marker *string
+
+	for {
+		output, err := c.client.ListVersionsByFunction(ctx, &lambda.ListVersionsByFunctionInput{
+			FunctionName: aws.String(functionName),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions of %s: %w", functionName, err)
+		}
+
+		for _, fn := range output.Versions {
+			versions = append(versions, convertFunctionVersion(fn))
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return versions, nil
+}
+
+// ListAliases lists all aliases of a function
+func (c *VersionsClient) ListAliases(ctx context.Context, functionName string) ([]Alias, error) {
+	var aliases []Alias
+	var marker *string
+
+	for {
+		output, err := c.client.ListAliases(ctx, &lambda.ListAliasesInput{
+			FunctionName: aws.String(functionName),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list aliases of %s: %w", functionName, err)
+		}
+
+		for _, alias := range output.Aliases {
+			aliases = append(aliases, convertAlias(alias))
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return aliases, nil
+}
+
+// ListProvisionedConcurrency lists the provisioned concurrency configs of a function, keyed by version or alias
+func (c *VersionsClient) ListProvisionedConcurrency(ctx context.Context, functionName string) (map[string]ProvisionedConcurrency, error) {
+	configs := make(map[string]ProvisionedConcurrency)
+	var marker *string
+
+	for {
+		output, err := c.client.ListProvisionedConcurrencyConfigs(ctx, &lambda.ListProvisionedConcurrencyConfigsInput{
+			FunctionName: aws.String(functionName),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list provisioned concurrency of %s: %w", functionName, err)
+		}
+
+		for _, item := range output.ProvisionedConcurrencyConfigs {
+			pc := convertProvisionedConcurrency(item)
+			configs[pc.Qualifier] = pc
+		}
+
+		if output.NextMarker == nil {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return configs, nil
+}
+
+// GetReservedConcurrency gets the reserved concurrency of a function, reporting false if none is set
+func (c *VersionsClient) GetReservedConcurrency(ctx context.Context, functionName string) (int32, bool, error) {
+	output, err := c.client.GetFunctionConcurrency(ctx, &lambda.GetFunctionConcurrencyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get concurrency of %s: %w", functionName, err)
+	}
+
+	if output.ReservedConcurrentExecutions == nil {
+		return 0, false, nil
+	}
+	return *output.ReservedConcurrentExecutions, true, nil
+}
+
+// GetAccountConcurrency gets the account concurrency limit and how much of it is unreserved
+func (c *VersionsClient) GetAccountConcurrency(ctx context.Context) (*AccountConcurrency, error) {
+	output, err := c.client.GetAccountSettings(ctx, &lambda.GetAccountSettingsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account settings: %w", err)
+	}
+
+	result := &AccountConcurrency{}
+	if output.AccountLimit != nil {
+		result.Limit = output.AccountLimit.ConcurrentExecutions
+		result.Unreserved = aws.ToInt32(output.AccountLimit.UnreservedConcurrentExecutions)
+	}
+
+	return result, nil
+}
+
+// PublishVersion publishes the current $LATEST code and configuration as a new version
+func (c *VersionsClient) PublishVersion(ctx context.Context, functionName, description string) (*FunctionVersion, error) {
+	input := &lambda.PublishVersionInput{
+		FunctionName: aws.String(functionName),
+	}
+	if description != "" {
+		input.Description = aws.String(description)
+	}
+
+	output, err := c.client.PublishVersion(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish version of %s: %w", functionName, err)
+	}
+
+	version := FunctionVersion{
+		Version:     aws.ToString(output.Version),
+		FunctionARN: aws.ToString(output.FunctionArn),
+		Description: aws.ToString(output.Description),
+		CodeSha256:  aws.ToString(output.CodeSha256),
+	}
+	return &version, nil
+}
+
+// UpdateAlias points an alias at a version, clearing any weighted routing
+func (c *VersionsClient) UpdateAlias(ctx context.Context, functionName, aliasName, version string) error {
+	_, err := c.client.UpdateAlias(ctx, &lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		Name:            aws.String(aliasName),
+		FunctionVersion: aws.String(version),
+		RoutingConfig:   &types.AliasRoutingConfiguration{},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update alias %s of %s: %w", aliasName, functionName, err)
+	}
+	return nil
+}
+
+// PutReservedConcurrency sets the reserved concurrency of a function
+func (c *VersionsClient) PutReservedConcurrency(ctx context.Context, functionName string, reserved int32) error {
+	_, err := c.client.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
+		FunctionName:                 aws.String(functionName),
+		ReservedConcurrentExecutions: aws.Int32(reserved),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set concurrency of %s: %w", functionName, err)
+	}
+	return nil
+}
+
+// DeleteReservedConcurrency removes the reserved concurrency of a function, so it uses the unreserved pool
+func (c *VersionsClient) DeleteReservedConcurrency(ctx context.Context, functionName string) error {
+	_, err := c.client.DeleteFunctionConcurrency(ctx, &lambda.DeleteFunctionConcurrencyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove concurrency of %s: %w", functionName, err)
+	}
+	return nil
+}
+
+func convertFunctionVersion(fn types.FunctionConfiguration) FunctionVersion {
+	config := convertFunctionConfig(fn)
+	return FunctionVersion{
+		Version:      aws.ToString(fn.Version),
+		FunctionARN:  config.FunctionARN,
+		Description:  config.Description,
+		Runtime:      config.Runtime,
+		CodeSha256:   aws.ToString(fn.CodeSha256),
+		CodeSize:     config.CodeSize,
+		MemorySize:   config.MemorySize,
+		State:        config.State,
+		LastModified: config.LastModified,
+	}
+}
+
+func convertAlias(alias types.AliasConfiguration) Alias {
+	result := Alias{
+		Name:            aws.ToString(alias.Name),
+		AliasARN:        aws.ToString(alias.AliasArn),
+		FunctionVersion: aws.ToString(alias.FunctionVersion),
+		Description:     aws.ToString(alias.Description),
+	}
+
+	if alias.RoutingConfig != nil && len(alias.RoutingConfig.AdditionalVersionWeights) > 0 {
+		result.RoutingWeights = alias.RoutingConfig.AdditionalVersionWeights
+	}
+
+	return result
+}
+
+func convertProvisionedConcurrency(item types.ProvisionedConcurrencyConfigListItem) ProvisionedConcurrency {
+	// The qualifier is the last segment of the function ARN
+	arn := aws.ToString(item.FunctionArn)
+	qualifier := arn[strings.LastIndex(arn, ":")+1:]
+
+	result := ProvisionedConcurrency{
+		Qualifier:    qualifier,
+		Requested:    aws.ToInt32(item.RequestedProvisionedConcurrentExecutions),
+		Allocated:    aws.ToInt32(item.AllocatedProvisionedConcurrentExecutions),
+		Available:    aws.ToInt32(item.AvailableProvisionedConcurrentExecutions),
+		Status:       string(item.Status),
+		StatusReason: aws.ToString(item.StatusReason),
+	}
+
+	if item.LastModified != nil {
+		if t, err := time.Parse(time.RFC3339, *item.LastModified); err == nil {
+			result.LastModified = t
+		}
+	}
+
+	return result
+}
@@ -3,6 +3,7 @@ package logs
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -24,6 +25,7 @@ func NewLogsClient(client *cloudwatchlogs.Client) *LogsClient {
 type LogGroup struct {
 	Name            string
 	Arn             string
+	IdentifierArn   string // ARN without the trailing :*, how a linked source account's group is read
 	CreatedAt       time.Time
 	RetentionInDays int32
 	StoredBytes     int64
@@ -43,42 +45,87 @@ type LogStream struct {
 
 // LogEvent represents a CloudWatch log event
 type LogEvent struct {
+	EventID       string // Only set by FilterLogEventsSince
+	Stream        string // Only set by FilterLogEventsSince
 	Timestamp     time.Time
 	Message       string
 	IngestionTime time.Time
 }
 
-// ListLogGroups lists all log groups with pagination
-func (c *LogsClient) ListLogGroups(ctx context.Context) ([]LogGroup, error) {
+// ListLogGroups lists log groups with pagination, stopping after maxItems unless it is 0.
+// It reports whether more log groups exist past the cap.
+func (c *LogsClient) ListLogGroups(ctx context.Context, maxItems int) ([]LogGroup, bool, error) {
+	return c.listLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{}, maxItems)
+}
+
+// ListLogGroupsInAccount lists the log groups of a source account linked to this monitoring
+// account through CloudWatch cross-account observability
+func (c *LogsClient) ListLogGroupsInAccount(ctx context.Context, accountID string, maxItems int) ([]LogGroup, bool, error) {
+	return c.listLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		AccountIdentifiers:    []string{accountID},
+		IncludeLinkedAccounts: aws.Bool(true),
+	}, maxItems)
+}
+
+// SearchLogGroups lists the log groups whose names contain pattern, which DescribeLogGroups
+// matches case-sensitively. A non-empty accountID searches a linked source account.
+func (c *LogsClient) SearchLogGroups(ctx context.Context, accountID, pattern string, maxItems int) ([]LogGroup, bool, error) {
+	input := &cloudwatchlogs.DescribeLogGroupsInput{LogGroupNamePattern: aws.String(pattern)}
+	if accountID != "" {
+		input.AccountIdentifiers = []string{accountID}
+		input.IncludeLinkedAccounts = aws.Bool(true)
+	}
+	return c.listLogGroups(ctx, input, maxItems)
+}
+
+func (c *LogsClient) listLogGroups(ctx context.Context, input *cloudwatchlogs.DescribeLogGroupsInput, maxItems int) ([]LogGroup, bool, error) {
 	var logGroups []LogGroup
 
-	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(c.client, &cloudwatchlogs.DescribeLogGroupsInput{})
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(c.client, input)
 
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to describe log groups: %w", err)
+			return nil, false, fmt.Errorf("failed to describe log groups: %w", err)
 		}
 
 		for _, group := range page.LogGroups {
-			lg := LogGroup{
-				Name:            aws.ToString(group.LogGroupName),
-				Arn:             aws.ToString(group.Arn),
-				CreatedAt:       timeFromMillis(group.CreationTime),
-				RetentionInDays: aws.ToInt32(group.RetentionInDays),
-				StoredBytes:     aws.ToInt64(group.StoredBytes),
-				Tags:            make(map[string]string), // Tags require separate API call
+			if maxItems > 0 && len(logGroups) == maxItems {
+				return logGroups, true, nil
 			}
 
-			logGroups = append(logGroups, lg)
+			logGroups = append(logGroups, convertLogGroup(group))
 		}
 	}
 
-	return logGroups, nil
+	return logGroups, false, nil
 }
 
-// GetLogGroup gets details of a specific log group
+// ListLogGroupNames lists the names of the log groups that start with prefix, stopping
+// after maxItems unless it is 0
+func (c *LogsClient) ListLogGroupNames(ctx context.Context, prefix string, maxItems int) ([]string, bool, error) {
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	if prefix != "" {
+		input.LogGroupNamePrefix = aws.String(prefix)
+	}
+	groups, truncated, err := c.listLogGroups(ctx, input, maxItems)
+	if err != nil {
+		return nil, false, err
+	}
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.Name)
+	}
+	return names, truncated, nil
+}
+
+// GetLogGroup gets details of a specific log group, by name or, for a log group in a linked
+// source account, by ARN
 func (c *LogsClient) GetLogGroup(ctx context.Context, groupName string) (*LogGroup, error) {
+	if strings.HasPrefix(groupName, "arn:") {
+		return c.getLinkedLogGroup(ctx, groupName)
+	}
+
 	output, err := c.client.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
 		LogGroupNamePrefix: aws.String(groupName),
 		Limit:              aws.Int32(1),
@@ -98,55 +145,177 @@ func (c *LogsClient) GetLogGroup(ctx context.Context, groupName string) (*LogGro
 		return nil, fmt.Errorf("log group %s not found", groupName)
 	}
 
-	lg := &LogGroup{
+	lg := convertLogGroup(group)
+	return &lg, nil
+}
+
+func (c *LogsClient) getLinkedLogGroup(ctx context.Context, arn string) (*LogGroup, error) {
+	output, err := c.client.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupIdentifiers:   []string{arn},
+		IncludeLinkedAccounts: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe log group %s: %w", arn, err)
+	}
+
+	if len(output.LogGroups) == 0 {
+		return nil, fmt.Errorf("log group %s not found", arn)
+	}
+
+	lg := convertLogGroup(output.LogGroups[0])
+	return &lg, nil
+}
+
+// LogGroupTags gets the tags of a log group, by its ARN without the trailing :*
+func (c *LogsClient) LogGroupTags(ctx context.Context, arn string) (map[string]string, error) {
+	output, err := c.client.ListTagsForResource(ctx, &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log group tags: %w", err)
+	}
+	if output.Tags == nil {
+		return map[string]string{}, nil
+	}
+	return output.Tags, nil
+}
+
+// UpdateLogGroupTags adds or changes the tags in set on a log group and removes the keys
+// in remove
+func (c *LogsClient) UpdateLogGroupTags(ctx context.Context, arn string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		if _, err := c.client.TagResource(ctx, &cloudwatchlogs.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        set,
+		}); err != nil {
+			return fmt.Errorf("failed to tag log group: %w", err)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := c.client.UntagResource(ctx, &cloudwatchlogs.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     remove,
+		}); err != nil {
+			return fmt.Errorf("failed to remove tags from log group: %w", err)
+		}
+	}
+	return nil
+}
+
+// LogGroupNameFromARN returns the name in a log group ARN,
+// arn:aws:logs:region:account:log-group:name with an optional trailing :*
+func LogGroupNameFromARN(arn string) string {
+	_, name, found := strings.Cut(arn, ":log-group:")
+	if !found {
+		return arn
+	}
+	return strings.TrimSuffix(name, ":*")
+}
+
+func convertLogGroup(group types.LogGroup) LogGroup {
+	return LogGroup{
 		Name:            aws.ToString(group.LogGroupName),
 		Arn:             aws.ToString(group.Arn),
+		IdentifierArn:   aws.ToString(group.LogGroupArn),
 		CreatedAt:       timeFromMillis(group.CreationTime),
 		RetentionInDays: aws.ToInt32(group.RetentionInDays),
 		StoredBytes:     aws.ToInt64(group.StoredBytes),
 		Tags:            make(map[string]string), // Tags require separate API call
 	}
-
-	return lg, nil
 }
 
-// ListLogStreams lists all log streams in a log group
-func (c *LogsClient) ListLogStreams(ctx context.Context, groupName string) ([]LogStream, error) {
+// ListLogStreams lists the log streams in a log group, most recent first, stopping after
+// maxItems unless it is 0. It reports whether more log streams exist past the cap.
+func (c *LogsClient) ListLogStreams(ctx context.Context, groupName string, maxItems int) ([]LogStream, bool, error) {
 	var logStreams []LogStream
 
 	paginator := cloudwatchlogs.NewDescribeLogStreamsPaginator(c.client, &cloudwatchlogs.DescribeLogStreamsInput{
-		LogGroupName: aws.String(groupName),
-		OrderBy:      types.OrderByLastEventTime,
-		Descending:   aws.Bool(true),
+		LogGroupIdentifier: aws.String(groupName),
+		OrderBy:            types.OrderByLastEventTime,
+		Descending:         aws.Bool(true),
 	})
 
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to describe log streams for group %s: %w", groupName, err)
+			return nil, false, fmt.Errorf("failed to describe log streams for group %s: %w", groupName, err)
 		}
 
 		for _, stream := range page.LogStreams {
-			ls := LogStream{
-				Name:                aws.ToString(stream.LogStreamName),
-				CreatedAt:           timeFromMillis(stream.CreationTime),
-				LastIngestionTime:   timeFromMillis(stream.LastIngestionTime),
-				StoredBytes:         aws.ToInt64(stream.StoredBytes),
-				UploadSequenceToken: aws.ToString(stream.UploadSequenceToken),
+			if maxItems > 0 && len(logStreams) == maxItems {
+				return logStreams, true, nil
 			}
+			logStreams = append(logStreams, convertLogStream(stream))
+		}
+	}
 
-			if stream.FirstEventTimestamp != nil {
-				ls.FirstEventTime = timeFromMillis(stream.FirstEventTimestamp)
-			}
-			if stream.LastEventTimestamp != nil {
-				ls.LastEventTime = timeFromMillis(stream.LastEventTimestamp)
-			}
+	return logStreams, false, nil
+}
 
-			logStreams = append(logStreams, ls)
+// ListLogStreamsPage lists one page of up to limit log streams, most recent first, from
+// the page token. It returns the token of the next page, or "" after the last.
+func (c *LogsClient) ListLogStreamsPage(ctx context.Context, groupName, token string, limit int) ([]LogStream, string, error) {
+	input := &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupIdentifier: aws.String(groupName),
+		OrderBy:            types.OrderByLastEventTime,
+		Descending:         aws.Bool(true),
+		Limit:              aws.Int32(int32(min(limit, 50))), // The API's maximum
+	}
+	if token != "" {
+		input.NextToken = aws.String(token)
+	}
+
+	output, err := c.client.DescribeLogStreams(ctx, input)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to describe log streams for group %s: %w", groupName, err)
+	}
+
+	logStreams := make([]LogStream, 0, len(output.LogStreams))
+	for _, stream := range output.LogStreams {
+		logStreams = append(logStreams, convertLogStream(stream))
+	}
+	return logStreams, aws.ToString(output.NextToken), nil
+}
+
+func convertLogStream(stream types.LogStream) LogStream {
+	ls := LogStream{
+		Name:                aws.ToString(stream.LogStreamName),
+		CreatedAt:           timeFromMillis(stream.CreationTime),
+		LastIngestionTime:   timeFromMillis(stream.LastIngestionTime),
+		StoredBytes:         aws.ToInt64(stream.StoredBytes),
+		UploadSequenceToken: aws.ToString(stream.UploadSequenceToken),
+	}
+
+	if stream.FirstEventTimestamp != nil {
+		ls.FirstEventTime = timeFromMillis(stream.FirstEventTimestamp)
+	}
+	if stream.LastEventTimestamp != nil {
+		ls.LastEventTime = timeFromMillis(stream.LastEventTimestamp)
+	}
+	return ls
+}
+
+// ListLogStreamNames lists the names of the log streams in a log group that start with prefix
+func (c *LogsClient) ListLogStreamNames(ctx context.Context, groupName, prefix string) ([]string, error) {
+	var names []string
+
+	paginator := cloudwatchlogs.NewDescribeLogStreamsPaginator(c.client, &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupIdentifier:  aws.String(groupName),
+		LogStreamNamePrefix: aws.String(prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe log streams for group %s: %w", groupName, err)
+		}
+
+		for _, stream := range page.LogStreams {
+			names = append(names, aws.ToString(stream.LogStreamName))
 		}
 	}
 
-	return logStreams, nil
+	return names, nil
 }
 
 // GetLogEvents gets log events from a specific log stream
@@ -156,10 +325,10 @@ func (c *LogsClient) GetLogEvents(ctx context.Context, groupName, streamName str
 	}
 
 	output, err := c.client.GetLogEvents(ctx, &cloudwatchlogs.GetLogEventsInput{
-		LogGroupName:  aws.String(groupName),
-		LogStreamName: aws.String(streamName),
-		Limit:         aws.Int32(int32(limit)),
-		StartFromHead: aws.Bool(false), // Get most recent events
+		LogGroupIdentifier: aws.String(groupName),
+		LogStreamName:      aws.String(streamName),
+		Limit:              aws.Int32(int32(limit)),
+		StartFromHead:      aws.Bool(false), // Get most recent events
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get log events for stream %s in group %s: %w", streamName, groupName, err)
@@ -177,10 +346,78 @@ func (c *LogsClient) GetLogEvents(ctx context.Context, groupName, streamName str
 	return logEvents, nil
 }
 
+// TailLogEvents gets log events newer than the given forward token. With an empty token it
+// returns the most recent events. The returned token is passed to the next call to continue
+// from where this one stopped; it is unchanged when there are no new events.
+func (c *LogsClient) TailLogEvents(ctx context.Context, groupName, streamName, token string, limit int) ([]LogEvent, string, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	input := &cloudwatchlogs.GetLogEventsInput{
+		LogGroupIdentifier: aws.String(groupName),
+		LogStreamName:      aws.String(streamName),
+		Limit:              aws.Int32(int32(limit)),
+		StartFromHead:      aws.Bool(token != ""),
+	}
+	if token != "" {
+		input.NextToken = aws.String(token)
+	}
+
+	output, err := c.client.GetLogEvents(ctx, input)
+	if err != nil {
+		return nil, token, fmt.Errorf("failed to get log events for stream %s in group %s: %w", streamName, groupName, err)
+	}
+
+	logEvents := make([]LogEvent, 0, len(output.Events))
+	for _, event := range output.Events {
+		logEvents = append(logEvents, LogEvent{
+			Timestamp:     timeFromMillis(event.Timestamp),
+			Message:       aws.ToString(event.Message),
+			IngestionTime: timeFromMillis(event.IngestionTime),
+		})
+	}
+
+	return logEvents, aws.ToString(output.NextForwardToken), nil
+}
+
+// FilterLogEventsSince gets the events of every stream in a log group from since onwards,
+// oldest first, stopping after limit events
+func (c *LogsClient) FilterLogEventsSince(ctx context.Context, groupName string, since time.Time, limit int) ([]LogEvent, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	paginator := cloudwatchlogs.NewFilterLogEventsPaginator(c.client, &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupIdentifier: aws.String(groupName),
+		StartTime:          aws.Int64(since.UnixMilli()),
+		Limit:              aws.Int32(int32(limit)),
+	})
+
+	var logEvents []LogEvent
+	for paginator.HasMorePages() && len(logEvents) < limit {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter log events in group %s: %w", groupName, err)
+		}
+		for _, event := range page.Events {
+			logEvents = append(logEvents, LogEvent{
+				EventID:       aws.ToString(event.EventId),
+				Stream:        aws.ToString(event.LogStreamName),
+				Timestamp:     timeFromMillis(event.Timestamp),
+				Message:       aws.ToString(event.Message),
+				IngestionTime: timeFromMillis(event.IngestionTime),
+			})
+		}
+	}
+
+	return logEvents, nil
+}
+
 // Helper function to convert milliseconds to time.Time
 func timeFromMillis(millis *int64) time.Time {
 	if millis == nil || *millis == 0 {
 		return time.Time{}
 	}
-	return time.UnixMilli(*millis)
+	return time.UnixMilli(*millis).UTC()
 }
@@ -0,0 +1,180 @@
+package mq
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+)
+
+// BrokersClient wraps the Amazon MQ client for broker operations
+type BrokersClient struct {
+	client *mq.Client
+}
+
+// NewBrokersClient creates a new Amazon MQ brokers client
+func NewBrokersClient(client *mq.Client) *BrokersClient {
+	return &BrokersClient{client: client}
+}
+
+// Broker represents an Amazon MQ broker as returned by listing
+type Broker struct {
+	BrokerID         string
+	BrokerARN        string
+	BrokerName       string
+	State            string
+	EngineType       string
+	DeploymentMode   string
+	HostInstanceType string
+	CreatedTime      time.Time
+}
+
+// BrokerDetails represents a fully described Amazon MQ broker
+type BrokerDetails struct {
+	Broker
+	EngineVersion           string
+	AutoMinorVersionUpgrade bool
+	PubliclyAccessible      bool
+	StorageType             string
+	AuthenticationStrategy  string
+	MaintenanceWindow       string
+	SecurityGroups          []string
+	SubnetIDs               []string
+	Instances               []BrokerInstance
+	Users                   []BrokerUser
+	PendingEngineVersion    string
+	PendingInstanceType     string
+	Tags                    map[string]string
+}
+
+// BrokerInstance is a single broker node with its endpoints
+type BrokerInstance struct {
+	ConsoleURL string
+	IPAddress  string
+	Endpoints  []string
+}
+
+// BrokerUser is a broker user, with any change waiting for the next reboot
+type BrokerUser struct {
+	Username      string
+	PendingChange string
+}
+
+// ListBrokers lists all brokers
+func (c *BrokersClient) ListBrokers(ctx context.Context) ([]Broker, error) {
+	var brokers []Broker
+	var nextToken *string
+
+	for {
+		output, err := c.client.ListBrokers(ctx, &mq.ListBrokersInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list brokers: %w", err)
+		}
+
+		for _, summary := range output.BrokerSummaries {
+			brokers = append(brokers, convertBrokerSummary(summary))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return brokers, nil
+}
+
+// DescribeBroker gets a single broker by ID
+func (c *BrokersClient) DescribeBroker(ctx context.Context, brokerID string) (*BrokerDetails, error) {
+	output, err := c.client.DescribeBroker(ctx, &mq.DescribeBrokerInput{
+		BrokerId: aws.String(brokerID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe broker %s: %w", brokerID, err)
+	}
+
+	result := &BrokerDetails{
+		Broker: Broker{
+			BrokerID:         aws.ToString(output.BrokerId),
+			BrokerARN:        aws.ToString(output.BrokerArn),
+			BrokerName:       aws.ToString(output.BrokerName),
+			State:            string(output.BrokerState),
+			EngineType:       string(output.EngineType),
+			DeploymentMode:   string(output.DeploymentMode),
+			HostInstanceType: aws.ToString(output.HostInstanceType),
+		},
+		EngineVersion:           aws.ToString(output.EngineVersion),
+		AutoMinorVersionUpgrade: aws.ToBool(output.AutoMinorVersionUpgrade),
+		PubliclyAccessible:      aws.ToBool(output.PubliclyAccessible),
+		StorageType:             string(output.StorageType),
+		AuthenticationStrategy:  string(output.AuthenticationStrategy),
+		SecurityGroups:          output.SecurityGroups,
+		SubnetIDs:               output.SubnetIds,
+		PendingEngineVersion:    aws.ToString(output.PendingEngineVersion),
+		PendingInstanceType:     aws.ToString(output.PendingHostInstanceType),
+		Tags:                    output.Tags,
+	}
+
+	if output.Created != nil {
+		result.CreatedTime = *output.Created
+	}
+
+	if mw := output.MaintenanceWindowStartTime; mw != nil {
+		result.MaintenanceWindow = fmt.Sprintf("%s %s %s", mw.DayOfWeek, aws.ToString(mw.TimeOfDay), aws.ToString(mw.TimeZone))
+	}
+
+	for _, inst := range output.BrokerInstances {
+		result.Instances = append(result.Instances, BrokerInstance{
+			ConsoleURL: aws.ToString(inst.ConsoleURL),
+			IPAddress:  aws.ToString(inst.IpAddress),
+			Endpoints:  inst.Endpoints,
+		})
+	}
+
+	for _, user := range output.Users {
+		result.Users = append(result.Users, BrokerUser{
+			Username:      aws.ToString(user.Username),
+			PendingChange: string(user.PendingChange),
+		})
+	}
+
+	if result.Tags == nil {
+		result.Tags = make(map[string]string)
+	}
+
+	return result, nil
+}
+
+// RebootBroker reboots a broker, applying any pending configuration and user changes
+func (c *BrokersClient) RebootBroker(ctx context.Context, brokerID string) error {
+	_, err := c.client.RebootBroker(ctx, &mq.RebootBrokerInput{
+		BrokerId: aws.String(brokerID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reboot broker %s: %w", brokerID, err)
+	}
+	return nil
+}
+
+func convertBrokerSummary(summary types.BrokerSummary) Broker {
+	result := Broker{
+		BrokerID:         aws.ToString(summary.BrokerId),
+		BrokerARN:        aws.ToString(summary.BrokerArn),
+		BrokerName:       aws.ToString(summary.BrokerName),
+		State:            string(summary.BrokerState),
+		EngineType:       string(summary.EngineType),
+		DeploymentMode:   string(summary.DeploymentMode),
+		HostInstanceType: aws.ToString(summary.HostInstanceType),
+	}
+
+	if summary.Created != nil {
+		result.CreatedTime = *summary.Created
+	}
+
+	return result
+}
@@ -0,0 +1,86 @@
+package oam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/oam"
+)
+
+// LinksClient wraps the CloudWatch Observability Access Manager client
+type LinksClient struct {
+	client *oam.Client
+}
+
+// NewLinksClient creates a new observability links client
+func NewLinksClient(client *oam.Client) *LinksClient {
+	return &LinksClient{client: client}
+}
+
+// SourceAccount is a source account linked to a sink of this monitoring account
+type SourceAccount struct {
+	AccountID     string
+	Label         string
+	LinkARN       string
+	SinkName      string
+	ResourceTypes []string // e.g. AWS::Logs::LogGroup, AWS::CloudWatch::Metric
+}
+
+// ListSourceAccounts lists the source accounts linked to every sink in the region. It is
+// empty when this isn't a monitoring account.
+func (c *LinksClient) ListSourceAccounts(ctx context.Context) ([]SourceAccount, error) {
+	var accounts []SourceAccount
+
+	sinks := oam.NewListSinksPaginator(c.client, &oam.ListSinksInput{})
+	for sinks.HasMorePages() {
+		page, err := sinks.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sinks: %w", err)
+		}
+
+		for _, sink := range page.Items {
+			links := oam.NewListAttachedLinksPaginator(c.client, &oam.ListAttachedLinksInput{
+				SinkIdentifier: sink.Arn,
+			})
+			for links.HasMorePages() {
+				linkPage, err := links.NextPage(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list links of sink %s: %w", aws.ToString(sink.Name), err)
+				}
+
+				for _, link := range linkPage.Items {
+					accounts = append(accounts, SourceAccount{
+						AccountID:     AccountFromARN(aws.ToString(link.LinkArn)),
+						Label:         aws.ToString(link.Label),
+						LinkARN:       aws.ToString(link.LinkArn),
+						SinkName:      aws.ToString(sink.Name),
+						ResourceTypes: link.ResourceTypes,
+					})
+				}
+			}
+		}
+	}
+
+	return accounts, nil
+}
+
+// AccountFromARN returns the account ID in an ARN, arn:partition:service:region:account:resource
+func AccountFromARN(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[4]
+}
+
+// Shares reports whether the account shares a resource type, such as AWS::Logs::LogGroup
+func (a SourceAccount) Shares(resourceType string) bool {
+	for _, t := range a.ResourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
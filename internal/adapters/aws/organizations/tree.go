@@ -0,0 +1,208 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+// Kinds of nodes in the organization tree
+const (
+	KindRoot    = "root"
+	KindOU      = "ou"
+	KindAccount = "account"
+)
+
+// ErrNotInOrganization is returned when the account isn't a member of an organization
+var ErrNotInOrganization = errors.New("the account is not a member of an organization")
+
+// OrgClient wraps the Organizations client
+type OrgClient struct {
+	client *organizations.Client
+}
+
+// NewOrgClient creates a new Organizations client
+func NewOrgClient(client *organizations.Client) *OrgClient {
+	return &OrgClient{client: client}
+}
+
+// Node is the root, an organizational unit or an account of the organization
+type Node struct {
+	ID       string
+	ARN      string
+	Name     string
+	Kind     string
+	ParentID string
+	Depth    int  // 0 for the root
+	Last     bool // Last child of its parent, to draw the tree
+
+	// Accounts only
+	Email      string
+	State      string
+	JoinedVia  string
+	JoinedAt   time.Time
+	Management bool // The organization's management account
+}
+
+// Policy is a policy attached to a node
+type Policy struct {
+	ID          string
+	ARN         string
+	Name        string
+	Description string
+	AWSManaged  bool
+}
+
+// Tree lists the organization depth first: each root, then its OUs with everything under
+// them, then the accounts directly in it. OUs and accounts are ordered by name.
+func (c *OrgClient) Tree(ctx context.Context) ([]Node, error) {
+	org, err := c.client.DescribeOrganization(ctx, &organizations.DescribeOrganizationInput{})
+	if err != nil {
+		return nil, orgError("failed to describe organization", err)
+	}
+	managementID := ""
+	if org.Organization != nil {
+		managementID = aws.ToString(org.Organization.MasterAccountId)
+	}
+
+	var roots []types.Root
+	paginator := organizations.NewListRootsPaginator(c.client, &organizations.ListRootsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, orgError("failed to list roots", err)
+		}
+		roots = append(roots, page.Roots...)
+	}
+
+	var nodes []Node
+	for i, root := range roots {
+		nodes = append(nodes, Node{
+			ID:   aws.ToString(root.Id),
+			ARN:  aws.ToString(root.Arn),
+			Name: aws.ToString(root.Name),
+			Kind: KindRoot,
+			Last: i == len(roots)-1,
+		})
+		if nodes, err = c.appendChildren(ctx, nodes, aws.ToString(root.Id), 1, managementID); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// appendChildren appends the OUs and accounts under a parent, recursing into the OUs
+func (c *OrgClient) appendChildren(ctx context.Context, nodes []Node, parentID string, depth int, managementID string) ([]Node, error) {
+	var units []types.OrganizationalUnit
+	unitPages := organizations.NewListOrganizationalUnitsForParentPaginator(c.client, &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentID),
+	})
+	for unitPages.HasMorePages() {
+		page, err := unitPages.NextPage(ctx)
+		if err != nil {
+			return nil, orgError("failed to list organizational units", err)
+		}
+		units = append(units, page.OrganizationalUnits...)
+	}
+
+	var accounts []types.Account
+	accountPages := organizations.NewListAccountsForParentPaginator(c.client, &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentID),
+	})
+	for accountPages.HasMorePages() {
+		page, err := accountPages.NextPage(ctx)
+		if err != nil {
+			return nil, orgError("failed to list accounts", err)
+		}
+		accounts = append(accounts, page.Accounts...)
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		return strings.ToLower(aws.ToString(units[i].Name)) < strings.ToLower(aws.ToString(units[j].Name))
+	})
+	sort.Slice(accounts, func(i, j int) bool {
+		return strings.ToLower(aws.ToString(accounts[i].Name)) < strings.ToLower(aws.ToString(accounts[j].Name))
+	})
+
+	var err error
+	for i, unit := range units {
+		nodes = append(nodes, Node{
+			ID:       aws.ToString(unit.Id),
+			ARN:      aws.ToString(unit.Arn),
+			Name:     aws.ToString(unit.Name),
+			Kind:     KindOU,
+			ParentID: parentID,
+			Depth:    depth,
+			Last:     i == len(units)-1 && len(accounts) == 0,
+		})
+		if nodes, err = c.appendChildren(ctx, nodes, aws.ToString(unit.Id), depth+1, managementID); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, account := range accounts {
+		id := aws.ToString(account.Id)
+		node := Node{
+			ID:         id,
+			ARN:        aws.ToString(account.Arn),
+			Name:       aws.ToString(account.Name),
+			Kind:       KindAccount,
+			ParentID:   parentID,
+			Depth:      depth,
+			Last:       i == len(accounts)-1,
+			Email:      aws.ToString(account.Email),
+			State:      string(account.State),
+			JoinedVia:  string(account.JoinedMethod),
+			Management: id == managementID,
+		}
+		if node.State == "" {
+			node.State = string(account.Status)
+		}
+		if account.JoinedTimestamp != nil {
+			node.JoinedAt = *account.JoinedTimestamp
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// ServiceControlPolicies lists the SCPs attached directly to a root, OU or account
+func (c *OrgClient) ServiceControlPolicies(ctx context.Context, targetID string) ([]Policy, error) {
+	var policies []Policy
+	paginator := organizations.NewListPoliciesForTargetPaginator(c.client, &organizations.ListPoliciesForTargetInput{
+		TargetId: aws.String(targetID),
+		Filter:   types.PolicyTypeServiceControlPolicy,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, orgError("failed to list service control policies", err)
+		}
+		for _, p := range page.Policies {
+			policies = append(policies, Policy{
+				ID:          aws.ToString(p.Id),
+				ARN:         aws.ToString(p.Arn),
+				Name:        aws.ToString(p.Name),
+				Description: aws.ToString(p.Description),
+				AWSManaged:  p.AwsManaged,
+			})
+		}
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+	return policies, nil
+}
+
+func orgError(message string, err error) error {
+	var notInUse *types.AWSOrganizationsNotInUseException
+	if errors.As(err, &notInUse) {
+		return ErrNotInOrganization
+	}
+	return fmt.Errorf("%s: %w", message, err)
+}
@@ -0,0 +1,132 @@
+package pinpoint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+)
+
+// AppsClient wraps the Amazon Pinpoint client for project operations. Pinpoint calls its
+// projects apps in the API.
+type AppsClient struct {
+	client *pinpoint.Client
+}
+
+// NewAppsClient creates a new Amazon Pinpoint apps client
+func NewAppsClient(client *pinpoint.Client) *AppsClient {
+	return &AppsClient{client: client}
+}
+
+// App represents an Amazon Pinpoint project
+type App struct {
+	ID          string
+	ARN         string
+	Name        string
+	CreatedTime time.Time
+	Tags        map[string]string
+}
+
+// Channel is a channel set up for a project, such as SMS or EMAIL
+type Channel struct {
+	Type         string
+	Enabled      bool
+	IsArchived   bool
+	LastModified string
+}
+
+// ListApps lists all projects
+func (c *AppsClient) ListApps(ctx context.Context) ([]App, error) {
+	var apps []App
+	var token *string
+
+	for {
+		output, err := c.client.GetApps(ctx, &pinpoint.GetAppsInput{
+			PageSize: aws.String("100"),
+			Token:    token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Pinpoint projects: %w", err)
+		}
+		if output.ApplicationsResponse == nil {
+			break
+		}
+
+		for _, app := range output.ApplicationsResponse.Item {
+			apps = append(apps, convertApp(app))
+		}
+
+		if output.ApplicationsResponse.NextToken == nil {
+			break
+		}
+		token = output.ApplicationsResponse.NextToken
+	}
+
+	return apps, nil
+}
+
+// GetApp gets a single project by ID
+func (c *AppsClient) GetApp(ctx context.Context, appID string) (*App, error) {
+	output, err := c.client.GetApp(ctx, &pinpoint.GetAppInput{
+		ApplicationId: aws.String(appID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pinpoint project %s: %w", appID, err)
+	}
+	if output.ApplicationResponse == nil {
+		return nil, fmt.Errorf("Pinpoint project %s not found", appID)
+	}
+
+	app := convertApp(*output.ApplicationResponse)
+	return &app, nil
+}
+
+// GetChannels lists the channels set up for a project, sorted by type
+func (c *AppsClient) GetChannels(ctx context.Context, appID string) ([]Channel, error) {
+	output, err := c.client.GetChannels(ctx, &pinpoint.GetChannelsInput{
+		ApplicationId: aws.String(appID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channels of Pinpoint project %s: %w", appID, err)
+	}
+	if output.ChannelsResponse == nil {
+		return nil, nil
+	}
+
+	channels := make([]Channel, 0, len(output.ChannelsResponse.Channels))
+	for channelType, ch := range output.ChannelsResponse.Channels {
+		channels = append(channels, Channel{
+			Type:         channelType,
+			Enabled:      aws.ToBool(ch.Enabled),
+			IsArchived:   aws.ToBool(ch.IsArchived),
+			LastModified: aws.ToString(ch.LastModifiedDate),
+		})
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Type < channels[j].Type
+	})
+
+	return channels, nil
+}
+
+func convertApp(app types.ApplicationResponse) App {
+	result := App{
+		ID:   aws.ToString(app.Id),
+		ARN:  aws.ToString(app.Arn),
+		Name: aws.ToString(app.Name),
+		Tags: app.Tags,
+	}
+
+	// The creation date is an ISO 8601 string
+	if created := aws.ToString(app.CreationDate); created != "" {
+		if t, err := time.Parse(time.RFC3339, created); err == nil {
+			result.CreatedTime = t
+		}
+	}
+
+	return result
+}
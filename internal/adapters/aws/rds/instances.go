@@ -23,6 +23,7 @@ func NewInstancesClient(client *rds.Client) *InstancesClient {
 // DBInstance represents an RDS instance
 type DBInstance struct {
 	DBInstanceID            string
+	ARN                     string
 	DBInstanceClass         string
 	Engine                  string
 	EngineVersion           string
@@ -30,6 +31,7 @@ type DBInstance struct {
 	Endpoint                string
 	Port                    int32
 	MasterUsername          string
+	MasterUserSecretARN     string // Set when RDS manages the master password in Secrets Manager
 	DBName                  string
 	AllocatedStorage        int32
 	StorageType             string
@@ -37,13 +39,27 @@ type DBInstance struct {
 	MultiAZ                 bool
 	AvailabilityZone        string
 	VpcID                   string
+	DBSubnetGroup           string
+	VpcSecurityGroups       []string
 	PubliclyAccessible      bool
 	AutoMinorVersionUpgrade bool
 	BackupRetentionPeriod   int32
+	DBClusterID             string   // Set for Aurora cluster members
+	ReadReplicaSource       string   // Set for read replicas
+	ReadReplicas            []string // Read replicas of this instance
+	ParameterGroups         []GroupMembership
+	OptionGroups            []GroupMembership
 	CreatedTime             time.Time
 	Tags                    map[string]string
 }
 
+// GroupMembership is a parameter or option group an instance uses, with the status of
+// applying it, e.g. pending-reboot
+type GroupMembership struct {
+	Name   string
+	Status string
+}
+
 // ListDBInstances lists all RDS instances
 func (c *InstancesClient) ListDBInstances(ctx context.Context) ([]DBInstance, error) {
 	var instances []DBInstance
@@ -91,9 +107,79 @@ func (c *InstancesClient) GetDBInstance(ctx context.Context, dbInstanceID string
 	return &inst, nil
 }
 
+// ClusterLogin is the master user of an Aurora cluster, which its instances share
+type ClusterLogin struct {
+	MasterUsername      string
+	MasterUserSecretARN string // Set when RDS manages the master password in Secrets Manager
+	DatabaseName        string
+}
+
+// GetClusterLogin gets the master user of an Aurora cluster
+func (c *InstancesClient) GetClusterLogin(ctx context.Context, clusterID string) (*ClusterLogin, error) {
+	output, err := c.client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe DB cluster %s: %w", clusterID, err)
+	}
+	if len(output.DBClusters) == 0 {
+		return nil, fmt.Errorf("DB cluster %s not found", clusterID)
+	}
+
+	cluster := output.DBClusters[0]
+	login := &ClusterLogin{
+		MasterUsername: aws.ToString(cluster.MasterUsername),
+		DatabaseName:   aws.ToString(cluster.DatabaseName),
+	}
+	if cluster.MasterUserSecret != nil {
+		login.MasterUserSecretARN = aws.ToString(cluster.MasterUserSecret.SecretArn)
+	}
+	return login, nil
+}
+
+// StartDBInstance starts a stopped instance
+func (c *InstancesClient) StartDBInstance(ctx context.Context, dbInstanceID string) error {
+	_, err := c.client.StartDBInstance(ctx, &rds.StartDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start DB instance %s: %w", dbInstanceID, err)
+	}
+	return nil
+}
+
+// StopDBInstance stops an instance. RDS starts it again after seven days.
+func (c *InstancesClient) StopDBInstance(ctx context.Context, dbInstanceID string) error {
+	_, err := c.client.StopDBInstance(ctx, &rds.StopDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop DB instance %s: %w", dbInstanceID, err)
+	}
+	return nil
+}
+
+// RebootDBInstance reboots an instance, failing over to the standby of a Multi-AZ
+// instance if forceFailover is set
+func (c *InstancesClient) RebootDBInstance(ctx context.Context, dbInstanceID string, forceFailover bool) error {
+	input := &rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+	}
+	if forceFailover {
+		input.ForceFailover = aws.Bool(true)
+	}
+
+	_, err := c.client.RebootDBInstance(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to reboot DB instance %s: %w", dbInstanceID, err)
+	}
+	return nil
+}
+
 func convertDBInstance(db types.DBInstance) DBInstance {
 	result := DBInstance{
 		DBInstanceID:            aws.ToString(db.DBInstanceIdentifier),
+		ARN:                     aws.ToString(db.DBInstanceArn),
 		DBInstanceClass:         aws.ToString(db.DBInstanceClass),
 		Engine:                  aws.ToString(db.Engine),
 		EngineVersion:           aws.ToString(db.EngineVersion),
@@ -102,6 +188,9 @@ func convertDBInstance(db types.DBInstance) DBInstance {
 		DBName:                  aws.ToString(db.DBName),
 		StorageType:             aws.ToString(db.StorageType),
 		AvailabilityZone:        aws.ToString(db.AvailabilityZone),
+		DBClusterID:             aws.ToString(db.DBClusterIdentifier),
+		ReadReplicaSource:       aws.ToString(db.ReadReplicaSourceDBInstanceIdentifier),
+		ReadReplicas:            db.ReadReplicaDBInstanceIdentifiers,
 		Tags:                    make(map[string]string),
 	}
 
@@ -112,6 +201,10 @@ func convertDBInstance(db types.DBInstance) DBInstance {
 		}
 	}
 
+	if db.MasterUserSecret != nil {
+		result.MasterUserSecretARN = aws.ToString(db.MasterUserSecret.SecretArn)
+	}
+
 	if db.AllocatedStorage != nil {
 		result.AllocatedStorage = *db.AllocatedStorage
 	}
@@ -142,6 +235,25 @@ func convertDBInstance(db types.DBInstance) DBInstance {
 
 	if db.DBSubnetGroup != nil {
 		result.VpcID = aws.ToString(db.DBSubnetGroup.VpcId)
+		result.DBSubnetGroup = aws.ToString(db.DBSubnetGroup.DBSubnetGroupName)
+	}
+
+	for _, sg := range db.VpcSecurityGroups {
+		result.VpcSecurityGroups = append(result.VpcSecurityGroups, aws.ToString(sg.VpcSecurityGroupId))
+	}
+
+	for _, pg := range db.DBParameterGroups {
+		result.ParameterGroups = append(result.ParameterGroups, GroupMembership{
+			Name:   aws.ToString(pg.DBParameterGroupName),
+			Status: aws.ToString(pg.ParameterApplyStatus),
+		})
+	}
+
+	for _, og := range db.OptionGroupMemberships {
+		result.OptionGroups = append(result.OptionGroups, GroupMembership{
+			Name:   aws.ToString(og.OptionGroupName),
+			Status: aws.ToString(og.Status),
+		})
 	}
 
 	for _, tag := range db.TagList {
@@ -150,3 +262,29 @@ func convertDBInstance(db types.DBInstance) DBInstance {
 
 	return result
 }
+
+// UpdateTags adds or changes the tags in set on an RDS resource and removes the keys in
+// remove. The resource is identified by ARN, so snapshots and clusters can be tagged too.
+func (c *InstancesClient) UpdateTags(ctx context.Context, arn string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		tags := make([]types.Tag, 0, len(set))
+		for key, value := range set {
+			tags = append(tags, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+		if _, err := c.client.AddTagsToResource(ctx, &rds.AddTagsToResourceInput{
+			ResourceName: aws.String(arn),
+			Tags:         tags,
+		}); err != nil {
+			return fmt.Errorf("failed to tag %s: %w", arn, err)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := c.client.RemoveTagsFromResource(ctx, &rds.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(arn),
+			TagKeys:      remove,
+		}); err != nil {
+			return fmt.Errorf("failed to remove tags from %s: %w", arn, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// ParameterGroupsClient wraps the RDS client for parameter and option group operations
+type ParameterGroupsClient struct {
+	client *rds.Client
+}
+
+// NewParameterGroupsClient creates a new RDS parameter groups client
+func NewParameterGroupsClient(client *rds.Client) *ParameterGroupsClient {
+	return &ParameterGroupsClient{client: client}
+}
+
+// DBParameterGroup represents an RDS DB parameter group
+type DBParameterGroup struct {
+	Name        string
+	ARN         string
+	Family      string // e.g. postgres16, engine defaults are per family
+	Description string
+}
+
+// IsDefault reports whether the group is one RDS creates for its family, such as
+// default.postgres16. Those can't be modified.
+func (g DBParameterGroup) IsDefault() bool {
+	return g.Name == "default."+g.Family
+}
+
+// Parameter is a parameter of a parameter group or of an engine's defaults
+type Parameter struct {
+	Name          string
+	Value         string // Empty when the engine's built-in value applies
+	Source        string // engine-default, system or user
+	ApplyType     string // static or dynamic
+	ApplyMethod   string // immediate or pending-reboot, only set for modified parameters
+	DataType      string
+	AllowedValues string
+	Modifiable    bool
+	Description   string
+}
+
+// OptionGroup represents an RDS option group
+type OptionGroup struct {
+	Name               string
+	ARN                string
+	Description        string
+	Engine             string
+	MajorEngineVersion string
+	VpcID              string
+	Options            []Option
+}
+
+// Option is an option added to an option group
+type Option struct {
+	Name       string
+	Version    string
+	Port       int32
+	Persistent bool
+	Permanent  bool
+	Settings   map[string]string // Settings with a value
+}
+
+// ListDBParameterGroups lists all DB parameter groups
+func (c *ParameterGroupsClient) ListDBParameterGroups(ctx context.Context) ([]DBParameterGroup, error) {
+	var groups []DBParameterGroup
+
+	paginator := rds.NewDescribeDBParameterGroupsPaginator(c.client, &rds.DescribeDBParameterGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB parameter groups: %w", err)
+		}
+
+		for _, g := range output.DBParameterGroups {
+			groups = append(groups, convertDBParameterGroup(g))
+		}
+	}
+
+	return groups, nil
+}
+
+// GetDBParameterGroup gets a single parameter group by name
+func (c *ParameterGroupsClient) GetDBParameterGroup(ctx context.Context, name string) (*DBParameterGroup, error) {
+	output, err := c.client.DescribeDBParameterGroups(ctx, &rds.DescribeDBParameterGroupsInput{
+		DBParameterGroupName: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe DB parameter group %s: %w", name, err)
+	}
+	if len(output.DBParameterGroups) == 0 {
+		return nil, fmt.Errorf("DB parameter group %s not found", name)
+	}
+
+	group := convertDBParameterGroup(output.DBParameterGroups[0])
+	return &group, nil
+}
+
+// ListParameters lists every parameter of a parameter group, sorted by name
+func (c *ParameterGroupsClient) ListParameters(ctx context.Context, groupName string) ([]Parameter, error) {
+	var params []Parameter
+
+	paginator := rds.NewDescribeDBParametersPaginator(c.client, &rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(groupName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe parameters of %s: %w", groupName, err)
+		}
+
+		for _, p := range output.Parameters {
+			params = append(params, convertParameter(p))
+		}
+	}
+
+	sortParameters(params)
+	return params, nil
+}
+
+// ListEngineDefaultParameters lists the default parameters of a parameter group family,
+// sorted by name
+func (c *ParameterGroupsClient) ListEngineDefaultParameters(ctx context.Context, family string) ([]Parameter, error) {
+	var params []Parameter
+	var marker *string
+
+	for {
+		output, err := c.client.DescribeEngineDefaultParameters(ctx, &rds.DescribeEngineDefaultParametersInput{
+			DBParameterGroupFamily: aws.String(family),
+			Marker:                 marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe engine defaults of %s: %w", family, err)
+		}
+		if output.EngineDefaults == nil {
+			break
+		}
+
+		for _, p := range output.EngineDefaults.Parameters {
+			params = append(params, convertParameter(p))
+		}
+
+		if output.EngineDefaults.Marker == nil {
+			break
+		}
+		marker = output.EngineDefaults.Marker
+	}
+
+	sortParameters(params)
+	return params, nil
+}
+
+// ListOptionGroups lists all option groups
+func (c *ParameterGroupsClient) ListOptionGroups(ctx context.Context) ([]OptionGroup, error) {
+	var groups []OptionGroup
+
+	paginator := rds.NewDescribeOptionGroupsPaginator(c.client, &rds.DescribeOptionGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe option groups: %w", err)
+		}
+
+		for _, g := range output.OptionGroupsList {
+			groups = append(groups, convertOptionGroup(g))
+		}
+	}
+
+	return groups, nil
+}
+
+// GetOptionGroup gets a single option group by name
+func (c *ParameterGroupsClient) GetOptionGroup(ctx context.Context, name string) (*OptionGroup, error) {
+	output, err := c.client.DescribeOptionGroups(ctx, &rds.DescribeOptionGroupsInput{
+		OptionGroupName: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe option group %s: %w", name, err)
+	}
+	if len(output.OptionGroupsList) == 0 {
+		return nil, fmt.Errorf("option group %s not found", name)
+	}
+
+	group := convertOptionGroup(output.OptionGroupsList[0])
+	return &group, nil
+}
+
+func sortParameters(params []Parameter) {
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+}
+
+func convertDBParameterGroup(g types.DBParameterGroup) DBParameterGroup {
+	return DBParameterGroup{
+		Name:        aws.ToString(g.DBParameterGroupName),
+		ARN:         aws.ToString(g.DBParameterGroupArn),
+		Family:      aws.ToString(g.DBParameterGroupFamily),
+		Description: aws.ToString(g.Description),
+	}
+}
+
+func convertParameter(p types.Parameter) Parameter {
+	return Parameter{
+		Name:          aws.ToString(p.ParameterName),
+		Value:         aws.ToString(p.ParameterValue),
+		Source:        aws.ToString(p.Source),
+		ApplyType:     aws.ToString(p.ApplyType),
+		ApplyMethod:   string(p.ApplyMethod),
+		DataType:      aws.ToString(p.DataType),
+		AllowedValues: aws.ToString(p.AllowedValues),
+		Modifiable:    aws.ToBool(p.IsModifiable),
+		Description:   aws.ToString(p.Description),
+	}
+}
+
+func convertOptionGroup(g types.OptionGroup) OptionGroup {
+	result := OptionGroup{
+		Name:               aws.ToString(g.OptionGroupName),
+		ARN:                aws.ToString(g.OptionGroupArn),
+		Description:        aws.ToString(g.OptionGroupDescription),
+		Engine:             aws.ToString(g.EngineName),
+		MajorEngineVersion: aws.ToString(g.MajorEngineVersion),
+		VpcID:              aws.ToString(g.VpcId),
+	}
+
+	for _, o := range g.Options {
+		option := Option{
+			Name:       aws.ToString(o.OptionName),
+			Version:    aws.ToString(o.OptionVersion),
+			Persistent: aws.ToBool(o.Persistent),
+			Permanent:  aws.ToBool(o.Permanent),
+			Settings:   make(map[string]string),
+		}
+		if o.Port != nil {
+			option.Port = *o.Port
+		}
+		for _, s := range o.OptionSettings {
+			if value := aws.ToString(s.Value); value != "" {
+				option.Settings[aws.ToString(s.Name)] = value
+			}
+		}
+		result.Options = append(result.Options, option)
+	}
+
+	return result
+}
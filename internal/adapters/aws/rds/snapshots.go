@@ -0,0 +1,319 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// SnapshotsClient wraps the RDS client for snapshot operations
+type SnapshotsClient struct {
+	client *rds.Client
+}
+
+// NewSnapshotsClient creates a new RDS snapshots client
+func NewSnapshotsClient(client *rds.Client) *SnapshotsClient {
+	return &SnapshotsClient{client: client}
+}
+
+// DBSnapshot represents an RDS DB snapshot
+type DBSnapshot struct {
+	SnapshotID       string
+	SnapshotARN      string
+	DBInstanceID     string
+	SnapshotType     string
+	Status           string
+	Engine           string
+	EngineVersion    string
+	AllocatedStorage int32
+	StorageType      string
+	Encrypted        bool
+	AvailabilityZone string
+	VpcID            string
+	Port             int32
+	PercentProgress  int32
+	CreatedTime      time.Time
+	Tags             map[string]string
+}
+
+// DBSubnetGroup represents an RDS DB subnet group
+type DBSubnetGroup struct {
+	Name        string
+	Description string
+	VpcID       string
+	Status      string
+	Subnets     []string
+}
+
+// RestoreFromSnapshotInput holds the settings for a new instance restored from a snapshot
+type RestoreFromSnapshotInput struct {
+	SnapshotID          string
+	DBInstanceID        string
+	DBInstanceClass     string
+	DBSubnetGroupName   string
+	VpcSecurityGroupIDs []string
+}
+
+// ListDBSnapshots lists snapshots, limited to one instance if dbInstanceID is set
+func (c *SnapshotsClient) ListDBSnapshots(ctx context.Context, dbInstanceID string) ([]DBSnapshot, error) {
+	var snapshots []DBSnapshot
+	var marker *string
+
+	for {
+		input := &rds.DescribeDBSnapshotsInput{
+			Marker: marker,
+		}
+		if dbInstanceID != "" {
+			input.DBInstanceIdentifier = aws.String(dbInstanceID)
+		}
+
+		output, err := c.client.DescribeDBSnapshots(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB snapshots: %w", err)
+		}
+
+		for _, snap := range output.DBSnapshots {
+			snapshots = append(snapshots, convertDBSnapshot(snap))
+		}
+
+		if output.Marker == nil {
+			break
+		}
+		marker = output.Marker
+	}
+
+	// Newest first
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedTime.After(snapshots[j].CreatedTime)
+	})
+
+	return snapshots, nil
+}
+
+// GetDBSnapshot gets a single snapshot by ID
+func (c *SnapshotsClient) GetDBSnapshot(ctx context.Context, snapshotID string) (*DBSnapshot, error) {
+	output, err := c.client.DescribeDBSnapshots(ctx, &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe DB snapshot %s: %w", snapshotID, err)
+	}
+
+	if len(output.DBSnapshots) == 0 {
+		return nil, fmt.Errorf("DB snapshot %s not found", snapshotID)
+	}
+
+	snap := convertDBSnapshot(output.DBSnapshots[0])
+	return &snap, nil
+}
+
+// ListDBSubnetGroups lists all DB subnet groups
+func (c *SnapshotsClient) ListDBSubnetGroups(ctx context.Context) ([]DBSubnetGroup, error) {
+	var groups []DBSubnetGroup
+	var marker *string
+
+	for {
+		output, err := c.client.DescribeDBSubnetGroups(ctx, &rds.DescribeDBSubnetGroupsInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe DB subnet groups: %w", err)
+		}
+
+		for _, group := range output.DBSubnetGroups {
+			result := DBSubnetGroup{
+				Name:        aws.ToString(group.DBSubnetGroupName),
+				Description: aws.ToString(group.DBSubnetGroupDescription),
+				VpcID:       aws.ToString(group.VpcId),
+				Status:      aws.ToString(group.SubnetGroupStatus),
+			}
+			for _, subnet := range group.Subnets {
+				result.Subnets = append(result.Subnets, aws.ToString(subnet.SubnetIdentifier))
+			}
+			groups = append(groups, result)
+		}
+
+		if output.Marker == nil {
+			break
+		}
+		marker = output.Marker
+	}
+
+	return groups, nil
+}
+
+// ListOrderableInstanceClasses lists the instance classes available for an engine version, sorted by name
+func (c *SnapshotsClient) ListOrderableInstanceClasses(ctx context.Context, engine, engineVersion string) ([]string, error) {
+	seen := make(map[string]bool)
+	var classes []string
+	var marker *string
+
+	for {
+		input := &rds.DescribeOrderableDBInstanceOptionsInput{
+			Engine: aws.String(engine),
+			Marker: marker,
+		}
+		if engineVersion != "" {
+			input.EngineVersion = aws.String(engineVersion)
+		}
+
+		output, err := c.client.DescribeOrderableDBInstanceOptions(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe orderable instance options: %w", err)
+		}
+
+		// Options are returned per storage type and AZ, so classes repeat
+		for _, option := range output.OrderableDBInstanceOptions {
+			class := aws.ToString(option.DBInstanceClass)
+			if class != "" && !seen[class] {
+				seen[class] = true
+				classes = append(classes, class)
+			}
+		}
+
+		if output.Marker == nil {
+			break
+		}
+		marker = output.Marker
+	}
+
+	sort.Strings(classes)
+	return classes, nil
+}
+
+// RestoreDBInstanceFromDBSnapshot starts restoring a new instance from a snapshot
+func (c *SnapshotsClient) RestoreDBInstanceFromDBSnapshot(ctx context.Context, in RestoreFromSnapshotInput) (*DBInstance, error) {
+	input := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(in.SnapshotID),
+		DBInstanceIdentifier: aws.String(in.DBInstanceID),
+		VpcSecurityGroupIds:  in.VpcSecurityGroupIDs,
+	}
+	if in.DBInstanceClass != "" {
+		input.DBInstanceClass = aws.String(in.DBInstanceClass)
+	}
+	if in.DBSubnetGroupName != "" {
+		input.DBSubnetGroupName = aws.String(in.DBSubnetGroupName)
+	}
+
+	output, err := c.client.RestoreDBInstanceFromDBSnapshot(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore DB instance from snapshot %s: %w", in.SnapshotID, err)
+	}
+
+	if output.DBInstance == nil {
+		return nil, fmt.Errorf("restore of snapshot %s returned no instance", in.SnapshotID)
+	}
+
+	inst := convertDBInstance(*output.DBInstance)
+	return &inst, nil
+}
+
+// CreateDBSnapshot starts a manual snapshot of an instance
+func (c *SnapshotsClient) CreateDBSnapshot(ctx context.Context, dbInstanceID, snapshotID string) (*DBSnapshot, error) {
+	output, err := c.client.CreateDBSnapshot(ctx, &rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot of %s: %w", dbInstanceID, err)
+	}
+	if output.DBSnapshot == nil {
+		return nil, fmt.Errorf("snapshot of %s returned no snapshot", dbInstanceID)
+	}
+
+	snap := convertDBSnapshot(*output.DBSnapshot)
+	return &snap, nil
+}
+
+// DeleteDBSnapshot deletes a manual snapshot
+func (c *SnapshotsClient) DeleteDBSnapshot(ctx context.Context, snapshotID string) error {
+	_, err := c.client.DeleteDBSnapshot(ctx, &rds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete DB snapshot %s: %w", snapshotID, err)
+	}
+	return nil
+}
+
+// CopySnapshotInput holds the source and target of a snapshot copy
+type CopySnapshotInput struct {
+	SourceARN    string // The source must be given by ARN when it is in another region
+	SourceRegion string
+	TargetID     string
+	KMSKeyID     string // Needed for encrypted snapshots copied to another region
+}
+
+// CopyDBSnapshot copies a snapshot into the client's region. For a copy from another
+// region the SDK presigns the request in the source region.
+func (c *SnapshotsClient) CopyDBSnapshot(ctx context.Context, in CopySnapshotInput) (*DBSnapshot, error) {
+	input := &rds.CopyDBSnapshotInput{
+		SourceDBSnapshotIdentifier: aws.String(in.SourceARN),
+		TargetDBSnapshotIdentifier: aws.String(in.TargetID),
+		CopyTags:                   aws.Bool(true),
+	}
+	if in.SourceRegion != "" {
+		input.SourceRegion = aws.String(in.SourceRegion)
+	}
+	if in.KMSKeyID != "" {
+		input.KmsKeyId = aws.String(in.KMSKeyID)
+	}
+
+	output, err := c.client.CopyDBSnapshot(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy DB snapshot %s: %w", in.SourceARN, err)
+	}
+	if output.DBSnapshot == nil {
+		return nil, fmt.Errorf("copy of %s returned no snapshot", in.SourceARN)
+	}
+
+	snap := convertDBSnapshot(*output.DBSnapshot)
+	return &snap, nil
+}
+
+func convertDBSnapshot(snap types.DBSnapshot) DBSnapshot {
+	result := DBSnapshot{
+		SnapshotID:       aws.ToString(snap.DBSnapshotIdentifier),
+		SnapshotARN:      aws.ToString(snap.DBSnapshotArn),
+		DBInstanceID:     aws.ToString(snap.DBInstanceIdentifier),
+		SnapshotType:     aws.ToString(snap.SnapshotType),
+		Status:           aws.ToString(snap.Status),
+		Engine:           aws.ToString(snap.Engine),
+		EngineVersion:    aws.ToString(snap.EngineVersion),
+		StorageType:      aws.ToString(snap.StorageType),
+		AvailabilityZone: aws.ToString(snap.AvailabilityZone),
+		VpcID:            aws.ToString(snap.VpcId),
+		Tags:             make(map[string]string),
+	}
+
+	if snap.AllocatedStorage != nil {
+		result.AllocatedStorage = *snap.AllocatedStorage
+	}
+
+	if snap.Encrypted != nil {
+		result.Encrypted = *snap.Encrypted
+	}
+
+	if snap.Port != nil {
+		result.Port = *snap.Port
+	}
+
+	if snap.PercentProgress != nil {
+		result.PercentProgress = *snap.PercentProgress
+	}
+
+	if snap.SnapshotCreateTime != nil {
+		result.CreatedTime = *snap.SnapshotCreateTime
+	}
+
+	for _, tag := range snap.TagList {
+		result.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return result
+}
@@ -0,0 +1,124 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+// RecordsClient wraps the Route 53 client for hosted zone and record operations
+type RecordsClient struct {
+	client *route53.Client
+}
+
+// NewRecordsClient creates a new Route 53 records client
+func NewRecordsClient(client *route53.Client) *RecordsClient {
+	return &RecordsClient{client: client}
+}
+
+// HostedZone represents a Route 53 hosted zone
+type HostedZone struct {
+	ID          string
+	Name        string
+	Private     bool
+	RecordCount int64
+}
+
+// Record represents a Route 53 resource record set
+type Record struct {
+	ZoneID        string
+	ZoneName      string
+	Name          string
+	Type          string
+	TTL           int64
+	Values        []string
+	AliasTarget   string
+	SetIdentifier string
+}
+
+// ListHostedZones lists all hosted zones
+func (c *RecordsClient) ListHostedZones(ctx context.Context) ([]HostedZone, error) {
+	var zones []HostedZone
+	var marker *string
+
+	for {
+		output, err := c.client.ListHostedZones(ctx, &route53.ListHostedZonesInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list hosted zones: %w", err)
+		}
+
+		for _, zone := range output.HostedZones {
+			hz := HostedZone{
+				ID:          strings.TrimPrefix(aws.ToString(zone.Id), "/hostedzone/"),
+				Name:        aws.ToString(zone.Name),
+				RecordCount: aws.ToInt64(zone.ResourceRecordSetCount),
+			}
+			if zone.Config != nil {
+				hz.Private = zone.Config.PrivateZone
+			}
+			zones = append(zones, hz)
+		}
+
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.NextMarker
+	}
+
+	return zones, nil
+}
+
+// ListRecords lists all record sets in a hosted zone
+func (c *RecordsClient) ListRecords(ctx context.Context, zone HostedZone) ([]Record, error) {
+	var records []Record
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zone.ID),
+	}
+
+	for {
+		output, err := c.client.ListResourceRecordSets(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list records for zone %s: %w", zone.Name, err)
+		}
+
+		for _, rrs := range output.ResourceRecordSets {
+			records = append(records, convertRecord(zone, rrs))
+		}
+
+		if !output.IsTruncated {
+			break
+		}
+		input.StartRecordName = output.NextRecordName
+		input.StartRecordType = output.NextRecordType
+		input.StartRecordIdentifier = output.NextRecordIdentifier
+	}
+
+	return records, nil
+}
+
+func convertRecord(zone HostedZone, rrs types.ResourceRecordSet) Record {
+	record := Record{
+		ZoneID:        zone.ID,
+		ZoneName:      zone.Name,
+		Name:          aws.ToString(rrs.Name),
+		Type:          string(rrs.Type),
+		TTL:           aws.ToInt64(rrs.TTL),
+		SetIdentifier: aws.ToString(rrs.SetIdentifier),
+	}
+
+	for _, rr := range rrs.ResourceRecords {
+		record.Values = append(record.Values, aws.ToString(rr.Value))
+	}
+
+	if rrs.AliasTarget != nil {
+		record.AliasTarget = aws.ToString(rrs.AliasTarget.DNSName)
+	}
+
+	return record
+}
@@ -2,12 +2,14 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 )
 
 // BucketsClient wraps the S3 client
@@ -143,9 +145,230 @@ func (c *BucketsClient) GetBucketPolicy(ctx context.Context, bucketName string)
 func (c *BucketsClient) GetBucketLifecycle(ctx context.Context, bucketName string) ([]types.LifecycleRule, error) {
 	output, err := c.client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucketName),
-	})
+	}, c.regionOption(ctx, bucketName))
 	if err != nil {
+		if isNotConfigured(err, "NoSuchLifecycleConfiguration") {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return output.Rules, nil
 }
+
+// PutBucketLifecycle replaces the lifecycle rules of a bucket, removing the
+// configuration when there are none
+func (c *BucketsClient) PutBucketLifecycle(ctx context.Context, bucketName string, rules []types.LifecycleRule) error {
+	region := c.regionOption(ctx, bucketName)
+	var err error
+	if len(rules) == 0 {
+		_, err = c.client.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
+			Bucket: aws.String(bucketName),
+		}, region)
+	} else {
+		_, err = c.client.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+			Bucket:                 aws.String(bucketName),
+			LifecycleConfiguration: &types.BucketLifecycleConfiguration{Rules: rules},
+		}, region)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update lifecycle rules of %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// GetBucketCORS gets the CORS rules of a bucket, none if it has no CORS configuration
+func (c *BucketsClient) GetBucketCORS(ctx context.Context, bucketName string) ([]types.CORSRule, error) {
+	output, err := c.client.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}, c.regionOption(ctx, bucketName))
+	if err != nil {
+		if isNotConfigured(err, "NoSuchCORSConfiguration") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return output.CORSRules, nil
+}
+
+// PutBucketCORS replaces the CORS rules of a bucket, removing the configuration when
+// there are none
+func (c *BucketsClient) PutBucketCORS(ctx context.Context, bucketName string, rules []types.CORSRule) error {
+	region := c.regionOption(ctx, bucketName)
+	var err error
+	if len(rules) == 0 {
+		_, err = c.client.DeleteBucketCors(ctx, &s3.DeleteBucketCorsInput{
+			Bucket: aws.String(bucketName),
+		}, region)
+	} else {
+		_, err = c.client.PutBucketCors(ctx, &s3.PutBucketCorsInput{
+			Bucket:            aws.String(bucketName),
+			CORSConfiguration: &types.CORSConfiguration{CORSRules: rules},
+		}, region)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update CORS rules of %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// GetBucketVersioning gets the versioning status of a bucket: Enabled, Suspended or
+// Disabled if it was never enabled
+func (c *BucketsClient) GetBucketVersioning(ctx context.Context, bucketName string) (string, error) {
+	output, err := c.client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucketName),
+	}, c.regionOption(ctx, bucketName))
+	if err != nil {
+		return "", fmt.Errorf("failed to get versioning of %s: %w", bucketName, err)
+	}
+	if output.Status == "" {
+		return "Disabled", nil
+	}
+	return string(output.Status), nil
+}
+
+// SetBucketVersioning enables versioning, or suspends it. Once enabled, versioning
+// can't be turned off again, only suspended.
+func (c *BucketsClient) SetBucketVersioning(ctx context.Context, bucketName string, enabled bool) error {
+	status := types.BucketVersioningStatusSuspended
+	if enabled {
+		status = types.BucketVersioningStatusEnabled
+	}
+
+	_, err := c.client.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucketName),
+		VersioningConfiguration: &types.VersioningConfiguration{Status: status},
+	}, c.regionOption(ctx, bucketName))
+	if err != nil {
+		return fmt.Errorf("failed to set versioning of %s to %s: %w", bucketName, status, err)
+	}
+	return nil
+}
+
+// BucketEncryption is the default encryption applied to new objects
+type BucketEncryption struct {
+	Algorithm string // AES256, aws:kms or aws:kms:dsse
+	KMSKeyID  string // Empty for the AWS managed key
+	BucketKey bool
+}
+
+// GetBucketEncryption gets the default encryption of a bucket
+func (c *BucketsClient) GetBucketEncryption(ctx context.Context, bucketName string) (*BucketEncryption, error) {
+	output, err := c.client.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucketName),
+	}, c.regionOption(ctx, bucketName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get encryption of %s: %w", bucketName, err)
+	}
+
+	encryption := &BucketEncryption{}
+	if config := output.ServerSideEncryptionConfiguration; config != nil {
+		for _, rule := range config.Rules {
+			if rule.ApplyServerSideEncryptionByDefault == nil {
+				continue
+			}
+			encryption.Algorithm = string(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+			encryption.KMSKeyID = aws.ToString(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID)
+			encryption.BucketKey = aws.ToBool(rule.BucketKeyEnabled)
+			break
+		}
+	}
+	return encryption, nil
+}
+
+// PutBucketEncryption sets the default encryption of a bucket to SSE-S3, or to SSE-KMS
+// with an S3 Bucket Key when a KMS key is given
+func (c *BucketsClient) PutBucketEncryption(ctx context.Context, bucketName, kmsKeyID string) error {
+	rule := types.ServerSideEncryptionRule{
+		ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+			SSEAlgorithm: types.ServerSideEncryptionAes256,
+		},
+	}
+	if kmsKeyID != "" {
+		rule.ApplyServerSideEncryptionByDefault = &types.ServerSideEncryptionByDefault{
+			SSEAlgorithm:   types.ServerSideEncryptionAwsKms,
+			KMSMasterKeyID: aws.String(kmsKeyID),
+		}
+		// Bucket keys cut the KMS requests, and their cost, made for each object
+		rule.BucketKeyEnabled = aws.Bool(true)
+	}
+
+	_, err := c.client.PutBucketEncryption(ctx, &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucketName),
+		ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{rule},
+		},
+	}, c.regionOption(ctx, bucketName))
+	if err != nil {
+		return fmt.Errorf("failed to set encryption of %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// GetBucketTags gets the tags of a bucket, none if it has no tag set
+func (c *BucketsClient) GetBucketTags(ctx context.Context, bucketName string) (map[string]string, error) {
+	output, err := c.client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
+		Bucket: aws.String(bucketName),
+	}, c.regionOption(ctx, bucketName))
+	tags := make(map[string]string)
+	if err != nil {
+		if isNotConfigured(err, "NoSuchTagSet") {
+			return tags, nil
+		}
+		return nil, fmt.Errorf("failed to get tags of %s: %w", bucketName, err)
+	}
+	for _, tag := range output.TagSet {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
+}
+
+// PutBucketTags replaces the tag set of a bucket, removing it when there are no tags
+func (c *BucketsClient) PutBucketTags(ctx context.Context, bucketName string, tags map[string]string) error {
+	region := c.regionOption(ctx, bucketName)
+	var err error
+	if len(tags) == 0 {
+		_, err = c.client.DeleteBucketTagging(ctx, &s3.DeleteBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+		}, region)
+	} else {
+		tagSet := make([]types.Tag, 0, len(tags))
+		for key, value := range tags {
+			tagSet = append(tagSet, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+		_, err = c.client.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+			Bucket:  aws.String(bucketName),
+			Tagging: &types.Tagging{TagSet: tagSet},
+		}, region)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update tags of %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// regionOption sends requests to the bucket's region, which may differ from the
+// client's. If the lookup fails the client's region is used.
+func (c *BucketsClient) regionOption(ctx context.Context, bucketName string) func(*s3.Options) {
+	output, err := c.client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
+		Bucket: aws.String(bucketName),
+	})
+	region := ""
+	if err == nil {
+		region = string(output.LocationConstraint)
+		if region == "" {
+			region = "us-east-1" // Empty means us-east-1
+		}
+	}
+
+	return func(o *s3.Options) {
+		if region != "" {
+			o.Region = region
+		}
+	}
+}
+
+// isNotConfigured reports whether err is S3 saying a bucket has no configuration of a kind
+func isNotConfigured(err error, code string) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == code
+}
@@ -0,0 +1,233 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// DefaultDownloadConcurrency is how many objects are downloaded at once
+const DefaultDownloadConcurrency = 8
+
+// downloadAttempts is how often an object is tried before it counts as failed.
+// Each retry resumes from the bytes already written.
+const downloadAttempts = 3
+
+// partialSuffix marks files that are still being downloaded
+const partialSuffix = ".part"
+
+// DownloadProgress is a snapshot of a running download
+type DownloadProgress struct {
+	Objects      int // Objects finished, including skipped and failed ones
+	TotalObjects int
+	Bytes        int64 // Bytes on disk, including those of skipped and resumed objects
+	TotalBytes   int64
+}
+
+// DownloadResult is the outcome of a finished download
+type DownloadResult struct {
+	Downloaded int
+	Skipped    int              // Already present locally with the same size
+	Failed     map[string]error // By key
+	Bytes      int64            // Bytes transferred, excluding skipped objects
+	Elapsed    time.Duration
+}
+
+// DownloadObjects downloads objects into dir, keeping each key as a path below it.
+// Objects already present with the same size are skipped and partial files left
+// by an earlier attempt are resumed, so running the same download again picks up
+// where it stopped. progress, if set, is called about every interval until it returns.
+func (c *ObjectsClient) DownloadObjects(ctx context.Context, objects []Object, dir string, concurrency int, interval time.Duration, progress func(DownloadProgress)) *DownloadResult {
+	if concurrency < 1 {
+		concurrency = DefaultDownloadConcurrency
+	}
+	region := c.regionOption(ctx)
+
+	var totalBytes int64
+	for _, obj := range objects {
+		totalBytes += obj.Size
+	}
+
+	var (
+		finished    atomic.Int64
+		onDisk      atomic.Int64
+		transferred atomic.Int64
+		mu          sync.Mutex
+	)
+	result := &DownloadResult{Failed: make(map[string]error)}
+	start := time.Now()
+
+	// Report progress until every worker is done
+	stop := make(chan struct{})
+	reported := make(chan struct{})
+	go func() {
+		defer close(reported)
+		if progress == nil {
+			<-stop
+			return
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				progress(DownloadProgress{
+					Objects:      int(finished.Load()),
+					TotalObjects: len(objects),
+					Bytes:        onDisk.Load(),
+					TotalBytes:   totalBytes,
+				})
+			}
+		}
+	}()
+
+	jobs := make(chan Object)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for obj := range jobs {
+				skipped, err := c.downloadObject(ctx, obj, dir, region, &onDisk, &transferred)
+
+				mu.Lock()
+				switch {
+				case err != nil:
+					result.Failed[obj.Key] = err
+				case skipped:
+					result.Skipped++
+				default:
+					result.Downloaded++
+				}
+				mu.Unlock()
+				finished.Add(1)
+			}
+		}()
+	}
+
+	for _, obj := range objects {
+		if ctx.Err() != nil {
+			mu.Lock()
+			result.Failed[obj.Key] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+		jobs <- obj
+	}
+	close(jobs)
+	wg.Wait()
+
+	close(stop)
+	<-reported
+
+	result.Bytes = transferred.Load()
+	result.Elapsed = time.Since(start)
+	return result
+}
+
+// downloadObject downloads a single object, retrying and resuming on failure.
+// It reports whether the object was skipped because it was already downloaded.
+func (c *ObjectsClient) downloadObject(ctx context.Context, obj Object, dir string, region func(*s3.Options), onDisk, transferred *atomic.Int64) (bool, error) {
+	path, err := localPath(dir, obj.Key)
+	if err != nil {
+		return false, err
+	}
+
+	if info, err := os.Stat(path); err == nil && info.Size() == obj.Size {
+		onDisk.Add(obj.Size)
+		return true, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return false, fmt.Errorf("failed to create directory for %s: %w", obj.Key, err)
+	}
+
+	partial := path + partialSuffix
+	var written int64
+	if info, err := os.Stat(partial); err == nil && info.Size() <= obj.Size {
+		written = info.Size()
+		onDisk.Add(written)
+	}
+
+	for attempt := 1; ; attempt++ {
+		n, err := c.downloadRange(ctx, obj.Key, partial, written, region)
+		written += n
+		onDisk.Add(n)
+		transferred.Add(n)
+
+		if err == nil && written == obj.Size {
+			break
+		}
+		if err == nil {
+			err = fmt.Errorf("got %d of %d bytes", written, obj.Size)
+		}
+		if attempt == downloadAttempts || ctx.Err() != nil {
+			return false, fmt.Errorf("failed to download %s: %w", obj.Key, err)
+		}
+	}
+
+	if err := os.Rename(partial, path); err != nil {
+		return false, fmt.Errorf("failed to finish %s: %w", obj.Key, err)
+	}
+	return false, nil
+}
+
+// downloadRange appends the object from offset onwards to the file at path and
+// returns how many bytes were written, even if it failed part way through
+func (c *ObjectsClient) downloadRange(ctx context.Context, key, path string, offset int64, region func(*s3.Options)) (int64, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	}
+	if offset > 0 {
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	output, err := c.client.GetObject(ctx, input, region)
+	if err != nil {
+		return 0, err
+	}
+	defer output.Body.Close()
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	// Drop anything past the offset, e.g. a torn write from a crashed run
+	if err := file.Truncate(offset); err != nil {
+		return 0, err
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return io.Copy(file, output.Body)
+}
+
+// localPath maps a key to a path below dir, refusing keys that would escape it
+func localPath(dir, key string) (string, error) {
+	if key == "" || strings.HasSuffix(key, "/") {
+		return "", errors.New("folder markers are not downloaded")
+	}
+
+	path := filepath.Join(dir, filepath.FromSlash(key))
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("key %s would be written outside %s", key, dir)
+	}
+	return path, nil
+}
@@ -0,0 +1,218 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// ObjectsClient wraps the S3 client for the objects of a single bucket
+type ObjectsClient struct {
+	client     *s3.Client
+	bucket     string
+	region     string // Bucket region, resolved on first use
+	regionOnce sync.Once
+}
+
+// NewObjectsClient creates a new S3 objects client for a bucket
+func NewObjectsClient(client *s3.Client, bucket string) *ObjectsClient {
+	return &ObjectsClient{client: client, bucket: bucket}
+}
+
+// Object represents an S3 object
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+	StorageClass string
+	ETag         string
+
+	// Restore of an archived object, only known for objects fetched one at a time
+	Restoring     bool
+	RestoredUntil time.Time // Set once a restore finished, when its copy expires
+}
+
+// Listing is one level of a bucket, the "folders" and objects directly under a prefix
+type Listing struct {
+	Prefixes  []string
+	Objects   []Object
+	Truncated bool   // More entries exist past the requested maximum
+	NextToken string // The next page of a paged listing, "" after the last
+}
+
+// Bucket returns the bucket the client lists
+func (c *ObjectsClient) Bucket() string {
+	return c.bucket
+}
+
+// regionOption sends requests to the bucket's region, which may differ from the
+// client's. The region is looked up once; if that fails the client's region is used.
+func (c *ObjectsClient) regionOption(ctx context.Context) func(*s3.Options) {
+	c.regionOnce.Do(func() {
+		output, err := c.client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
+			Bucket: aws.String(c.bucket),
+		})
+		if err != nil {
+			return
+		}
+		c.region = string(output.LocationConstraint)
+		if c.region == "" {
+			c.region = "us-east-1" // Empty means us-east-1
+		}
+	})
+
+	return func(o *s3.Options) {
+		if c.region != "" {
+			o.Region = c.region
+		}
+	}
+}
+
+// ListPrefix lists the folders and objects directly under a prefix, stopping after
+// maxItems entries unless it is 0
+func (c *ObjectsClient) ListPrefix(ctx context.Context, prefix string, maxItems int) (*Listing, error) {
+	region := c.regionOption(ctx)
+	listing := &Listing{}
+	var token *string
+
+	for {
+		output, err := c.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(c.bucket),
+			Prefix:            aws.String(prefix),
+			Delimiter:         aws.String("/"),
+			ContinuationToken: token,
+		}, region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects in s3://%s/%s: %w", c.bucket, prefix, err)
+		}
+
+		listing.add(output, prefix)
+
+		if !aws.ToBool(output.IsTruncated) {
+			break
+		}
+		if maxItems > 0 && len(listing.Prefixes)+len(listing.Objects) >= maxItems {
+			listing.Truncated = true
+			break
+		}
+		token = output.NextContinuationToken
+	}
+
+	return listing, nil
+}
+
+// ListPrefixPage lists one page of up to pageSize folders and objects directly under a
+// prefix, from the page token. NextToken of the listing is "" after the last page.
+func (c *ObjectsClient) ListPrefixPage(ctx context.Context, prefix, token string, pageSize int) (*Listing, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket:    aws.String(c.bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+		MaxKeys:   aws.Int32(int32(pageSize)),
+	}
+	if token != "" {
+		input.ContinuationToken = aws.String(token)
+	}
+
+	output, err := c.client.ListObjectsV2(ctx, input, c.regionOption(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects in s3://%s/%s: %w", c.bucket, prefix, err)
+	}
+
+	listing := &Listing{}
+	listing.add(output, prefix)
+	if aws.ToBool(output.IsTruncated) {
+		listing.NextToken = aws.ToString(output.NextContinuationToken)
+	}
+	return listing, nil
+}
+
+// add adds the folders and objects of a page of a listing under prefix
+func (l *Listing) add(output *s3.ListObjectsV2Output, prefix string) {
+	for _, p := range output.CommonPrefixes {
+		l.Prefixes = append(l.Prefixes, aws.ToString(p.Prefix))
+	}
+	for _, obj := range output.Contents {
+		// Skip the zero-byte marker some tools create for the folder itself
+		if aws.ToString(obj.Key) == prefix {
+			continue
+		}
+		l.Objects = append(l.Objects, convertObject(obj.Key, obj.Size, obj.LastModified, string(obj.StorageClass), obj.ETag))
+	}
+}
+
+// ListAll lists every object under a prefix, recursively
+func (c *ObjectsClient) ListAll(ctx context.Context, prefix string) ([]Object, error) {
+	region := c.regionOption(ctx)
+	var objects []Object
+	var token *string
+
+	for {
+		output, err := c.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(c.bucket),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: token,
+		}, region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects in s3://%s/%s: %w", c.bucket, prefix, err)
+		}
+
+		for _, obj := range output.Contents {
+			objects = append(objects, convertObject(obj.Key, obj.Size, obj.LastModified, string(obj.StorageClass), obj.ETag))
+		}
+
+		if !aws.ToBool(output.IsTruncated) {
+			break
+		}
+		token = output.NextContinuationToken
+	}
+
+	return objects, nil
+}
+
+// GetObject gets the metadata of a single object
+func (c *ObjectsClient) GetObject(ctx context.Context, key string) (*Object, error) {
+	output, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	}, c.regionOption(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get s3://%s/%s: %w", c.bucket, key, err)
+	}
+
+	obj := convertObject(aws.String(key), output.ContentLength, output.LastModified, string(output.StorageClass), output.ETag)
+	obj.Restoring, obj.RestoredUntil = parseRestore(aws.ToString(output.Restore))
+	return &obj, nil
+}
+
+// DeleteObject deletes an object. In versioned buckets this adds a delete marker.
+func (c *ObjectsClient) DeleteObject(ctx context.Context, key string) error {
+	_, err := c.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	}, c.regionOption(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete s3://%s/%s: %w", c.bucket, key, err)
+	}
+	return nil
+}
+
+func convertObject(key *string, size *int64, lastModified *time.Time, storageClass string, etag *string) Object {
+	obj := Object{
+		Key:          aws.ToString(key),
+		Size:         aws.ToInt64(size),
+		StorageClass: storageClass,
+		ETag:         aws.ToString(etag),
+	}
+	if lastModified != nil {
+		obj.LastModified = *lastModified
+	}
+	if obj.StorageClass == "" {
+		obj.StorageClass = "STANDARD"
+	}
+	return obj
+}
@@ -0,0 +1,101 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+// MaxCopySize is the largest object CopyObject copies in a single request
+const MaxCopySize = 5 * 1024 * 1024 * 1024
+
+// restoreExpiry matches the expiry date of a finished restore in the x-amz-restore header,
+// e.g. ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"
+var restoreExpiry = regexp.MustCompile(`expiry-date="([^"]+)"`)
+
+// RestoreObject starts a temporary restore of an archived object, keeping the copy
+// for days. Tier is Expedited, Standard or Bulk.
+func (c *ObjectsClient) RestoreObject(ctx context.Context, key string, days int32, tier string) error {
+	_, err := c.client.RestoreObject(ctx, &s3.RestoreObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+		RestoreRequest: &types.RestoreRequest{
+			Days: aws.Int32(days),
+			GlacierJobParameters: &types.GlacierJobParameters{
+				Tier: types.Tier(tier),
+			},
+		},
+	}, c.regionOption(ctx))
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "RestoreAlreadyInProgress" {
+			return fmt.Errorf("a restore of s3://%s/%s is already in progress", c.bucket, key)
+		}
+		return fmt.Errorf("failed to restore s3://%s/%s: %w", c.bucket, key, err)
+	}
+	return nil
+}
+
+// SetStorageClass moves an object to another storage class by copying it onto itself.
+// Metadata, tags and the object's KMS key are kept. In versioned buckets the copy is
+// a new version.
+func (c *ObjectsClient) SetStorageClass(ctx context.Context, key, storageClass string) error {
+	region := c.regionOption(ctx)
+
+	head, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	}, region)
+	if err != nil {
+		return fmt.Errorf("failed to get s3://%s/%s: %w", c.bucket, key, err)
+	}
+	if size := aws.ToInt64(head.ContentLength); size > MaxCopySize {
+		return fmt.Errorf("s3://%s/%s is larger than 5 GB, which a copy in place can't handle", c.bucket, key)
+	}
+
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(c.bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(url.PathEscape(c.bucket + "/" + key)),
+		StorageClass:      types.StorageClass(storageClass),
+		MetadataDirective: types.MetadataDirectiveCopy,
+	}
+	// Without this the copy would be encrypted with the bucket's default instead
+	if head.ServerSideEncryption == types.ServerSideEncryptionAwsKms {
+		input.ServerSideEncryption = head.ServerSideEncryption
+		input.SSEKMSKeyId = head.SSEKMSKeyId
+		input.BucketKeyEnabled = head.BucketKeyEnabled
+	}
+
+	if _, err := c.client.CopyObject(ctx, input, region); err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "InvalidObjectState" {
+			return fmt.Errorf("s3://%s/%s is archived, restore it before changing its storage class", c.bucket, key)
+		}
+		return fmt.Errorf("failed to change the storage class of s3://%s/%s: %w", c.bucket, key, err)
+	}
+	return nil
+}
+
+// parseRestore reads the x-amz-restore header of an archived object: whether a restore
+// is running, and until when a finished restore's copy is kept
+func parseRestore(header string) (inProgress bool, expiry time.Time) {
+	if header == "" {
+		return false, time.Time{}
+	}
+	if m := restoreExpiry.FindStringSubmatch(header); m != nil {
+		if t, err := time.Parse(time.RFC1123, m[1]); err == nil {
+			return false, t
+		}
+	}
+	return strings.Contains(header, `ongoing-request="true"`), time.Time{}
+}
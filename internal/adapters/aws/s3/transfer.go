@@ -0,0 +1,190 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// TransferProgress is a snapshot of a running single object upload or download
+type TransferProgress struct {
+	Bytes      int64
+	TotalBytes int64
+}
+
+// TransferResult is the outcome of a finished single object upload or download
+type TransferResult struct {
+	Bucket  string
+	Key     string
+	Path    string // Local file
+	Bytes   int64
+	Elapsed time.Duration
+}
+
+// DownloadFile downloads an object to the file at path. Large objects are fetched
+// in parallel ranged parts by the transfer manager. The object is written next to
+// path first and only moved into place once complete. progress, if set, is called
+// about every interval until it returns.
+func (c *ObjectsClient) DownloadFile(ctx context.Context, key, path string, interval time.Duration, progress func(TransferProgress)) (*TransferResult, error) {
+	region := c.regionOption(ctx)
+
+	head, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	}, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get s3://%s/%s: %w", c.bucket, key, err)
+	}
+	total := aws.ToInt64(head.ContentLength)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+	partial := path + partialSuffix
+	file, err := os.Create(partial)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", partial, err)
+	}
+
+	var written atomic.Int64
+	stop := reportTransfer(&written, total, interval, progress)
+	start := time.Now()
+
+	downloader := manager.NewDownloader(c.client, manager.WithDownloaderClientOptions(region))
+	n, err := downloader.Download(ctx, &countingWriterAt{w: file, n: &written}, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	stop()
+	closeErr := file.Close()
+
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(partial)
+		return nil, fmt.Errorf("failed to download s3://%s/%s: %w", c.bucket, key, err)
+	}
+	if err := os.Rename(partial, path); err != nil {
+		return nil, fmt.Errorf("failed to finish %s: %w", path, err)
+	}
+
+	return &TransferResult{Bucket: c.bucket, Key: key, Path: path, Bytes: n, Elapsed: time.Since(start)}, nil
+}
+
+// UploadFile uploads the file at path as key. Files larger than a part are sent as
+// a multipart upload by the transfer manager, which aborts the upload if it fails.
+// progress, if set, is called about every interval until it returns.
+func (c *ObjectsClient) UploadFile(ctx context.Context, path, key string, interval time.Duration, progress func(TransferProgress)) (*TransferResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory, select a file to upload", path)
+	}
+	total := info.Size()
+
+	// The body is only read forward, so the part size has to fit the whole file
+	// into the part limit up front
+	partSize := manager.DefaultUploadPartSize
+	if needed := total/int64(manager.MaxUploadParts) + 1; needed > partSize {
+		partSize = needed
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	}
+	if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+
+	var read atomic.Int64
+	input.Body = &countingReader{r: file, n: &read}
+
+	stop := reportTransfer(&read, total, interval, progress)
+	start := time.Now()
+
+	region := c.regionOption(ctx)
+	uploader := manager.NewUploader(c.client, func(u *manager.Uploader) {
+		u.PartSize = partSize
+		u.ClientOptions = append(u.ClientOptions, region)
+	})
+	_, err = uploader.Upload(ctx, input)
+	stop()
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload %s to s3://%s/%s: %w", path, c.bucket, key, err)
+	}
+
+	return &TransferResult{Bucket: c.bucket, Key: key, Path: path, Bytes: total, Elapsed: time.Since(start)}, nil
+}
+
+// reportTransfer calls progress about every interval with the bytes counted so far.
+// The returned function stops reporting and waits for the last call to return.
+func reportTransfer(bytes *atomic.Int64, total int64, interval time.Duration, progress func(TransferProgress)) func() {
+	if progress == nil {
+		return func() {}
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				progress(TransferProgress{Bytes: bytes.Load(), TotalBytes: total})
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+// countingWriterAt counts the bytes the downloader writes, in any order
+type countingWriterAt struct {
+	w io.WriterAt
+	n *atomic.Int64
+}
+
+func (c *countingWriterAt) WriteAt(p []byte, off int64) (int, error) {
+	n, err := c.w.WriteAt(p, off)
+	c.n.Add(int64(n))
+	return n, err
+}
+
+// countingReader counts the bytes the uploader has read. It deliberately hides the
+// file's Seek and ReadAt so every byte is read exactly once.
+type countingReader struct {
+	r io.Reader
+	n *atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
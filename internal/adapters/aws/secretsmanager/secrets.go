@@ -28,6 +28,10 @@ type Secret struct {
 	KmsKeyID           string
 	RotationEnabled    bool
 	RotationLambdaARN  string
+	RotationSchedule   string // Rotation interval or schedule expression, with its window
+	RotationExpression string // Schedule expression, with intervals given as rate(N days)
+	RotationWindow     string // Rotation window duration, such as 3h
+	NextRotationDate   time.Time
 	LastChangedDate    time.Time
 	LastAccessedDate   time.Time
 	LastRotatedDate    time.Time
@@ -65,6 +69,11 @@ func (c *SecretsClient) ListSecrets(ctx context.Context) ([]Secret, error) {
 				Tags:              make(map[string]string),
 			}
 
+			secret.RotationSchedule = rotationSchedule(entry.RotationRules)
+			secret.RotationExpression, secret.RotationWindow = rotationExpression(entry.RotationRules)
+			if entry.NextRotationDate != nil {
+				secret.NextRotationDate = *entry.NextRotationDate
+			}
 			if entry.LastChangedDate != nil {
 				secret.LastChangedDate = *entry.LastChangedDate
 			}
@@ -115,6 +124,11 @@ func (c *SecretsClient) GetSecret(ctx context.Context, secretID string) (*Secret
 		Tags:              make(map[string]string),
 	}
 
+	secret.RotationSchedule = rotationSchedule(output.RotationRules)
+	secret.RotationExpression, secret.RotationWindow = rotationExpression(output.RotationRules)
+	if output.NextRotationDate != nil {
+		secret.NextRotationDate = *output.NextRotationDate
+	}
 	if output.LastChangedDate != nil {
 		secret.LastChangedDate = *output.LastChangedDate
 	}
@@ -176,6 +190,176 @@ func (c *SecretsClient) GetSecretVersionIDs(ctx context.Context, secretID string
 	return versionIDs, nil
 }
 
+// RotationPending reports whether a version of the secret is still labeled AWSPENDING
+// without being current, which a rotation leaves behind when it fails part way
+func (c *SecretsClient) RotationPending(ctx context.Context, secretID string) (bool, error) {
+	paginator := secretsmanager.NewListSecretVersionIdsPaginator(c.client, &secretsmanager.ListSecretVersionIdsInput{
+		SecretId: aws.String(secretID),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to list secret versions: %w", err)
+		}
+
+		for _, version := range output.Versions {
+			pending, current := false, false
+			for _, stage := range version.VersionStages {
+				switch stage {
+				case "AWSPENDING":
+					pending = true
+				case "AWSCURRENT":
+					current = true
+				}
+			}
+			if pending && !current {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// SecretVersion is a version of a secret's value and the staging labels attached to it
+type SecretVersion struct {
+	ID           string
+	Stages       []string
+	Created      time.Time
+	LastAccessed time.Time
+}
+
+// HasStage reports whether the version carries a staging label
+func (v SecretVersion) HasStage(stage string) bool {
+	for _, s := range v.Stages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// ListVersions lists the versions of a secret, including those without a staging label
+// that Secrets Manager hasn't removed yet
+func (c *SecretsClient) ListVersions(ctx context.Context, secretID string) ([]SecretVersion, error) {
+	paginator := secretsmanager.NewListSecretVersionIdsPaginator(c.client, &secretsmanager.ListSecretVersionIdsInput{
+		SecretId:          aws.String(secretID),
+		IncludeDeprecated: aws.Bool(true),
+	})
+
+	var versions []SecretVersion
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secret versions: %w", err)
+		}
+
+		for _, v := range output.Versions {
+			version := SecretVersion{
+				ID:     aws.ToString(v.VersionId),
+				Stages: v.VersionStages,
+			}
+			if v.CreatedDate != nil {
+				version.Created = *v.CreatedDate
+			}
+			if v.LastAccessedDate != nil {
+				version.LastAccessed = *v.LastAccessedDate
+			}
+			versions = append(versions, version)
+		}
+	}
+
+	return versions, nil
+}
+
+// PromoteVersion moves the AWSCURRENT label to a version. Secrets Manager moves
+// AWSPREVIOUS to the version that was current.
+func (c *SecretsClient) PromoteVersion(ctx context.Context, secretID, versionID, currentVersionID string) error {
+	_, err := c.client.UpdateSecretVersionStage(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+		SecretId:            aws.String(secretID),
+		VersionStage:        aws.String("AWSCURRENT"),
+		MoveToVersionId:     aws.String(versionID),
+		RemoveFromVersionId: aws.String(currentVersionID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to promote secret version: %w", err)
+	}
+	return nil
+}
+
+// rotationSchedule describes a secret's rotation rules, empty when it has none
+func rotationSchedule(rules *types.RotationRulesType) string {
+	if rules == nil {
+		return ""
+	}
+
+	var schedule string
+	switch {
+	case rules.ScheduleExpression != nil:
+		schedule = aws.ToString(rules.ScheduleExpression)
+	case rules.AutomaticallyAfterDays != nil:
+		schedule = fmt.Sprintf("every %d days", aws.ToInt64(rules.AutomaticallyAfterDays))
+	default:
+		return ""
+	}
+
+	if rules.Duration != nil {
+		schedule += fmt.Sprintf(" (%s window)", aws.ToString(rules.Duration))
+	}
+	return schedule
+}
+
+// rotationExpression returns a secret's rotation rules as a schedule expression and window,
+// so they can be edited and written back
+func rotationExpression(rules *types.RotationRulesType) (string, string) {
+	if rules == nil {
+		return "", ""
+	}
+
+	var expression string
+	switch {
+	case rules.ScheduleExpression != nil:
+		expression = aws.ToString(rules.ScheduleExpression)
+	case rules.AutomaticallyAfterDays != nil:
+		expression = fmt.Sprintf("rate(%d days)", aws.ToInt64(rules.AutomaticallyAfterDays))
+	}
+	return expression, aws.ToString(rules.Duration)
+}
+
+// ConfigureRotation turns on rotation with a Lambda function and schedule. The first
+// rotation runs on the schedule rather than immediately.
+func (c *SecretsClient) ConfigureRotation(ctx context.Context, secretID, lambdaARN, expression, window string) error {
+	rules := &types.RotationRulesType{
+		ScheduleExpression: aws.String(expression),
+	}
+	if window != "" {
+		rules.Duration = aws.String(window)
+	}
+
+	_, err := c.client.RotateSecret(ctx, &secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(secretID),
+		RotationLambdaARN: aws.String(lambdaARN),
+		RotationRules:     rules,
+		RotateImmediately: aws.Bool(false),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure rotation: %w", err)
+	}
+	return nil
+}
+
+// RotateNow starts a rotation with the secret's configured Lambda function
+func (c *SecretsClient) RotateNow(ctx context.Context, secretID string) error {
+	_, err := c.client.RotateSecret(ctx, &secretsmanager.RotateSecretInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rotate secret: %w", err)
+	}
+	return nil
+}
+
 // GetSecretValue retrieves the actual secret value
 func (c *SecretsClient) GetSecretValue(ctx context.Context, secretID string) (string, error) {
 	output, err := c.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
@@ -198,6 +382,26 @@ func (c *SecretsClient) GetSecretValue(ctx context.Context, secretID string) (st
 	return "", fmt.Errorf("secret has no value")
 }
 
+// GetSecretVersionValue retrieves the value of a specific version of a secret
+func (c *SecretsClient) GetSecretVersionValue(ctx context.Context, secretID, versionID string) (string, error) {
+	output, err := c.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId:  aws.String(secretID),
+		VersionId: aws.String(versionID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret version value: %w", err)
+	}
+
+	if output.SecretString != nil {
+		return aws.ToString(output.SecretString), nil
+	}
+	if output.SecretBinary != nil {
+		return string(output.SecretBinary), nil
+	}
+
+	return "", fmt.Errorf("secret version has no value")
+}
+
 // UpdateSecretValue updates a secret's value
 func (c *SecretsClient) UpdateSecretValue(ctx context.Context, secretID, secretValue string) error {
 	_, err := c.client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
@@ -266,3 +470,28 @@ func (c *SecretsClient) DeleteSecret(ctx context.Context, secretID string, recov
 
 	return nil
 }
+
+// UpdateTags adds or changes the tags in set on a secret and removes the keys in remove
+func (c *SecretsClient) UpdateTags(ctx context.Context, secretID string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		tags := make([]types.Tag, 0, len(set))
+		for key, value := range set {
+			tags = append(tags, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+		}
+		if _, err := c.client.TagResource(ctx, &secretsmanager.TagResourceInput{
+			SecretId: aws.String(secretID),
+			Tags:     tags,
+		}); err != nil {
+			return fmt.Errorf("failed to tag secret %s: %w", secretID, err)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := c.client.UntagResource(ctx, &secretsmanager.UntagResourceInput{
+			SecretId: aws.String(secretID),
+			TagKeys:  remove,
+		}); err != nil {
+			return fmt.Errorf("failed to remove tags from secret %s: %w", secretID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package securityhub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+)
+
+// batchUpdateSize is the most findings BatchUpdateFindings takes at once
+const batchUpdateSize = 100
+
+// FindingsClient wraps the Security Hub client for findings
+type FindingsClient struct {
+	client *securityhub.Client
+}
+
+// NewFindingsClient creates a new Security Hub findings client
+func NewFindingsClient(client *securityhub.Client) *FindingsClient {
+	return &FindingsClient{client: client}
+}
+
+// FindingFilters narrows the findings listed. Empty fields don't filter.
+type FindingFilters struct {
+	Severities         []string // CRITICAL, HIGH, MEDIUM, LOW, INFORMATIONAL
+	ComplianceStatuses []string // FAILED, WARNING, PASSED, NOT_AVAILABLE
+	WorkflowStatuses   []string // NEW, NOTIFIED, SUPPRESSED, RESOLVED
+	Standard           string   // Part of a standard's ID, e.g. cis-aws-foundations-benchmark
+	ControlID          string   // A security control, e.g. S3.1
+}
+
+// FindingRef identifies a finding for updates
+type FindingRef struct {
+	ID         string
+	ProductARN string
+}
+
+// Finding is an active Security Hub finding
+type Finding struct {
+	FindingRef
+	Title            string
+	Description      string
+	Severity         string
+	ComplianceStatus string
+	WorkflowStatus   string
+	ControlID        string
+	Standards        []string
+	GeneratorID      string
+	ProductName      string
+	AccountID        string
+	Region           string
+	ResourceType     string
+	ResourceID       string
+	Remediation      string
+	RemediationURL   string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// ListFindings lists active findings matching the filters, most severe first, stopping
+// after maxItems when it isn't 0. It reports whether more findings matched.
+func (c *FindingsClient) ListFindings(ctx context.Context, filters FindingFilters, maxItems int) ([]Finding, bool, error) {
+	input := &securityhub.GetFindingsInput{
+		Filters: &types.AwsSecurityFindingFilters{
+			RecordState:                 equalsAny([]string{string(types.RecordStateActive)}),
+			SeverityLabel:               equalsAny(filters.Severities),
+			ComplianceStatus:            equalsAny(filters.ComplianceStatuses),
+			WorkflowStatus:              equalsAny(filters.WorkflowStatuses),
+			ComplianceSecurityControlId: equalsAny(nonEmpty(filters.ControlID)),
+		},
+		SortCriteria: []types.SortCriterion{
+			{Field: aws.String("SeverityNormalized"), SortOrder: types.SortOrderDescending},
+		},
+		MaxResults: aws.Int32(100),
+	}
+	if filters.Standard != "" {
+		input.Filters.ComplianceAssociatedStandardsId = []types.StringFilter{{
+			Value:      aws.String(filters.Standard),
+			Comparison: types.StringFilterComparisonContains,
+		}}
+	}
+
+	var findings []Finding
+	paginator := securityhub.NewGetFindingsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		if maxItems > 0 && len(findings) >= maxItems {
+			return findings[:maxItems], true, nil
+		}
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get findings: %w", err)
+		}
+		for _, f := range page.Findings {
+			findings = append(findings, toFinding(f))
+		}
+	}
+
+	if maxItems > 0 && len(findings) > maxItems {
+		return findings[:maxItems], true, nil
+	}
+	return findings, false, nil
+}
+
+// UpdateWorkflowStatus sets the workflow status of findings, with an optional note,
+// returning an error per finding that wasn't updated
+func (c *FindingsClient) UpdateWorkflowStatus(ctx context.Context, refs []FindingRef, status, note string) ([]string, error) {
+	var failures []string
+	for start := 0; start < len(refs); start += batchUpdateSize {
+		end := min(start+batchUpdateSize, len(refs))
+
+		input := &securityhub.BatchUpdateFindingsInput{
+			Workflow: &types.WorkflowUpdate{Status: types.WorkflowStatus(status)},
+		}
+		for _, ref := range refs[start:end] {
+			input.FindingIdentifiers = append(input.FindingIdentifiers, types.AwsSecurityFindingIdentifier{
+				Id:         aws.String(ref.ID),
+				ProductArn: aws.String(ref.ProductARN),
+			})
+		}
+		if note != "" {
+			input.Note = &types.NoteUpdate{Text: aws.String(note), UpdatedBy: aws.String("aws-tui")}
+		}
+
+		output, err := c.client.BatchUpdateFindings(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update findings: %w", err)
+		}
+		for _, unprocessed := range output.UnprocessedFindings {
+			id := ""
+			if unprocessed.FindingIdentifier != nil {
+				id = aws.ToString(unprocessed.FindingIdentifier.Id)
+			}
+			failures = append(failures, fmt.Sprintf("%s: %s", id, aws.ToString(unprocessed.ErrorMessage)))
+		}
+	}
+	return failures, nil
+}
+
+func toFinding(f types.AwsSecurityFinding) Finding {
+	finding := Finding{
+		FindingRef: FindingRef{
+			ID:         aws.ToString(f.Id),
+			ProductARN: aws.ToString(f.ProductArn),
+		},
+		Title:       aws.ToString(f.Title),
+		Description: aws.ToString(f.Description),
+		GeneratorID: aws.ToString(f.GeneratorId),
+		ProductName: aws.ToString(f.ProductName),
+		AccountID:   aws.ToString(f.AwsAccountId),
+		Region:      aws.ToString(f.Region),
+		CreatedAt:   parseTime(aws.ToString(f.CreatedAt)),
+		UpdatedAt:   parseTime(aws.ToString(f.UpdatedAt)),
+	}
+	if f.Severity != nil {
+		finding.Severity = string(f.Severity.Label)
+	}
+	if f.Workflow != nil {
+		finding.WorkflowStatus = string(f.Workflow.Status)
+	}
+	if f.Compliance != nil {
+		finding.ComplianceStatus = string(f.Compliance.Status)
+		finding.ControlID = aws.ToString(f.Compliance.SecurityControlId)
+		for _, standard := range f.Compliance.AssociatedStandards {
+			finding.Standards = append(finding.Standards, aws.ToString(standard.StandardsId))
+		}
+	}
+	if len(f.Resources) > 0 {
+		finding.ResourceType = aws.ToString(f.Resources[0].Type)
+		finding.ResourceID = aws.ToString(f.Resources[0].Id)
+	}
+	if f.Remediation != nil && f.Remediation.Recommendation != nil {
+		finding.Remediation = aws.ToString(f.Remediation.Recommendation.Text)
+		finding.RemediationURL = aws.ToString(f.Remediation.Recommendation.Url)
+	}
+	return finding
+}
+
+// equalsAny builds a filter matching any of the values, or no filter when there are none
+func equalsAny(values []string) []types.StringFilter {
+	var filters []types.StringFilter
+	for _, value := range values {
+		filters = append(filters, types.StringFilter{
+			Value:      aws.String(value),
+			Comparison: types.StringFilterComparisonEquals,
+		})
+	}
+	return filters
+}
+
+func nonEmpty(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+func parseTime(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, value)
+	return t
+}
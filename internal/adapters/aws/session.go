@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+)
+
+// SessionPolicy limits what an assumed role session may do. The session only gets the
+// permissions allowed by both the role and the policy, so it can't exceed either.
+type SessionPolicy struct {
+	Name        string
+	Description string
+	Document    string   // Inline policy JSON
+	PolicyARNs  []string // Managed policies used as session policies
+	ReadOnly    bool     // Known to allow no mutating calls
+}
+
+// BuiltinSessionPolicies returns the session policies that are always available
+func BuiltinSessionPolicies() []SessionPolicy {
+	return []SessionPolicy{
+		{
+			Name:        "read-only",
+			Description: "AWS ReadOnlyAccess: describe, list and read data, no changes",
+			PolicyARNs:  []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+			ReadOnly:    true,
+		},
+		{
+			Name:        "view-only",
+			Description: "AWS ViewOnlyAccess: metadata only, no secret values or object contents",
+			PolicyARNs:  []string{"arn:aws:iam::aws:policy/job-function/ViewOnlyAccess"},
+			ReadOnly:    true,
+		},
+	}
+}
+
+// AssumedRole is a role assumed from the TUI, optionally scoped by a session policy
+type AssumedRole struct {
+	RoleARN     string
+	SessionName string
+	ExternalID  string         // Required by some cross-account trust policies
+	Policy      *SessionPolicy // Nil for the role's full permissions
+}
+
+// MFATokenFunc asks for the current code of the MFA device with the given serial
+// number. It may block until the code is entered.
+type MFATokenFunc func(serial string) (string, error)
+
+// SetMFATokenFunc sets how MFA codes are asked for when a profile's role_arn comes with
+// an mfa_serial. Without it such profiles fail to load credentials.
+func (cm *ClientManager) SetMFATokenFunc(fn MFATokenFunc) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.mfaToken = fn
+}
+
+// mfaTokenOption gives assume-role profiles with an mfa_serial a token provider that
+// asks fn for the code. The SDK sets SerialNumber from the profile before applying it.
+func mfaTokenOption(fn MFATokenFunc) func(*stscreds.AssumeRoleOptions) {
+	return func(o *stscreds.AssumeRoleOptions) {
+		if o.SerialNumber == nil || o.TokenProvider != nil {
+			return
+		}
+		serial := aws.ToString(o.SerialNumber)
+		o.TokenProvider = func() (string, error) {
+			return fn(serial)
+		}
+	}
+}
+
+// Scoped returns whether a session policy limits the session
+func (r AssumedRole) Scoped() bool {
+	return r.Policy != nil
+}
+
+// DefaultSessionName names sessions after the local user so CloudTrail shows who assumed the role
+func DefaultSessionName() string {
+	user := os.Getenv("USER")
+	if user == "" {
+		user = "user"
+	}
+	return fmt.Sprintf("aws-tui-%s-%d", user, time.Now().Unix())
+}
+
+// AssumeRole switches every client to credentials of the given role, assumed with the
+// current profile's credentials. It stays in effect across region switches until
+// DropAssumedRole is called or the profile changes. The lock isn't held while the role is
+// assumed, as STS, or an MFA code for the profile's credentials, can take a while.
+func (cm *ClientManager) AssumeRole(ctx context.Context, role AssumedRole) error {
+	cm.mu.RLock()
+	base := cm.baseConfig
+	profile := cm.profile
+	cm.mu.RUnlock()
+
+	cfg, err := assumeRoleConfig(ctx, base, role)
+	if err != nil {
+		return err
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if cm.profile != profile {
+		return fmt.Errorf("profile changed to %s while assuming role %s", cm.profile, role.RoleARN)
+	}
+	cm.assumedRole = &role
+	cm.currentConfig = cfg
+	cm.resetClients()
+	return nil
+}
+
+// DropAssumedRole switches back to the profile's own credentials
+func (cm *ClientManager) DropAssumedRole() {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.assumedRole == nil {
+		return
+	}
+	cm.assumedRole = nil
+	cm.currentConfig = cm.baseConfig
+	cm.resetClients()
+}
+
+// AssumedRole returns the role in use, or nil if the profile's credentials are used
+func (cm *ClientManager) AssumedRole() *AssumedRole {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.assumedRole
+}
+
+// CredentialsEnv returns the environment variables that give other tools, like the AWS
+// CLI, the credentials in use: the assumed role's, or those the profile resolved to
+// through SSO, role chaining or a credential_process. Tools given them don't resolve the
+// profile themselves, which some do differently or can't do at all.
+func (cm *ClientManager) CredentialsEnv(ctx context.Context) ([]string, error) {
+	cm.mu.RLock()
+	credentials := cm.currentConfig.Credentials
+	cm.mu.RUnlock()
+
+	if credentials == nil {
+		return nil, fmt.Errorf("no AWS credentials loaded, press p to select a profile")
+	}
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+	env := []string{
+		"AWS_ACCESS_KEY_ID=" + creds.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY=" + creds.SecretAccessKey,
+	}
+	if creds.SessionToken != "" {
+		env = append(env, "AWS_SESSION_TOKEN="+creds.SessionToken)
+	}
+	if creds.CanExpire {
+		env = append(env, "AWS_CREDENTIAL_EXPIRATION="+creds.Expires.UTC().Format(time.RFC3339))
+	}
+	return env, nil
+}
+
+// assumeRoleConfig returns a copy of base that uses credentials of the role. The first
+// credentials are fetched right away so a denied AssumeRole fails here.
+func assumeRoleConfig(ctx context.Context, base aws.Config, role AssumedRole) (aws.Config, error) {
+	provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(base), role.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = role.SessionName
+		if role.ExternalID != "" {
+			o.ExternalID = aws.String(role.ExternalID)
+		}
+		if role.Policy == nil {
+			return
+		}
+		if role.Policy.Document != "" {
+			o.Policy = aws.String(role.Policy.Document)
+		}
+		for _, arn := range role.Policy.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{Arn: aws.String(arn)})
+		}
+	})
+
+	cache := aws.NewCredentialsCache(provider)
+	if _, err := cache.Retrieve(ctx); err != nil {
+		return aws.Config{}, fmt.Errorf("failed to assume role %s: %w", role.RoleARN, err)
+	}
+
+	cfg := base.Copy()
+	cfg.Credentials = cache
+	return cfg, nil
+}
@@ -0,0 +1,138 @@
+package ssmsession
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Message types of the data channel
+const (
+	typeInput         = "input_stream_data"
+	typeOutput        = "output_stream_data"
+	typeAcknowledge   = "acknowledge"
+	typeChannelClosed = "channel_closed"
+)
+
+// Payload types of input and output stream messages
+const (
+	payloadOutput            uint32 = 1
+	payloadSize              uint32 = 3
+	payloadHandshakeRequest  uint32 = 5
+	payloadHandshakeResponse uint32 = 6
+	payloadHandshakeComplete uint32 = 7
+	payloadStdErr            uint32 = 11
+)
+
+// Field sizes and offsets of a message's header. The header length is written first and
+// the payload length follows the header, so the payload starts 4 bytes after it.
+const (
+	headerLength      = 116
+	messageTypeLength = 32
+	payloadOffset     = headerLength + 4
+
+	offsetMessageType   = 4
+	offsetSchemaVersion = 36
+	offsetCreatedDate   = 40
+	offsetSequence      = 48
+	offsetFlags         = 56
+	offsetMessageID     = 64
+	offsetDigest        = 80
+	offsetPayloadType   = 112
+)
+
+// flagAcknowledge marks acknowledge messages
+const flagAcknowledge uint64 = 3
+
+// message is a message of the Session Manager data channel, sent as one binary websocket
+// frame with a big-endian header
+type message struct {
+	Type          string
+	SchemaVersion uint32
+	CreatedDate   time.Time
+	Sequence      int64
+	Flags         uint64
+	ID            [16]byte
+	PayloadType   uint32
+	Payload       []byte
+}
+
+// newMessage returns a message of the given type with a new ID
+func newMessage(messageType string, sequence int64, payloadType uint32, payload []byte) *message {
+	return &message{
+		Type:          messageType,
+		SchemaVersion: 1,
+		CreatedDate:   time.Now(),
+		Sequence:      sequence,
+		ID:            newUUID(),
+		PayloadType:   payloadType,
+		Payload:       payload,
+	}
+}
+
+// marshal encodes the message for the data channel
+func (m *message) marshal() []byte {
+	b := make([]byte, payloadOffset+len(m.Payload))
+	binary.BigEndian.PutUint32(b, headerLength)
+	copy(b[offsetMessageType:offsetSchemaVersion], m.Type+strings.Repeat(" ", messageTypeLength-len(m.Type)))
+	binary.BigEndian.PutUint32(b[offsetSchemaVersion:], m.SchemaVersion)
+	binary.BigEndian.PutUint64(b[offsetCreatedDate:], uint64(m.CreatedDate.UnixMilli()))
+	binary.BigEndian.PutUint64(b[offsetSequence:], uint64(m.Sequence))
+	binary.BigEndian.PutUint64(b[offsetFlags:], m.Flags)
+	// The agent writes the UUID's less significant half first
+	copy(b[offsetMessageID:], m.ID[8:])
+	copy(b[offsetMessageID+8:], m.ID[:8])
+	digest := sha256.Sum256(m.Payload)
+	copy(b[offsetDigest:], digest[:])
+	binary.BigEndian.PutUint32(b[offsetPayloadType:], m.PayloadType)
+	binary.BigEndian.PutUint32(b[headerLength:], uint32(len(m.Payload)))
+	copy(b[payloadOffset:], m.Payload)
+	return b
+}
+
+// parseMessage decodes a message read from the data channel
+func parseMessage(b []byte) (*message, error) {
+	if len(b) < payloadOffset {
+		return nil, fmt.Errorf("session message of %d bytes is too short", len(b))
+	}
+	hl := int(binary.BigEndian.Uint32(b))
+	if hl < headerLength || len(b) < hl+4 {
+		return nil, fmt.Errorf("session message has an invalid header length %d", hl)
+	}
+	length := int(binary.BigEndian.Uint32(b[hl:]))
+	if len(b) < hl+4+length {
+		return nil, fmt.Errorf("session message payload of %d bytes is truncated", length)
+	}
+
+	m := &message{
+		Type:          strings.TrimRight(string(b[offsetMessageType:offsetSchemaVersion]), " \x00"),
+		SchemaVersion: binary.BigEndian.Uint32(b[offsetSchemaVersion:]),
+		CreatedDate:   time.UnixMilli(int64(binary.BigEndian.Uint64(b[offsetCreatedDate:]))),
+		Sequence:      int64(binary.BigEndian.Uint64(b[offsetSequence:])),
+		Flags:         binary.BigEndian.Uint64(b[offsetFlags:]),
+		PayloadType:   binary.BigEndian.Uint32(b[offsetPayloadType:]),
+		Payload:       b[hl+4 : hl+4+length],
+	}
+	copy(m.ID[8:], b[offsetMessageID:])
+	copy(m.ID[:8], b[offsetMessageID+8:offsetDigest])
+	return m, nil
+}
+
+// newUUID returns a random version 4 UUID
+func newUUID() [16]byte {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return id
+}
+
+// uuidString formats a UUID as 8-4-4-4-12 hex digits
+func uuidString(id [16]byte) string {
+	h := hex.EncodeToString(id[:])
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
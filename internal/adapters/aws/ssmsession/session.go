@@ -0,0 +1,323 @@
+// Package ssmsession joins Session Manager sessions over their websocket data channel,
+// for when the session-manager-plugin isn't installed. It covers interactive shell
+// sessions such as ECS Exec; port forwarding and KMS encrypted sessions need the plugin.
+package ssmsession
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ClientVersion is the version reported to the agent, that of the plugin whose protocol
+// is spoken
+const ClientVersion = "1.2.0.0"
+
+// Intervals of the terminal size checks and of the pings keeping the channel open
+const (
+	sizeInterval = 500 * time.Millisecond
+	pingInterval = 5 * time.Minute
+)
+
+// Session is a session started by an API such as ECS ExecuteCommand or SSM StartSession
+type Session struct {
+	ID        string
+	StreamURL string
+	Token     string
+}
+
+// SizeFunc returns the size of the terminal, ok false when it isn't known
+type SizeFunc func() (cols, rows int, ok bool)
+
+// Run joins the session and relays in to it and its output to out until it ends. size,
+// when set, is polled so the remote terminal follows the local one.
+func Run(ctx context.Context, s Session, in io.Reader, out io.Writer, size SizeFunc) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, s.StreamURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to open the data channel of session %s: %w", s.ID, err)
+	}
+	defer conn.Close()
+
+	open := map[string]string{
+		"MessageSchemaVersion": "1.0",
+		"RequestId":            uuidString(newUUID()),
+		"TokenValue":           s.Token,
+		"ClientId":             uuidString(newUUID()),
+		"ClientVersion":        ClientVersion,
+	}
+	if err := conn.WriteJSON(open); err != nil {
+		return fmt.Errorf("failed to open the data channel of session %s: %w", s.ID, err)
+	}
+
+	c := &channel{
+		conn:    conn,
+		out:     out,
+		pending: make(map[int64]*message),
+		ready:   make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- c.receive()
+		close(c.closed)
+	}()
+	go c.relayInput(in)
+	go c.relaySize(size)
+	go c.keepAlive()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// channel is the data channel of a joined session
+type channel struct {
+	conn *websocket.Conn
+	out  io.Writer
+
+	mu       sync.Mutex // Serializes writes to conn and guards sequence
+	sequence int64      // Sequence number of the next input message
+
+	expected int64              // Sequence number of the next output message
+	pending  map[int64]*message // Output received ahead of expected
+
+	ready     chan struct{} // Closed once the handshake completes
+	readyOnce sync.Once
+	closed    chan struct{} // Closed once the session ends
+}
+
+// receive reads the agent's messages until the session is closed
+func (c *channel) receive() error {
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil
+			}
+			return fmt.Errorf("session data channel: %w", err)
+		}
+		m, err := parseMessage(data)
+		if err != nil {
+			return err
+		}
+
+		switch m.Type {
+		case typeOutput:
+			if err := c.acknowledge(m); err != nil {
+				return err
+			}
+			if m.Sequence < c.expected {
+				continue // Sent again before our acknowledgement arrived
+			}
+			c.pending[m.Sequence] = m
+			for next, ok := c.pending[c.expected]; ok; next, ok = c.pending[c.expected] {
+				delete(c.pending, c.expected)
+				c.expected++
+				if err := c.handleOutput(next); err != nil {
+					return err
+				}
+			}
+
+		case typeChannelClosed:
+			var closed struct {
+				Output string `json:"Output"`
+			}
+			_ = json.Unmarshal(m.Payload, &closed)
+			if closed.Output != "" {
+				fmt.Fprintf(c.out, "\r\n%s\r\n", closed.Output)
+			}
+			return nil
+		}
+	}
+}
+
+// handleOutput handles an output message in sequence
+func (c *channel) handleOutput(m *message) error {
+	switch m.PayloadType {
+	case payloadOutput, payloadStdErr:
+		_, err := c.out.Write(m.Payload)
+		return err
+	case payloadHandshakeRequest:
+		return c.handshake(m.Payload)
+	case payloadHandshakeComplete:
+		var complete struct {
+			CustomerMessage string `json:"CustomerMessage"`
+		}
+		_ = json.Unmarshal(m.Payload, &complete)
+		if complete.CustomerMessage != "" {
+			fmt.Fprintf(c.out, "%s\r\n", complete.CustomerMessage)
+		}
+		c.readyOnce.Do(func() { close(c.ready) })
+	}
+	return nil
+}
+
+// Statuses of the actions the agent asks the client for
+const (
+	actionSuccess     = 1
+	actionUnsupported = 3
+)
+
+// handshake answers the agent's handshake request, accepting the session type and
+// declining anything else, such as KMS encryption
+func (c *channel) handshake(payload []byte) error {
+	var request struct {
+		RequestedClientActions []struct {
+			ActionType string `json:"ActionType"`
+		} `json:"RequestedClientActions"`
+	}
+	if err := json.Unmarshal(payload, &request); err != nil {
+		return fmt.Errorf("invalid session handshake: %w", err)
+	}
+
+	type processedAction struct {
+		ActionType   string `json:"ActionType"`
+		ActionStatus int    `json:"ActionStatus"`
+		Error        string `json:"Error,omitempty"`
+	}
+	response := struct {
+		ClientVersion          string            `json:"ClientVersion"`
+		ProcessedClientActions []processedAction `json:"ProcessedClientActions"`
+		Errors                 []string          `json:"Errors"`
+	}{ClientVersion: ClientVersion, ProcessedClientActions: []processedAction{}, Errors: []string{}}
+
+	var unsupported []string
+	for _, action := range request.RequestedClientActions {
+		if action.ActionType == "SessionType" {
+			response.ProcessedClientActions = append(response.ProcessedClientActions,
+				processedAction{ActionType: action.ActionType, ActionStatus: actionSuccess})
+			continue
+		}
+		unsupported = append(unsupported, action.ActionType)
+		response.ProcessedClientActions = append(response.ProcessedClientActions,
+			processedAction{ActionType: action.ActionType, ActionStatus: actionUnsupported, Error: "unsupported by this client"})
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	if err := c.send(payloadHandshakeResponse, body); err != nil {
+		return err
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("the session requires %s, which only the session-manager-plugin supports", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// send sends an input message with the next sequence number
+func (c *channel) send(payloadType uint32, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m := newMessage(typeInput, c.sequence, payloadType, payload)
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, m.marshal()); err != nil {
+		return fmt.Errorf("session data channel: %w", err)
+	}
+	c.sequence++
+	return nil
+}
+
+// acknowledge tells the agent an output message arrived, so it isn't sent again
+func (c *channel) acknowledge(m *message) error {
+	body, err := json.Marshal(map[string]any{
+		"AcknowledgedMessageType":           m.Type,
+		"AcknowledgedMessageId":             uuidString(m.ID),
+		"AcknowledgedMessageSequenceNumber": m.Sequence,
+		"IsSequentialMessage":               true,
+	})
+	if err != nil {
+		return err
+	}
+	ack := newMessage(typeAcknowledge, 0, 0, body)
+	ack.Flags = flagAcknowledge
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, ack.marshal()); err != nil {
+		return fmt.Errorf("session data channel: %w", err)
+	}
+	return nil
+}
+
+// relayInput sends what is read from in once the handshake completes, until the session
+// ends or in fails. The caller unblocks a pending read by closing or cancelling in.
+func (c *channel) relayInput(in io.Reader) {
+	select {
+	case <-c.ready:
+	case <-c.closed:
+		return
+	}
+
+	buf := make([]byte, 1024)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			if c.send(payloadOutput, append([]byte(nil), buf[:n]...)) != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// relaySize sends the terminal size once the handshake completes and again whenever it
+// changes
+func (c *channel) relaySize(size SizeFunc) {
+	if size == nil {
+		return
+	}
+	select {
+	case <-c.ready:
+	case <-c.closed:
+		return
+	}
+
+	ticker := time.NewTicker(sizeInterval)
+	defer ticker.Stop()
+	var lastCols, lastRows int
+	for {
+		if cols, rows, ok := size(); ok && (cols != lastCols || rows != lastRows) {
+			body, _ := json.Marshal(map[string]int{"cols": cols, "rows": rows})
+			if c.send(payloadSize, body) != nil {
+				return
+			}
+			lastCols, lastRows = cols, rows
+		}
+		select {
+		case <-ticker.C:
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+// keepAlive pings the agent so an idle session isn't closed
+func (c *channel) keepAlive() {
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.mu.Lock()
+			err := c.conn.WriteControl(websocket.PingMessage, []byte("keepalive"), time.Now().Add(10*time.Second))
+			c.mu.Unlock()
+			if err != nil {
+				return
+			}
+		case <-c.closed:
+			return
+		}
+	}
+}
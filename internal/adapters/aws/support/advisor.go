@@ -0,0 +1,159 @@
+package support
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// summaryBatchSize keeps the check IDs of a summaries request to a reasonable size
+const summaryBatchSize = 100
+
+// AdvisorClient reads Trusted Advisor checks and their results
+type AdvisorClient struct {
+	client *Client
+}
+
+// NewAdvisorClient creates a new Trusted Advisor client
+func NewAdvisorClient(client *Client) *AdvisorClient {
+	return &AdvisorClient{client: client}
+}
+
+// Check is a Trusted Advisor check with its latest summary
+type Check struct {
+	ID          string
+	Name        string
+	Description string
+	Category    string   // cost_optimizing, security, fault_tolerance, performance, service_limits...
+	Metadata    []string // Column names of the check's flagged resources
+
+	Status     string // ok, warning, error or not_available
+	CheckedAt  time.Time
+	Processed  int64
+	Flagged    int64
+	Suppressed int64
+	Savings    float64 // Estimated monthly savings of cost checks, in USD
+}
+
+// FlaggedResource is a resource a check flags, with the check's metadata columns
+type FlaggedResource struct {
+	ID         string // Trusted Advisor's own identifier of the flagged row
+	Status     string
+	Region     string
+	Suppressed bool
+	Metadata   []string // Values of the check's metadata columns, in order
+}
+
+type checkDescription struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Category    string   `json:"category"`
+	Metadata    []string `json:"metadata"`
+}
+
+type checkSummary struct {
+	CheckID          string `json:"checkId"`
+	Timestamp        string `json:"timestamp"`
+	Status           string `json:"status"`
+	ResourcesSummary struct {
+		ResourcesProcessed  int64 `json:"resourcesProcessed"`
+		ResourcesFlagged    int64 `json:"resourcesFlagged"`
+		ResourcesSuppressed int64 `json:"resourcesSuppressed"`
+	} `json:"resourcesSummary"`
+	CategorySpecificSummary struct {
+		CostOptimizing *struct {
+			EstimatedMonthlySavings float64 `json:"estimatedMonthlySavings"`
+		} `json:"costOptimizing"`
+	} `json:"categorySpecificSummary"`
+}
+
+// ListChecks lists the checks with their latest summaries
+func (c *AdvisorClient) ListChecks(ctx context.Context) ([]Check, error) {
+	var described struct {
+		Checks []checkDescription `json:"checks"`
+	}
+	if err := c.client.call(ctx, "DescribeTrustedAdvisorChecks", map[string]string{"language": "en"}, &described); err != nil {
+		return nil, fmt.Errorf("failed to list Trusted Advisor checks: %w", err)
+	}
+
+	checks := make([]Check, 0, len(described.Checks))
+	byID := make(map[string]int, len(described.Checks))
+	ids := make([]string, 0, len(described.Checks))
+	for _, d := range described.Checks {
+		byID[d.ID] = len(checks)
+		ids = append(ids, d.ID)
+		checks = append(checks, Check{
+			ID:          d.ID,
+			Name:        d.Name,
+			Description: d.Description,
+			Category:    d.Category,
+			Metadata:    d.Metadata,
+			Status:      "not_available",
+		})
+	}
+
+	for start := 0; start < len(ids); start += summaryBatchSize {
+		end := min(start+summaryBatchSize, len(ids))
+		var summaries struct {
+			Summaries []checkSummary `json:"summaries"`
+		}
+		input := map[string][]string{"checkIds": ids[start:end]}
+		if err := c.client.call(ctx, "DescribeTrustedAdvisorCheckSummaries", input, &summaries); err != nil {
+			return nil, fmt.Errorf("failed to get Trusted Advisor summaries: %w", err)
+		}
+		for _, s := range summaries.Summaries {
+			i, ok := byID[s.CheckID]
+			if !ok {
+				continue
+			}
+			check := &checks[i]
+			check.Status = s.Status
+			check.CheckedAt, _ = time.Parse(time.RFC3339, s.Timestamp)
+			check.Processed = s.ResourcesSummary.ResourcesProcessed
+			check.Flagged = s.ResourcesSummary.ResourcesFlagged
+			check.Suppressed = s.ResourcesSummary.ResourcesSuppressed
+			if cost := s.CategorySpecificSummary.CostOptimizing; cost != nil {
+				check.Savings = cost.EstimatedMonthlySavings
+			}
+		}
+	}
+	return checks, nil
+}
+
+// FlaggedResources gets the resources a check flags
+func (c *AdvisorClient) FlaggedResources(ctx context.Context, checkID string) ([]FlaggedResource, error) {
+	var output struct {
+		Result struct {
+			FlaggedResources []struct {
+				ResourceID   string    `json:"resourceId"`
+				Status       string    `json:"status"`
+				Region       string    `json:"region"`
+				IsSuppressed bool      `json:"isSuppressed"`
+				Metadata     []*string `json:"metadata"`
+			} `json:"flaggedResources"`
+		} `json:"result"`
+	}
+	input := map[string]string{"checkId": checkID, "language": "en"}
+	if err := c.client.call(ctx, "DescribeTrustedAdvisorCheckResult", input, &output); err != nil {
+		return nil, fmt.Errorf("failed to get Trusted Advisor check result: %w", err)
+	}
+
+	resources := make([]FlaggedResource, 0, len(output.Result.FlaggedResources))
+	for _, r := range output.Result.FlaggedResources {
+		metadata := make([]string, len(r.Metadata))
+		for i, value := range r.Metadata {
+			if value != nil {
+				metadata[i] = *value
+			}
+		}
+		resources = append(resources, FlaggedResource{
+			ID:         r.ResourceID,
+			Status:     r.Status,
+			Region:     r.Region,
+			Suppressed: r.IsSuppressed,
+			Metadata:   metadata,
+		})
+	}
+	return resources, nil
+}
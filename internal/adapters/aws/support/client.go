@@ -0,0 +1,124 @@
+// Package support calls the AWS Support API for Trusted Advisor checks.
+//
+// The API is small and only used for Trusted Advisor here, so it is called over its JSON
+// protocol with SigV4 signing rather than through a generated client.
+package support
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// Region is the only region the Support API is served from
+const Region = "us-east-1"
+
+const (
+	endpoint      = "https://support.us-east-1.amazonaws.com/"
+	targetPrefix  = "AWSSupport_20130415."
+	signingName   = "support"
+	clientTimeout = 30 * time.Second
+)
+
+// Client calls the AWS Support API with the credentials of a config
+type Client struct {
+	credentials aws.CredentialsProvider
+	httpClient  *http.Client
+	signer      *v4.Signer
+}
+
+// NewClient creates a Support API client using the config's credentials
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		credentials: cfg.Credentials,
+		httpClient:  &http.Client{Timeout: clientTimeout},
+		signer:      v4.NewSigner(),
+	}
+}
+
+// APIError is an error returned by the Support API, such as SubscriptionRequiredException
+// for accounts without a Business or Enterprise support plan
+type APIError struct {
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return e.Code
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// SubscriptionRequired reports whether the account's support plan doesn't include the API
+func (e *APIError) SubscriptionRequired() bool {
+	return e.Code == "SubscriptionRequiredException"
+}
+
+// call runs an operation, encoding input and decoding the response into output
+func (c *Client) call(ctx context.Context, operation string, input, output interface{}) error {
+	if c.credentials == nil {
+		return fmt.Errorf("no AWS credentials configured")
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", targetPrefix+operation)
+
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get credentials: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if err := c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), signingName, Region, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", operation, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", operation, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &apiErr)
+		code := apiErr.Type
+		if i := strings.LastIndex(code, "#"); i >= 0 {
+			code = code[i+1:]
+		}
+		if code == "" {
+			code = resp.Status
+		}
+		return &APIError{Code: code, Message: apiErr.Message}
+	}
+
+	if err := json.Unmarshal(data, output); err != nil {
+		return fmt.Errorf("failed to read %s response: %w", operation, err)
+	}
+	return nil
+}
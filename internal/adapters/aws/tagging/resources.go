@@ -0,0 +1,101 @@
+package tagging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rgt "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+// ResourcesClient wraps the Resource Groups Tagging API client
+type ResourcesClient struct {
+	client *rgt.Client
+}
+
+// NewResourcesClient creates a new tagged resources client
+func NewResourcesClient(client *rgt.Client) *ResourcesClient {
+	return &ResourcesClient{client: client}
+}
+
+// TagFilter selects resources with a tag key, and one of the values unless there are none
+type TagFilter struct {
+	Key    string
+	Values []string
+}
+
+// String returns the filter as key=value1,value2, or the key alone
+func (f TagFilter) String() string {
+	if len(f.Values) == 0 {
+		return f.Key
+	}
+	return f.Key + "=" + strings.Join(f.Values, ",")
+}
+
+// ParseTagFilter parses key=value1,value2 or key
+func ParseTagFilter(s string) (TagFilter, error) {
+	key, values, hasValues := strings.Cut(s, "=")
+	if key == "" {
+		return TagFilter{}, fmt.Errorf("%q has no tag key, use key=value or key", s)
+	}
+	filter := TagFilter{Key: key}
+	if hasValues {
+		for _, v := range strings.Split(values, ",") {
+			if v != "" {
+				filter.Values = append(filter.Values, v)
+			}
+		}
+		if len(filter.Values) == 0 {
+			return TagFilter{}, fmt.Errorf("%q has no value, use key=value or key for any value", s)
+		}
+	}
+	return filter, nil
+}
+
+// TaggedResource is a resource of any service found by its tags
+type TaggedResource struct {
+	ARN  string
+	Tags map[string]string
+}
+
+// GetResources lists the resources of the region passing every filter, stopping after
+// maxItems unless it is 0. The bool is true when it stopped with resources left.
+func (c *ResourcesClient) GetResources(ctx context.Context, filters []TagFilter, maxItems int) ([]TaggedResource, bool, error) {
+	input := &rgt.GetResourcesInput{
+		ResourcesPerPage: aws.Int32(100),
+	}
+	for _, f := range filters {
+		input.TagFilters = append(input.TagFilters, types.TagFilter{
+			Key:    aws.String(f.Key),
+			Values: f.Values,
+		})
+	}
+
+	var resources []TaggedResource
+	paginator := rgt.NewGetResourcesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get tagged resources: %w", err)
+		}
+
+		for _, mapping := range page.ResourceTagMappingList {
+			if maxItems > 0 && len(resources) == maxItems {
+				return resources, true, nil
+			}
+
+			tags := make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			resources = append(resources, TaggedResource{
+				ARN:  aws.ToString(mapping.ResourceARN),
+				Tags: tags,
+			})
+		}
+	}
+
+	return resources, false, nil
+}
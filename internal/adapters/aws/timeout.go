@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// untimedOperations keep streaming their responses after the call returns, or wait on
+// someone (an MFA code while credentials load, a Lambda function running for minutes),
+// so a request timeout would cut them off
+var untimedOperations = map[string]bool{
+	"GetObject":                true,
+	"PutObject":                true,
+	"UploadPart":               true,
+	"SelectObjectContent":      true,
+	"Invoke":                   true,
+	"InvokeWithResponseStream": true,
+	"StartLiveTail":            true,
+	"GetCallerIdentity":        true,
+	"AssumeRole":               true,
+}
+
+// SetRequestTimeout bounds each AWS API call, its retries included, so a hung call fails
+// with a deadline error instead of waiting forever. It applies to contexts loaded after
+// it is set; 0 means no timeout.
+func (cm *ClientManager) SetRequestTimeout(timeout time.Duration) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.requestTimeout = timeout
+}
+
+// requestTimeoutMiddleware gives each call a context with the timeout. It runs after the
+// operation's metadata is set, so it can tell which operation the call is.
+func requestTimeoutMiddleware(timeout time.Duration) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("RequestTimeout",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				if untimedOperations[awsmiddleware.GetOperationName(ctx)] {
+					return next.HandleInitialize(ctx, in)
+				}
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+				return next.HandleInitialize(ctx, in)
+			}), middleware.After)
+	}
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AccountMaxAge is how long a resolved account is trusted before it is resolved again
+const AccountMaxAge = 7 * 24 * time.Hour
+
+// A profile whose account couldn't be resolved is tried again after a backoff, doubling
+// from accountRetryMin with each failure up to accountRetryMax
+const (
+	accountRetryMin = 10 * time.Minute
+	accountRetryMax = 24 * time.Hour
+)
+
+// ProfileAccount is the account a profile's credentials belong to
+type ProfileAccount struct {
+	AccountID  string    `yaml:"account_id"`
+	Alias      string    `yaml:"alias,omitempty"` // Empty if the account has none or it couldn't be listed
+	ResolvedAt time.Time `yaml:"resolved_at"`
+	Failures   int       `yaml:"failures,omitempty"`  // Failed attempts since it was last resolved
+	FailedAt   time.Time `yaml:"failed_at,omitempty"` // Of the last failed attempt
+}
+
+// Stale returns whether the account was resolved too long ago to be trusted
+func (a ProfileAccount) Stale() bool {
+	return time.Since(a.ResolvedAt) > AccountMaxAge
+}
+
+// Due returns whether the account should be resolved again: it is stale, and any backoff
+// after failed attempts has passed
+func (a ProfileAccount) Due() bool {
+	if !a.Stale() {
+		return false
+	}
+	if a.Failures == 0 {
+		return true
+	}
+	backoff := min(accountRetryMin<<min(a.Failures-1, 10), accountRetryMax)
+	return time.Since(a.FailedAt) > backoff
+}
+
+// AccountStore is the persisted account of each profile, so the profile selector can show
+// them without asking AWS every time
+type AccountStore struct {
+	mu       sync.RWMutex
+	filepath string
+	accounts map[string]ProfileAccount
+}
+
+// NewAccountStore creates an account store persisted at path
+func NewAccountStore(path string) *AccountStore {
+	return &AccountStore{filepath: path, accounts: make(map[string]ProfileAccount)}
+}
+
+// Load loads the accounts from disk
+func (s *AccountStore) Load() error {
+	data, err := os.ReadFile(s.filepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read accounts file: %w", err)
+	}
+
+	accounts := make(map[string]ProfileAccount)
+	if err := yaml.Unmarshal(data, &accounts); err != nil {
+		return fmt.Errorf("failed to parse accounts file: %w", err)
+	}
+	s.mu.Lock()
+	s.accounts = accounts
+	s.mu.Unlock()
+	return nil
+}
+
+// Save saves the accounts to disk
+func (s *AccountStore) Save() error {
+	s.mu.RLock()
+	data, err := yaml.Marshal(s.accounts)
+	s.mu.RUnlock()
+	if err != nil {
+		return fmt.Errorf("failed to marshal accounts: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.filepath), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(s.filepath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write accounts file: %w", err)
+	}
+	return nil
+}
+
+// Get returns the account of a profile
+func (s *AccountStore) Get(profile string) (ProfileAccount, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	account, ok := s.accounts[profile]
+	return account, ok
+}
+
+// Set records the account of a profile, without saving
+func (s *AccountStore) Set(profile string, account ProfileAccount) {
+	if account.ResolvedAt.IsZero() {
+		account.ResolvedAt = time.Now()
+	}
+	s.mu.Lock()
+	s.accounts[profile] = account
+	s.mu.Unlock()
+}
+
+// Fail records a failed attempt at resolving the account of a profile, without saving. An
+// account resolved before is kept.
+func (s *AccountStore) Fail(profile string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account := s.accounts[profile]
+	account.Failures++
+	account.FailedAt = time.Now()
+	s.accounts[profile] = account
+}
+
+// ssoCacheDir is where the AWS CLI keeps the tokens of SSO sessions
+func ssoCacheDir() string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".aws", "sso", "cache")
+}
+
+// SSOExpiry returns when the cached token of an SSO profile's session expires, or the
+// zero time if it has none. The AWS CLI names the cache file after the SHA-1 of the
+// sso-session name, or of the start URL for profiles configured without one.
+func SSOExpiry(p Profile) time.Time {
+	if !p.IsSSO {
+		return time.Time{}
+	}
+	key := p.SSOStartURL
+	if p.SSOSession != "" {
+		key = p.SSOSession
+	}
+	sum := sha1.Sum([]byte(key))
+
+	data, err := os.ReadFile(filepath.Join(ssoCacheDir(), hex.EncodeToString(sum[:])+".json"))
+	if err != nil {
+		return time.Time{}
+	}
+	var token struct {
+		ExpiresAt string `json:"expiresAt"`
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return time.Time{}
+	}
+	expiry, err := time.Parse(time.RFC3339, token.ExpiresAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return expiry
+}
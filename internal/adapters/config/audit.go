@@ -0,0 +1,140 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Audit log events
+const (
+	AuditElevate = "elevate" // Changes allowed, with the reason given
+	AuditExpire  = "expire"  // The elevation ran out, back to read-only
+	AuditEnd     = "end"     // The elevation was ended early
+	AuditCall    = "call"    // An AWS API call that may have changed something
+	AuditCommand = "command" // An AWS CLI command run with :! that may have changed something
+)
+
+// Outcomes of an audited call
+const (
+	AuditSucceeded = "succeeded"
+	AuditFailed    = "failed"
+)
+
+// AuditEntry is a line of the audit log
+type AuditEntry struct {
+	Time    time.Time `json:"time"`
+	Event   string    `json:"event"`
+	User    string    `json:"user"`
+	Profile string    `json:"profile"`
+	Account string    `json:"account,omitempty"`
+	Region  string    `json:"region,omitempty"`
+	Reason  string    `json:"reason,omitempty"`
+	Minutes int       `json:"minutes,omitempty"` // Length of an elevation
+
+	// Of a call
+	Service   string `json:"service,omitempty"`
+	Operation string `json:"operation,omitempty"`
+	Resource  string `json:"resource,omitempty"`
+	Outcome   string `json:"outcome,omitempty"`
+	Error     string `json:"error,omitempty"`
+
+	// Of a command, its arguments after aws
+	Command []string `json:"command,omitempty"`
+}
+
+// AuditLog is an append-only log of elevations and of the calls that change resources,
+// one JSON object per line so it can be shipped or grepped like any other log
+type AuditLog struct {
+	mu       sync.Mutex
+	filepath string
+	lastErr  error // Of the last Append, kept for calls recorded where no one can be told
+}
+
+// NewAuditLog creates an audit log written to path
+func NewAuditLog(path string) *AuditLog {
+	return &AuditLog{filepath: path}
+}
+
+// Path returns where the log is written
+func (l *AuditLog) Path() string {
+	return l.filepath
+}
+
+// Append writes an entry, filling in the time and local user if unset
+func (l *AuditLog) Append(entry AuditEntry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+	if entry.User == "" {
+		entry.User = os.Getenv("USER")
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastErr = l.write(data)
+	return l.lastErr
+}
+
+// LastError returns the error of the last Append, nil if it succeeded
+func (l *AuditLog) LastError() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastErr
+}
+
+// write appends a line to the log file
+func (l *AuditLog) write(data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(l.filepath), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	f, err := os.OpenFile(l.filepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return f.Close()
+}
+
+// Read returns the entries of the log, oldest first. Lines that don't parse, such as one
+// cut short by a crash, are skipped; a log not written yet has no entries.
+func (l *AuditLog) Read() ([]AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.filepath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if json.Unmarshal(scanner.Bytes(), &entry) == nil {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -18,6 +19,8 @@ type Bookmark struct {
 	Region       string    `yaml:"region"`
 	Profile      string    `yaml:"profile"`
 	CreatedAt    time.Time `yaml:"created_at"`
+	Folder       string    `yaml:"folder,omitempty"` // Groups bookmarks in the selector
+	Note         string    `yaml:"note,omitempty"`
 }
 
 // BookmarkStore manages bookmark persistence
@@ -92,12 +95,121 @@ func (s *BookmarkStore) Save() error {
 	return nil
 }
 
+// Export writes the bookmarks of a folder, or all of them when folder is empty, to a
+// file in the format of the bookmarks file. It returns how many it wrote.
+func (s *BookmarkStore) Export(path, folder string) (int, error) {
+	bookmarks := s.bookmarks
+	if folder != "" {
+		bookmarks = nil
+		for _, b := range s.bookmarks {
+			if b.Folder == folder {
+				bookmarks = append(bookmarks, b)
+			}
+		}
+		if len(bookmarks) == 0 {
+			return 0, fmt.Errorf("no bookmarks in folder %s", folder)
+		}
+	}
+
+	data, err := yaml.Marshal(bookmarks)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal bookmarks: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return 0, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return len(bookmarks), nil
+}
+
+// Import merges the bookmarks of a file written by Export. A bookmark of a resource
+// already bookmarked replaces it, keeping its folder and note where the imported one has
+// none. It returns how many bookmarks were added and how many replaced.
+func (s *BookmarkStore) Import(path string) (added, updated int, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var bookmarks []Bookmark
+	if err := yaml.Unmarshal(data, &bookmarks); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for _, bookmark := range bookmarks {
+		if bookmark.ResourceType == "" || bookmark.ResourceID == "" {
+			return 0, 0, fmt.Errorf("%s has a bookmark without a resource_type and resource_id", path)
+		}
+		if i := s.index(bookmark.ResourceType, bookmark.ResourceID); i >= 0 {
+			existing := s.bookmarks[i]
+			if bookmark.Folder == "" {
+				bookmark.Folder = existing.Folder
+			}
+			if bookmark.Note == "" {
+				bookmark.Note = existing.Note
+			}
+			s.bookmarks[i] = bookmark
+			updated++
+			continue
+		}
+		if bookmark.CreatedAt.IsZero() {
+			bookmark.CreatedAt = time.Now()
+		}
+		s.bookmarks = append(s.bookmarks, bookmark)
+		added++
+	}
+	return added, updated, s.Save()
+}
+
+// index returns the index of the bookmark of a resource, -1 if it isn't bookmarked
+func (s *BookmarkStore) index(resourceType, resourceID string) int {
+	for i, b := range s.bookmarks {
+		if b.ResourceType == resourceType && b.ResourceID == resourceID {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetFolder moves a bookmark, by index, to a folder, or out of folders when it is empty
+func (s *BookmarkStore) SetFolder(index int, folder string) error {
+	if index < 0 || index >= len(s.bookmarks) {
+		return fmt.Errorf("bookmark index out of range")
+	}
+	s.bookmarks[index].Folder = folder
+	return s.Save()
+}
+
+// SetNote sets the note of a bookmark by index, removing it when note is empty
+func (s *BookmarkStore) SetNote(index int, note string) error {
+	if index < 0 || index >= len(s.bookmarks) {
+		return fmt.Errorf("bookmark index out of range")
+	}
+	s.bookmarks[index].Note = note
+	return s.Save()
+}
+
+// Folders returns the folders bookmarks are in, sorted
+func (s *BookmarkStore) Folders() []string {
+	seen := make(map[string]bool)
+	var folders []string
+	for _, b := range s.bookmarks {
+		if b.Folder != "" && !seen[b.Folder] {
+			seen[b.Folder] = true
+			folders = append(folders, b.Folder)
+		}
+	}
+	sort.Strings(folders)
+	return folders
+}
+
 // Add adds a new bookmark
 func (s *BookmarkStore) Add(bookmark Bookmark) error {
 	// Check for duplicates
 	for i, b := range s.bookmarks {
 		if b.ResourceType == bookmark.ResourceType && b.ResourceID == bookmark.ResourceID {
-			// Update existing bookmark
+			// Update existing bookmark, keeping where it was filed and its note
+			bookmark.CreatedAt = b.CreatedAt
+			bookmark.Folder = b.Folder
+			bookmark.Note = b.Note
 			s.bookmarks[i] = bookmark
 			return s.Save()
 		}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MaxHistoryEntries is how many commands the history keeps, oldest dropped first
+const MaxHistoryEntries = 1000
+
+// CommandHistory is the persisted history of commands entered in command mode, one per
+// line oldest first, like a shell history file
+type CommandHistory struct {
+	filepath string
+	entries  []string
+}
+
+// NewCommandHistory creates a command history persisted at path
+func NewCommandHistory(path string) *CommandHistory {
+	return &CommandHistory{filepath: path}
+}
+
+// Load loads the history from disk
+func (h *CommandHistory) Load() error {
+	data, err := os.ReadFile(h.filepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			h.entries = nil
+			return nil
+		}
+		return fmt.Errorf("failed to read history file: %w", err)
+	}
+
+	h.entries = nil
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			h.add(line)
+		}
+	}
+	return nil
+}
+
+// Save saves the history to disk
+func (h *CommandHistory) Save() error {
+	dir := filepath.Dir(h.filepath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data := strings.Join(h.entries, "\n")
+	if len(h.entries) > 0 {
+		data += "\n"
+	}
+	// Commands can carry ARNs and AWS CLI arguments, so only the user can read them
+	if err := os.WriteFile(h.filepath, []byte(data), 0600); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	return nil
+}
+
+// Add records a command and saves the history. A command entered before moves to the end
+// rather than being recorded twice.
+func (h *CommandHistory) Add(command string) error {
+	command = strings.TrimSpace(command)
+	if command == "" {
+		return nil
+	}
+	h.add(command)
+	return h.Save()
+}
+
+func (h *CommandHistory) add(command string) {
+	for i, entry := range h.entries {
+		if entry == command {
+			h.entries = append(h.entries[:i], h.entries[i+1:]...)
+			break
+		}
+	}
+	h.entries = append(h.entries, command)
+	if len(h.entries) > MaxHistoryEntries {
+		h.entries = h.entries[len(h.entries)-MaxHistoryEntries:]
+	}
+}
+
+// Entries returns the commands, oldest first
+func (h *CommandHistory) Entries() []string {
+	return h.entries
+}
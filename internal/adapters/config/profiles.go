@@ -11,15 +11,20 @@ import (
 
 // Profile represents an AWS profile
 type Profile struct {
-	Name       string
-	Region     string
-	RoleARN    string
-	SourceProf string
-	SSOStartURL string
-	SSORegion  string
-	SSOAccount string
-	SSORoleName string
-	IsSSO      bool
+	Name              string
+	Region            string
+	RoleARN           string
+	SourceProf        string
+	MFASerial         string // Set when assuming RoleARN needs an MFA code
+	ExternalID        string
+	DurationSeconds   int // Session length of the assumed role, 0 for the SDK's hour
+	SSOStartURL       string
+	SSORegion         string
+	SSOAccount        string
+	SSORoleName       string
+	SSOSession        string // Name of the sso-session section the profile uses, if any
+	IsSSO             bool
+	CredentialProcess string // Command the profile's credentials come from, if any
 }
 
 // Region represents an AWS region
@@ -51,7 +56,7 @@ func (pl *ProfileLoader) ListProfiles() ([]Profile, error) {
 	if cfg, err := ini.Load(pl.configPath); err == nil {
 		for _, section := range cfg.Sections() {
 			name := section.Name()
-			if name == "DEFAULT" {
+			if name == "DEFAULT" || strings.HasPrefix(name, "sso-session ") {
 				continue
 			}
 
@@ -59,14 +64,31 @@ func (pl *ProfileLoader) ListProfiles() ([]Profile, error) {
 			profileName := strings.TrimPrefix(name, "profile ")
 
 			p := &Profile{
-				Name:        profileName,
-				Region:      section.Key("region").String(),
-				RoleARN:     section.Key("role_arn").String(),
-				SourceProf:  section.Key("source_profile").String(),
-				SSOStartURL: section.Key("sso_start_url").String(),
-				SSORegion:   section.Key("sso_region").String(),
-				SSOAccount:  section.Key("sso_account_id").String(),
-				SSORoleName: section.Key("sso_role_name").String(),
+				Name:              profileName,
+				Region:            section.Key("region").String(),
+				RoleARN:           section.Key("role_arn").String(),
+				SourceProf:        section.Key("source_profile").String(),
+				MFASerial:         section.Key("mfa_serial").String(),
+				ExternalID:        section.Key("external_id").String(),
+				DurationSeconds:   section.Key("duration_seconds").MustInt(0),
+				SSOStartURL:       section.Key("sso_start_url").String(),
+				SSORegion:         section.Key("sso_region").String(),
+				SSOAccount:        section.Key("sso_account_id").String(),
+				SSORoleName:       section.Key("sso_role_name").String(),
+				SSOSession:        section.Key("sso_session").String(),
+				CredentialProcess: section.Key("credential_process").String(),
+			}
+
+			// Profiles using an sso-session section take the start URL and region from it
+			if p.SSOSession != "" {
+				if session, err := cfg.GetSection("sso-session " + p.SSOSession); err == nil {
+					if p.SSOStartURL == "" {
+						p.SSOStartURL = session.Key("sso_start_url").String()
+					}
+					if p.SSORegion == "" {
+						p.SSORegion = session.Key("sso_region").String()
+					}
+				}
 			}
 			p.IsSSO = p.SSOStartURL != ""
 
@@ -85,6 +107,9 @@ func (pl *ProfileLoader) ListProfiles() ([]Profile, error) {
 			if _, exists := profiles[name]; !exists {
 				profiles[name] = &Profile{Name: name}
 			}
+			if process := section.Key("credential_process").String(); process != "" {
+				profiles[name].CredentialProcess = process
+			}
 		}
 	}
 
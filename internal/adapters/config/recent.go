@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxRecentResources is how many resources the recent list keeps, the least recent dropped
+const MaxRecentResources = 50
+
+// RecentResource is a resource that was described or acted on
+type RecentResource struct {
+	Name         string    `yaml:"name"`
+	ResourceType string    `yaml:"resource_type"`
+	ResourceID   string    `yaml:"resource_id"`
+	ARN          string    `yaml:"arn,omitempty"`
+	Region       string    `yaml:"region"`
+	Profile      string    `yaml:"profile"`
+	Action       string    `yaml:"action,omitempty"` // Last action run on it, empty if only described
+	ViewedAt     time.Time `yaml:"viewed_at"`
+}
+
+// RecentStore is the persisted list of recently viewed resources, most recent first
+type RecentStore struct {
+	filepath string
+	recent   []RecentResource
+}
+
+// NewRecentStore creates a recent resources list persisted at path
+func NewRecentStore(path string) *RecentStore {
+	return &RecentStore{filepath: path}
+}
+
+// Load loads the list from disk
+func (s *RecentStore) Load() error {
+	data, err := os.ReadFile(s.filepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.recent = nil
+			return nil
+		}
+		return fmt.Errorf("failed to read recent resources file: %w", err)
+	}
+
+	var recent []RecentResource
+	if err := yaml.Unmarshal(data, &recent); err != nil {
+		return fmt.Errorf("failed to parse recent resources file: %w", err)
+	}
+	s.recent = recent
+	return nil
+}
+
+// Save saves the list to disk
+func (s *RecentStore) Save() error {
+	dir := filepath.Dir(s.filepath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(s.recent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal recent resources: %w", err)
+	}
+	// ARNs and names can tell a lot about an account, so only the user can read them
+	if err := os.WriteFile(s.filepath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write recent resources file: %w", err)
+	}
+	return nil
+}
+
+// Add records a resource as the most recent and saves the list. A resource seen before
+// moves to the top, keeping the action last run on it unless another one is given.
+func (s *RecentStore) Add(resource RecentResource) error {
+	if resource.ViewedAt.IsZero() {
+		resource.ViewedAt = time.Now()
+	}
+	for i, r := range s.recent {
+		if r.ResourceType == resource.ResourceType && r.ResourceID == resource.ResourceID &&
+			r.Profile == resource.Profile && r.Region == resource.Region {
+			if resource.Action == "" {
+				resource.Action = r.Action
+			}
+			s.recent = append(s.recent[:i], s.recent[i+1:]...)
+			break
+		}
+	}
+
+	s.recent = append([]RecentResource{resource}, s.recent...)
+	if len(s.recent) > MaxRecentResources {
+		s.recent = s.recent[:MaxRecentResources]
+	}
+	return s.Save()
+}
+
+// List returns the resources, most recent first
+func (s *RecentStore) List() []RecentResource {
+	return s.recent
+}
+
+// Get returns a resource by its position in the list
+func (s *RecentStore) Get(index int) (RecentResource, bool) {
+	if index < 0 || index >= len(s.recent) {
+		return RecentResource{}, false
+	}
+	return s.recent[index], true
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReminderDateFormat is the layout expiry dates are entered and shown in
+const ReminderDateFormat = "2006-01-02"
+
+// DefaultReminderWindow is how far ahead an expiry counts as upcoming
+const DefaultReminderWindow = 30 * 24 * time.Hour
+
+// Reminder is a local expiry date set on a resource, e.g. when an API key stored in a secret expires.
+// Nothing is written to AWS, reminders live only in the config directory.
+type Reminder struct {
+	Name         string    `yaml:"name"`
+	ResourceType string    `yaml:"resource_type"`
+	ResourceID   string    `yaml:"resource_id"`
+	ARN          string    `yaml:"arn"`
+	Region       string    `yaml:"region"`
+	Profile      string    `yaml:"profile"`
+	ExpiresOn    time.Time `yaml:"expires_on"`
+	CreatedAt    time.Time `yaml:"created_at"`
+}
+
+// DaysLeft returns the whole days until the expiry date, negative once it has passed
+func (r Reminder) DaysLeft(now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	expires := time.Date(r.ExpiresOn.Year(), r.ExpiresOn.Month(), r.ExpiresOn.Day(), 0, 0, 0, 0, time.UTC)
+	return int(expires.Sub(today).Hours() / 24)
+}
+
+// ReminderStore manages reminder persistence
+type ReminderStore struct {
+	filepath  string
+	reminders []Reminder
+}
+
+// NewReminderStore creates a new reminder store
+func NewReminderStore() *ReminderStore {
+	configDir := getConfigDir()
+	return &ReminderStore{
+		filepath:  filepath.Join(configDir, "reminders.yaml"),
+		reminders: []Reminder{},
+	}
+}
+
+// Load loads reminders from disk
+func (s *ReminderStore) Load() error {
+	// Ensure config directory exists
+	dir := filepath.Dir(s.filepath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	// Check if file exists
+	if _, err := os.Stat(s.filepath); os.IsNotExist(err) {
+		s.reminders = []Reminder{}
+		return nil
+	}
+
+	data, err := os.ReadFile(s.filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read reminders file: %w", err)
+	}
+
+	var reminders []Reminder
+	if err := yaml.Unmarshal(data, &reminders); err != nil {
+		return fmt.Errorf("failed to parse reminders file: %w", err)
+	}
+
+	s.reminders = reminders
+	return nil
+}
+
+// Save saves reminders to disk
+func (s *ReminderStore) Save() error {
+	// Ensure config directory exists
+	dir := filepath.Dir(s.filepath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(s.reminders)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminders: %w", err)
+	}
+
+	if err := os.WriteFile(s.filepath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write reminders file: %w", err)
+	}
+
+	return nil
+}
+
+// Set adds a reminder, replacing any existing reminder on the same resource
+func (s *ReminderStore) Set(reminder Reminder) error {
+	for i, r := range s.reminders {
+		if r.ResourceType == reminder.ResourceType && r.ARN == reminder.ARN {
+			reminder.CreatedAt = r.CreatedAt
+			s.reminders[i] = reminder
+			return s.Save()
+		}
+	}
+
+	reminder.CreatedAt = time.Now()
+	s.reminders = append(s.reminders, reminder)
+	return s.Save()
+}
+
+// Remove removes the reminder on a resource, if any
+func (s *ReminderStore) Remove(resourceType, arn string) error {
+	for i, r := range s.reminders {
+		if r.ResourceType == resourceType && r.ARN == arn {
+			s.reminders = append(s.reminders[:i], s.reminders[i+1:]...)
+			return s.Save()
+		}
+	}
+	return nil
+}
+
+// Get returns the reminder on a resource. Reminders are matched by ARN so
+// resources with the same name in other accounts or regions don't share them.
+func (s *ReminderStore) Get(resourceType, arn string) (Reminder, bool) {
+	for _, r := range s.reminders {
+		if r.ResourceType == resourceType && r.ARN == arn {
+			return r, true
+		}
+	}
+	return Reminder{}, false
+}
+
+// List returns all reminders
+func (s *ReminderStore) List() []Reminder {
+	return s.reminders
+}
+
+// Upcoming returns the reminders expiring within the window, including those
+// already expired, soonest first
+func (s *ReminderStore) Upcoming(now time.Time, window time.Duration) []Reminder {
+	var upcoming []Reminder
+	for _, r := range s.reminders {
+		if r.DaysLeft(now) <= int(window.Hours()/24) {
+			upcoming = append(upcoming, r)
+		}
+	}
+
+	sort.Slice(upcoming, func(i, j int) bool {
+		return upcoming[i].ExpiresOn.Before(upcoming[j].ExpiresOn)
+	})
+
+	return upcoming
+}
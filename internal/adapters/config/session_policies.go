@@ -0,0 +1,55 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// PolicyFile is an inline session policy kept as <config dir>/session-policies/<name>.json
+type PolicyFile struct {
+	Name     string
+	Document string
+}
+
+// SessionPoliciesDir returns the directory session policy files are read from
+func SessionPoliciesDir() string {
+	return filepath.Join(getConfigDir(), "session-policies")
+}
+
+// LoadSessionPolicies reads the session policy files, sorted by name. A missing
+// directory means there are none.
+func LoadSessionPolicies() ([]PolicyFile, error) {
+	dir := SessionPoliciesDir()
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session policies: %w", err)
+	}
+
+	var policies []PolicyFile
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !ok {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read session policy %s: %w", name, err)
+		}
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("session policy %s is not valid JSON", name)
+		}
+		policies = append(policies, PolicyFile{Name: name, Document: string(data)})
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies, nil
+}
@@ -0,0 +1,245 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// testEventNamePattern limits event names to what is safe as a file name everywhere
+var testEventNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// TestEvent is a named Lambda invoke payload. Events are stored as one JSON file each,
+// <dir>/<function>/<name>.json, so a shared directory can be kept in a git repository.
+type TestEvent struct {
+	Name     string
+	Payload  string
+	Shared   bool // Read from the shared directory
+	Template bool // Built in, not stored anywhere
+}
+
+// Source describes where the event comes from
+func (e TestEvent) Source() string {
+	switch {
+	case e.Template:
+		return "template"
+	case e.Shared:
+		return "shared"
+	default:
+		return "local"
+	}
+}
+
+// TestEventStore manages test events in the config directory and an optional shared directory
+type TestEventStore struct {
+	dir       string
+	sharedDir string
+}
+
+// NewTestEventStore creates a new test event store. sharedDir may be empty or start with ~.
+func NewTestEventStore(sharedDir string) *TestEventStore {
+	if rest, ok := strings.CutPrefix(sharedDir, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			sharedDir = filepath.Join(home, rest)
+		}
+	}
+	return &TestEventStore{
+		dir:       filepath.Join(getConfigDir(), "test-events"),
+		sharedDir: sharedDir,
+	}
+}
+
+// HasShared returns whether a shared directory is configured
+func (s *TestEventStore) HasShared() bool {
+	return s.sharedDir != ""
+}
+
+// List returns the local and shared events of a function, sorted by name. A local
+// event hides a shared one of the same name.
+func (s *TestEventStore) List(function string) ([]TestEvent, error) {
+	local, err := readTestEvents(filepath.Join(s.dir, function), false)
+	if err != nil {
+		return nil, err
+	}
+
+	events := local
+	if s.sharedDir != "" {
+		shared, err := readTestEvents(filepath.Join(s.sharedDir, function), true)
+		if err != nil {
+			return nil, err
+		}
+
+		names := make(map[string]bool, len(local))
+		for _, event := range local {
+			names[event.Name] = true
+		}
+		for _, event := range shared {
+			if !names[event.Name] {
+				events = append(events, event)
+			}
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Name < events[j].Name
+	})
+	return events, nil
+}
+
+// Save writes an event for a function, to the shared directory if shared is set.
+// The payload must be valid JSON and is stored indented so changes diff cleanly.
+func (s *TestEventStore) Save(function, name, payload string, shared bool) error {
+	if !testEventNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid event name %q, use letters, digits, '.', '_' and '-'", name)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(payload), &data); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	formatted, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format event: %w", err)
+	}
+
+	base := s.dir
+	if shared {
+		if s.sharedDir == "" {
+			return fmt.Errorf("no shared test event directory configured")
+		}
+		base = s.sharedDir
+	}
+
+	dir := filepath.Join(base, function)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create test event directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".json"), append(formatted, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write test event: %w", err)
+	}
+	return nil
+}
+
+// readTestEvents reads the events in a directory, which may not exist
+func readTestEvents(dir string, shared bool) ([]TestEvent, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read test events: %w", err)
+	}
+
+	var events []TestEvent
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !ok {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read test event %s: %w", name, err)
+		}
+		events = append(events, TestEvent{Name: name, Payload: string(data), Shared: shared})
+	}
+	return events, nil
+}
+
+// TestEventTemplates returns starting points for events from common sources
+func TestEventTemplates() []TestEvent {
+	return []TestEvent{
+		{Name: "apigateway-proxy", Template: true, Payload: apiGatewayProxyTemplate},
+		{Name: "sqs", Template: true, Payload: sqsTemplate},
+		{Name: "s3-put", Template: true, Payload: s3PutTemplate},
+	}
+}
+
+const apiGatewayProxyTemplate = `{
+  "resource": "/{proxy+}",
+  "path": "/hello",
+  "httpMethod": "GET",
+  "headers": {
+    "Accept": "application/json",
+    "Host": "example.execute-api.us-east-1.amazonaws.com"
+  },
+  "queryStringParameters": {
+    "name": "world"
+  },
+  "pathParameters": {
+    "proxy": "hello"
+  },
+  "stageVariables": null,
+  "requestContext": {
+    "resourcePath": "/{proxy+}",
+    "httpMethod": "GET",
+    "path": "/prod/hello",
+    "stage": "prod",
+    "requestId": "c6af9ac6-7b61-11e6-9a41-93e8deadbeef",
+    "identity": {
+      "sourceIp": "127.0.0.1",
+      "userAgent": "aws-tui"
+    }
+  },
+  "body": null,
+  "isBase64Encoded": false
+}
+`
+
+const sqsTemplate = `{
+  "Records": [
+    {
+      "messageId": "059f36b4-87a3-44ab-83d2-661975830a7d",
+      "receiptHandle": "AQEBwJnKyrHigUMZj6rYigCgxlaS3SLy0a",
+      "body": "{\"hello\": \"world\"}",
+      "attributes": {
+        "ApproximateReceiveCount": "1",
+        "SentTimestamp": "1545082649183",
+        "SenderId": "AIDAIENQZJOLO23YVJ4VO",
+        "ApproximateFirstReceiveTimestamp": "1545082649185"
+      },
+      "messageAttributes": {},
+      "md5OfBody": "49dfdd54b01cbcd2d2ab5e9e5ee6b9b9",
+      "eventSource": "aws:sqs",
+      "eventSourceARN": "arn:aws:sqs:us-east-1:123456789012:my-queue",
+      "awsRegion": "us-east-1"
+    }
+  ]
+}
+`
+
+const s3PutTemplate = `{
+  "Records": [
+    {
+      "eventVersion": "2.1",
+      "eventSource": "aws:s3",
+      "awsRegion": "us-east-1",
+      "eventTime": "2024-01-01T00:00:00.000Z",
+      "eventName": "ObjectCreated:Put",
+      "userIdentity": {
+        "principalId": "EXAMPLE"
+      },
+      "s3": {
+        "s3SchemaVersion": "1.0",
+        "configurationId": "testConfigRule",
+        "bucket": {
+          "name": "example-bucket",
+          "ownerIdentity": {
+            "principalId": "EXAMPLE"
+          },
+          "arn": "arn:aws:s3:::example-bucket"
+        },
+        "object": {
+          "key": "test/key",
+          "size": 1024,
+          "eTag": "0123456789abcdef0123456789abcdef",
+          "sequencer": "0A1B2C3D4E5F678901"
+        }
+      }
+    }
+  ]
+}
+`
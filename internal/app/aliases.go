@@ -0,0 +1,52 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Aliases are commands of the user's own, by name, each running built-in commands in
+// turn, e.g. prod: [profile prod-admin, region us-east-1, ecs]
+type Aliases map[string][]string
+
+// Validate checks the names and commands of the aliases
+func (a Aliases) Validate() error {
+	for _, name := range a.Names() {
+		if name == "" || strings.ContainsAny(name, " \t:!") {
+			return fmt.Errorf("alias %q: names are one word, without : or !", name)
+		}
+		if len(a[name]) == 0 {
+			return fmt.Errorf("alias %q has no commands", name)
+		}
+		for _, step := range a.Steps(name) {
+			fields := strings.Fields(step)
+			if len(fields) == 0 {
+				return fmt.Errorf("alias %q has an empty command", name)
+			}
+			if _, ok := a[fields[0]]; ok {
+				return fmt.Errorf("alias %q runs alias %q, aliases only run built-in commands", name, fields[0])
+			}
+		}
+	}
+	return nil
+}
+
+// Names returns the names of the aliases, sorted
+func (a Aliases) Names() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Steps returns the commands of an alias without their leading colons
+func (a Aliases) Steps(name string) []string {
+	steps := make([]string, len(a[name]))
+	for i, step := range a[name] {
+		steps[i] = strings.TrimPrefix(strings.TrimSpace(step), ":")
+	}
+	return steps
+}
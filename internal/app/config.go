@@ -2,7 +2,9 @@ package app
 
 import (
 	"os"
+	"path"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -18,10 +20,147 @@ type Config struct {
 	ShowHelp       bool   `yaml:"show_help"`
 	RefreshSeconds int    `yaml:"refresh_seconds"`
 
+	// Show estimated monthly cost columns for EC2 and RDS instances
+	ShowCostEstimates bool `yaml:"show_cost_estimates"`
+
+	// Hide and block every action that changes AWS resources
+	ReadOnly bool `yaml:"read_only"`
+
+	// Stay read-only unless elevated with :elevate, which allows changes for a number of
+	// minutes (ElevationMinutes, 15 by default) and records the reason in the audit log
+	RequireElevation bool `yaml:"require_elevation,omitempty"`
+	ElevationMinutes int  `yaml:"elevation_minutes,omitempty"`
+
+	// Lowest action severity that asks for the resource name to be typed to confirm:
+	// high (default), critical or off
+	TypedConfirmation string `yaml:"typed_confirmation,omitempty"`
+
+	// Table display, overriding the theme's own table settings when set
+	TableDensity string `yaml:"table_density,omitempty"` // compact or comfortable
+	ZebraStripes *bool  `yaml:"zebra_stripes,omitempty"` // Unset keeps the theme's, false turns its stripes off
+
+	// Match / searches fuzzily, ranking rows by how well they match and highlighting the
+	// matched characters, instead of by substring
+	FuzzySearch bool `yaml:"fuzzy_search,omitempty"`
+
+	// Timestamp display in tables and detail views: absolute (default) or relative, and the
+	// zone (UTC by default, Local or an IANA name) and locale (iso, us or eu) of absolute ones
+	Timestamps string `yaml:"timestamps,omitempty"`
+	TimeZone   string `yaml:"time_zone,omitempty"`
+	TimeLocale string `yaml:"time_locale,omitempty"`
+
+	// How copies reach the clipboard: auto (default) uses pbcopy, xclip, xsel, wl-copy or
+	// clip, and the terminal's clipboard over SSH or without any of them, through tmux
+	// inside it; system, osc52 or tmux use only one way
+	Clipboard string `yaml:"clipboard,omitempty"`
+
+	// Most items a list fetches before it stops and shows a truncation banner, 0 for
+	// no cap. ListLimits overrides it per resource type, keyed by shortcut (e.g. logs).
+	MaxListItems int            `yaml:"max_list_items"`
+	ListLimits   map[string]int `yaml:"list_limits,omitempty"`
+
+	// Directory of Lambda test events shared with others, e.g. in a git repository
+	SharedTestEventsDir string `yaml:"shared_test_events_dir,omitempty"`
+
+	// CloudWatch log group to read VPC flow logs from, instead of the log groups of the
+	// flow logs found on the interface, its subnet or its VPC
+	FlowLogGroup string `yaml:"flow_log_group,omitempty"`
+
+	// Role assumed into member accounts from :orgs, OrganizationAccountAccessRole by default
+	OrgAccessRole string `yaml:"org_access_role,omitempty"`
+
+	// How ECS Exec joins the container's session: auto (default) uses the
+	// session-manager-plugin when it is on the PATH and the built-in client otherwise;
+	// plugin or builtin use only one
+	ECSExecClient string `yaml:"ecs_exec_client,omitempty"`
+
+	// External commands the selected resource can be opened with
+	OpenWith []OpenWithCommand `yaml:"open_with,omitempty"`
+
+	// Grids of widgets combining several resource lists, alarms and metrics
+	Dashboards []Dashboard `yaml:"dashboards,omitempty"`
+
+	// Counts pinned to the home screen and the bookmarks it lists
+	Home Home `yaml:"home,omitempty"`
+
+	// Longest an AWS API call may take before it fails, 30 seconds by default. Downloads,
+	// uploads, Lambda invocations and live tails aren't limited.
+	RequestTimeoutSeconds int `yaml:"request_timeout_seconds,omitempty"`
+
+	// Check GitHub for a newer release on startup and show it in the footer
+	CheckForUpdates bool `yaml:"check_for_updates,omitempty"`
+
+	// Keys remapped from the defaults
+	Keybindings Keybindings `yaml:"keybindings,omitempty"`
+
+	// Commands of the user's own, expanding into built-in commands
+	Aliases Aliases `yaml:"aliases,omitempty"`
+
 	// Paths
 	ConfigDir string `yaml:"-"`
 }
 
+// Keybindings remaps keys by the name of their binding, listed by :keys. Values are keys
+// separated by spaces, such as "ctrl+r f5".
+type Keybindings struct {
+	Global map[string]string `yaml:"global,omitempty"` // Keys of the home screen and of every view
+	List   map[string]string `yaml:"list,omitempty"`   // Keys of resource lists and the detail pane
+	// Keys of handler actions, by resource type and action name, e.g. to free r for
+	// refreshing the secrets list: secretsmanager:secrets: {rotation: ctrl+o}
+	Actions map[string]map[string]string `yaml:"actions,omitempty"`
+}
+
+// OpenWithCommand is an external command the selected resource's JSON is piped to, such as a
+// jq filter or a script. Its output is shown in a pane, unless it is interactive (an editor)
+// and takes over the terminal, reading the JSON from $AWS_TUI_JSON_FILE instead.
+type OpenWithCommand struct {
+	Name        string   `yaml:"name"`
+	Command     string   `yaml:"command"`               // Run with sh -c
+	Types       []string `yaml:"types,omitempty"`       // Resource types it is offered for, e.g. iam:*, all if empty
+	Interactive bool     `yaml:"interactive,omitempty"` // Hand the terminal to the command
+}
+
+// AppliesTo reports whether the command is offered for a resource type
+func (c OpenWithCommand) AppliesTo(resourceType string) bool {
+	if len(c.Types) == 0 {
+		return true
+	}
+	for _, pattern := range c.Types {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Elevation lengths in minutes, the default for :elevate without a length and the longest allowed
+const (
+	DefaultElevationMinutes = 15
+	MaxElevationMinutes     = 240
+)
+
+// ElevationLength returns how many minutes :elevate allows changes for by default
+func (c *Config) ElevationLength() int {
+	if c.ElevationMinutes < 1 {
+		return DefaultElevationMinutes
+	}
+	return min(c.ElevationMinutes, MaxElevationMinutes)
+}
+
+// DefaultRequestTimeoutSeconds is how long an AWS API call may take unless configured
+const DefaultRequestTimeoutSeconds = 30
+
+// RequestTimeout returns how long an AWS API call may take before it fails
+func (c *Config) RequestTimeout() time.Duration {
+	if c.RequestTimeoutSeconds < 1 {
+		return DefaultRequestTimeoutSeconds * time.Second
+	}
+	return time.Duration(c.RequestTimeoutSeconds) * time.Second
+}
+
+// DefaultMaxListItems keeps huge accounts from loading every item of a list
+const DefaultMaxListItems = 2000
+
 // DefaultConfig returns the default configuration
 func DefaultConfig() *Config {
 	homeDir, _ := os.UserHomeDir()
@@ -48,6 +187,7 @@ func DefaultConfig() *Config {
 		Theme:          "default",
 		ShowHelp:       true,
 		RefreshSeconds: 30,
+		MaxListItems:   DefaultMaxListItems,
 		ConfigDir:      configDir,
 	}
 }
@@ -105,6 +245,21 @@ func (c *Config) HistoryPath() string {
 	return filepath.Join(c.ConfigDir, "history")
 }
 
+// AuditLogPath returns the path to the audit log of elevations and changes
+func (c *Config) AuditLogPath() string {
+	return filepath.Join(c.ConfigDir, "audit.log")
+}
+
+// RecentPath returns the path to the list of recently viewed resources
+func (c *Config) RecentPath() string {
+	return filepath.Join(c.ConfigDir, "recent.yaml")
+}
+
+// AccountsPath returns the path to the accounts resolved for each profile
+func (c *Config) AccountsPath() string {
+	return filepath.Join(c.ConfigDir, "accounts.yaml")
+}
+
 // BookmarksPath returns the path to the bookmarks file
 func (c *Config) BookmarksPath() string {
 	return filepath.Join(c.ConfigDir, "bookmarks.yaml")
@@ -0,0 +1,186 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dashboard widget types
+const (
+	WidgetResources = "resources" // Rows of any resource list, e.g. ec2
+	WidgetServices  = "services"  // ECS services of a cluster
+	WidgetAlarms    = "alarms"    // CloudWatch alarms, in ALARM state by default
+	WidgetMetric    = "metric"    // Sparkline of a CloudWatch metric
+	WidgetPipeline  = "pipeline"  // Stages of a CodePipeline pipeline
+)
+
+// Dashboard defaults
+const (
+	DefaultDashboardColumns = 2
+	DefaultWidgetRefresh    = 60  // Seconds
+	DefaultMetricPeriod     = 300 // Seconds
+	DefaultMetricHours      = 3
+)
+
+// Dashboard is a named grid of widgets, opened with :dashboard <name>
+type Dashboard struct {
+	Name    string   `yaml:"name"`
+	Columns int      `yaml:"columns,omitempty"` // Widgets per row, 2 by default
+	Widgets []Widget `yaml:"widgets"`
+}
+
+// Widget is a cell of a dashboard. Which fields apply depends on its type.
+type Widget struct {
+	Title   string `yaml:"title,omitempty"`
+	Type    string `yaml:"type"`
+	Refresh int    `yaml:"refresh,omitempty"` // Seconds between refreshes, 60 by default
+
+	// resources: the list's shortcut, as used with :, and an optional filter
+	Resource string `yaml:"resource,omitempty"`
+	Filter   string `yaml:"filter,omitempty"`
+
+	// services: the cluster name or ARN
+	Cluster string `yaml:"cluster,omitempty"`
+
+	// alarms: the state to show, ALARM by default, or all for every alarm
+	State string `yaml:"state,omitempty"`
+
+	// metric: the metric and statistic, over the last Hours in Period second steps
+	Namespace  string            `yaml:"namespace,omitempty"`
+	Metric     string            `yaml:"metric,omitempty"`
+	Dimensions map[string]string `yaml:"dimensions,omitempty"`
+	Stat       string            `yaml:"stat,omitempty"` // Average by default
+	Period     int               `yaml:"period,omitempty"`
+	Hours      int               `yaml:"hours,omitempty"`
+
+	// pipeline: the CodePipeline pipeline name
+	Pipeline string `yaml:"pipeline,omitempty"`
+}
+
+// FindDashboard returns the dashboard with a name, ignoring case
+func (c *Config) FindDashboard(name string) (*Dashboard, bool) {
+	for i := range c.Dashboards {
+		if strings.EqualFold(c.Dashboards[i].Name, name) {
+			return &c.Dashboards[i], true
+		}
+	}
+	return nil, false
+}
+
+// DashboardNames returns the names of the configured dashboards
+func (c *Config) DashboardNames() []string {
+	names := make([]string, 0, len(c.Dashboards))
+	for _, d := range c.Dashboards {
+		names = append(names, d.Name)
+	}
+	return names
+}
+
+// GridColumns returns the widgets per row
+func (d *Dashboard) GridColumns() int {
+	if d.Columns < 1 {
+		return DefaultDashboardColumns
+	}
+	return d.Columns
+}
+
+// Validate checks that a widget has what its type needs
+func (w *Widget) Validate() error {
+	switch w.Type {
+	case WidgetResources:
+		if w.Resource == "" {
+			return fmt.Errorf("resources widget needs a resource, e.g. ec2")
+		}
+	case WidgetServices:
+		if w.Cluster == "" {
+			return fmt.Errorf("services widget needs a cluster")
+		}
+	case WidgetAlarms:
+		switch strings.ToUpper(w.State) {
+		case "", "ALL", "OK", "ALARM", "INSUFFICIENT_DATA":
+		default:
+			return fmt.Errorf("alarms widget state must be OK, ALARM, INSUFFICIENT_DATA or all")
+		}
+	case WidgetMetric:
+		if w.Namespace == "" || w.Metric == "" {
+			return fmt.Errorf("metric widget needs a namespace and metric")
+		}
+		if w.Period != 0 && w.Period%60 != 0 {
+			return fmt.Errorf("metric widget period must be a multiple of 60 seconds")
+		}
+	case WidgetPipeline:
+		if w.Pipeline == "" {
+			return fmt.Errorf("pipeline widget needs a pipeline")
+		}
+	default:
+		return fmt.Errorf("unknown widget type %q, use resources, services, alarms, metric or pipeline", w.Type)
+	}
+	return nil
+}
+
+// DisplayTitle returns the widget's title, or one made up from what it shows
+func (w *Widget) DisplayTitle() string {
+	if w.Title != "" {
+		return w.Title
+	}
+	switch w.Type {
+	case WidgetResources:
+		return w.Resource
+	case WidgetServices:
+		return "ECS services: " + w.Cluster
+	case WidgetAlarms:
+		if state := w.AlarmState(); state != "" {
+			return "Alarms: " + state
+		}
+		return "Alarms"
+	case WidgetMetric:
+		return fmt.Sprintf("%s %s (%s)", w.Namespace, w.Metric, w.MetricStat())
+	case WidgetPipeline:
+		return "Pipeline: " + w.Pipeline
+	}
+	return w.Type
+}
+
+// RefreshSeconds returns the seconds between refreshes of the widget
+func (w *Widget) RefreshSeconds() int {
+	if w.Refresh < 1 {
+		return DefaultWidgetRefresh
+	}
+	return w.Refresh
+}
+
+// AlarmState returns the alarm state an alarms widget shows, empty meaning all
+func (w *Widget) AlarmState() string {
+	state := strings.ToUpper(w.State)
+	switch state {
+	case "":
+		return "ALARM"
+	case "ALL":
+		return ""
+	}
+	return state
+}
+
+// MetricStat returns the statistic a metric widget plots
+func (w *Widget) MetricStat() string {
+	if w.Stat == "" {
+		return "Average"
+	}
+	return w.Stat
+}
+
+// MetricPeriod returns the seconds per datapoint of a metric widget
+func (w *Widget) MetricPeriod() int {
+	if w.Period < 1 {
+		return DefaultMetricPeriod
+	}
+	return w.Period
+}
+
+// MetricHours returns how many hours back a metric widget plots
+func (w *Widget) MetricHours() int {
+	if w.Hours < 1 {
+		return DefaultMetricHours
+	}
+	return w.Hours
+}
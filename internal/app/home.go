@@ -0,0 +1,127 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Home screen pin types
+const (
+	PinResources         = "resources"          // Resources of any list, e.g. ec2 filtered to running
+	PinUnhealthyServices = "unhealthy_services" // ECS services running fewer tasks than desired
+	PinAlarms            = "alarms"             // CloudWatch alarms, in ALARM state by default
+)
+
+// Home screen defaults
+const (
+	DefaultHomeRefresh   = 60 // Seconds
+	DefaultHomeBookmarks = 5
+)
+
+// Home configures the home screen: counts pinned to it, how often they refresh and how
+// many recent bookmarks it lists
+type Home struct {
+	Pins      []Pin `yaml:"pins,omitempty"`
+	Refresh   int   `yaml:"refresh,omitempty"`   // Seconds between refreshes, 60 by default
+	Bookmarks int   `yaml:"bookmarks,omitempty"` // Recent bookmarks listed, 5 by default, -1 for none
+}
+
+// Pin is a count on the home screen. Which fields apply depends on its type.
+type Pin struct {
+	Title string `yaml:"title,omitempty"`
+	Type  string `yaml:"type,omitempty"` // resources by default
+
+	// resources: the list's shortcut, as used with :, and an optional filter
+	Resource string `yaml:"resource,omitempty"`
+	Filter   string `yaml:"filter,omitempty"`
+
+	// unhealthy_services: the cluster name or ARN, every cluster when empty
+	Cluster string `yaml:"cluster,omitempty"`
+
+	// alarms: the state to count, ALARM by default, or all for every alarm
+	State string `yaml:"state,omitempty"`
+}
+
+// RefreshSeconds returns the seconds between refreshes of the home screen
+func (h *Home) RefreshSeconds() int {
+	if h.Refresh < 1 {
+		return DefaultHomeRefresh
+	}
+	return h.Refresh
+}
+
+// BookmarkCount returns how many recent bookmarks the home screen lists
+func (h *Home) BookmarkCount() int {
+	switch {
+	case h.Bookmarks < 0:
+		return 0
+	case h.Bookmarks == 0:
+		return DefaultHomeBookmarks
+	}
+	return h.Bookmarks
+}
+
+// PinType returns the type of a pin, resources unless set
+func (p *Pin) PinType() string {
+	if p.Type == "" {
+		return PinResources
+	}
+	return p.Type
+}
+
+// Validate checks that a pin has what its type needs
+func (p *Pin) Validate() error {
+	switch p.PinType() {
+	case PinResources:
+		if p.Resource == "" {
+			return fmt.Errorf("resources pin needs a resource, e.g. ec2")
+		}
+	case PinUnhealthyServices:
+	case PinAlarms:
+		switch strings.ToUpper(p.State) {
+		case "", "ALL", "OK", "ALARM", "INSUFFICIENT_DATA":
+		default:
+			return fmt.Errorf("alarms pin state must be OK, ALARM, INSUFFICIENT_DATA or all")
+		}
+	default:
+		return fmt.Errorf("unknown pin type %q, use resources, unhealthy_services or alarms", p.Type)
+	}
+	return nil
+}
+
+// DisplayTitle returns the pin's title, or one made up from what it counts
+func (p *Pin) DisplayTitle() string {
+	if p.Title != "" {
+		return p.Title
+	}
+	switch p.PinType() {
+	case PinResources:
+		if p.Filter != "" {
+			return fmt.Sprintf("%s: %s", p.Resource, p.Filter)
+		}
+		return p.Resource
+	case PinUnhealthyServices:
+		if p.Cluster != "" {
+			return "Unhealthy services: " + p.Cluster
+		}
+		return "Unhealthy ECS services"
+	case PinAlarms:
+		if state := p.AlarmState(); state != "" {
+			return "Alarms: " + state
+		}
+		return "Alarms"
+	}
+	return p.Type
+}
+
+// AlarmState returns the alarm state an alarms pin counts, empty meaning all
+func (p *Pin) AlarmState() string {
+	state := strings.ToUpper(p.State)
+	switch state {
+	case "":
+		return "ALARM"
+	case "ALL":
+		return ""
+	}
+	return state
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// LaunchScheme is the scheme of links that start the app on a view
+const LaunchScheme = "awstui"
+
+// launchViews are the commands a launch may open: views that only read, so a link pasted
+// into a runbook can't run the AWS CLI, an alias or a command that changes anything
+var launchViews = map[string]bool{
+	"home": true, "recent": true, "audit": true, "whoami": true, "changelog": true,
+	"errors": true, "keys": true, "aliases": true,
+	"users": true, "roles": true, "groups": true, "policies": true, "credreport": true,
+	"cleanup": true, "can": true, "sg": true, "kms": true, "secrets": true,
+	"secrets-rotation": true, "ec2": true, "instances": true, "imds": true,
+	"launch-templates": true, "lt": true, "vpc": true, "vpcs": true, "subnets": true,
+	"route-tables": true, "rtb": true, "nat": true, "igw": true, "eni": true, "enis": true,
+	"asg": true, "elb": true, "alb": true, "nlb": true, "rds": true, "rds-snapshots": true,
+	"rds-params": true, "rds-parameter-groups": true, "rds-options": true,
+	"rds-option-groups": true, "ecs": true, "lambda": true, "logs": true, "sources": true,
+	"tail": true, "s3": true, "dynamodb": true, "mq": true, "imagebuilder": true,
+	"pipelines": true, "connect": true, "pinpoint": true, "stacksets": true, "apigw": true,
+	"apigateway": true, "cost": true, "costs": true, "lookup": true, "tagged": true,
+	"orgs": true, "org": true, "advisor": true, "ta": true, "securityhub": true,
+	"sechub": true, "dashboard": true, "dash": true,
+}
+
+// Launch is where the app starts, given on the command line: the profile and region to
+// use, and a view to open with a search applied to it
+type Launch struct {
+	Profile string
+	Region  string
+	View    string // A command without the colon, e.g. ecs or "securityhub controls"
+	Filter  string
+}
+
+// ParseLaunchURL parses a link such as awstui://ecs?profile=prod&region=eu-west-1&filter=payments.
+// Path segments after the view are its arguments, so awstui://securityhub/controls opens
+// :securityhub controls.
+func ParseLaunchURL(link string) (Launch, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return Launch{}, fmt.Errorf("invalid link %q: %w", link, err)
+	}
+	if u.Scheme != LaunchScheme {
+		return Launch{}, fmt.Errorf("invalid link %q, it should start with %s://", link, LaunchScheme)
+	}
+
+	var view []string
+	if u.Host != "" {
+		view = append(view, u.Host)
+	}
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment != "" {
+			view = append(view, segment)
+		}
+	}
+
+	query := u.Query()
+	for key := range query {
+		switch key {
+		case "profile", "region", "filter":
+		default:
+			return Launch{}, fmt.Errorf("unknown parameter %q in %q, use profile, region or filter", key, link)
+		}
+	}
+	return Launch{
+		Profile: query.Get("profile"),
+		Region:  query.Get("region"),
+		View:    strings.Join(view, " "),
+		Filter:  query.Get("filter"),
+	}, nil
+}
+
+// Merge returns the launch with the fields set in other taking precedence
+func (l Launch) Merge(other Launch) Launch {
+	if other.Profile != "" {
+		l.Profile = other.Profile
+	}
+	if other.Region != "" {
+		l.Region = other.Region
+	}
+	if other.View != "" {
+		l.View = other.View
+	}
+	if other.Filter != "" {
+		l.Filter = other.Filter
+	}
+	return l
+}
+
+// Validate checks that the view is one that only reads, and that a filter comes with a
+// view to apply it to
+func (l Launch) Validate() error {
+	if fields := strings.Fields(l.View); len(fields) > 0 && !launchViews[fields[0]] {
+		return fmt.Errorf("%q can't be opened on launch, only views such as ecs or \"securityhub controls\" can", fields[0])
+	}
+	if l.Filter != "" && l.View == "" {
+		return fmt.Errorf("a filter needs a view to apply to, e.g. --view ecs")
+	}
+	return nil
+}
+
+// Apply makes the launch's profile and region the ones the app starts with, for this run
+// only
+func (l Launch) Apply(cfg *Config) {
+	if l.Profile != "" {
+		cfg.DefaultProfile = l.Profile
+	}
+	if l.Region != "" {
+		cfg.DefaultRegion = l.Region
+	}
+}
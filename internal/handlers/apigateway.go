@@ -0,0 +1,771 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+
+	apigwadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/apigateway"
+)
+
+// APIRef identifies an API for the stage and route views
+type APIRef struct {
+	ID       string
+	Name     string
+	Protocol string
+	Endpoint string // Only set for HTTP and WebSocket APIs
+}
+
+// NavigateToAPIStagesAction triggers navigation to the stages of an API
+type NavigateToAPIStagesAction struct {
+	API APIRef
+}
+
+func (a *NavigateToAPIStagesAction) Error() string {
+	return fmt.Sprintf("navigate to stages of %s", a.API.Name)
+}
+
+func (a *NavigateToAPIStagesAction) IsActionMsg() {}
+
+// NavigateToAPIRoutesAction triggers navigation to the routes of an API, optionally for a stage
+type NavigateToAPIRoutesAction struct {
+	API   APIRef
+	Stage string
+}
+
+func (a *NavigateToAPIRoutesAction) Error() string {
+	return fmt.Sprintf("navigate to routes of %s", a.API.Name)
+}
+
+func (a *NavigateToAPIRoutesAction) IsActionMsg() {}
+
+// TestInvokeRouteAction triggers a test invocation of a route
+type TestInvokeRouteAction struct {
+	API     APIRef
+	Stage   string
+	RouteID string
+	Method  string
+	Path    string
+}
+
+func (a *TestInvokeRouteAction) Error() string {
+	return fmt.Sprintf("test invoke %s %s", a.Method, a.Path)
+}
+
+func (a *TestInvokeRouteAction) IsActionMsg() {}
+
+// APIGatewayAPIsHandler handles API Gateway REST, HTTP and WebSocket APIs
+type APIGatewayAPIsHandler struct {
+	BaseHandler
+	rest   *apigwadapter.RestAPIsClient
+	http   *apigwadapter.HTTPAPIsClient
+	region string
+
+	// Protocol of each API seen when listing, by ID, to pick the API Gateway version to call
+	protocols map[string]string
+}
+
+// NewAPIGatewayAPIsHandler creates a new API Gateway APIs handler
+func NewAPIGatewayAPIsHandler(restClient *apigateway.Client, httpClient *apigatewayv2.Client, region string) *APIGatewayAPIsHandler {
+	return &APIGatewayAPIsHandler{
+		rest:      apigwadapter.NewRestAPIsClient(restClient),
+		http:      apigwadapter.NewHTTPAPIsClient(httpClient),
+		region:    region,
+		protocols: make(map[string]string),
+	}
+}
+
+func (h *APIGatewayAPIsHandler) ResourceType() string { return "apigateway:apis" }
+func (h *APIGatewayAPIsHandler) ResourceName() string { return "API Gateway" }
+func (h *APIGatewayAPIsHandler) ResourceIcon() string { return "🚪" }
+func (h *APIGatewayAPIsHandler) ShortcutKey() string  { return "apigw" }
+
+func (h *APIGatewayAPIsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "API ID", Width: 12, Sortable: true},
+		{Title: "Protocol", Width: 10, Sortable: true},
+		{Title: "Endpoint Type", Width: 14, Sortable: true},
+		{Title: "Description", Width: 35, Sortable: false},
+		{Title: "Created", Width: 17, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *APIGatewayAPIsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	restAPIs, err := h.rest.ListAPIs(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list REST APIs", err)
+	}
+
+	httpAPIs, err := h.http.ListAPIs(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list HTTP APIs", err)
+	}
+
+	apis := append(restAPIs, httpAPIs...)
+	resources := make([]Resource, 0, len(apis))
+	for _, api := range apis {
+		h.protocols[api.APIID] = api.Protocol
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(api.Name), filter) &&
+				!strings.Contains(strings.ToLower(api.APIID), filter) &&
+				!strings.Contains(strings.ToLower(api.Protocol), filter) &&
+				!strings.Contains(strings.ToLower(api.Description), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &APIGatewayAPIResource{
+			api:    api,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+// getAPI gets an API from the API Gateway version it was listed from
+func (h *APIGatewayAPIsHandler) getAPI(ctx context.Context, id string) (*apigwadapter.API, error) {
+	if h.protocols[id] == apigwadapter.ProtocolREST {
+		return h.rest.GetAPI(ctx, id)
+	}
+	return h.http.GetAPI(ctx, id)
+}
+
+func (h *APIGatewayAPIsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	api, err := h.getAPI(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get API %s", id), err)
+	}
+
+	return &APIGatewayAPIResource{
+		api:    *api,
+		region: h.region,
+	}, nil
+}
+
+func (h *APIGatewayAPIsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	api, err := h.getAPI(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe API %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	// Basic info
+	info := map[string]interface{}{
+		"Name":        api.Name,
+		"ApiId":       api.APIID,
+		"Protocol":    api.Protocol,
+		"Description": api.Description,
+		"Created":     api.CreatedTime.Format(time.RFC3339),
+	}
+	if api.EndpointType != "" {
+		info["EndpointType"] = api.EndpointType
+	}
+	if api.Endpoint != "" {
+		info["Endpoint"] = api.Endpoint
+	}
+	if api.Version != "" {
+		info["Version"] = api.Version
+	}
+	details["API"] = info
+
+	// Stages with their invoke URLs
+	var stages []apigwadapter.Stage
+	if api.Protocol == apigwadapter.ProtocolREST {
+		stages, err = h.rest.ListStages(ctx, id)
+	} else {
+		stages, err = h.http.ListStages(ctx, id)
+	}
+	if err == nil && len(stages) > 0 {
+		stageURLs := make(map[string]string, len(stages))
+		for _, stage := range stages {
+			stageURLs[stage.StageName] = stageInvokeURL(APIRef{ID: api.APIID, Protocol: api.Protocol, Endpoint: api.Endpoint}, h.region, stage.StageName)
+		}
+		details["Stages"] = stageURLs
+	}
+
+	// Tags
+	if len(api.Tags) > 0 {
+		details["Tags"] = api.Tags
+	}
+
+	return details, nil
+}
+
+func (h *APIGatewayAPIsHandler) Actions() []Action {
+	return []Action{
+		{Key: "s", Name: "stages", Description: "View stages"},
+		{Key: "o", Name: "routes", Description: "View routes"},
+	}
+}
+
+func (h *APIGatewayAPIsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "stages" && action != "routes" {
+		return ErrNotSupported
+	}
+
+	api, err := h.getAPI(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	ref := APIRef{
+		ID:       api.APIID,
+		Name:     api.Name,
+		Protocol: api.Protocol,
+		Endpoint: api.Endpoint,
+	}
+
+	if action == "stages" {
+		return &NavigateToAPIStagesAction{API: ref}
+	}
+	return &NavigateToAPIRoutesAction{API: ref}
+}
+
+// stageInvokeURL builds the URL a stage of an API is served from
+func stageInvokeURL(api APIRef, region, stage string) string {
+	if api.Protocol == apigwadapter.ProtocolREST {
+		return fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com/%s", api.ID, region, stage)
+	}
+	// The $default stage is served from the root of the endpoint
+	if stage == "$default" {
+		return api.Endpoint
+	}
+	return api.Endpoint + "/" + stage
+}
+
+// APIGatewayAPIResource implements Resource interface for APIs
+type APIGatewayAPIResource struct {
+	api    apigwadapter.API
+	region string
+}
+
+func (r *APIGatewayAPIResource) GetID() string   { return r.api.APIID }
+func (r *APIGatewayAPIResource) GetName() string { return r.api.Name }
+func (r *APIGatewayAPIResource) GetARN() string {
+	if r.api.Protocol == apigwadapter.ProtocolREST {
+		return fmt.Sprintf("arn:aws:apigateway:%s::/restapis/%s", r.region, r.api.APIID)
+	}
+	return fmt.Sprintf("arn:aws:apigateway:%s::/apis/%s", r.region, r.api.APIID)
+}
+func (r *APIGatewayAPIResource) GetType() string   { return "apigateway:apis" }
+func (r *APIGatewayAPIResource) GetRegion() string { return r.region }
+
+func (r *APIGatewayAPIResource) GetCreatedAt() time.Time {
+	return r.api.CreatedTime
+}
+
+func (r *APIGatewayAPIResource) GetTags() map[string]string {
+	return r.api.Tags
+}
+
+func (r *APIGatewayAPIResource) ToTableRow() []string {
+	created := "-"
+	if !r.api.CreatedTime.IsZero() {
+		created = r.api.CreatedTime.Format("2006-01-02 15:04")
+	}
+
+	endpointType := r.api.EndpointType
+	if endpointType == "" {
+		endpointType = "-"
+	}
+
+	return []string{
+		r.api.Name,
+		r.api.APIID,
+		r.api.Protocol,
+		endpointType,
+		r.api.Description,
+		created,
+	}
+}
+
+func (r *APIGatewayAPIResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Name":     r.api.Name,
+		"ApiId":    r.api.APIID,
+		"Protocol": r.api.Protocol,
+		"Endpoint": r.api.Endpoint,
+	}
+}
+
+// APIGatewayStagesHandler handles the stages of a single API
+type APIGatewayStagesHandler struct {
+	BaseHandler
+	rest   *apigwadapter.RestAPIsClient
+	http   *apigwadapter.HTTPAPIsClient
+	region string
+	api    APIRef
+}
+
+// NewAPIGatewayStagesHandler creates a new handler for the stages of an API
+func NewAPIGatewayStagesHandler(restClient *apigateway.Client, httpClient *apigatewayv2.Client, region string, api APIRef) *APIGatewayStagesHandler {
+	return &APIGatewayStagesHandler{
+		rest:   apigwadapter.NewRestAPIsClient(restClient),
+		http:   apigwadapter.NewHTTPAPIsClient(httpClient),
+		region: region,
+		api:    api,
+	}
+}
+
+func (h *APIGatewayStagesHandler) ResourceType() string { return "apigateway:stages" }
+func (h *APIGatewayStagesHandler) ResourceName() string { return "API Stages" }
+func (h *APIGatewayStagesHandler) ResourceIcon() string { return "🚪" }
+func (h *APIGatewayStagesHandler) ShortcutKey() string  { return "apigw-stages" }
+
+func (h *APIGatewayStagesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Stage", Width: 20, Sortable: true},
+		{Title: "Deployment", Width: 12, Sortable: false},
+		{Title: "Auto Deploy", Width: 11, Sortable: true},
+		{Title: "Invoke URL", Width: 60, Sortable: false},
+		{Title: "Last Updated", Width: 17, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *APIGatewayStagesHandler) listStages(ctx context.Context) ([]apigwadapter.Stage, error) {
+	if h.api.Protocol == apigwadapter.ProtocolREST {
+		return h.rest.ListStages(ctx, h.api.ID)
+	}
+	return h.http.ListStages(ctx, h.api.ID)
+}
+
+func (h *APIGatewayStagesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	stages, err := h.listStages(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list stages of %s", h.api.Name), err)
+	}
+
+	resources := make([]Resource, 0, len(stages))
+	for _, stage := range stages {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(stage.StageName), filter) &&
+				!strings.Contains(strings.ToLower(stage.Description), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &APIGatewayStageResource{
+			stage:     stage,
+			invokeURL: stageInvokeURL(h.api, h.region, stage.StageName),
+			api:       h.api,
+			region:    h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *APIGatewayStagesHandler) getStage(ctx context.Context, name string) (*APIGatewayStageResource, error) {
+	stages, err := h.listStages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stage := range stages {
+		if stage.StageName == name {
+			return &APIGatewayStageResource{
+				stage:     stage,
+				invokeURL: stageInvokeURL(h.api, h.region, stage.StageName),
+				api:       h.api,
+				region:    h.region,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("stage %s not found in %s", name, h.api.Name)
+}
+
+func (h *APIGatewayStagesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	stage, err := h.getStage(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get stage %s", id), err)
+	}
+	return stage, nil
+}
+
+func (h *APIGatewayStagesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.getStage(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe stage %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	stage := map[string]interface{}{
+		"StageName":    res.stage.StageName,
+		"DeploymentId": res.stage.DeploymentID,
+		"Description":  res.stage.Description,
+		"InvokeURL":    res.invokeURL,
+		"Created":      res.stage.CreatedTime.Format(time.RFC3339),
+		"LastUpdated":  res.stage.LastUpdated.Format(time.RFC3339),
+	}
+	if h.api.Protocol != apigwadapter.ProtocolREST {
+		stage["AutoDeploy"] = res.stage.AutoDeploy
+	}
+	details["Stage"] = stage
+
+	if len(res.stage.Variables) > 0 {
+		details["StageVariables"] = res.stage.Variables
+	}
+
+	return details, nil
+}
+
+func (h *APIGatewayStagesHandler) Actions() []Action {
+	return []Action{
+		{Key: "o", Name: "routes", Description: "View routes"},
+	}
+}
+
+func (h *APIGatewayStagesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "routes" {
+		return ErrNotSupported
+	}
+
+	return &NavigateToAPIRoutesAction{
+		API:   h.api,
+		Stage: resourceID,
+	}
+}
+
+// APIGatewayStageResource implements Resource interface for API stages
+type APIGatewayStageResource struct {
+	stage     apigwadapter.Stage
+	invokeURL string
+	api       APIRef
+	region    string
+}
+
+func (r *APIGatewayStageResource) GetID() string   { return r.stage.StageName }
+func (r *APIGatewayStageResource) GetName() string { return r.stage.StageName }
+func (r *APIGatewayStageResource) GetARN() string {
+	if r.api.Protocol == apigwadapter.ProtocolREST {
+		return fmt.Sprintf("arn:aws:apigateway:%s::/restapis/%s/stages/%s", r.region, r.api.ID, r.stage.StageName)
+	}
+	return fmt.Sprintf("arn:aws:apigateway:%s::/apis/%s/stages/%s", r.region, r.api.ID, r.stage.StageName)
+}
+func (r *APIGatewayStageResource) GetType() string   { return "apigateway:stages" }
+func (r *APIGatewayStageResource) GetRegion() string { return r.region }
+
+func (r *APIGatewayStageResource) GetCreatedAt() time.Time {
+	return r.stage.CreatedTime
+}
+
+func (r *APIGatewayStageResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *APIGatewayStageResource) ToTableRow() []string {
+	lastUpdated := "-"
+	if !r.stage.LastUpdated.IsZero() {
+		lastUpdated = r.stage.LastUpdated.Format("2006-01-02 15:04")
+	}
+
+	autoDeploy := "-"
+	if r.api.Protocol != apigwadapter.ProtocolREST {
+		autoDeploy = "no"
+		if r.stage.AutoDeploy {
+			autoDeploy = "yes"
+		}
+	}
+
+	return []string{
+		r.stage.StageName,
+		r.stage.DeploymentID,
+		autoDeploy,
+		r.invokeURL,
+		lastUpdated,
+	}
+}
+
+func (r *APIGatewayStageResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"StageName":    r.stage.StageName,
+		"DeploymentId": r.stage.DeploymentID,
+		"InvokeURL":    r.invokeURL,
+	}
+}
+
+// APIGatewayRoutesHandler handles the routes of a single API. REST API routes are the
+// methods of its resources.
+type APIGatewayRoutesHandler struct {
+	BaseHandler
+	rest   *apigwadapter.RestAPIsClient
+	http   *apigwadapter.HTTPAPIsClient
+	region string
+	api    APIRef
+	stage  string // Stage HTTP routes are invoked on, the $default or first stage if empty
+}
+
+// NewAPIGatewayRoutesHandler creates a new handler for the routes of an API
+func NewAPIGatewayRoutesHandler(restClient *apigateway.Client, httpClient *apigatewayv2.Client, region string, api APIRef, stage string) *APIGatewayRoutesHandler {
+	return &APIGatewayRoutesHandler{
+		rest:   apigwadapter.NewRestAPIsClient(restClient),
+		http:   apigwadapter.NewHTTPAPIsClient(httpClient),
+		region: region,
+		api:    api,
+		stage:  stage,
+	}
+}
+
+func (h *APIGatewayRoutesHandler) ResourceType() string { return "apigateway:routes" }
+func (h *APIGatewayRoutesHandler) ResourceName() string { return "API Routes" }
+func (h *APIGatewayRoutesHandler) ResourceIcon() string { return "🚪" }
+func (h *APIGatewayRoutesHandler) ShortcutKey() string  { return "apigw-routes" }
+
+func (h *APIGatewayRoutesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Method", Width: 8, Sortable: true},
+		{Title: "Path", Width: 40, Sortable: true},
+		{Title: "Integration", Width: 14, Sortable: true},
+		{Title: "Target", Width: 50, Sortable: false},
+		{Title: "Auth", Width: 12, Sortable: true},
+	}
+}
+
+func (h *APIGatewayRoutesHandler) listRoutes(ctx context.Context) ([]apigwadapter.Route, error) {
+	if h.api.Protocol == apigwadapter.ProtocolREST {
+		return h.rest.ListRoutes(ctx, h.api.ID)
+	}
+	return h.http.ListRoutes(ctx, h.api.ID)
+}
+
+func (h *APIGatewayRoutesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	routes, err := h.listRoutes(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list routes of %s", h.api.Name), err)
+	}
+
+	resources := make([]Resource, 0, len(routes))
+	for _, route := range routes {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			target := ""
+			if route.Integration != nil {
+				target = strings.ToLower(route.Integration.URI)
+			}
+			if !strings.Contains(strings.ToLower(route.Path), filter) &&
+				!strings.Contains(strings.ToLower(route.Method), filter) &&
+				!strings.Contains(target, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &APIGatewayRouteResource{
+			route:  route,
+			api:    h.api,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *APIGatewayRoutesHandler) getRoute(ctx context.Context, id string) (*apigwadapter.Route, error) {
+	routes, err := h.listRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, route := range routes {
+		if routeResourceID(h.api, route) == id {
+			return &route, nil
+		}
+	}
+
+	return nil, fmt.Errorf("route %s not found in %s", id, h.api.Name)
+}
+
+func (h *APIGatewayRoutesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	route, err := h.getRoute(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get route %s", id), err)
+	}
+
+	return &APIGatewayRouteResource{
+		route:  *route,
+		api:    h.api,
+		region: h.region,
+	}, nil
+}
+
+func (h *APIGatewayRoutesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	route, err := h.getRoute(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe route %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	details["Route"] = map[string]interface{}{
+		"Method":            route.Method,
+		"Path":              route.Path,
+		"AuthorizationType": route.AuthorizationType,
+		"ApiKeyRequired":    route.APIKeyRequired,
+	}
+
+	if i := route.Integration; i != nil {
+		integration := map[string]interface{}{
+			"Type": i.Type,
+			"Uri":  i.URI,
+		}
+		if i.Method != "" {
+			integration["HttpMethod"] = i.Method
+		}
+		if i.ConnectionType != "" {
+			integration["ConnectionType"] = i.ConnectionType
+		}
+		if i.TimeoutMillis > 0 {
+			integration["Timeout"] = fmt.Sprintf("%d ms", i.TimeoutMillis)
+		}
+		details["Integration"] = integration
+	}
+
+	return details, nil
+}
+
+func (h *APIGatewayRoutesHandler) Actions() []Action {
+	return []Action{
+		{Key: "i", Name: "invoke", Description: "Test invoke route", Mutating: true},
+	}
+}
+
+func (h *APIGatewayRoutesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "invoke" {
+		return ErrNotSupported
+	}
+
+	if h.api.Protocol == apigwadapter.ProtocolWebSocket {
+		return fmt.Errorf("WebSocket routes can't be test invoked")
+	}
+
+	route, err := h.getRoute(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	return &TestInvokeRouteAction{
+		API:     h.api,
+		Stage:   h.stage,
+		RouteID: route.RouteID,
+		Method:  route.Method,
+		Path:    route.Path,
+	}
+}
+
+// TestInvoke invokes a route. REST routes use API Gateway's test invocation, which needs no
+// deployment and skips authorizers. HTTP routes are called on a deployed stage.
+func (h *APIGatewayRoutesHandler) TestInvoke(ctx context.Context, action *TestInvokeRouteAction) (*apigwadapter.InvokeResult, error) {
+	if action.API.Protocol == apigwadapter.ProtocolREST {
+		return h.rest.TestInvoke(ctx, action.API.ID, action.RouteID, action.Method, action.Path)
+	}
+
+	stage := action.Stage
+	if stage == "" {
+		stages, err := h.http.ListStages(ctx, action.API.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(stages) == 0 {
+			return nil, fmt.Errorf("%s has no stages to invoke", action.API.Name)
+		}
+		stage = stages[0].StageName
+		for _, s := range stages {
+			if s.StageName == "$default" {
+				stage = s.StageName
+				break
+			}
+		}
+	}
+
+	return h.http.Invoke(ctx, action.API.Endpoint, stage, action.Method, action.Path)
+}
+
+// routeResourceID identifies a route within an API. REST resources can have several
+// methods, so their ID includes the method.
+func routeResourceID(api APIRef, route apigwadapter.Route) string {
+	if api.Protocol == apigwadapter.ProtocolREST {
+		return route.RouteID + " " + route.Method
+	}
+	return route.RouteID
+}
+
+// APIGatewayRouteResource implements Resource interface for API routes
+type APIGatewayRouteResource struct {
+	route  apigwadapter.Route
+	api    APIRef
+	region string
+}
+
+func (r *APIGatewayRouteResource) GetID() string   { return routeResourceID(r.api, r.route) }
+func (r *APIGatewayRouteResource) GetName() string { return r.route.Method + " " + r.route.Path }
+func (r *APIGatewayRouteResource) GetARN() string {
+	if r.api.Protocol == apigwadapter.ProtocolREST {
+		return fmt.Sprintf("arn:aws:apigateway:%s::/restapis/%s/resources/%s/methods/%s", r.region, r.api.ID, r.route.RouteID, r.route.Method)
+	}
+	return fmt.Sprintf("arn:aws:apigateway:%s::/apis/%s/routes/%s", r.region, r.api.ID, r.route.RouteID)
+}
+func (r *APIGatewayRouteResource) GetType() string   { return "apigateway:routes" }
+func (r *APIGatewayRouteResource) GetRegion() string { return r.region }
+
+func (r *APIGatewayRouteResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *APIGatewayRouteResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *APIGatewayRouteResource) ToTableRow() []string {
+	integrationType := "-"
+	target := "-"
+	if i := r.route.Integration; i != nil {
+		integrationType = i.Type
+		if i.URI != "" {
+			target = i.URI
+		}
+	}
+
+	auth := r.route.AuthorizationType
+	if auth == "" {
+		auth = "NONE"
+	}
+
+	return []string{
+		r.route.Method,
+		r.route.Path,
+		integrationType,
+		target,
+		auth,
+	}
+}
+
+func (r *APIGatewayRouteResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Method":            r.route.Method,
+		"Path":              r.route.Path,
+		"AuthorizationType": r.route.AuthorizationType,
+	}
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+)
+
+// AuditLogHandler lists the audit log: the elevations, and the AWS calls that may have
+// changed something, newest first
+type AuditLogHandler struct {
+	BaseHandler
+	log *config.AuditLog
+
+	// Entries of the last list, keyed by line number
+	entries map[string]config.AuditEntry
+}
+
+// NewAuditLogHandler creates a new audit log handler
+func NewAuditLogHandler(log *config.AuditLog) *AuditLogHandler {
+	return &AuditLogHandler{
+		log:     log,
+		entries: make(map[string]config.AuditEntry),
+	}
+}
+
+func (h *AuditLogHandler) ResourceType() string { return "audit:log" }
+func (h *AuditLogHandler) ResourceName() string { return "Audit Log" }
+func (h *AuditLogHandler) ResourceIcon() string { return "📜" }
+func (h *AuditLogHandler) ShortcutKey() string  { return "audit" }
+
+func (h *AuditLogHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Time", Width: 19, Sortable: true, SortType: SortDate},
+		{Title: "Action", Width: 32, Sortable: true, Priority: 2},
+		{Title: "Resource", Width: 40, Sortable: true, Priority: 1},
+		{Title: "Outcome", Width: 10, Sortable: true, Priority: 1},
+		{Title: "Profile", Width: 18, Sortable: true},
+		{Title: "Region", Width: 14, Sortable: true},
+		{Title: "User", Width: 12, Sortable: true},
+	}
+}
+
+func (h *AuditLogHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	entries, err := h.log.Read()
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to read the audit log", err)
+	}
+
+	h.entries = make(map[string]config.AuditEntry, len(entries))
+	resources := make([]Resource, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		res := &AuditLogResource{id: strconv.Itoa(i + 1), entry: entries[i]}
+		h.entries[res.id] = entries[i]
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(strings.Join(res.ToTableRow(), " ")), filter) {
+				continue
+			}
+		}
+		resources = append(resources, res)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *AuditLogHandler) Get(ctx context.Context, id string) (Resource, error) {
+	entry, ok := h.entries[id]
+	if !ok {
+		return nil, NewHandlerError("NOT_FOUND", fmt.Sprintf("no audit log entry %s", id), nil)
+	}
+	return &AuditLogResource{id: id, entry: entry}, nil
+}
+
+func (h *AuditLogHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	entry, ok := h.entries[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("no audit log entry %s", id), nil)
+	}
+	return (&AuditLogResource{id: id, entry: entry}).ToDetailMap(), nil
+}
+
+// AuditLogResource is an entry of the audit log
+type AuditLogResource struct {
+	id    string // Line of the log
+	entry config.AuditEntry
+}
+
+func (r *AuditLogResource) GetID() string { return r.id }
+func (r *AuditLogResource) GetName() string {
+	if r.entry.Operation != "" {
+		return r.entry.Operation
+	}
+	return r.entry.Event
+}
+func (r *AuditLogResource) GetARN() string {
+	if strings.HasPrefix(r.entry.Resource, "arn:") {
+		return r.entry.Resource
+	}
+	return ""
+}
+func (r *AuditLogResource) GetType() string            { return "audit:log" }
+func (r *AuditLogResource) GetRegion() string          { return r.entry.Region }
+func (r *AuditLogResource) GetCreatedAt() time.Time    { return r.entry.Time }
+func (r *AuditLogResource) GetTags() map[string]string { return nil }
+
+// action describes the entry, e.g. "EC2 StopInstances" or "elevate 30m"
+func (r *AuditLogResource) action() string {
+	e := r.entry
+	switch e.Event {
+	case config.AuditCall:
+		return strings.TrimSpace(e.Service + " " + e.Operation)
+	case config.AuditCommand:
+		return "aws " + strings.Join(e.Command[:min(len(e.Command), 2)], " ")
+	case config.AuditElevate:
+		return fmt.Sprintf("elevate %dm", e.Minutes)
+	case config.AuditExpire:
+		return "elevation expired"
+	case config.AuditEnd:
+		return "elevation ended"
+	}
+	return e.Event
+}
+
+func (r *AuditLogResource) ToTableRow() []string {
+	e := r.entry
+	resource := e.Resource
+	if resource == "" {
+		resource = e.Reason
+	}
+	if resource == "" && len(e.Command) > 2 {
+		resource = strings.Join(e.Command[2:], " ")
+	}
+	return []string{
+		e.Time.Local().Format("2006-01-02 15:04:05"),
+		r.action(),
+		orDash(resource),
+		orDash(e.Outcome),
+		orDash(e.Profile),
+		orDash(e.Region),
+		orDash(e.User),
+	}
+}
+
+func (r *AuditLogResource) ToDetailMap() map[string]interface{} {
+	e := r.entry
+	details := map[string]interface{}{
+		"Time":  e.Time.Local().Format("2006-01-02 15:04:05"),
+		"Event": e.Event,
+		"User":  e.User,
+	}
+	fields := map[string]string{
+		"Profile":   e.Profile,
+		"Account":   e.Account,
+		"Region":    e.Region,
+		"Service":   e.Service,
+		"Operation": e.Operation,
+		"Resource":  e.Resource,
+		"Outcome":   e.Outcome,
+		"Error":     e.Error,
+		"Reason":    e.Reason,
+	}
+	for key, value := range fields {
+		if value != "" {
+			details[key] = value
+		}
+	}
+	if e.Minutes > 0 {
+		details["Minutes"] = e.Minutes
+	}
+	if len(e.Command) > 0 {
+		details["Command"] = "aws " + strings.Join(e.Command, " ")
+	}
+	return map[string]interface{}{"AuditEntry": details}
+}
@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	asgadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/autoscaling"
+)
+
+// SetDesiredCapacityAction is returned by ExecuteAction to ask for a group's new desired capacity
+type SetDesiredCapacityAction struct {
+	GroupName string
+	Desired   int32
+	Min       int32
+	Max       int32
+}
+
+func (a *SetDesiredCapacityAction) Error() string {
+	return fmt.Sprintf("set desired capacity of %s", a.GroupName)
+}
+
+func (a *SetDesiredCapacityAction) IsActionMsg() {}
+
+// StartInstanceRefreshAction is returned by ExecuteAction to confirm an instance refresh
+type StartInstanceRefreshAction struct {
+	GroupName string
+	Instances int
+}
+
+func (a *StartInstanceRefreshAction) Error() string {
+	return fmt.Sprintf("start instance refresh of %s", a.GroupName)
+}
+
+func (a *StartInstanceRefreshAction) IsActionMsg() {}
+
+// StandbyCandidate is an in-service instance that can be moved to standby
+type StandbyCandidate struct {
+	ID          string
+	Description string
+}
+
+// EnterStandbyAction is returned by ExecuteAction to pick the instance to move to standby.
+// Decrement is set when the desired capacity can be lowered instead of launching a replacement.
+type EnterStandbyAction struct {
+	GroupName  string
+	Candidates []StandbyCandidate
+	Decrement  bool
+}
+
+func (a *EnterStandbyAction) Error() string {
+	return fmt.Sprintf("move an instance of %s to standby", a.GroupName)
+}
+
+func (a *EnterStandbyAction) IsActionMsg() {}
+
+// AutoScalingGroupsHandler handles Auto Scaling groups
+type AutoScalingGroupsHandler struct {
+	BaseHandler
+	client *asgadapter.GroupsClient
+	region string
+}
+
+// NewAutoScalingGroupsHandler creates a new Auto Scaling groups handler
+func NewAutoScalingGroupsHandler(asgClient *autoscaling.Client, region string) *AutoScalingGroupsHandler {
+	return &AutoScalingGroupsHandler{
+		client: asgadapter.NewGroupsClient(asgClient),
+		region: region,
+	}
+}
+
+func (h *AutoScalingGroupsHandler) ResourceType() string { return "autoscaling:groups" }
+func (h *AutoScalingGroupsHandler) ResourceName() string { return "Auto Scaling Groups" }
+func (h *AutoScalingGroupsHandler) ResourceIcon() string { return "📈" }
+func (h *AutoScalingGroupsHandler) ShortcutKey() string  { return "asg" }
+
+func (h *AutoScalingGroupsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 40, Sortable: true},
+		{Title: "Desired", Width: 8, Sortable: true, SortType: SortNumber},
+		{Title: "Min", Width: 6, Sortable: true, SortType: SortNumber},
+		{Title: "Max", Width: 6, Sortable: true, SortType: SortNumber},
+		{Title: "In Service", Width: 10, Sortable: true},
+		{Title: "Launch Template", Width: 36, Sortable: false},
+		{Title: "Health Check", Width: 12, Sortable: true},
+	}
+}
+
+func (h *AutoScalingGroupsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	groups, err := h.client.ListGroups(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list auto scaling groups", err)
+	}
+
+	resources := make([]Resource, 0, len(groups))
+	for _, group := range groups {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(group.Name)
+			template := strings.ToLower(group.LaunchTemplate)
+			if !strings.Contains(name, filter) && !strings.Contains(template, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &AutoScalingGroupResource{
+			group:  group,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *AutoScalingGroupsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	group, err := h.client.GetGroup(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get auto scaling group %s", id), err)
+	}
+
+	return &AutoScalingGroupResource{
+		group:  *group,
+		region: h.region,
+	}, nil
+}
+
+func (h *AutoScalingGroupsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe auto scaling group %s", id), err)
+	}
+
+	details := res.ToDetailMap()
+	group := res.(*AutoScalingGroupResource).group
+
+	instances := make([]string, 0, len(group.Instances))
+	for _, inst := range group.Instances {
+		line := fmt.Sprintf("%s %s %s %s (%s)", inst.InstanceID, inst.InstanceType, inst.AvailabilityZone, inst.LifecycleState, inst.HealthStatus)
+		if inst.ProtectedFromScaleIn {
+			line += " protected"
+		}
+		instances = append(instances, line)
+	}
+	details["Instances"] = instances
+
+	policies, err := h.client.ListPolicies(ctx, id)
+	if err == nil {
+		summaries := make([]string, 0, len(policies))
+		for _, p := range policies {
+			line := fmt.Sprintf("%s: %s", p.Name, p.Summary)
+			if !p.Enabled {
+				line += " (disabled)"
+			}
+			summaries = append(summaries, line)
+		}
+		details["ScalingPolicies"] = summaries
+	}
+
+	refresh, err := h.client.LatestInstanceRefresh(ctx, id)
+	if err == nil && refresh != nil {
+		latest := map[string]interface{}{
+			"InstanceRefreshId":  refresh.ID,
+			"Status":             refresh.Status,
+			"PercentageComplete": refresh.PercentageComplete,
+		}
+		if refresh.StatusReason != "" {
+			latest["StatusReason"] = refresh.StatusReason
+		}
+		if !refresh.StartTime.IsZero() {
+			latest["StartTime"] = refresh.StartTime.Format(time.RFC3339)
+		}
+		details["LatestInstanceRefresh"] = latest
+	}
+
+	return details, nil
+}
+
+func (h *AutoScalingGroupsHandler) Actions() []Action {
+	return []Action{
+		{Key: "D", Name: "desired", Description: "Set desired capacity", Mutating: true},
+		{Key: "I", Name: "refresh", Description: "Start instance refresh", Dangerous: true, Mutating: true},
+		{Key: "S", Name: "standby", Description: "Move an instance to standby", Mutating: true},
+	}
+}
+
+func (h *AutoScalingGroupsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	group, err := h.client.GetGroup(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "desired":
+		return &SetDesiredCapacityAction{
+			GroupName: group.Name,
+			Desired:   group.DesiredCapacity,
+			Min:       group.MinSize,
+			Max:       group.MaxSize,
+		}
+	case "refresh":
+		return &StartInstanceRefreshAction{
+			GroupName: group.Name,
+			Instances: len(group.Instances),
+		}
+	case "standby":
+		var candidates []StandbyCandidate
+		for _, inst := range group.Instances {
+			if inst.LifecycleState != "InService" {
+				continue
+			}
+			candidates = append(candidates, StandbyCandidate{
+				ID:          inst.InstanceID,
+				Description: fmt.Sprintf("%s, %s, %s", inst.InstanceType, inst.AvailabilityZone, inst.HealthStatus),
+			})
+		}
+		if len(candidates) == 0 {
+			return fmt.Errorf("%s has no in-service instances", group.Name)
+		}
+		return &EnterStandbyAction{
+			GroupName:  group.Name,
+			Candidates: candidates,
+			Decrement:  group.DesiredCapacity > group.MinSize,
+		}
+	}
+
+	return ErrNotSupported
+}
+
+// SetDesiredCapacity sets the desired capacity of a group
+func (h *AutoScalingGroupsHandler) SetDesiredCapacity(ctx context.Context, groupName string, desired int32) error {
+	return h.client.SetDesiredCapacity(ctx, groupName, desired)
+}
+
+// StartInstanceRefresh starts an instance refresh of a group and returns its ID
+func (h *AutoScalingGroupsHandler) StartInstanceRefresh(ctx context.Context, groupName string) (string, error) {
+	return h.client.StartInstanceRefresh(ctx, groupName)
+}
+
+// EnterStandby moves an instance of a group to standby
+func (h *AutoScalingGroupsHandler) EnterStandby(ctx context.Context, groupName, instanceID string, decrement bool) error {
+	return h.client.EnterStandby(ctx, groupName, instanceID, decrement)
+}
+
+// AutoScalingGroupResource implements Resource interface for Auto Scaling groups
+type AutoScalingGroupResource struct {
+	group  asgadapter.Group
+	region string
+}
+
+func (r *AutoScalingGroupResource) GetID() string              { return r.group.Name }
+func (r *AutoScalingGroupResource) GetName() string            { return r.group.Name }
+func (r *AutoScalingGroupResource) GetARN() string             { return r.group.ARN }
+func (r *AutoScalingGroupResource) GetType() string            { return "autoscaling:groups" }
+func (r *AutoScalingGroupResource) GetRegion() string          { return r.region }
+func (r *AutoScalingGroupResource) GetCreatedAt() time.Time    { return r.group.CreatedTime }
+func (r *AutoScalingGroupResource) GetTags() map[string]string { return r.group.Tags }
+
+func (r *AutoScalingGroupResource) ToTableRow() []string {
+	return []string{
+		r.group.Name,
+		fmt.Sprintf("%d", r.group.DesiredCapacity),
+		fmt.Sprintf("%d", r.group.MinSize),
+		fmt.Sprintf("%d", r.group.MaxSize),
+		fmt.Sprintf("%d", r.group.InService()),
+		orDash(r.group.LaunchTemplate),
+		r.group.HealthCheckType,
+	}
+}
+
+func (r *AutoScalingGroupResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"AutoScalingGroupName": r.group.Name,
+		"AutoScalingGroupARN":  r.group.ARN,
+		"DesiredCapacity":      r.group.DesiredCapacity,
+		"MinSize":              r.group.MinSize,
+		"MaxSize":              r.group.MaxSize,
+		"InService":            r.group.InService(),
+		"HealthCheckType":      r.group.HealthCheckType,
+		"AvailabilityZones":    r.group.AvailabilityZones,
+	}
+	if r.group.LaunchTemplate != "" {
+		details["LaunchTemplate"] = r.group.LaunchTemplate
+	}
+	if r.group.Subnets != "" {
+		details["VPCZoneIdentifier"] = r.group.Subnets
+	}
+	if len(r.group.TargetGroupARNs) > 0 {
+		details["TargetGroupARNs"] = r.group.TargetGroupARNs
+	}
+	if r.group.Status != "" {
+		details["Status"] = r.group.Status
+	}
+	if !r.group.CreatedTime.IsZero() {
+		details["CreatedTime"] = r.group.CreatedTime.Format(time.RFC3339)
+	}
+	if len(r.group.Tags) > 0 {
+		details["Tags"] = r.group.Tags
+	}
+	return details
+}
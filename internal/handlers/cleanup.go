@@ -0,0 +1,433 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+	iamadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/iam"
+)
+
+// DefaultUnusedRoleDays is how long a role goes unused before the cleanup report flags it
+const DefaultUnusedRoleDays = 90
+
+// cleanupRoleConcurrency caps the GetRole calls in flight, ListRoles leaves out when a
+// role was last used
+const cleanupRoleConcurrency = 5
+
+// Kinds of resources in the cleanup report
+const (
+	CleanupSecurityGroup = "Security Group"
+	CleanupRole          = "IAM Role"
+)
+
+// DeleteUnusedAction triggers the delete confirmation for resources of the cleanup report.
+// Resources with dependencies that block the delete are left out and listed in Blocked.
+type DeleteUnusedAction struct {
+	Findings []CleanupFinding
+	Blocked  []string
+}
+
+func (a *DeleteUnusedAction) Error() string {
+	return fmt.Sprintf("delete %d unused resources", len(a.Findings))
+}
+
+func (a *DeleteUnusedAction) IsActionMsg() {}
+
+// ConfirmText is what has to be typed to confirm the delete
+func (a *DeleteUnusedAction) ConfirmText() string {
+	return fmt.Sprintf("delete %d", len(a.Findings))
+}
+
+// CleanupFinding is a resource the cleanup report flags as unused
+type CleanupFinding struct {
+	Kind        string
+	ID          string // Group ID or role name
+	Name        string
+	ARN         string
+	Reason      string
+	Blockers    []string // Dependencies that keep it from being deleted
+	Description string
+	VpcID       string
+	CreatedAt   time.Time
+	LastUsed    time.Time
+	Policies    int // Managed and inline policies removed along with a role
+}
+
+// CleanupHandler reports security groups without network interfaces and IAM roles unused
+// for a number of days, and deletes the marked ones
+type CleanupHandler struct {
+	BaseHandler
+	groups *ec2adapter.SecurityGroupsClient
+	enis   *ec2adapter.NetworkInterfacesClient
+	roles  *iamadapter.RolesClient
+	region string
+	days   int
+
+	// Findings of the last list, keyed by resource ID. The report takes a call per role,
+	// so it isn't repeated to describe one.
+	findings map[string]*CleanupFinding
+}
+
+// NewCleanupHandler creates a cleanup report flagging roles unused for the given days
+func NewCleanupHandler(ec2Client *ec2.Client, iamClient *iam.Client, region string, days int) *CleanupHandler {
+	return &CleanupHandler{
+		groups: ec2adapter.NewSecurityGroupsClient(ec2Client),
+		enis:   ec2adapter.NewNetworkInterfacesClient(ec2Client),
+		roles:  iamadapter.NewRolesClient(iamClient),
+		region: region,
+		days:   days,
+	}
+}
+
+func (h *CleanupHandler) ResourceType() string { return "cleanup:unused" }
+func (h *CleanupHandler) ResourceName() string { return "Unused Resources" }
+func (h *CleanupHandler) ResourceIcon() string { return "🧹" }
+func (h *CleanupHandler) ShortcutKey() string  { return "cleanup" }
+
+func (h *CleanupHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 35, Sortable: true},
+		{Title: "Type", Width: 15, Sortable: true},
+		{Title: "ID", Width: 22, Sortable: true},
+		{Title: "Unused", Width: 35, Sortable: true},
+		{Title: "Dependencies", Width: 35, Sortable: false},
+	}
+}
+
+func (h *CleanupHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	groups, err := h.unusedSecurityGroups(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to check security groups", err)
+	}
+	roles, err := h.unusedRoles(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to check IAM roles", err)
+	}
+
+	h.findings = make(map[string]*CleanupFinding, len(groups)+len(roles))
+	resources := make([]Resource, 0, len(groups)+len(roles))
+	for _, finding := range append(groups, roles...) {
+		h.findings[finding.ID] = finding
+
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(finding.Name), filter) &&
+				!strings.Contains(strings.ToLower(finding.ID), filter) &&
+				!strings.Contains(strings.ToLower(finding.Kind), filter) {
+				continue
+			}
+		}
+		resources = append(resources, &CleanupResource{finding: finding, region: h.region})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+// unusedSecurityGroups flags groups no network interface uses. Default groups can't be
+// deleted and are left out; groups other groups' rules refer to are flagged as blocked.
+func (h *CleanupHandler) unusedSecurityGroups(ctx context.Context) ([]*CleanupFinding, error) {
+	groups, err := h.groups.ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enis, err := h.enis.ListNetworkInterfaces(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	attached := make(map[string]bool)
+	for _, eni := range enis {
+		for _, id := range eni.SecurityGroups {
+			attached[id] = true
+		}
+	}
+	referencedBy := make(map[string][]string)
+	for _, sg := range groups {
+		for _, id := range sg.ReferencedGroupIDs {
+			referencedBy[id] = append(referencedBy[id], sg.GroupID)
+		}
+	}
+
+	var findings []*CleanupFinding
+	for _, sg := range groups {
+		if sg.GroupName == "default" || attached[sg.GroupID] {
+			continue
+		}
+		finding := &CleanupFinding{
+			Kind:        CleanupSecurityGroup,
+			ID:          sg.GroupID,
+			Name:        sg.GroupName,
+			ARN:         fmt.Sprintf("arn:aws:ec2:%s:%s:security-group/%s", h.region, sg.OwnerID, sg.GroupID),
+			Reason:      "No network interfaces",
+			Description: sg.Description,
+			VpcID:       sg.VpcID,
+		}
+		if name := sg.Tags["Name"]; name != "" {
+			finding.Name = name
+		}
+		if refs := referencedBy[sg.GroupID]; len(refs) > 0 {
+			finding.Blockers = []string{"Referenced by " + strings.Join(refs, ", ")}
+		}
+		findings = append(findings, finding)
+	}
+	return findings, nil
+}
+
+// unusedRoles flags roles not used within the report's days, or never used and older.
+// Service-linked roles are deleted by their service and are left out, as are roles
+// whose last use can't be read.
+func (h *CleanupHandler) unusedRoles(ctx context.Context) ([]*CleanupFinding, error) {
+	roles, err := h.roles.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		findings []*CleanupFinding
+	)
+	sem := make(chan struct{}, cleanupRoleConcurrency)
+	cutoff := time.Now().AddDate(0, 0, -h.days)
+
+	for _, role := range roles {
+		if role.ServiceLinked() || role.CreateDate.After(cutoff) {
+			continue
+		}
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			finding, err := h.checkRole(ctx, name, cutoff)
+			if err != nil || finding == nil {
+				return
+			}
+			mu.Lock()
+			findings = append(findings, finding)
+			mu.Unlock()
+		}(role.Name)
+	}
+	wg.Wait()
+
+	// Longest unused first, never used roles by age
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].lastActive().Before(findings[j].lastActive())
+	})
+	return findings, nil
+}
+
+// checkRole returns a finding for a role unused since the cutoff, or nil when it was used
+func (h *CleanupHandler) checkRole(ctx context.Context, name string, cutoff time.Time) (*CleanupFinding, error) {
+	role, err := h.roles.GetRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if role.LastUsed.After(cutoff) || role.CreateDate.After(cutoff) {
+		return nil, nil
+	}
+
+	finding := &CleanupFinding{
+		Kind:        CleanupRole,
+		ID:          role.Name,
+		Name:        role.Name,
+		ARN:         role.ARN,
+		Description: role.Description,
+		CreatedAt:   role.CreateDate,
+		LastUsed:    role.LastUsed,
+		Reason:      unusedSince(role.CreateDate, role.LastUsed),
+	}
+
+	deps, err := h.roles.GetRoleDependencies(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	finding.Policies = len(deps.AttachedPolicies) + len(deps.InlinePolicies)
+	if len(deps.InstanceProfiles) > 0 {
+		finding.Blockers = []string{"Instance profile " + strings.Join(deps.InstanceProfiles, ", ")}
+	}
+	return finding, nil
+}
+
+// unusedSince describes how long a role went unused
+func unusedSince(created, lastUsed time.Time) string {
+	if lastUsed.IsZero() {
+		return fmt.Sprintf("Never used, created %s", created.Format("2006-01-02"))
+	}
+	days := int(time.Since(lastUsed).Hours() / 24)
+	return fmt.Sprintf("Last used %s (%d days)", lastUsed.Format("2006-01-02"), days)
+}
+
+// lastActive is when a finding was last used, or created when it never was
+func (f *CleanupFinding) lastActive() time.Time {
+	if f.LastUsed.IsZero() {
+		return f.CreatedAt
+	}
+	return f.LastUsed
+}
+
+func (h *CleanupHandler) Get(ctx context.Context, id string) (Resource, error) {
+	finding, ok := h.findings[id]
+	if !ok {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("%s is not in the cleanup report, refresh it", id), nil)
+	}
+	return &CleanupResource{finding: finding, region: h.region}, nil
+}
+
+func (h *CleanupHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	finding, ok := h.findings[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("%s is not in the cleanup report, refresh it", id), nil)
+	}
+
+	details := (&CleanupResource{finding: finding, region: h.region}).ToDetailMap()
+	if len(finding.Blockers) > 0 {
+		details["Dependencies"] = finding.Blockers
+	}
+	return details, nil
+}
+
+func (h *CleanupHandler) Actions() []Action {
+	return []Action{
+		{Key: "D", Name: "delete", Description: "Delete marked (space)", Mutating: true, Severity: SeverityCritical, Batch: true},
+	}
+}
+
+// ExecuteBatchAction prepares deleting the marked resources, leaving out those with
+// dependencies
+func (h *CleanupHandler) ExecuteBatchAction(ctx context.Context, action string, resourceIDs []string) error {
+	if action != "delete" {
+		return ErrNotSupported
+	}
+
+	del := &DeleteUnusedAction{}
+	for _, id := range resourceIDs {
+		finding, ok := h.findings[id]
+		if !ok {
+			continue
+		}
+		if len(finding.Blockers) > 0 {
+			del.Blocked = append(del.Blocked, fmt.Sprintf("%s: %s", finding.Name, strings.Join(finding.Blockers, "; ")))
+			continue
+		}
+		del.Findings = append(del.Findings, *finding)
+	}
+	if len(del.Findings) == 0 {
+		if len(del.Blocked) > 0 {
+			return fmt.Errorf("nothing to delete, %s", strings.Join(del.Blocked, "; "))
+		}
+		return fmt.Errorf("nothing to delete, refresh the report")
+	}
+	return del
+}
+
+// DeleteFinding checks a finding's dependencies again and deletes it. A role used since
+// the report was listed is kept.
+func (h *CleanupHandler) DeleteFinding(ctx context.Context, finding CleanupFinding) error {
+	switch finding.Kind {
+	case CleanupSecurityGroup:
+		enis, err := h.enis.ListSecurityGroupInterfaces(ctx, finding.ID)
+		if err != nil {
+			return err
+		}
+		if len(enis) > 0 {
+			return fmt.Errorf("%s is now attached to %s", finding.ID, enis[0].NetworkInterfaceID)
+		}
+		refs, err := h.groups.FindReferencingGroups(ctx, finding.ID)
+		if err != nil {
+			return err
+		}
+		if len(refs) > 0 {
+			return fmt.Errorf("%s is referenced by %s", finding.ID, strings.Join(refs, ", "))
+		}
+		return h.groups.DeleteSecurityGroup(ctx, finding.ID)
+
+	case CleanupRole:
+		role, err := h.roles.GetRole(ctx, finding.ID)
+		if err != nil {
+			return err
+		}
+		if role.LastUsed.After(finding.LastUsed) {
+			return fmt.Errorf("role %s was used on %s", finding.ID, role.LastUsed.Format("2006-01-02"))
+		}
+		return h.roles.DeleteRole(ctx, finding.ID)
+	}
+	return ErrNotSupported
+}
+
+// CleanupResource implements Resource for a finding of the cleanup report
+type CleanupResource struct {
+	finding *CleanupFinding
+	region  string
+}
+
+func (r *CleanupResource) GetID() string   { return r.finding.ID }
+func (r *CleanupResource) GetARN() string  { return r.finding.ARN }
+func (r *CleanupResource) GetName() string { return r.finding.Name }
+func (r *CleanupResource) GetType() string { return "cleanup:unused" }
+
+func (r *CleanupResource) GetRegion() string {
+	if r.finding.Kind == CleanupRole {
+		return "global"
+	}
+	return r.region
+}
+
+func (r *CleanupResource) GetCreatedAt() time.Time    { return r.finding.CreatedAt }
+func (r *CleanupResource) GetTags() map[string]string { return nil }
+
+func (r *CleanupResource) ToTableRow() []string {
+	deps := "-"
+	if len(r.finding.Blockers) > 0 {
+		deps = strings.Join(r.finding.Blockers, "; ")
+	} else if r.finding.Policies > 0 {
+		deps = fmt.Sprintf("%d policies, removed with it", r.finding.Policies)
+	}
+	id := r.finding.ID
+	if r.finding.Kind == CleanupRole {
+		id = "-"
+	}
+	return []string{
+		r.finding.Name,
+		r.finding.Kind,
+		id,
+		r.finding.Reason,
+		deps,
+	}
+}
+
+func (r *CleanupResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"Type":   r.finding.Kind,
+		"Name":   r.finding.Name,
+		"ARN":    r.finding.ARN,
+		"Unused": r.finding.Reason,
+	}
+	if r.finding.Description != "" {
+		details["Description"] = r.finding.Description
+	}
+	switch r.finding.Kind {
+	case CleanupSecurityGroup:
+		details["GroupId"] = r.finding.ID
+		details["VpcId"] = r.finding.VpcID
+	case CleanupRole:
+		details["CreateDate"] = r.finding.CreatedAt.Format(time.RFC3339)
+		if !r.finding.LastUsed.IsZero() {
+			details["LastUsedDate"] = r.finding.LastUsed.Format(time.RFC3339)
+		}
+		details["Policies"] = r.finding.Policies
+	}
+	return details
+}
@@ -0,0 +1,586 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+
+	cfnadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/cloudformation"
+)
+
+// stackInstanceCountConcurrency bounds the parallel ListStackInstances calls made to
+// count instances per stack set
+const stackInstanceCountConcurrency = 5
+
+// NavigateToStackInstancesAction is returned by ExecuteAction to trigger navigation to
+// the instances of a stack set
+type NavigateToStackInstancesAction struct {
+	StackSetName string
+	CallAs       string
+}
+
+func (a *NavigateToStackInstancesAction) Error() string {
+	return fmt.Sprintf("navigate to stack instances for %s", a.StackSetName)
+}
+
+func (a *NavigateToStackInstancesAction) IsActionMsg() {}
+
+// stackInstanceFailed returns true if the last operation on an instance did not succeed
+func stackInstanceFailed(inst cfnadapter.StackInstance) bool {
+	switch inst.DetailedStatus {
+	case "FAILED", "FAILED_IMPORT", "CANCELLED", "INOPERABLE":
+		return true
+	}
+	return inst.Status == "INOPERABLE"
+}
+
+// stackInstanceDrifted returns true if the last drift detection found the instance drifted
+func stackInstanceDrifted(inst cfnadapter.StackInstance) bool {
+	return inst.DriftStatus == "DRIFTED"
+}
+
+// CloudFormationStackSetsHandler handles CloudFormation stack set resources
+type CloudFormationStackSetsHandler struct {
+	BaseHandler
+	client *cfnadapter.StackSetsClient
+	region string
+
+	// Identity each stack set was listed as, by name
+	callAs map[string]string
+}
+
+// NewCloudFormationStackSetsHandler creates a new stack sets handler
+func NewCloudFormationStackSetsHandler(cfnClient *cloudformation.Client, region string) *CloudFormationStackSetsHandler {
+	return &CloudFormationStackSetsHandler{
+		client: cfnadapter.NewStackSetsClient(cfnClient),
+		region: region,
+		callAs: make(map[string]string),
+	}
+}
+
+func (h *CloudFormationStackSetsHandler) ResourceType() string { return "cloudformation:stacksets" }
+func (h *CloudFormationStackSetsHandler) ResourceName() string { return "StackSets" }
+func (h *CloudFormationStackSetsHandler) ResourceIcon() string { return "🧱" }
+func (h *CloudFormationStackSetsHandler) ShortcutKey() string  { return "stacksets" }
+
+func (h *CloudFormationStackSetsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 40, Sortable: true},
+		{Title: "Permission Model", Width: 16, Sortable: true},
+		{Title: "Instances", Width: 10, Sortable: true, SortType: SortNumber},
+		{Title: "Failed", Width: 8, Sortable: true},
+		{Title: "Drifted", Width: 8, Sortable: true},
+		{Title: "Drift Status", Width: 14, Sortable: true},
+		{Title: "Last Drift Check", Width: 18, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *CloudFormationStackSetsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	stackSets, err := h.client.ListStackSets(ctx, cfnadapter.CallAsSelf)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list stack sets", err)
+	}
+
+	// Service-managed stack sets are only listed for a delegated administrator when asked
+	// as one. Accounts that aren't delegated administrators get an error, which is ignored.
+	seen := make(map[string]bool, len(stackSets))
+	for _, ss := range stackSets {
+		seen[ss.StackSetID] = true
+	}
+	if delegated, err := h.client.ListStackSets(ctx, cfnadapter.CallAsDelegatedAdmin); err == nil {
+		for _, ss := range delegated {
+			if !seen[ss.StackSetID] {
+				stackSets = append(stackSets, ss)
+			}
+		}
+	}
+
+	resources := make([]*StackSetResource, 0, len(stackSets))
+	for _, ss := range stackSets {
+		h.callAs[ss.StackSetName] = ss.CallAs
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(ss.StackSetName), filter) &&
+				!strings.Contains(strings.ToLower(ss.PermissionModel), filter) &&
+				!strings.Contains(strings.ToLower(ss.DriftStatus), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &StackSetResource{
+			stackSet: ss,
+			region:   h.region,
+		})
+	}
+
+	h.countInstances(ctx, resources)
+
+	result := make([]Resource, 0, len(resources))
+	for _, res := range resources {
+		result = append(result, res)
+	}
+
+	return &ListResult{
+		Resources: result,
+		NextToken: "",
+	}, nil
+}
+
+// countInstances fills in the instance, failed and drifted counts of each stack set. A stack
+// set whose instances can't be listed is left without counts rather than failing the list.
+func (h *CloudFormationStackSetsHandler) countInstances(ctx context.Context, resources []*StackSetResource) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, stackInstanceCountConcurrency)
+
+	for _, res := range resources {
+		wg.Add(1)
+		go func(res *StackSetResource) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			instances, err := h.client.ListStackInstances(ctx, res.stackSet.StackSetName, res.stackSet.CallAs)
+			if err != nil {
+				return
+			}
+
+			res.counted = true
+			res.instances = len(instances)
+			for _, inst := range instances {
+				if stackInstanceFailed(inst) {
+					res.failed++
+				}
+				if stackInstanceDrifted(inst) {
+					res.drifted++
+				}
+			}
+		}(res)
+	}
+
+	wg.Wait()
+}
+
+// callAsFor returns the identity a stack set was listed as, defaulting to SELF
+func (h *CloudFormationStackSetsHandler) callAsFor(name string) string {
+	if callAs, ok := h.callAs[name]; ok {
+		return callAs
+	}
+	return cfnadapter.CallAsSelf
+}
+
+func (h *CloudFormationStackSetsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	ss, err := h.client.DescribeStackSet(ctx, id, h.callAsFor(id))
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get stack set %s", id), err)
+	}
+
+	return &StackSetResource{
+		stackSet: ss.StackSetSummary,
+		details:  ss,
+		region:   h.region,
+	}, nil
+}
+
+func (h *CloudFormationStackSetsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	callAs := h.callAsFor(id)
+	ss, err := h.client.DescribeStackSet(ctx, id, callAs)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe stack set %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	// Basic info
+	details["StackSet"] = map[string]interface{}{
+		"StackSetName":    ss.StackSetName,
+		"StackSetId":      ss.StackSetID,
+		"StackSetARN":     ss.StackSetARN,
+		"Description":     ss.Description,
+		"Status":          ss.Status,
+		"PermissionModel": ss.PermissionModel,
+		"CallAs":          callAs,
+	}
+
+	// Deployment targets
+	deployment := map[string]interface{}{
+		"Regions":        ss.Regions,
+		"AutoDeployment": ss.AutoDeployment,
+	}
+	if len(ss.OrganizationalUnitIDs) > 0 {
+		deployment["OrganizationalUnitIds"] = ss.OrganizationalUnitIDs
+	}
+	if ss.AdministrationRoleARN != "" {
+		deployment["AdministrationRoleARN"] = ss.AdministrationRoleARN
+	}
+	if ss.ExecutionRoleName != "" {
+		deployment["ExecutionRoleName"] = ss.ExecutionRoleName
+	}
+	if len(ss.Capabilities) > 0 {
+		deployment["Capabilities"] = ss.Capabilities
+	}
+	details["Deployment"] = deployment
+
+	// Drift detection
+	if ss.Drift != nil {
+		drift := map[string]interface{}{
+			"DriftStatus":          ss.Drift.DriftStatus,
+			"DriftDetectionStatus": ss.Drift.DetectionStatus,
+			"TotalInstances":       ss.Drift.Total,
+			"InSync":               ss.Drift.InSync,
+			"Drifted":              ss.Drift.Drifted,
+			"Failed":               ss.Drift.Failed,
+			"InProgress":           ss.Drift.InProgress,
+		}
+		if !ss.Drift.LastCheck.IsZero() {
+			drift["LastDriftCheck"] = ss.Drift.LastCheck.Format(time.RFC3339)
+		}
+		details["Drift"] = drift
+	}
+
+	// Recent operations, where failures usually start
+	if operations, err := h.client.ListStackSetOperations(ctx, id, callAs, 5); err == nil && len(operations) > 0 {
+		ops := make([]map[string]interface{}, 0, len(operations))
+		for _, op := range operations {
+			o := map[string]interface{}{
+				"OperationId": op.OperationID,
+				"Action":      op.Action,
+				"Status":      op.Status,
+				"Created":     op.CreatedTime.Format(time.RFC3339),
+			}
+			if op.StatusReason != "" {
+				o["StatusReason"] = op.StatusReason
+			}
+			ops = append(ops, o)
+		}
+		details["RecentOperations"] = ops
+	}
+
+	// Parameters
+	if len(ss.Parameters) > 0 {
+		details["Parameters"] = ss.Parameters
+	}
+
+	// Tags
+	if len(ss.Tags) > 0 {
+		details["Tags"] = ss.Tags
+	}
+
+	return details, nil
+}
+
+func (h *CloudFormationStackSetsHandler) Actions() []Action {
+	return []Action{
+		{Key: "i", Name: "instances", Description: "instances"},
+	}
+}
+
+func (h *CloudFormationStackSetsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "instances" {
+		return ErrNotSupported
+	}
+
+	return &NavigateToStackInstancesAction{
+		StackSetName: resourceID,
+		CallAs:       h.callAsFor(resourceID),
+	}
+}
+
+// StackSetResource implements Resource interface for stack sets
+type StackSetResource struct {
+	stackSet cfnadapter.StackSetSummary
+	details  *cfnadapter.StackSet // Only set when fetched with Get
+	region   string
+
+	// Instance counts, only set when the instances could be listed
+	counted   bool
+	instances int
+	failed    int
+	drifted   int
+}
+
+func (r *StackSetResource) GetID() string     { return r.stackSet.StackSetName }
+func (r *StackSetResource) GetName() string   { return r.stackSet.StackSetName }
+func (r *StackSetResource) GetType() string   { return "cloudformation:stacksets" }
+func (r *StackSetResource) GetRegion() string { return r.region }
+
+func (r *StackSetResource) GetARN() string {
+	if r.details != nil {
+		return r.details.StackSetARN
+	}
+	return r.stackSet.StackSetID
+}
+
+func (r *StackSetResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *StackSetResource) GetTags() map[string]string {
+	if r.details != nil {
+		return r.details.Tags
+	}
+	return nil
+}
+
+func (r *StackSetResource) ToTableRow() []string {
+	instances, failed, drifted := "-", "-", "-"
+	if r.counted {
+		instances = fmt.Sprintf("%d", r.instances)
+		failed = fmt.Sprintf("%d", r.failed)
+		drifted = fmt.Sprintf("%d", r.drifted)
+	}
+
+	lastCheck := "-"
+	if !r.stackSet.LastDriftCheck.IsZero() {
+		lastCheck = r.stackSet.LastDriftCheck.Format("2006-01-02 15:04")
+	}
+
+	return []string{
+		r.stackSet.StackSetName,
+		r.stackSet.PermissionModel,
+		instances,
+		failed,
+		drifted,
+		r.stackSet.DriftStatus,
+		lastCheck,
+	}
+}
+
+func (r *StackSetResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"StackSetName":    r.stackSet.StackSetName,
+		"StackSetId":      r.stackSet.StackSetID,
+		"Status":          r.stackSet.Status,
+		"PermissionModel": r.stackSet.PermissionModel,
+		"DriftStatus":     r.stackSet.DriftStatus,
+	}
+}
+
+// CloudFormationStackInstancesHandler handles the instances of a stack set
+type CloudFormationStackInstancesHandler struct {
+	BaseHandler
+	client       *cfnadapter.StackSetsClient
+	region       string
+	stackSetName string
+	callAs       string
+}
+
+// NewCloudFormationStackInstancesHandler creates a new stack instances handler for a stack set
+func NewCloudFormationStackInstancesHandler(cfnClient *cloudformation.Client, region, stackSetName, callAs string) *CloudFormationStackInstancesHandler {
+	return &CloudFormationStackInstancesHandler{
+		client:       cfnadapter.NewStackSetsClient(cfnClient),
+		region:       region,
+		stackSetName: stackSetName,
+		callAs:       callAs,
+	}
+}
+
+func (h *CloudFormationStackInstancesHandler) ResourceType() string {
+	return "cloudformation:stackinstances"
+}
+func (h *CloudFormationStackInstancesHandler) ResourceName() string { return "Stack Instances" }
+func (h *CloudFormationStackInstancesHandler) ResourceIcon() string { return "🧱" }
+func (h *CloudFormationStackInstancesHandler) ShortcutKey() string  { return "stackinstances" }
+
+func (h *CloudFormationStackInstancesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Account", Width: 14, Sortable: true},
+		{Title: "Region", Width: 16, Sortable: true},
+		{Title: "Status", Width: 12, Sortable: true},
+		{Title: "Detailed Status", Width: 16, Sortable: true},
+		{Title: "Drift", Width: 12, Sortable: true},
+		{Title: "Status Reason", Width: 60, Sortable: false},
+	}
+}
+
+func (h *CloudFormationStackInstancesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	instances, err := h.client.ListStackInstances(ctx, h.stackSetName, h.callAs)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list instances of %s", h.stackSetName), err)
+	}
+
+	// Failed, then drifted instances first so they don't get lost among hundreds of healthy ones
+	rank := func(inst cfnadapter.StackInstance) int {
+		switch {
+		case stackInstanceFailed(inst):
+			return 0
+		case stackInstanceDrifted(inst):
+			return 1
+		case inst.Status != "CURRENT":
+			return 2
+		}
+		return 3
+	}
+	sort.SliceStable(instances, func(i, j int) bool {
+		if ri, rj := rank(instances[i]), rank(instances[j]); ri != rj {
+			return ri < rj
+		}
+		if instances[i].Account != instances[j].Account {
+			return instances[i].Account < instances[j].Account
+		}
+		return instances[i].Region < instances[j].Region
+	})
+
+	resources := make([]Resource, 0, len(instances))
+	for _, inst := range instances {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(inst.Account), filter) &&
+				!strings.Contains(strings.ToLower(inst.Region), filter) &&
+				!strings.Contains(strings.ToLower(inst.Status), filter) &&
+				!strings.Contains(strings.ToLower(inst.DetailedStatus), filter) &&
+				!strings.Contains(strings.ToLower(inst.DriftStatus), filter) &&
+				!strings.Contains(strings.ToLower(inst.StatusReason), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &StackInstanceResource{
+			instance:     inst,
+			stackSetName: h.stackSetName,
+			region:       h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+// parseStackInstanceID splits a stack instance ID of the form "account/region"
+func parseStackInstanceID(id string) (account, region string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid stack instance ID: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (h *CloudFormationStackInstancesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	account, region, err := parseStackInstanceID(id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", err.Error(), err)
+	}
+
+	inst, err := h.client.DescribeStackInstance(ctx, h.stackSetName, account, region, h.callAs)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get stack instance %s", id), err)
+	}
+
+	return &StackInstanceResource{
+		instance:     *inst,
+		stackSetName: h.stackSetName,
+		region:       h.region,
+	}, nil
+}
+
+func (h *CloudFormationStackInstancesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	account, region, err := parseStackInstanceID(id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", err.Error(), err)
+	}
+
+	inst, err := h.client.DescribeStackInstance(ctx, h.stackSetName, account, region, h.callAs)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe stack instance %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	// Basic info
+	instance := map[string]interface{}{
+		"StackSetName":   h.stackSetName,
+		"Account":        inst.Account,
+		"Region":         inst.Region,
+		"Status":         inst.Status,
+		"DetailedStatus": inst.DetailedStatus,
+		"StackId":        inst.StackID,
+	}
+	if inst.StatusReason != "" {
+		instance["StatusReason"] = inst.StatusReason
+	}
+	if inst.OrganizationalUnitID != "" {
+		instance["OrganizationalUnitId"] = inst.OrganizationalUnitID
+	}
+	if inst.LastOperationID != "" {
+		instance["LastOperationId"] = inst.LastOperationID
+	}
+	details["StackInstance"] = instance
+
+	// Drift
+	drift := map[string]interface{}{
+		"DriftStatus": inst.DriftStatus,
+	}
+	if !inst.LastDriftCheck.IsZero() {
+		drift["LastDriftCheck"] = inst.LastDriftCheck.Format(time.RFC3339)
+	}
+	details["Drift"] = drift
+
+	// Parameter overrides
+	if len(inst.Parameters) > 0 {
+		details["ParameterOverrides"] = inst.Parameters
+	}
+
+	return details, nil
+}
+
+// StackInstanceResource implements Resource interface for stack set instances
+type StackInstanceResource struct {
+	instance     cfnadapter.StackInstance
+	stackSetName string
+	region       string
+}
+
+func (r *StackInstanceResource) GetID() string {
+	return fmt.Sprintf("%s/%s", r.instance.Account, r.instance.Region)
+}
+func (r *StackInstanceResource) GetName() string {
+	return fmt.Sprintf("%s %s/%s", r.stackSetName, r.instance.Account, r.instance.Region)
+}
+func (r *StackInstanceResource) GetARN() string    { return r.instance.StackID }
+func (r *StackInstanceResource) GetType() string   { return "cloudformation:stackinstances" }
+func (r *StackInstanceResource) GetRegion() string { return r.instance.Region }
+
+func (r *StackInstanceResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *StackInstanceResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *StackInstanceResource) ToTableRow() []string {
+	reason := r.instance.StatusReason
+	if reason == "" {
+		reason = "-"
+	}
+
+	return []string{
+		r.instance.Account,
+		r.instance.Region,
+		r.instance.Status,
+		r.instance.DetailedStatus,
+		r.instance.DriftStatus,
+		reason,
+	}
+}
+
+func (r *StackInstanceResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"StackSetName":   r.stackSetName,
+		"Account":        r.instance.Account,
+		"Region":         r.instance.Region,
+		"Status":         r.instance.Status,
+		"DetailedStatus": r.instance.DetailedStatus,
+		"DriftStatus":    r.instance.DriftStatus,
+		"StatusReason":   r.instance.StatusReason,
+	}
+}
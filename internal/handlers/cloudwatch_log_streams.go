@@ -11,12 +11,15 @@ import (
 	logsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/logs"
 )
 
+// logStreamsPageSize is how many log streams a page streams in, DescribeLogStreams' maximum
+const logStreamsPageSize = 50
+
 // CloudWatchLogStreamsHandler handles CloudWatch log stream resources for a specific log group
 type CloudWatchLogStreamsHandler struct {
 	BaseHandler
 	client       *logsadapter.LogsClient
 	region       string
-	logGroupName string
+	logGroupName string // Name, or ARN for a log group in a linked source account
 }
 
 // NewCloudWatchLogStreamsHandlerForGroup creates a new log streams handler for a specific log group
@@ -36,14 +39,28 @@ func (h *CloudWatchLogStreamsHandler) ShortcutKey() string  { return "log-stream
 func (h *CloudWatchLogStreamsHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Stream Name", Width: 45, Sortable: true},
-		{Title: "Last Event", Width: 20, Sortable: true},
-		{Title: "Stored (KB)", Width: 12, Sortable: true},
-		{Title: "Created", Width: 19, Sortable: true},
+		{Title: "Last Event", Width: 20, Sortable: true, SortType: SortDate},
+		{Title: "Stored (KB)", Width: 12, Sortable: true, SortType: SortNumber},
+		{Title: "Created", Width: 19, Sortable: true, SortType: SortDate},
 	}
 }
 
+// IncrementalPageSize streams the log streams into the list a page at a time, since busy
+// groups collect tens of thousands of them
+func (h *CloudWatchLogStreamsHandler) IncrementalPageSize() int { return logStreamsPageSize }
+
 func (h *CloudWatchLogStreamsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
-	logStreams, err := h.client.ListLogStreams(ctx, h.logGroupName)
+	var (
+		logStreams []logsadapter.LogStream
+		nextToken  string
+		truncated  bool
+		err        error
+	)
+	if opts.PageSize > 0 {
+		logStreams, nextToken, err = h.client.ListLogStreamsPage(ctx, h.logGroupName, opts.NextToken, opts.PageSize)
+	} else {
+		logStreams, truncated, err = h.client.ListLogStreams(ctx, h.logGroupName, opts.MaxItems)
+	}
 	if err != nil {
 		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list log streams for group %s", h.logGroupName), err)
 	}
@@ -71,12 +88,13 @@ func (h *CloudWatchLogStreamsHandler) List(ctx context.Context, opts ListOptions
 
 	return &ListResult{
 		Resources: resources,
-		NextToken: "",
+		NextToken: nextToken,
+		Truncated: truncated,
 	}, nil
 }
 
 func (h *CloudWatchLogStreamsHandler) Get(ctx context.Context, id string) (Resource, error) {
-	logStreams, err := h.client.ListLogStreams(ctx, h.logGroupName)
+	logStreams, _, err := h.client.ListLogStreams(ctx, h.logGroupName, 0)
 	if err != nil {
 		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get log stream %s", id), err)
 	}
@@ -14,6 +14,7 @@ import (
 // NavigateToLogStreamsAction is returned by ExecuteAction to trigger navigation to log streams
 type NavigateToLogStreamsAction struct {
 	LogGroupName string
+	LogGroupARN  string // Set for a log group in a linked source account, which is read by ARN
 }
 
 func (a *NavigateToLogStreamsAction) Error() string {
@@ -22,11 +23,16 @@ func (a *NavigateToLogStreamsAction) Error() string {
 
 func (a *NavigateToLogStreamsAction) IsActionMsg() {}
 
+// MaxTailedLogGroups is how many whole log groups are tailed together at most. Every group
+// is polled with FilterLogEvents, whose rate limit the account shares.
+const MaxTailedLogGroups = 10
+
 // CloudWatchLogsHandler handles CloudWatch log group resources
 type CloudWatchLogsHandler struct {
 	BaseHandler
-	client *logsadapter.LogsClient
-	region string
+	client    *logsadapter.LogsClient
+	region    string
+	accountID string // Linked source account to list log groups of, empty for this account
 }
 
 // NewCloudWatchLogsHandler creates a new CloudWatch Logs handler
@@ -37,6 +43,16 @@ func NewCloudWatchLogsHandler(logsClient *cloudwatchlogs.Client, region string)
 	}
 }
 
+// NewCloudWatchLogsHandlerForAccount creates a CloudWatch Logs handler listing the log groups
+// of a source account linked to this monitoring account. Its resource IDs are log group ARNs.
+func NewCloudWatchLogsHandlerForAccount(logsClient *cloudwatchlogs.Client, region, accountID string) *CloudWatchLogsHandler {
+	return &CloudWatchLogsHandler{
+		client:    logsadapter.NewLogsClient(logsClient),
+		region:    region,
+		accountID: accountID,
+	}
+}
+
 func (h *CloudWatchLogsHandler) ResourceType() string { return "logs:loggroups" }
 func (h *CloudWatchLogsHandler) ResourceName() string { return "Log Groups" }
 func (h *CloudWatchLogsHandler) ResourceIcon() string { return "📋" }
@@ -45,14 +61,28 @@ func (h *CloudWatchLogsHandler) ShortcutKey() string  { return "logs" }
 func (h *CloudWatchLogsHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Log Group Name", Width: 50, Sortable: true},
-		{Title: "Retention (days)", Width: 15, Sortable: true},
-		{Title: "Stored (MB)", Width: 12, Sortable: true},
-		{Title: "Created", Width: 19, Sortable: true},
+		{Title: "Retention (days)", Width: 15, Sortable: true, SortType: SortNumber},
+		{Title: "Stored (MB)", Width: 12, Sortable: true, SortType: SortNumber},
+		{Title: "Created", Width: 19, Sortable: true, SortType: SortDate},
 	}
 }
 
+// ServerFilterHint describes how a search matches log groups on the server
+func (h *CloudWatchLogsHandler) ServerFilterHint() string {
+	return "name, case-sensitive"
+}
+
 func (h *CloudWatchLogsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
-	logGroups, err := h.client.ListLogGroups(ctx)
+	var logGroups []logsadapter.LogGroup
+	var truncated bool
+	var err error
+	if opts.ServerFilter != "" {
+		logGroups, truncated, err = h.client.SearchLogGroups(ctx, h.accountID, opts.ServerFilter, opts.MaxItems)
+	} else if h.accountID != "" {
+		logGroups, truncated, err = h.client.ListLogGroupsInAccount(ctx, h.accountID, opts.MaxItems)
+	} else {
+		logGroups, truncated, err = h.client.ListLogGroups(ctx, opts.MaxItems)
+	}
 	if err != nil {
 		return nil, NewHandlerError("LIST_FAILED", "failed to list log groups", err)
 	}
@@ -62,6 +92,7 @@ func (h *CloudWatchLogsHandler) List(ctx context.Context, opts ListOptions) (*Li
 		resource := &LogGroupResource{
 			logGroup: lg,
 			region:   h.region,
+			byARN:    h.accountID != "",
 		}
 
 		// Apply filter if specified
@@ -79,6 +110,7 @@ func (h *CloudWatchLogsHandler) List(ctx context.Context, opts ListOptions) (*Li
 	return &ListResult{
 		Resources: resources,
 		NextToken: "",
+		Truncated: truncated,
 	}, nil
 }
 
@@ -91,6 +123,7 @@ func (h *CloudWatchLogsHandler) Get(ctx context.Context, id string) (Resource, e
 	return &LogGroupResource{
 		logGroup: *lg,
 		region:   h.region,
+		byARN:    h.accountID != "",
 	}, nil
 }
 
@@ -133,7 +166,37 @@ func (h *CloudWatchLogsHandler) Describe(ctx context.Context, id string) (map[st
 func (h *CloudWatchLogsHandler) Actions() []Action {
 	return []Action{
 		{Key: "s", Name: "streams", Description: "View log streams"},
+		{Key: "t", Name: "tail", Description: "Tail marked groups", Batch: true},
+	}
+}
+
+// ExecuteBatchAction tails every stream of the marked log groups together
+func (h *CloudWatchLogsHandler) ExecuteBatchAction(ctx context.Context, action string, resourceIDs []string) error {
+	if action != "tail" {
+		return ErrNotSupported
+	}
+	if len(resourceIDs) > MaxTailedLogGroups {
+		return fmt.Errorf("%d log groups are marked, at most %d can be tailed together", len(resourceIDs), MaxTailedLogGroups)
+	}
+	return TailLogGroupsAction(resourceIDs, h.region)
+}
+
+// TailLogGroupsAction follows every stream of the log groups, given by name or by ARN
+func TailLogGroupsAction(groups []string, region string) *TailLogsAction {
+	targets := make([]LogTailTarget, 0, len(groups))
+	for _, group := range groups {
+		targets = append(targets, LogTailTarget{
+			Label:  logsadapter.LogGroupNameFromARN(group),
+			Group:  group,
+			Region: region,
+		})
+	}
+
+	title := targets[0].Label
+	if len(targets) > 1 {
+		title = fmt.Sprintf("%d log groups", len(targets))
 	}
+	return &TailLogsAction{Title: title, Targets: targets}
 }
 
 func (h *CloudWatchLogsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
@@ -141,18 +204,58 @@ func (h *CloudWatchLogsHandler) ExecuteAction(ctx context.Context, action string
 		return ErrNotSupported
 	}
 
+	if h.accountID != "" {
+		return &NavigateToLogStreamsAction{
+			LogGroupName: logsadapter.LogGroupNameFromARN(resourceID),
+			LogGroupARN:  resourceID,
+		}
+	}
 	return &NavigateToLogStreamsAction{
 		LogGroupName: resourceID,
 	}
 }
 
+func (h *CloudWatchLogsHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	arn, err := h.taggingARN(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return h.client.LogGroupTags(ctx, arn)
+}
+
+func (h *CloudWatchLogsHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	arn, err := h.taggingARN(ctx, id)
+	if err != nil {
+		return err
+	}
+	return h.client.UpdateLogGroupTags(ctx, arn, set, remove)
+}
+
+// taggingARN returns the ARN the tag APIs take for a log group, without the trailing :*
+func (h *CloudWatchLogsHandler) taggingARN(ctx context.Context, id string) (string, error) {
+	if h.accountID != "" {
+		return "", fmt.Errorf("log groups of linked source accounts can only be tagged from their own account")
+	}
+	lg, err := h.client.GetLogGroup(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return lg.IdentifierArn, nil
+}
+
 // LogGroupResource implements Resource interface for log groups
 type LogGroupResource struct {
 	logGroup logsadapter.LogGroup
 	region   string
+	byARN    bool // Identified by ARN, as log groups of linked source accounts are
 }
 
-func (r *LogGroupResource) GetID() string   { return r.logGroup.Name }
+func (r *LogGroupResource) GetID() string {
+	if r.byARN {
+		return r.logGroup.IdentifierArn
+	}
+	return r.logGroup.Name
+}
 func (r *LogGroupResource) GetName() string { return r.logGroup.Name }
 func (r *LogGroupResource) GetARN() string  { return r.logGroup.Arn }
 func (r *LogGroupResource) GetType() string { return "logs:loggroups" }
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/oam"
+
+	oamadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/oam"
+)
+
+// NavigateToSourceLogGroupsAction triggers navigation to the log groups of a linked source account
+type NavigateToSourceLogGroupsAction struct {
+	AccountID string
+	Label     string
+}
+
+func (a *NavigateToSourceLogGroupsAction) Error() string {
+	return fmt.Sprintf("navigate to log groups of %s", a.AccountID)
+}
+
+func (a *NavigateToSourceLogGroupsAction) IsActionMsg() {}
+
+// CloudWatchSourcesHandler lists the source accounts linked to this monitoring account
+// through CloudWatch cross-account observability
+type CloudWatchSourcesHandler struct {
+	BaseHandler
+	client *oamadapter.LinksClient
+	region string
+}
+
+// NewCloudWatchSourcesHandler creates a new linked source accounts handler
+func NewCloudWatchSourcesHandler(oamClient *oam.Client, region string) *CloudWatchSourcesHandler {
+	return &CloudWatchSourcesHandler{
+		client: oamadapter.NewLinksClient(oamClient),
+		region: region,
+	}
+}
+
+func (h *CloudWatchSourcesHandler) ResourceType() string { return "oam:links" }
+func (h *CloudWatchSourcesHandler) ResourceName() string { return "Source Accounts" }
+func (h *CloudWatchSourcesHandler) ResourceIcon() string { return "🔗" }
+func (h *CloudWatchSourcesHandler) ShortcutKey() string  { return "sources" }
+
+func (h *CloudWatchSourcesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Account ID", Width: 14, Sortable: true},
+		{Title: "Label", Width: 36, Sortable: true},
+		{Title: "Shared Data", Width: 50, Sortable: false},
+		{Title: "Sink", Width: 24, Sortable: true},
+	}
+}
+
+func (h *CloudWatchSourcesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	accounts, err := h.client.ListSourceAccounts(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list linked source accounts", err)
+	}
+
+	resources := make([]Resource, 0, len(accounts))
+	for _, account := range accounts {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			label := strings.ToLower(account.Label)
+			if !strings.Contains(account.AccountID, filter) && !strings.Contains(label, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &SourceAccountResource{
+			account: account,
+			region:  h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *CloudWatchSourcesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	accounts, err := h.client.ListSourceAccounts(ctx)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get source account %s", id), err)
+	}
+
+	for _, account := range accounts {
+		if account.AccountID == id {
+			return &SourceAccountResource{account: account, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("source account %s is not linked", id), nil)
+}
+
+func (h *CloudWatchSourcesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe source account %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *CloudWatchSourcesHandler) Actions() []Action {
+	return []Action{
+		{Key: "l", Name: "logs", Description: "View the account's log groups"},
+	}
+}
+
+func (h *CloudWatchSourcesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "logs" {
+		return ErrNotSupported
+	}
+
+	res, err := h.Get(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	account := res.(*SourceAccountResource).account
+	if !account.Shares("AWS::Logs::LogGroup") {
+		return fmt.Errorf("%s doesn't share log groups with this account", resourceID)
+	}
+
+	return &NavigateToSourceLogGroupsAction{
+		AccountID: account.AccountID,
+		Label:     account.Label,
+	}
+}
+
+// SourceAccountResource implements Resource interface for linked source accounts
+type SourceAccountResource struct {
+	account oamadapter.SourceAccount
+	region  string
+}
+
+func (r *SourceAccountResource) GetID() string              { return r.account.AccountID }
+func (r *SourceAccountResource) GetName() string            { return r.account.Label }
+func (r *SourceAccountResource) GetARN() string             { return r.account.LinkARN }
+func (r *SourceAccountResource) GetType() string            { return "oam:links" }
+func (r *SourceAccountResource) GetRegion() string          { return r.region }
+func (r *SourceAccountResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *SourceAccountResource) GetTags() map[string]string { return nil }
+
+func (r *SourceAccountResource) ToTableRow() []string {
+	shared := make([]string, 0, len(r.account.ResourceTypes))
+	for _, resourceType := range r.account.ResourceTypes {
+		shared = append(shared, strings.TrimPrefix(resourceType, "AWS::"))
+	}
+
+	return []string{
+		r.account.AccountID,
+		orDash(r.account.Label),
+		strings.Join(shared, ", "),
+		r.account.SinkName,
+	}
+}
+
+func (r *SourceAccountResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"AccountId":     r.account.AccountID,
+		"Label":         r.account.Label,
+		"LinkArn":       r.account.LinkARN,
+		"Sink":          r.account.SinkName,
+		"ResourceTypes": r.account.ResourceTypes,
+	}
+}
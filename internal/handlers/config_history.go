@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	configadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/configservice"
+	"github.com/aaw-tui/aws-tui/internal/utils"
+)
+
+// configHistoryMaxChanges caps the changes listed per snapshot, a replaced launch template
+// or policy document can otherwise fill the pane
+const configHistoryMaxChanges = 40
+
+// configHistoryValueWidth is how much of a changed value is shown
+const configHistoryValueWidth = 80
+
+// configResourceTypes maps resource types to the AWS Config types they are recorded as
+var configResourceTypes = map[string][]string{
+	"ec2:instances":            {"AWS::EC2::Instance"},
+	"ec2:security-groups":      {"AWS::EC2::SecurityGroup"},
+	"ec2:vpcs":                 {"AWS::EC2::VPC"},
+	"ec2:subnets":              {"AWS::EC2::Subnet"},
+	"ec2:route-tables":         {"AWS::EC2::RouteTable"},
+	"ec2:internet-gateways":    {"AWS::EC2::InternetGateway"},
+	"ec2:nat-gateways":         {"AWS::EC2::NatGateway"},
+	"ec2:network-interfaces":   {"AWS::EC2::NetworkInterface"},
+	"ec2:launch-templates":     {"AWS::EC2::LaunchTemplate"},
+	"iam:users":                {"AWS::IAM::User"},
+	"iam:roles":                {"AWS::IAM::Role"},
+	"iam:groups":               {"AWS::IAM::Group"},
+	"iam:policies":             {"AWS::IAM::Policy"},
+	"rds:instances":            {"AWS::RDS::DBInstance"},
+	"rds:snapshots":            {"AWS::RDS::DBSnapshot"},
+	"s3:buckets":               {"AWS::S3::Bucket"},
+	"lambda:functions":         {"AWS::Lambda::Function"},
+	"dynamodb:tables":          {"AWS::DynamoDB::Table"},
+	"kms:keys":                 {"AWS::KMS::Key"},
+	"secretsmanager:secrets":   {"AWS::SecretsManager::Secret"},
+	"autoscaling:groups":       {"AWS::AutoScaling::AutoScalingGroup"},
+	"elb:loadbalancers":        {"AWS::ElasticLoadBalancingV2::LoadBalancer"},
+	"elb:listeners":            {"AWS::ElasticLoadBalancingV2::Listener"},
+	"elb:targetgroups":         {"AWS::ElasticLoadBalancingV2::TargetGroup"},
+	"ecs:clusters":             {"AWS::ECS::Cluster"},
+	"ecs:services":             {"AWS::ECS::Service"},
+	"ecs:taskdefinitions":      {"AWS::ECS::TaskDefinition"},
+	"mq:brokers":               {"AWS::AmazonMQ::Broker"},
+	"apigateway:apis":          {"AWS::ApiGateway::RestApi", "AWS::ApiGatewayV2::Api"},
+	"imagebuilder:pipelines":   {"AWS::ImageBuilder::ImagePipeline"},
+	"cloudformation:stacksets": {"AWS::CloudFormation::StackSet"},
+	"connect:instances":        {"AWS::Connect::Instance"},
+	"pinpoint:projects":        {"AWS::Pinpoint::App"},
+}
+
+// ConfigResourceTypes returns the AWS Config types a resource type is recorded as, or nil
+// when Config doesn't record it
+func ConfigResourceTypes(resourceType string) []string {
+	return configResourceTypes[resourceType]
+}
+
+// ConfigTimeline lays a resource's configuration history out for the detail pane: a
+// summary and the snapshots newest first, each with the changes from the one before it
+func ConfigTimeline(resource *configadapter.RecordedResource, items []configadapter.ConfigurationItem) map[string]interface{} {
+	summary := map[string]interface{}{
+		"ResourceType": resource.Type,
+		"ResourceId":   resource.ID,
+		"Snapshots":    len(items),
+	}
+	if resource.Name != "" && resource.Name != resource.ID {
+		summary["ResourceName"] = resource.Name
+	}
+	if resource.Deleted != nil {
+		summary["Deleted"] = resource.Deleted.Local().Format("2006-01-02 15:04:05")
+	}
+	if len(items) > 0 {
+		summary["Oldest"] = items[len(items)-1].CaptureTime.Local().Format("2006-01-02 15:04:05")
+	}
+
+	timeline := make([]map[string]interface{}, 0, len(items))
+	for i, item := range items {
+		entry := map[string]interface{}{
+			"Captured": item.CaptureTime.Local().Format("2006-01-02 15:04:05"),
+			"Status":   item.Status,
+		}
+		if len(item.RelatedEvents) > 0 {
+			entry["CloudTrailEvents"] = item.RelatedEvents
+		}
+
+		switch {
+		case i == len(items)-1:
+			entry["Changes"] = "oldest snapshot shown, nothing to compare it with"
+		case item.Configuration == "" && item.Status != "OK":
+			entry["Changes"] = "no configuration recorded"
+		default:
+			changes := configChanges(items[i+1], item)
+			if len(changes) == 0 {
+				entry["Changes"] = "no configuration changes, only relationships"
+			} else {
+				entry["Changes"] = changes
+			}
+		}
+		timeline = append(timeline, entry)
+	}
+
+	return map[string]interface{}{
+		"ConfigHistory": summary,
+		"Timeline":      timeline,
+	}
+}
+
+// configChanges lists the paths that differ between two snapshots as "~ path: old → new",
+// "+ path: new" and "- path: old"
+func configChanges(older, newer configadapter.ConfigurationItem) []string {
+	before := flattenConfigItem(older)
+	after := flattenConfigItem(newer)
+
+	paths := make([]string, 0, len(before)+len(after))
+	for path := range before {
+		paths = append(paths, path)
+	}
+	for path := range after {
+		if _, ok := before[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var changes []string
+	for _, path := range paths {
+		old, hadOld := before[path]
+		value, hasNew := after[path]
+		switch {
+		case hadOld && hasNew && old != value:
+			changes = append(changes, fmt.Sprintf("~ %s: %s → %s", path, truncateConfigValue(old), truncateConfigValue(value)))
+		case !hadOld:
+			changes = append(changes, fmt.Sprintf("+ %s: %s", path, truncateConfigValue(value)))
+		case !hasNew:
+			changes = append(changes, fmt.Sprintf("- %s: %s", path, truncateConfigValue(old)))
+		}
+	}
+
+	if len(changes) > configHistoryMaxChanges {
+		more := len(changes) - configHistoryMaxChanges
+		changes = append(changes[:configHistoryMaxChanges], fmt.Sprintf("... and %d more", more))
+	}
+	return changes
+}
+
+// flattenConfigItem turns a snapshot's configuration, supplementary configuration and tags
+// into leaf paths such as configuration.securityGroups[0].groupId
+func flattenConfigItem(item configadapter.ConfigurationItem) map[string]string {
+	flat := make(map[string]string)
+
+	var configuration interface{}
+	if item.Configuration != "" && json.Unmarshal([]byte(item.Configuration), &configuration) == nil {
+		flattenConfigValue(flat, "configuration", configuration)
+	}
+	for name, raw := range item.Supplementary {
+		var value interface{}
+		if json.Unmarshal([]byte(raw), &value) != nil {
+			value = raw
+		}
+		flattenConfigValue(flat, "supplementary."+name, value)
+	}
+	for key, value := range item.Tags {
+		flat["tags."+key] = value
+	}
+	return flat
+}
+
+func flattenConfigValue(flat map[string]string, path string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, member := range v {
+			flattenConfigValue(flat, path+"."+key, member)
+		}
+	case []interface{}:
+		for i, member := range v {
+			flattenConfigValue(flat, fmt.Sprintf("%s[%d]", path, i), member)
+		}
+	case string:
+		// Policy documents are recorded as URL-encoded or embedded JSON strings
+		trimmed := strings.TrimSpace(v)
+		if strings.HasPrefix(trimmed, "%7B") {
+			if decoded, err := url.QueryUnescape(trimmed); err == nil {
+				trimmed = decoded
+			}
+		}
+		var embedded interface{}
+		if strings.HasPrefix(trimmed, "{") && json.Unmarshal([]byte(trimmed), &embedded) == nil {
+			flattenConfigValue(flat, path, embedded)
+			return
+		}
+		flat[path] = v
+	case nil:
+		flat[path] = "null"
+	default:
+		encoded, _ := json.Marshal(v)
+		flat[path] = string(encoded)
+	}
+}
+
+func truncateConfigValue(value string) string {
+	if value == "" {
+		return `""`
+	}
+	return utils.Truncate(value, configHistoryValueWidth)
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+
+	connectadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/connect"
+)
+
+// ConnectInstancesHandler lists Amazon Connect instances, read-only
+type ConnectInstancesHandler struct {
+	BaseHandler
+	client *connectadapter.InstancesClient
+	region string
+}
+
+// NewConnectInstancesHandler creates a new Amazon Connect instances handler
+func NewConnectInstancesHandler(connectClient *connect.Client, region string) *ConnectInstancesHandler {
+	return &ConnectInstancesHandler{
+		client: connectadapter.NewInstancesClient(connectClient),
+		region: region,
+	}
+}
+
+func (h *ConnectInstancesHandler) ResourceType() string { return "connect:instances" }
+func (h *ConnectInstancesHandler) ResourceName() string { return "Connect Instances" }
+func (h *ConnectInstancesHandler) ResourceIcon() string { return "☎" }
+func (h *ConnectInstancesHandler) ShortcutKey() string  { return "connect" }
+
+func (h *ConnectInstancesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Alias", Width: 30, Sortable: true},
+		{Title: "Status", Width: 16, Sortable: true},
+		{Title: "Calls", Width: 18, Sortable: true},
+		{Title: "Identity", Width: 18, Sortable: true},
+		{Title: "Instance ID", Width: 38, Sortable: true},
+		{Title: "Created", Width: 20, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *ConnectInstancesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	instances, err := h.client.ListInstances(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list Connect instances", err)
+	}
+
+	resources := make([]Resource, 0, len(instances))
+	for _, inst := range instances {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(inst.Alias), filter) &&
+				!strings.Contains(strings.ToLower(inst.ID), filter) &&
+				!strings.Contains(strings.ToLower(inst.Status), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &ConnectInstanceResource{
+			instance: inst,
+			region:   h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *ConnectInstancesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	inst, err := h.client.DescribeInstance(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get Connect instance %s", id), err)
+	}
+
+	return &ConnectInstanceResource{
+		instance: *inst,
+		region:   h.region,
+	}, nil
+}
+
+func (h *ConnectInstancesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	inst, err := h.client.DescribeInstance(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe Connect instance %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	info := map[string]interface{}{
+		"InstanceAlias":          inst.Alias,
+		"InstanceId":             inst.ID,
+		"InstanceArn":            inst.ARN,
+		"Status":                 inst.Status,
+		"IdentityManagementType": inst.IdentityManagementType,
+		"AccessUrl":              inst.AccessURL,
+		"ServiceRole":            inst.ServiceRole,
+		"Created":                inst.CreatedTime.Format(time.RFC3339),
+	}
+	if inst.StatusReason != "" {
+		info["StatusReason"] = inst.StatusReason
+	}
+	details["Instance"] = info
+
+	details["Telephony"] = map[string]interface{}{
+		"InboundCallsEnabled":  inst.InboundCallsEnabled,
+		"OutboundCallsEnabled": inst.OutboundCallsEnabled,
+	}
+
+	// Features such as Contact Lens and contact flow logs. Reading them needs its own
+	// permission, so the details still show without it.
+	if attributes, err := h.client.ListInstanceAttributes(ctx, id); err == nil && len(attributes) > 0 {
+		details["Attributes"] = attributes
+	}
+
+	if len(inst.Tags) > 0 {
+		details["Tags"] = inst.Tags
+	}
+
+	return details, nil
+}
+
+// connectCalls describes which calls an instance takes, e.g. inbound+outbound
+func connectCalls(inst connectadapter.Instance) string {
+	switch {
+	case inst.InboundCallsEnabled && inst.OutboundCallsEnabled:
+		return "inbound+outbound"
+	case inst.InboundCallsEnabled:
+		return "inbound"
+	case inst.OutboundCallsEnabled:
+		return "outbound"
+	}
+	return "-"
+}
+
+// ConnectInstanceResource implements Resource interface for Amazon Connect instances
+type ConnectInstanceResource struct {
+	instance connectadapter.Instance
+	region   string
+}
+
+func (r *ConnectInstanceResource) GetID() string     { return r.instance.ID }
+func (r *ConnectInstanceResource) GetName() string   { return r.instance.Alias }
+func (r *ConnectInstanceResource) GetARN() string    { return r.instance.ARN }
+func (r *ConnectInstanceResource) GetType() string   { return "connect:instances" }
+func (r *ConnectInstanceResource) GetRegion() string { return r.region }
+
+func (r *ConnectInstanceResource) GetCreatedAt() time.Time {
+	return r.instance.CreatedTime
+}
+
+func (r *ConnectInstanceResource) GetTags() map[string]string {
+	return r.instance.Tags
+}
+
+func (r *ConnectInstanceResource) ToTableRow() []string {
+	created := "-"
+	if !r.instance.CreatedTime.IsZero() {
+		created = r.instance.CreatedTime.Format("2006-01-02 15:04")
+	}
+
+	return []string{
+		r.instance.Alias,
+		r.instance.Status,
+		connectCalls(r.instance),
+		r.instance.IdentityManagementType,
+		r.instance.ID,
+		created,
+	}
+}
+
+func (r *ConnectInstanceResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"InstanceAlias":          r.instance.Alias,
+		"InstanceId":             r.instance.ID,
+		"Status":                 r.instance.Status,
+		"IdentityManagementType": r.instance.IdentityManagementType,
+		"InboundCallsEnabled":    r.instance.InboundCallsEnabled,
+		"OutboundCallsEnabled":   r.instance.OutboundCallsEnabled,
+	}
+}
@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+
+	ceadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/costexplorer"
+)
+
+// CostExplorerHandler handles month-to-date spend grouped by service or tag
+type CostExplorerHandler struct {
+	BaseHandler
+	client *ceadapter.CostsClient
+	region string
+	tagKey string // Group by this tag key instead of by service when set
+
+	// Cache of the last listed entries, Cost Explorer has no single-item lookup
+	entries map[string]ceadapter.CostEntry
+	total   float64
+}
+
+// NewCostExplorerHandler creates a new cost handler grouped by service
+func NewCostExplorerHandler(ceClient *costexplorer.Client, region string) *CostExplorerHandler {
+	return &CostExplorerHandler{
+		client:  ceadapter.NewCostsClient(ceClient),
+		region:  region,
+		entries: make(map[string]ceadapter.CostEntry),
+	}
+}
+
+// NewCostExplorerHandlerForTag creates a new cost handler grouped by a tag key
+func NewCostExplorerHandlerForTag(ceClient *costexplorer.Client, region, tagKey string) *CostExplorerHandler {
+	h := NewCostExplorerHandler(ceClient, region)
+	h.tagKey = tagKey
+	return h
+}
+
+func (h *CostExplorerHandler) ResourceType() string { return "ce:costs" }
+func (h *CostExplorerHandler) ResourceName() string { return "Cost Explorer" }
+func (h *CostExplorerHandler) ResourceIcon() string { return "💰" }
+func (h *CostExplorerHandler) ShortcutKey() string  { return "cost" }
+
+// TagKey returns the tag key costs are grouped by, empty when grouped by service
+func (h *CostExplorerHandler) TagKey() string {
+	return h.tagKey
+}
+
+func (h *CostExplorerHandler) Columns() []ColumnDef {
+	groupTitle := "Service"
+	if h.tagKey != "" {
+		groupTitle = "Tag: " + h.tagKey
+	}
+	return []ColumnDef{
+		{Title: groupTitle, Width: 45, Sortable: true},
+		{Title: "Month to Date", Width: 15, Sortable: true, SortType: SortNumber},
+		{Title: "Share", Width: 8, Sortable: false},
+		{Title: "Period", Width: 24, Sortable: false},
+	}
+}
+
+func (h *CostExplorerHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	var entries []ceadapter.CostEntry
+	var err error
+	if h.tagKey != "" {
+		entries, err = h.client.GetCostByTag(ctx, h.tagKey)
+	} else {
+		entries, err = h.client.GetCostByService(ctx)
+	}
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to get month-to-date costs", err)
+	}
+
+	h.entries = make(map[string]ceadapter.CostEntry, len(entries))
+	h.total = 0
+	for _, entry := range entries {
+		h.entries[entry.Key] = entry
+		h.total += entry.Amount
+	}
+
+	resources := make([]Resource, 0, len(entries))
+	for _, entry := range entries {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(entry.Key), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &CostResource{
+			entry:  entry,
+			total:  h.total,
+			tagKey: h.tagKey,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *CostExplorerHandler) Get(ctx context.Context, id string) (Resource, error) {
+	entry, ok := h.entries[id]
+	if !ok {
+		return nil, NewHandlerError("NOT_FOUND", fmt.Sprintf("no cost data for %s", id), nil)
+	}
+
+	return &CostResource{
+		entry:  entry,
+		total:  h.total,
+		tagKey: h.tagKey,
+		region: h.region,
+	}, nil
+}
+
+func (h *CostExplorerHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	entry, ok := h.entries[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("no cost data for %s", id), nil)
+	}
+
+	groupBy := "Service"
+	if h.tagKey != "" {
+		groupBy = "Tag: " + h.tagKey
+	}
+
+	details := make(map[string]interface{})
+
+	details["Cost"] = map[string]interface{}{
+		"Group":      entry.Key,
+		"GroupedBy":  groupBy,
+		"Amount":     formatCost(entry.Amount, entry.Unit),
+		"ShareTotal": formatShare(entry.Amount, h.total),
+		"Estimated":  entry.Estimated,
+	}
+
+	details["Period"] = map[string]interface{}{
+		"Start": entry.Start,
+		"End":   entry.End + " (exclusive)",
+	}
+
+	details["Account"] = map[string]interface{}{
+		"MonthToDateTotal": formatCost(h.total, entry.Unit),
+		"Metric":           "UnblendedCost",
+	}
+
+	return details, nil
+}
+
+// formatCost formats a cost amount with its currency unit
+func formatCost(amount float64, unit string) string {
+	if unit == "" || unit == "USD" {
+		return fmt.Sprintf("$%.2f", amount)
+	}
+	return fmt.Sprintf("%.2f %s", amount, unit)
+}
+
+// formatShare formats a part of the total as a percentage
+func formatShare(amount, total float64) string {
+	if total <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", amount/total*100)
+}
+
+// CostResource implements Resource interface for a Cost Explorer group
+type CostResource struct {
+	entry  ceadapter.CostEntry
+	total  float64
+	tagKey string
+	region string
+}
+
+func (r *CostResource) GetID() string   { return r.entry.Key }
+func (r *CostResource) GetName() string { return r.entry.Key }
+func (r *CostResource) GetARN() string  { return r.entry.Key }
+func (r *CostResource) GetType() string { return "ce:costs" }
+func (r *CostResource) GetRegion() string {
+	return r.region
+}
+
+func (r *CostResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *CostResource) GetTags() map[string]string {
+	if r.tagKey != "" {
+		return map[string]string{r.tagKey: r.entry.Key}
+	}
+	return nil
+}
+
+func (r *CostResource) ToTableRow() []string {
+	amount := formatCost(r.entry.Amount, r.entry.Unit)
+	if r.entry.Estimated {
+		amount += "*"
+	}
+
+	return []string{
+		r.entry.Key,
+		amount,
+		formatShare(r.entry.Amount, r.total),
+		fmt.Sprintf("%s → %s", r.entry.Start, r.entry.End),
+	}
+}
+
+func (r *CostResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Group":     r.entry.Key,
+		"Amount":    r.entry.Amount,
+		"Unit":      r.entry.Unit,
+		"Estimated": r.entry.Estimated,
+	}
+}
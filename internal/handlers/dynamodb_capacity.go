@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ddbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/dynamodb"
+)
+
+const (
+	BillingModeOnDemand    = "PAY_PER_REQUEST"
+	BillingModeProvisioned = "PROVISIONED"
+
+	// defaultCapacityUnits is offered for tables and indexes switching from on-demand
+	defaultCapacityUnits = 5
+
+	// DynamoDB allows four capacity decreases a day at any time, then one an hour
+	freeDecreasesPerDay = 4
+)
+
+// EditTableCapacityAction triggers the capacity editor for a table
+type EditTableCapacityAction struct {
+	TableName string
+}
+
+func (a *EditTableCapacityAction) Error() string {
+	return fmt.Sprintf("edit capacity of table %s", a.TableName)
+}
+
+func (a *EditTableCapacityAction) IsActionMsg() {}
+
+// Capacity is the provisioned read and write capacity of a table or index, with its
+// decreases today as DynamoDB limits them
+type Capacity struct {
+	Read           int64
+	Write          int64
+	DecreasesToday int64
+	LastDecrease   time.Time
+}
+
+// IndexCapacity is the capacity of a global secondary index
+type IndexCapacity struct {
+	Name string
+	Capacity
+}
+
+// TableCapacity is the billing mode and capacity of a table and its global secondary indexes
+type TableCapacity struct {
+	TableName            string
+	Status               string
+	BillingMode          string
+	Table                Capacity
+	Indexes              []IndexCapacity
+	LastSwitchToOnDemand time.Time
+}
+
+// TableCapacityRequest is a change to a table's billing mode or capacity, as entered in
+// the capacity editor
+type TableCapacityRequest struct {
+	Current     *TableCapacity
+	BillingMode string
+	Table       Capacity
+	Indexes     []IndexCapacity // In the order of Current.Indexes
+}
+
+// DefaultCapacity returns the capacity to offer for a table or index: its current one, or
+// a small default if it is on-demand
+func DefaultCapacity(c Capacity) Capacity {
+	if c.Read == 0 {
+		c.Read = defaultCapacityUnits
+	}
+	if c.Write == 0 {
+		c.Write = defaultCapacityUnits
+	}
+	return c
+}
+
+// Validate checks a capacity change against DynamoDB's limits, so a change that would be
+// rejected isn't confirmed first
+func (r *TableCapacityRequest) Validate(now time.Time) error {
+	current := r.Current
+	if current.Status != "ACTIVE" {
+		return fmt.Errorf("table is %s, its capacity can only change once it is ACTIVE", current.Status)
+	}
+	if !r.Changed() {
+		return fmt.Errorf("nothing to change")
+	}
+	if r.BillingMode == BillingModeOnDemand {
+		return nil
+	}
+
+	if err := validateCapacity("table", r.Table, current.Table, current.BillingMode, now); err != nil {
+		return err
+	}
+	for i, index := range r.Indexes {
+		if err := validateCapacity("index "+index.Name, index.Capacity, current.Indexes[i].Capacity, current.BillingMode, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCapacity checks the new capacity of a table or index, and that a decrease is
+// still allowed today
+func validateCapacity(name string, next, current Capacity, currentMode string, now time.Time) error {
+	if next.Read < 1 || next.Write < 1 {
+		return fmt.Errorf("%s needs at least 1 read and 1 write capacity unit", name)
+	}
+
+	decreasing := currentMode == BillingModeProvisioned && (next.Read < current.Read || next.Write < current.Write)
+	if !decreasing || current.DecreasesToday < freeDecreasesPerDay {
+		return nil
+	}
+	if next := current.LastDecrease.Add(time.Hour); now.Before(next) {
+		return fmt.Errorf("%s was decreased %d times today; DynamoDB allows %d, then one an hour, so the next decrease is possible at %s",
+			name, current.DecreasesToday, freeDecreasesPerDay, next.UTC().Format("15:04 UTC"))
+	}
+	return nil
+}
+
+// Changed reports whether the request changes anything
+func (r *TableCapacityRequest) Changed() bool {
+	if r.BillingMode != r.Current.BillingMode {
+		return true
+	}
+	if r.BillingMode == BillingModeOnDemand {
+		return false
+	}
+	if r.Table.Read != r.Current.Table.Read || r.Table.Write != r.Current.Table.Write {
+		return true
+	}
+	for i, index := range r.Indexes {
+		current := r.Current.Indexes[i]
+		if index.Read != current.Read || index.Write != current.Write {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary describes the change for its confirmation
+func (r *TableCapacityRequest) Summary(now time.Time) string {
+	current := r.Current
+	summary := fmt.Sprintf("You are about to change the capacity of the table:\n\n%s\n\n", current.TableName)
+
+	if r.BillingMode != current.BillingMode {
+		summary += fmt.Sprintf("Billing mode: %s → %s\n", billingModeLabel(current.BillingMode), billingModeLabel(r.BillingMode))
+	}
+
+	if r.BillingMode == BillingModeProvisioned {
+		summary += capacityChange("Table", current.Table, r.Table, current.BillingMode)
+		for i, index := range r.Indexes {
+			summary += capacityChange("Index "+index.Name, current.Indexes[i].Capacity, index.Capacity, current.BillingMode)
+		}
+	}
+
+	if r.BillingMode == BillingModeOnDemand && current.BillingMode != BillingModeOnDemand {
+		summary += "\nIndexes switch to on-demand with the table, and requests are billed as they are made."
+		if !current.LastSwitchToOnDemand.IsZero() && now.Sub(current.LastSwitchToOnDemand) < 24*time.Hour {
+			summary += fmt.Sprintf("\nThe table last switched to on-demand at %s; DynamoDB allows four switches to on-demand in 24 hours.",
+				current.LastSwitchToOnDemand.UTC().Format("2006-01-02 15:04 UTC"))
+		}
+	}
+	if r.BillingMode == BillingModeProvisioned && current.BillingMode == BillingModeOnDemand {
+		summary += "\nRequests beyond the provisioned capacity are throttled."
+	}
+
+	return summary
+}
+
+// capacityChange describes the read and write capacity of a table or index before and after
+func capacityChange(name string, before, after Capacity, beforeMode string) string {
+	if beforeMode == BillingModeOnDemand {
+		return fmt.Sprintf("%s: %d RCU, %d WCU\n", name, after.Read, after.Write)
+	}
+	if before.Read == after.Read && before.Write == after.Write {
+		return fmt.Sprintf("%s: %d RCU, %d WCU (unchanged)\n", name, after.Read, after.Write)
+	}
+	return fmt.Sprintf("%s: %d → %d RCU, %d → %d WCU\n", name, before.Read, after.Read, before.Write, after.Write)
+}
+
+// billingModeLabel names a billing mode as the console does
+func billingModeLabel(mode string) string {
+	if mode == BillingModeOnDemand {
+		return "on-demand"
+	}
+	return "provisioned"
+}
+
+// TableCapacity loads the billing mode and capacity of a table for the capacity editor
+func (h *DynamoDBTablesHandler) TableCapacity(ctx context.Context, tableName string) (*TableCapacity, error) {
+	table, err := h.client.GetTable(ctx, tableName)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get table %s", tableName), err)
+	}
+
+	capacity := &TableCapacity{
+		TableName:            table.TableName,
+		Status:               table.TableStatus,
+		BillingMode:          table.BillingModeSummary,
+		Table:                capacityOf(table.ProvisionedThroughput),
+		LastSwitchToOnDemand: table.LastSwitchToOnDemand,
+	}
+	for _, gsi := range table.GlobalSecondaryIndexes {
+		capacity.Indexes = append(capacity.Indexes, IndexCapacity{
+			Name:     gsi.IndexName,
+			Capacity: capacityOf(gsi.ProvisionedThroughput),
+		})
+	}
+	return capacity, nil
+}
+
+func capacityOf(pt *ddbadapter.ProvisionedThroughput) Capacity {
+	if pt == nil {
+		return Capacity{}
+	}
+	return Capacity{
+		Read:           pt.ReadCapacityUnits,
+		Write:          pt.WriteCapacityUnits,
+		DecreasesToday: pt.NumberOfDecreasesToday,
+		LastDecrease:   pt.LastDecreaseDateTime,
+	}
+}
+
+// UpdateCapacity applies a confirmed capacity change
+func (h *DynamoDBTablesHandler) UpdateCapacity(ctx context.Context, req *TableCapacityRequest) error {
+	update := ddbadapter.CapacityUpdate{
+		TableName:   req.Current.TableName,
+		BillingMode: req.BillingMode,
+	}
+
+	if req.BillingMode == BillingModeProvisioned {
+		switching := req.Current.BillingMode != BillingModeProvisioned
+		if switching || req.Table.Read != req.Current.Table.Read || req.Table.Write != req.Current.Table.Write {
+			update.Table = &ddbadapter.ProvisionedThroughput{
+				ReadCapacityUnits:  req.Table.Read,
+				WriteCapacityUnits: req.Table.Write,
+			}
+		}
+
+		// Indexes need their capacity when the table switches to provisioned
+		update.Indexes = make(map[string]ddbadapter.ProvisionedThroughput)
+		for i, index := range req.Indexes {
+			current := req.Current.Indexes[i]
+			if switching || index.Read != current.Read || index.Write != current.Write {
+				update.Indexes[index.Name] = ddbadapter.ProvisionedThroughput{
+					ReadCapacityUnits:  index.Read,
+					WriteCapacityUnits: index.Write,
+				}
+			}
+		}
+	}
+
+	if err := h.client.UpdateCapacity(ctx, update); err != nil {
+		return NewHandlerError("UPDATE_FAILED", fmt.Sprintf("failed to update capacity of table %s", req.Current.TableName), err)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
 	ddbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/dynamodb"
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // EditItemAction is returned by ExecuteAction to trigger editing an item
@@ -39,6 +40,9 @@ func (a *DeleteItemAction) Error() string {
 
 func (a *DeleteItemAction) IsActionMsg() {}
 
+// dynamoDBItemsPageSize is how many items a scan reads per page
+const dynamoDBItemsPageSize = 100
+
 type DynamoDBItemsHandler struct {
 	BaseHandler
 	itemsClient  *ddbadapter.ItemsClient
@@ -71,10 +75,25 @@ func (h *DynamoDBItemsHandler) Columns() []ColumnDef {
 	}
 }
 
+// IncrementalPageSize streams the table's items into the list a scan page at a time
+func (h *DynamoDBItemsHandler) IncrementalPageSize() int { return dynamoDBItemsPageSize }
+
+// List scans a page of items. The next page's token is the last evaluated key, in the
+// DynamoDB JSON an item's ID is written in.
 func (h *DynamoDBItemsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
 	scanOpts := ddbadapter.ScanOptions{
 		TableName: h.tableName,
-		Limit:     100,
+		Limit:     dynamoDBItemsPageSize,
+	}
+	if opts.PageSize > 0 {
+		scanOpts.Limit = int32(opts.PageSize)
+	}
+	if opts.NextToken != "" {
+		startKey, err := keyFromID(opts.NextToken)
+		if err != nil {
+			return nil, NewHandlerError("LIST_FAILED", "invalid page token", err)
+		}
+		scanOpts.ExclusiveStartKey = startKey
 	}
 
 	result, err := h.itemsClient.ScanTable(ctx, scanOpts)
@@ -107,21 +126,26 @@ func (h *DynamoDBItemsHandler) List(ctx context.Context, opts ListOptions) (*Lis
 		resources = append(resources, resource)
 	}
 
+	// Only a paged list continues past the first scan page
+	var nextToken string
+	if opts.PageSize > 0 && len(result.LastEvaluatedKey) > 0 {
+		token, err := ddbadapter.MarshalItemJSON(result.LastEvaluatedKey, false)
+		if err != nil {
+			return nil, NewHandlerError("LIST_FAILED", "failed to encode the page token", err)
+		}
+		nextToken = string(token)
+	}
+
 	return &ListResult{
 		Resources: resources,
-		NextToken: "",
+		NextToken: nextToken,
 	}, nil
 }
 
 func (h *DynamoDBItemsHandler) Get(ctx context.Context, id string) (Resource, error) {
-	var keyMap map[string]interface{}
-	if err := json.Unmarshal([]byte(id), &keyMap); err != nil {
-		return nil, NewHandlerError("GET_FAILED", "invalid item key", err)
-	}
-
-	key, err := ddbadapter.MapToKey(keyMap)
+	key, err := keyFromID(id)
 	if err != nil {
-		return nil, NewHandlerError("GET_FAILED", "failed to convert key", err)
+		return nil, NewHandlerError("GET_FAILED", "invalid item key", err)
 	}
 
 	item, err := h.itemsClient.GetItem(ctx, h.tableName, key)
@@ -143,14 +167,9 @@ func (h *DynamoDBItemsHandler) Get(ctx context.Context, id string) (Resource, er
 }
 
 func (h *DynamoDBItemsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
-	var keyMap map[string]interface{}
-	if err := json.Unmarshal([]byte(id), &keyMap); err != nil {
-		return nil, NewHandlerError("DESCRIBE_FAILED", "invalid item key", err)
-	}
-
-	key, err := ddbadapter.MapToKey(keyMap)
+	key, err := keyFromID(id)
 	if err != nil {
-		return nil, NewHandlerError("DESCRIBE_FAILED", "failed to convert key", err)
+		return nil, NewHandlerError("DESCRIBE_FAILED", "invalid item key", err)
 	}
 
 	item, err := h.itemsClient.GetItem(ctx, h.tableName, key)
@@ -165,45 +184,123 @@ func (h *DynamoDBItemsHandler) Describe(ctx context.Context, id string) (map[str
 func (h *DynamoDBItemsHandler) CanEdit() bool   { return true }
 func (h *DynamoDBItemsHandler) CanDelete() bool { return true }
 
+// Update replaces the item with updates["item"], its attribute values
 func (h *DynamoDBItemsHandler) Update(ctx context.Context, id string, updates map[string]interface{}) error {
-	var keyMap map[string]interface{}
-	if err := json.Unmarshal([]byte(id), &keyMap); err != nil {
-		return NewHandlerError("UPDATE_FAILED", "invalid item key", err)
+	item, ok := updates["item"].(map[string]types.AttributeValue)
+	if !ok {
+		return NewHandlerError("UPDATE_FAILED", "invalid item data", fmt.Errorf("item field is required"))
 	}
+	return h.SaveItem(ctx, id, item)
+}
+
+// ItemKeyAttribute is an attribute of the table's key, with the type its definition gives it
+type ItemKeyAttribute struct {
+	Name    string
+	Type    string // S, N or B
+	KeyType string // HASH or RANGE
+}
+
+// EditableItem is an item loaded for editing, with its attribute types intact, and the
+// table's key to validate it against
+type EditableItem struct {
+	Attributes map[string]types.AttributeValue
+	Keys       []ItemKeyAttribute
+}
 
-	key, err := ddbadapter.MapToKey(keyMap)
+// LoadItem gets an item for editing
+func (h *DynamoDBItemsHandler) LoadItem(ctx context.Context, id string) (*EditableItem, error) {
+	key, err := keyFromID(id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", "invalid item key", err)
+	}
+	item, err := h.itemsClient.GetItem(ctx, h.tableName, key)
 	if err != nil {
-		return NewHandlerError("UPDATE_FAILED", "failed to convert key", err)
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get item from %s", h.tableName), err)
 	}
+	keys, err := h.keyAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EditableItem{Attributes: item.Attributes, Keys: keys}, nil
+}
 
-	itemMap, ok := updates["item"].(map[string]interface{})
-	if !ok {
-		return NewHandlerError("UPDATE_FAILED", "invalid item data", fmt.Errorf("item field is required"))
+// SaveItem puts the edited item in place of the one with the given ID, once its key
+// matches the table's key schema and is unchanged
+func (h *DynamoDBItemsHandler) SaveItem(ctx context.Context, id string, item map[string]types.AttributeValue) error {
+	key, err := keyFromID(id)
+	if err != nil {
+		return NewHandlerError("UPDATE_FAILED", "invalid item key", err)
 	}
+	keys, err := h.keyAttributes(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ValidateItemKey(keys, key, item); err != nil {
+		return err
+	}
+	return h.itemsClient.PutItem(ctx, h.tableName, item)
+}
 
-	// Convert the item map to DynamoDB attribute values
-	attributeValues, err := ddbadapter.MapToKey(itemMap)
+// keyAttributes reads the table's key schema with the types of its attributes
+func (h *DynamoDBItemsHandler) keyAttributes(ctx context.Context) ([]ItemKeyAttribute, error) {
+	table, err := h.tablesClient.GetTable(ctx, h.tableName)
 	if err != nil {
-		return NewHandlerError("UPDATE_FAILED", "failed to convert item to DynamoDB format", err)
+		return nil, NewHandlerError("GET_FAILED", "failed to get table schema", err)
 	}
 
-	// Ensure the key attributes are present in the item
-	for k, v := range key {
-		attributeValues[k] = v
+	keys := make([]ItemKeyAttribute, 0, len(table.KeySchema))
+	for _, elem := range table.KeySchema {
+		key := ItemKeyAttribute{Name: elem.AttributeName, KeyType: elem.KeyType}
+		for _, def := range table.AttributeDefinitions {
+			if def.AttributeName == elem.AttributeName {
+				key.Type = def.AttributeType
+			}
+		}
+		keys = append(keys, key)
 	}
+	return keys, nil
+}
 
-	return h.itemsClient.PutItem(ctx, h.tableName, attributeValues)
+// ValidateItemKey checks an item has every key attribute, of the type the table defines
+// and not empty, and that they are those of the original key. A changed key would put a
+// new item and leave the original one in place.
+func ValidateItemKey(keys []ItemKeyAttribute, original, item map[string]types.AttributeValue) error {
+	for _, key := range keys {
+		av, ok := item[key.Name]
+		if !ok {
+			return fmt.Errorf("key attribute %s is missing", key.Name)
+		}
+		typ, text := ddbadapter.AttributeText(av)
+		if typ != key.Type {
+			return fmt.Errorf("key attribute %s must be of type %s, not %s", key.Name, key.Type, typ)
+		}
+		if text == "" {
+			return fmt.Errorf("key attribute %s can't be empty", key.Name)
+		}
+		if _, was := ddbadapter.AttributeText(original[key.Name]); was != text {
+			return fmt.Errorf("key attribute %s can't be changed, create a new item and delete this one instead", key.Name)
+		}
+	}
+	return nil
 }
 
-func (h *DynamoDBItemsHandler) Delete(ctx context.Context, id string) error {
+// keyFromID reads an item's key from its ID, the key attributes as DynamoDB JSON. IDs
+// of plain JSON, as kept in older bookmarks, are read with their types guessed.
+func keyFromID(id string) (map[string]types.AttributeValue, error) {
+	if key, err := ddbadapter.UnmarshalItemJSON([]byte(id)); err == nil {
+		return key, nil
+	}
 	var keyMap map[string]interface{}
 	if err := json.Unmarshal([]byte(id), &keyMap); err != nil {
-		return NewHandlerError("DELETE_FAILED", "invalid item key", err)
+		return nil, err
 	}
+	return ddbadapter.MapToKey(keyMap)
+}
 
-	key, err := ddbadapter.MapToKey(keyMap)
+func (h *DynamoDBItemsHandler) Delete(ctx context.Context, id string) error {
+	key, err := keyFromID(id)
 	if err != nil {
-		return NewHandlerError("DELETE_FAILED", "failed to convert key", err)
+		return NewHandlerError("DELETE_FAILED", "invalid item key", err)
 	}
 
 	return h.itemsClient.DeleteItem(ctx, h.tableName, key)
@@ -211,8 +308,8 @@ func (h *DynamoDBItemsHandler) Delete(ctx context.Context, id string) error {
 
 func (h *DynamoDBItemsHandler) Actions() []Action {
 	return []Action{
-		{Key: "e", Name: "edit", Description: "Edit item"},
-		{Key: "x", Name: "delete", Description: "Delete item"},
+		{Key: "e", Name: "edit", Description: "Edit item", Mutating: true},
+		{Key: "x", Name: "delete", Description: "Delete item", Mutating: true},
 	}
 }
 
@@ -255,13 +352,11 @@ func (r *DynamoDBItemResource) GetID() string {
 		}
 	}
 
-	// Convert to plain map for JSON serialization
-	keyMap, err := ddbadapter.KeyToMap(keyData)
+	// DynamoDB JSON keeps the key's types, so binary and numeric keys read back as they were
+	keyJSON, err := ddbadapter.MarshalItemJSON(keyData, false)
 	if err != nil {
 		return ""
 	}
-
-	keyJSON, _ := json.Marshal(keyMap)
 	return string(keyJSON)
 }
 
@@ -312,10 +407,7 @@ func (r *DynamoDBItemResource) ToTableRow() []string {
 		}
 	}
 
-	attributes := strings.Join(otherAttrs, ", ")
-	if len(attributes) > 47 {
-		attributes = attributes[:47] + "..."
-	}
+	attributes := utils.Truncate(strings.Join(otherAttrs, ", "), 50)
 
 	return []string{
 		primaryKey,
@@ -22,6 +22,17 @@ func (a *NavigateToItemsAction) Error() string {
 
 func (a *NavigateToItemsAction) IsActionMsg() {}
 
+// DeleteTableAction triggers the delete confirmation for a table
+type DeleteTableAction struct {
+	TableName string
+}
+
+func (a *DeleteTableAction) Error() string {
+	return fmt.Sprintf("delete table %s", a.TableName)
+}
+
+func (a *DeleteTableAction) IsActionMsg() {}
+
 type DynamoDBTablesHandler struct {
 	BaseHandler
 	client *ddbadapter.TablesClient
@@ -45,9 +56,9 @@ func (h *DynamoDBTablesHandler) Columns() []ColumnDef {
 		{Title: "Table Name", Width: 35, Sortable: true},
 		{Title: "Status", Width: 12, Sortable: true},
 		{Title: "Billing Mode", Width: 15, Sortable: true},
-		{Title: "Item Count", Width: 12, Sortable: true},
-		{Title: "Size (MB)", Width: 12, Sortable: true},
-		{Title: "Created", Width: 14, Sortable: true},
+		{Title: "Item Count", Width: 12, Sortable: true, SortType: SortNumber},
+		{Title: "Size (MB)", Width: 12, Sortable: true, SortType: SortNumber},
+		{Title: "Created", Width: 14, Sortable: true, SortType: SortDate},
 	}
 }
 
@@ -112,6 +123,14 @@ func (h *DynamoDBTablesHandler) Describe(ctx context.Context, id string) (map[st
 	}
 	details["Table"] = tableInfo
 
+	if table.BillingModeSummary == BillingModeProvisioned && table.ProvisionedThroughput != nil {
+		details["ProvisionedThroughput"] = map[string]int64{
+			"ReadCapacityUnits":      table.ProvisionedThroughput.ReadCapacityUnits,
+			"WriteCapacityUnits":     table.ProvisionedThroughput.WriteCapacityUnits,
+			"NumberOfDecreasesToday": table.ProvisionedThroughput.NumberOfDecreasesToday,
+		}
+	}
+
 	if len(table.KeySchema) > 0 {
 		keySchema := make([]map[string]string, 0, len(table.KeySchema))
 		for _, key := range table.KeySchema {
@@ -188,6 +207,8 @@ func (h *DynamoDBTablesHandler) Delete(ctx context.Context, id string) error {
 func (h *DynamoDBTablesHandler) Actions() []Action {
 	return []Action{
 		{Key: "v", Name: "view-items", Description: "View table items"},
+		{Key: "b", Name: "capacity", Description: "Edit billing mode and capacity", Mutating: true},
+		{Key: "x", Name: "delete", Description: "Delete table", Mutating: true, Severity: SeverityCritical},
 	}
 }
 
@@ -202,11 +223,46 @@ func (h *DynamoDBTablesHandler) ExecuteAction(ctx context.Context, action string
 		return &NavigateToItemsAction{
 			TableName: table.GetName(),
 		}
+	case "delete":
+		return &DeleteTableAction{
+			TableName: table.GetName(),
+		}
+	case "capacity":
+		return &EditTableCapacityAction{
+			TableName: table.GetName(),
+		}
 	default:
 		return fmt.Errorf("unknown action: %s", action)
 	}
 }
 
+func (h *DynamoDBTablesHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	table, err := h.client.GetTable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if table.Tags == nil {
+		return map[string]string{}, nil
+	}
+	return table.Tags, nil
+}
+
+func (h *DynamoDBTablesHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	table, err := h.client.GetTable(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(set) > 0 {
+		if err := h.client.UpdateTableTags(ctx, table.TableArn, set); err != nil {
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		return h.client.RemoveTableTags(ctx, table.TableArn, remove)
+	}
+	return nil
+}
+
 type DynamoDBTableResource struct {
 	table  ddbadapter.Table
 	region string
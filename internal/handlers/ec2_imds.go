@@ -0,0 +1,327 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// imdsv2Release is when IMDSv2 became available. Software on instances launched before it
+// may not support tokens unless it has been updated since.
+var imdsv2Release = time.Date(2019, time.November, 19, 0, 0, 0, 0, time.UTC)
+
+// IMDSv2MinimumVersions are the first releases of common tools and SDKs that use IMDSv2.
+// Older releases running on an instance fail to get credentials once tokens are required.
+var IMDSv2MinimumVersions = []string{
+	"AWS CLI v1 1.16.289",
+	"boto3 1.12.6 / botocore 1.13.25",
+	"AWS SDK for Java 1.11.678, 2.10.21",
+	"AWS SDK for Go 1.25.38, v2 0.19.0",
+	"AWS SDK for JavaScript 2.722.0",
+	"AWS SDK for .NET 3.3.634.1",
+	"AWS SDK for Ruby 3.79.0",
+	"AWS SDK for PHP 3.147.7",
+	"AWS Tools for PowerShell 4.0.1.0",
+}
+
+// IMDSTarget is an instance to switch to IMDSv2, with the hop limit it will get and what
+// may break on it
+type IMDSTarget struct {
+	InstanceID string
+	Name       string
+	HopLimit   int32
+	Warnings   []string
+}
+
+// RequireIMDSv2Action is returned by ExecuteAction after a dry run, to confirm requiring
+// IMDSv2 on instances. Denied holds the instances the dry run was refused for.
+type RequireIMDSv2Action struct {
+	Targets []IMDSTarget
+	Denied  map[string]string
+}
+
+func (a *RequireIMDSv2Action) Error() string {
+	return fmt.Sprintf("require IMDSv2 on %d instances", len(a.Targets))
+}
+
+func (a *RequireIMDSv2Action) IsActionMsg() {}
+
+// EC2IMDSHandler reports the instances whose metadata service still allows IMDSv1
+type EC2IMDSHandler struct {
+	BaseHandler
+	client *ec2adapter.InstancesClient
+	region string
+}
+
+// NewEC2IMDSHandler creates a new IMDSv1 report handler
+func NewEC2IMDSHandler(ec2Client *ec2.Client, region string) *EC2IMDSHandler {
+	return &EC2IMDSHandler{
+		client: ec2adapter.NewInstancesClient(ec2Client),
+		region: region,
+	}
+}
+
+func (h *EC2IMDSHandler) ResourceType() string { return "ec2:imds" }
+func (h *EC2IMDSHandler) ResourceName() string { return "IMDSv1 Instances" }
+func (h *EC2IMDSHandler) ResourceIcon() string { return "🛡️" }
+func (h *EC2IMDSHandler) ShortcutKey() string  { return "imds" }
+
+func (h *EC2IMDSHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 25, Sortable: true},
+		{Title: "Instance ID", Width: 20, Sortable: false},
+		{Title: "State", Width: 10, Sortable: true},
+		{Title: "Platform", Width: 10, Sortable: true},
+		{Title: "Hop Limit", Width: 9, Sortable: true},
+		{Title: "Launched", Width: 12, Sortable: true, SortType: SortDate},
+		{Title: "Warnings", Width: 50, Sortable: false},
+	}
+}
+
+// listIMDSv1 lists the instances that allow IMDSv1, leaving out terminated ones
+func (h *EC2IMDSHandler) listIMDSv1(ctx context.Context) ([]ec2adapter.Instance, error) {
+	instances, err := h.client.ListInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ec2adapter.Instance
+	for _, inst := range instances {
+		if inst.State == "terminated" || inst.State == "shutting-down" || !inst.AllowsIMDSv1() {
+			continue
+		}
+		result = append(result, inst)
+	}
+	return result, nil
+}
+
+func (h *EC2IMDSHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	instances, err := h.listIMDSv1(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list EC2 instances", err)
+	}
+
+	resources := make([]Resource, 0, len(instances))
+	for _, inst := range instances {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(inst.Name)
+			id := strings.ToLower(inst.InstanceID)
+			if !strings.Contains(name, filter) && !strings.Contains(id, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &IMDSInstanceResource{
+			EC2InstanceResource: EC2InstanceResource{instance: inst, region: h.region},
+			target:              imdsTarget(inst),
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *EC2IMDSHandler) Get(ctx context.Context, id string) (Resource, error) {
+	inst, err := h.client.GetInstance(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get instance %s", id), err)
+	}
+
+	return &IMDSInstanceResource{
+		EC2InstanceResource: EC2InstanceResource{instance: *inst, region: h.region},
+		target:              imdsTarget(*inst),
+	}, nil
+}
+
+func (h *EC2IMDSHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe instance %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *EC2IMDSHandler) Actions() []Action {
+	return []Action{
+		{Key: "e", Name: "require", Description: "Require IMDSv2 (dry run first)", Mutating: true},
+		{Key: "E", Name: "require-all", Description: "Require IMDSv2 on all listed (dry run first)", Dangerous: true, Mutating: true},
+	}
+}
+
+func (h *EC2IMDSHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	var instances []ec2adapter.Instance
+
+	switch action {
+	case "require":
+		inst, err := h.client.GetInstance(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		if !inst.AllowsIMDSv1() {
+			return fmt.Errorf("%s already requires IMDSv2", resourceID)
+		}
+		instances = append(instances, *inst)
+	case "require-all":
+		listed, err := h.listIMDSv1(ctx)
+		if err != nil {
+			return err
+		}
+		if len(listed) == 0 {
+			return fmt.Errorf("no instances allow IMDSv1")
+		}
+		instances = listed
+	default:
+		return ErrNotSupported
+	}
+
+	return h.dryRun(ctx, instances)
+}
+
+// dryRun checks that IMDSv2 can be required on each instance without changing anything
+func (h *EC2IMDSHandler) dryRun(ctx context.Context, instances []ec2adapter.Instance) error {
+	action := &RequireIMDSv2Action{Denied: make(map[string]string)}
+	for _, inst := range instances {
+		target := imdsTarget(inst)
+		if err := h.client.RequireIMDSv2(ctx, inst.InstanceID, target.HopLimit, true); err != nil {
+			action.Denied[inst.InstanceID] = err.Error()
+			continue
+		}
+		action.Targets = append(action.Targets, target)
+	}
+
+	if len(action.Targets) == 0 {
+		return fmt.Errorf("dry run failed for every instance, e.g. %s", firstValue(action.Denied))
+	}
+	return action
+}
+
+// RequireIMDSv2 requires IMDSv2 on the targets, returning how many were changed and the
+// errors of those that failed
+func (h *EC2IMDSHandler) RequireIMDSv2(ctx context.Context, targets []IMDSTarget) (int, []error) {
+	changed := 0
+	var errs []error
+	for _, target := range targets {
+		if err := h.client.RequireIMDSv2(ctx, target.InstanceID, target.HopLimit, false); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		changed++
+	}
+	return changed, errs
+}
+
+// imdsTarget works out the hop limit an instance needs and what may break on it.
+// Containers reach the metadata service through an extra hop, so instances that look
+// like container hosts keep a hop limit of at least 2.
+func imdsTarget(inst ec2adapter.Instance) IMDSTarget {
+	target := IMDSTarget{
+		InstanceID: inst.InstanceID,
+		Name:       inst.Name,
+		HopLimit:   inst.MetadataHopLimit,
+	}
+	if target.HopLimit < 1 {
+		target.HopLimit = 1
+	}
+
+	if looksLikeContainerHost(inst) {
+		if target.HopLimit < 2 {
+			target.HopLimit = 2
+		}
+		target.Warnings = append(target.Warnings, "container host: pods/tasks using instance credentials need current SDKs")
+	}
+	if strings.EqualFold(inst.Platform, "windows") {
+		target.Warnings = append(target.Warnings, "Windows: EC2Config/EC2Launch and AWS Tools for PowerShell must be current")
+	}
+	if !inst.LaunchTime.IsZero() && inst.LaunchTime.Before(imdsv2Release) {
+		target.Warnings = append(target.Warnings, "launched before IMDSv2 existed, agents and SDKs may predate it")
+	}
+	if inst.IAMRole == "" {
+		target.Warnings = append(target.Warnings, "no instance profile, only metadata reads are affected")
+	}
+	return target
+}
+
+// looksLikeContainerHost reports whether an instance appears to be an EKS, ECS or
+// Kubernetes node, from its tags and instance profile
+func looksLikeContainerHost(inst ec2adapter.Instance) bool {
+	for key := range inst.Tags {
+		if key == "eks:cluster-name" || key == "aws:eks:cluster-name" || key == "eks:nodegroup-name" ||
+			strings.HasPrefix(key, "kubernetes.io/cluster/") || strings.HasPrefix(key, "aws:ecs:") {
+			return true
+		}
+	}
+	role := strings.ToLower(inst.IAMRole)
+	return strings.Contains(role, "ecs") || strings.Contains(role, "eks") || strings.Contains(role, "nodeinstancerole")
+}
+
+// firstValue returns any one value of a map, for examples in error messages
+func firstValue(m map[string]string) string {
+	for _, v := range m {
+		return v
+	}
+	return ""
+}
+
+// IMDSInstanceResource is an instance allowing IMDSv1 in the report
+type IMDSInstanceResource struct {
+	EC2InstanceResource
+	target IMDSTarget
+}
+
+func (r *IMDSInstanceResource) GetType() string { return "ec2:imds" }
+
+func (r *IMDSInstanceResource) ToTableRow() []string {
+	hopLimit := fmt.Sprintf("%d", r.instance.MetadataHopLimit)
+	if r.target.HopLimit != r.instance.MetadataHopLimit {
+		hopLimit += fmt.Sprintf(" → %d", r.target.HopLimit)
+	}
+
+	launched := ""
+	if !r.instance.LaunchTime.IsZero() {
+		launched = r.instance.LaunchTime.Format("2006-01-02")
+	}
+
+	return []string{
+		orDash(r.instance.Name),
+		r.instance.InstanceID,
+		r.instance.State,
+		r.instance.Platform,
+		hopLimit,
+		launched,
+		orDash(strings.Join(r.target.Warnings, "; ")),
+	}
+}
+
+func (r *IMDSInstanceResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"InstanceId": r.instance.InstanceID,
+		"Name":       r.instance.Name,
+		"State":      r.instance.State,
+		"Platform":   r.instance.Platform,
+		"MetadataOptions": map[string]interface{}{
+			"HttpEndpoint":            r.instance.MetadataEndpoint,
+			"HttpTokens":              r.instance.MetadataTokens,
+			"HttpPutResponseHopLimit": r.instance.MetadataHopLimit,
+		},
+		"HopLimitAfterChange": r.target.HopLimit,
+	}
+	if r.instance.IAMRole != "" {
+		details["IamInstanceProfile"] = r.instance.IAMRole
+	}
+	if !r.instance.LaunchTime.IsZero() {
+		details["LaunchTime"] = r.instance.LaunchTime.Format(time.RFC3339)
+	}
+	if len(r.target.Warnings) > 0 {
+		details["Warnings"] = r.target.Warnings
+	}
+	return details
+}
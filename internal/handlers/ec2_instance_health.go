@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	cwadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/cloudwatch"
+)
+
+// Instance metrics in the details cover the last hour at the 5 minute period of basic
+// monitoring
+const (
+	instanceMetricsWindow = time.Hour
+	instanceMetricsPeriod = 5 * time.Minute
+)
+
+// instanceMetric is a metric shown in an instance's details. Byte counts are summed
+// per period and shown per second.
+type instanceMetric struct {
+	name  string
+	stat  string
+	bytes bool
+}
+
+var instanceMetrics = []instanceMetric{
+	{name: "CPUUtilization", stat: "Average"},
+	{name: "NetworkIn", stat: "Sum", bytes: true},
+	{name: "NetworkOut", stat: "Sum", bytes: true},
+}
+
+// statusChecks summarizes the system and instance status checks and the scheduled
+// events of an instance. Failing to read them is shown instead of failing the details.
+func (h *EC2InstancesHandler) statusChecks(ctx context.Context, instanceID string) map[string]interface{} {
+	status, err := h.client.GetInstanceStatus(ctx, instanceID)
+	if err != nil {
+		return map[string]interface{}{"Error": err.Error()}
+	}
+	if status == nil {
+		return map[string]interface{}{"Status": "No checks while the instance isn't running"}
+	}
+
+	checks := map[string]interface{}{
+		"System":   statusSummary(status.SystemStatus),
+		"Instance": statusSummary(status.InstanceStatus),
+	}
+	if status.AttachedEbsStatus != nil && status.AttachedEbsStatus.Status != "" {
+		checks["AttachedEBS"] = string(status.AttachedEbsStatus.Status)
+	}
+
+	if len(status.Events) > 0 {
+		events := make([]string, 0, len(status.Events))
+		for _, event := range status.Events {
+			text := fmt.Sprintf("%s: %s", event.Code, aws.ToString(event.Description))
+			if event.NotBefore != nil {
+				text += " from " + event.NotBefore.Format(time.RFC3339)
+			}
+			events = append(events, text)
+		}
+		checks["ScheduledEvents"] = events
+	}
+	return checks
+}
+
+// statusSummary describes a status check, e.g. impaired (reachability failed since ...)
+func statusSummary(summary *ec2types.InstanceStatusSummary) string {
+	if summary == nil {
+		return "-"
+	}
+
+	details := make([]string, 0, len(summary.Details))
+	for _, d := range summary.Details {
+		detail := fmt.Sprintf("%s %s", d.Name, d.Status)
+		if d.ImpairedSince != nil {
+			detail += " since " + d.ImpairedSince.Format(time.RFC3339)
+		}
+		details = append(details, detail)
+	}
+	if len(details) == 0 {
+		return string(summary.Status)
+	}
+	return fmt.Sprintf("%s (%s)", summary.Status, strings.Join(details, ", "))
+}
+
+// recentMetrics summarizes the instance's CPU and network over the last hour
+func (h *EC2InstancesHandler) recentMetrics(ctx context.Context, instanceID string) map[string]interface{} {
+	end := time.Now()
+	queries := make([]cwadapter.MetricQuery, 0, len(instanceMetrics))
+	for _, m := range instanceMetrics {
+		queries = append(queries, cwadapter.MetricQuery{
+			Namespace:  "AWS/EC2",
+			MetricName: m.name,
+			Dimensions: map[string]string{"InstanceId": instanceID},
+			Stat:       m.stat,
+			Period:     instanceMetricsPeriod,
+			Start:      end.Add(-instanceMetricsWindow),
+			End:        end,
+		})
+	}
+
+	series, err := h.metrics.GetSeriesBatch(ctx, queries)
+	if err != nil {
+		return map[string]interface{}{"Error": err.Error()}
+	}
+
+	metrics := map[string]interface{}{"Window": "last hour"}
+	for i, m := range instanceMetrics {
+		metrics[m.name] = metricSummary(series[i], m.bytes)
+	}
+	return metrics
+}
+
+// metricSummary gives the latest, average and highest value of a series
+func metricSummary(points []cwadapter.Datapoint, bytes bool) string {
+	if len(points) == 0 {
+		return "no datapoints"
+	}
+
+	format := func(v float64) string { return fmt.Sprintf("%.1f%%", v) }
+	if bytes {
+		format = func(v float64) string {
+			return FormatBytes(int64(v/instanceMetricsPeriod.Seconds())) + "/s"
+		}
+	}
+
+	high, total := points[0].Value, 0.0
+	for _, point := range points {
+		high = max(high, point.Value)
+		total += point.Value
+	}
+	return fmt.Sprintf("latest %s, avg %s, max %s",
+		format(points[len(points)-1].Value), format(total/float64(len(points))), format(high))
+}
+
+// ConsoleOutputAction is returned by ExecuteAction with an instance's console output
+type ConsoleOutputAction struct {
+	InstanceID string
+	Output     string
+	CapturedAt time.Time
+}
+
+func (a *ConsoleOutputAction) Error() string {
+	return fmt.Sprintf("console output of instance %s", a.InstanceID)
+}
+
+func (a *ConsoleOutputAction) IsActionMsg() {}
+
+// ConsoleScreenshotAction is returned by ExecuteAction with a JPG screenshot of an
+// instance's console, to be saved
+type ConsoleScreenshotAction struct {
+	InstanceID string
+	Image      []byte
+}
+
+func (a *ConsoleScreenshotAction) Error() string {
+	return fmt.Sprintf("console screenshot of instance %s", a.InstanceID)
+}
+
+func (a *ConsoleScreenshotAction) IsActionMsg() {}
+
+// consoleOutput gets an instance's console output for boot debugging
+func (h *EC2InstancesHandler) consoleOutput(ctx context.Context, instanceID string) error {
+	output, capturedAt, err := h.client.GetConsoleOutput(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(output) == "" {
+		return fmt.Errorf("instance %s has no console output yet", instanceID)
+	}
+	// Serial consoles end lines with \r\n
+	output = strings.ReplaceAll(output, "\r\n", "\n")
+	return &ConsoleOutputAction{InstanceID: instanceID, Output: output, CapturedAt: capturedAt}
+}
+
+// consoleScreenshot gets a screenshot of a running instance's console
+func (h *EC2InstancesHandler) consoleScreenshot(ctx context.Context, instanceID string) error {
+	image, err := h.client.GetConsoleScreenshot(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	return &ConsoleScreenshotAction{InstanceID: instanceID, Image: image}
+}
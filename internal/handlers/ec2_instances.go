@@ -6,23 +6,34 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
+	cwadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/cloudwatch"
 	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
 )
 
 // EC2InstancesHandler handles EC2 Instance resources
 type EC2InstancesHandler struct {
 	BaseHandler
-	client *ec2adapter.InstancesClient
-	region string
+	ec2Tagging
+	client  *ec2adapter.InstancesClient
+	enis    *ec2adapter.NetworkInterfacesClient
+	metrics *cwadapter.MetricsClient
+	region  string
+
+	// Show an estimated monthly cost column derived from the instance type
+	showCost bool
 }
 
 // NewEC2InstancesHandler creates a new EC2 instances handler
-func NewEC2InstancesHandler(ec2Client *ec2.Client, region string) *EC2InstancesHandler {
+func NewEC2InstancesHandler(ec2Client *ec2.Client, cwClient *cloudwatch.Client, region string) *EC2InstancesHandler {
 	return &EC2InstancesHandler{
-		client: ec2adapter.NewInstancesClient(ec2Client),
-		region: region,
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewInstancesClient(ec2Client),
+		enis:       ec2adapter.NewNetworkInterfacesClient(ec2Client),
+		metrics:    cwadapter.NewMetricsClient(cwClient),
+		region:     region,
 	}
 }
 
@@ -31,20 +42,40 @@ func (h *EC2InstancesHandler) ResourceName() string { return "EC2 Instances" }
 func (h *EC2InstancesHandler) ResourceIcon() string { return "💻" }
 func (h *EC2InstancesHandler) ShortcutKey() string  { return "ec2" }
 
+// SetShowCostEstimate enables or disables the estimated cost column
+func (h *EC2InstancesHandler) SetShowCostEstimate(show bool) {
+	h.showCost = show
+}
+
 func (h *EC2InstancesHandler) Columns() []ColumnDef {
-	return []ColumnDef{
+	columns := []ColumnDef{
 		{Title: "Name", Width: 25, Sortable: true},
 		{Title: "Instance ID", Width: 20, Sortable: false},
-		{Title: "State", Width: 12, Sortable: true},
+		{Title: "State", Width: 12, Sortable: true, Priority: 1},
 		{Title: "Type", Width: 12, Sortable: true},
 		{Title: "Private IP", Width: 16, Sortable: false},
 		{Title: "Public IP", Width: 16, Sortable: false},
 		{Title: "AZ", Width: 12, Sortable: false},
 	}
+	if h.showCost {
+		columns = append(columns, ColumnDef{Title: "Est. $/mo", Width: 10, Sortable: true, SortType: SortNumber})
+	}
+	return columns
+}
+
+// ServerFilterHint describes how a search matches instances on the server
+func (h *EC2InstancesHandler) ServerFilterHint() string {
+	return "instance ID, state, private IP or Name tag"
 }
 
 func (h *EC2InstancesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
-	instances, err := h.client.ListInstances(ctx)
+	var instances []ec2adapter.Instance
+	var err error
+	if opts.ServerFilter != "" {
+		instances, err = h.client.SearchInstances(ctx, opts.ServerFilter)
+	} else {
+		instances, err = h.client.ListInstances(ctx)
+	}
 	if err != nil {
 		return nil, NewHandlerError("LIST_FAILED", "failed to list EC2 instances", err)
 	}
@@ -54,6 +85,7 @@ func (h *EC2InstancesHandler) List(ctx context.Context, opts ListOptions) (*List
 		resource := &EC2InstanceResource{
 			instance: inst,
 			region:   h.region,
+			showCost: h.showCost,
 		}
 
 		// Apply filter if specified
@@ -87,6 +119,7 @@ func (h *EC2InstancesHandler) Get(ctx context.Context, id string) (Resource, err
 	return &EC2InstanceResource{
 		instance: *inst,
 		region:   h.region,
+		showCost: h.showCost,
 	}, nil
 }
 
@@ -131,20 +164,49 @@ func (h *EC2InstancesHandler) Describe(ctx context.Context, id string) (map[stri
 		details["IAMInstanceProfile"] = inst.IAMRole
 	}
 
+	if inst.MetadataTokens != "" {
+		details["MetadataOptions"] = map[string]interface{}{
+			"HttpEndpoint":            inst.MetadataEndpoint,
+			"HttpTokens":              inst.MetadataTokens,
+			"HttpPutResponseHopLimit": inst.MetadataHopLimit,
+		}
+	}
+
+	// Health
+	details["StatusChecks"] = h.statusChecks(ctx, id)
+	details["Metrics"] = h.recentMetrics(ctx, id)
+
 	// Tags
 	if len(inst.Tags) > 0 {
 		details["Tags"] = inst.Tags
 	}
 
+	// Estimated cost
+	if h.showCost {
+		if cost, ok := EstimateEC2MonthlyCost(inst.InstanceType); ok {
+			details["EstimatedCost"] = map[string]interface{}{
+				"OnDemandMonthly": fmt.Sprintf("$%.2f", cost),
+				"Basis":           "us-east-1 Linux on-demand list price, 730h/month",
+			}
+		}
+	}
+
 	return details, nil
 }
 
 func (h *EC2InstancesHandler) Actions() []Action {
 	return []Action{
-		{Key: "s", Name: "start", Description: "Start instance"},
-		{Key: "S", Name: "stop", Description: "Stop instance"},
-		{Key: "r", Name: "reboot", Description: "Reboot instance"},
+		{Key: "s", Name: "start", Description: "Start instance", Mutating: true},
+		{Key: "S", Name: "stop", Description: "Stop instance", Mutating: true},
+		{Key: "r", Name: "reboot", Description: "Reboot instance", Mutating: true},
+		{Key: "x", Name: "terminate", Description: "Terminate instance", Mutating: true, Severity: SeverityCritical},
 		{Key: "c", Name: "connect", Description: "Connection info"},
+		{Key: "v", Name: "console", Description: "Console output"},
+		{Key: "V", Name: "screenshot", Description: "Save console screenshot"},
+		{Key: "u", Name: "userdata", Description: "User data"},
+		{Key: "L", Name: "launchtemplate", Description: "Launch template"},
+		{Key: "f", Name: "flowlogs", Description: "View flow logs"},
+		{Key: "P", Name: "reachability", Description: "Analyze path (source, then destination)", Mutating: true},
 	}
 }
 
@@ -162,10 +224,73 @@ func (h *EC2InstancesHandler) ExecuteAction(ctx context.Context, action string,
 		return &RebootInstanceAction{
 			InstanceID: resourceID,
 		}
+	case "terminate":
+		inst, err := h.client.GetInstance(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		if inst.State == "shutting-down" || inst.State == "terminated" {
+			return fmt.Errorf("instance %s is already %s", resourceID, inst.State)
+		}
+
+		protected, err := h.client.GetTerminationProtection(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		if protected {
+			return fmt.Errorf("termination protection is enabled on %s, disable it first", resourceID)
+		}
+
+		return &TerminateInstanceAction{
+			InstanceID: resourceID,
+			Name:       inst.Name,
+			State:      inst.State,
+		}
 	case "connect":
 		return &ViewConnectionInfoAction{
 			InstanceID: resourceID,
 		}
+	case "console":
+		return h.consoleOutput(ctx, resourceID)
+	case "screenshot":
+		return h.consoleScreenshot(ctx, resourceID)
+	case "userdata":
+		userData, err := h.client.GetUserData(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		if userData == "" {
+			return fmt.Errorf("instance %s has no user data", resourceID)
+		}
+		return &ShowUserDataAction{Source: resourceID, UserData: userData}
+	case "launchtemplate":
+		inst, err := h.client.GetInstance(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		// EC2 tags the instances it launches from a template with the template and version
+		templateID := inst.Tags[ec2adapter.LaunchTemplateIDTag]
+		if templateID == "" {
+			return fmt.Errorf("instance %s wasn't launched from a launch template", resourceID)
+		}
+		return &NavigateToLaunchTemplateVersionsAction{
+			TemplateID: templateID,
+			Version:    inst.Tags[ec2adapter.LaunchTemplateVersionTag],
+		}
+	case "flowlogs":
+		enis, err := h.enis.ListInstanceNetworkInterfaces(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		if len(enis) == 0 {
+			return fmt.Errorf("instance %s has no network interfaces", resourceID)
+		}
+		return flowLogsRequest(ctx, h.enis, h.region, resourceID, enis)
+	case "reachability":
+		return &ReachabilityEndpointAction{
+			ResourceID: resourceID,
+			Region:     h.region,
+		}
 	default:
 		return ErrNotSupported
 	}
@@ -188,6 +313,11 @@ func (h *EC2InstancesHandler) RebootInstance(ctx context.Context, instanceID str
 	return h.client.RebootInstance(ctx, instanceID)
 }
 
+// TerminateInstance terminates an EC2 instance
+func (h *EC2InstancesHandler) TerminateInstance(ctx context.Context, instanceID string) error {
+	return h.client.TerminateInstance(ctx, instanceID)
+}
+
 // GetConnectionInfo retrieves connection information for an instance
 func (h *EC2InstancesHandler) GetConnectionInfo(ctx context.Context, instanceID string) (map[string]interface{}, error) {
 	return h.client.GetInstanceConnectionInfo(ctx, instanceID)
@@ -228,6 +358,20 @@ func (a *RebootInstanceAction) Error() string {
 
 func (a *RebootInstanceAction) IsActionMsg() {}
 
+// TerminateInstanceAction triggers the terminate confirmation for an instance
+// whose termination protection is off
+type TerminateInstanceAction struct {
+	InstanceID string
+	Name       string
+	State      string
+}
+
+func (a *TerminateInstanceAction) Error() string {
+	return fmt.Sprintf("terminate instance %s", a.InstanceID)
+}
+
+func (a *TerminateInstanceAction) IsActionMsg() {}
+
 // ViewConnectionInfoAction triggers viewing connection info
 type ViewConnectionInfoAction struct {
 	InstanceID string
@@ -243,9 +387,10 @@ func (a *ViewConnectionInfoAction) IsActionMsg() {}
 type EC2InstanceResource struct {
 	instance ec2adapter.Instance
 	region   string
+	showCost bool
 }
 
-func (r *EC2InstanceResource) GetID() string   { return r.instance.InstanceID }
+func (r *EC2InstanceResource) GetID() string { return r.instance.InstanceID }
 func (r *EC2InstanceResource) GetName() string {
 	if r.instance.Name != "" {
 		return r.instance.Name
@@ -282,7 +427,7 @@ func (r *EC2InstanceResource) ToTableRow() []string {
 		privateIP = "-"
 	}
 
-	return []string{
+	row := []string{
 		name,
 		r.instance.InstanceID,
 		r.instance.State,
@@ -291,6 +436,17 @@ func (r *EC2InstanceResource) ToTableRow() []string {
 		publicIP,
 		r.instance.AvailabilityZone,
 	}
+
+	if r.showCost {
+		// Stopped instances don't accrue compute charges
+		cost := "-"
+		if r.instance.State == "running" || r.instance.State == "pending" {
+			cost = formatMonthlyEstimate(EstimateEC2MonthlyCost(r.instance.InstanceType))
+		}
+		row = append(row, cost)
+	}
+
+	return row
 }
 
 func (r *EC2InstanceResource) ToDetailMap() map[string]interface{} {
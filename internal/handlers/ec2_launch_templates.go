@@ -0,0 +1,491 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// NavigateToLaunchTemplateVersionsAction is returned by ExecuteAction to trigger
+// navigation to the versions of a launch template, selecting Version when it is set
+type NavigateToLaunchTemplateVersionsAction struct {
+	TemplateID   string
+	TemplateName string
+	Version      string
+}
+
+func (a *NavigateToLaunchTemplateVersionsAction) Error() string {
+	return fmt.Sprintf("navigate to versions of launch template %s", a.TemplateID)
+}
+
+func (a *NavigateToLaunchTemplateVersionsAction) IsActionMsg() {}
+
+// ShowUserDataAction is returned by ExecuteAction with the decoded user data of an
+// instance or launch template version
+type ShowUserDataAction struct {
+	Source   string
+	UserData string
+}
+
+func (a *ShowUserDataAction) Error() string {
+	return fmt.Sprintf("user data of %s", a.Source)
+}
+
+func (a *ShowUserDataAction) IsActionMsg() {}
+
+// EC2LaunchTemplatesHandler handles EC2 launch templates
+type EC2LaunchTemplatesHandler struct {
+	BaseHandler
+	ec2Tagging
+	client *ec2adapter.LaunchTemplatesClient
+	region string
+}
+
+// NewEC2LaunchTemplatesHandler creates a new launch templates handler
+func NewEC2LaunchTemplatesHandler(ec2Client *ec2.Client, region string) *EC2LaunchTemplatesHandler {
+	return &EC2LaunchTemplatesHandler{
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewLaunchTemplatesClient(ec2Client),
+		region:     region,
+	}
+}
+
+func (h *EC2LaunchTemplatesHandler) ResourceType() string { return "ec2:launch-templates" }
+func (h *EC2LaunchTemplatesHandler) ResourceName() string { return "Launch Templates" }
+func (h *EC2LaunchTemplatesHandler) ResourceIcon() string { return "🚀" }
+func (h *EC2LaunchTemplatesHandler) ShortcutKey() string  { return "launch-templates" }
+
+func (h *EC2LaunchTemplatesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 36, Sortable: true},
+		{Title: "Template ID", Width: 22, Sortable: false},
+		{Title: "Default", Width: 8, Sortable: true},
+		{Title: "Latest", Width: 8, Sortable: true},
+		{Title: "Created", Width: 20, Sortable: true},
+		{Title: "Created By", Width: 40, Sortable: true},
+	}
+}
+
+func (h *EC2LaunchTemplatesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	templates, err := h.client.ListLaunchTemplates(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list launch templates", err)
+	}
+
+	resources := make([]Resource, 0, len(templates))
+	for _, lt := range templates {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(lt.Name), filter) &&
+				!strings.Contains(strings.ToLower(lt.TemplateID), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &LaunchTemplateResource{
+			template: lt,
+			region:   h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *EC2LaunchTemplatesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	lt, err := h.client.GetLaunchTemplate(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get launch template %s", id), err)
+	}
+	return &LaunchTemplateResource{template: *lt, region: h.region}, nil
+}
+
+func (h *EC2LaunchTemplatesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe launch template %s", id), err)
+	}
+
+	details := map[string]interface{}{
+		"LaunchTemplate": res.ToDetailMap(),
+	}
+
+	// The default version is what launches use unless they ask for another
+	version, err := h.client.GetVersion(ctx, id, "$Default")
+	if err != nil {
+		details["DefaultVersion"] = map[string]interface{}{"Error": err.Error()}
+	} else {
+		details["DefaultVersion"] = launchTemplateVersionDetails(version)
+	}
+
+	if tags := res.GetTags(); len(tags) > 0 {
+		details["Tags"] = tags
+	}
+	return details, nil
+}
+
+func (h *EC2LaunchTemplatesHandler) Actions() []Action {
+	return []Action{
+		{Key: "v", Name: "versions", Description: "Versions"},
+		{Key: "u", Name: "userdata", Description: "Default version user data"},
+	}
+}
+
+func (h *EC2LaunchTemplatesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "versions":
+		lt, err := h.client.GetLaunchTemplate(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return &NavigateToLaunchTemplateVersionsAction{TemplateID: lt.TemplateID, TemplateName: lt.Name}
+	case "userdata":
+		version, err := h.client.GetVersion(ctx, resourceID, "$Default")
+		if err != nil {
+			return err
+		}
+		return versionUserData(version)
+	default:
+		return ErrNotSupported
+	}
+}
+
+// LaunchTemplateResource implements Resource interface for launch templates
+type LaunchTemplateResource struct {
+	template ec2adapter.LaunchTemplate
+	region   string
+}
+
+func (r *LaunchTemplateResource) GetID() string   { return r.template.TemplateID }
+func (r *LaunchTemplateResource) GetName() string { return r.template.Name }
+
+// GetARN returns an ARN without the account ID, which the launch template API doesn't return
+func (r *LaunchTemplateResource) GetARN() string {
+	return fmt.Sprintf("arn:aws:ec2:%s::launch-template/%s", r.region, r.template.TemplateID)
+}
+func (r *LaunchTemplateResource) GetType() string         { return "ec2:launch-templates" }
+func (r *LaunchTemplateResource) GetRegion() string       { return r.region }
+func (r *LaunchTemplateResource) GetCreatedAt() time.Time { return r.template.CreatedAt }
+
+func (r *LaunchTemplateResource) GetTags() map[string]string {
+	return r.template.Tags
+}
+
+func (r *LaunchTemplateResource) ToTableRow() []string {
+	return []string{
+		r.template.Name,
+		r.template.TemplateID,
+		strconv.FormatInt(r.template.DefaultVersion, 10),
+		strconv.FormatInt(r.template.LatestVersion, 10),
+		r.template.CreatedAt.Format("2006-01-02 15:04:05"),
+		r.template.CreatedBy,
+	}
+}
+
+func (r *LaunchTemplateResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"LaunchTemplateId":   r.template.TemplateID,
+		"LaunchTemplateName": r.template.Name,
+		"DefaultVersion":     r.template.DefaultVersion,
+		"LatestVersion":      r.template.LatestVersion,
+		"CreatedBy":          r.template.CreatedBy,
+		"CreatedAt":          r.template.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// EC2LaunchTemplateVersionsHandler handles the versions of a launch template
+type EC2LaunchTemplateVersionsHandler struct {
+	BaseHandler
+	client       *ec2adapter.LaunchTemplatesClient
+	region       string
+	templateID   string
+	templateName string
+
+	// Versions from the last List, newest first, used to find the previous and default
+	// versions
+	versions []ec2adapter.LaunchTemplateVersion
+}
+
+// NewEC2LaunchTemplateVersionsHandler creates a new versions handler for a launch template
+func NewEC2LaunchTemplateVersionsHandler(ec2Client *ec2.Client, region, templateID, templateName string) *EC2LaunchTemplateVersionsHandler {
+	return &EC2LaunchTemplateVersionsHandler{
+		client:       ec2adapter.NewLaunchTemplatesClient(ec2Client),
+		region:       region,
+		templateID:   templateID,
+		templateName: templateName,
+	}
+}
+
+func (h *EC2LaunchTemplateVersionsHandler) ResourceType() string {
+	return "ec2:launch-template-versions"
+}
+func (h *EC2LaunchTemplateVersionsHandler) ResourceName() string { return "Launch Template Versions" }
+func (h *EC2LaunchTemplateVersionsHandler) ResourceIcon() string { return "🚀" }
+func (h *EC2LaunchTemplateVersionsHandler) ShortcutKey() string  { return "launch-template-versions" }
+
+func (h *EC2LaunchTemplateVersionsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Version", Width: 8, Sortable: true},
+		{Title: "Default", Width: 8, Sortable: true},
+		{Title: "Description", Width: 36, Sortable: true},
+		{Title: "AMI", Width: 22, Sortable: true},
+		{Title: "Type", Width: 12, Sortable: true},
+		{Title: "Created", Width: 20, Sortable: true},
+		{Title: "Created By", Width: 40, Sortable: true},
+	}
+}
+
+func (h *EC2LaunchTemplateVersionsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	versions, err := h.client.ListVersions(ctx, h.templateID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list versions of %s", h.templateID), err)
+	}
+	h.versions = versions
+	// Navigating from an instance only knows the template's ID
+	if h.templateName == "" && len(versions) > 0 {
+		h.templateName = versions[0].TemplateName
+	}
+
+	resources := make([]Resource, 0, len(versions))
+	for _, v := range versions {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(v.Description), filter) &&
+				!strings.Contains(strings.ToLower(v.ImageID), filter) &&
+				!strings.Contains(strings.ToLower(v.InstanceType), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &LaunchTemplateVersionResource{
+			version: v,
+			region:  h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+// versionNumber returns the version number of a resource ID, <template ID>:<version>
+func (h *EC2LaunchTemplateVersionsHandler) versionNumber(id string) string {
+	return id[strings.LastIndex(id, ":")+1:]
+}
+
+func (h *EC2LaunchTemplateVersionsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	version, err := h.client.GetVersion(ctx, h.templateID, h.versionNumber(id))
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get launch template version %s", id), err)
+	}
+	return &LaunchTemplateVersionResource{version: *version, region: h.region}, nil
+}
+
+func (h *EC2LaunchTemplateVersionsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	version, err := h.client.GetVersion(ctx, h.templateID, h.versionNumber(id))
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe launch template version %s", id), err)
+	}
+	return launchTemplateVersionDetails(version), nil
+}
+
+func (h *EC2LaunchTemplateVersionsHandler) Actions() []Action {
+	return []Action{
+		{Key: "p", Name: "diff_previous", Description: "diff prev"},
+		{Key: "D", Name: "diff_default", Description: "diff default"},
+		{Key: "u", Name: "userdata", Description: "User data"},
+	}
+}
+
+func (h *EC2LaunchTemplateVersionsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	number, err := strconv.ParseInt(h.versionNumber(resourceID), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid launch template version %s", resourceID)
+	}
+
+	switch action {
+	case "diff_previous":
+		// Versions can be deleted, so diff against the next older one that exists
+		for _, v := range h.versions {
+			if v.Version < number {
+				return h.diff(ctx, v.Version, number)
+			}
+		}
+		return fmt.Errorf("no version older than %d", number)
+	case "diff_default":
+		for _, v := range h.versions {
+			if v.Default {
+				if v.Version == number {
+					return fmt.Errorf("version %d is the default version", number)
+				}
+				return h.diff(ctx, v.Version, number)
+			}
+		}
+		return fmt.Errorf("the default version of %s isn't listed", h.templateID)
+	case "userdata":
+		version, err := h.client.GetVersion(ctx, h.templateID, strconv.FormatInt(number, 10))
+		if err != nil {
+			return err
+		}
+		return versionUserData(version)
+	default:
+		return ErrNotSupported
+	}
+}
+
+// diff shows how a version differs from another, the older one on the left
+func (h *EC2LaunchTemplateVersionsHandler) diff(ctx context.Context, other, version int64) error {
+	left, right := other, version
+	if left > right {
+		left, right = right, left
+	}
+
+	leftVersion, err := h.client.GetVersion(ctx, h.templateID, strconv.FormatInt(left, 10))
+	if err != nil {
+		return err
+	}
+	rightVersion, err := h.client.GetVersion(ctx, h.templateID, strconv.FormatInt(right, 10))
+	if err != nil {
+		return err
+	}
+
+	return &ShowDiffAction{
+		LeftName:  fmt.Sprintf("%s:%d", h.templateName, left),
+		RightName: fmt.Sprintf("%s:%d", h.templateName, right),
+		Left:      launchTemplateVersionDetails(leftVersion),
+		Right:     launchTemplateVersionDetails(rightVersion),
+	}
+}
+
+// launchTemplateVersionDetails describes a version and the settings it launches
+// instances with. User data is split into lines so diffs show the lines that changed.
+func launchTemplateVersionDetails(v *ec2adapter.LaunchTemplateVersion) map[string]interface{} {
+	details := map[string]interface{}{
+		"Version": map[string]interface{}{
+			"LaunchTemplateId":   v.TemplateID,
+			"LaunchTemplateName": v.TemplateName,
+			"VersionNumber":      v.Version,
+			"Description":        v.Description,
+			"DefaultVersion":     v.Default,
+			"CreatedBy":          v.CreatedBy,
+			"CreatedAt":          v.CreatedAt.Format(time.RFC3339),
+		},
+	}
+
+	instance := map[string]interface{}{
+		"ImageId":      v.ImageID,
+		"InstanceType": v.InstanceType,
+		"KeyName":      v.KeyName,
+		"Monitoring":   v.Monitoring,
+		"EbsOptimized": v.EBSOptimized,
+		"Spot":         v.SpotInstances,
+	}
+	if v.IAMInstanceProfile != "" {
+		instance["IamInstanceProfile"] = v.IAMInstanceProfile
+	}
+	if v.MetadataTokens != "" {
+		instance["MetadataTokens"] = v.MetadataTokens
+	}
+	if v.MetadataHopLimit != 0 {
+		instance["MetadataHopLimit"] = v.MetadataHopLimit
+	}
+	details["LaunchTemplateData"] = instance
+
+	if len(v.SecurityGroups) > 0 || len(v.NetworkInterfaces) > 0 {
+		network := map[string]interface{}{}
+		if len(v.SecurityGroups) > 0 {
+			network["SecurityGroups"] = v.SecurityGroups
+		}
+		if len(v.NetworkInterfaces) > 0 {
+			network["NetworkInterfaces"] = v.NetworkInterfaces
+		}
+		details["Network"] = network
+	}
+	if len(v.BlockDevices) > 0 {
+		details["BlockDeviceMappings"] = v.BlockDevices
+	}
+	if len(v.TagSpecifications) > 0 {
+		details["TagSpecifications"] = v.TagSpecifications
+	}
+	if v.UserData != "" {
+		details["UserData"] = strings.Split(strings.TrimRight(v.UserData, "\n"), "\n")
+	}
+	return details
+}
+
+// versionUserData shows the user data of a launch template version
+func versionUserData(v *ec2adapter.LaunchTemplateVersion) error {
+	source := fmt.Sprintf("%s version %d", v.TemplateName, v.Version)
+	if v.UserData == "" {
+		return fmt.Errorf("%s has no user data", source)
+	}
+	return &ShowUserDataAction{Source: source, UserData: v.UserData}
+}
+
+// LaunchTemplateVersionResource implements Resource interface for launch template versions
+type LaunchTemplateVersionResource struct {
+	version ec2adapter.LaunchTemplateVersion
+	region  string
+}
+
+func (r *LaunchTemplateVersionResource) GetID() string {
+	return fmt.Sprintf("%s:%d", r.version.TemplateID, r.version.Version)
+}
+func (r *LaunchTemplateVersionResource) GetName() string {
+	return fmt.Sprintf("%s:%d", r.version.TemplateName, r.version.Version)
+}
+
+// GetARN returns the template's ARN without the account ID, which the launch template
+// API doesn't return
+func (r *LaunchTemplateVersionResource) GetARN() string {
+	return fmt.Sprintf("arn:aws:ec2:%s::launch-template/%s", r.region, r.version.TemplateID)
+}
+func (r *LaunchTemplateVersionResource) GetType() string         { return "ec2:launch-template-versions" }
+func (r *LaunchTemplateVersionResource) GetRegion() string       { return r.region }
+func (r *LaunchTemplateVersionResource) GetCreatedAt() time.Time { return r.version.CreatedAt }
+func (r *LaunchTemplateVersionResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *LaunchTemplateVersionResource) ToTableRow() []string {
+	isDefault := ""
+	if r.version.Default {
+		isDefault = "yes"
+	}
+	description := r.version.Description
+	if description == "" {
+		description = "-"
+	}
+
+	return []string{
+		strconv.FormatInt(r.version.Version, 10),
+		isDefault,
+		description,
+		r.version.ImageID,
+		r.version.InstanceType,
+		r.version.CreatedAt.Format("2006-01-02 15:04:05"),
+		r.version.CreatedBy,
+	}
+}
+
+func (r *LaunchTemplateVersionResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"LaunchTemplateId": r.version.TemplateID,
+		"VersionNumber":    r.version.Version,
+		"Description":      r.version.Description,
+		"DefaultVersion":   r.version.Default,
+		"ImageId":          r.version.ImageID,
+		"InstanceType":     r.version.InstanceType,
+	}
+}
@@ -23,6 +23,22 @@ func (a *NavigateToServicesAction) Error() string {
 
 func (a *NavigateToServicesAction) IsActionMsg() {}
 
+// ContainerInsightsAction is returned by ExecuteAction to chart the Container Insights
+// metrics of a cluster, or of one of its services
+type ContainerInsightsAction struct {
+	ClusterName string
+	ServiceName string // Empty for the whole cluster
+}
+
+func (a *ContainerInsightsAction) Error() string {
+	if a.ServiceName != "" {
+		return fmt.Sprintf("container insights for service %s", a.ServiceName)
+	}
+	return fmt.Sprintf("container insights for cluster %s", a.ClusterName)
+}
+
+func (a *ContainerInsightsAction) IsActionMsg() {}
+
 // NavigateToTasksAction is returned by ExecuteAction to trigger navigation to tasks
 type NavigateToTasksAction struct {
 	ClusterARN  string
@@ -173,6 +189,7 @@ func (h *ECSClustersHandler) Actions() []Action {
 	return []Action{
 		{Key: "s", Name: "services", Description: "services"},
 		{Key: "t", Name: "tasks", Description: "tasks"},
+		{Key: "i", Name: "insights", Description: "Container Insights"},
 	}
 }
 
@@ -183,6 +200,8 @@ func (h *ECSClustersHandler) ExecuteAction(ctx context.Context, action string, r
 	}
 
 	switch action {
+	case "insights":
+		return &ContainerInsightsAction{ClusterName: cluster.GetName()}
 	case "services":
 		return &NavigateToServicesAction{
 			ClusterARN:  cluster.GetARN(),
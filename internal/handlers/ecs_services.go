@@ -38,10 +38,10 @@ func (h *ECSServicesHandler) ShortcutKey() string  { return "ecs-services" }
 func (h *ECSServicesHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Service Name", Width: 30, Sortable: true},
-		{Title: "Status", Width: 12, Sortable: true},
-		{Title: "Desired", Width: 10, Sortable: false},
-		{Title: "Running", Width: 10, Sortable: false},
-		{Title: "Pending", Width: 10, Sortable: false},
+		{Title: "Status", Width: 12, Sortable: true, Priority: 1},
+		{Title: "Desired", Width: 10, Sortable: true, SortType: SortNumber},
+		{Title: "Running", Width: 10, Sortable: true, SortType: SortNumber},
+		{Title: "Pending", Width: 10, Sortable: true, SortType: SortNumber},
 		{Title: "Launch Type", Width: 12, Sortable: false},
 		{Title: "Task Definition", Width: 40, Sortable: false},
 	}
@@ -144,25 +144,51 @@ func (h *ECSServicesHandler) Describe(ctx context.Context, id string) (map[strin
 func (h *ECSServicesHandler) Actions() []Action {
 	return []Action{
 		{Key: "t", Name: "tasks", Description: "tasks"},
+		{Key: "f", Name: "taskdefs", Description: "task def revisions"},
+		{Key: "i", Name: "insights", Description: "Container Insights"},
 	}
 }
 
 func (h *ECSServicesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
-	if action != "tasks" {
-		return ErrNotSupported
-	}
+	switch action {
+	case "insights":
+		service, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
 
-	service, err := h.Get(ctx, resourceID)
-	if err != nil {
-		return err
-	}
+		// Container Insights names the cluster, where the list may have been opened by ARN
+		clusterName := h.clusterName[strings.LastIndex(h.clusterName, "/")+1:]
+		return &ContainerInsightsAction{ClusterName: clusterName, ServiceName: service.GetName()}
+	case "tasks":
+		service, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+
+		return &NavigateToTasksAction{
+			ClusterARN:  h.clusterARN,
+			ClusterName: h.clusterName,
+			ServiceARN:  service.GetARN(),
+			ServiceName: service.GetName(),
+		}
+
+	case "taskdefs":
+		resource, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
 
-	return &NavigateToTasksAction{
-		ClusterARN:  h.clusterARN,
-		ClusterName: h.clusterName,
-		ServiceARN:  service.GetARN(),
-		ServiceName: service.GetName(),
+		serviceResource, ok := resource.(*ECSServiceResource)
+		if !ok || serviceResource.service.TaskDefinition == "" {
+			return fmt.Errorf("service %s has no task definition", resourceID)
+		}
+
+		family, _ := ecsadapter.ParseTaskDefinitionARN(serviceResource.service.TaskDefinition)
+		return &NavigateToTaskDefinitionsAction{Family: family}
 	}
+
+	return ErrNotSupported
 }
 
 // ECSServiceResource implements Resource interface for ECS services
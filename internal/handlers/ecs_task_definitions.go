@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	ecsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ecs"
+)
+
+// NavigateToTaskDefinitionsAction is returned by ExecuteAction to trigger navigation to
+// the revisions of a task definition family
+type NavigateToTaskDefinitionsAction struct {
+	Family string
+}
+
+func (a *NavigateToTaskDefinitionsAction) Error() string {
+	return fmt.Sprintf("navigate to task definition revisions for %s", a.Family)
+}
+
+func (a *NavigateToTaskDefinitionsAction) IsActionMsg() {}
+
+// ShowDiffAction is returned by ExecuteAction to show a diff of two described resources
+type ShowDiffAction struct {
+	LeftName  string
+	RightName string
+	Left      map[string]interface{}
+	Right     map[string]interface{}
+}
+
+func (a *ShowDiffAction) Error() string {
+	return fmt.Sprintf("diff %s against %s", a.LeftName, a.RightName)
+}
+
+func (a *ShowDiffAction) IsActionMsg() {}
+
+// ECSTaskDefinitionsHandler handles the revisions of an ECS task definition family
+type ECSTaskDefinitionsHandler struct {
+	BaseHandler
+	client *ecsadapter.TaskDefinitionsClient
+	region string
+	family string
+
+	// Revisions from the last List, newest first, used to find the previous revision
+	revisions []ecsadapter.TaskDefinitionRevision
+}
+
+// NewECSTaskDefinitionsHandlerForFamily creates a new task definitions handler for a family
+func NewECSTaskDefinitionsHandlerForFamily(ecsClient *ecs.Client, region, family string) *ECSTaskDefinitionsHandler {
+	return &ECSTaskDefinitionsHandler{
+		client: ecsadapter.NewTaskDefinitionsClient(ecsClient),
+		region: region,
+		family: family,
+	}
+}
+
+func (h *ECSTaskDefinitionsHandler) ResourceType() string { return "ecs:taskdefinitions" }
+func (h *ECSTaskDefinitionsHandler) ResourceName() string { return "ECS Task Definitions" }
+func (h *ECSTaskDefinitionsHandler) ResourceIcon() string { return "📜" }
+func (h *ECSTaskDefinitionsHandler) ShortcutKey() string  { return "ecs-taskdefs" }
+
+func (h *ECSTaskDefinitionsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Revision", Width: 10, Sortable: true},
+		{Title: "Family", Width: 30, Sortable: true},
+		{Title: "Status", Width: 10, Sortable: true},
+		{Title: "ARN", Width: 70, Sortable: false},
+	}
+}
+
+func (h *ECSTaskDefinitionsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	revisions, err := h.client.ListRevisions(ctx, h.family)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list revisions of %s", h.family), err)
+	}
+	h.revisions = revisions
+
+	resources := make([]Resource, 0, len(revisions))
+	for _, rev := range revisions {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(rev.ARN), filter) &&
+				!strings.Contains(strings.ToLower(rev.Status), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &ECSTaskDefinitionResource{
+			revision: rev,
+			region:   h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *ECSTaskDefinitionsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	td, err := h.client.DescribeTaskDefinition(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get task definition %s", id), err)
+	}
+
+	return &ECSTaskDefinitionResource{
+		revision: ecsadapter.TaskDefinitionRevision{
+			ARN:      td.ARN,
+			Family:   td.Family,
+			Revision: td.Revision,
+			Status:   td.Status,
+		},
+		definition: td,
+		region:     h.region,
+	}, nil
+}
+
+func (h *ECSTaskDefinitionsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	td, err := h.client.DescribeTaskDefinition(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe task definition %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	// Basic info
+	details["TaskDefinition"] = map[string]interface{}{
+		"Family":       td.Family,
+		"Revision":     td.Revision,
+		"Arn":          td.ARN,
+		"Status":       td.Status,
+		"RegisteredAt": td.RegisteredAt,
+		"RegisteredBy": td.RegisteredBy,
+	}
+	if td.DeregisteredAt != "" {
+		details["TaskDefinition"].(map[string]interface{})["DeregisteredAt"] = td.DeregisteredAt
+	}
+
+	// Task size and networking
+	details["Resources"] = map[string]interface{}{
+		"Cpu":                     td.CPU,
+		"Memory":                  td.Memory,
+		"NetworkMode":             td.NetworkMode,
+		"RequiresCompatibilities": strings.Join(td.RequiresCompatibilities, ", "),
+	}
+
+	// IAM roles
+	details["Roles"] = map[string]interface{}{
+		"TaskRoleArn":      td.TaskRoleARN,
+		"ExecutionRoleArn": td.ExecutionRoleARN,
+	}
+
+	// Container definitions
+	containers := make([]map[string]interface{}, 0, len(td.ContainerDefinitions))
+	for _, cd := range td.ContainerDefinitions {
+		c := map[string]interface{}{
+			"Name":      cd.Name,
+			"Image":     cd.Image,
+			"Essential": cd.Essential,
+		}
+		if cd.CPU != 0 {
+			c["Cpu"] = cd.CPU
+		}
+		if cd.Memory != 0 {
+			c["Memory"] = cd.Memory
+		}
+		if cd.MemoryReservation != 0 {
+			c["MemoryReservation"] = cd.MemoryReservation
+		}
+		if len(cd.PortMappings) > 0 {
+			c["PortMappings"] = strings.Join(cd.PortMappings, ", ")
+		}
+		if len(cd.Command) > 0 {
+			c["Command"] = strings.Join(cd.Command, " ")
+		}
+		if len(cd.EntryPoint) > 0 {
+			c["EntryPoint"] = strings.Join(cd.EntryPoint, " ")
+		}
+		if len(cd.Environment) > 0 {
+			c["Environment"] = cd.Environment
+		}
+		if len(cd.Secrets) > 0 {
+			c["Secrets"] = cd.Secrets
+		}
+		if cd.LogDriver != "" {
+			c["LogDriver"] = cd.LogDriver
+			if len(cd.LogOptions) > 0 {
+				c["LogOptions"] = cd.LogOptions
+			}
+		}
+		containers = append(containers, c)
+	}
+	details["ContainerDefinitions"] = containers
+
+	// Tags
+	if len(td.Tags) > 0 {
+		details["Tags"] = td.Tags
+	}
+
+	return details, nil
+}
+
+func (h *ECSTaskDefinitionsHandler) Actions() []Action {
+	return []Action{
+		{Key: "p", Name: "diff_previous", Description: "diff prev"},
+	}
+}
+
+func (h *ECSTaskDefinitionsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "diff_previous" {
+		return ErrNotSupported
+	}
+
+	_, revision := ecsadapter.ParseTaskDefinitionARN(resourceID)
+
+	// Revisions can be deleted, so diff against the next older one that exists
+	var previous *ecsadapter.TaskDefinitionRevision
+	for i := range h.revisions {
+		if h.revisions[i].Revision < revision {
+			previous = &h.revisions[i]
+			break
+		}
+	}
+	if previous == nil {
+		return fmt.Errorf("no revision older than %s", resourceID)
+	}
+
+	previousID := fmt.Sprintf("%s:%d", previous.Family, previous.Revision)
+
+	left, err := h.Describe(ctx, previousID)
+	if err != nil {
+		return err
+	}
+	right, err := h.Describe(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	return &ShowDiffAction{
+		LeftName:  previousID,
+		RightName: resourceID,
+		Left:      left,
+		Right:     right,
+	}
+}
+
+// ECSTaskDefinitionResource implements Resource interface for ECS task definition revisions
+type ECSTaskDefinitionResource struct {
+	revision   ecsadapter.TaskDefinitionRevision
+	definition *ecsadapter.TaskDefinition // Only set when fetched with Get
+	region     string
+}
+
+func (r *ECSTaskDefinitionResource) GetID() string {
+	return fmt.Sprintf("%s:%d", r.revision.Family, r.revision.Revision)
+}
+func (r *ECSTaskDefinitionResource) GetName() string   { return r.GetID() }
+func (r *ECSTaskDefinitionResource) GetARN() string    { return r.revision.ARN }
+func (r *ECSTaskDefinitionResource) GetType() string   { return "ecs:taskdefinitions" }
+func (r *ECSTaskDefinitionResource) GetRegion() string { return r.region }
+
+func (r *ECSTaskDefinitionResource) GetCreatedAt() time.Time {
+	if r.definition != nil && r.definition.RegisteredAt != "" {
+		t, err := time.Parse("2006-01-02 15:04:05", r.definition.RegisteredAt)
+		if err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (r *ECSTaskDefinitionResource) GetTags() map[string]string {
+	if r.definition != nil {
+		return r.definition.Tags
+	}
+	return nil
+}
+
+func (r *ECSTaskDefinitionResource) ToTableRow() []string {
+	return []string{
+		fmt.Sprintf("%d", r.revision.Revision),
+		r.revision.Family,
+		r.revision.Status,
+		r.revision.ARN,
+	}
+}
+
+func (r *ECSTaskDefinitionResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Family":   r.revision.Family,
+		"Revision": r.revision.Revision,
+		"Status":   r.revision.Status,
+		"Arn":      r.revision.ARN,
+	}
+}
@@ -16,6 +16,7 @@ import (
 type ECSTasksHandler struct {
 	BaseHandler
 	client      *ecsadapter.TasksClient
+	taskDefs    *ecsadapter.TaskDefinitionsClient
 	region      string
 	clusterARN  string
 	clusterName string
@@ -27,6 +28,7 @@ type ECSTasksHandler struct {
 func NewECSTasksHandlerForService(ecsClient *ecs.Client, region, clusterARN, clusterName, serviceARN, serviceName string) *ECSTasksHandler {
 	return &ECSTasksHandler{
 		client:      ecsadapter.NewTasksClient(ecsClient),
+		taskDefs:    ecsadapter.NewTaskDefinitionsClient(ecsClient),
 		region:      region,
 		clusterARN:  clusterARN,
 		clusterName: clusterName,
@@ -39,6 +41,7 @@ func NewECSTasksHandlerForService(ecsClient *ecs.Client, region, clusterARN, clu
 func NewECSTasksHandlerForCluster(ecsClient *ecs.Client, region, clusterARN, clusterName string) *ECSTasksHandler {
 	return &ECSTasksHandler{
 		client:      ecsadapter.NewTasksClient(ecsClient),
+		taskDefs:    ecsadapter.NewTaskDefinitionsClient(ecsClient),
 		region:      region,
 		clusterARN:  clusterARN,
 		clusterName: clusterName,
@@ -57,7 +60,7 @@ func (h *ECSTasksHandler) Columns() []ColumnDef {
 		{Title: "Launch Type", Width: 12, Sortable: false},
 		{Title: "Containers", Width: 10, Sortable: false},
 		{Title: "Exec Enabled", Width: 12, Sortable: false},
-		{Title: "Started At", Width: 20, Sortable: true},
+		{Title: "Started At", Width: 20, Sortable: true, SortType: SortDate},
 	}
 }
 
@@ -147,13 +150,20 @@ func (h *ECSTasksHandler) Describe(ctx context.Context, id string) (map[string]i
 	}
 
 	// Status
-	details["Status"] = map[string]interface{}{
+	status := map[string]interface{}{
 		"LastStatus":           task.LastStatus,
 		"DesiredStatus":        task.DesiredStatus,
 		"LaunchType":           task.LaunchType,
 		"PlatformVersion":      task.PlatformVersion,
 		"EnableExecuteCommand": task.EnableExecuteCommand,
 	}
+	if task.Group != "" {
+		status["Group"] = task.Group
+	}
+	if task.StoppedReason != "" {
+		status["StoppedReason"] = task.StoppedReason
+	}
+	details["Status"] = status
 
 	// Containers
 	if len(task.Containers) > 0 {
@@ -191,15 +201,44 @@ func (h *ECSTasksHandler) Describe(ctx context.Context, id string) (map[string]i
 
 func (h *ECSTasksHandler) Actions() []Action {
 	return []Action{
-		{Key: "x", Name: "exec", Description: "exec shell"},
+		{Key: "x", Name: "exec", Description: "exec shell", Mutating: true},
+		{Key: "f", Name: "taskdefs", Description: "task def revisions"},
+		{Key: "l", Name: "logs", Description: "tail logs"},
+		{Key: "S", Name: "stop", Description: "stop task", Dangerous: true, Mutating: true},
+		{Key: "R", Name: "restart", Description: "restart task", Dangerous: true, Mutating: true},
 	}
 }
 
 func (h *ECSTasksHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
-	if action != "exec" {
-		return ErrNotSupported
+	switch action {
+	case "exec":
+		return h.execRequest(ctx, resourceID)
+	case "logs":
+		return h.tailLogsRequest(ctx, resourceID)
+	case "stop":
+		return h.stopRequest(ctx, resourceID, false)
+	case "restart":
+		return h.stopRequest(ctx, resourceID, true)
+	case "taskdefs":
+		resource, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+
+		taskResource, ok := resource.(*ECSTaskResource)
+		if !ok {
+			return fmt.Errorf("failed to convert resource to task")
+		}
+
+		family, _ := ecsadapter.ParseTaskDefinitionARN(taskResource.task.TaskDefinitionARN)
+		return &NavigateToTaskDefinitionsAction{Family: family}
 	}
 
+	return ErrNotSupported
+}
+
+// execRequest validates a task for ECS Exec and returns the exec request
+func (h *ECSTasksHandler) execRequest(ctx context.Context, resourceID string) error {
 	// Get task details
 	resource, err := h.Get(ctx, resourceID)
 	if err != nil {
@@ -247,6 +286,152 @@ func (h *ECSTasksHandler) ExecuteAction(ctx context.Context, action string, reso
 	}
 }
 
+// stopRequest checks a task can be stopped and returns the stop request. Restarting only
+// applies to tasks of a service, which replaces them.
+func (h *ECSTasksHandler) stopRequest(ctx context.Context, resourceID string, restart bool) error {
+	resource, err := h.Get(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	taskResource, ok := resource.(*ECSTaskResource)
+	if !ok {
+		return fmt.Errorf("failed to convert resource to task")
+	}
+
+	task := taskResource.task
+	if task.DesiredStatus == "STOPPED" {
+		return fmt.Errorf("task %s is already %s", getTaskIDFromARN(task.TaskARN), strings.ToLower(task.LastStatus))
+	}
+
+	service, _ := strings.CutPrefix(task.Group, "service:")
+	if service == task.Group {
+		service = ""
+	}
+	if restart && service == "" {
+		return fmt.Errorf("task %s isn't part of a service, so nothing would replace it; press S to stop it", getTaskIDFromARN(task.TaskARN))
+	}
+
+	clusterARN := task.ClusterARN
+	if clusterARN == "" {
+		clusterARN = h.clusterARN
+	}
+	return &StopTaskAction{
+		ClusterARN: clusterARN,
+		TaskARN:    task.TaskARN,
+		Service:    service,
+		Restart:    restart,
+	}
+}
+
+// StopTask stops a task, recording reason in its details
+func (h *ECSTasksHandler) StopTask(ctx context.Context, clusterARN, taskARN, reason string) error {
+	return h.client.StopTask(ctx, clusterARN, taskARN, reason)
+}
+
+// tailLogsRequest resolves the awslogs streams of a task's containers from its task definition
+func (h *ECSTasksHandler) tailLogsRequest(ctx context.Context, resourceID string) error {
+	resource, err := h.Get(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	taskResource, ok := resource.(*ECSTaskResource)
+	if !ok {
+		return fmt.Errorf("failed to convert resource to task")
+	}
+
+	task := taskResource.task
+	td, err := h.taskDefs.DescribeTaskDefinition(ctx, task.TaskDefinitionARN)
+	if err != nil {
+		return err
+	}
+
+	taskID := getTaskIDFromARN(task.TaskARN)
+	targets := make([]LogTailTarget, 0, len(td.ContainerDefinitions))
+	for _, cd := range td.ContainerDefinitions {
+		if cd.LogDriver != "awslogs" {
+			continue
+		}
+
+		group := cd.LogOptions["awslogs-group"]
+		prefix := cd.LogOptions["awslogs-stream-prefix"]
+		if group == "" || prefix == "" {
+			// Without a prefix the stream is named after the container ID, which ECS doesn't expose
+			continue
+		}
+
+		region := cd.LogOptions["awslogs-region"]
+		if region == "" {
+			region = h.region
+		}
+
+		targets = append(targets, LogTailTarget{
+			Label:  cd.Name,
+			Group:  group,
+			Stream: fmt.Sprintf("%s/%s/%s", prefix, cd.Name, taskID),
+			Region: region,
+		})
+	}
+
+	if len(targets) == 0 {
+		return fmt.Errorf("no containers in %s:%d use the awslogs driver with a stream prefix", td.Family, td.Revision)
+	}
+
+	return &TailLogsAction{
+		Title:   fmt.Sprintf("task %s", taskID),
+		Targets: targets,
+	}
+}
+
+// LogTailTarget is a log stream to follow, or every stream of the group without one
+type LogTailTarget struct {
+	Label  string
+	Group  string
+	Stream string
+	Region string
+}
+
+// TailLogsAction is returned by ExecuteAction to open the live log tail view
+type TailLogsAction struct {
+	Title   string
+	Targets []LogTailTarget
+}
+
+func (a *TailLogsAction) Error() string {
+	return fmt.Sprintf("tail logs for %s", a.Title)
+}
+
+func (a *TailLogsAction) IsActionMsg() {}
+
+// StopTaskAction is returned by ExecuteAction to stop a task after confirmation. The
+// reason is entered in the confirmation dialog.
+type StopTaskAction struct {
+	ClusterARN string
+	TaskARN    string
+	Service    string // Service that replaces the task, empty for a standalone task
+	Restart    bool   // Stopped for the service to start a fresh one
+}
+
+func (a *StopTaskAction) Error() string {
+	return fmt.Sprintf("stop task %s", getTaskIDFromARN(a.TaskARN))
+}
+
+func (a *StopTaskAction) IsActionMsg() {}
+
+// TaskID returns the ID of the task to stop
+func (a *StopTaskAction) TaskID() string {
+	return getTaskIDFromARN(a.TaskARN)
+}
+
+// DefaultReason is the reason offered in the confirmation dialog
+func (a *StopTaskAction) DefaultReason() string {
+	if a.Restart {
+		return "Restarted from aws-tui"
+	}
+	return "Stopped from aws-tui"
+}
+
 // ExecRequestAction is returned by ExecuteAction to trigger exec
 type ExecRequestAction struct {
 	ClusterARN string
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	elbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/elb"
+)
+
+// ELBListenersHandler handles the listeners of a load balancer
+type ELBListenersHandler struct {
+	BaseHandler
+	client *elbadapter.LoadBalancersClient
+	region string
+	lbARN  string
+}
+
+// NewELBListenersHandlerForLoadBalancer creates a new listeners handler for a load balancer
+func NewELBListenersHandlerForLoadBalancer(elbClient *elbv2.Client, region, lbARN string) *ELBListenersHandler {
+	return &ELBListenersHandler{
+		client: elbadapter.NewLoadBalancersClient(elbClient),
+		region: region,
+		lbARN:  lbARN,
+	}
+}
+
+func (h *ELBListenersHandler) ResourceType() string { return "elb:listeners" }
+func (h *ELBListenersHandler) ResourceName() string { return "Listeners" }
+func (h *ELBListenersHandler) ResourceIcon() string { return "👂" }
+func (h *ELBListenersHandler) ShortcutKey() string  { return "elb-listeners" }
+
+func (h *ELBListenersHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Protocol", Width: 10, Sortable: true},
+		{Title: "Port", Width: 8, Sortable: true},
+		{Title: "Default Action", Width: 50, Sortable: false},
+		{Title: "Certificates", Width: 12, Sortable: false},
+		{Title: "SSL Policy", Width: 36, Sortable: false},
+	}
+}
+
+func (h *ELBListenersHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	listeners, err := h.client.ListListeners(ctx, h.lbARN)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list listeners", err)
+	}
+
+	resources := make([]Resource, 0, len(listeners))
+	for _, listener := range listeners {
+		resource := &ListenerResource{
+			listener: listener,
+			region:   h.region,
+		}
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			fields := strings.ToLower(fmt.Sprintf("%s %d %s", listener.Protocol, listener.Port, strings.Join(listener.DefaultActions, " ")))
+			if !strings.Contains(fields, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *ELBListenersHandler) Get(ctx context.Context, id string) (Resource, error) {
+	listeners, err := h.client.ListListeners(ctx, h.lbARN)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get listener %s", id), err)
+	}
+
+	for _, listener := range listeners {
+		if listener.ARN == id {
+			return &ListenerResource{listener: listener, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("listener %s not found", id), nil)
+}
+
+func (h *ELBListenersHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe listener %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+// ListenerResource implements Resource interface for listeners
+type ListenerResource struct {
+	listener elbadapter.Listener
+	region   string
+}
+
+func (r *ListenerResource) GetID() string { return r.listener.ARN }
+func (r *ListenerResource) GetName() string {
+	return fmt.Sprintf("%s:%d", r.listener.Protocol, r.listener.Port)
+}
+func (r *ListenerResource) GetARN() string             { return r.listener.ARN }
+func (r *ListenerResource) GetType() string            { return "elb:listeners" }
+func (r *ListenerResource) GetRegion() string          { return r.region }
+func (r *ListenerResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *ListenerResource) GetTags() map[string]string { return nil }
+
+func (r *ListenerResource) ToTableRow() []string {
+	return []string{
+		r.listener.Protocol,
+		fmt.Sprintf("%d", r.listener.Port),
+		strings.Join(r.listener.DefaultActions, "; "),
+		fmt.Sprintf("%d", len(r.listener.Certificates)),
+		orDash(r.listener.SslPolicy),
+	}
+}
+
+func (r *ListenerResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"ListenerArn":    r.listener.ARN,
+		"Protocol":       r.listener.Protocol,
+		"Port":           r.listener.Port,
+		"DefaultActions": r.listener.DefaultActions,
+	}
+	if r.listener.SslPolicy != "" {
+		details["SslPolicy"] = r.listener.SslPolicy
+	}
+	if len(r.listener.Certificates) > 0 {
+		details["Certificates"] = r.listener.Certificates
+	}
+	return details
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	elbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/elb"
+)
+
+// NavigateToListenersAction triggers navigation to the listeners of a load balancer
+type NavigateToListenersAction struct {
+	LoadBalancerARN  string
+	LoadBalancerName string
+}
+
+func (a *NavigateToListenersAction) Error() string {
+	return fmt.Sprintf("navigate to listeners of %s", a.LoadBalancerName)
+}
+
+func (a *NavigateToListenersAction) IsActionMsg() {}
+
+// NavigateToTargetGroupsAction triggers navigation to the target groups of a load balancer
+type NavigateToTargetGroupsAction struct {
+	LoadBalancerARN  string
+	LoadBalancerName string
+}
+
+func (a *NavigateToTargetGroupsAction) Error() string {
+	return fmt.Sprintf("navigate to target groups of %s", a.LoadBalancerName)
+}
+
+func (a *NavigateToTargetGroupsAction) IsActionMsg() {}
+
+// ELBLoadBalancersHandler handles Application, Network and Gateway load balancers
+type ELBLoadBalancersHandler struct {
+	BaseHandler
+	client *elbadapter.LoadBalancersClient
+	region string
+}
+
+// NewELBLoadBalancersHandler creates a new load balancers handler
+func NewELBLoadBalancersHandler(elbClient *elbv2.Client, region string) *ELBLoadBalancersHandler {
+	return &ELBLoadBalancersHandler{
+		client: elbadapter.NewLoadBalancersClient(elbClient),
+		region: region,
+	}
+}
+
+func (h *ELBLoadBalancersHandler) ResourceType() string { return "elb:loadbalancers" }
+func (h *ELBLoadBalancersHandler) ResourceName() string { return "Load Balancers" }
+func (h *ELBLoadBalancersHandler) ResourceIcon() string { return "⚖" }
+func (h *ELBLoadBalancersHandler) ShortcutKey() string  { return "elb" }
+
+func (h *ELBLoadBalancersHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 32, Sortable: true},
+		{Title: "Type", Width: 12, Sortable: true},
+		{Title: "Scheme", Width: 16, Sortable: true},
+		{Title: "State", Width: 12, Sortable: true},
+		{Title: "DNS Name", Width: 60, Sortable: false},
+		{Title: "VPC ID", Width: 22, Sortable: true},
+	}
+}
+
+func (h *ELBLoadBalancersHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	lbs, err := h.client.ListLoadBalancers(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list load balancers", err)
+	}
+
+	resources := make([]Resource, 0, len(lbs))
+	for _, lb := range lbs {
+		resource := &LoadBalancerResource{
+			lb:     lb,
+			region: h.region,
+		}
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(lb.Name)
+			dns := strings.ToLower(lb.DNSName)
+			lbType := strings.ToLower(lb.Type)
+			if !strings.Contains(name, filter) && !strings.Contains(dns, filter) && !strings.Contains(lbType, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *ELBLoadBalancersHandler) Get(ctx context.Context, id string) (Resource, error) {
+	lb, err := h.client.GetLoadBalancer(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get load balancer %s", id), err)
+	}
+
+	return &LoadBalancerResource{
+		lb:     *lb,
+		region: h.region,
+	}, nil
+}
+
+func (h *ELBLoadBalancersHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe load balancer %s", id), err)
+	}
+
+	details := res.ToDetailMap()
+
+	listeners, err := h.client.ListListeners(ctx, id)
+	if err == nil && len(listeners) > 0 {
+		summaries := make([]string, 0, len(listeners))
+		for _, l := range listeners {
+			summaries = append(summaries, fmt.Sprintf("%s:%d → %s", l.Protocol, l.Port, strings.Join(l.DefaultActions, "; ")))
+		}
+		details["Listeners"] = summaries
+	}
+
+	return details, nil
+}
+
+func (h *ELBLoadBalancersHandler) Actions() []Action {
+	return []Action{
+		{Key: "L", Name: "listeners", Description: "View listeners"},
+		{Key: "g", Name: "target-groups", Description: "View target groups"},
+		{Key: "P", Name: "reachability", Description: "Analyze path (source, then destination)", Mutating: true},
+	}
+}
+
+func (h *ELBLoadBalancersHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	res, err := h.Get(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "listeners":
+		return &NavigateToListenersAction{
+			LoadBalancerARN:  resourceID,
+			LoadBalancerName: res.GetName(),
+		}
+	case "target-groups":
+		return &NavigateToTargetGroupsAction{
+			LoadBalancerARN:  resourceID,
+			LoadBalancerName: res.GetName(),
+		}
+	case "reachability":
+		return &ReachabilityEndpointAction{
+			ResourceID: resourceID,
+			Region:     h.region,
+		}
+	}
+
+	return ErrNotSupported
+}
+
+func (h *ELBLoadBalancersHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	return h.client.Tags(ctx, id)
+}
+
+func (h *ELBLoadBalancersHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	return h.client.UpdateTags(ctx, id, set, remove)
+}
+
+// LoadBalancerResource implements Resource interface for load balancers
+type LoadBalancerResource struct {
+	lb     elbadapter.LoadBalancer
+	region string
+}
+
+func (r *LoadBalancerResource) GetID() string              { return r.lb.ARN }
+func (r *LoadBalancerResource) GetName() string            { return r.lb.Name }
+func (r *LoadBalancerResource) GetARN() string             { return r.lb.ARN }
+func (r *LoadBalancerResource) GetType() string            { return "elb:loadbalancers" }
+func (r *LoadBalancerResource) GetRegion() string          { return r.region }
+func (r *LoadBalancerResource) GetCreatedAt() time.Time    { return r.lb.CreatedTime }
+func (r *LoadBalancerResource) GetTags() map[string]string { return nil }
+
+func (r *LoadBalancerResource) ToTableRow() []string {
+	return []string{
+		r.lb.Name,
+		r.lb.Type,
+		r.lb.Scheme,
+		r.lb.State,
+		r.lb.DNSName,
+		orDash(r.lb.VpcID),
+	}
+}
+
+func (r *LoadBalancerResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"LoadBalancerName":  r.lb.Name,
+		"LoadBalancerArn":   r.lb.ARN,
+		"Type":              r.lb.Type,
+		"Scheme":            r.lb.Scheme,
+		"State":             r.lb.State,
+		"DNSName":           r.lb.DNSName,
+		"VpcId":             r.lb.VpcID,
+		"IpAddressType":     r.lb.IPAddressType,
+		"AvailabilityZones": r.lb.AvailabilityZones,
+	}
+	if len(r.lb.SecurityGroups) > 0 {
+		details["SecurityGroups"] = r.lb.SecurityGroups
+	}
+	if !r.lb.CreatedTime.IsZero() {
+		details["CreatedTime"] = r.lb.CreatedTime.Format(time.RFC3339)
+	}
+	return details
+}
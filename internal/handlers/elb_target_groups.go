@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	elbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/elb"
+)
+
+// NavigateToTargetsAction triggers navigation to the targets of a target group
+type NavigateToTargetsAction struct {
+	TargetGroupARN  string
+	TargetGroupName string
+}
+
+func (a *NavigateToTargetsAction) Error() string {
+	return fmt.Sprintf("navigate to targets of %s", a.TargetGroupName)
+}
+
+func (a *NavigateToTargetsAction) IsActionMsg() {}
+
+// ELBTargetGroupsHandler handles the target groups of a load balancer
+type ELBTargetGroupsHandler struct {
+	BaseHandler
+	client *elbadapter.LoadBalancersClient
+	region string
+	lbARN  string
+}
+
+// NewELBTargetGroupsHandlerForLoadBalancer creates a new target groups handler for a load balancer
+func NewELBTargetGroupsHandlerForLoadBalancer(elbClient *elbv2.Client, region, lbARN string) *ELBTargetGroupsHandler {
+	return &ELBTargetGroupsHandler{
+		client: elbadapter.NewLoadBalancersClient(elbClient),
+		region: region,
+		lbARN:  lbARN,
+	}
+}
+
+func (h *ELBTargetGroupsHandler) ResourceType() string { return "elb:targetgroups" }
+func (h *ELBTargetGroupsHandler) ResourceName() string { return "Target Groups" }
+func (h *ELBTargetGroupsHandler) ResourceIcon() string { return "🎯" }
+func (h *ELBTargetGroupsHandler) ShortcutKey() string  { return "elb-target-groups" }
+
+func (h *ELBTargetGroupsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 32, Sortable: true},
+		{Title: "Protocol", Width: 10, Sortable: true},
+		{Title: "Port", Width: 8, Sortable: true},
+		{Title: "Target Type", Width: 12, Sortable: true},
+		{Title: "Healthy", Width: 10, Sortable: false},
+		{Title: "Health Check", Width: 30, Sortable: false},
+		{Title: "VPC ID", Width: 22, Sortable: true},
+	}
+}
+
+func (h *ELBTargetGroupsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	groups, err := h.client.ListTargetGroups(ctx, h.lbARN)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list target groups", err)
+	}
+
+	resources := make([]Resource, 0, len(groups))
+	for _, group := range groups {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(group.Name), filter) {
+				continue
+			}
+		}
+
+		resource := &TargetGroupResource{
+			group:  group,
+			region: h.region,
+		}
+
+		// Health counts need a call per group, a failure only leaves them blank
+		if targets, err := h.client.DescribeTargetHealth(ctx, group.ARN); err == nil {
+			resource.total = len(targets)
+			for _, target := range targets {
+				if target.State == "healthy" {
+					resource.healthy++
+				}
+			}
+			resource.healthKnown = true
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *ELBTargetGroupsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	group, err := h.client.GetTargetGroup(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get target group %s", id), err)
+	}
+
+	return &TargetGroupResource{
+		group:  *group,
+		region: h.region,
+	}, nil
+}
+
+func (h *ELBTargetGroupsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe target group %s", id), err)
+	}
+
+	details := res.ToDetailMap()
+
+	targets, err := h.client.DescribeTargetHealth(ctx, id)
+	if err == nil {
+		health := make([]string, 0, len(targets))
+		for _, target := range targets {
+			health = append(health, fmt.Sprintf("%s:%d %s", target.TargetID, target.Port, target.State))
+		}
+		details["Targets"] = health
+	}
+
+	return details, nil
+}
+
+func (h *ELBTargetGroupsHandler) Actions() []Action {
+	return []Action{
+		{Key: "T", Name: "targets", Description: "View targets and health"},
+	}
+}
+
+func (h *ELBTargetGroupsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "targets" {
+		return ErrNotSupported
+	}
+
+	return &NavigateToTargetsAction{
+		TargetGroupARN:  resourceID,
+		TargetGroupName: elbadapter.TargetGroupNameFromARN(resourceID),
+	}
+}
+
+// TargetGroupResource implements Resource interface for target groups
+type TargetGroupResource struct {
+	group  elbadapter.TargetGroup
+	region string
+
+	// Target health counts, only set when listed
+	healthKnown bool
+	healthy     int
+	total       int
+}
+
+func (r *TargetGroupResource) GetID() string              { return r.group.ARN }
+func (r *TargetGroupResource) GetName() string            { return r.group.Name }
+func (r *TargetGroupResource) GetARN() string             { return r.group.ARN }
+func (r *TargetGroupResource) GetType() string            { return "elb:targetgroups" }
+func (r *TargetGroupResource) GetRegion() string          { return r.region }
+func (r *TargetGroupResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *TargetGroupResource) GetTags() map[string]string { return nil }
+
+func (r *TargetGroupResource) ToTableRow() []string {
+	healthy := "-"
+	if r.healthKnown {
+		healthy = fmt.Sprintf("%d/%d", r.healthy, r.total)
+	}
+
+	healthCheck := r.group.HealthCheckProtocol
+	if r.group.HealthCheckPath != "" {
+		healthCheck += " " + r.group.HealthCheckPath
+	}
+
+	return []string{
+		r.group.Name,
+		orDash(r.group.Protocol),
+		fmt.Sprintf("%d", r.group.Port),
+		r.group.TargetType,
+		healthy,
+		orDash(healthCheck),
+		orDash(r.group.VpcID),
+	}
+}
+
+func (r *TargetGroupResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"TargetGroupName":     r.group.Name,
+		"TargetGroupArn":      r.group.ARN,
+		"Protocol":            r.group.Protocol,
+		"Port":                r.group.Port,
+		"TargetType":          r.group.TargetType,
+		"VpcId":               r.group.VpcID,
+		"HealthCheckProtocol": r.group.HealthCheckProtocol,
+		"LoadBalancerArns":    r.group.LoadBalancerARNs,
+	}
+	if r.group.HealthCheckPath != "" {
+		details["HealthCheckPath"] = r.group.HealthCheckPath
+	}
+	return details
+}
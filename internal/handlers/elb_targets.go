@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+	elbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/elb"
+)
+
+// TargetCandidate is an instance that can be registered with a target group
+type TargetCandidate struct {
+	ID          string
+	Description string
+}
+
+// RegisterTargetAction is returned by ExecuteAction to pick an instance to register
+type RegisterTargetAction struct {
+	TargetGroupARN  string
+	TargetGroupName string
+	Candidates      []TargetCandidate
+}
+
+func (a *RegisterTargetAction) Error() string {
+	return fmt.Sprintf("register target with %s", a.TargetGroupName)
+}
+
+func (a *RegisterTargetAction) IsActionMsg() {}
+
+// DeregisterTargetAction is returned by ExecuteAction to confirm deregistering a target
+type DeregisterTargetAction struct {
+	TargetGroupARN  string
+	TargetGroupName string
+	TargetID        string
+	Port            int32
+}
+
+func (a *DeregisterTargetAction) Error() string {
+	return fmt.Sprintf("deregister %s from %s", a.TargetID, a.TargetGroupName)
+}
+
+func (a *DeregisterTargetAction) IsActionMsg() {}
+
+// ELBTargetsHandler handles the targets registered with a target group
+type ELBTargetsHandler struct {
+	BaseHandler
+	client    *elbadapter.LoadBalancersClient
+	instances *ec2adapter.InstancesClient
+	region    string
+	tgARN     string
+	tgName    string
+}
+
+// NewELBTargetsHandlerForTargetGroup creates a new targets handler for a target group
+func NewELBTargetsHandlerForTargetGroup(elbClient *elbv2.Client, ec2Client *ec2.Client, region, tgARN, tgName string) *ELBTargetsHandler {
+	return &ELBTargetsHandler{
+		client:    elbadapter.NewLoadBalancersClient(elbClient),
+		instances: ec2adapter.NewInstancesClient(ec2Client),
+		region:    region,
+		tgARN:     tgARN,
+		tgName:    tgName,
+	}
+}
+
+func (h *ELBTargetsHandler) ResourceType() string { return "elb:targets" }
+func (h *ELBTargetsHandler) ResourceName() string { return "Targets" }
+func (h *ELBTargetsHandler) ResourceIcon() string { return "🎯" }
+func (h *ELBTargetsHandler) ShortcutKey() string  { return "elb-targets" }
+
+func (h *ELBTargetsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Target", Width: 40, Sortable: true},
+		{Title: "Port", Width: 8, Sortable: true},
+		{Title: "AZ", Width: 14, Sortable: true},
+		{Title: "Health", Width: 12, Sortable: true},
+		{Title: "Reason", Width: 34, Sortable: false},
+	}
+}
+
+func (h *ELBTargetsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	targets, err := h.client.DescribeTargetHealth(ctx, h.tgARN)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list targets", err)
+	}
+
+	resources := make([]Resource, 0, len(targets))
+	for _, target := range targets {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			id := strings.ToLower(target.TargetID)
+			state := strings.ToLower(target.State)
+			if !strings.Contains(id, filter) && !strings.Contains(state, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &TargetResource{
+			target: target,
+			tgARN:  h.tgARN,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *ELBTargetsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	targets, err := h.client.DescribeTargetHealth(ctx, h.tgARN)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get target %s", id), err)
+	}
+
+	for _, target := range targets {
+		res := &TargetResource{target: target, tgARN: h.tgARN, region: h.region}
+		if res.GetID() == id {
+			return res, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("target %s not found", id), nil)
+}
+
+func (h *ELBTargetsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe target %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *ELBTargetsHandler) Actions() []Action {
+	return []Action{
+		{Key: "a", Name: "register", Description: "Register an instance", Mutating: true},
+		{Key: "x", Name: "deregister", Description: "Deregister target", Dangerous: true, Mutating: true},
+	}
+}
+
+func (h *ELBTargetsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "register":
+		return h.registerRequest(ctx)
+	case "deregister":
+		targetID, port := parseTargetID(resourceID)
+		return &DeregisterTargetAction{
+			TargetGroupARN:  h.tgARN,
+			TargetGroupName: h.tgName,
+			TargetID:        targetID,
+			Port:            port,
+		}
+	}
+
+	return ErrNotSupported
+}
+
+// registerRequest lists the instances in the target group's VPC that aren't registered yet
+func (h *ELBTargetsHandler) registerRequest(ctx context.Context) error {
+	group, err := h.client.GetTargetGroup(ctx, h.tgARN)
+	if err != nil {
+		return err
+	}
+	if group.TargetType != "instance" {
+		return fmt.Errorf("%s has %s targets, only instances can be registered from here", group.Name, group.TargetType)
+	}
+
+	targets, err := h.client.DescribeTargetHealth(ctx, h.tgARN)
+	if err != nil {
+		return err
+	}
+	registered := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		registered[target.TargetID] = true
+	}
+
+	instances, err := h.instances.ListInstances(ctx)
+	if err != nil {
+		return err
+	}
+
+	var candidates []TargetCandidate
+	for _, inst := range instances {
+		if inst.VpcID != group.VpcID || registered[inst.InstanceID] {
+			continue
+		}
+		if inst.State != "running" && inst.State != "stopped" {
+			continue
+		}
+		description := fmt.Sprintf("%s, %s, %s", inst.State, inst.InstanceType, inst.AvailabilityZone)
+		if inst.Name != "" {
+			description = inst.Name + ", " + description
+		}
+		candidates = append(candidates, TargetCandidate{ID: inst.InstanceID, Description: description})
+	}
+
+	if len(candidates) == 0 {
+		return fmt.Errorf("no unregistered instances in %s", group.VpcID)
+	}
+
+	return &RegisterTargetAction{
+		TargetGroupARN:  h.tgARN,
+		TargetGroupName: h.tgName,
+		Candidates:      candidates,
+	}
+}
+
+// RegisterTarget registers a target on the target group's port
+func (h *ELBTargetsHandler) RegisterTarget(ctx context.Context, tgARN, targetID string) error {
+	return h.client.RegisterTarget(ctx, tgARN, targetID, 0)
+}
+
+// DeregisterTarget deregisters a target
+func (h *ELBTargetsHandler) DeregisterTarget(ctx context.Context, tgARN, targetID string, port int32) error {
+	return h.client.DeregisterTarget(ctx, tgARN, targetID, port)
+}
+
+// parseTargetID splits a target resource ID into the target and its port
+func parseTargetID(id string) (string, int32) {
+	i := strings.LastIndex(id, ":")
+	if i < 0 {
+		return id, 0
+	}
+	port, err := strconv.ParseInt(id[i+1:], 10, 32)
+	if err != nil {
+		return id, 0
+	}
+	return id[:i], int32(port)
+}
+
+// TargetResource implements Resource interface for registered targets
+type TargetResource struct {
+	target elbadapter.TargetHealth
+	tgARN  string
+	region string
+}
+
+// GetID includes the port, as a target can be registered on several ports
+func (r *TargetResource) GetID() string {
+	return fmt.Sprintf("%s:%d", r.target.TargetID, r.target.Port)
+}
+func (r *TargetResource) GetName() string            { return r.target.TargetID }
+func (r *TargetResource) GetARN() string             { return r.tgARN }
+func (r *TargetResource) GetType() string            { return "elb:targets" }
+func (r *TargetResource) GetRegion() string          { return r.region }
+func (r *TargetResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *TargetResource) GetTags() map[string]string { return nil }
+
+func (r *TargetResource) ToTableRow() []string {
+	port := "-"
+	if r.target.Port > 0 {
+		port = fmt.Sprintf("%d", r.target.Port)
+	}
+
+	return []string{
+		r.target.TargetID,
+		port,
+		orDash(r.target.AvailabilityZone),
+		r.target.State,
+		orDash(r.target.Reason),
+	}
+}
+
+func (r *TargetResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"TargetId":         r.target.TargetID,
+		"Port":             r.target.Port,
+		"AvailabilityZone": r.target.AvailabilityZone,
+		"State":            r.target.State,
+		"TargetGroupArn":   r.tgARN,
+	}
+	if r.target.Reason != "" {
+		details["Reason"] = r.target.Reason
+	}
+	if r.target.Description != "" {
+		details["Description"] = r.target.Description
+	}
+	return details
+}
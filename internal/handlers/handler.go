@@ -28,6 +28,42 @@ type ColumnDef struct {
 	Title    string
 	Width    int
 	Sortable bool
+	SortType SortType
+
+	// Priority keeps a column on narrow terminals: columns are hidden lowest priority
+	// first, and the rightmost first among equals. The first column always shows.
+	Priority int
+}
+
+// SortType is how the values of a column compare when the table is sorted by it. Values
+// that don't parse as the type, such as "-" or "Never", sort after the others.
+type SortType int
+
+const (
+	SortText   SortType = iota // Case-insensitive text, the default
+	SortNumber                 // A leading number, e.g. "128", "$12.50", "1,024" or "45d"
+	SortDate                   // A timestamp as handlers format them
+	SortSize                   // A size, e.g. "512 B", "3.4 MB" or "12 GB", in powers of 1024
+)
+
+// Severity is how destructive an action is, deciding how it must be confirmed
+type Severity int
+
+const (
+	SeverityNormal   Severity = iota // A y/n confirmation, if any
+	SeverityHigh                     // Hard to undo, e.g. deleting with a recovery window
+	SeverityCritical                 // Irreversible, e.g. deleting a table or terminating an instance
+)
+
+// ParseSeverity parses a severity name, "high" or "critical"
+func ParseSeverity(s string) (Severity, bool) {
+	switch s {
+	case "high":
+		return SeverityHigh, true
+	case "critical":
+		return SeverityCritical, true
+	}
+	return SeverityNormal, false
 }
 
 // Action defines a resource-specific action
@@ -36,6 +72,42 @@ type Action struct {
 	Name        string
 	Description string
 	Dangerous   bool
+	Mutating    bool     // Changes AWS resources, so it is hidden and blocked in read-only mode
+	Severity    Severity // From high on the resource name must be typed to confirm, see typed_confirmation
+	Batch       bool     // Runs on the marked resources, see MultiSelectHandler
+}
+
+// DetailLink is an entry of a resource's details that leads to another resource
+type DetailLink struct {
+	Label  string
+	Action error // Navigation action, as returned by ExecuteAction, run when the link is followed
+}
+
+// DetailLinker is implemented by handlers whose details link to other resources
+type DetailLinker interface {
+	DetailLinks(details map[string]interface{}) []DetailLink
+}
+
+// MultiSelectHandler is implemented by handlers whose resources can be marked with space.
+// Batch actions run on the marked resources, or the selected one when none are marked.
+type MultiSelectHandler interface {
+	ExecuteBatchAction(ctx context.Context, action string, resourceIDs []string) error
+}
+
+// ServerFilterHandler is implemented by handlers whose List narrows the AWS API call to
+// ListOptions.ServerFilter, so a search can find resources beyond a truncated list
+type ServerFilterHandler interface {
+	// ServerFilterHint describes how the filter matches, e.g. "name prefix"
+	ServerFilterHint() string
+}
+
+// IncrementalHandler is implemented by handlers whose lists can run to tens of thousands
+// of resources. When ListOptions.PageSize is set, List returns one page and the NextToken
+// of the next, and the list view streams the following pages into the table as it is
+// scrolled rather than loading everything up front.
+type IncrementalHandler interface {
+	// IncrementalPageSize is how many resources to fetch per page
+	IncrementalPageSize() int
 }
 
 // ListOptions defines options for listing resources
@@ -45,6 +117,11 @@ type ListOptions struct {
 	NextToken string
 	SortField string
 	SortAsc   bool
+	MaxItems  int // Stop fetching after this many resources, 0 for no cap
+
+	// Search text passed to the AWS API by a ServerFilterHandler. Unlike Filter, the API
+	// decides how it matches, so the results can miss resources Filter would keep.
+	ServerFilter string
 }
 
 // ListResult contains the result of a list operation
@@ -52,6 +129,7 @@ type ListResult struct {
 	Resources []Resource
 	NextToken string
 	Total     int
+	Truncated bool // MaxItems was reached and more resources exist
 }
 
 // ResourceHandler defines the interface for resource type handlers
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	iamadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/iam"
+)
+
+// NavigateToAccessAdvisorAction triggers navigation to the services a user or role last
+// accessed
+type NavigateToAccessAdvisorAction struct {
+	PrincipalARN  string
+	PrincipalName string
+	PrincipalKind string // Users or Roles, for the breadcrumb
+}
+
+func (a *NavigateToAccessAdvisorAction) Error() string {
+	return fmt.Sprintf("view services last accessed by %s", a.PrincipalName)
+}
+
+func (a *NavigateToAccessAdvisorAction) IsActionMsg() {}
+
+// IAMAccessAdvisorHandler lists the services a principal's policies allow and when it last
+// used each of them, to find permissions that can be removed
+type IAMAccessAdvisorHandler struct {
+	BaseHandler
+	client        *iamadapter.ReportsClient
+	principalARN  string
+	principalName string
+
+	// The services last listed, as each report takes a few seconds to run
+	mu       sync.Mutex
+	services []iamadapter.ServiceLastAccessed
+}
+
+// NewIAMAccessAdvisorHandlerForPrincipal creates a new access advisor handler for a user
+// or role
+func NewIAMAccessAdvisorHandlerForPrincipal(client *iam.Client, principalARN, principalName string) *IAMAccessAdvisorHandler {
+	return &IAMAccessAdvisorHandler{
+		client:        iamadapter.NewReportsClient(client),
+		principalARN:  principalARN,
+		principalName: principalName,
+	}
+}
+
+func (h *IAMAccessAdvisorHandler) ResourceType() string { return "iam:access-advisor" }
+func (h *IAMAccessAdvisorHandler) ResourceName() string { return "Access Advisor" }
+func (h *IAMAccessAdvisorHandler) ResourceIcon() string { return "🔎" }
+func (h *IAMAccessAdvisorHandler) ShortcutKey() string  { return "access-advisor" }
+
+func (h *IAMAccessAdvisorHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Service", Width: 36, Sortable: true},
+		{Title: "Namespace", Width: 22, Sortable: true},
+		{Title: "Last Accessed", Width: 20, Sortable: true, SortType: SortDate},
+		{Title: "Region", Width: 14, Sortable: true},
+		{Title: "Actions Used", Width: 40, Sortable: false},
+	}
+}
+
+func (h *IAMAccessAdvisorHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	services, err := h.client.ServicesLastAccessed(ctx, h.principalARN)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to get services last accessed by %s", h.principalName), err)
+	}
+
+	h.mu.Lock()
+	h.services = services
+	h.mu.Unlock()
+
+	resources := make([]Resource, 0, len(services))
+	for _, service := range services {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(service.ServiceName)
+			if !strings.Contains(name, filter) && !strings.Contains(service.Namespace, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &ServiceLastAccessedResource{
+			service:      service,
+			principalARN: h.principalARN,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *IAMAccessAdvisorHandler) Get(ctx context.Context, id string) (Resource, error) {
+	h.mu.Lock()
+	services := h.services
+	h.mu.Unlock()
+
+	if services == nil {
+		var err error
+		services, err = h.client.ServicesLastAccessed(ctx, h.principalARN)
+		if err != nil {
+			return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get service %s", id), err)
+		}
+	}
+
+	for _, service := range services {
+		if service.Namespace == id {
+			return &ServiceLastAccessedResource{service: service, principalARN: h.principalARN}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("service %s not found", id), nil)
+}
+
+func (h *IAMAccessAdvisorHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe service %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+// ServiceLastAccessedResource implements Resource interface for access advisor entries
+type ServiceLastAccessedResource struct {
+	service      iamadapter.ServiceLastAccessed
+	principalARN string
+}
+
+func (r *ServiceLastAccessedResource) GetID() string              { return r.service.Namespace }
+func (r *ServiceLastAccessedResource) GetName() string            { return r.service.ServiceName }
+func (r *ServiceLastAccessedResource) GetARN() string             { return r.principalARN }
+func (r *ServiceLastAccessedResource) GetType() string            { return "iam:access-advisor" }
+func (r *ServiceLastAccessedResource) GetRegion() string          { return "global" }
+func (r *ServiceLastAccessedResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *ServiceLastAccessedResource) GetTags() map[string]string { return nil }
+
+// usedActions returns the tracked actions used within the tracking period
+func (r *ServiceLastAccessedResource) usedActions() []iamadapter.ActionLastAccessed {
+	var used []iamadapter.ActionLastAccessed
+	for _, action := range r.service.Actions {
+		if !action.LastAccessed.IsZero() {
+			used = append(used, action)
+		}
+	}
+	return used
+}
+
+func (r *ServiceLastAccessedResource) ToTableRow() []string {
+	lastAccessed := "Never"
+	if !r.service.LastAuthenticated.IsZero() {
+		lastAccessed = r.service.LastAuthenticated.UTC().Format("2006-01-02 15:04")
+	}
+
+	used := r.usedActions()
+	actions := make([]string, 0, len(used))
+	for _, action := range used {
+		actions = append(actions, action.Action)
+	}
+
+	return []string{
+		r.service.ServiceName,
+		r.service.Namespace,
+		lastAccessed,
+		orDash(r.service.Region),
+		orDash(strings.Join(actions, ", ")),
+	}
+}
+
+func (r *ServiceLastAccessedResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"ServiceName":      r.service.ServiceName,
+		"ServiceNamespace": r.service.Namespace,
+		"Principal":        r.principalARN,
+	}
+	if r.service.LastAuthenticated.IsZero() {
+		details["LastAuthenticated"] = "Never, within the tracking period"
+	} else {
+		details["LastAuthenticated"] = r.service.LastAuthenticated.Format(time.RFC3339)
+		details["LastAuthenticatedRegion"] = orDash(r.service.Region)
+		if r.service.Entity != "" && r.service.Entity != r.principalARN {
+			details["LastAuthenticatedEntity"] = r.service.Entity
+		}
+	}
+
+	if len(r.service.Actions) > 0 {
+		actions := make([]map[string]string, 0, len(r.service.Actions))
+		for _, action := range r.service.Actions {
+			lastAccessed := "Never"
+			if !action.LastAccessed.IsZero() {
+				lastAccessed = action.LastAccessed.Format(time.RFC3339)
+			}
+			actions = append(actions, map[string]string{
+				"Action":       action.Action,
+				"LastAccessed": lastAccessed,
+				"Region":       orDash(action.Region),
+			})
+		}
+		details["TrackedActions"] = actions
+	}
+
+	return details
+}
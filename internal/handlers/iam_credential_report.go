@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	iamadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/iam"
+)
+
+// IAMCredentialReportHandler shows the IAM credential report: the password, MFA and access
+// key state of every user, and of the root user
+type IAMCredentialReportHandler struct {
+	BaseHandler
+	client *iamadapter.ReportsClient
+
+	// The report last listed, so selecting a row doesn't fetch it again
+	mu     sync.Mutex
+	report *iamadapter.CredentialReport
+}
+
+// NewIAMCredentialReportHandler creates a new credential report handler
+func NewIAMCredentialReportHandler(client *iam.Client) *IAMCredentialReportHandler {
+	return &IAMCredentialReportHandler{client: iamadapter.NewReportsClient(client)}
+}
+
+func (h *IAMCredentialReportHandler) ResourceType() string { return "iam:credreport" }
+func (h *IAMCredentialReportHandler) ResourceName() string { return "IAM Credential Report" }
+func (h *IAMCredentialReportHandler) ResourceIcon() string { return "🪪" }
+func (h *IAMCredentialReportHandler) ShortcutKey() string  { return "credreport" }
+
+func (h *IAMCredentialReportHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "User", Width: 28, Sortable: true},
+		{Title: "Password Age", Width: 12, Sortable: true, SortType: SortNumber},
+		{Title: "Password Last Used", Width: 18, Sortable: true, SortType: SortDate},
+		{Title: "MFA", Width: 5, Sortable: true},
+		{Title: "Key 1 Age", Width: 10, Sortable: true, SortType: SortNumber},
+		{Title: "Key 1 Last Used", Width: 15, Sortable: true, SortType: SortDate},
+		{Title: "Key 2 Age", Width: 10, Sortable: true, SortType: SortNumber},
+		{Title: "Key 2 Last Used", Width: 15, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *IAMCredentialReportHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	report, err := h.client.CredentialReport(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to get the credential report", err)
+	}
+
+	h.mu.Lock()
+	h.report = report
+	h.mu.Unlock()
+
+	resources := make([]Resource, 0, len(report.Entries))
+	for _, entry := range report.Entries {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			user := strings.ToLower(entry.User)
+			if !strings.Contains(user, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &CredentialReportResource{
+			entry:     entry,
+			generated: report.Generated,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *IAMCredentialReportHandler) Get(ctx context.Context, id string) (Resource, error) {
+	h.mu.Lock()
+	report := h.report
+	h.mu.Unlock()
+
+	if report == nil {
+		var err error
+		report, err = h.client.CredentialReport(ctx)
+		if err != nil {
+			return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get credential report entry %s", id), err)
+		}
+	}
+
+	for _, entry := range report.Entries {
+		if entry.User == id {
+			return &CredentialReportResource{entry: entry, generated: report.Generated}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("%s is not in the credential report", id), nil)
+}
+
+func (h *IAMCredentialReportHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe credential report entry %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *IAMCredentialReportHandler) Actions() []Action {
+	return []Action{
+		{Key: "u", Name: "user", Description: "Open the user"},
+		{Key: "v", Name: "access-advisor", Description: "Services last accessed"},
+	}
+}
+
+func (h *IAMCredentialReportHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "user" && action != "access-advisor" {
+		return ErrNotSupported
+	}
+
+	res, err := h.Get(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+	entry := res.(*CredentialReportResource).entry
+	if entry.IsRoot() {
+		return fmt.Errorf("the root user isn't an IAM user")
+	}
+
+	if action == "user" {
+		return &NavigateToIAMResourceAction{Shortcut: "users", ID: entry.User}
+	}
+	return &NavigateToAccessAdvisorAction{
+		PrincipalARN:  entry.ARN,
+		PrincipalName: entry.User,
+		PrincipalKind: "Users",
+	}
+}
+
+// credentialAge formats how many days ago a credential was set, padded so ages sort by
+// number. Credentials that aren't in use show a dash.
+func credentialAge(since time.Time, inUse bool) string {
+	if !inUse || since.IsZero() {
+		return "-"
+	}
+	return fmt.Sprintf("%5dd", int(time.Since(since).Hours()/24))
+}
+
+// credentialLastUsed formats the day a credential was last used
+func credentialLastUsed(lastUsed time.Time, inUse bool) string {
+	switch {
+	case !lastUsed.IsZero():
+		return lastUsed.UTC().Format("2006-01-02")
+	case inUse:
+		return "Never"
+	}
+	return "-"
+}
+
+// CredentialReportResource implements Resource interface for credential report entries
+type CredentialReportResource struct {
+	entry     iamadapter.CredentialReportEntry
+	generated time.Time
+}
+
+func (r *CredentialReportResource) GetID() string              { return r.entry.User }
+func (r *CredentialReportResource) GetName() string            { return r.entry.User }
+func (r *CredentialReportResource) GetARN() string             { return r.entry.ARN }
+func (r *CredentialReportResource) GetType() string            { return "iam:credreport" }
+func (r *CredentialReportResource) GetRegion() string          { return "global" }
+func (r *CredentialReportResource) GetCreatedAt() time.Time    { return r.entry.Created }
+func (r *CredentialReportResource) GetTags() map[string]string { return nil }
+
+func (r *CredentialReportResource) ToTableRow() []string {
+	mfa := "No"
+	if r.entry.MFAActive {
+		mfa = "Yes"
+	}
+
+	// The root user's password is reported as not_supported, but its last use is
+	passwordInUse := r.entry.PasswordEnabled || r.entry.IsRoot()
+	key1, key2 := r.entry.AccessKeys[0], r.entry.AccessKeys[1]
+
+	return []string{
+		r.entry.User,
+		credentialAge(r.entry.PasswordLastChanged, r.entry.PasswordEnabled),
+		credentialLastUsed(r.entry.PasswordLastUsed, passwordInUse),
+		mfa,
+		credentialAge(key1.LastRotated, key1.Active),
+		credentialLastUsed(key1.LastUsed, key1.Active),
+		credentialAge(key2.LastRotated, key2.Active),
+		credentialLastUsed(key2.LastUsed, key2.Active),
+	}
+}
+
+func (r *CredentialReportResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"User":            r.entry.User,
+		"ARN":             r.entry.ARN,
+		"PasswordEnabled": r.entry.PasswordEnabled,
+		"MFAActive":       r.entry.MFAActive,
+		"Report":          r.entry.Fields,
+	}
+	if !r.entry.Created.IsZero() {
+		details["Created"] = r.entry.Created.Format(time.RFC3339)
+	}
+	if !r.generated.IsZero() {
+		details["ReportGenerated"] = r.generated.Format(time.RFC3339)
+	}
+	if !r.entry.PasswordLastChanged.IsZero() {
+		details["PasswordLastChanged"] = r.entry.PasswordLastChanged.Format(time.RFC3339)
+	}
+	if !r.entry.PasswordLastUsed.IsZero() {
+		details["PasswordLastUsed"] = r.entry.PasswordLastUsed.Format(time.RFC3339)
+	}
+	if !r.entry.PasswordNextRotate.IsZero() {
+		details["PasswordNextRotation"] = r.entry.PasswordNextRotate.Format(time.RFC3339)
+	}
+
+	for i, key := range r.entry.AccessKeys {
+		if !key.Active && key.LastRotated.IsZero() {
+			continue
+		}
+		info := map[string]interface{}{
+			"Active": key.Active,
+		}
+		if !key.LastRotated.IsZero() {
+			info["LastRotated"] = key.LastRotated.Format(time.RFC3339)
+		}
+		if !key.LastUsed.IsZero() {
+			info["LastUsed"] = key.LastUsed.Format(time.RFC3339)
+			info["LastUsedRegion"] = orDash(key.LastUsedRegion)
+			info["LastUsedService"] = orDash(key.LastUsedService)
+		}
+		details[fmt.Sprintf("AccessKey%d", i+1)] = info
+	}
+
+	return details
+}
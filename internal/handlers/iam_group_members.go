@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// GroupMemberCandidate is a user that can be added to a group
+type GroupMemberCandidate struct {
+	UserName    string
+	Description string
+}
+
+// AddUserToGroupAction is returned by ExecuteAction to pick a user to add to a group
+type AddUserToGroupAction struct {
+	GroupName  string
+	Candidates []GroupMemberCandidate
+}
+
+func (a *AddUserToGroupAction) Error() string {
+	return fmt.Sprintf("add a user to group %s", a.GroupName)
+}
+
+func (a *AddUserToGroupAction) IsActionMsg() {}
+
+// RemoveUserFromGroupAction is returned by ExecuteAction to confirm removing a user from a group
+type RemoveUserFromGroupAction struct {
+	GroupName string
+	UserName  string
+}
+
+func (a *RemoveUserFromGroupAction) Error() string {
+	return fmt.Sprintf("remove %s from group %s", a.UserName, a.GroupName)
+}
+
+func (a *RemoveUserFromGroupAction) IsActionMsg() {}
+
+// IAMGroupMembersHandler handles the users in an IAM group
+type IAMGroupMembersHandler struct {
+	BaseHandler
+	client    *iam.Client
+	groupName string
+}
+
+// NewIAMGroupMembersHandlerForGroup creates a new members handler for a group
+func NewIAMGroupMembersHandlerForGroup(client *iam.Client, groupName string) *IAMGroupMembersHandler {
+	return &IAMGroupMembersHandler{
+		client:    client,
+		groupName: groupName,
+	}
+}
+
+func (h *IAMGroupMembersHandler) ResourceType() string { return "iam:users" }
+func (h *IAMGroupMembersHandler) ResourceName() string { return "Group Members" }
+func (h *IAMGroupMembersHandler) ResourceIcon() string { return "👤" }
+func (h *IAMGroupMembersHandler) ShortcutKey() string  { return "group-members" }
+
+func (h *IAMGroupMembersHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 25, Sortable: true},
+		{Title: "User ID", Width: 22, Sortable: false},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
+		{Title: "Password Last Used", Width: 18, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *IAMGroupMembersHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	users, err := listGroupMembers(ctx, h.client, h.groupName)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list members of group %s", h.groupName), err)
+	}
+
+	resources := make([]Resource, 0, len(users))
+	for _, user := range users {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(aws.ToString(user.UserName))
+			if !strings.Contains(name, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &IAMGroupMemberResource{IAMUserResource{user: user}})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *IAMGroupMembersHandler) Get(ctx context.Context, id string) (Resource, error) {
+	result, err := h.client.GetUser(ctx, &iam.GetUserInput{
+		UserName: aws.String(id),
+	})
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get IAM user %s", id), err)
+	}
+
+	return &IAMGroupMemberResource{IAMUserResource{user: *result.User}}, nil
+}
+
+func (h *IAMGroupMembersHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	return NewIAMUsersHandler(h.client).Describe(ctx, id)
+}
+
+func (h *IAMGroupMembersHandler) Actions() []Action {
+	return []Action{
+		{Key: "a", Name: "add", Description: "Add a user to the group", Mutating: true},
+		{Key: "x", Name: "remove", Description: "Remove user from the group", Dangerous: true, Mutating: true},
+	}
+}
+
+func (h *IAMGroupMembersHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "add":
+		return h.addRequest(ctx)
+	case "remove":
+		return &RemoveUserFromGroupAction{
+			GroupName: h.groupName,
+			UserName:  resourceID,
+		}
+	default:
+		return ErrNotSupported
+	}
+}
+
+// DetailLinks links the member's groups and the managed policies attached to it
+func (h *IAMGroupMembersHandler) DetailLinks(details map[string]interface{}) []DetailLink {
+	return NewIAMUsersHandler(h.client).DetailLinks(details)
+}
+
+// addRequest lists the users that aren't in the group yet
+func (h *IAMGroupMembersHandler) addRequest(ctx context.Context) error {
+	members, err := listGroupMembers(ctx, h.client, h.groupName)
+	if err != nil {
+		return err
+	}
+	isMember := make(map[string]bool, len(members))
+	for _, user := range members {
+		isMember[aws.ToString(user.UserName)] = true
+	}
+
+	var candidates []GroupMemberCandidate
+	paginator := iam.NewListUsersPaginator(h.client, &iam.ListUsersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+		for _, user := range output.Users {
+			name := aws.ToString(user.UserName)
+			if isMember[name] {
+				continue
+			}
+			description := "created " + formatTime(user.CreateDate)
+			if user.PasswordLastUsed != nil {
+				description += ", password last used " + user.PasswordLastUsed.Format("2006-01-02")
+			}
+			candidates = append(candidates, GroupMemberCandidate{UserName: name, Description: description})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return fmt.Errorf("every user is already in %s", h.groupName)
+	}
+
+	return &AddUserToGroupAction{
+		GroupName:  h.groupName,
+		Candidates: candidates,
+	}
+}
+
+// AddUserToGroup adds a user to a group
+func (h *IAMGroupMembersHandler) AddUserToGroup(ctx context.Context, groupName, userName string) error {
+	_, err := h.client.AddUserToGroup(ctx, &iam.AddUserToGroupInput{
+		GroupName: aws.String(groupName),
+		UserName:  aws.String(userName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to %s: %w", userName, groupName, err)
+	}
+	return nil
+}
+
+// RemoveUserFromGroup removes a user from a group
+func (h *IAMGroupMembersHandler) RemoveUserFromGroup(ctx context.Context, groupName, userName string) error {
+	_, err := h.client.RemoveUserFromGroup(ctx, &iam.RemoveUserFromGroupInput{
+		GroupName: aws.String(groupName),
+		UserName:  aws.String(userName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove %s from %s: %w", userName, groupName, err)
+	}
+	return nil
+}
+
+// IAMGroupMemberResource is a user listed as a member of a group. MFA and access keys
+// aren't looked up, so only the user's own columns are shown.
+type IAMGroupMemberResource struct {
+	IAMUserResource
+}
+
+func (r *IAMGroupMemberResource) ToTableRow() []string {
+	return r.IAMUserResource.ToTableRow()[:4]
+}
@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// IAMGroupsHandler handles IAM Group resources
+type IAMGroupsHandler struct {
+	BaseHandler
+	client *iam.Client
+}
+
+// NewIAMGroupsHandler creates a new IAM groups handler
+func NewIAMGroupsHandler(client *iam.Client) *IAMGroupsHandler {
+	return &IAMGroupsHandler{client: client}
+}
+
+func (h *IAMGroupsHandler) ResourceType() string { return "iam:groups" }
+func (h *IAMGroupsHandler) ResourceName() string { return "IAM Groups" }
+func (h *IAMGroupsHandler) ResourceIcon() string { return "👥" }
+func (h *IAMGroupsHandler) ShortcutKey() string  { return "groups" }
+
+func (h *IAMGroupsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "Members", Width: 8, Sortable: true},
+		{Title: "Attached Policies", Width: 50, Sortable: false},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *IAMGroupsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	input := &iam.ListGroupsInput{}
+
+	if opts.PageSize > 0 {
+		input.MaxItems = aws.Int32(int32(opts.PageSize))
+	}
+	if opts.NextToken != "" {
+		input.Marker = aws.String(opts.NextToken)
+	}
+
+	result, err := h.client.ListGroups(ctx, input)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list IAM groups", err)
+	}
+
+	resources := make([]Resource, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(aws.ToString(group.GroupName))
+			if !strings.Contains(name, filter) {
+				continue
+			}
+		}
+
+		groupResource := &IAMGroupResource{group: group, memberCount: -1}
+
+		// Get member count
+		members, err := h.listMembers(ctx, aws.ToString(group.GroupName))
+		if err == nil {
+			groupResource.memberCount = len(members)
+		}
+
+		// Get attached policy names
+		policiesResult, err := h.client.ListAttachedGroupPolicies(ctx, &iam.ListAttachedGroupPoliciesInput{
+			GroupName: group.GroupName,
+		})
+		if err == nil {
+			for _, p := range policiesResult.AttachedPolicies {
+				groupResource.policies = append(groupResource.policies, aws.ToString(p.PolicyName))
+			}
+		}
+
+		resources = append(resources, groupResource)
+	}
+
+	nextToken := ""
+	if result.Marker != nil {
+		nextToken = aws.ToString(result.Marker)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: nextToken,
+	}, nil
+}
+
+func (h *IAMGroupsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	result, err := h.client.GetGroup(ctx, &iam.GetGroupInput{
+		GroupName: aws.String(id),
+	})
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get IAM group %s", id), err)
+	}
+
+	return &IAMGroupResource{group: *result.Group, memberCount: len(result.Users)}, nil
+}
+
+func (h *IAMGroupsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	groupResult, err := h.client.GetGroup(ctx, &iam.GetGroupInput{
+		GroupName: aws.String(id),
+	})
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe IAM group %s", id), err)
+	}
+
+	group := groupResult.Group
+	details := make(map[string]interface{})
+
+	// Basic info
+	details["Group"] = map[string]interface{}{
+		"GroupName":  aws.ToString(group.GroupName),
+		"GroupId":    aws.ToString(group.GroupId),
+		"ARN":        aws.ToString(group.Arn),
+		"Path":       aws.ToString(group.Path),
+		"CreateDate": formatTime(group.CreateDate),
+	}
+
+	// Get members
+	members, err := h.listMembers(ctx, id)
+	if err == nil {
+		names := make([]string, 0, len(members))
+		for _, u := range members {
+			names = append(names, aws.ToString(u.UserName))
+		}
+		details["Members"] = names
+	}
+
+	// Get attached managed policies
+	policiesResult, err := h.client.ListAttachedGroupPolicies(ctx, &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(id),
+	})
+	if err == nil {
+		policies := make([]map[string]string, 0, len(policiesResult.AttachedPolicies))
+		for _, p := range policiesResult.AttachedPolicies {
+			policies = append(policies, map[string]string{
+				"PolicyName": aws.ToString(p.PolicyName),
+				"PolicyArn":  aws.ToString(p.PolicyArn),
+			})
+		}
+		details["AttachedPolicies"] = policies
+	}
+
+	// Get inline policies
+	inlineResult, err := h.client.ListGroupPolicies(ctx, &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(id),
+	})
+	if err == nil {
+		details["InlinePolicies"] = inlineResult.PolicyNames
+	}
+
+	return details, nil
+}
+
+func (h *IAMGroupsHandler) Actions() []Action {
+	return []Action{
+		{Key: "u", Name: "members", Description: "View and manage members"},
+		{Key: "a", Name: "manage-policies", Description: "Attach/detach policies", Mutating: true},
+	}
+}
+
+func (h *IAMGroupsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "members":
+		return &NavigateToGroupMembersAction{
+			GroupName: resourceID,
+		}
+	case "manage-policies":
+		return &ManagePoliciesAction{
+			PrincipalType: PrincipalGroup,
+			PrincipalName: resourceID,
+		}
+	default:
+		return ErrNotSupported
+	}
+}
+
+// DetailLinks links the group's members and the managed policies attached to it
+func (h *IAMGroupsHandler) DetailLinks(details map[string]interface{}) []DetailLink {
+	var links []DetailLink
+	members, _ := details["Members"].([]string)
+	for _, member := range members {
+		links = append(links, DetailLink{
+			Label:  "user: " + member,
+			Action: &NavigateToIAMResourceAction{Shortcut: "users", ID: member},
+		})
+	}
+	return append(links, attachedPolicyLinks(details)...)
+}
+
+// listMembers lists all users in a group
+func (h *IAMGroupsHandler) listMembers(ctx context.Context, groupName string) ([]types.User, error) {
+	return listGroupMembers(ctx, h.client, groupName)
+}
+
+// listGroupMembers lists all users in a group, following pagination
+func listGroupMembers(ctx context.Context, client *iam.Client, groupName string) ([]types.User, error) {
+	var users []types.User
+	paginator := iam.NewGetGroupPaginator(client, &iam.GetGroupInput{
+		GroupName: aws.String(groupName),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group members: %w", err)
+		}
+		users = append(users, output.Users...)
+	}
+
+	return users, nil
+}
+
+// NavigateToGroupMembersAction triggers navigation to the members of a group
+type NavigateToGroupMembersAction struct {
+	GroupName string
+}
+
+func (a *NavigateToGroupMembersAction) Error() string {
+	return fmt.Sprintf("navigate to members of group %s", a.GroupName)
+}
+
+func (a *NavigateToGroupMembersAction) IsActionMsg() {}
+
+// IAMGroupResource implements Resource interface for IAM groups
+type IAMGroupResource struct {
+	group       types.Group
+	memberCount int // -1 if the members couldn't be listed
+	policies    []string
+}
+
+func (r *IAMGroupResource) GetID() string     { return aws.ToString(r.group.GroupName) }
+func (r *IAMGroupResource) GetARN() string    { return aws.ToString(r.group.Arn) }
+func (r *IAMGroupResource) GetName() string   { return aws.ToString(r.group.GroupName) }
+func (r *IAMGroupResource) GetType() string   { return "iam:groups" }
+func (r *IAMGroupResource) GetRegion() string { return "global" }
+
+func (r *IAMGroupResource) GetCreatedAt() time.Time {
+	if r.group.CreateDate != nil {
+		return *r.group.CreateDate
+	}
+	return time.Time{}
+}
+
+func (r *IAMGroupResource) GetTags() map[string]string {
+	// Groups can't be tagged
+	return nil
+}
+
+func (r *IAMGroupResource) ToTableRow() []string {
+	members := "?"
+	if r.memberCount >= 0 {
+		members = fmt.Sprintf("%d", r.memberCount)
+	}
+
+	created := ""
+	if r.group.CreateDate != nil {
+		created = r.group.CreateDate.Format("2006-01-02")
+	}
+
+	return []string{
+		aws.ToString(r.group.GroupName),
+		members,
+		orDash(strings.Join(r.policies, ", ")),
+		created,
+	}
+}
+
+func (r *IAMGroupResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"GroupName":  aws.ToString(r.group.GroupName),
+		"GroupId":    aws.ToString(r.group.GroupId),
+		"ARN":        aws.ToString(r.group.Arn),
+		"Path":       aws.ToString(r.group.Path),
+		"CreateDate": formatTime(r.group.CreateDate),
+	}
+}
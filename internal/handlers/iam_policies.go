@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // IAMPoliciesHandler handles IAM Policy resources
@@ -34,7 +36,7 @@ func (h *IAMPoliciesHandler) Columns() []ColumnDef {
 		{Title: "Name", Width: 40, Sortable: true},
 		{Title: "Type", Width: 12, Sortable: true},
 		{Title: "Attached", Width: 10, Sortable: true},
-		{Title: "Created", Width: 12, Sortable: true},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
 		{Title: "Description", Width: 50, Sortable: false},
 	}
 }
@@ -227,6 +229,59 @@ func (h *IAMPoliciesHandler) Actions() []Action {
 	}
 }
 
+// NavigateToIAMResourceAction is returned by a detail link to open an IAM list with one of
+// its resources selected and described
+type NavigateToIAMResourceAction struct {
+	Shortcut string // users, roles, groups or policies
+	ID       string // User, role or group name, or policy ARN
+}
+
+func (a *NavigateToIAMResourceAction) Error() string {
+	return fmt.Sprintf("navigate to %s %s", a.Shortcut, a.ID)
+}
+
+func (a *NavigateToIAMResourceAction) IsActionMsg() {}
+
+// DetailLinks links the users, roles and groups a policy is attached to
+func (h *IAMPoliciesHandler) DetailLinks(details map[string]interface{}) []DetailLink {
+	var links []DetailLink
+	users, _ := details["AttachedUsers"].([]string)
+	for _, user := range users {
+		links = append(links, DetailLink{
+			Label:  "user: " + user,
+			Action: &NavigateToIAMResourceAction{Shortcut: "users", ID: user},
+		})
+	}
+	roles, _ := details["AttachedRoles"].([]string)
+	for _, role := range roles {
+		links = append(links, DetailLink{
+			Label:  "role: " + role,
+			Action: &NavigateToIAMResourceAction{Shortcut: "roles", ID: role},
+		})
+	}
+	groups, _ := details["AttachedGroups"].([]string)
+	for _, group := range groups {
+		links = append(links, DetailLink{
+			Label:  "group: " + group,
+			Action: &NavigateToIAMResourceAction{Shortcut: "groups", ID: group},
+		})
+	}
+	return links
+}
+
+// attachedPolicyLinks links the managed policies in the AttachedPolicies of a user or role
+func attachedPolicyLinks(details map[string]interface{}) []DetailLink {
+	policies, _ := details["AttachedPolicies"].([]map[string]string)
+	links := make([]DetailLink, 0, len(policies))
+	for _, policy := range policies {
+		links = append(links, DetailLink{
+			Label:  "policy: " + policy["PolicyName"],
+			Action: &NavigateToIAMResourceAction{Shortcut: "policies", ID: policy["PolicyArn"]},
+		})
+	}
+	return links
+}
+
 // IAMPolicyResource implements Resource interface for IAM policies
 type IAMPolicyResource struct {
 	policy     types.Policy
@@ -258,10 +313,7 @@ func (r *IAMPolicyResource) ToTableRow() []string {
 
 	attached := fmt.Sprintf("%d", aws.ToInt32(r.policy.AttachmentCount))
 
-	description := aws.ToString(r.policy.Description)
-	if len(description) > 50 {
-		description = description[:47] + "..."
-	}
+	description := utils.Truncate(aws.ToString(r.policy.Description), 50)
 
 	return []string{
 		aws.ToString(r.policy.PolicyName),
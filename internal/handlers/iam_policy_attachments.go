@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// Principal types that managed policies can be attached to
+const (
+	PrincipalUser  = "user"
+	PrincipalRole  = "role"
+	PrincipalGroup = "group"
+)
+
+// DefaultManagedPolicyQuota is the default IAM limit of managed policies per user, role or group
+const DefaultManagedPolicyQuota = 10
+
+// ManagedPolicyRef identifies a managed policy
+type ManagedPolicyRef struct {
+	Name string
+	ARN  string
+	AWS  bool // AWS managed rather than customer managed
+}
+
+// ManagePoliciesAction is returned by ExecuteAction to open the policy attachment picker
+type ManagePoliciesAction struct {
+	PrincipalType string
+	PrincipalName string
+}
+
+func (a *ManagePoliciesAction) Error() string {
+	return fmt.Sprintf("manage policies for %s %s", a.PrincipalType, a.PrincipalName)
+}
+
+func (a *ManagePoliciesAction) IsActionMsg() {}
+
+// PolicyAttachmentChange is a set of policies to attach to and detach from a principal
+type PolicyAttachmentChange struct {
+	PrincipalType string
+	PrincipalName string
+	Current       []ManagedPolicyRef // Attached before the change
+	Attach        []ManagedPolicyRef
+	Detach        []ManagedPolicyRef
+}
+
+// Resulting returns the policies that will be attached once the change is applied
+func (c *PolicyAttachmentChange) Resulting() []ManagedPolicyRef {
+	detach := make(map[string]bool, len(c.Detach))
+	for _, p := range c.Detach {
+		detach[p.ARN] = true
+	}
+
+	var result []ManagedPolicyRef
+	for _, p := range c.Current {
+		if !detach[p.ARN] {
+			result = append(result, p)
+		}
+	}
+	result = append(result, c.Attach...)
+	return result
+}
+
+// IsEmpty returns true if the change neither attaches nor detaches anything
+func (c *PolicyAttachmentChange) IsEmpty() bool {
+	return len(c.Attach) == 0 && len(c.Detach) == 0
+}
+
+// PolicyAttacher lists and changes the managed policies attached to users, roles or groups
+type PolicyAttacher struct {
+	client *iam.Client
+}
+
+// NewPolicyAttacher creates a new policy attacher
+func NewPolicyAttacher(client *iam.Client) *PolicyAttacher {
+	return &PolicyAttacher{client: client}
+}
+
+// ListManagedPolicies lists all attachable AWS managed and customer managed policies, sorted by name
+func (p *PolicyAttacher) ListManagedPolicies(ctx context.Context) ([]ManagedPolicyRef, error) {
+	var policies []ManagedPolicyRef
+	var marker *string
+
+	for {
+		output, err := p.client.ListPolicies(ctx, &iam.ListPoliciesInput{
+			Scope:  types.PolicyScopeTypeAll,
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list managed policies: %w", err)
+		}
+
+		for _, policy := range output.Policies {
+			if !policy.IsAttachable {
+				continue
+			}
+			policies = append(policies, managedPolicyRef(aws.ToString(policy.PolicyName), aws.ToString(policy.Arn)))
+		}
+
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.Marker
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return strings.ToLower(policies[i].Name) < strings.ToLower(policies[j].Name)
+	})
+
+	return policies, nil
+}
+
+// ListAttachedPolicies lists the managed policies attached to a user, role or group
+func (p *PolicyAttacher) ListAttachedPolicies(ctx context.Context, principalType, name string) ([]ManagedPolicyRef, error) {
+	var policies []ManagedPolicyRef
+	var marker *string
+
+	for {
+		var attached []types.AttachedPolicy
+		var truncated bool
+		var next *string
+
+		switch principalType {
+		case PrincipalUser:
+			output, err := p.client.ListAttachedUserPolicies(ctx, &iam.ListAttachedUserPoliciesInput{
+				UserName: aws.String(name),
+				Marker:   marker,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list attached policies: %w", err)
+			}
+			attached, truncated, next = output.AttachedPolicies, output.IsTruncated, output.Marker
+		case PrincipalRole:
+			output, err := p.client.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{
+				RoleName: aws.String(name),
+				Marker:   marker,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list attached policies: %w", err)
+			}
+			attached, truncated, next = output.AttachedPolicies, output.IsTruncated, output.Marker
+		case PrincipalGroup:
+			output, err := p.client.ListAttachedGroupPolicies(ctx, &iam.ListAttachedGroupPoliciesInput{
+				GroupName: aws.String(name),
+				Marker:    marker,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list attached policies: %w", err)
+			}
+			attached, truncated, next = output.AttachedPolicies, output.IsTruncated, output.Marker
+		default:
+			return nil, fmt.Errorf("unsupported principal type: %s", principalType)
+		}
+
+		for _, policy := range attached {
+			policies = append(policies, managedPolicyRef(aws.ToString(policy.PolicyName), aws.ToString(policy.PolicyArn)))
+		}
+
+		if !truncated {
+			break
+		}
+		marker = next
+	}
+
+	return policies, nil
+}
+
+// Apply detaches and then attaches the policies in a change. It stops at the first failure,
+// so the error reports which policy failed and earlier changes stay applied.
+func (p *PolicyAttacher) Apply(ctx context.Context, change *PolicyAttachmentChange) error {
+	for _, policy := range change.Detach {
+		if err := p.detach(ctx, change.PrincipalType, change.PrincipalName, policy.ARN); err != nil {
+			return fmt.Errorf("failed to detach %s: %w", policy.Name, err)
+		}
+	}
+
+	for _, policy := range change.Attach {
+		if err := p.attach(ctx, change.PrincipalType, change.PrincipalName, policy.ARN); err != nil {
+			return fmt.Errorf("failed to attach %s: %w", policy.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *PolicyAttacher) attach(ctx context.Context, principalType, name, policyARN string) error {
+	switch principalType {
+	case PrincipalUser:
+		_, err := p.client.AttachUserPolicy(ctx, &iam.AttachUserPolicyInput{
+			UserName:  aws.String(name),
+			PolicyArn: aws.String(policyARN),
+		})
+		return err
+	case PrincipalRole:
+		_, err := p.client.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			RoleName:  aws.String(name),
+			PolicyArn: aws.String(policyARN),
+		})
+		return err
+	case PrincipalGroup:
+		_, err := p.client.AttachGroupPolicy(ctx, &iam.AttachGroupPolicyInput{
+			GroupName: aws.String(name),
+			PolicyArn: aws.String(policyARN),
+		})
+		return err
+	}
+	return fmt.Errorf("unsupported principal type: %s", principalType)
+}
+
+func (p *PolicyAttacher) detach(ctx context.Context, principalType, name, policyARN string) error {
+	switch principalType {
+	case PrincipalUser:
+		_, err := p.client.DetachUserPolicy(ctx, &iam.DetachUserPolicyInput{
+			UserName:  aws.String(name),
+			PolicyArn: aws.String(policyARN),
+		})
+		return err
+	case PrincipalRole:
+		_, err := p.client.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+			RoleName:  aws.String(name),
+			PolicyArn: aws.String(policyARN),
+		})
+		return err
+	case PrincipalGroup:
+		_, err := p.client.DetachGroupPolicy(ctx, &iam.DetachGroupPolicyInput{
+			GroupName: aws.String(name),
+			PolicyArn: aws.String(policyARN),
+		})
+		return err
+	}
+	return fmt.Errorf("unsupported principal type: %s", principalType)
+}
+
+func managedPolicyRef(name, arn string) ManagedPolicyRef {
+	return ManagedPolicyRef{
+		Name: name,
+		ARN:  arn,
+		AWS:  strings.Contains(arn, ":iam::aws:policy/"),
+	}
+}
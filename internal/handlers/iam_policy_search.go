@@ -0,0 +1,540 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// policyScanTTL is how long a scan of the account's policies is reused by later searches
+const policyScanTTL = 15 * time.Minute
+
+// scannedPolicy is a managed or inline policy document found by a scan
+type scannedPolicy struct {
+	ID         string // Policy ARN, or <user|role|group>/<name>/<policy> for inline policies
+	Name       string
+	Kind       string // Customer, AWS or Inline
+	ARN        string
+	Owner      string   // Entity embedding an inline policy, e.g. "role: deployer"
+	AttachedTo []string // Entities a managed policy is attached to
+	Document   string
+}
+
+// policyScan is the result of scanning every policy of an account
+type policyScan struct {
+	policies  []scannedPolicy
+	scannedAt time.Time
+}
+
+// policyScanCache keeps the last scan per IAM client. Clients are replaced on profile,
+// region and role switches, so a scan never outlives the credentials it was made with.
+// AWS managed policy versions never change, so their documents are kept for the session.
+var policyScanCache = struct {
+	sync.Mutex
+	scans       map[*iam.Client]*policyScan
+	awsVersions map[string]string // <policy ARN>@<version ID> -> document
+}{
+	scans:       make(map[*iam.Client]*policyScan),
+	awsVersions: make(map[string]string),
+}
+
+// RescanPoliciesAction is returned by ExecuteAction after dropping the cached scan, so the
+// search runs again against the current policies
+type RescanPoliciesAction struct{}
+
+func (a *RescanPoliciesAction) Error() string { return "rescan policies" }
+
+func (a *RescanPoliciesAction) IsActionMsg() {}
+
+// PolicySearchMatch is a policy with statements matching a permission search
+type PolicySearchMatch struct {
+	Policy      scannedPolicy
+	Statements  []map[string]interface{}
+	Effects     []string
+	Conditional bool
+}
+
+// IAMPolicySearchHandler finds the policies with statements covering an action, optionally
+// on a resource
+type IAMPolicySearchHandler struct {
+	BaseHandler
+	client   *iam.Client
+	action   string
+	resource string
+
+	// Matches from the last search, keyed by policy ID
+	matches map[string]PolicySearchMatch
+}
+
+// NewIAMPolicySearchHandler creates a new policy search for an action such as s3:DeleteObject
+// and an optional resource ARN. Both may contain * and ? wildcards.
+func NewIAMPolicySearchHandler(client *iam.Client, action, resource string) *IAMPolicySearchHandler {
+	return &IAMPolicySearchHandler{
+		client:   client,
+		action:   action,
+		resource: resource,
+		matches:  make(map[string]PolicySearchMatch),
+	}
+}
+
+func (h *IAMPolicySearchHandler) ResourceType() string { return "iam:policysearch" }
+func (h *IAMPolicySearchHandler) ResourceName() string { return "Policy Search" }
+func (h *IAMPolicySearchHandler) ResourceIcon() string { return "🔎" }
+func (h *IAMPolicySearchHandler) ShortcutKey() string  { return "can" }
+
+func (h *IAMPolicySearchHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Policy", Width: 36, Sortable: true},
+		{Title: "Kind", Width: 10, Sortable: true},
+		{Title: "Effect", Width: 12, Sortable: true},
+		{Title: "Statements", Width: 10, Sortable: true},
+		{Title: "Conditional", Width: 11, Sortable: true},
+		{Title: "Attached To", Width: 50, Sortable: false},
+	}
+}
+
+func (h *IAMPolicySearchHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	scan, err := h.scan(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to scan IAM policies", err)
+	}
+
+	h.matches = make(map[string]PolicySearchMatch)
+	resources := make([]Resource, 0)
+	for _, policy := range scan.policies {
+		match, ok := h.match(policy)
+		if !ok {
+			continue
+		}
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(policy.Name)
+			attached := strings.ToLower(strings.Join(policyEntities(policy), " "))
+			if !strings.Contains(name, filter) && !strings.Contains(attached, filter) {
+				continue
+			}
+		}
+
+		h.matches[policy.ID] = match
+		resources = append(resources, &PolicySearchResource{match: match})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *IAMPolicySearchHandler) Get(ctx context.Context, id string) (Resource, error) {
+	match, ok := h.matches[id]
+	if !ok {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("policy %s not found in search results", id), nil)
+	}
+	return &PolicySearchResource{match: match}, nil
+}
+
+func (h *IAMPolicySearchHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe policy %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *IAMPolicySearchHandler) Actions() []Action {
+	return []Action{
+		{Key: "R", Name: "rescan", Description: "Rescan policies, skipping the cache"},
+	}
+}
+
+func (h *IAMPolicySearchHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "rescan" {
+		return ErrNotSupported
+	}
+
+	policyScanCache.Lock()
+	delete(policyScanCache.scans, h.client)
+	policyScanCache.Unlock()
+	return &RescanPoliciesAction{}
+}
+
+// DetailLinks links a managed policy and the users, roles and groups holding the matched policy
+func (h *IAMPolicySearchHandler) DetailLinks(details map[string]interface{}) []DetailLink {
+	id, _ := details["PolicyId"].(string)
+	match, ok := h.matches[id]
+	if !ok {
+		return nil
+	}
+
+	var links []DetailLink
+	if match.Policy.ARN != "" {
+		links = append(links, DetailLink{
+			Label:  "policy: " + match.Policy.Name,
+			Action: &NavigateToIAMResourceAction{Shortcut: "policies", ID: match.Policy.ARN},
+		})
+	}
+	for _, entity := range policyEntities(match.Policy) {
+		kind, name, _ := strings.Cut(entity, ": ")
+		if kind != "user" && kind != "role" && kind != "group" {
+			continue
+		}
+		links = append(links, DetailLink{
+			Label:  entity,
+			Action: &NavigateToIAMResourceAction{Shortcut: kind + "s", ID: name},
+		})
+	}
+	return links
+}
+
+// scan returns the account's policies, from the cache while it is fresh
+func (h *IAMPolicySearchHandler) scan(ctx context.Context) (*policyScan, error) {
+	policyScanCache.Lock()
+	cached := policyScanCache.scans[h.client]
+	policyScanCache.Unlock()
+	if cached != nil && time.Since(cached.scannedAt) < policyScanTTL {
+		return cached, nil
+	}
+
+	// One paginated call returns every customer managed and inline policy document along
+	// with what each managed policy is attached to
+	var policies []scannedPolicy
+	attached := make(map[string][]string)
+	paginator := iam.NewGetAccountAuthorizationDetailsPaginator(h.client, &iam.GetAccountAuthorizationDetailsInput{
+		Filter: []types.EntityType{
+			types.EntityTypeUser,
+			types.EntityTypeRole,
+			types.EntityTypeGroup,
+			types.EntityTypeLocalManagedPolicy,
+		},
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range output.UserDetailList {
+			name := aws.ToString(user.UserName)
+			policies = append(policies, inlinePolicies("user", name, user.UserPolicyList)...)
+			for _, p := range user.AttachedManagedPolicies {
+				attached[aws.ToString(p.PolicyArn)] = append(attached[aws.ToString(p.PolicyArn)], "user: "+name)
+			}
+		}
+		for _, role := range output.RoleDetailList {
+			name := aws.ToString(role.RoleName)
+			policies = append(policies, inlinePolicies("role", name, role.RolePolicyList)...)
+			for _, p := range role.AttachedManagedPolicies {
+				attached[aws.ToString(p.PolicyArn)] = append(attached[aws.ToString(p.PolicyArn)], "role: "+name)
+			}
+		}
+		for _, group := range output.GroupDetailList {
+			name := aws.ToString(group.GroupName)
+			policies = append(policies, inlinePolicies("group", name, group.GroupPolicyList)...)
+			for _, p := range group.AttachedManagedPolicies {
+				attached[aws.ToString(p.PolicyArn)] = append(attached[aws.ToString(p.PolicyArn)], "group: "+name)
+			}
+		}
+		for _, policy := range output.Policies {
+			for _, version := range policy.PolicyVersionList {
+				if !version.IsDefaultVersion {
+					continue
+				}
+				policies = append(policies, scannedPolicy{
+					ID:       aws.ToString(policy.Arn),
+					Name:     aws.ToString(policy.PolicyName),
+					Kind:     "Customer",
+					ARN:      aws.ToString(policy.Arn),
+					Document: decodePolicyDocument(aws.ToString(version.Document)),
+				})
+			}
+		}
+	}
+
+	// AWS managed policies are only scanned when attached to something in the account
+	for arn := range attached {
+		if !strings.Contains(arn, ":iam::aws:policy/") {
+			continue
+		}
+		policy, err := h.awsManagedPolicy(ctx, arn)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *policy)
+	}
+
+	for i := range policies {
+		if policies[i].ARN != "" {
+			policies[i].AttachedTo = attached[policies[i].ARN]
+		}
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+
+	scan := &policyScan{policies: policies, scannedAt: time.Now()}
+	policyScanCache.Lock()
+	policyScanCache.scans[h.client] = scan
+	policyScanCache.Unlock()
+	return scan, nil
+}
+
+// awsManagedPolicy fetches the default version of an AWS managed policy, reusing documents
+// of versions fetched before
+func (h *IAMPolicySearchHandler) awsManagedPolicy(ctx context.Context, arn string) (*scannedPolicy, error) {
+	result, err := h.client.GetPolicy(ctx, &iam.GetPolicyInput{PolicyArn: aws.String(arn)})
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &scannedPolicy{
+		ID:   arn,
+		Name: aws.ToString(result.Policy.PolicyName),
+		Kind: "AWS",
+		ARN:  arn,
+	}
+
+	key := arn + "@" + aws.ToString(result.Policy.DefaultVersionId)
+	policyScanCache.Lock()
+	document, ok := policyScanCache.awsVersions[key]
+	policyScanCache.Unlock()
+	if ok {
+		policy.Document = document
+		return policy, nil
+	}
+
+	version, err := h.client.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: aws.String(arn),
+		VersionId: result.Policy.DefaultVersionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	policy.Document = decodePolicyDocument(aws.ToString(version.PolicyVersion.Document))
+
+	policyScanCache.Lock()
+	policyScanCache.awsVersions[key] = policy.Document
+	policyScanCache.Unlock()
+	return policy, nil
+}
+
+// match returns the statements of a policy covering the searched action and resource
+func (h *IAMPolicySearchHandler) match(policy scannedPolicy) (PolicySearchMatch, bool) {
+	match := PolicySearchMatch{Policy: policy}
+	effects := make(map[string]bool)
+
+	for _, statement := range policyStatements(policy.Document) {
+		if !statementCovers(statement, "Action", "NotAction", h.action, true) {
+			continue
+		}
+		if h.resource != "" && !statementCovers(statement, "Resource", "NotResource", h.resource, false) {
+			continue
+		}
+
+		match.Statements = append(match.Statements, statement)
+		if effect, _ := statement["Effect"].(string); effect != "" {
+			effects[effect] = true
+		}
+		if _, ok := statement["Condition"]; ok {
+			match.Conditional = true
+		}
+	}
+
+	for effect := range effects {
+		match.Effects = append(match.Effects, effect)
+	}
+	sort.Strings(match.Effects)
+	return match, len(match.Statements) > 0
+}
+
+// statementCovers checks a statement's element, e.g. Action, or its Not form against a
+// searched value. A missing element covers everything.
+func statementCovers(statement map[string]interface{}, key, notKey, value string, foldCase bool) bool {
+	if patterns, ok := statement[key]; ok {
+		for _, pattern := range stringOrList(patterns) {
+			if wildcardOverlaps(pattern, value, foldCase) {
+				return true
+			}
+		}
+		return false
+	}
+	if patterns, ok := statement[notKey]; ok {
+		for _, pattern := range stringOrList(patterns) {
+			if wildcardMatch(pattern, value, foldCase) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// wildcardOverlaps reports whether a policy pattern covers a searched value, or the searched
+// value, itself a pattern such as s3:Delete*, covers the policy pattern
+func wildcardOverlaps(pattern, value string, foldCase bool) bool {
+	return wildcardMatch(pattern, value, foldCase) || wildcardMatch(value, pattern, foldCase)
+}
+
+// wildcardMatch matches a value against an IAM pattern where * matches any run of
+// characters and ? a single one
+func wildcardMatch(pattern, value string, foldCase bool) bool {
+	if foldCase {
+		pattern = strings.ToLower(pattern)
+		value = strings.ToLower(value)
+	}
+
+	p, v := 0, 0
+	star, mark := -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, v
+			p++
+		case star >= 0:
+			p = star + 1
+			mark++
+			v = mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// policyStatements parses the statements of a policy document, a single statement or a list
+func policyStatements(document string) []map[string]interface{} {
+	var doc struct {
+		Statement json.RawMessage
+	}
+	if json.Unmarshal([]byte(document), &doc) != nil {
+		return nil
+	}
+
+	var statements []map[string]interface{}
+	if json.Unmarshal(doc.Statement, &statements) == nil {
+		return statements
+	}
+	var statement map[string]interface{}
+	if json.Unmarshal(doc.Statement, &statement) == nil {
+		return []map[string]interface{}{statement}
+	}
+	return nil
+}
+
+// stringOrList returns a policy element that is either a string or a list of strings
+func stringOrList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// decodePolicyDocument unescapes a URL encoded policy document as returned by IAM
+func decodePolicyDocument(document string) string {
+	decoded, err := url.QueryUnescape(document)
+	if err != nil {
+		return document
+	}
+	return decoded
+}
+
+// inlinePolicies converts the inline policies embedded in a user, role or group
+func inlinePolicies(kind, owner string, details []types.PolicyDetail) []scannedPolicy {
+	policies := make([]scannedPolicy, 0, len(details))
+	for _, detail := range details {
+		name := aws.ToString(detail.PolicyName)
+		policies = append(policies, scannedPolicy{
+			ID:       fmt.Sprintf("%s/%s/%s", kind, owner, name),
+			Name:     name,
+			Kind:     "Inline",
+			Owner:    kind + ": " + owner,
+			Document: decodePolicyDocument(aws.ToString(detail.PolicyDocument)),
+		})
+	}
+	return policies
+}
+
+// policyEntities returns the entities holding a policy, its owner for an inline policy
+func policyEntities(policy scannedPolicy) []string {
+	if policy.Owner != "" {
+		return []string{policy.Owner}
+	}
+	return policy.AttachedTo
+}
+
+// PolicySearchResource implements Resource interface for policy search results
+type PolicySearchResource struct {
+	match PolicySearchMatch
+}
+
+func (r *PolicySearchResource) GetID() string              { return r.match.Policy.ID }
+func (r *PolicySearchResource) GetName() string            { return r.match.Policy.Name }
+func (r *PolicySearchResource) GetARN() string             { return r.match.Policy.ARN }
+func (r *PolicySearchResource) GetType() string            { return "iam:policysearch" }
+func (r *PolicySearchResource) GetRegion() string          { return "global" }
+func (r *PolicySearchResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *PolicySearchResource) GetTags() map[string]string { return nil }
+
+func (r *PolicySearchResource) ToTableRow() []string {
+	conditional := "no"
+	if r.match.Conditional {
+		conditional = "yes"
+	}
+
+	entities := policyEntities(r.match.Policy)
+	attached := "-"
+	if len(entities) > 0 {
+		attached = strings.Join(entities, ", ")
+	}
+
+	return []string{
+		r.match.Policy.Name,
+		r.match.Policy.Kind,
+		strings.Join(r.match.Effects, "/"),
+		fmt.Sprintf("%d", len(r.match.Statements)),
+		conditional,
+		attached,
+	}
+}
+
+func (r *PolicySearchResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"PolicyId":          r.match.Policy.ID,
+		"PolicyName":        r.match.Policy.Name,
+		"Kind":              r.match.Policy.Kind,
+		"MatchedStatements": r.match.Statements,
+	}
+	if r.match.Policy.ARN != "" {
+		details["ARN"] = r.match.Policy.ARN
+	}
+	if r.match.Policy.Owner != "" {
+		details["EmbeddedIn"] = r.match.Policy.Owner
+	}
+	if len(r.match.Policy.AttachedTo) > 0 {
+		details["AttachedTo"] = r.match.Policy.AttachedTo
+	}
+	return details
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // IAMRolesHandler handles IAM Role resources
@@ -32,8 +34,8 @@ func (h *IAMRolesHandler) ShortcutKey() string  { return "roles" }
 func (h *IAMRolesHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Name", Width: 35, Sortable: true},
-		{Title: "Created", Width: 12, Sortable: true},
-		{Title: "Last Used", Width: 12, Sortable: true},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
+		{Title: "Last Used", Width: 12, Sortable: true, SortType: SortDate},
 		{Title: "Trust Policy", Width: 30, Sortable: false},
 		{Title: "Description", Width: 40, Sortable: false},
 	}
@@ -192,7 +194,85 @@ func (h *IAMRolesHandler) Actions() []Action {
 		{Key: "p", Name: "policies", Description: "View attached policies"},
 		{Key: "t", Name: "trust", Description: "View trust policy"},
 		{Key: "i", Name: "instance-profiles", Description: "View instance profiles"},
+		{Key: "a", Name: "manage-policies", Description: "Attach/detach policies", Mutating: true},
+		{Key: "A", Name: "assume", Description: "Assume role"},
+		{Key: "v", Name: "access-advisor", Description: "Services last accessed"},
+	}
+}
+
+func (h *IAMRolesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "manage-policies":
+		return &ManagePoliciesAction{
+			PrincipalType: PrincipalRole,
+			PrincipalName: resourceID,
+		}
+	case "assume":
+		res, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return &AssumeRoleAction{RoleName: res.GetName(), RoleARN: res.GetARN()}
+	case "access-advisor":
+		res, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return &NavigateToAccessAdvisorAction{
+			PrincipalARN:  res.GetARN(),
+			PrincipalName: res.GetName(),
+			PrincipalKind: "Roles",
+		}
+	default:
+		return ErrNotSupported
+	}
+}
+
+// DetailLinks links the managed policies attached to the role
+func (h *IAMRolesHandler) DetailLinks(details map[string]interface{}) []DetailLink {
+	return attachedPolicyLinks(details)
+}
+
+// AssumeRoleAction triggers the session policy choice for assuming a role
+type AssumeRoleAction struct {
+	RoleName   string
+	RoleARN    string
+	ExternalID string // Set with :assume when the trust policy requires one
+}
+
+func (a *AssumeRoleAction) Error() string {
+	return fmt.Sprintf("assume role %s", a.RoleName)
+}
+
+func (a *AssumeRoleAction) IsActionMsg() {}
+
+func (h *IAMRolesHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	tags := make(map[string]string)
+	paginator := iam.NewListRoleTagsPaginator(h.client, &iam.ListRoleTagsInput{RoleName: aws.String(id)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags of role %s: %w", id, err)
+		}
+		for _, tag := range page.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+func (h *IAMRolesHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		if _, err := h.client.TagRole(ctx, &iam.TagRoleInput{RoleName: aws.String(id), Tags: iamTags(set)}); err != nil {
+			return fmt.Errorf("failed to tag role %s: %w", id, err)
+		}
 	}
+	if len(remove) > 0 {
+		if _, err := h.client.UntagRole(ctx, &iam.UntagRoleInput{RoleName: aws.String(id), TagKeys: remove}); err != nil {
+			return fmt.Errorf("failed to remove tags from role %s: %w", id, err)
+		}
+	}
+	return nil
 }
 
 // IAMRoleResource implements Resource interface for IAM roles
@@ -229,10 +309,7 @@ func (r *IAMRoleResource) ToTableRow() []string {
 	}
 
 	trustPrincipal := extractTrustPrincipal(r.role.AssumeRolePolicyDocument)
-	description := aws.ToString(r.role.Description)
-	if len(description) > 40 {
-		description = description[:37] + "..."
-	}
+	description := utils.Truncate(aws.ToString(r.role.Description), 40)
 
 	return []string{
 		aws.ToString(r.role.RoleName),
@@ -31,8 +31,8 @@ func (h *IAMUsersHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Name", Width: 25, Sortable: true},
 		{Title: "User ID", Width: 22, Sortable: false},
-		{Title: "Created", Width: 12, Sortable: true},
-		{Title: "Password Last Used", Width: 18, Sortable: true},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
+		{Title: "Password Last Used", Width: 18, Sortable: true, SortType: SortDate},
 		{Title: "MFA", Width: 5, Sortable: false},
 		{Title: "Access Keys", Width: 12, Sortable: false},
 	}
@@ -235,6 +235,8 @@ func (h *IAMUsersHandler) Actions() []Action {
 		{Key: "g", Name: "groups", Description: "View group memberships"},
 		{Key: "k", Name: "access-keys", Description: "View access keys"},
 		{Key: "m", Name: "mfa", Description: "View MFA devices"},
+		{Key: "a", Name: "manage-policies", Description: "Attach/detach policies", Mutating: true},
+		{Key: "v", Name: "access-advisor", Description: "Services last accessed"},
 	}
 }
 
@@ -256,11 +258,39 @@ func (h *IAMUsersHandler) ExecuteAction(ctx context.Context, action string, reso
 		return &ViewUserMFAAction{
 			UserName: resourceID,
 		}
+	case "manage-policies":
+		return &ManagePoliciesAction{
+			PrincipalType: PrincipalUser,
+			PrincipalName: resourceID,
+		}
+	case "access-advisor":
+		res, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return &NavigateToAccessAdvisorAction{
+			PrincipalARN:  res.GetARN(),
+			PrincipalName: res.GetName(),
+			PrincipalKind: "Users",
+		}
 	default:
 		return ErrNotSupported
 	}
 }
 
+// DetailLinks links the user's groups and the managed policies attached to it
+func (h *IAMUsersHandler) DetailLinks(details map[string]interface{}) []DetailLink {
+	var links []DetailLink
+	groups, _ := details["Groups"].([]string)
+	for _, group := range groups {
+		links = append(links, DetailLink{
+			Label:  "group: " + group,
+			Action: &NavigateToIAMResourceAction{Shortcut: "groups", ID: group},
+		})
+	}
+	return append(links, attachedPolicyLinks(details)...)
+}
+
 // Action message types for IAM users
 
 // ViewUserPoliciesAction triggers viewing user policies
@@ -425,6 +455,44 @@ func (h *IAMUsersHandler) GetUserMFADevices(ctx context.Context, userName string
 	return mfaDevices, nil
 }
 
+func (h *IAMUsersHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	tags := make(map[string]string)
+	paginator := iam.NewListUserTagsPaginator(h.client, &iam.ListUserTagsInput{UserName: aws.String(id)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags of user %s: %w", id, err)
+		}
+		for _, tag := range page.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+func (h *IAMUsersHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	if len(set) > 0 {
+		if _, err := h.client.TagUser(ctx, &iam.TagUserInput{UserName: aws.String(id), Tags: iamTags(set)}); err != nil {
+			return fmt.Errorf("failed to tag user %s: %w", id, err)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := h.client.UntagUser(ctx, &iam.UntagUserInput{UserName: aws.String(id), TagKeys: remove}); err != nil {
+			return fmt.Errorf("failed to remove tags from user %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// iamTags converts tags to the list IAM's tag APIs take
+func iamTags(tags map[string]string) []types.Tag {
+	list := make([]types.Tag, 0, len(tags))
+	for key, value := range tags {
+		list = append(list, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return list
+}
+
 // IAMUserResource implements Resource interface for IAM users
 type IAMUserResource struct {
 	user           types.User
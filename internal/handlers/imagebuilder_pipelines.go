@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	ibadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/imagebuilder"
+)
+
+// imageBuilderRecentImages is how many of a pipeline's latest builds its details show
+const imageBuilderRecentImages = 5
+
+// StartPipelineAction triggers a run of an image pipeline after confirmation
+type StartPipelineAction struct {
+	PipelineARN  string
+	PipelineName string
+	Schedule     string
+}
+
+func (a *StartPipelineAction) Error() string {
+	return fmt.Sprintf("run image pipeline %s", a.PipelineName)
+}
+
+func (a *StartPipelineAction) IsActionMsg() {}
+
+// ImagePipelinesHandler handles EC2 Image Builder pipeline resources
+type ImagePipelinesHandler struct {
+	BaseHandler
+	client *ibadapter.PipelinesClient
+	region string
+}
+
+// NewImagePipelinesHandler creates a new Image Builder pipelines handler
+func NewImagePipelinesHandler(ibClient *imagebuilder.Client, region string) *ImagePipelinesHandler {
+	return &ImagePipelinesHandler{
+		client: ibadapter.NewPipelinesClient(ibClient),
+		region: region,
+	}
+}
+
+func (h *ImagePipelinesHandler) ResourceType() string { return "imagebuilder:pipelines" }
+func (h *ImagePipelinesHandler) ResourceName() string { return "Image Pipelines" }
+func (h *ImagePipelinesHandler) ResourceIcon() string { return "🏭" }
+func (h *ImagePipelinesHandler) ShortcutKey() string  { return "imagebuilder" }
+
+func (h *ImagePipelinesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 32, Sortable: true},
+		{Title: "Platform", Width: 10, Sortable: true},
+		{Title: "Status", Width: 10, Sortable: true},
+		{Title: "Last Run", Width: 17, Sortable: true, SortType: SortDate},
+		{Title: "Last Run Status", Width: 16, Sortable: true},
+		{Title: "Next Run", Width: 17, Sortable: true, SortType: SortDate},
+		{Title: "Schedule", Width: 28, Sortable: true},
+	}
+}
+
+func (h *ImagePipelinesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	pipelines, err := h.client.ListPipelines(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list image pipelines", err)
+	}
+
+	resources := make([]Resource, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(pipeline.Name), filter) &&
+				!strings.Contains(strings.ToLower(pipeline.Platform), filter) &&
+				!strings.Contains(strings.ToLower(pipeline.LastRunStatus), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &ImagePipelineResource{
+			pipeline: pipeline,
+			region:   h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *ImagePipelinesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	pipeline, err := h.client.GetPipeline(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get image pipeline %s", id), err)
+	}
+
+	return &ImagePipelineResource{
+		pipeline: *pipeline,
+		region:   h.region,
+	}, nil
+}
+
+func (h *ImagePipelinesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	pipeline, err := h.client.GetPipeline(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe image pipeline %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	info := map[string]interface{}{
+		"Name":                pipeline.Name,
+		"Arn":                 pipeline.ARN,
+		"Platform":            pipeline.Platform,
+		"Status":              pipeline.Status,
+		"LastRunStatus":       pipeline.LastRunStatus,
+		"ConsecutiveFailures": pipeline.ConsecutiveFailures,
+		"Created":             formatPipelineTime(pipeline.CreatedTime),
+		"LastRun":             formatPipelineTime(pipeline.LastRunTime),
+		"NextRun":             formatPipelineTime(pipeline.NextRunTime),
+	}
+	if pipeline.Description != "" {
+		info["Description"] = pipeline.Description
+	}
+	details["Pipeline"] = info
+
+	schedule := map[string]interface{}{
+		"Expression": "manual only",
+	}
+	if pipeline.Schedule != "" {
+		schedule["Expression"] = pipeline.Schedule
+		schedule["StartCondition"] = pipeline.StartCondition
+	}
+	details["Schedule"] = schedule
+
+	details["Configuration"] = map[string]interface{}{
+		"Recipe":                       pipeline.RecipeARN,
+		"InfrastructureConfiguration":  pipeline.InfrastructureARN,
+		"DistributionConfiguration":    pipeline.DistributionARN,
+		"ExecutionRole":                pipeline.ExecutionRole,
+		"EnhancedImageMetadataEnabled": pipeline.EnhancedMetadata,
+	}
+
+	// The latest builds with the AMIs they produced
+	images, err := h.client.ListImages(ctx, id, imageBuilderRecentImages)
+	if err != nil {
+		details["RecentImages"] = fmt.Sprintf("unavailable: %v", err)
+	} else {
+		recent := make([]map[string]interface{}, 0, len(images))
+		for _, image := range images {
+			recent = append(recent, ImageDetailMap(&image))
+		}
+		details["RecentImages"] = recent
+	}
+
+	if len(pipeline.Tags) > 0 {
+		details["Tags"] = pipeline.Tags
+	}
+
+	return details, nil
+}
+
+func (h *ImagePipelinesHandler) Actions() []Action {
+	return []Action{
+		{Key: "s", Name: "start", Description: "Run pipeline", Mutating: true},
+	}
+}
+
+func (h *ImagePipelinesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "start" {
+		return ErrNotSupported
+	}
+
+	pipeline, err := h.client.GetPipeline(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+	if pipeline.Status != "ENABLED" {
+		return fmt.Errorf("pipeline %s is %s, enable it before running it", pipeline.Name, strings.ToLower(pipeline.Status))
+	}
+
+	return &StartPipelineAction{
+		PipelineARN:  pipeline.ARN,
+		PipelineName: pipeline.Name,
+		Schedule:     pipeline.Schedule,
+	}
+}
+
+// StartPipeline starts a run of a pipeline and returns the ARN of the image it builds
+func (h *ImagePipelinesHandler) StartPipeline(ctx context.Context, pipelineARN string) (string, error) {
+	return h.client.StartExecution(ctx, pipelineARN)
+}
+
+// GetImage gets the current state of an image build
+func (h *ImagePipelinesHandler) GetImage(ctx context.Context, imageARN string) (*ibadapter.Image, error) {
+	return h.client.GetImage(ctx, imageARN)
+}
+
+// ImageDetailMap describes an image build with its output AMIs or container images
+func ImageDetailMap(image *ibadapter.Image) map[string]interface{} {
+	detail := map[string]interface{}{
+		"Version": image.Version,
+		"Status":  image.Status,
+		"Created": formatPipelineTime(image.CreatedTime),
+		"Arn":     image.ARN,
+	}
+	if image.Reason != "" {
+		detail["Reason"] = image.Reason
+	}
+	if len(image.AMIs) > 0 {
+		amis := make([]map[string]interface{}, 0, len(image.AMIs))
+		for _, ami := range image.AMIs {
+			amis = append(amis, map[string]interface{}{
+				"ImageId": ami.ImageID,
+				"Name":    ami.Name,
+				"Region":  ami.Region,
+				"Account": ami.AccountID,
+			})
+		}
+		detail["OutputAMIs"] = amis
+	}
+	if len(image.Containers) > 0 {
+		detail["OutputContainers"] = image.Containers
+	}
+	return detail
+}
+
+func formatPipelineTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// ImagePipelineResource implements Resource interface for Image Builder pipelines
+type ImagePipelineResource struct {
+	pipeline ibadapter.Pipeline
+	region   string
+}
+
+func (r *ImagePipelineResource) GetID() string     { return r.pipeline.ARN }
+func (r *ImagePipelineResource) GetName() string   { return r.pipeline.Name }
+func (r *ImagePipelineResource) GetARN() string    { return r.pipeline.ARN }
+func (r *ImagePipelineResource) GetType() string   { return "imagebuilder:pipelines" }
+func (r *ImagePipelineResource) GetRegion() string { return r.region }
+
+func (r *ImagePipelineResource) GetCreatedAt() time.Time {
+	return r.pipeline.CreatedTime
+}
+
+func (r *ImagePipelineResource) GetTags() map[string]string {
+	return r.pipeline.Tags
+}
+
+func (r *ImagePipelineResource) ToTableRow() []string {
+	lastRunStatus := r.pipeline.LastRunStatus
+	if lastRunStatus == "" {
+		lastRunStatus = "-"
+	}
+	schedule := r.pipeline.Schedule
+	if schedule == "" {
+		schedule = "manual"
+	}
+
+	return []string{
+		r.pipeline.Name,
+		r.pipeline.Platform,
+		r.pipeline.Status,
+		formatPipelineTime(r.pipeline.LastRunTime),
+		lastRunStatus,
+		formatPipelineTime(r.pipeline.NextRunTime),
+		schedule,
+	}
+}
+
+func (r *ImagePipelineResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Name":          r.pipeline.Name,
+		"Arn":           r.pipeline.ARN,
+		"Platform":      r.pipeline.Platform,
+		"Status":        r.pipeline.Status,
+		"LastRunStatus": r.pipeline.LastRunStatus,
+		"Schedule":      r.pipeline.Schedule,
+	}
+}
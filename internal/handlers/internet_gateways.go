@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// InternetGatewaysHandler handles internet gateway resources
+type InternetGatewaysHandler struct {
+	BaseHandler
+	ec2Tagging
+	client *ec2adapter.VPCsClient
+	region string
+	vpcID  string // Empty lists every internet gateway, attached or not
+}
+
+// NewInternetGatewaysHandler creates a new internet gateways handler
+func NewInternetGatewaysHandler(ec2Client *ec2.Client, region string) *InternetGatewaysHandler {
+	return NewInternetGatewaysHandlerForVPC(ec2Client, region, "")
+}
+
+// NewInternetGatewaysHandlerForVPC creates a new internet gateways handler for the gateways attached to a VPC
+func NewInternetGatewaysHandlerForVPC(ec2Client *ec2.Client, region, vpcID string) *InternetGatewaysHandler {
+	return &InternetGatewaysHandler{
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewVPCsClient(ec2Client),
+		region:     region,
+		vpcID:      vpcID,
+	}
+}
+
+func (h *InternetGatewaysHandler) ResourceType() string { return "ec2:internet-gateways" }
+func (h *InternetGatewaysHandler) ResourceName() string { return "Internet Gateways" }
+func (h *InternetGatewaysHandler) ResourceIcon() string { return "🌍" }
+func (h *InternetGatewaysHandler) ShortcutKey() string  { return "igw" }
+
+func (h *InternetGatewaysHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "Internet Gateway ID", Width: 24, Sortable: false},
+		{Title: "State", Width: 10, Sortable: true},
+		{Title: "VPC ID", Width: 22, Sortable: true},
+		{Title: "Owner", Width: 14, Sortable: false},
+	}
+}
+
+func (h *InternetGatewaysHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	gateways, err := h.client.ListInternetGateways(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list internet gateways", err)
+	}
+
+	resources := make([]Resource, 0, len(gateways))
+	for _, gateway := range gateways {
+		resource := &InternetGatewayResource{
+			gateway: gateway,
+			region:  h.region,
+		}
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			fields := strings.ToLower(strings.Join(append([]string{gateway.Name, gateway.InternetGatewayID}, gateway.VpcIDs...), " "))
+			if !strings.Contains(fields, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *InternetGatewaysHandler) Get(ctx context.Context, id string) (Resource, error) {
+	gateways, err := h.client.ListInternetGateways(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get internet gateway %s", id), err)
+	}
+
+	for _, gateway := range gateways {
+		if gateway.InternetGatewayID == id {
+			return &InternetGatewayResource{gateway: gateway, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("internet gateway %s not found", id), nil)
+}
+
+func (h *InternetGatewaysHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe internet gateway %s", id), err)
+	}
+
+	details := res.ToDetailMap()
+	if tags := res.GetTags(); len(tags) > 0 {
+		details["Tags"] = tags
+	}
+	return details, nil
+}
+
+func (h *InternetGatewaysHandler) Actions() []Action {
+	return []Action{
+		{Key: "P", Name: "reachability", Description: "Analyze path (source, then destination)", Mutating: true},
+	}
+}
+
+func (h *InternetGatewaysHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "reachability" {
+		return ErrNotSupported
+	}
+	return &ReachabilityEndpointAction{
+		ResourceID: resourceID,
+		Region:     h.region,
+	}
+}
+
+// InternetGatewayResource implements Resource interface for internet gateways
+type InternetGatewayResource struct {
+	gateway ec2adapter.InternetGateway
+	region  string
+}
+
+func (r *InternetGatewayResource) GetID() string { return r.gateway.InternetGatewayID }
+func (r *InternetGatewayResource) GetName() string {
+	if r.gateway.Name != "" {
+		return r.gateway.Name
+	}
+	return r.gateway.InternetGatewayID
+}
+func (r *InternetGatewayResource) GetARN() string {
+	return fmt.Sprintf("arn:aws:ec2:%s:%s:internet-gateway/%s", r.region, r.gateway.OwnerID, r.gateway.InternetGatewayID)
+}
+func (r *InternetGatewayResource) GetType() string   { return "ec2:internet-gateways" }
+func (r *InternetGatewayResource) GetRegion() string { return r.region }
+func (r *InternetGatewayResource) GetCreatedAt() time.Time {
+	return time.Time{} // Internet gateways don't have creation time
+}
+
+func (r *InternetGatewayResource) GetTags() map[string]string {
+	return r.gateway.Tags
+}
+
+func (r *InternetGatewayResource) ToTableRow() []string {
+	name := r.gateway.Name
+	if name == "" {
+		name = "-"
+	}
+
+	vpcID := "-"
+	if len(r.gateway.VpcIDs) > 0 {
+		vpcID = strings.Join(r.gateway.VpcIDs, ", ")
+	}
+
+	return []string{
+		name,
+		r.gateway.InternetGatewayID,
+		r.gateway.State,
+		vpcID,
+		r.gateway.OwnerID,
+	}
+}
+
+func (r *InternetGatewayResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"InternetGatewayId": r.gateway.InternetGatewayID,
+		"Name":              r.gateway.Name,
+		"State":             r.gateway.State,
+		"VpcIds":            r.gateway.VpcIDs,
+		"OwnerId":           r.gateway.OwnerID,
+	}
+}
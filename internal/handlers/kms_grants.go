@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+
+	kmsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/kms"
+)
+
+// KMSGrantsHandler handles the grants of a KMS key
+type KMSGrantsHandler struct {
+	BaseHandler
+	client  *kmsadapter.KeysClient
+	region  string
+	keyID   string
+	keyName string
+}
+
+// NewKMSGrantsHandlerForKey creates a new grants handler for a key
+func NewKMSGrantsHandlerForKey(kmsClient *kms.Client, region, keyID, keyName string) *KMSGrantsHandler {
+	return &KMSGrantsHandler{
+		client:  kmsadapter.NewKeysClient(kmsClient),
+		region:  region,
+		keyID:   keyID,
+		keyName: keyName,
+	}
+}
+
+func (h *KMSGrantsHandler) ResourceType() string { return "kms:grants" }
+func (h *KMSGrantsHandler) ResourceName() string { return "Grants" }
+func (h *KMSGrantsHandler) ResourceIcon() string { return "🎫" }
+func (h *KMSGrantsHandler) ShortcutKey() string  { return "kms-grants" }
+
+func (h *KMSGrantsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Grantee", Width: 50, Sortable: true},
+		{Title: "Operations", Width: 40, Sortable: false},
+		{Title: "Name", Width: 20, Sortable: true},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *KMSGrantsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	grants, err := h.client.ListGrants(ctx, h.keyID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list grants of %s", h.keyName), err)
+	}
+
+	resources := make([]Resource, 0, len(grants))
+	for _, grant := range grants {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			grantee := strings.ToLower(grant.GranteePrincipal)
+			name := strings.ToLower(grant.Name)
+			operations := strings.ToLower(strings.Join(grant.Operations, " "))
+			if !strings.Contains(grantee, filter) && !strings.Contains(name, filter) && !strings.Contains(operations, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &KMSGrantResource{
+			grant:  grant,
+			keyID:  h.keyID,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *KMSGrantsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	grants, err := h.client.ListGrants(ctx, h.keyID)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get grant %s", id), err)
+	}
+
+	for _, grant := range grants {
+		if grant.GrantID == id {
+			return &KMSGrantResource{grant: grant, keyID: h.keyID, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("grant %s not found", id), nil)
+}
+
+func (h *KMSGrantsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe grant %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+// KMSGrantResource implements Resource interface for KMS grants
+type KMSGrantResource struct {
+	grant  kmsadapter.Grant
+	keyID  string
+	region string
+}
+
+func (r *KMSGrantResource) GetID() string              { return r.grant.GrantID }
+func (r *KMSGrantResource) GetName() string            { return r.grant.Name }
+func (r *KMSGrantResource) GetARN() string             { return "" }
+func (r *KMSGrantResource) GetType() string            { return "kms:grants" }
+func (r *KMSGrantResource) GetRegion() string          { return r.region }
+func (r *KMSGrantResource) GetCreatedAt() time.Time    { return r.grant.CreationDate }
+func (r *KMSGrantResource) GetTags() map[string]string { return nil }
+
+func (r *KMSGrantResource) ToTableRow() []string {
+	created := ""
+	if !r.grant.CreationDate.IsZero() {
+		created = r.grant.CreationDate.Format("2006-01-02")
+	}
+
+	return []string{
+		r.grant.GranteePrincipal,
+		strings.Join(r.grant.Operations, ", "),
+		orDash(r.grant.Name),
+		created,
+	}
+}
+
+func (r *KMSGrantResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"GrantId":          r.grant.GrantID,
+		"KeyId":            r.keyID,
+		"GranteePrincipal": r.grant.GranteePrincipal,
+		"Operations":       r.grant.Operations,
+		"IssuingAccount":   r.grant.IssuingAccount,
+	}
+	if r.grant.Name != "" {
+		details["Name"] = r.grant.Name
+	}
+	if r.grant.RetiringPrincipal != "" {
+		details["RetiringPrincipal"] = r.grant.RetiringPrincipal
+	}
+	if len(r.grant.Constraints) > 0 {
+		details["Constraints"] = r.grant.Constraints
+	}
+	if !r.grant.CreationDate.IsZero() {
+		details["CreationDate"] = r.grant.CreationDate.Format(time.RFC3339)
+	}
+	return details
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 
 	kmsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/kms"
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // KMSKeysHandler handles KMS Key resources
@@ -38,7 +39,7 @@ func (h *KMSKeysHandler) Columns() []ColumnDef {
 		{Title: "State", Width: 12, Sortable: true},
 		{Title: "Usage", Width: 18, Sortable: false},
 		{Title: "Origin", Width: 10, Sortable: false},
-		{Title: "Created", Width: 12, Sortable: true},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
 		{Title: "Description", Width: 30, Sortable: false},
 	}
 }
@@ -102,7 +103,7 @@ func (h *KMSKeysHandler) Describe(ctx context.Context, id string) (map[string]in
 	details := make(map[string]interface{})
 
 	// Basic info
-	details["Key"] = map[string]interface{}{
+	keyInfo := map[string]interface{}{
 		"KeyId":       key.KeyID,
 		"ARN":         key.KeyARN,
 		"Alias":       key.AliasName,
@@ -115,13 +116,34 @@ func (h *KMSKeysHandler) Describe(ctx context.Context, id string) (map[string]in
 		"Enabled":     key.Enabled,
 		"CreatedAt":   key.CreationDate.Format(time.RFC3339),
 	}
+	if !key.DeletionDate.IsZero() {
+		keyInfo["DeletionDate"] = key.DeletionDate.Format(time.RFC3339)
+	}
+	details["Key"] = keyInfo
+
+	aliases, err := h.client.ListAliases(ctx, id)
+	if err == nil && len(aliases) > 0 {
+		details["Aliases"] = aliases
+	}
 
 	// Try to get rotation status
-	rotationEnabled, err := h.client.GetKeyRotationStatus(ctx, id)
+	rotation, err := h.client.GetKeyRotationStatus(ctx, id)
 	if err == nil {
-		details["Rotation"] = map[string]interface{}{
-			"Enabled": rotationEnabled,
+		status := map[string]interface{}{
+			"Enabled": rotation.Enabled,
+		}
+		if rotation.PeriodInDays > 0 {
+			status["PeriodInDays"] = rotation.PeriodInDays
+		}
+		if !rotation.NextRotationDate.IsZero() {
+			status["NextRotationDate"] = rotation.NextRotationDate.Format(time.RFC3339)
 		}
+		details["Rotation"] = status
+	}
+
+	grants, err := h.client.ListGrants(ctx, id)
+	if err == nil {
+		details["Grants"] = len(grants)
 	}
 
 	// Try to get key policy
@@ -147,8 +169,169 @@ func (h *KMSKeysHandler) Describe(ctx context.Context, id string) (map[string]in
 func (h *KMSKeysHandler) Actions() []Action {
 	return []Action{
 		{Key: "p", Name: "policy", Description: "View key policy"},
-		{Key: "r", Name: "rotation", Description: "View rotation status"},
+		{Key: "g", Name: "grants", Description: "View grants"},
+		{Key: "o", Name: "rotation", Description: "Enable/disable annual rotation", Mutating: true},
+		{Key: "x", Name: "delete", Description: "Schedule key deletion", Mutating: true, Severity: SeverityHigh},
+		{Key: "u", Name: "undelete", Description: "Cancel key deletion", Mutating: true},
+	}
+}
+
+func (h *KMSKeysHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	key, err := h.client.GetKey(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+	name := key.KeyID
+	if key.AliasName != "" {
+		name = key.AliasName
+	}
+
+	switch action {
+	case "policy":
+		return &ViewKeyPolicyAction{KeyID: key.KeyID, KeyName: name}
+	case "grants":
+		return &NavigateToKMSGrantsAction{KeyID: key.KeyID, KeyName: name}
+	}
+
+	// AWS managed keys can't be changed
+	if !key.CustomerOwned {
+		switch action {
+		case "rotation", "delete", "undelete":
+			return fmt.Errorf("%s is an AWS managed key", name)
+		}
 	}
+
+	switch action {
+	case "rotation":
+		if key.KeySpec != "SYMMETRIC_DEFAULT" || key.Origin != "AWS_KMS" {
+			return fmt.Errorf("automatic rotation is only supported for symmetric keys with AWS_KMS key material")
+		}
+		rotation, err := h.client.GetKeyRotationStatus(ctx, key.KeyID)
+		if err != nil {
+			return err
+		}
+		return &ToggleKeyRotationAction{KeyID: key.KeyID, KeyName: name, Enable: !rotation.Enabled}
+	case "delete":
+		if key.KeyState == "PendingDeletion" {
+			return fmt.Errorf("%s is already scheduled for deletion on %s", name, key.DeletionDate.Format("2006-01-02"))
+		}
+		return &ScheduleKeyDeletionAction{KeyID: key.KeyID, KeyName: name}
+	case "undelete":
+		if key.KeyState != "PendingDeletion" {
+			return fmt.Errorf("%s isn't scheduled for deletion", name)
+		}
+		return &CancelKeyDeletionAction{KeyID: key.KeyID, KeyName: name}
+	}
+
+	return ErrNotSupported
+}
+
+// GetKeyPolicyForView retrieves the key policy for viewing
+func (h *KMSKeysHandler) GetKeyPolicyForView(ctx context.Context, keyID string) (interface{}, error) {
+	policy, err := h.client.GetKeyPolicy(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var policyDoc map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &policyDoc); err != nil {
+		return map[string]string{"policy": policy}, nil
+	}
+	return policyDoc, nil
+}
+
+// SetKeyRotation enables or disables annual rotation of a key
+func (h *KMSKeysHandler) SetKeyRotation(ctx context.Context, keyID string, enable bool) error {
+	if enable {
+		return h.client.EnableKeyRotation(ctx, keyID)
+	}
+	return h.client.DisableKeyRotation(ctx, keyID)
+}
+
+// ScheduleKeyDeletion schedules a key for deletion and returns the deletion date
+func (h *KMSKeysHandler) ScheduleKeyDeletion(ctx context.Context, keyID string, pendingWindowDays int32) (time.Time, error) {
+	return h.client.ScheduleKeyDeletion(ctx, keyID, pendingWindowDays)
+}
+
+// CancelKeyDeletion cancels a scheduled key deletion
+func (h *KMSKeysHandler) CancelKeyDeletion(ctx context.Context, keyID string) error {
+	return h.client.CancelKeyDeletion(ctx, keyID)
+}
+
+// ViewKeyPolicyAction triggers viewing a key policy
+type ViewKeyPolicyAction struct {
+	KeyID   string
+	KeyName string
+}
+
+func (a *ViewKeyPolicyAction) Error() string {
+	return fmt.Sprintf("view policy for key %s", a.KeyName)
+}
+
+func (a *ViewKeyPolicyAction) IsActionMsg() {}
+
+// NavigateToKMSGrantsAction triggers navigation to the grants of a key
+type NavigateToKMSGrantsAction struct {
+	KeyID   string
+	KeyName string
+}
+
+func (a *NavigateToKMSGrantsAction) Error() string {
+	return fmt.Sprintf("navigate to grants of %s", a.KeyName)
+}
+
+func (a *NavigateToKMSGrantsAction) IsActionMsg() {}
+
+// ToggleKeyRotationAction is returned by ExecuteAction to confirm turning rotation on or off
+type ToggleKeyRotationAction struct {
+	KeyID   string
+	KeyName string
+	Enable  bool
+}
+
+func (a *ToggleKeyRotationAction) Error() string {
+	if a.Enable {
+		return fmt.Sprintf("enable rotation of %s", a.KeyName)
+	}
+	return fmt.Sprintf("disable rotation of %s", a.KeyName)
+}
+
+func (a *ToggleKeyRotationAction) IsActionMsg() {}
+
+// ScheduleKeyDeletionAction triggers the key deletion confirmation
+type ScheduleKeyDeletionAction struct {
+	KeyID   string
+	KeyName string
+}
+
+func (a *ScheduleKeyDeletionAction) Error() string {
+	return fmt.Sprintf("schedule deletion of %s", a.KeyName)
+}
+
+func (a *ScheduleKeyDeletionAction) IsActionMsg() {}
+
+// CancelKeyDeletionAction is returned by ExecuteAction to confirm cancelling a key deletion
+type CancelKeyDeletionAction struct {
+	KeyID   string
+	KeyName string
+}
+
+func (a *CancelKeyDeletionAction) Error() string {
+	return fmt.Sprintf("cancel deletion of %s", a.KeyName)
+}
+
+func (a *CancelKeyDeletionAction) IsActionMsg() {}
+
+func (h *KMSKeysHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	key, err := h.client.GetKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return key.Tags, nil
+}
+
+func (h *KMSKeysHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	return h.client.UpdateTags(ctx, id, set, remove)
 }
 
 // KMSKeyResource implements Resource interface for KMS keys
@@ -194,7 +377,7 @@ func (r *KMSKeyResource) ToTableRow() []string {
 		r.key.KeyUsage,
 		r.key.Origin,
 		created,
-		truncateString(r.key.Description, 30),
+		utils.Truncate(r.key.Description, 30),
 	}
 }
 
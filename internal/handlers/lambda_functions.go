@@ -11,18 +11,31 @@ import (
 	lambdaadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/lambda"
 )
 
+// InvokeFunctionAction triggers the test event picker to invoke a function
+type InvokeFunctionAction struct {
+	FunctionName string
+}
+
+func (a *InvokeFunctionAction) Error() string {
+	return fmt.Sprintf("invoke %s", a.FunctionName)
+}
+
+func (a *InvokeFunctionAction) IsActionMsg() {}
+
 // LambdaFunctionsHandler handles Lambda Function resources
 type LambdaFunctionsHandler struct {
 	BaseHandler
-	client *lambdaadapter.FunctionsClient
-	region string
+	client   *lambdaadapter.FunctionsClient
+	versions *lambdaadapter.VersionsClient
+	region   string
 }
 
 // NewLambdaFunctionsHandler creates a new Lambda functions handler
 func NewLambdaFunctionsHandler(lambdaClient *lambda.Client, region string) *LambdaFunctionsHandler {
 	return &LambdaFunctionsHandler{
-		client: lambdaadapter.NewFunctionsClient(lambdaClient),
-		region: region,
+		client:   lambdaadapter.NewFunctionsClient(lambdaClient),
+		versions: lambdaadapter.NewVersionsClient(lambdaClient),
+		region:   region,
 	}
 }
 
@@ -35,10 +48,10 @@ func (h *LambdaFunctionsHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Function Name", Width: 35, Sortable: true},
 		{Title: "Runtime", Width: 15, Sortable: true},
-		{Title: "Memory", Width: 8, Sortable: true},
-		{Title: "Timeout", Width: 8, Sortable: false},
-		{Title: "Code Size", Width: 12, Sortable: false},
-		{Title: "Last Modified", Width: 12, Sortable: true},
+		{Title: "Memory", Width: 8, Sortable: true, SortType: SortSize},
+		{Title: "Timeout", Width: 8, Sortable: true, SortType: SortNumber},
+		{Title: "Code Size", Width: 12, Sortable: true, SortType: SortSize},
+		{Title: "Last Modified", Width: 12, Sortable: true, SortType: SortDate},
 	}
 }
 
@@ -119,6 +132,15 @@ func (h *LambdaFunctionsHandler) Describe(ctx context.Context, id string) (map[s
 		"LastModified":  fn.LastModified.Format(time.RFC3339),
 	}
 
+	// Reserved concurrency, unset means the function draws from the unreserved pool
+	if reserved, ok, err := h.versions.GetReservedConcurrency(ctx, id); err == nil {
+		concurrency := "unreserved"
+		if ok {
+			concurrency = fmt.Sprintf("%d", reserved)
+		}
+		details["Configuration"].(map[string]interface{})["ReservedConcurrency"] = concurrency
+	}
+
 	// IAM Role
 	details["IAM"] = map[string]interface{}{
 		"Role": fn.Role,
@@ -143,9 +165,55 @@ func (h *LambdaFunctionsHandler) Describe(ctx context.Context, id string) (map[s
 
 func (h *LambdaFunctionsHandler) Actions() []Action {
 	return []Action{
-		{Key: "i", Name: "invoke", Description: "Invoke function"},
+		{Key: "i", Name: "invoke", Description: "Invoke function", Mutating: true},
 		{Key: "l", Name: "logs", Description: "View CloudWatch logs"},
+		{Key: "v", Name: "versions", Description: "Versions and aliases"},
+		{Key: "R", Name: "concurrency", Description: "Set reserved concurrency", Mutating: true},
+	}
+}
+
+func (h *LambdaFunctionsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "invoke":
+		return &InvokeFunctionAction{FunctionName: resourceID}
+
+	case "versions":
+		return &NavigateToLambdaVersionsAction{FunctionName: resourceID}
+
+	case "concurrency":
+		result, err := reservedConcurrencyAction(ctx, h.versions, resourceID)
+		if err != nil {
+			return err
+		}
+		return result
+	}
+
+	return ErrNotSupported
+}
+
+// Invoke invokes a function synchronously with a JSON payload
+func (h *LambdaFunctionsHandler) Invoke(ctx context.Context, functionName, payload string) (*lambdaadapter.InvokeResult, error) {
+	return h.client.Invoke(ctx, functionName, payload)
+}
+
+func (h *LambdaFunctionsHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	fn, err := h.client.GetFunction(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if fn.Tags == nil {
+		return map[string]string{}, nil
+	}
+	return fn.Tags, nil
+}
+
+// UpdateTags tags the function by ARN, which Lambda's tag APIs need
+func (h *LambdaFunctionsHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	fn, err := h.client.GetFunction(ctx, id)
+	if err != nil {
+		return err
 	}
+	return h.client.UpdateTags(ctx, fn.FunctionARN, set, remove)
 }
 
 // LambdaFunctionResource implements Resource interface for Lambda functions
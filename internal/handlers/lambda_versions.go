@@ -0,0 +1,469 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	lambdaadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/lambda"
+)
+
+// lambdaUnreservedMinimum is the concurrency Lambda always keeps unreserved in an account
+const lambdaUnreservedMinimum = 100
+
+// NavigateToLambdaVersionsAction triggers navigation to the versions and aliases of a function
+type NavigateToLambdaVersionsAction struct {
+	FunctionName string
+}
+
+func (a *NavigateToLambdaVersionsAction) Error() string {
+	return fmt.Sprintf("navigate to versions of %s", a.FunctionName)
+}
+
+func (a *NavigateToLambdaVersionsAction) IsActionMsg() {}
+
+// PublishVersionAction triggers publishing a new version after confirmation
+type PublishVersionAction struct {
+	FunctionName string
+}
+
+func (a *PublishVersionAction) Error() string {
+	return fmt.Sprintf("publish version of %s", a.FunctionName)
+}
+
+func (a *PublishVersionAction) IsActionMsg() {}
+
+// UpdateAliasAction triggers pointing an alias at another version after confirmation
+type UpdateAliasAction struct {
+	FunctionName   string
+	AliasName      string
+	CurrentVersion string
+	LatestVersion  int
+	Weighted       bool // The alias currently splits traffic, repointing removes the split
+}
+
+func (a *UpdateAliasAction) Error() string {
+	return fmt.Sprintf("update alias %s of %s", a.AliasName, a.FunctionName)
+}
+
+func (a *UpdateAliasAction) IsActionMsg() {}
+
+// SetReservedConcurrencyAction triggers setting a function's reserved concurrency after confirmation
+type SetReservedConcurrencyAction struct {
+	FunctionName string
+	Reserved     int32
+	HasReserved  bool
+	Max          int32 // Most that can be reserved while keeping the account's unreserved minimum
+}
+
+func (a *SetReservedConcurrencyAction) Error() string {
+	return fmt.Sprintf("set reserved concurrency of %s", a.FunctionName)
+}
+
+func (a *SetReservedConcurrencyAction) IsActionMsg() {}
+
+// reservedConcurrencyAction looks up a function's current reservation and the account headroom
+func reservedConcurrencyAction(ctx context.Context, client *lambdaadapter.VersionsClient, functionName string) (*SetReservedConcurrencyAction, error) {
+	reserved, hasReserved, err := client.GetReservedConcurrency(ctx, functionName)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.GetAccountConcurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	max := account.Unreserved + reserved - lambdaUnreservedMinimum
+	if max < 0 {
+		max = 0
+	}
+
+	return &SetReservedConcurrencyAction{
+		FunctionName: functionName,
+		Reserved:     reserved,
+		HasReserved:  hasReserved,
+		Max:          max,
+	}, nil
+}
+
+// LambdaVersionsHandler handles the versions and aliases of a single Lambda function
+type LambdaVersionsHandler struct {
+	BaseHandler
+	client       *lambdaadapter.VersionsClient
+	region       string
+	functionName string
+}
+
+// NewLambdaVersionsHandler creates a new handler for the versions and aliases of a function
+func NewLambdaVersionsHandler(lambdaClient *lambda.Client, region, functionName string) *LambdaVersionsHandler {
+	return &LambdaVersionsHandler{
+		client:       lambdaadapter.NewVersionsClient(lambdaClient),
+		region:       region,
+		functionName: functionName,
+	}
+}
+
+func (h *LambdaVersionsHandler) ResourceType() string { return "lambda:versions" }
+func (h *LambdaVersionsHandler) ResourceName() string { return "Lambda Versions" }
+func (h *LambdaVersionsHandler) ResourceIcon() string { return "λ" }
+func (h *LambdaVersionsHandler) ShortcutKey() string  { return "lambda-versions" }
+
+func (h *LambdaVersionsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 20, Sortable: true},
+		{Title: "Type", Width: 8, Sortable: true},
+		{Title: "Version", Width: 16, Sortable: true},
+		{Title: "Provisioned", Width: 20, Sortable: false},
+		{Title: "Reserved", Width: 10, Sortable: false},
+		{Title: "Description", Width: 30, Sortable: false},
+		{Title: "Last Modified", Width: 17, Sortable: true, SortType: SortDate},
+	}
+}
+
+// load fetches the versions, aliases and concurrency of the function
+func (h *LambdaVersionsHandler) load(ctx context.Context) ([]*LambdaVersionResource, error) {
+	versions, err := h.client.ListVersions(ctx, h.functionName)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := h.client.ListAliases(ctx, h.functionName)
+	if err != nil {
+		return nil, err
+	}
+
+	provisioned, err := h.client.ListProvisionedConcurrency(ctx, h.functionName)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := "-"
+	if n, ok, err := h.client.GetReservedConcurrency(ctx, h.functionName); err == nil && ok {
+		reserved = strconv.Itoa(int(n))
+	}
+
+	// Aliases by name, then $LATEST and versions newest first
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+	sort.Slice(versions, func(i, j int) bool {
+		if versions[i].Version == "$LATEST" || versions[j].Version == "$LATEST" {
+			return versions[i].Version == "$LATEST"
+		}
+		a, _ := strconv.Atoi(versions[i].Version)
+		b, _ := strconv.Atoi(versions[j].Version)
+		return a > b
+	})
+
+	resources := make([]*LambdaVersionResource, 0, len(aliases)+len(versions))
+	for i := range aliases {
+		res := &LambdaVersionResource{
+			functionName: h.functionName,
+			alias:        &aliases[i],
+			reserved:     reserved,
+			region:       h.region,
+		}
+		if pc, ok := provisioned[aliases[i].Name]; ok {
+			res.provisioned = &pc
+		}
+		resources = append(resources, res)
+	}
+	for i := range versions {
+		res := &LambdaVersionResource{
+			functionName: h.functionName,
+			version:      &versions[i],
+			reserved:     reserved,
+			region:       h.region,
+		}
+		if pc, ok := provisioned[versions[i].Version]; ok {
+			res.provisioned = &pc
+		}
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+func (h *LambdaVersionsHandler) find(ctx context.Context, id string) (*LambdaVersionResource, error) {
+	resources, err := h.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range resources {
+		if res.GetID() == id {
+			return res, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s not found for function %s", id, h.functionName)
+}
+
+func (h *LambdaVersionsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	all, err := h.load(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list versions of %s", h.functionName), err)
+	}
+
+	resources := make([]Resource, 0, len(all))
+	for _, res := range all {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(res.GetName()), filter) &&
+				!strings.Contains(strings.ToLower(res.description()), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, res)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *LambdaVersionsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	res, err := h.find(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get %s", id), err)
+	}
+	return res, nil
+}
+
+func (h *LambdaVersionsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.find(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	if res.alias != nil {
+		alias := map[string]interface{}{
+			"Name":            res.alias.Name,
+			"AliasArn":        res.alias.AliasARN,
+			"FunctionVersion": res.alias.FunctionVersion,
+			"Description":     res.alias.Description,
+		}
+		if len(res.alias.RoutingWeights) > 0 {
+			alias["AdditionalVersionWeights"] = res.alias.RoutingWeights
+		}
+		details["Alias"] = alias
+	} else {
+		details["Version"] = map[string]interface{}{
+			"Version":      res.version.Version,
+			"FunctionArn":  res.version.FunctionARN,
+			"Description":  res.version.Description,
+			"Runtime":      res.version.Runtime,
+			"CodeSha256":   res.version.CodeSha256,
+			"CodeSize":     formatBytes(res.version.CodeSize),
+			"MemorySize":   fmt.Sprintf("%d MB", res.version.MemorySize),
+			"State":        res.version.State,
+			"LastModified": res.version.LastModified.Format(time.RFC3339),
+		}
+	}
+
+	concurrency := map[string]interface{}{
+		"ReservedConcurrency": res.reserved,
+	}
+	if pc := res.provisioned; pc != nil {
+		concurrency["Provisioned"] = map[string]interface{}{
+			"Requested":    pc.Requested,
+			"Allocated":    pc.Allocated,
+			"Available":    pc.Available,
+			"Status":       pc.Status,
+			"StatusReason": pc.StatusReason,
+		}
+	}
+	details["Concurrency"] = concurrency
+
+	return details, nil
+}
+
+func (h *LambdaVersionsHandler) Actions() []Action {
+	return []Action{
+		{Key: "p", Name: "publish", Description: "Publish new version", Mutating: true},
+		{Key: "a", Name: "alias", Description: "Point alias at version", Mutating: true},
+		{Key: "R", Name: "concurrency", Description: "Set reserved concurrency", Mutating: true},
+	}
+}
+
+func (h *LambdaVersionsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "publish":
+		return &PublishVersionAction{FunctionName: h.functionName}
+
+	case "alias":
+		if !strings.HasPrefix(resourceID, "alias:") {
+			return fmt.Errorf("select an alias to repoint it")
+		}
+		res, err := h.find(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+
+		latest, err := h.latestVersion(ctx)
+		if err != nil {
+			return err
+		}
+		if latest == 0 {
+			return fmt.Errorf("%s has no published versions", h.functionName)
+		}
+
+		return &UpdateAliasAction{
+			FunctionName:   h.functionName,
+			AliasName:      res.alias.Name,
+			CurrentVersion: res.alias.FunctionVersion,
+			LatestVersion:  latest,
+			Weighted:       len(res.alias.RoutingWeights) > 0,
+		}
+
+	case "concurrency":
+		result, err := reservedConcurrencyAction(ctx, h.client, h.functionName)
+		if err != nil {
+			return err
+		}
+		return result
+	}
+
+	return ErrNotSupported
+}
+
+// latestVersion returns the highest published version number, or 0 if none are published
+func (h *LambdaVersionsHandler) latestVersion(ctx context.Context) (int, error) {
+	versions, err := h.client.ListVersions(ctx, h.functionName)
+	if err != nil {
+		return 0, err
+	}
+
+	latest := 0
+	for _, v := range versions {
+		if n, err := strconv.Atoi(v.Version); err == nil && n > latest {
+			latest = n
+		}
+	}
+	return latest, nil
+}
+
+// PublishVersion publishes $LATEST as a new version and returns its number
+func (h *LambdaVersionsHandler) PublishVersion(ctx context.Context) (string, error) {
+	version, err := h.client.PublishVersion(ctx, h.functionName, "")
+	if err != nil {
+		return "", err
+	}
+	return version.Version, nil
+}
+
+// UpdateAlias points an alias at a version
+func (h *LambdaVersionsHandler) UpdateAlias(ctx context.Context, aliasName, version string) error {
+	return h.client.UpdateAlias(ctx, h.functionName, aliasName, version)
+}
+
+// SetReservedConcurrency reserves concurrency for the function, or removes the reservation if reserved is negative
+func (h *LambdaVersionsHandler) SetReservedConcurrency(ctx context.Context, reserved int32) error {
+	if reserved < 0 {
+		return h.client.DeleteReservedConcurrency(ctx, h.functionName)
+	}
+	return h.client.PutReservedConcurrency(ctx, h.functionName, reserved)
+}
+
+// LambdaVersionResource implements Resource interface for a function version or alias
+type LambdaVersionResource struct {
+	functionName string
+	version      *lambdaadapter.FunctionVersion // Set for versions
+	alias        *lambdaadapter.Alias           // Set for aliases
+	provisioned  *lambdaadapter.ProvisionedConcurrency
+	reserved     string
+	region       string
+}
+
+func (r *LambdaVersionResource) GetID() string {
+	if r.alias != nil {
+		return "alias:" + r.alias.Name
+	}
+	return "version:" + r.version.Version
+}
+
+func (r *LambdaVersionResource) GetName() string {
+	if r.alias != nil {
+		return r.alias.Name
+	}
+	return r.version.Version
+}
+
+func (r *LambdaVersionResource) GetARN() string {
+	if r.alias != nil {
+		return r.alias.AliasARN
+	}
+	return r.version.FunctionARN
+}
+
+func (r *LambdaVersionResource) GetType() string   { return "lambda:versions" }
+func (r *LambdaVersionResource) GetRegion() string { return r.region }
+
+func (r *LambdaVersionResource) GetCreatedAt() time.Time {
+	if r.version != nil {
+		return r.version.LastModified
+	}
+	return time.Time{}
+}
+
+func (r *LambdaVersionResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *LambdaVersionResource) description() string {
+	if r.alias != nil {
+		return r.alias.Description
+	}
+	return r.version.Description
+}
+
+func (r *LambdaVersionResource) ToTableRow() []string {
+	kind := "version"
+	version := "-"
+	lastMod := "-"
+	if r.alias != nil {
+		kind = "alias"
+		version = r.alias.FunctionVersion
+		for v, weight := range r.alias.RoutingWeights {
+			version += fmt.Sprintf(" +%s@%.0f%%", v, weight*100)
+		}
+	} else if !r.version.LastModified.IsZero() {
+		lastMod = r.version.LastModified.Format("2006-01-02 15:04")
+	}
+
+	provisioned := "-"
+	if pc := r.provisioned; pc != nil {
+		provisioned = fmt.Sprintf("%d/%d", pc.Allocated, pc.Requested)
+		if pc.Status != "READY" {
+			provisioned += " " + pc.Status
+		}
+	}
+
+	return []string{
+		r.GetName(),
+		kind,
+		version,
+		provisioned,
+		r.reserved,
+		r.description(),
+		lastMod,
+	}
+}
+
+func (r *LambdaVersionResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"FunctionName": r.functionName,
+		"Name":         r.GetName(),
+		"Arn":          r.GetARN(),
+		"Reserved":     r.reserved,
+	}
+}
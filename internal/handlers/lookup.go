@@ -0,0 +1,411 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+	elbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/elb"
+	r53adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/route53"
+)
+
+// LookupHandler finds which resources own an IP address or DNS name
+type LookupHandler struct {
+	BaseHandler
+	eniClient *ec2adapter.NetworkInterfacesClient
+	elbClient *elbadapter.LoadBalancersClient
+	r53Client *r53adapter.RecordsClient
+	region    string
+	query     string
+
+	// Matches from the last lookup, keyed by resource ID
+	matches map[string]LookupMatch
+}
+
+// LookupMatch is a single resource that matched a lookup query
+type LookupMatch struct {
+	Source     string // EIP, ENI, ELB, Route53, DNS
+	ResourceID string
+	Name       string
+	MatchedOn  string
+	Owner      string
+	Details    map[string]interface{}
+}
+
+// NewLookupHandler creates a new lookup handler for an IP address or DNS name
+func NewLookupHandler(ec2Client *ec2.Client, elbClient *elbv2.Client, r53Client *route53.Client, region, query string) *LookupHandler {
+	return &LookupHandler{
+		eniClient: ec2adapter.NewNetworkInterfacesClient(ec2Client),
+		elbClient: elbadapter.NewLoadBalancersClient(elbClient),
+		r53Client: r53adapter.NewRecordsClient(r53Client),
+		region:    region,
+		query:     query,
+		matches:   make(map[string]LookupMatch),
+	}
+}
+
+func (h *LookupHandler) ResourceType() string { return "lookup:results" }
+func (h *LookupHandler) ResourceName() string { return "Lookup" }
+func (h *LookupHandler) ResourceIcon() string { return "🔎" }
+func (h *LookupHandler) ShortcutKey() string  { return "lookup" }
+
+func (h *LookupHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Source", Width: 8, Sortable: true},
+		{Title: "Resource", Width: 30, Sortable: true},
+		{Title: "Name", Width: 25, Sortable: true},
+		{Title: "Matched On", Width: 30, Sortable: false},
+		{Title: "Owner", Width: 35, Sortable: true},
+	}
+}
+
+func (h *LookupHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	query := normalizeDNSName(h.query)
+	if query == "" {
+		return nil, NewHandlerError("LIST_FAILED", "nothing to look up", nil)
+	}
+
+	var matches []LookupMatch
+	var failures []string
+
+	// Resolve names so we can also find what owns the addresses they point at
+	ips := []string{}
+	isIP := net.ParseIP(query) != nil
+	if isIP {
+		ips = append(ips, query)
+	} else {
+		resolved, err := net.DefaultResolver.LookupHost(ctx, query)
+		if err == nil {
+			for _, ip := range resolved {
+				ips = append(ips, ip)
+				matches = append(matches, LookupMatch{
+					Source:     "DNS",
+					ResourceID: ip,
+					Name:       query,
+					MatchedOn:  "resolves to " + ip,
+					Owner:      "-",
+					Details: map[string]interface{}{
+						"Query":    query,
+						"Resolved": ip,
+					},
+				})
+			}
+		}
+	}
+
+	// Elastic IPs and ENIs by address
+	for _, ip := range ips {
+		addresses, err := h.eniClient.FindAddressesByIP(ctx, ip)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("Elastic IPs: %v", err))
+		}
+		for _, addr := range addresses {
+			matches = append(matches, eipMatch(addr, ip))
+		}
+
+		enis, err := h.eniClient.FindNetworkInterfacesByIP(ctx, ip)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("Network interfaces: %v", err))
+		}
+		for _, eni := range enis {
+			matches = append(matches, eniMatch(eni, ip))
+		}
+	}
+
+	// Load balancers by DNS name
+	if !isIP {
+		lbs, err := h.elbClient.ListLoadBalancers(ctx)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("Load balancers: %v", err))
+		}
+		for _, lb := range lbs {
+			dns := normalizeDNSName(lb.DNSName)
+			if query == dns || query == "dualstack."+dns {
+				matches = append(matches, lbMatch(lb, "DNS name "+dns))
+			}
+		}
+	}
+
+	// Route 53 records by name, value or alias target
+	zones, err := h.r53Client.ListHostedZones(ctx)
+	if err != nil {
+		failures = append(failures, fmt.Sprintf("Route 53: %v", err))
+	}
+	for _, zone := range zones {
+		records, err := h.r53Client.ListRecords(ctx, zone)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("Route 53 zone %s: %v", zone.Name, err))
+			continue
+		}
+		for _, record := range records {
+			if matchedOn := matchRecord(record, query, ips); matchedOn != "" {
+				matches = append(matches, recordMatch(record, matchedOn))
+			}
+		}
+	}
+
+	// Surface partial failures so a missing permission doesn't look like "not found"
+	seenFailures := make(map[string]bool)
+	for i, failure := range failures {
+		if seenFailures[failure] {
+			continue
+		}
+		seenFailures[failure] = true
+
+		matches = append(matches, LookupMatch{
+			Source:     "Error",
+			ResourceID: fmt.Sprintf("error-%d", i+1),
+			Name:       "-",
+			MatchedOn:  "-",
+			Owner:      failure,
+			Details: map[string]interface{}{
+				"Error": failure,
+			},
+		})
+	}
+
+	h.matches = make(map[string]LookupMatch, len(matches))
+	resources := make([]Resource, 0, len(matches))
+	for _, match := range matches {
+		id := match.Source + ":" + match.ResourceID
+		h.matches[id] = match
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(id), filter) && !strings.Contains(strings.ToLower(match.Owner), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &LookupResource{
+			id:     id,
+			match:  match,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *LookupHandler) Get(ctx context.Context, id string) (Resource, error) {
+	match, ok := h.matches[id]
+	if !ok {
+		return nil, NewHandlerError("NOT_FOUND", fmt.Sprintf("no lookup result %s", id), nil)
+	}
+	return &LookupResource{id: id, match: match, region: h.region}, nil
+}
+
+func (h *LookupHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	match, ok := h.matches[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("no lookup result %s", id), nil)
+	}
+
+	details := make(map[string]interface{})
+
+	details["Match"] = map[string]interface{}{
+		"Query":     h.query,
+		"Source":    match.Source,
+		"Resource":  match.ResourceID,
+		"Name":      match.Name,
+		"MatchedOn": match.MatchedOn,
+		"Owner":     match.Owner,
+	}
+
+	if len(match.Details) > 0 {
+		details[match.Source] = match.Details
+	}
+
+	return details, nil
+}
+
+// normalizeDNSName lowercases a name and strips the trailing root dot
+func normalizeDNSName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// matchRecord returns why a record matches the query, or empty if it doesn't
+func matchRecord(record r53adapter.Record, query string, ips []string) string {
+	if normalizeDNSName(record.Name) == query {
+		return "record name"
+	}
+
+	if record.AliasTarget != "" {
+		alias := normalizeDNSName(record.AliasTarget)
+		if alias == query || strings.TrimPrefix(alias, "dualstack.") == query {
+			return "alias target"
+		}
+	}
+
+	for _, value := range record.Values {
+		v := normalizeDNSName(value)
+		if v == query {
+			return "record value"
+		}
+		for _, ip := range ips {
+			if v == ip {
+				return "record value " + ip
+			}
+		}
+	}
+
+	return ""
+}
+
+func eipMatch(addr ec2adapter.Address, ip string) LookupMatch {
+	owner := addr.InstanceID
+	if owner == "" {
+		owner = addr.NetworkInterfaceID
+	}
+	if owner == "" {
+		owner = "unassociated"
+	}
+
+	return LookupMatch{
+		Source:     "EIP",
+		ResourceID: addr.AllocationID,
+		Name:       orDash(addr.Name),
+		MatchedOn:  ip,
+		Owner:      owner,
+		Details: map[string]interface{}{
+			"AllocationId":       addr.AllocationID,
+			"AssociationId":      addr.AssociationID,
+			"PublicIp":           addr.PublicIP,
+			"PrivateIpAddress":   addr.PrivateIP,
+			"InstanceId":         addr.InstanceID,
+			"NetworkInterfaceId": addr.NetworkInterfaceID,
+			"Domain":             addr.Domain,
+		},
+	}
+}
+
+func eniMatch(eni ec2adapter.NetworkInterface, ip string) LookupMatch {
+	return LookupMatch{
+		Source:     "ENI",
+		ResourceID: eni.NetworkInterfaceID,
+		Name:       orDash(eni.Name),
+		MatchedOn:  ip,
+		Owner:      eni.Owner(),
+		Details: map[string]interface{}{
+			"NetworkInterfaceId": eni.NetworkInterfaceID,
+			"Description":        eni.Description,
+			"InterfaceType":      eni.InterfaceType,
+			"Status":             eni.Status,
+			"VpcId":              eni.VpcID,
+			"SubnetId":           eni.SubnetID,
+			"AvailabilityZone":   eni.AvailabilityZone,
+			"PrivateIpAddresses": strings.Join(eni.PrivateIPs, ", "),
+			"PublicIp":           eni.PublicIP,
+			"InstanceId":         eni.InstanceID,
+			"RequesterId":        eni.RequesterID,
+			"SecurityGroups":     strings.Join(eni.SecurityGroups, ", "),
+		},
+	}
+}
+
+func lbMatch(lb elbadapter.LoadBalancer, matchedOn string) LookupMatch {
+	return LookupMatch{
+		Source:     "ELB",
+		ResourceID: lb.ARN,
+		Name:       lb.Name,
+		MatchedOn:  matchedOn,
+		Owner:      lb.Type + " load balancer",
+		Details: map[string]interface{}{
+			"LoadBalancerArn": lb.ARN,
+			"DNSName":         lb.DNSName,
+			"Type":            lb.Type,
+			"Scheme":          lb.Scheme,
+			"State":           lb.State,
+			"VpcId":           lb.VpcID,
+		},
+	}
+}
+
+func recordMatch(record r53adapter.Record, matchedOn string) LookupMatch {
+	target := strings.Join(record.Values, ", ")
+	if record.AliasTarget != "" {
+		target = "ALIAS " + record.AliasTarget
+	}
+
+	id := record.ZoneID + "/" + record.Name + "/" + record.Type
+	if record.SetIdentifier != "" {
+		id += "/" + record.SetIdentifier
+	}
+
+	return LookupMatch{
+		Source:     "Route53",
+		ResourceID: id,
+		Name:       record.Name,
+		MatchedOn:  matchedOn,
+		Owner:      fmt.Sprintf("%s → %s", record.Type, target),
+		Details: map[string]interface{}{
+			"HostedZoneId":  record.ZoneID,
+			"HostedZone":    record.ZoneName,
+			"Name":          record.Name,
+			"Type":          record.Type,
+			"TTL":           record.TTL,
+			"Values":        record.Values,
+			"AliasTarget":   record.AliasTarget,
+			"SetIdentifier": record.SetIdentifier,
+		},
+	}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// LookupResource implements Resource interface for a lookup match
+type LookupResource struct {
+	id     string
+	match  LookupMatch
+	region string
+}
+
+func (r *LookupResource) GetID() string     { return r.id }
+func (r *LookupResource) GetName() string   { return r.match.Name }
+func (r *LookupResource) GetARN() string    { return r.match.ResourceID }
+func (r *LookupResource) GetType() string   { return "lookup:results" }
+func (r *LookupResource) GetRegion() string { return r.region }
+
+func (r *LookupResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *LookupResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *LookupResource) ToTableRow() []string {
+	return []string{
+		r.match.Source,
+		r.match.ResourceID,
+		r.match.Name,
+		r.match.MatchedOn,
+		r.match.Owner,
+	}
+}
+
+func (r *LookupResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Source":    r.match.Source,
+		"Resource":  r.match.ResourceID,
+		"Name":      r.match.Name,
+		"MatchedOn": r.match.MatchedOn,
+		"Owner":     r.match.Owner,
+	}
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+
+	mqadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/mq"
+)
+
+// MQBrokersHandler handles Amazon MQ broker resources
+type MQBrokersHandler struct {
+	BaseHandler
+	client *mqadapter.BrokersClient
+	region string
+}
+
+// NewMQBrokersHandler creates a new Amazon MQ brokers handler
+func NewMQBrokersHandler(mqClient *mq.Client, region string) *MQBrokersHandler {
+	return &MQBrokersHandler{
+		client: mqadapter.NewBrokersClient(mqClient),
+		region: region,
+	}
+}
+
+func (h *MQBrokersHandler) ResourceType() string { return "mq:brokers" }
+func (h *MQBrokersHandler) ResourceName() string { return "MQ Brokers" }
+func (h *MQBrokersHandler) ResourceIcon() string { return "📨" }
+func (h *MQBrokersHandler) ShortcutKey() string  { return "mq" }
+
+func (h *MQBrokersHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "Engine", Width: 10, Sortable: true},
+		{Title: "State", Width: 22, Sortable: true},
+		{Title: "Deployment", Width: 26, Sortable: true},
+		{Title: "Instance Type", Width: 16, Sortable: true},
+		{Title: "Created", Width: 20, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *MQBrokersHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	brokers, err := h.client.ListBrokers(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list MQ brokers", err)
+	}
+
+	resources := make([]Resource, 0, len(brokers))
+	for _, broker := range brokers {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(broker.BrokerName), filter) &&
+				!strings.Contains(strings.ToLower(broker.EngineType), filter) &&
+				!strings.Contains(strings.ToLower(broker.State), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &MQBrokerResource{
+			broker: broker,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *MQBrokersHandler) Get(ctx context.Context, id string) (Resource, error) {
+	details, err := h.client.DescribeBroker(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get MQ broker %s", id), err)
+	}
+
+	return &MQBrokerResource{
+		broker:  details.Broker,
+		details: details,
+		region:  h.region,
+	}, nil
+}
+
+func (h *MQBrokersHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	broker, err := h.client.DescribeBroker(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe MQ broker %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	// Basic info
+	info := map[string]interface{}{
+		"BrokerName":       broker.BrokerName,
+		"BrokerId":         broker.BrokerID,
+		"BrokerArn":        broker.BrokerARN,
+		"State":            broker.State,
+		"EngineType":       broker.EngineType,
+		"EngineVersion":    broker.EngineVersion,
+		"DeploymentMode":   broker.DeploymentMode,
+		"HostInstanceType": broker.HostInstanceType,
+		"StorageType":      broker.StorageType,
+		"Created":          broker.CreatedTime.Format(time.RFC3339),
+	}
+	if broker.AuthenticationStrategy != "" {
+		info["AuthenticationStrategy"] = broker.AuthenticationStrategy
+	}
+	details["Broker"] = info
+
+	// Endpoints per broker node
+	instances := make([]map[string]interface{}, 0, len(broker.Instances))
+	for _, inst := range broker.Instances {
+		instances = append(instances, map[string]interface{}{
+			"ConsoleURL": inst.ConsoleURL,
+			"IpAddress":  inst.IPAddress,
+			"Endpoints":  inst.Endpoints,
+		})
+	}
+	details["Endpoints"] = instances
+
+	// Users
+	if len(broker.Users) > 0 {
+		users := make([]map[string]interface{}, 0, len(broker.Users))
+		for _, user := range broker.Users {
+			u := map[string]interface{}{
+				"Username": user.Username,
+			}
+			if user.PendingChange != "" {
+				u["PendingChange"] = user.PendingChange
+			}
+			users = append(users, u)
+		}
+		details["Users"] = users
+	}
+
+	// Network
+	details["Network"] = map[string]interface{}{
+		"PubliclyAccessible": broker.PubliclyAccessible,
+		"SecurityGroups":     broker.SecurityGroups,
+		"SubnetIds":          broker.SubnetIDs,
+	}
+
+	// Maintenance, pending changes are applied on the next reboot or maintenance window
+	maintenance := map[string]interface{}{
+		"AutoMinorVersionUpgrade": broker.AutoMinorVersionUpgrade,
+		"MaintenanceWindow":       broker.MaintenanceWindow,
+	}
+	if broker.PendingEngineVersion != "" {
+		maintenance["PendingEngineVersion"] = broker.PendingEngineVersion
+	}
+	if broker.PendingInstanceType != "" {
+		maintenance["PendingHostInstanceType"] = broker.PendingInstanceType
+	}
+	details["Maintenance"] = maintenance
+
+	// Tags
+	if len(broker.Tags) > 0 {
+		details["Tags"] = broker.Tags
+	}
+
+	return details, nil
+}
+
+func (h *MQBrokersHandler) Actions() []Action {
+	return []Action{
+		{Key: "r", Name: "reboot", Description: "Reboot broker", Dangerous: true, Mutating: true},
+	}
+}
+
+func (h *MQBrokersHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "reboot" {
+		return ErrNotSupported
+	}
+
+	broker, err := h.client.DescribeBroker(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	if broker.State != "RUNNING" {
+		return fmt.Errorf("broker %s is %s, only running brokers can be rebooted", broker.BrokerName, broker.State)
+	}
+
+	return &RebootBrokerAction{
+		BrokerID:       broker.BrokerID,
+		BrokerName:     broker.BrokerName,
+		DeploymentMode: broker.DeploymentMode,
+	}
+}
+
+// RebootBroker reboots an Amazon MQ broker
+func (h *MQBrokersHandler) RebootBroker(ctx context.Context, brokerID string) error {
+	return h.client.RebootBroker(ctx, brokerID)
+}
+
+// RebootBrokerAction triggers rebooting a broker after confirmation
+type RebootBrokerAction struct {
+	BrokerID       string
+	BrokerName     string
+	DeploymentMode string
+}
+
+func (a *RebootBrokerAction) Error() string {
+	return fmt.Sprintf("reboot broker %s", a.BrokerName)
+}
+
+func (a *RebootBrokerAction) IsActionMsg() {}
+
+// MQBrokerResource implements Resource interface for Amazon MQ brokers
+type MQBrokerResource struct {
+	broker  mqadapter.Broker
+	details *mqadapter.BrokerDetails // Only set when fetched with Get
+	region  string
+}
+
+func (r *MQBrokerResource) GetID() string     { return r.broker.BrokerID }
+func (r *MQBrokerResource) GetName() string   { return r.broker.BrokerName }
+func (r *MQBrokerResource) GetARN() string    { return r.broker.BrokerARN }
+func (r *MQBrokerResource) GetType() string   { return "mq:brokers" }
+func (r *MQBrokerResource) GetRegion() string { return r.region }
+
+func (r *MQBrokerResource) GetCreatedAt() time.Time {
+	return r.broker.CreatedTime
+}
+
+func (r *MQBrokerResource) GetTags() map[string]string {
+	if r.details != nil {
+		return r.details.Tags
+	}
+	return nil
+}
+
+func (r *MQBrokerResource) ToTableRow() []string {
+	created := "-"
+	if !r.broker.CreatedTime.IsZero() {
+		created = r.broker.CreatedTime.Format("2006-01-02 15:04")
+	}
+
+	return []string{
+		r.broker.BrokerName,
+		r.broker.EngineType,
+		r.broker.State,
+		r.broker.DeploymentMode,
+		r.broker.HostInstanceType,
+		created,
+	}
+}
+
+func (r *MQBrokerResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"BrokerName":       r.broker.BrokerName,
+		"BrokerId":         r.broker.BrokerID,
+		"EngineType":       r.broker.EngineType,
+		"State":            r.broker.State,
+		"DeploymentMode":   r.broker.DeploymentMode,
+		"HostInstanceType": r.broker.HostInstanceType,
+	}
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// NatGatewaysHandler handles NAT gateway resources
+type NatGatewaysHandler struct {
+	BaseHandler
+	ec2Tagging
+	client *ec2adapter.VPCsClient
+	region string
+	vpcID  string // Empty lists NAT gateways of every VPC
+}
+
+// NewNatGatewaysHandler creates a new NAT gateways handler
+func NewNatGatewaysHandler(ec2Client *ec2.Client, region string) *NatGatewaysHandler {
+	return NewNatGatewaysHandlerForVPC(ec2Client, region, "")
+}
+
+// NewNatGatewaysHandlerForVPC creates a new NAT gateways handler for a specific VPC
+func NewNatGatewaysHandlerForVPC(ec2Client *ec2.Client, region, vpcID string) *NatGatewaysHandler {
+	return &NatGatewaysHandler{
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewVPCsClient(ec2Client),
+		region:     region,
+		vpcID:      vpcID,
+	}
+}
+
+func (h *NatGatewaysHandler) ResourceType() string { return "ec2:nat-gateways" }
+func (h *NatGatewaysHandler) ResourceName() string { return "NAT Gateways" }
+func (h *NatGatewaysHandler) ResourceIcon() string { return "↗" }
+func (h *NatGatewaysHandler) ShortcutKey() string  { return "nat" }
+
+func (h *NatGatewaysHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "NAT Gateway ID", Width: 24, Sortable: false},
+		{Title: "State", Width: 10, Sortable: true},
+		{Title: "Type", Width: 8, Sortable: true},
+		{Title: "Public IP", Width: 16, Sortable: false},
+		{Title: "Subnet ID", Width: 26, Sortable: false},
+		{Title: "VPC ID", Width: 22, Sortable: true},
+	}
+}
+
+func (h *NatGatewaysHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	gateways, err := h.client.ListNatGateways(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list NAT gateways", err)
+	}
+
+	resources := make([]Resource, 0, len(gateways))
+	for _, gateway := range gateways {
+		resource := &NatGatewayResource{
+			gateway: gateway,
+			region:  h.region,
+		}
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(gateway.Name)
+			id := strings.ToLower(gateway.NatGatewayID)
+			ip := strings.ToLower(gateway.PublicIP)
+			state := strings.ToLower(gateway.State)
+			if !strings.Contains(name, filter) && !strings.Contains(id, filter) &&
+				!strings.Contains(ip, filter) && !strings.Contains(state, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *NatGatewaysHandler) Get(ctx context.Context, id string) (Resource, error) {
+	gateways, err := h.client.ListNatGateways(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get NAT gateway %s", id), err)
+	}
+
+	for _, gateway := range gateways {
+		if gateway.NatGatewayID == id {
+			return &NatGatewayResource{gateway: gateway, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("NAT gateway %s not found", id), nil)
+}
+
+func (h *NatGatewaysHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe NAT gateway %s", id), err)
+	}
+
+	details := res.ToDetailMap()
+	if tags := res.GetTags(); len(tags) > 0 {
+		details["Tags"] = tags
+	}
+	return details, nil
+}
+
+// NatGatewayResource implements Resource interface for NAT gateways
+type NatGatewayResource struct {
+	gateway ec2adapter.NatGateway
+	region  string
+}
+
+func (r *NatGatewayResource) GetID() string { return r.gateway.NatGatewayID }
+func (r *NatGatewayResource) GetName() string {
+	if r.gateway.Name != "" {
+		return r.gateway.Name
+	}
+	return r.gateway.NatGatewayID
+}
+
+// GetARN returns an ARN without the account ID, which the NAT gateway API doesn't return
+func (r *NatGatewayResource) GetARN() string {
+	return fmt.Sprintf("arn:aws:ec2:%s::natgateway/%s", r.region, r.gateway.NatGatewayID)
+}
+func (r *NatGatewayResource) GetType() string         { return "ec2:nat-gateways" }
+func (r *NatGatewayResource) GetRegion() string       { return r.region }
+func (r *NatGatewayResource) GetCreatedAt() time.Time { return r.gateway.CreatedAt }
+
+func (r *NatGatewayResource) GetTags() map[string]string {
+	return r.gateway.Tags
+}
+
+func (r *NatGatewayResource) ToTableRow() []string {
+	name := r.gateway.Name
+	if name == "" {
+		name = "-"
+	}
+
+	publicIP := r.gateway.PublicIP
+	if publicIP == "" {
+		publicIP = "-"
+	}
+
+	return []string{
+		name,
+		r.gateway.NatGatewayID,
+		r.gateway.State,
+		r.gateway.ConnectivityType,
+		publicIP,
+		r.gateway.SubnetID,
+		r.gateway.VpcID,
+	}
+}
+
+func (r *NatGatewayResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"NatGatewayId":     r.gateway.NatGatewayID,
+		"Name":             r.gateway.Name,
+		"State":            r.gateway.State,
+		"ConnectivityType": r.gateway.ConnectivityType,
+		"PublicIp":         r.gateway.PublicIP,
+		"PrivateIp":        r.gateway.PrivateIP,
+		"SubnetId":         r.gateway.SubnetID,
+		"VpcId":            r.gateway.VpcID,
+	}
+	if !r.gateway.CreatedAt.IsZero() {
+		details["CreatedAt"] = r.gateway.CreatedAt.Format(time.RFC3339)
+	}
+	return details
+}
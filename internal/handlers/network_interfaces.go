@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// ViewFlowLogsAction is returned by ExecuteAction to follow the VPC flow logs of network interfaces
+type ViewFlowLogsAction struct {
+	Title        string
+	Region       string
+	InterfaceIDs []string
+	LogGroups    []string // CloudWatch log groups of the flow logs covering the interfaces
+}
+
+func (a *ViewFlowLogsAction) Error() string {
+	return fmt.Sprintf("view flow logs for %s", a.Title)
+}
+
+func (a *ViewFlowLogsAction) IsActionMsg() {}
+
+// ReachabilityEndpointAction is returned by ExecuteAction to use a resource as the source,
+// or once a source is marked the destination, of a Reachability Analyzer path
+type ReachabilityEndpointAction struct {
+	ResourceID string
+	Region     string
+}
+
+func (a *ReachabilityEndpointAction) Error() string {
+	return fmt.Sprintf("analyze reachability of %s", a.ResourceID)
+}
+
+func (a *ReachabilityEndpointAction) IsActionMsg() {}
+
+// NetworkInterfacesHandler handles elastic network interface resources
+type NetworkInterfacesHandler struct {
+	BaseHandler
+	ec2Tagging
+	client *ec2adapter.NetworkInterfacesClient
+	region string
+	vpcID  string // Empty lists ENIs of every VPC
+}
+
+// NewNetworkInterfacesHandler creates a new network interfaces handler
+func NewNetworkInterfacesHandler(ec2Client *ec2.Client, region string) *NetworkInterfacesHandler {
+	return NewNetworkInterfacesHandlerForVPC(ec2Client, region, "")
+}
+
+// NewNetworkInterfacesHandlerForVPC creates a new network interfaces handler for a specific VPC
+func NewNetworkInterfacesHandlerForVPC(ec2Client *ec2.Client, region, vpcID string) *NetworkInterfacesHandler {
+	return &NetworkInterfacesHandler{
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewNetworkInterfacesClient(ec2Client),
+		region:     region,
+		vpcID:      vpcID,
+	}
+}
+
+func (h *NetworkInterfacesHandler) ResourceType() string { return "ec2:network-interfaces" }
+func (h *NetworkInterfacesHandler) ResourceName() string { return "Network Interfaces" }
+func (h *NetworkInterfacesHandler) ResourceIcon() string { return "🔌" }
+func (h *NetworkInterfacesHandler) ShortcutKey() string  { return "eni" }
+
+func (h *NetworkInterfacesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "ENI ID", Width: 24, Sortable: false},
+		{Title: "Owner", Width: 30, Sortable: true},
+		{Title: "Status", Width: 10, Sortable: true},
+		{Title: "Private IP", Width: 16, Sortable: false},
+		{Title: "Public IP", Width: 16, Sortable: false},
+		{Title: "Subnet ID", Width: 26, Sortable: true},
+		{Title: "Type", Width: 12, Sortable: true},
+	}
+}
+
+func (h *NetworkInterfacesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	enis, err := h.client.ListNetworkInterfaces(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list network interfaces", err)
+	}
+
+	resources := make([]Resource, 0, len(enis))
+	for _, eni := range enis {
+		resource := &NetworkInterfaceResource{
+			eni:    eni,
+			region: h.region,
+		}
+
+		// Apply filter if specified, matching any of the interface's IPs
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			fields := []string{eni.NetworkInterfaceID, eni.Name, eni.Owner(), eni.PublicIP, eni.SubnetID}
+			fields = append(fields, eni.PrivateIPs...)
+			if !strings.Contains(strings.ToLower(strings.Join(fields, " ")), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *NetworkInterfacesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	eni, err := h.client.GetNetworkInterface(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get network interface %s", id), err)
+	}
+
+	return &NetworkInterfaceResource{
+		eni:    *eni,
+		region: h.region,
+	}, nil
+}
+
+func (h *NetworkInterfacesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe network interface %s", id), err)
+	}
+
+	details := res.ToDetailMap()
+	if tags := res.GetTags(); len(tags) > 0 {
+		details["Tags"] = tags
+	}
+	return details, nil
+}
+
+func (h *NetworkInterfacesHandler) Actions() []Action {
+	return []Action{
+		{Key: "f", Name: "flowlogs", Description: "View flow logs"},
+		{Key: "P", Name: "reachability", Description: "Analyze path (source, then destination)", Mutating: true},
+	}
+}
+
+func (h *NetworkInterfacesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "flowlogs":
+		eni, err := h.client.GetNetworkInterface(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return flowLogsRequest(ctx, h.client, h.region, resourceID, []ec2adapter.NetworkInterface{*eni})
+	case "reachability":
+		return &ReachabilityEndpointAction{
+			ResourceID: resourceID,
+			Region:     h.region,
+		}
+	}
+
+	return ErrNotSupported
+}
+
+// flowLogsRequest finds the flow logs publishing to CloudWatch Logs that cover the given
+// interfaces, whether set on the interface itself, its subnet or its VPC
+func flowLogsRequest(ctx context.Context, client *ec2adapter.NetworkInterfacesClient, region, title string, enis []ec2adapter.NetworkInterface) error {
+	var resourceIDs, interfaceIDs []string
+	seen := make(map[string]bool)
+	for _, eni := range enis {
+		interfaceIDs = append(interfaceIDs, eni.NetworkInterfaceID)
+		for _, id := range []string{eni.NetworkInterfaceID, eni.SubnetID, eni.VpcID} {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				resourceIDs = append(resourceIDs, id)
+			}
+		}
+	}
+
+	flowLogs, err := client.ListCloudWatchFlowLogs(ctx, resourceIDs)
+	if err != nil {
+		return err
+	}
+
+	var groups []string
+	seenGroups := make(map[string]bool)
+	for _, fl := range flowLogs {
+		if fl.Status == "ACTIVE" && fl.LogGroupName != "" && !seenGroups[fl.LogGroupName] {
+			seenGroups[fl.LogGroupName] = true
+			groups = append(groups, fl.LogGroupName)
+		}
+	}
+
+	return &ViewFlowLogsAction{
+		Title:        title,
+		Region:       region,
+		InterfaceIDs: interfaceIDs,
+		LogGroups:    groups,
+	}
+}
+
+// NetworkInterfaceResource implements Resource interface for network interfaces
+type NetworkInterfaceResource struct {
+	eni    ec2adapter.NetworkInterface
+	region string
+}
+
+func (r *NetworkInterfaceResource) GetID() string { return r.eni.NetworkInterfaceID }
+func (r *NetworkInterfaceResource) GetName() string {
+	if r.eni.Name != "" {
+		return r.eni.Name
+	}
+	return r.eni.NetworkInterfaceID
+}
+func (r *NetworkInterfaceResource) GetARN() string {
+	return fmt.Sprintf("arn:aws:ec2:%s:%s:network-interface/%s", r.region, r.eni.OwnerID, r.eni.NetworkInterfaceID)
+}
+func (r *NetworkInterfaceResource) GetType() string   { return "ec2:network-interfaces" }
+func (r *NetworkInterfaceResource) GetRegion() string { return r.region }
+func (r *NetworkInterfaceResource) GetCreatedAt() time.Time {
+	return time.Time{} // The API doesn't return a creation time
+}
+
+func (r *NetworkInterfaceResource) GetTags() map[string]string {
+	return r.eni.Tags
+}
+
+func (r *NetworkInterfaceResource) ToTableRow() []string {
+	return []string{
+		r.eni.NetworkInterfaceID,
+		r.eni.Owner(),
+		r.eni.Status,
+		orDash(r.eni.PrivateIP),
+		orDash(r.eni.PublicIP),
+		r.eni.SubnetID,
+		r.eni.InterfaceType,
+	}
+}
+
+func (r *NetworkInterfaceResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"NetworkInterfaceId": r.eni.NetworkInterfaceID,
+		"Name":               r.eni.Name,
+		"Description":        r.eni.Description,
+		"InterfaceType":      r.eni.InterfaceType,
+		"Status":             r.eni.Status,
+		"Owner":              r.eni.Owner(),
+		"VpcId":              r.eni.VpcID,
+		"SubnetId":           r.eni.SubnetID,
+		"AvailabilityZone":   r.eni.AvailabilityZone,
+		"PrivateIpAddresses": r.eni.PrivateIPs,
+		"PublicIp":           r.eni.PublicIP,
+		"MacAddress":         r.eni.MacAddress,
+		"InstanceId":         r.eni.InstanceID,
+		"AttachmentId":       r.eni.AttachmentID,
+		"SecurityGroups":     r.eni.SecurityGroups,
+	}
+}
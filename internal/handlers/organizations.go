@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	orgsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/organizations"
+)
+
+// DefaultOrgAccessRole is the role Organizations creates in the accounts it creates
+const DefaultOrgAccessRole = "OrganizationAccountAccessRole"
+
+// orgPolicyConcurrency bounds the parallel ListPoliciesForTarget calls, which
+// Organizations throttles at a low rate
+const orgPolicyConcurrency = 4
+
+// OrganizationsHandler lists the organization's root, OUs and accounts as a tree, with
+// the SCPs attached at each level
+type OrganizationsHandler struct {
+	BaseHandler
+	client     *orgsadapter.OrgClient
+	accessRole string
+
+	nodes    map[string]*orgsadapter.Node
+	policies map[string][]orgsadapter.Policy
+}
+
+// NewOrganizationsHandler creates a handler for the organization, assuming accessRole
+// into member accounts
+func NewOrganizationsHandler(client *organizations.Client, accessRole string) *OrganizationsHandler {
+	if accessRole == "" {
+		accessRole = DefaultOrgAccessRole
+	}
+	return &OrganizationsHandler{
+		client:     orgsadapter.NewOrgClient(client),
+		accessRole: accessRole,
+	}
+}
+
+func (h *OrganizationsHandler) ResourceType() string { return "organizations:tree" }
+func (h *OrganizationsHandler) ResourceName() string { return "Organization" }
+func (h *OrganizationsHandler) ResourceIcon() string { return "🏢" }
+func (h *OrganizationsHandler) ShortcutKey() string  { return "orgs" }
+
+func (h *OrganizationsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 45, Sortable: false},
+		{Title: "ID", Width: 36, Sortable: true},
+		{Title: "Type", Width: 8, Sortable: true},
+		{Title: "State", Width: 10, Sortable: true},
+		{Title: "Email", Width: 30, Sortable: true},
+		{Title: "SCPs", Width: 40, Sortable: false},
+	}
+}
+
+// List lists the tree depth first, so the rows read as the organization's hierarchy
+func (h *OrganizationsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	nodes, err := h.client.Tree(ctx)
+	if err != nil {
+		if errors.Is(err, orgsadapter.ErrNotInOrganization) {
+			return nil, NewHandlerError("LIST_FAILED", "the account is not in an organization", nil)
+		}
+		return nil, NewHandlerError("LIST_FAILED", "failed to list the organization (it needs the management account or a delegated administrator)", err)
+	}
+
+	policies, err := h.loadPolicies(ctx, nodes)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list service control policies", err)
+	}
+
+	h.nodes = make(map[string]*orgsadapter.Node, len(nodes))
+	h.policies = policies
+	prefixes := orgTreePrefixes(nodes)
+	resources := make([]Resource, 0, len(nodes))
+	for i := range nodes {
+		node := &nodes[i]
+		h.nodes[node.ID] = node
+
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(node.Name), filter) &&
+				!strings.Contains(node.ID, filter) &&
+				!strings.Contains(strings.ToLower(node.Email), filter) {
+				continue
+			}
+		}
+		resources = append(resources, &OrganizationNodeResource{
+			node:     node,
+			prefix:   prefixes[i],
+			policies: policies[node.ID],
+			parents:  h.ancestorPolicies(node),
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+// loadPolicies gets the SCPs attached to each node
+func (h *OrganizationsHandler) loadPolicies(ctx context.Context, nodes []orgsadapter.Node) (map[string][]orgsadapter.Policy, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	policies := make(map[string][]orgsadapter.Policy, len(nodes))
+	sem := make(chan struct{}, orgPolicyConcurrency)
+
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			attached, err := h.client.ServiceControlPolicies(ctx, id)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			policies[id] = attached
+		}(node.ID)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return policies, nil
+}
+
+// ancestorPolicies lists the SCPs a node inherits from the levels above it, closest first
+func (h *OrganizationsHandler) ancestorPolicies(node *orgsadapter.Node) []orgInheritedPolicy {
+	var inherited []orgInheritedPolicy
+	for parent, ok := h.nodes[node.ParentID]; ok; parent, ok = h.nodes[parent.ParentID] {
+		for _, policy := range h.policies[parent.ID] {
+			inherited = append(inherited, orgInheritedPolicy{Policy: policy, From: parent.Name})
+		}
+	}
+	return inherited
+}
+
+func (h *OrganizationsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	node, ok := h.nodes[id]
+	if !ok {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("%s is not listed, refresh the list", id), nil)
+	}
+	return &OrganizationNodeResource{node: node, policies: h.policies[id], parents: h.ancestorPolicies(node)}, nil
+}
+
+func (h *OrganizationsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	resource, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	details := resource.ToDetailMap()
+	if node := h.nodes[id]; node.Kind == orgsadapter.KindAccount {
+		details["AccessRole"] = fmt.Sprintf("arn:aws:iam::%s:role/%s", node.ID, h.accessRole)
+	}
+	return details, nil
+}
+
+func (h *OrganizationsHandler) Actions() []Action {
+	return []Action{
+		{Key: "A", Name: "assume", Description: fmt.Sprintf("Assume %s in the account", h.accessRole)},
+	}
+}
+
+func (h *OrganizationsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "assume" {
+		return ErrNotSupported
+	}
+	node, ok := h.nodes[resourceID]
+	if !ok {
+		return fmt.Errorf("%s is not listed, refresh the list", resourceID)
+	}
+	if node.Kind != orgsadapter.KindAccount {
+		return fmt.Errorf("select an account to assume a role into")
+	}
+	if node.State != "" && node.State != "ACTIVE" {
+		return fmt.Errorf("account %s is %s", node.Name, strings.ToLower(node.State))
+	}
+	return &AssumeRoleAction{
+		RoleName: fmt.Sprintf("%s in %s", h.accessRole, node.Name),
+		RoleARN:  fmt.Sprintf("arn:aws:iam::%s:role/%s", node.ID, h.accessRole),
+	}
+}
+
+// orgTreePrefixes draws each node's branch of the tree, from the depth and last-child
+// flags of the nodes listed depth first
+func orgTreePrefixes(nodes []orgsadapter.Node) []string {
+	prefixes := make([]string, len(nodes))
+	var open []bool // Whether the ancestor at each depth has siblings still to come
+	for i, node := range nodes {
+		if node.Depth == 0 {
+			open = open[:0]
+			continue
+		}
+		var sb strings.Builder
+		for depth := 1; depth < node.Depth; depth++ {
+			if depth < len(open) && open[depth] {
+				sb.WriteString("│  ")
+			} else {
+				sb.WriteString("   ")
+			}
+		}
+		if node.Last {
+			sb.WriteString("└─ ")
+		} else {
+			sb.WriteString("├─ ")
+		}
+		prefixes[i] = sb.String()
+
+		for len(open) <= node.Depth {
+			open = append(open, false)
+		}
+		open = open[:node.Depth+1]
+		open[node.Depth] = !node.Last
+	}
+	return prefixes
+}
+
+// orgInheritedPolicy is an SCP attached to a level above a node
+type orgInheritedPolicy struct {
+	orgsadapter.Policy
+	From string
+}
+
+// OrganizationNodeResource implements Resource for a root, OU or account
+type OrganizationNodeResource struct {
+	node     *orgsadapter.Node
+	prefix   string
+	policies []orgsadapter.Policy
+	parents  []orgInheritedPolicy
+}
+
+func (r *OrganizationNodeResource) GetID() string              { return r.node.ID }
+func (r *OrganizationNodeResource) GetARN() string             { return r.node.ARN }
+func (r *OrganizationNodeResource) GetName() string            { return r.node.Name }
+func (r *OrganizationNodeResource) GetType() string            { return "organizations:tree" }
+func (r *OrganizationNodeResource) GetRegion() string          { return "global" }
+func (r *OrganizationNodeResource) GetCreatedAt() time.Time    { return r.node.JoinedAt }
+func (r *OrganizationNodeResource) GetTags() map[string]string { return nil }
+
+func (r *OrganizationNodeResource) ToTableRow() []string {
+	name := r.node.Name
+	if r.node.Management {
+		name += " (management)"
+	}
+	kind := "Account"
+	switch r.node.Kind {
+	case orgsadapter.KindRoot:
+		kind = "Root"
+	case orgsadapter.KindOU:
+		kind = "OU"
+	}
+	names := make([]string, 0, len(r.policies))
+	for _, policy := range r.policies {
+		names = append(names, policy.Name)
+	}
+	return []string{
+		r.prefix + name,
+		r.node.ID,
+		kind,
+		orDash(strings.ToLower(r.node.State)),
+		orDash(r.node.Email),
+		orDash(strings.Join(names, ", ")),
+	}
+}
+
+func (r *OrganizationNodeResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"Id":   r.node.ID,
+		"Arn":  r.node.ARN,
+		"Name": r.node.Name,
+		"Type": r.node.Kind,
+	}
+	if r.node.ParentID != "" {
+		details["ParentId"] = r.node.ParentID
+	}
+	if r.node.Kind == orgsadapter.KindAccount {
+		details["Email"] = r.node.Email
+		details["State"] = r.node.State
+		details["JoinedMethod"] = r.node.JoinedVia
+		details["ManagementAccount"] = r.node.Management
+		if !r.node.JoinedAt.IsZero() {
+			details["JoinedAt"] = r.node.JoinedAt.Format(time.RFC3339)
+		}
+	}
+
+	attached := make([]map[string]interface{}, 0, len(r.policies))
+	for _, policy := range r.policies {
+		attached = append(attached, map[string]interface{}{
+			"Id":          policy.ID,
+			"Name":        policy.Name,
+			"Description": policy.Description,
+			"AwsManaged":  policy.AWSManaged,
+		})
+	}
+	details["ServiceControlPolicies"] = attached
+
+	if len(r.parents) > 0 {
+		inherited := make([]map[string]interface{}, 0, len(r.parents))
+		for _, policy := range r.parents {
+			inherited = append(inherited, map[string]interface{}{
+				"Id":   policy.ID,
+				"Name": policy.Name,
+				"From": policy.From,
+			})
+		}
+		details["InheritedServiceControlPolicies"] = inherited
+	}
+	return details
+}
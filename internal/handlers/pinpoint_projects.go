@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+
+	pinpointadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/pinpoint"
+)
+
+// pinpointChannelConcurrency bounds the parallel GetChannels calls made to fill in the
+// channels of each listed project
+const pinpointChannelConcurrency = 5
+
+// PinpointProjectsHandler lists Amazon Pinpoint projects with their channels, read-only
+type PinpointProjectsHandler struct {
+	BaseHandler
+	client *pinpointadapter.AppsClient
+	region string
+}
+
+// NewPinpointProjectsHandler creates a new Amazon Pinpoint projects handler
+func NewPinpointProjectsHandler(pinpointClient *pinpoint.Client, region string) *PinpointProjectsHandler {
+	return &PinpointProjectsHandler{
+		client: pinpointadapter.NewAppsClient(pinpointClient),
+		region: region,
+	}
+}
+
+func (h *PinpointProjectsHandler) ResourceType() string { return "pinpoint:projects" }
+func (h *PinpointProjectsHandler) ResourceName() string { return "Pinpoint Projects" }
+func (h *PinpointProjectsHandler) ResourceIcon() string { return "📣" }
+func (h *PinpointProjectsHandler) ShortcutKey() string  { return "pinpoint" }
+
+func (h *PinpointProjectsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "Channels", Width: 30, Sortable: true},
+		{Title: "Project ID", Width: 34, Sortable: true},
+		{Title: "Created", Width: 20, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *PinpointProjectsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	apps, err := h.client.ListApps(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list Pinpoint projects", err)
+	}
+
+	projects := make([]*PinpointProjectResource, 0, len(apps))
+	for _, app := range apps {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(app.Name), filter) &&
+				!strings.Contains(strings.ToLower(app.ID), filter) {
+				continue
+			}
+		}
+
+		projects = append(projects, &PinpointProjectResource{
+			app:    app,
+			region: h.region,
+		})
+	}
+
+	h.loadChannels(ctx, projects)
+
+	resources := make([]Resource, 0, len(projects))
+	for _, project := range projects {
+		resources = append(resources, project)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+// loadChannels fills in the channels of each project. A project whose channels can't be
+// read is shown without them rather than failing the list.
+func (h *PinpointProjectsHandler) loadChannels(ctx context.Context, projects []*PinpointProjectResource) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, pinpointChannelConcurrency)
+
+	for _, project := range projects {
+		wg.Add(1)
+		go func(project *PinpointProjectResource) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			channels, err := h.client.GetChannels(ctx, project.app.ID)
+			if err != nil {
+				return
+			}
+			project.channels = channels
+			project.channelsLoaded = true
+		}(project)
+	}
+
+	wg.Wait()
+}
+
+func (h *PinpointProjectsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	app, err := h.client.GetApp(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get Pinpoint project %s", id), err)
+	}
+
+	project := &PinpointProjectResource{app: *app, region: h.region}
+	if channels, err := h.client.GetChannels(ctx, id); err == nil {
+		project.channels = channels
+		project.channelsLoaded = true
+	}
+	return project, nil
+}
+
+func (h *PinpointProjectsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	app, err := h.client.GetApp(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe Pinpoint project %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	info := map[string]interface{}{
+		"Name":      app.Name,
+		"ProjectId": app.ID,
+		"Arn":       app.ARN,
+	}
+	if !app.CreatedTime.IsZero() {
+		info["Created"] = app.CreatedTime.Format(time.RFC3339)
+	}
+	details["Project"] = info
+
+	channels, err := h.client.GetChannels(ctx, id)
+	if err != nil {
+		details["Channels"] = fmt.Sprintf("Unavailable: %v", err)
+	} else {
+		list := make([]map[string]interface{}, 0, len(channels))
+		for _, ch := range channels {
+			c := map[string]interface{}{
+				"Type":    ch.Type,
+				"Enabled": ch.Enabled,
+			}
+			if ch.IsArchived {
+				c["Archived"] = true
+			}
+			if ch.LastModified != "" {
+				c["LastModified"] = ch.LastModified
+			}
+			list = append(list, c)
+		}
+		details["Channels"] = list
+	}
+
+	if len(app.Tags) > 0 {
+		details["Tags"] = app.Tags
+	}
+
+	return details, nil
+}
+
+// PinpointProjectResource implements Resource interface for Amazon Pinpoint projects
+type PinpointProjectResource struct {
+	app            pinpointadapter.App
+	channels       []pinpointadapter.Channel
+	channelsLoaded bool
+	region         string
+}
+
+func (r *PinpointProjectResource) GetID() string     { return r.app.ID }
+func (r *PinpointProjectResource) GetName() string   { return r.app.Name }
+func (r *PinpointProjectResource) GetARN() string    { return r.app.ARN }
+func (r *PinpointProjectResource) GetType() string   { return "pinpoint:projects" }
+func (r *PinpointProjectResource) GetRegion() string { return r.region }
+
+func (r *PinpointProjectResource) GetCreatedAt() time.Time {
+	return r.app.CreatedTime
+}
+
+func (r *PinpointProjectResource) GetTags() map[string]string {
+	return r.app.Tags
+}
+
+// enabledChannels lists the types of the enabled channels, e.g. EMAIL, SMS
+func (r *PinpointProjectResource) enabledChannels() string {
+	if !r.channelsLoaded {
+		return "?"
+	}
+	var enabled []string
+	for _, ch := range r.channels {
+		if ch.Enabled && !ch.IsArchived {
+			enabled = append(enabled, ch.Type)
+		}
+	}
+	if len(enabled) == 0 {
+		return "none"
+	}
+	return strings.Join(enabled, ", ")
+}
+
+func (r *PinpointProjectResource) ToTableRow() []string {
+	created := "-"
+	if !r.app.CreatedTime.IsZero() {
+		created = r.app.CreatedTime.Format("2006-01-02 15:04")
+	}
+
+	return []string{
+		r.app.Name,
+		r.enabledChannels(),
+		r.app.ID,
+		created,
+	}
+}
+
+func (r *PinpointProjectResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Name":      r.app.Name,
+		"ProjectId": r.app.ID,
+		"Channels":  r.enabledChannels(),
+	}
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// hoursPerMonth is the average month length AWS uses for monthly pricing
+const hoursPerMonth = 730
+
+// Approximate us-east-1 on-demand Linux hourly price of the "large" size per
+// instance family. Other sizes within a family scale linearly from it.
+var ec2LargeHourlyPrice = map[string]float64{
+	"t2":   0.0928,
+	"t3":   0.0832,
+	"t3a":  0.0752,
+	"t4g":  0.0672,
+	"m4":   0.10,
+	"m5":   0.096,
+	"m5a":  0.086,
+	"m6a":  0.0864,
+	"m6g":  0.077,
+	"m6i":  0.096,
+	"m7g":  0.0816,
+	"m7i":  0.1008,
+	"c4":   0.10,
+	"c5":   0.085,
+	"c5a":  0.077,
+	"c6a":  0.0765,
+	"c6g":  0.068,
+	"c6i":  0.085,
+	"c7g":  0.0725,
+	"c7i":  0.08925,
+	"r4":   0.133,
+	"r5":   0.126,
+	"r5a":  0.113,
+	"r6a":  0.1134,
+	"r6g":  0.1008,
+	"r6i":  0.126,
+	"r7g":  0.1071,
+	"r7i":  0.1323,
+	"i3":   0.156,
+	"i4i":  0.172,
+	"g4dn": 0.263, // xlarge is the smallest g4dn size, priced at twice this
+	"g5":   0.503, // xlarge is the smallest g5 size, priced at twice this
+}
+
+// Approximate us-east-1 on-demand single-AZ MySQL/PostgreSQL hourly price of
+// the "large" size per DB instance family.
+var rdsLargeHourlyPrice = map[string]float64{
+	"t3":  0.136,
+	"t4g": 0.129,
+	"m5":  0.171,
+	"m6g": 0.152,
+	"m6i": 0.171,
+	"m7g": 0.168,
+	"r5":  0.24,
+	"r6g": 0.215,
+	"r6i": 0.24,
+	"r7g": 0.239,
+}
+
+// sizeMultiplier returns the price multiplier of a size relative to "large"
+func sizeMultiplier(size string) (float64, bool) {
+	switch size {
+	case "nano":
+		return 1.0 / 16, true
+	case "micro":
+		return 1.0 / 8, true
+	case "small":
+		return 1.0 / 4, true
+	case "medium":
+		return 1.0 / 2, true
+	case "large":
+		return 1, true
+	case "xlarge":
+		return 2, true
+	}
+
+	// Nxlarge sizes are N times the xlarge price
+	if strings.HasSuffix(size, "xlarge") {
+		n, err := strconv.Atoi(strings.TrimSuffix(size, "xlarge"))
+		if err != nil || n <= 0 {
+			return 0, false
+		}
+		return float64(2 * n), true
+	}
+
+	return 0, false
+}
+
+// estimateMonthly looks up a "family.size" type in a price table
+func estimateMonthly(prices map[string]float64, instanceType string) (float64, bool) {
+	parts := strings.SplitN(instanceType, ".", 2)
+	if len(parts) != 2 {
+		return 0, false
+	}
+
+	hourly, ok := prices[parts[0]]
+	if !ok {
+		return 0, false
+	}
+
+	multiplier, ok := sizeMultiplier(parts[1])
+	if !ok {
+		return 0, false
+	}
+
+	return hourly * multiplier * hoursPerMonth, true
+}
+
+// EstimateEC2MonthlyCost returns the approximate monthly on-demand cost of an instance type
+func EstimateEC2MonthlyCost(instanceType string) (float64, bool) {
+	return estimateMonthly(ec2LargeHourlyPrice, instanceType)
+}
+
+// EstimateRDSMonthlyCost returns the approximate monthly on-demand cost of a DB instance class
+func EstimateRDSMonthlyCost(instanceClass string, multiAZ bool) (float64, bool) {
+	cost, ok := estimateMonthly(rdsLargeHourlyPrice, strings.TrimPrefix(instanceClass, "db."))
+	if !ok {
+		return 0, false
+	}
+	if multiAZ {
+		cost *= 2
+	}
+	return cost, true
+}
+
+// formatMonthlyEstimate formats an estimated monthly cost for table display
+func formatMonthlyEstimate(cost float64, ok bool) string {
+	if !ok {
+		return "?"
+	}
+	return fmt.Sprintf("~$%.0f", cost)
+}
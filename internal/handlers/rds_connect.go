@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ConnectDBAction starts connecting to an RDS instance with its engine's command line
+// client. The password comes from SecretARN, or a secret picked from the list when it
+// is empty.
+type ConnectDBAction struct {
+	DBInstanceID string
+	Client       string // psql or mysql
+	Host         string
+	Port         int32
+	User         string
+	DBName       string
+	VpcID        string
+	SecretARN    string // The master user secret RDS manages, if any
+}
+
+func (a *ConnectDBAction) Error() string {
+	return fmt.Sprintf("connect to %s", a.DBInstanceID)
+}
+
+func (a *ConnectDBAction) IsActionMsg() {}
+
+// DBTunnel forwards a local port to the instance through an EC2 instance, with Session
+// Manager port forwarding
+type DBTunnel struct {
+	InstanceID string
+	LocalPort  int
+}
+
+// DBLogin is the user and password read from a secret
+type DBLogin struct {
+	User     string
+	Password string
+}
+
+// dbClients are the command line clients of the engines that have one
+var dbClients = map[string]string{
+	"postgres":          "psql",
+	"aurora-postgresql": "psql",
+	"mysql":             "mysql",
+	"mariadb":           "mysql",
+	"aurora-mysql":      "mysql",
+	"aurora":            "mysql",
+}
+
+// ParseDBSecret reads the login from a secret's value. RDS and the rotation templates
+// store JSON with username and password; other secrets are taken as the bare password.
+func ParseDBSecret(value string) DBLogin {
+	var fields struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := json.Unmarshal([]byte(value), &fields); err != nil || fields.Password == "" {
+		return DBLogin{Password: value}
+	}
+	return DBLogin{User: fields.Username, Password: fields.Password}
+}
+
+// PasswordEnv is the environment variable the client reads its password from
+func (a *ConnectDBAction) PasswordEnv() string {
+	if a.Client == "psql" {
+		return "PGPASSWORD"
+	}
+	return "MYSQL_PWD"
+}
+
+// ClientArgs builds the client command line. Through a tunnel the client connects to
+// the forwarded local port.
+func (a *ConnectDBAction) ClientArgs(user string, tunnel *DBTunnel) []string {
+	host, port := a.Host, strconv.Itoa(int(a.Port))
+	if tunnel != nil {
+		host, port = "127.0.0.1", strconv.Itoa(tunnel.LocalPort)
+	}
+	if user == "" {
+		user = a.User
+	}
+
+	if a.Client == "psql" {
+		dbName := a.DBName
+		if dbName == "" {
+			dbName = "postgres"
+		}
+		return []string{"psql", "-h", host, "-p", port, "-U", user, "-d", dbName}
+	}
+	args := []string{"mysql", "-h", host, "-P", port, "-u", user}
+	if a.DBName != "" {
+		args = append(args, a.DBName)
+	}
+	return args
+}
+
+// TunnelArgs builds the AWS CLI command forwarding the tunnel's local port to the instance
+func (a *ConnectDBAction) TunnelArgs(tunnel DBTunnel) []string {
+	return []string{
+		"ssm", "start-session",
+		"--target", tunnel.InstanceID,
+		"--document-name", "AWS-StartPortForwardingSessionToRemoteHost",
+		"--parameters", fmt.Sprintf("host=%s,portNumber=%d,localPortNumber=%d", a.Host, a.Port, tunnel.LocalPort),
+	}
+}
+
+// ShellCommand is the connection as shell commands to copy. The password is read from the
+// secret when the command runs, so it never ends up in the clipboard. It logs in as the
+// master user.
+func (a *ConnectDBAction) ShellCommand(secretARN, profile, region string, tunnel *DBTunnel) string {
+	awsFlags := ""
+	if profile != "" {
+		awsFlags += " --profile " + shellQuote(profile)
+	}
+	awsFlags += " --region " + shellQuote(region)
+
+	var lines []string
+	if tunnel != nil {
+		lines = append(lines, "aws "+shellJoin(a.TunnelArgs(*tunnel))+awsFlags+" &")
+	}
+
+	client := shellJoin(a.ClientArgs("", tunnel))
+	if secretARN != "" {
+		client = fmt.Sprintf(`%s="$(aws secretsmanager get-secret-value --secret-id %s%s --query SecretString --output text | jq -r .password)" %s`,
+			a.PasswordEnv(), shellQuote(secretARN), awsFlags, client)
+	}
+	lines = append(lines, client)
+	return strings.Join(lines, "\n")
+}
+
+// shellJoin quotes arguments for a POSIX shell
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// shellQuote quotes an argument for a POSIX shell when it needs it
+func shellQuote(s string) string {
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.,:/=@") == "" {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+// connectAction prepares connecting to an available instance whose engine has a client.
+// Aurora instances share the master user of their cluster.
+func (h *RDSInstancesHandler) connectAction(ctx context.Context, id string) error {
+	inst, err := h.client.GetDBInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	client, ok := dbClients[inst.Engine]
+	if !ok {
+		return fmt.Errorf("no command line client is set up for %s, only PostgreSQL, MySQL and MariaDB", inst.Engine)
+	}
+	if inst.Status != "available" || inst.Endpoint == "" {
+		return fmt.Errorf("%s is %s, wait until it is available to connect", id, inst.Status)
+	}
+
+	action := &ConnectDBAction{
+		DBInstanceID: id,
+		Client:       client,
+		Host:         inst.Endpoint,
+		Port:         inst.Port,
+		User:         inst.MasterUsername,
+		DBName:       inst.DBName,
+		VpcID:        inst.VpcID,
+		SecretARN:    inst.MasterUserSecretARN,
+	}
+	if inst.DBClusterID != "" {
+		login, err := h.client.GetClusterLogin(ctx, inst.DBClusterID)
+		if err != nil {
+			return err
+		}
+		action.User = login.MasterUsername
+		action.DBName = login.DatabaseName
+		action.SecretARN = login.MasterUserSecretARN
+	}
+	return action
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// StartDBInstanceAction triggers starting a stopped RDS instance
+type StartDBInstanceAction struct {
+	DBInstanceID string
+}
+
+func (a *StartDBInstanceAction) Error() string {
+	return fmt.Sprintf("start DB instance %s", a.DBInstanceID)
+}
+
+func (a *StartDBInstanceAction) IsActionMsg() {}
+
+// StopDBInstanceAction triggers stopping an RDS instance
+type StopDBInstanceAction struct {
+	DBInstanceID string
+}
+
+func (a *StopDBInstanceAction) Error() string {
+	return fmt.Sprintf("stop DB instance %s", a.DBInstanceID)
+}
+
+func (a *StopDBInstanceAction) IsActionMsg() {}
+
+// RebootDBInstanceAction triggers rebooting an RDS instance
+type RebootDBInstanceAction struct {
+	DBInstanceID string
+}
+
+func (a *RebootDBInstanceAction) Error() string {
+	return fmt.Sprintf("reboot DB instance %s", a.DBInstanceID)
+}
+
+func (a *RebootDBInstanceAction) IsActionMsg() {}
+
+// startAction checks that an instance can be started on its own
+func (h *RDSInstancesHandler) startAction(ctx context.Context, id string) error {
+	inst, err := h.client.GetDBInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inst.DBClusterID != "" {
+		return fmt.Errorf("%s is a member of Aurora cluster %s, start the cluster instead", id, inst.DBClusterID)
+	}
+	if inst.Status != "stopped" {
+		return fmt.Errorf("%s is %s, only stopped instances can be started", id, inst.Status)
+	}
+	return &StartDBInstanceAction{DBInstanceID: id}
+}
+
+// stopAction checks that an instance can be stopped on its own. Aurora instances stop
+// with their cluster, and RDS doesn't stop read replicas or instances that have them.
+func (h *RDSInstancesHandler) stopAction(ctx context.Context, id string) error {
+	inst, err := h.client.GetDBInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch {
+	case inst.DBClusterID != "":
+		return fmt.Errorf("%s is a member of Aurora cluster %s, an Aurora instance can't be stopped alone, stop the cluster instead", id, inst.DBClusterID)
+	case inst.ReadReplicaSource != "":
+		return fmt.Errorf("%s is a read replica of %s, read replicas can't be stopped", id, inst.ReadReplicaSource)
+	case len(inst.ReadReplicas) > 0:
+		return fmt.Errorf("%s has read replicas (%s), instances with read replicas can't be stopped", id, strings.Join(inst.ReadReplicas, ", "))
+	case inst.Status != "available":
+		return fmt.Errorf("%s is %s, only available instances can be stopped", id, inst.Status)
+	}
+	return &StopDBInstanceAction{DBInstanceID: id}
+}
+
+// rebootAction checks that an instance is in a state that can be rebooted
+func (h *RDSInstancesHandler) rebootAction(ctx context.Context, id string) error {
+	inst, err := h.client.GetDBInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inst.Status != "available" {
+		return fmt.Errorf("%s is %s, only available instances can be rebooted", id, inst.Status)
+	}
+	return &RebootDBInstanceAction{DBInstanceID: id}
+}
+
+// StartInstance starts a stopped RDS instance
+func (h *RDSInstancesHandler) StartInstance(ctx context.Context, id string) error {
+	return h.client.StartDBInstance(ctx, id)
+}
+
+// StopInstance stops an RDS instance
+func (h *RDSInstancesHandler) StopInstance(ctx context.Context, id string) error {
+	return h.client.StopDBInstance(ctx, id)
+}
+
+// RebootInstance reboots an RDS instance without failing over
+func (h *RDSInstancesHandler) RebootInstance(ctx context.Context, id string) error {
+	return h.client.RebootDBInstance(ctx, id, false)
+}
@@ -16,6 +16,9 @@ type RDSInstancesHandler struct {
 	BaseHandler
 	client *rdsadapter.InstancesClient
 	region string
+
+	// Show an estimated monthly cost column derived from the instance class
+	showCost bool
 }
 
 // NewRDSInstancesHandler creates a new RDS instances handler
@@ -31,16 +34,25 @@ func (h *RDSInstancesHandler) ResourceName() string { return "RDS Instances" }
 func (h *RDSInstancesHandler) ResourceIcon() string { return "🗄️" }
 func (h *RDSInstancesHandler) ShortcutKey() string  { return "rds" }
 
+// SetShowCostEstimate enables or disables the estimated cost column
+func (h *RDSInstancesHandler) SetShowCostEstimate(show bool) {
+	h.showCost = show
+}
+
 func (h *RDSInstancesHandler) Columns() []ColumnDef {
-	return []ColumnDef{
+	columns := []ColumnDef{
 		{Title: "DB Identifier", Width: 25, Sortable: true},
 		{Title: "Engine", Width: 15, Sortable: true},
-		{Title: "Status", Width: 12, Sortable: true},
+		{Title: "Status", Width: 12, Sortable: true, Priority: 1},
 		{Title: "Class", Width: 15, Sortable: true},
-		{Title: "Storage", Width: 10, Sortable: false},
+		{Title: "Storage", Width: 10, Sortable: true, SortType: SortSize},
 		{Title: "Multi-AZ", Width: 8, Sortable: false},
 		{Title: "Endpoint", Width: 35, Sortable: false},
 	}
+	if h.showCost {
+		columns = append(columns, ColumnDef{Title: "Est. $/mo", Width: 10, Sortable: true, SortType: SortNumber})
+	}
+	return columns
 }
 
 func (h *RDSInstancesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
@@ -54,6 +66,7 @@ func (h *RDSInstancesHandler) List(ctx context.Context, opts ListOptions) (*List
 		resource := &RDSInstanceResource{
 			instance: inst,
 			region:   h.region,
+			showCost: h.showCost,
 		}
 
 		// Apply filter if specified
@@ -85,6 +98,7 @@ func (h *RDSInstancesHandler) Get(ctx context.Context, id string) (Resource, err
 	return &RDSInstanceResource{
 		instance: *inst,
 		region:   h.region,
+		showCost: h.showCost,
 	}, nil
 }
 
@@ -133,6 +147,15 @@ func (h *RDSInstancesHandler) Describe(ctx context.Context, id string) (map[stri
 	if inst.VpcID != "" {
 		availability["VpcId"] = inst.VpcID
 	}
+	if inst.DBClusterID != "" {
+		availability["DBClusterIdentifier"] = inst.DBClusterID
+	}
+	if inst.ReadReplicaSource != "" {
+		availability["ReadReplicaSource"] = inst.ReadReplicaSource
+	}
+	if len(inst.ReadReplicas) > 0 {
+		availability["ReadReplicas"] = inst.ReadReplicas
+	}
 	details["Availability"] = availability
 
 	// Maintenance
@@ -141,32 +164,125 @@ func (h *RDSInstancesHandler) Describe(ctx context.Context, id string) (map[stri
 		"BackupRetentionPeriod":   fmt.Sprintf("%d days", inst.BackupRetentionPeriod),
 	}
 
+	// Parameter and option groups, with whether changes to them are still pending
+	if len(inst.ParameterGroups) > 0 {
+		details["ParameterGroups"] = groupMemberships(inst.ParameterGroups)
+	}
+	if len(inst.OptionGroups) > 0 {
+		details["OptionGroups"] = groupMemberships(inst.OptionGroups)
+	}
+
 	// Tags
 	if len(inst.Tags) > 0 {
 		details["Tags"] = inst.Tags
 	}
 
+	// Estimated cost
+	if h.showCost {
+		if cost, ok := EstimateRDSMonthlyCost(inst.DBInstanceClass, inst.MultiAZ); ok {
+			details["EstimatedCost"] = map[string]interface{}{
+				"OnDemandMonthly": fmt.Sprintf("$%.2f", cost),
+				"Basis":           "us-east-1 MySQL/PostgreSQL on-demand list price, 730h/month, excludes storage",
+			}
+		}
+	}
+
 	return details, nil
 }
 
 func (h *RDSInstancesHandler) Actions() []Action {
 	return []Action{
-		{Key: "s", Name: "start", Description: "Start instance"},
-		{Key: "S", Name: "stop", Description: "Stop instance"},
-		{Key: "r", Name: "reboot", Description: "Reboot instance"},
+		{Key: "s", Name: "start", Description: "Start instance", Mutating: true},
+		{Key: "S", Name: "stop", Description: "Stop instance", Mutating: true},
+		{Key: "r", Name: "reboot", Description: "Reboot instance", Mutating: true},
 		{Key: "b", Name: "snapshots", Description: "View snapshots"},
+		{Key: "B", Name: "snapshot", Description: "Take snapshot", Mutating: true},
+		{Key: "c", Name: "connect", Description: "Connect with psql/mysql"},
+	}
+}
+
+func (h *RDSInstancesHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "start":
+		return h.startAction(ctx, resourceID)
+	case "stop":
+		return h.stopAction(ctx, resourceID)
+	case "reboot":
+		return h.rebootAction(ctx, resourceID)
+	case "snapshots":
+		return &NavigateToInstanceSnapshotsAction{DBInstanceID: resourceID}
+	case "connect":
+		return h.connectAction(ctx, resourceID)
+	case "snapshot":
+		return &CreateDBSnapshotAction{DBInstanceID: resourceID}
+	}
+
+	return ErrNotSupported
+}
+
+// DetailLinks links the parameters of the instance's parameter groups and its option groups
+func (h *RDSInstancesHandler) DetailLinks(details map[string]interface{}) []DetailLink {
+	var links []DetailLink
+	parameterGroups, _ := details["ParameterGroups"].([]map[string]interface{})
+	for _, group := range parameterGroups {
+		name, _ := group["Name"].(string)
+		links = append(links, DetailLink{
+			Label:  "parameter group: " + name,
+			Action: &NavigateToParametersAction{GroupName: name},
+		})
+	}
+	optionGroups, _ := details["OptionGroups"].([]map[string]interface{})
+	for _, group := range optionGroups {
+		name, _ := group["Name"].(string)
+		links = append(links, DetailLink{
+			Label:  "option group: " + name,
+			Action: &NavigateToRDSResourceAction{Shortcut: "rds-options", ID: name},
+		})
+	}
+	return links
+}
+
+// groupMemberships lists parameter or option groups for the details
+func groupMemberships(groups []rdsadapter.GroupMembership) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, map[string]interface{}{
+			"Name":   g.Name,
+			"Status": g.Status,
+		})
+	}
+	return result
+}
+
+func (h *RDSInstancesHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	instance, err := h.client.GetDBInstance(ctx, id)
+	if err != nil {
+		return nil, err
 	}
+	return instance.Tags, nil
+}
+
+func (h *RDSInstancesHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	instance, err := h.client.GetDBInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	return h.client.UpdateTags(ctx, instance.ARN, set, remove)
 }
 
 // RDSInstanceResource implements Resource interface for RDS instances
 type RDSInstanceResource struct {
 	instance rdsadapter.DBInstance
 	region   string
+	showCost bool
 }
 
 func (r *RDSInstanceResource) GetID() string   { return r.instance.DBInstanceID }
 func (r *RDSInstanceResource) GetName() string { return r.instance.DBInstanceID }
 func (r *RDSInstanceResource) GetARN() string {
+	if r.instance.ARN != "" {
+		return r.instance.ARN
+	}
 	return fmt.Sprintf("arn:aws:rds:%s::db:%s", r.region, r.instance.DBInstanceID)
 }
 func (r *RDSInstanceResource) GetType() string   { return "rds:instances" }
@@ -198,7 +314,7 @@ func (r *RDSInstanceResource) ToTableRow() []string {
 		endpoint = "-"
 	}
 
-	return []string{
+	row := []string{
 		r.instance.DBInstanceID,
 		engineVersion,
 		r.instance.Status,
@@ -207,6 +323,17 @@ func (r *RDSInstanceResource) ToTableRow() []string {
 		multiAZ,
 		endpoint,
 	}
+
+	if r.showCost {
+		// Stopped instances only accrue storage charges
+		cost := "-"
+		if r.instance.Status != "stopped" {
+			cost = formatMonthlyEstimate(EstimateRDSMonthlyCost(r.instance.DBInstanceClass, r.instance.MultiAZ))
+		}
+		row = append(row, cost)
+	}
+
+	return row
 }
 
 func (r *RDSInstanceResource) ToDetailMap() map[string]interface{} {
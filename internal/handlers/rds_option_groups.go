@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	rdsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/rds"
+)
+
+// NavigateToRDSResourceAction is returned to open an RDS list and select a resource in it
+type NavigateToRDSResourceAction struct {
+	Shortcut string // Shortcut of the list, e.g. rds-options
+	ID       string
+}
+
+func (a *NavigateToRDSResourceAction) Error() string {
+	return fmt.Sprintf("navigate to %s %s", a.Shortcut, a.ID)
+}
+
+func (a *NavigateToRDSResourceAction) IsActionMsg() {}
+
+// RDSOptionGroupsHandler handles RDS option groups, read-only
+type RDSOptionGroupsHandler struct {
+	BaseHandler
+	client *rdsadapter.ParameterGroupsClient
+	region string
+}
+
+// NewRDSOptionGroupsHandler creates a new RDS option groups handler
+func NewRDSOptionGroupsHandler(rdsClient *rds.Client, region string) *RDSOptionGroupsHandler {
+	return &RDSOptionGroupsHandler{
+		client: rdsadapter.NewParameterGroupsClient(rdsClient),
+		region: region,
+	}
+}
+
+func (h *RDSOptionGroupsHandler) ResourceType() string { return "rds:option-groups" }
+func (h *RDSOptionGroupsHandler) ResourceName() string { return "RDS Option Groups" }
+func (h *RDSOptionGroupsHandler) ResourceIcon() string { return "🧩" }
+func (h *RDSOptionGroupsHandler) ShortcutKey() string  { return "rds-options" }
+
+func (h *RDSOptionGroupsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 36, Sortable: true},
+		{Title: "Engine", Width: 16, Sortable: true},
+		{Title: "Version", Width: 8, Sortable: true},
+		{Title: "Options", Width: 40, Sortable: false},
+		{Title: "Description", Width: 35, Sortable: false},
+	}
+}
+
+func (h *RDSOptionGroupsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	groups, err := h.client.ListOptionGroups(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list RDS option groups", err)
+	}
+
+	resources := make([]Resource, 0, len(groups))
+	for _, group := range groups {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(group.Name), filter) &&
+				!strings.Contains(strings.ToLower(group.Engine), filter) &&
+				!strings.Contains(strings.ToLower(optionNames(group)), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &RDSOptionGroupResource{
+			group:  group,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *RDSOptionGroupsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	group, err := h.client.GetOptionGroup(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get RDS option group %s", id), err)
+	}
+
+	return &RDSOptionGroupResource{
+		group:  *group,
+		region: h.region,
+	}, nil
+}
+
+func (h *RDSOptionGroupsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	group, err := h.client.GetOptionGroup(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe RDS option group %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	info := map[string]interface{}{
+		"Name":               group.Name,
+		"Arn":                group.ARN,
+		"Engine":             group.Engine,
+		"MajorEngineVersion": group.MajorEngineVersion,
+		"Description":        group.Description,
+	}
+	if group.VpcID != "" {
+		info["VpcId"] = group.VpcID
+	}
+	details["OptionGroup"] = info
+
+	options := make([]map[string]interface{}, 0, len(group.Options))
+	for _, o := range group.Options {
+		option := map[string]interface{}{
+			"Name": o.Name,
+		}
+		if o.Version != "" {
+			option["Version"] = o.Version
+		}
+		if o.Port != 0 {
+			option["Port"] = o.Port
+		}
+		if o.Persistent {
+			option["Persistent"] = true
+		}
+		if o.Permanent {
+			option["Permanent"] = true
+		}
+		if len(o.Settings) > 0 {
+			option["Settings"] = o.Settings
+		}
+		options = append(options, option)
+	}
+	details["Options"] = options
+
+	return details, nil
+}
+
+// optionNames lists the options of a group, e.g. SQLSERVER_BACKUP_RESTORE, TDE
+func optionNames(group rdsadapter.OptionGroup) string {
+	names := make([]string, 0, len(group.Options))
+	for _, o := range group.Options {
+		names = append(names, o.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// RDSOptionGroupResource implements Resource interface for RDS option groups
+type RDSOptionGroupResource struct {
+	group  rdsadapter.OptionGroup
+	region string
+}
+
+func (r *RDSOptionGroupResource) GetID() string     { return r.group.Name }
+func (r *RDSOptionGroupResource) GetName() string   { return r.group.Name }
+func (r *RDSOptionGroupResource) GetARN() string    { return r.group.ARN }
+func (r *RDSOptionGroupResource) GetType() string   { return "rds:option-groups" }
+func (r *RDSOptionGroupResource) GetRegion() string { return r.region }
+
+func (r *RDSOptionGroupResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *RDSOptionGroupResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *RDSOptionGroupResource) ToTableRow() []string {
+	options := optionNames(r.group)
+	if options == "" {
+		options = "-"
+	}
+
+	return []string{
+		r.group.Name,
+		r.group.Engine,
+		r.group.MajorEngineVersion,
+		options,
+		r.group.Description,
+	}
+}
+
+func (r *RDSOptionGroupResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Name":               r.group.Name,
+		"Engine":             r.group.Engine,
+		"MajorEngineVersion": r.group.MajorEngineVersion,
+		"Options":            optionNames(r.group),
+	}
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	rdsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/rds"
+)
+
+// unsetParameter stands for a parameter without a value in a diff, the engine's own
+// built-in value applies to it
+const unsetParameter = "(engine built-in)"
+
+// NavigateToParametersAction is returned by ExecuteAction to list a parameter group's parameters
+type NavigateToParametersAction struct {
+	GroupName string
+}
+
+func (a *NavigateToParametersAction) Error() string {
+	return fmt.Sprintf("navigate to parameters of %s", a.GroupName)
+}
+
+func (a *NavigateToParametersAction) IsActionMsg() {}
+
+// RDSParameterGroupsHandler handles RDS DB parameter groups
+type RDSParameterGroupsHandler struct {
+	BaseHandler
+	client *rdsadapter.ParameterGroupsClient
+	region string
+}
+
+// NewRDSParameterGroupsHandler creates a new RDS parameter groups handler
+func NewRDSParameterGroupsHandler(rdsClient *rds.Client, region string) *RDSParameterGroupsHandler {
+	return &RDSParameterGroupsHandler{
+		client: rdsadapter.NewParameterGroupsClient(rdsClient),
+		region: region,
+	}
+}
+
+func (h *RDSParameterGroupsHandler) ResourceType() string { return "rds:parameter-groups" }
+func (h *RDSParameterGroupsHandler) ResourceName() string { return "RDS Parameter Groups" }
+func (h *RDSParameterGroupsHandler) ResourceIcon() string { return "⚙️" }
+func (h *RDSParameterGroupsHandler) ShortcutKey() string  { return "rds-params" }
+
+func (h *RDSParameterGroupsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 36, Sortable: true},
+		{Title: "Family", Width: 20, Sortable: true},
+		{Title: "Type", Width: 8, Sortable: true},
+		{Title: "Description", Width: 45, Sortable: false},
+	}
+}
+
+func (h *RDSParameterGroupsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	groups, err := h.client.ListDBParameterGroups(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list RDS parameter groups", err)
+	}
+
+	resources := make([]Resource, 0, len(groups))
+	for _, group := range groups {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(group.Name), filter) &&
+				!strings.Contains(strings.ToLower(group.Family), filter) &&
+				!strings.Contains(strings.ToLower(group.Description), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &RDSParameterGroupResource{
+			group:  group,
+			region: h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *RDSParameterGroupsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	group, err := h.client.GetDBParameterGroup(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get RDS parameter group %s", id), err)
+	}
+
+	return &RDSParameterGroupResource{
+		group:  *group,
+		region: h.region,
+	}, nil
+}
+
+func (h *RDSParameterGroupsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	group, err := h.client.GetDBParameterGroup(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe RDS parameter group %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+	details["ParameterGroup"] = map[string]interface{}{
+		"Name":        group.Name,
+		"Arn":         group.ARN,
+		"Family":      group.Family,
+		"Description": group.Description,
+		"Default":     group.IsDefault(),
+	}
+
+	// Parameters set on the group rather than left to the engine
+	params, err := h.client.ListParameters(ctx, id)
+	if err != nil {
+		details["ModifiedParameters"] = fmt.Sprintf("Unavailable: %v", err)
+		return details, nil
+	}
+	modified := make(map[string]interface{})
+	for _, p := range params {
+		if p.Source == "user" {
+			modified[p.Name] = p.Value
+		}
+	}
+	if len(modified) > 0 {
+		details["ModifiedParameters"] = modified
+	}
+
+	return details, nil
+}
+
+func (h *RDSParameterGroupsHandler) Actions() []Action {
+	return []Action{
+		{Key: "p", Name: "parameters", Description: "View parameters"},
+		{Key: "D", Name: "defaults", Description: "Diff against engine defaults"},
+	}
+}
+
+func (h *RDSParameterGroupsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "parameters":
+		return &NavigateToParametersAction{GroupName: resourceID}
+	case "defaults":
+		return diffAgainstEngineDefaults(ctx, h.client, resourceID)
+	}
+
+	return ErrNotSupported
+}
+
+// diffAgainstEngineDefaults compares the parameters of a group with the defaults of its
+// family. Only parameters with a value on either side are shown.
+func diffAgainstEngineDefaults(ctx context.Context, client *rdsadapter.ParameterGroupsClient, groupName string) error {
+	group, err := client.GetDBParameterGroup(ctx, groupName)
+	if err != nil {
+		return err
+	}
+	params, err := client.ListParameters(ctx, groupName)
+	if err != nil {
+		return err
+	}
+	defaults, err := client.ListEngineDefaultParameters(ctx, group.Family)
+	if err != nil {
+		return err
+	}
+
+	left := make(map[string]interface{})
+	right := make(map[string]interface{})
+	for _, p := range defaults {
+		if p.Value != "" {
+			left[p.Name] = p.Value
+			right[p.Name] = unsetParameter
+		}
+	}
+	for _, p := range params {
+		if p.Value == "" {
+			continue
+		}
+		right[p.Name] = p.Value
+		if _, ok := left[p.Name]; !ok {
+			left[p.Name] = unsetParameter
+		}
+	}
+
+	return &ShowDiffAction{
+		LeftName:  group.Family + " defaults",
+		RightName: group.Name,
+		Left:      left,
+		Right:     right,
+	}
+}
+
+// RDSParameterGroupResource implements Resource interface for RDS parameter groups
+type RDSParameterGroupResource struct {
+	group  rdsadapter.DBParameterGroup
+	region string
+}
+
+func (r *RDSParameterGroupResource) GetID() string     { return r.group.Name }
+func (r *RDSParameterGroupResource) GetName() string   { return r.group.Name }
+func (r *RDSParameterGroupResource) GetARN() string    { return r.group.ARN }
+func (r *RDSParameterGroupResource) GetType() string   { return "rds:parameter-groups" }
+func (r *RDSParameterGroupResource) GetRegion() string { return r.region }
+
+func (r *RDSParameterGroupResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *RDSParameterGroupResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *RDSParameterGroupResource) ToTableRow() []string {
+	groupType := "custom"
+	if r.group.IsDefault() {
+		groupType = "default"
+	}
+
+	return []string{
+		r.group.Name,
+		r.group.Family,
+		groupType,
+		r.group.Description,
+	}
+}
+
+func (r *RDSParameterGroupResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Name":        r.group.Name,
+		"Family":      r.group.Family,
+		"Description": r.group.Description,
+	}
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	rdsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/rds"
+)
+
+// RDSParametersHandler lists the parameters of an RDS parameter group
+type RDSParametersHandler struct {
+	BaseHandler
+	client    *rdsadapter.ParameterGroupsClient
+	region    string
+	groupName string
+
+	// Parameters from the last list, keyed by name. A group has hundreds of parameters
+	// and no call to get just one.
+	params map[string]rdsadapter.Parameter
+}
+
+// NewRDSParametersHandlerForGroup creates a handler listing the parameters of a group
+func NewRDSParametersHandlerForGroup(rdsClient *rds.Client, region, groupName string) *RDSParametersHandler {
+	return &RDSParametersHandler{
+		client:    rdsadapter.NewParameterGroupsClient(rdsClient),
+		region:    region,
+		groupName: groupName,
+	}
+}
+
+func (h *RDSParametersHandler) ResourceType() string { return "rds:parameters" }
+func (h *RDSParametersHandler) ResourceName() string { return "Parameters" }
+func (h *RDSParametersHandler) ResourceIcon() string { return "⚙️" }
+func (h *RDSParametersHandler) ShortcutKey() string  { return "rds-parameters" }
+
+func (h *RDSParametersHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 40, Sortable: true},
+		{Title: "Value", Width: 30, Sortable: true},
+		{Title: "Source", Width: 15, Sortable: true},
+		{Title: "Apply Type", Width: 10, Sortable: true},
+		{Title: "Modifiable", Width: 10, Sortable: true},
+	}
+}
+
+func (h *RDSParametersHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	params, err := h.client.ListParameters(ctx, h.groupName)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list parameters of %s", h.groupName), err)
+	}
+
+	h.params = make(map[string]rdsadapter.Parameter, len(params))
+	resources := make([]Resource, 0, len(params))
+	for _, param := range params {
+		h.params[param.Name] = param
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(param.Name), filter) &&
+				!strings.Contains(strings.ToLower(param.Value), filter) &&
+				!strings.Contains(strings.ToLower(param.Source), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &RDSParameterResource{
+			param:     param,
+			groupName: h.groupName,
+			region:    h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+// parameter returns a parameter from the last list, listing the group if needed
+func (h *RDSParametersHandler) parameter(ctx context.Context, name string) (rdsadapter.Parameter, error) {
+	if h.params == nil {
+		if _, err := h.List(ctx, ListOptions{}); err != nil {
+			return rdsadapter.Parameter{}, err
+		}
+	}
+	param, ok := h.params[name]
+	if !ok {
+		return rdsadapter.Parameter{}, fmt.Errorf("parameter %s not found in %s", name, h.groupName)
+	}
+	return param, nil
+}
+
+func (h *RDSParametersHandler) Get(ctx context.Context, id string) (Resource, error) {
+	param, err := h.parameter(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get parameter %s", id), err)
+	}
+
+	return &RDSParameterResource{
+		param:     param,
+		groupName: h.groupName,
+		region:    h.region,
+	}, nil
+}
+
+func (h *RDSParametersHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	param, err := h.parameter(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe parameter %s", id), err)
+	}
+
+	value := param.Value
+	if value == "" {
+		value = unsetParameter
+	}
+
+	details := map[string]interface{}{
+		"Name":           param.Name,
+		"Value":          value,
+		"Source":         param.Source,
+		"ApplyType":      param.ApplyType,
+		"DataType":       param.DataType,
+		"Modifiable":     param.Modifiable,
+		"ParameterGroup": h.groupName,
+	}
+	if param.ApplyMethod != "" {
+		details["ApplyMethod"] = param.ApplyMethod
+	}
+	if param.AllowedValues != "" {
+		details["AllowedValues"] = param.AllowedValues
+	}
+	if param.Description != "" {
+		details["Description"] = param.Description
+	}
+
+	return details, nil
+}
+
+func (h *RDSParametersHandler) Actions() []Action {
+	return []Action{
+		{Key: "D", Name: "defaults", Description: "Diff against engine defaults"},
+	}
+}
+
+func (h *RDSParametersHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "defaults" {
+		return ErrNotSupported
+	}
+	return diffAgainstEngineDefaults(ctx, h.client, h.groupName)
+}
+
+// RDSParameterResource implements Resource interface for the parameters of a group
+type RDSParameterResource struct {
+	param     rdsadapter.Parameter
+	groupName string
+	region    string
+}
+
+func (r *RDSParameterResource) GetID() string     { return r.param.Name }
+func (r *RDSParameterResource) GetName() string   { return r.param.Name }
+func (r *RDSParameterResource) GetARN() string    { return "" }
+func (r *RDSParameterResource) GetType() string   { return "rds:parameters" }
+func (r *RDSParameterResource) GetRegion() string { return r.region }
+
+func (r *RDSParameterResource) GetCreatedAt() time.Time {
+	return time.Time{}
+}
+
+func (r *RDSParameterResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *RDSParameterResource) ToTableRow() []string {
+	value := r.param.Value
+	if value == "" {
+		value = "-"
+	}
+	modifiable := "no"
+	if r.param.Modifiable {
+		modifiable = "yes"
+	}
+
+	return []string{
+		r.param.Name,
+		value,
+		r.param.Source,
+		r.param.ApplyType,
+		modifiable,
+	}
+}
+
+func (r *RDSParameterResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Name":      r.param.Name,
+		"Value":     r.param.Value,
+		"Source":    r.param.Source,
+		"ApplyType": r.param.ApplyType,
+	}
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	rdsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/rds"
+)
+
+// snapshotIDPattern is what RDS accepts as a snapshot identifier: a letter, then letters,
+// digits and single hyphens, not ending with a hyphen
+var snapshotIDPattern = regexp.MustCompile(`^[A-Za-z](?:-?[A-Za-z0-9])*$`)
+
+// DefaultCopyKMSKey encrypts copies of encrypted snapshots in the destination region
+// when no other key is given
+const DefaultCopyKMSKey = "alias/aws/rds"
+
+// NavigateToInstanceSnapshotsAction is returned by ExecuteAction to list an instance's snapshots
+type NavigateToInstanceSnapshotsAction struct {
+	DBInstanceID string
+}
+
+func (a *NavigateToInstanceSnapshotsAction) Error() string {
+	return fmt.Sprintf("navigate to snapshots of %s", a.DBInstanceID)
+}
+
+func (a *NavigateToInstanceSnapshotsAction) IsActionMsg() {}
+
+// CreateDBSnapshotAction takes a manual snapshot of an instance after confirmation.
+// The snapshot name is entered in the confirmation dialog.
+type CreateDBSnapshotAction struct {
+	DBInstanceID string
+}
+
+func (a *CreateDBSnapshotAction) Error() string {
+	return fmt.Sprintf("snapshot %s", a.DBInstanceID)
+}
+
+func (a *CreateDBSnapshotAction) IsActionMsg() {}
+
+// DefaultSnapshotID names a manual snapshot after its instance and the time it is taken
+func (a *CreateDBSnapshotAction) DefaultSnapshotID(now time.Time) string {
+	return fmt.Sprintf("%s-%s", a.DBInstanceID, now.UTC().Format("20060102-1504"))
+}
+
+// DeleteDBSnapshotAction triggers the delete confirmation for a manual snapshot
+type DeleteDBSnapshotAction struct {
+	SnapshotID string
+}
+
+func (a *DeleteDBSnapshotAction) Error() string {
+	return fmt.Sprintf("delete snapshot %s", a.SnapshotID)
+}
+
+func (a *DeleteDBSnapshotAction) IsActionMsg() {}
+
+// CopyDBSnapshotAction copies a snapshot to another region after confirmation. The
+// destination region is entered in the confirmation dialog.
+type CopyDBSnapshotAction struct {
+	SnapshotID   string
+	SnapshotARN  string
+	SnapshotType string
+	Region       string
+	Encrypted    bool
+}
+
+func (a *CopyDBSnapshotAction) Error() string {
+	return fmt.Sprintf("copy snapshot %s", a.SnapshotID)
+}
+
+func (a *CopyDBSnapshotAction) IsActionMsg() {}
+
+// TargetID names the copy. Automated snapshots are named rds:<instance>-<date>, which
+// isn't a valid name for a manual one.
+func (a *CopyDBSnapshotAction) TargetID() string {
+	return strings.TrimPrefix(a.SnapshotID, "rds:")
+}
+
+// Input builds the copy into the destination region
+func (a *CopyDBSnapshotAction) Input() rdsadapter.CopySnapshotInput {
+	in := rdsadapter.CopySnapshotInput{
+		SourceARN:    a.SnapshotARN,
+		SourceRegion: a.Region,
+		TargetID:     a.TargetID(),
+	}
+	if a.Encrypted {
+		in.KMSKeyID = DefaultCopyKMSKey
+	}
+	return in
+}
+
+// ValidateSnapshotID checks a snapshot name entered for a new snapshot
+func ValidateSnapshotID(id string) error {
+	if id == "" {
+		return fmt.Errorf("enter a snapshot name")
+	}
+	if len(id) > 255 {
+		return fmt.Errorf("snapshot names are at most 255 characters")
+	}
+	if !snapshotIDPattern.MatchString(id) {
+		return fmt.Errorf("%q is not a valid snapshot name: start with a letter and use letters, digits and single hyphens", id)
+	}
+	return nil
+}
+
+// ValidateCopyRegion checks the destination region of a snapshot copy
+func (a *CopyDBSnapshotAction) ValidateCopyRegion(region string, known []string) error {
+	if region == "" {
+		return fmt.Errorf("enter the region to copy the snapshot to")
+	}
+	if region == a.Region {
+		return fmt.Errorf("the snapshot is already in %s", region)
+	}
+	for _, r := range known {
+		if r == region {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown region %q", region)
+}
+
+// createSnapshotAction prepares a manual snapshot of the instance a snapshot belongs to
+func (h *RDSSnapshotsHandler) createSnapshotAction(ctx context.Context, snapshotID string) error {
+	instanceID := h.dbInstanceID
+	if instanceID == "" {
+		snap, err := h.client.GetDBSnapshot(ctx, snapshotID)
+		if err != nil {
+			return err
+		}
+		instanceID = snap.DBInstanceID
+	}
+	return &CreateDBSnapshotAction{DBInstanceID: instanceID}
+}
+
+// deleteSnapshotAction prepares the deletion of a manual snapshot. Automated snapshots
+// expire with the instance's backup retention period instead.
+func (h *RDSSnapshotsHandler) deleteSnapshotAction(ctx context.Context, snapshotID string) error {
+	snap, err := h.client.GetDBSnapshot(ctx, snapshotID)
+	if err != nil {
+		return err
+	}
+	if snap.SnapshotType == "automated" {
+		return fmt.Errorf("%s is an automated snapshot, it is deleted when it falls out of the backup retention period", snap.SnapshotID)
+	}
+	if snap.Status != "available" && snap.Status != "failed" {
+		return fmt.Errorf("snapshot %s is %s, wait until it is available to delete it", snap.SnapshotID, snap.Status)
+	}
+	return &DeleteDBSnapshotAction{SnapshotID: snap.SnapshotID}
+}
+
+// copySnapshotAction prepares copying a snapshot to another region
+func (h *RDSSnapshotsHandler) copySnapshotAction(ctx context.Context, snapshotID string) error {
+	snap, err := h.client.GetDBSnapshot(ctx, snapshotID)
+	if err != nil {
+		return err
+	}
+	if snap.Status != "available" {
+		return fmt.Errorf("snapshot %s is %s, only available snapshots can be copied", snap.SnapshotID, snap.Status)
+	}
+	return &CopyDBSnapshotAction{
+		SnapshotID:   snap.SnapshotID,
+		SnapshotARN:  snap.SnapshotARN,
+		SnapshotType: snap.SnapshotType,
+		Region:       h.region,
+		Encrypted:    snap.Encrypted,
+	}
+}
+
+// CreateSnapshot starts a manual snapshot of an instance
+func (h *RDSSnapshotsHandler) CreateSnapshot(ctx context.Context, dbInstanceID, snapshotID string) (*rdsadapter.DBSnapshot, error) {
+	return h.client.CreateDBSnapshot(ctx, dbInstanceID, snapshotID)
+}
+
+// DeleteSnapshot deletes a manual snapshot
+func (h *RDSSnapshotsHandler) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	return h.client.DeleteDBSnapshot(ctx, snapshotID)
+}
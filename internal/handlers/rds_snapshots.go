@@ -0,0 +1,364 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+	rdsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/rds"
+)
+
+// RestoreSnapshotAction is returned by ExecuteAction to open the restore wizard for a snapshot
+type RestoreSnapshotAction struct {
+	SnapshotID       string
+	SourceInstanceID string
+	Engine           string
+	EngineVersion    string
+	VpcID            string
+}
+
+func (a *RestoreSnapshotAction) Error() string {
+	return fmt.Sprintf("restore snapshot %s", a.SnapshotID)
+}
+
+func (a *RestoreSnapshotAction) IsActionMsg() {}
+
+// RestoreSnapshotOptions holds the choices offered by the restore wizard
+type RestoreSnapshotOptions struct {
+	SnapshotID       string
+	SourceInstanceID string
+	Engine           string
+
+	// Defaults copied from the source instance when it still exists
+	DefaultIdentifier     string
+	DefaultClass          string
+	DefaultSubnetGroup    string
+	DefaultSecurityGroups []string
+
+	InstanceClasses []string
+	SubnetGroups    []rdsadapter.DBSubnetGroup
+	SecurityGroups  []ec2adapter.SecurityGroup
+}
+
+// RestoreSnapshotRequest is a confirmed restore of a snapshot to a new instance
+type RestoreSnapshotRequest struct {
+	SnapshotID       string
+	DBInstanceID     string
+	DBInstanceClass  string
+	SubnetGroup      string
+	SecurityGroupIDs []string
+}
+
+// SnapshotRestorer loads restore options and restores RDS snapshots to new instances
+type SnapshotRestorer struct {
+	snapshots      *rdsadapter.SnapshotsClient
+	instances      *rdsadapter.InstancesClient
+	securityGroups *ec2adapter.SecurityGroupsClient
+}
+
+// NewSnapshotRestorer creates a new snapshot restorer
+func NewSnapshotRestorer(rdsClient *rds.Client, ec2Client *ec2.Client) *SnapshotRestorer {
+	return &SnapshotRestorer{
+		snapshots:      rdsadapter.NewSnapshotsClient(rdsClient),
+		instances:      rdsadapter.NewInstancesClient(rdsClient),
+		securityGroups: ec2adapter.NewSecurityGroupsClient(ec2Client),
+	}
+}
+
+// LoadOptions gathers the instance classes, subnet groups and security groups a snapshot can be restored with
+func (r *SnapshotRestorer) LoadOptions(ctx context.Context, action *RestoreSnapshotAction) (*RestoreSnapshotOptions, error) {
+	opts := &RestoreSnapshotOptions{
+		SnapshotID:        action.SnapshotID,
+		SourceInstanceID:  action.SourceInstanceID,
+		Engine:            action.Engine,
+		DefaultIdentifier: action.SourceInstanceID + "-restored",
+	}
+
+	// The source instance may have been deleted, in which case there are no defaults
+	if source, err := r.instances.GetDBInstance(ctx, action.SourceInstanceID); err == nil {
+		opts.DefaultClass = source.DBInstanceClass
+		opts.DefaultSubnetGroup = source.DBSubnetGroup
+		opts.DefaultSecurityGroups = source.VpcSecurityGroups
+	}
+
+	classes, err := r.snapshots.ListOrderableInstanceClasses(ctx, action.Engine, action.EngineVersion)
+	if err != nil {
+		return nil, err
+	}
+	opts.InstanceClasses = classes
+
+	subnetGroups, err := r.snapshots.ListDBSubnetGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts.SubnetGroups = subnetGroups
+
+	// Without a source instance, default to a subnet group in the snapshot's VPC
+	if opts.DefaultSubnetGroup == "" {
+		for _, group := range subnetGroups {
+			if group.VpcID == action.VpcID {
+				opts.DefaultSubnetGroup = group.Name
+				break
+			}
+		}
+	}
+
+	securityGroups, err := r.securityGroups.ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts.SecurityGroups = securityGroups
+
+	return opts, nil
+}
+
+// Restore starts restoring a snapshot to a new instance and returns the instance as created
+func (r *SnapshotRestorer) Restore(ctx context.Context, req *RestoreSnapshotRequest) (*rdsadapter.DBInstance, error) {
+	return r.snapshots.RestoreDBInstanceFromDBSnapshot(ctx, rdsadapter.RestoreFromSnapshotInput{
+		SnapshotID:          req.SnapshotID,
+		DBInstanceID:        req.DBInstanceID,
+		DBInstanceClass:     req.DBInstanceClass,
+		DBSubnetGroupName:   req.SubnetGroup,
+		VpcSecurityGroupIDs: req.SecurityGroupIDs,
+	})
+}
+
+// Instance gets the current state of an instance, used to track a restore until it is available
+func (r *SnapshotRestorer) Instance(ctx context.Context, dbInstanceID string) (*rdsadapter.DBInstance, error) {
+	return r.instances.GetDBInstance(ctx, dbInstanceID)
+}
+
+// RDSSnapshotsHandler handles RDS DB snapshot resources
+type RDSSnapshotsHandler struct {
+	BaseHandler
+	client       *rdsadapter.SnapshotsClient
+	region       string
+	dbInstanceID string // Optional - if set, only this instance's snapshots are listed
+}
+
+// NewRDSSnapshotsHandler creates a new RDS snapshots handler
+func NewRDSSnapshotsHandler(rdsClient *rds.Client, region string) *RDSSnapshotsHandler {
+	return &RDSSnapshotsHandler{
+		client: rdsadapter.NewSnapshotsClient(rdsClient),
+		region: region,
+	}
+}
+
+// NewRDSSnapshotsHandlerForInstance creates an RDS snapshots handler for one instance's snapshots
+func NewRDSSnapshotsHandlerForInstance(rdsClient *rds.Client, region, dbInstanceID string) *RDSSnapshotsHandler {
+	return &RDSSnapshotsHandler{
+		client:       rdsadapter.NewSnapshotsClient(rdsClient),
+		region:       region,
+		dbInstanceID: dbInstanceID,
+	}
+}
+
+func (h *RDSSnapshotsHandler) ResourceType() string { return "rds:snapshots" }
+func (h *RDSSnapshotsHandler) ResourceName() string { return "RDS Snapshots" }
+func (h *RDSSnapshotsHandler) ResourceIcon() string { return "📸" }
+func (h *RDSSnapshotsHandler) ShortcutKey() string  { return "rds-snapshots" }
+
+func (h *RDSSnapshotsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Snapshot ID", Width: 40, Sortable: true},
+		{Title: "DB Instance", Width: 25, Sortable: true},
+		{Title: "Type", Width: 10, Sortable: true},
+		{Title: "Status", Width: 12, Sortable: true},
+		{Title: "Engine", Width: 18, Sortable: true},
+		{Title: "Storage", Width: 10, Sortable: false},
+		{Title: "Created", Width: 20, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *RDSSnapshotsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	snapshots, err := h.client.ListDBSnapshots(ctx, h.dbInstanceID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list RDS snapshots", err)
+	}
+
+	resources := make([]Resource, 0, len(snapshots))
+	for _, snap := range snapshots {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(snap.SnapshotID), filter) &&
+				!strings.Contains(strings.ToLower(snap.DBInstanceID), filter) &&
+				!strings.Contains(strings.ToLower(snap.Status), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &RDSSnapshotResource{
+			snapshot: snap,
+			region:   h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *RDSSnapshotsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	snap, err := h.client.GetDBSnapshot(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get RDS snapshot %s", id), err)
+	}
+
+	return &RDSSnapshotResource{
+		snapshot: *snap,
+		region:   h.region,
+	}, nil
+}
+
+func (h *RDSSnapshotsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	snap, err := h.client.GetDBSnapshot(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe RDS snapshot %s", id), err)
+	}
+
+	details := make(map[string]interface{})
+
+	// Basic info
+	details["Snapshot"] = map[string]interface{}{
+		"DBSnapshotIdentifier": snap.SnapshotID,
+		"DBSnapshotArn":        snap.SnapshotARN,
+		"DBInstanceIdentifier": snap.DBInstanceID,
+		"SnapshotType":         snap.SnapshotType,
+		"Status":               snap.Status,
+		"PercentProgress":      fmt.Sprintf("%d%%", snap.PercentProgress),
+		"Engine":               snap.Engine,
+		"EngineVersion":        snap.EngineVersion,
+		"CreatedTime":          snap.CreatedTime.Format(time.RFC3339),
+	}
+
+	// Storage
+	details["Storage"] = map[string]interface{}{
+		"AllocatedStorage": fmt.Sprintf("%d GB", snap.AllocatedStorage),
+		"StorageType":      snap.StorageType,
+		"Encrypted":        snap.Encrypted,
+	}
+
+	// Network
+	network := map[string]interface{}{
+		"AvailabilityZone": snap.AvailabilityZone,
+		"Port":             snap.Port,
+	}
+	if snap.VpcID != "" {
+		network["VpcId"] = snap.VpcID
+	}
+	details["Network"] = network
+
+	// Tags
+	if len(snap.Tags) > 0 {
+		details["Tags"] = snap.Tags
+	}
+
+	return details, nil
+}
+
+func (h *RDSSnapshotsHandler) Actions() []Action {
+	return []Action{
+		{Key: "R", Name: "restore", Description: "Restore to new instance", Mutating: true},
+		{Key: "s", Name: "snapshot", Description: "Snapshot the instance", Mutating: true},
+		{Key: "y", Name: "copy", Description: "Copy to region", Mutating: true},
+		{Key: "x", Name: "delete", Description: "Delete snapshot", Mutating: true, Severity: SeverityCritical},
+	}
+}
+
+func (h *RDSSnapshotsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "snapshot":
+		return h.createSnapshotAction(ctx, resourceID)
+	case "copy":
+		return h.copySnapshotAction(ctx, resourceID)
+	case "delete":
+		return h.deleteSnapshotAction(ctx, resourceID)
+	}
+
+	if action != "restore" {
+		return ErrNotSupported
+	}
+
+	snap, err := h.client.GetDBSnapshot(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	if snap.Status != "available" {
+		return fmt.Errorf("snapshot %s is %s, only available snapshots can be restored", snap.SnapshotID, snap.Status)
+	}
+
+	return &RestoreSnapshotAction{
+		SnapshotID:       snap.SnapshotID,
+		SourceInstanceID: snap.DBInstanceID,
+		Engine:           snap.Engine,
+		EngineVersion:    snap.EngineVersion,
+		VpcID:            snap.VpcID,
+	}
+}
+
+// RDSSnapshotResource implements Resource interface for RDS snapshots
+type RDSSnapshotResource struct {
+	snapshot rdsadapter.DBSnapshot
+	region   string
+}
+
+func (r *RDSSnapshotResource) GetID() string     { return r.snapshot.SnapshotID }
+func (r *RDSSnapshotResource) GetName() string   { return r.snapshot.SnapshotID }
+func (r *RDSSnapshotResource) GetARN() string    { return r.snapshot.SnapshotARN }
+func (r *RDSSnapshotResource) GetType() string   { return "rds:snapshots" }
+func (r *RDSSnapshotResource) GetRegion() string { return r.region }
+
+func (r *RDSSnapshotResource) GetCreatedAt() time.Time {
+	return r.snapshot.CreatedTime
+}
+
+func (r *RDSSnapshotResource) GetTags() map[string]string {
+	return r.snapshot.Tags
+}
+
+func (r *RDSSnapshotResource) ToTableRow() []string {
+	engineVersion := r.snapshot.Engine
+	if r.snapshot.EngineVersion != "" {
+		engineVersion = fmt.Sprintf("%s %s", r.snapshot.Engine, r.snapshot.EngineVersion)
+	}
+
+	status := r.snapshot.Status
+	if status == "creating" && r.snapshot.PercentProgress > 0 {
+		status = fmt.Sprintf("%s %d%%", status, r.snapshot.PercentProgress)
+	}
+
+	created := "-"
+	if !r.snapshot.CreatedTime.IsZero() {
+		created = r.snapshot.CreatedTime.Format("2006-01-02 15:04")
+	}
+
+	return []string{
+		r.snapshot.SnapshotID,
+		r.snapshot.DBInstanceID,
+		r.snapshot.SnapshotType,
+		status,
+		engineVersion,
+		fmt.Sprintf("%d GB", r.snapshot.AllocatedStorage),
+		created,
+	}
+}
+
+func (r *RDSSnapshotResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"DBSnapshotIdentifier": r.snapshot.SnapshotID,
+		"DBInstanceIdentifier": r.snapshot.DBInstanceID,
+		"SnapshotType":         r.snapshot.SnapshotType,
+		"Status":               r.snapshot.Status,
+		"Engine":               r.snapshot.Engine,
+		"EngineVersion":        r.snapshot.EngineVersion,
+		"AllocatedStorage":     r.snapshot.AllocatedStorage,
+	}
+}
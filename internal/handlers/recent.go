@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+)
+
+// NavigateToRecentAction is returned to open the list of a recent resource and select it
+type NavigateToRecentAction struct {
+	Recent config.RecentResource
+}
+
+func (a *NavigateToRecentAction) Error() string {
+	return fmt.Sprintf("navigate to %s %s", a.Recent.ResourceType, a.Recent.ResourceID)
+}
+
+func (a *NavigateToRecentAction) IsActionMsg() {}
+
+// RecentHandler lists the resources recently described or acted on, most recent first
+type RecentHandler struct {
+	BaseHandler
+	store *config.RecentStore
+
+	// Entries of the last list, keyed by position
+	entries map[string]config.RecentResource
+}
+
+// NewRecentHandler creates a new recent resources handler
+func NewRecentHandler(store *config.RecentStore) *RecentHandler {
+	return &RecentHandler{
+		store:   store,
+		entries: make(map[string]config.RecentResource),
+	}
+}
+
+func (h *RecentHandler) ResourceType() string { return "recent:resources" }
+func (h *RecentHandler) ResourceName() string { return "Recent" }
+func (h *RecentHandler) ResourceIcon() string { return "🕘" }
+func (h *RecentHandler) ShortcutKey() string  { return "recent" }
+
+func (h *RecentHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Viewed", Width: 19, Sortable: true, SortType: SortDate},
+		{Title: "Type", Width: 24, Sortable: true, Priority: 1},
+		{Title: "Name", Width: 40, Sortable: true},
+		{Title: "Action", Width: 16, Sortable: true, Priority: 2},
+		{Title: "Profile", Width: 18, Sortable: true, Priority: 1},
+		{Title: "Region", Width: 14, Sortable: true, Priority: 1},
+	}
+}
+
+func (h *RecentHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	recent := h.store.List()
+	h.entries = make(map[string]config.RecentResource, len(recent))
+	resources := make([]Resource, 0, len(recent))
+	for i, r := range recent {
+		res := &RecentResource{id: strconv.Itoa(i + 1), recent: r}
+		h.entries[res.id] = r
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(strings.Join(res.ToTableRow(), " ")), filter) {
+				continue
+			}
+		}
+		resources = append(resources, res)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *RecentHandler) Get(ctx context.Context, id string) (Resource, error) {
+	recent, ok := h.entries[id]
+	if !ok {
+		return nil, NewHandlerError("NOT_FOUND", fmt.Sprintf("no recent resource %s", id), nil)
+	}
+	return &RecentResource{id: id, recent: recent}, nil
+}
+
+func (h *RecentHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	recent, ok := h.entries[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("no recent resource %s", id), nil)
+	}
+	return (&RecentResource{id: id, recent: recent}).ToDetailMap(), nil
+}
+
+func (h *RecentHandler) Actions() []Action {
+	return []Action{
+		{Key: "g", Name: "goto", Description: "Go to the resource"},
+	}
+}
+
+func (h *RecentHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	recent, ok := h.entries[resourceID]
+	if !ok {
+		return NewHandlerError("NOT_FOUND", fmt.Sprintf("no recent resource %s", resourceID), nil)
+	}
+	switch action {
+	case "goto":
+		return &NavigateToRecentAction{Recent: recent}
+	}
+	return ErrNotSupported
+}
+
+// RecentResource is a resource of the recent list
+type RecentResource struct {
+	id     string // Position in the list
+	recent config.RecentResource
+}
+
+func (r *RecentResource) GetID() string              { return r.id }
+func (r *RecentResource) GetName() string            { return r.recent.Name }
+func (r *RecentResource) GetARN() string             { return r.recent.ARN }
+func (r *RecentResource) GetType() string            { return "recent:resources" }
+func (r *RecentResource) GetRegion() string          { return r.recent.Region }
+func (r *RecentResource) GetCreatedAt() time.Time    { return r.recent.ViewedAt }
+func (r *RecentResource) GetTags() map[string]string { return nil }
+
+func (r *RecentResource) ToTableRow() []string {
+	rr := r.recent
+	return []string{
+		rr.ViewedAt.Local().Format("2006-01-02 15:04:05"),
+		rr.ResourceType,
+		orDash(rr.Name),
+		orDash(rr.Action),
+		orDash(rr.Profile),
+		orDash(rr.Region),
+	}
+}
+
+func (r *RecentResource) ToDetailMap() map[string]interface{} {
+	rr := r.recent
+	details := map[string]interface{}{
+		"Viewed":       rr.ViewedAt.Local().Format("2006-01-02 15:04:05"),
+		"ResourceType": rr.ResourceType,
+		"ResourceID":   rr.ResourceID,
+	}
+	fields := map[string]string{
+		"Name":    rr.Name,
+		"ARN":     rr.ARN,
+		"Profile": rr.Profile,
+		"Region":  rr.Region,
+		"Action":  rr.Action,
+	}
+	for key, value := range fields {
+		if value != "" {
+			details[key] = value
+		}
+	}
+	return map[string]interface{}{"RecentResource": details}
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// RouteTablesHandler handles VPC route table resources
+type RouteTablesHandler struct {
+	BaseHandler
+	ec2Tagging
+	client *ec2adapter.VPCsClient
+	region string
+	vpcID  string // Empty lists route tables of every VPC
+}
+
+// NewRouteTablesHandler creates a new route tables handler
+func NewRouteTablesHandler(ec2Client *ec2.Client, region string) *RouteTablesHandler {
+	return NewRouteTablesHandlerForVPC(ec2Client, region, "")
+}
+
+// NewRouteTablesHandlerForVPC creates a new route tables handler for a specific VPC
+func NewRouteTablesHandlerForVPC(ec2Client *ec2.Client, region, vpcID string) *RouteTablesHandler {
+	return &RouteTablesHandler{
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewVPCsClient(ec2Client),
+		region:     region,
+		vpcID:      vpcID,
+	}
+}
+
+func (h *RouteTablesHandler) ResourceType() string { return "ec2:route-tables" }
+func (h *RouteTablesHandler) ResourceName() string { return "Route Tables" }
+func (h *RouteTablesHandler) ResourceIcon() string { return "🧭" }
+func (h *RouteTablesHandler) ShortcutKey() string  { return "route-tables" }
+
+func (h *RouteTablesHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "Route Table ID", Width: 24, Sortable: false},
+		{Title: "VPC ID", Width: 22, Sortable: true},
+		{Title: "Main", Width: 6, Sortable: true},
+		{Title: "Subnets", Width: 8, Sortable: false},
+		{Title: "Routes", Width: 7, Sortable: false},
+		{Title: "Default Route", Width: 26, Sortable: false},
+	}
+}
+
+func (h *RouteTablesHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	tables, err := h.client.ListRouteTables(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list route tables", err)
+	}
+
+	resources := make([]Resource, 0, len(tables))
+	for _, table := range tables {
+		resource := &RouteTableResource{
+			table:  table,
+			region: h.region,
+		}
+
+		// Apply filter if specified, matching route targets too
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			fields := []string{table.Name, table.RouteTableID}
+			for _, route := range table.Routes {
+				fields = append(fields, route.Target)
+			}
+			if !strings.Contains(strings.ToLower(strings.Join(fields, " ")), filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *RouteTablesHandler) Get(ctx context.Context, id string) (Resource, error) {
+	tables, err := h.client.ListRouteTables(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get route table %s", id), err)
+	}
+
+	for _, table := range tables {
+		if table.RouteTableID == id {
+			return &RouteTableResource{table: table, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("route table %s not found", id), nil)
+}
+
+func (h *RouteTablesHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe route table %s", id), err)
+	}
+	table := res.(*RouteTableResource).table
+
+	details := res.ToDetailMap()
+	details["Routes"] = routeSummaries(table.Routes)
+	if len(table.SubnetIDs) > 0 {
+		details["Subnets"] = table.SubnetIDs
+	}
+	if len(table.Tags) > 0 {
+		details["Tags"] = table.Tags
+	}
+
+	return details, nil
+}
+
+// routeSummaries formats routes for the detail view, e.g. "0.0.0.0/0 → igw-123 (active)"
+func routeSummaries(routes []ec2adapter.Route) []string {
+	summaries := make([]string, 0, len(routes))
+	for _, route := range routes {
+		summary := fmt.Sprintf("%s → %s", route.Destination, route.Target)
+		if route.State != "" && route.State != "active" {
+			summary += fmt.Sprintf(" (%s)", route.State)
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// RouteTableResource implements Resource interface for route tables
+type RouteTableResource struct {
+	table  ec2adapter.RouteTable
+	region string
+}
+
+func (r *RouteTableResource) GetID() string { return r.table.RouteTableID }
+func (r *RouteTableResource) GetName() string {
+	if r.table.Name != "" {
+		return r.table.Name
+	}
+	return r.table.RouteTableID
+}
+func (r *RouteTableResource) GetARN() string {
+	return fmt.Sprintf("arn:aws:ec2:%s:%s:route-table/%s", r.region, r.table.OwnerID, r.table.RouteTableID)
+}
+func (r *RouteTableResource) GetType() string   { return "ec2:route-tables" }
+func (r *RouteTableResource) GetRegion() string { return r.region }
+func (r *RouteTableResource) GetCreatedAt() time.Time {
+	return time.Time{} // Route tables don't have creation time
+}
+
+func (r *RouteTableResource) GetTags() map[string]string {
+	return r.table.Tags
+}
+
+func (r *RouteTableResource) ToTableRow() []string {
+	name := r.table.Name
+	if name == "" {
+		name = "-"
+	}
+
+	main := "No"
+	if r.table.Main {
+		main = "Yes"
+	}
+
+	defaultRoute := "-"
+	for _, route := range r.table.Routes {
+		if route.Destination == "0.0.0.0/0" {
+			defaultRoute = route.Target
+		}
+	}
+
+	return []string{
+		name,
+		r.table.RouteTableID,
+		r.table.VpcID,
+		main,
+		fmt.Sprintf("%d", len(r.table.SubnetIDs)),
+		fmt.Sprintf("%d", len(r.table.Routes)),
+		defaultRoute,
+	}
+}
+
+func (r *RouteTableResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"RouteTableId": r.table.RouteTableID,
+		"Name":         r.table.Name,
+		"VpcId":        r.table.VpcID,
+		"Main":         r.table.Main,
+		"OwnerId":      r.table.OwnerID,
+	}
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// Bucket configurations editable as JSON
+const (
+	BucketConfigLifecycle = "lifecycle"
+	BucketConfigCORS      = "cors"
+)
+
+// S3 limits on the rules of a bucket configuration
+const (
+	maxLifecycleRules = 1000
+	maxCORSRules      = 100
+)
+
+// ToggleBucketVersioningAction enables versioning on a bucket, or suspends it, after
+// confirmation
+type ToggleBucketVersioningAction struct {
+	Bucket  string
+	Current string // Enabled, Suspended or Disabled
+	Enable  bool
+}
+
+func (a *ToggleBucketVersioningAction) Error() string {
+	if a.Enable {
+		return fmt.Sprintf("enable versioning on bucket %s", a.Bucket)
+	}
+	return fmt.Sprintf("suspend versioning on bucket %s", a.Bucket)
+}
+
+func (a *ToggleBucketVersioningAction) IsActionMsg() {}
+
+// EditBucketConfigAction triggers the JSON editor for the lifecycle or CORS rules of a bucket
+type EditBucketConfigAction struct {
+	Bucket string
+	Config string // BucketConfigLifecycle or BucketConfigCORS
+}
+
+func (a *EditBucketConfigAction) Error() string {
+	return fmt.Sprintf("edit %s rules of bucket %s", BucketConfigTitle(a.Config), a.Bucket)
+}
+
+func (a *EditBucketConfigAction) IsActionMsg() {}
+
+// ApplyBucketConfigAction replaces the lifecycle or CORS rules of a bucket with the
+// edited document after confirmation
+type ApplyBucketConfigAction struct {
+	Bucket   string
+	Config   string
+	Document string
+	Rules    int // Rules in the document, none removes the configuration
+}
+
+func (a *ApplyBucketConfigAction) Error() string {
+	return fmt.Sprintf("apply %d %s rules to bucket %s", a.Rules, BucketConfigTitle(a.Config), a.Bucket)
+}
+
+func (a *ApplyBucketConfigAction) IsActionMsg() {}
+
+// SetBucketEncryptionAction changes the default encryption of a bucket after
+// confirmation. The KMS key is entered in the confirmation dialog.
+type SetBucketEncryptionAction struct {
+	Bucket    string
+	Algorithm string // Current algorithm
+	KMSKeyID  string // Current KMS key, if any
+}
+
+func (a *SetBucketEncryptionAction) Error() string {
+	return fmt.Sprintf("set default encryption of bucket %s", a.Bucket)
+}
+
+func (a *SetBucketEncryptionAction) IsActionMsg() {}
+
+// BucketConfigTitle names a bucket configuration for messages
+func BucketConfigTitle(config string) string {
+	if config == BucketConfigCORS {
+		return "CORS"
+	}
+	return config
+}
+
+// versioningAction prepares a toggle of the bucket's versioning. A bucket that was
+// never versioned, or is suspended, gets it enabled.
+func (h *S3BucketsHandler) versioningAction(ctx context.Context, bucket string) error {
+	status, err := h.client.GetBucketVersioning(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return &ToggleBucketVersioningAction{
+		Bucket:  bucket,
+		Current: status,
+		Enable:  status != string(types.BucketVersioningStatusEnabled),
+	}
+}
+
+// encryptionAction prepares a change of the bucket's default encryption
+func (h *S3BucketsHandler) encryptionAction(ctx context.Context, bucket string) error {
+	encryption, err := h.client.GetBucketEncryption(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return &SetBucketEncryptionAction{
+		Bucket:    bucket,
+		Algorithm: encryption.Algorithm,
+		KMSKeyID:  encryption.KMSKeyID,
+	}
+}
+
+// SetVersioning enables or suspends versioning on a bucket
+func (h *S3BucketsHandler) SetVersioning(ctx context.Context, bucket string, enable bool) error {
+	return h.client.SetBucketVersioning(ctx, bucket, enable)
+}
+
+// SetEncryption sets the default encryption of a bucket to SSE-KMS with the given key,
+// or to SSE-S3 when it is empty
+func (h *S3BucketsHandler) SetEncryption(ctx context.Context, bucket, kmsKeyID string) error {
+	return h.client.PutBucketEncryption(ctx, bucket, kmsKeyID)
+}
+
+// BucketConfigJSON returns the lifecycle or CORS rules of a bucket as the JSON array
+// edited by the user. Unset fields are left out.
+func (h *S3BucketsHandler) BucketConfigJSON(ctx context.Context, bucket, config string) (string, error) {
+	var rules interface{}
+	switch config {
+	case BucketConfigLifecycle:
+		lifecycle, err := h.client.GetBucketLifecycle(ctx, bucket)
+		if err != nil {
+			return "", fmt.Errorf("failed to get lifecycle rules of %s: %w", bucket, err)
+		}
+		if lifecycle == nil {
+			lifecycle = []types.LifecycleRule{}
+		}
+		rules = lifecycle
+	case BucketConfigCORS:
+		cors, err := h.client.GetBucketCORS(ctx, bucket)
+		if err != nil {
+			return "", fmt.Errorf("failed to get CORS rules of %s: %w", bucket, err)
+		}
+		if cors == nil {
+			cors = []types.CORSRule{}
+		}
+		rules = cors
+	default:
+		return "", ErrNotSupported
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return "", err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", err
+	}
+	data, err = json.MarshalIndent(dropNulls(doc), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ParseBucketConfig validates an edited lifecycle or CORS document and returns the
+// number of rules in it, so a document S3 would reject isn't confirmed first
+func (h *S3BucketsHandler) ParseBucketConfig(config, document string) (int, error) {
+	switch config {
+	case BucketConfigLifecycle:
+		rules, err := parseLifecycleRules(document)
+		return len(rules), err
+	case BucketConfigCORS:
+		rules, err := parseCORSRules(document)
+		return len(rules), err
+	}
+	return 0, ErrNotSupported
+}
+
+// ApplyBucketConfig replaces the lifecycle or CORS rules of a bucket with those of the
+// document. An empty list removes the configuration.
+func (h *S3BucketsHandler) ApplyBucketConfig(ctx context.Context, bucket, config, document string) error {
+	switch config {
+	case BucketConfigLifecycle:
+		rules, err := parseLifecycleRules(document)
+		if err != nil {
+			return err
+		}
+		return h.client.PutBucketLifecycle(ctx, bucket, rules)
+	case BucketConfigCORS:
+		rules, err := parseCORSRules(document)
+		if err != nil {
+			return err
+		}
+		return h.client.PutBucketCORS(ctx, bucket, rules)
+	}
+	return ErrNotSupported
+}
+
+func parseLifecycleRules(document string) ([]types.LifecycleRule, error) {
+	var rules []types.LifecycleRule
+	if err := decodeStrict(document, &rules); err != nil {
+		return nil, err
+	}
+	if len(rules) > maxLifecycleRules {
+		return nil, fmt.Errorf("%d lifecycle rules, S3 allows at most %d", len(rules), maxLifecycleRules)
+	}
+
+	ids := make(map[string]bool)
+	for i, rule := range rules {
+		name := fmt.Sprintf("rule %d", i+1)
+		if rule.ID != nil {
+			name = fmt.Sprintf("rule %q", *rule.ID)
+			if ids[*rule.ID] {
+				return nil, fmt.Errorf("%s is defined twice", name)
+			}
+			ids[*rule.ID] = true
+		}
+
+		if rule.Status != types.ExpirationStatusEnabled && rule.Status != types.ExpirationStatusDisabled {
+			return nil, fmt.Errorf("%s: Status must be Enabled or Disabled", name)
+		}
+		if rule.Filter == nil && rule.Prefix == nil {
+			return nil, fmt.Errorf("%s: a Filter is required, {} applies the rule to the whole bucket", name)
+		}
+		if rule.Expiration == nil && len(rule.Transitions) == 0 &&
+			rule.NoncurrentVersionExpiration == nil && len(rule.NoncurrentVersionTransitions) == 0 &&
+			rule.AbortIncompleteMultipartUpload == nil {
+			return nil, fmt.Errorf("%s has no action: add an Expiration, Transitions or AbortIncompleteMultipartUpload", name)
+		}
+	}
+	return rules, nil
+}
+
+func parseCORSRules(document string) ([]types.CORSRule, error) {
+	var rules []types.CORSRule
+	if err := decodeStrict(document, &rules); err != nil {
+		return nil, err
+	}
+	if len(rules) > maxCORSRules {
+		return nil, fmt.Errorf("%d CORS rules, S3 allows at most %d", len(rules), maxCORSRules)
+	}
+
+	for i, rule := range rules {
+		name := fmt.Sprintf("rule %d", i+1)
+		if rule.ID != nil {
+			name = fmt.Sprintf("rule %q", *rule.ID)
+		}
+
+		if len(rule.AllowedOrigins) == 0 {
+			return nil, fmt.Errorf("%s: AllowedOrigins is required", name)
+		}
+		if len(rule.AllowedMethods) == 0 {
+			return nil, fmt.Errorf("%s: AllowedMethods is required", name)
+		}
+		for _, method := range rule.AllowedMethods {
+			switch method {
+			case "GET", "PUT", "POST", "DELETE", "HEAD":
+			default:
+				return nil, fmt.Errorf("%s: unsupported method %q, use GET, PUT, POST, DELETE or HEAD", name, method)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// decodeStrict decodes a JSON document, rejecting fields the target doesn't have so a
+// misspelt field isn't silently dropped
+func decodeStrict(document string, v interface{}) error {
+	dec := json.NewDecoder(strings.NewReader(document))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid rules: %w", err)
+	}
+	if dec.More() {
+		return fmt.Errorf("invalid rules: unexpected data after the list")
+	}
+	return nil
+}
+
+// dropNulls removes null values from decoded JSON objects
+func dropNulls(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if field == nil {
+				delete(value, k)
+				continue
+			}
+			value[k] = dropNulls(field)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = dropNulls(item)
+		}
+	}
+	return v
+}
@@ -36,7 +36,7 @@ func (h *S3BucketsHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Bucket Name", Width: 45, Sortable: true},
 		{Title: "Region", Width: 15, Sortable: true},
-		{Title: "Created", Width: 12, Sortable: true},
+		{Title: "Created", Width: 12, Sortable: true, SortType: SortDate},
 	}
 }
 
@@ -139,6 +139,19 @@ func (h *S3BucketsHandler) Describe(ctx context.Context, id string) (map[string]
 		details["LifecycleRules"] = rules
 	}
 
+	// Get CORS rules if exist
+	cors, err := h.client.GetBucketCORS(ctx, id)
+	if err == nil && len(cors) > 0 {
+		rules := make([]map[string]interface{}, 0, len(cors))
+		for _, rule := range cors {
+			rules = append(rules, map[string]interface{}{
+				"AllowedOrigins": strings.Join(rule.AllowedOrigins, ", "),
+				"AllowedMethods": strings.Join(rule.AllowedMethods, ", "),
+			})
+		}
+		details["CORSRules"] = rules
+	}
+
 	// Tags
 	if len(bucket.Tags) > 0 {
 		details["Tags"] = bucket.Tags
@@ -150,6 +163,11 @@ func (h *S3BucketsHandler) Describe(ctx context.Context, id string) (map[string]
 func (h *S3BucketsHandler) Actions() []Action {
 	return []Action{
 		{Key: "p", Name: "policy", Description: "View bucket policy"},
+		{Key: "b", Name: "browse", Description: "Browse objects"},
+		{Key: "v", Name: "versioning", Description: "Toggle versioning", Mutating: true},
+		{Key: "L", Name: "lifecycle", Description: "Edit lifecycle rules", Mutating: true},
+		{Key: "x", Name: "cors", Description: "Edit CORS rules", Mutating: true},
+		{Key: "e", Name: "encryption", Description: "Set default encryption", Mutating: true},
 	}
 }
 
@@ -159,6 +177,16 @@ func (h *S3BucketsHandler) ExecuteAction(ctx context.Context, action string, res
 		return &ViewBucketPolicyAction{
 			BucketName: resourceID,
 		}
+	case "browse":
+		return &NavigateToS3ObjectsAction{Bucket: resourceID}
+	case "versioning":
+		return h.versioningAction(ctx, resourceID)
+	case "lifecycle":
+		return &EditBucketConfigAction{Bucket: resourceID, Config: BucketConfigLifecycle}
+	case "cors":
+		return &EditBucketConfigAction{Bucket: resourceID, Config: BucketConfigCORS}
+	case "encryption":
+		return h.encryptionAction(ctx, resourceID)
 	default:
 		return ErrNotSupported
 	}
@@ -196,6 +224,26 @@ func (a *ViewBucketPolicyAction) Error() string {
 
 func (a *ViewBucketPolicyAction) IsActionMsg() {}
 
+// ResourceTags reads a bucket's tags from the bucket's region
+func (h *S3BucketsHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	return h.client.GetBucketTags(ctx, id)
+}
+
+// UpdateTags replaces the bucket's tag set, as S3 has no calls to change single tags
+func (h *S3BucketsHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	tags, err := h.client.GetBucketTags(ctx, id)
+	if err != nil {
+		return err
+	}
+	for key, value := range set {
+		tags[key] = value
+	}
+	for _, key := range remove {
+		delete(tags, key)
+	}
+	return h.client.PutBucketTags(ctx, id, tags)
+}
+
 // S3BucketResource implements Resource interface for S3 buckets
 type S3BucketResource struct {
 	bucket s3adapter.Bucket
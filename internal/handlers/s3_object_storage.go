@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	s3adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/s3"
+)
+
+// DefaultRestoreDays is how long a restored copy is kept unless another duration is entered
+const DefaultRestoreDays = 7
+
+// S3StorageClasses are the storage classes an object can be moved to
+var S3StorageClasses = []string{
+	"STANDARD",
+	"INTELLIGENT_TIERING",
+	"STANDARD_IA",
+	"ONEZONE_IA",
+	"GLACIER_IR",
+	"GLACIER",
+	"DEEP_ARCHIVE",
+}
+
+// restoreTimes is how long a restore takes per archive storage class and retrieval tier
+var restoreTimes = map[string]map[string]string{
+	"GLACIER": {
+		"Expedited": "1-5 minutes",
+		"Standard":  "3-5 hours",
+		"Bulk":      "5-12 hours",
+	},
+	"DEEP_ARCHIVE": {
+		"Standard": "within 12 hours",
+		"Bulk":     "within 48 hours",
+	},
+}
+
+// RestoreObjectAction triggers the restore form for an archived object
+type RestoreObjectAction struct {
+	Bucket        string
+	Key           string
+	StorageClass  string
+	RestoredUntil time.Time // Expiry of an earlier restore, which a new one extends
+}
+
+func (a *RestoreObjectAction) Error() string {
+	return fmt.Sprintf("restore s3://%s/%s", a.Bucket, a.Key)
+}
+
+func (a *RestoreObjectAction) IsActionMsg() {}
+
+// RestoreObjectRequest is a restore of an archived object, as entered in the restore form
+type RestoreObjectRequest struct {
+	Bucket       string
+	Key          string
+	StorageClass string
+	Tier         string
+	Days         int32
+}
+
+// RestoreTiers returns the retrieval tiers available for an archive storage class,
+// fastest first
+func RestoreTiers(storageClass string) []string {
+	tiers := make([]string, 0, 3)
+	for _, tier := range []string{"Expedited", "Standard", "Bulk"} {
+		if _, ok := restoreTimes[storageClass][tier]; ok {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers
+}
+
+// RestoreTime describes how long a restore with the tier takes
+func RestoreTime(storageClass, tier string) string {
+	return restoreTimes[storageClass][tier]
+}
+
+// Validate checks the restore before it is confirmed
+func (r *RestoreObjectRequest) Validate() error {
+	if RestoreTime(r.StorageClass, r.Tier) == "" {
+		return fmt.Errorf("the %s tier can't restore %s objects", r.Tier, r.StorageClass)
+	}
+	if r.Days < 1 {
+		return fmt.Errorf("the restored copy must be kept for at least a day")
+	}
+	return nil
+}
+
+// Summary describes the restore for the confirmation dialog
+func (r *RestoreObjectRequest) Summary() string {
+	return fmt.Sprintf(
+		"You are about to restore from %s:\n\ns3://%s/%s\n\n"+
+			"%s retrieval takes %s and is billed per GB retrieved.\n"+
+			"A temporary copy is kept for %d days, alongside the archived object.",
+		r.StorageClass, r.Bucket, r.Key, r.Tier, RestoreTime(r.StorageClass, r.Tier), r.Days,
+	)
+}
+
+// ChangeStorageClassAction moves an object to another storage class after confirmation.
+// The target class is entered in the confirmation dialog.
+type ChangeStorageClassAction struct {
+	Bucket  string
+	Key     string
+	Current string
+	Size    int64
+}
+
+func (a *ChangeStorageClassAction) Error() string {
+	return fmt.Sprintf("change storage class of s3://%s/%s", a.Bucket, a.Key)
+}
+
+func (a *ChangeStorageClassAction) IsActionMsg() {}
+
+// ParseStorageClass checks a storage class entered for an object, accepting any case
+func ParseStorageClass(input, current string) (string, error) {
+	class := strings.ToUpper(strings.TrimSpace(input))
+	for _, known := range S3StorageClasses {
+		if class != known {
+			continue
+		}
+		if class == current {
+			return "", fmt.Errorf("the object is already in %s", class)
+		}
+		return class, nil
+	}
+	return "", fmt.Errorf("unknown storage class %q, use one of %s", input, strings.Join(S3StorageClasses, ", "))
+}
+
+// restoreAction prepares the restore of an archived object
+func (h *S3ObjectsHandler) restoreAction(ctx context.Context, key string) error {
+	obj, err := h.client.GetObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !s3ArchivedClasses[obj.StorageClass] {
+		return fmt.Errorf("%s is in %s, only GLACIER and DEEP_ARCHIVE objects need restoring", key, obj.StorageClass)
+	}
+	if obj.Restoring {
+		return fmt.Errorf("a restore of %s is already in progress", key)
+	}
+	return &RestoreObjectAction{
+		Bucket:        h.bucket,
+		Key:           key,
+		StorageClass:  obj.StorageClass,
+		RestoredUntil: obj.RestoredUntil,
+	}
+}
+
+// storageClassAction prepares a change of an object's storage class
+func (h *S3ObjectsHandler) storageClassAction(ctx context.Context, key string) error {
+	obj, err := h.client.GetObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	if s3ArchivedClasses[obj.StorageClass] && obj.RestoredUntil.IsZero() {
+		return fmt.Errorf("%s is archived in %s, restore it with R before changing its storage class", key, obj.StorageClass)
+	}
+	if obj.Size > s3adapter.MaxCopySize {
+		return fmt.Errorf("%s is larger than 5 GB, which a copy in place can't handle", key)
+	}
+	return &ChangeStorageClassAction{
+		Bucket:  h.bucket,
+		Key:     key,
+		Current: obj.StorageClass,
+		Size:    obj.Size,
+	}
+}
+
+// RestoreObject starts the restore of an archived object
+func (h *S3ObjectsHandler) RestoreObject(ctx context.Context, req *RestoreObjectRequest) error {
+	return h.client.RestoreObject(ctx, req.Key, req.Days, req.Tier)
+}
+
+// SetStorageClass moves an object to another storage class, see ObjectsClient.SetStorageClass
+func (h *S3ObjectsHandler) SetStorageClass(ctx context.Context, key, storageClass string) error {
+	return h.client.SetStorageClass(ctx, key, storageClass)
+}
@@ -0,0 +1,416 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	s3adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/s3"
+)
+
+// s3ParentID is the ID of the row that leads back to the parent prefix
+const s3ParentID = ".."
+
+// s3ObjectsPageSize is how many folders and objects a page streams in
+const s3ObjectsPageSize = 500
+
+// s3ArchivedClasses can't be downloaded without restoring the objects first
+var s3ArchivedClasses = map[string]bool{
+	"GLACIER":      true,
+	"DEEP_ARCHIVE": true,
+}
+
+// NavigateToS3ObjectsAction triggers navigation to the objects under a prefix of a bucket
+type NavigateToS3ObjectsAction struct {
+	Bucket string
+	Prefix string
+}
+
+func (a *NavigateToS3ObjectsAction) Error() string {
+	return fmt.Sprintf("navigate to s3://%s/%s", a.Bucket, a.Prefix)
+}
+
+func (a *NavigateToS3ObjectsAction) IsActionMsg() {}
+
+// DownloadPrefixAction triggers the download preview of every object under a prefix
+type DownloadPrefixAction struct {
+	Bucket string
+	Prefix string
+}
+
+func (a *DownloadPrefixAction) Error() string {
+	return fmt.Sprintf("download s3://%s/%s", a.Bucket, a.Prefix)
+}
+
+func (a *DownloadPrefixAction) IsActionMsg() {}
+
+// DownloadObjectAction triggers the download of a single object to a local file
+type DownloadObjectAction struct {
+	Bucket string
+	Key    string
+}
+
+func (a *DownloadObjectAction) Error() string {
+	return fmt.Sprintf("download s3://%s/%s", a.Bucket, a.Key)
+}
+
+func (a *DownloadObjectAction) IsActionMsg() {}
+
+// UploadFileAction triggers the upload of a local file into a prefix
+type UploadFileAction struct {
+	Bucket string
+	Prefix string
+}
+
+func (a *UploadFileAction) Error() string {
+	return fmt.Sprintf("upload to s3://%s/%s", a.Bucket, a.Prefix)
+}
+
+func (a *UploadFileAction) IsActionMsg() {}
+
+// DeleteObjectAction triggers the delete confirmation for an object
+type DeleteObjectAction struct {
+	Bucket string
+	Key    string
+}
+
+func (a *DeleteObjectAction) Error() string {
+	return fmt.Sprintf("delete s3://%s/%s", a.Bucket, a.Key)
+}
+
+func (a *DeleteObjectAction) IsActionMsg() {}
+
+// DownloadPreview is what a prefix download would fetch, shown before it starts
+type DownloadPreview struct {
+	Bucket     string
+	Prefix     string
+	Objects    []s3adapter.Object
+	TotalBytes int64
+	Largest    int64
+	Archived   int // Objects in archive storage classes, left out of the download
+}
+
+// S3ObjectsHandler browses the objects of a bucket one prefix level at a time
+type S3ObjectsHandler struct {
+	BaseHandler
+	client *s3adapter.ObjectsClient
+	region string
+	bucket string
+	prefix string
+}
+
+// NewS3ObjectsHandler creates a new handler for the objects under a prefix of a bucket
+func NewS3ObjectsHandler(s3Client *s3.Client, region, bucket, prefix string) *S3ObjectsHandler {
+	return &S3ObjectsHandler{
+		client: s3adapter.NewObjectsClient(s3Client, bucket),
+		region: region,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (h *S3ObjectsHandler) ResourceType() string { return "s3:objects" }
+func (h *S3ObjectsHandler) ResourceName() string { return "S3 Objects" }
+func (h *S3ObjectsHandler) ResourceIcon() string { return "📄" }
+func (h *S3ObjectsHandler) ShortcutKey() string  { return "s3-objects" }
+
+func (h *S3ObjectsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 50, Sortable: true},
+		{Title: "Size", Width: 10, Sortable: true, SortType: SortSize},
+		{Title: "Storage Class", Width: 14, Sortable: true},
+		{Title: "Last Modified", Width: 17, Sortable: true, SortType: SortDate},
+	}
+}
+
+// ServerFilterHint describes how a search matches objects on the server
+func (h *S3ObjectsHandler) ServerFilterHint() string {
+	return "key prefix, case-sensitive"
+}
+
+// IncrementalPageSize streams the objects into the list a page at a time, since a prefix
+// can hold millions of keys
+func (h *S3ObjectsHandler) IncrementalPageSize() int { return s3ObjectsPageSize }
+
+func (h *S3ObjectsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	var (
+		listing *s3adapter.Listing
+		err     error
+	)
+	if opts.PageSize > 0 {
+		listing, err = h.client.ListPrefixPage(ctx, h.prefix+opts.ServerFilter, opts.NextToken, opts.PageSize)
+	} else {
+		// A search lists the keys starting with it, still one level deep
+		listing, err = h.client.ListPrefix(ctx, h.prefix+opts.ServerFilter, opts.MaxItems)
+	}
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list s3://%s/%s", h.bucket, h.prefix), err)
+	}
+
+	resources := make([]Resource, 0, len(listing.Prefixes)+len(listing.Objects)+1)
+	// The row back to the parent leads the first page only
+	if h.prefix != "" && opts.NextToken == "" {
+		resources = append(resources, &S3ObjectResource{bucket: h.bucket, key: s3ParentID, parent: h.prefix, region: h.region})
+	}
+
+	filter := strings.ToLower(opts.Filter)
+	for _, prefix := range listing.Prefixes {
+		res := &S3ObjectResource{bucket: h.bucket, key: prefix, parent: h.prefix, region: h.region}
+		if filter != "" && !strings.Contains(strings.ToLower(res.GetName()), filter) {
+			continue
+		}
+		resources = append(resources, res)
+	}
+	for i := range listing.Objects {
+		obj := listing.Objects[i]
+		res := &S3ObjectResource{bucket: h.bucket, key: obj.Key, parent: h.prefix, object: &obj, region: h.region}
+		if filter != "" && !strings.Contains(strings.ToLower(res.GetName()), filter) {
+			continue
+		}
+		resources = append(resources, res)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: listing.NextToken,
+		Truncated: listing.Truncated,
+	}, nil
+}
+
+func (h *S3ObjectsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	if id == s3ParentID || strings.HasSuffix(id, "/") {
+		return &S3ObjectResource{bucket: h.bucket, key: id, parent: h.prefix, region: h.region}, nil
+	}
+
+	obj, err := h.client.GetObject(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get s3://%s/%s", h.bucket, id), err)
+	}
+	return &S3ObjectResource{bucket: h.bucket, key: id, parent: h.prefix, object: obj, region: h.region}, nil
+}
+
+func (h *S3ObjectsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe s3://%s/%s", h.bucket, id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *S3ObjectsHandler) Actions() []Action {
+	return []Action{
+		{Key: "b", Name: "browse", Description: "Open folder"},
+		{Key: "D", Name: "download", Description: "Download prefix"},
+		{Key: "s", Name: "save", Description: "Download object"},
+		{Key: "u", Name: "upload", Description: "Upload file here", Mutating: true},
+		{Key: "R", Name: "restore", Description: "Restore from archive", Mutating: true},
+		{Key: "S", Name: "class", Description: "Change storage class", Mutating: true},
+		{Key: "x", Name: "delete", Description: "Delete object", Mutating: true, Severity: SeverityCritical},
+	}
+}
+
+func (h *S3ObjectsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "browse":
+		if resourceID == s3ParentID {
+			return &NavigateToS3ObjectsAction{Bucket: h.bucket, Prefix: parentPrefix(h.prefix)}
+		}
+		if !strings.HasSuffix(resourceID, "/") {
+			return fmt.Errorf("%s is an object, not a folder", resourceID)
+		}
+		return &NavigateToS3ObjectsAction{Bucket: h.bucket, Prefix: resourceID}
+
+	case "download":
+		// The parent row stands for the folder being browsed
+		prefix := resourceID
+		if resourceID == s3ParentID {
+			prefix = h.prefix
+		}
+		return &DownloadPrefixAction{Bucket: h.bucket, Prefix: prefix}
+
+	case "save":
+		if resourceID == s3ParentID || strings.HasSuffix(resourceID, "/") {
+			return fmt.Errorf("select an object to download, use D for folders")
+		}
+		return &DownloadObjectAction{Bucket: h.bucket, Key: resourceID}
+
+	case "upload":
+		// Files always go into the folder being browsed, whatever row is selected
+		return &UploadFileAction{Bucket: h.bucket, Prefix: h.prefix}
+
+	case "restore", "class":
+		if resourceID == s3ParentID || strings.HasSuffix(resourceID, "/") {
+			return fmt.Errorf("select an object, folders have no storage class")
+		}
+		if action == "restore" {
+			return h.restoreAction(ctx, resourceID)
+		}
+		return h.storageClassAction(ctx, resourceID)
+
+	case "delete":
+		if resourceID == s3ParentID || strings.HasSuffix(resourceID, "/") {
+			return fmt.Errorf("select an object to delete, folders can't be deleted")
+		}
+		return &DeleteObjectAction{Bucket: h.bucket, Key: resourceID}
+	}
+
+	return ErrNotSupported
+}
+
+// PreviewDownload lists every object a download of the prefix would fetch
+func (h *S3ObjectsHandler) PreviewDownload(ctx context.Context, prefix string) (*DownloadPreview, error) {
+	objects, err := h.client.ListAll(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &DownloadPreview{Bucket: h.bucket, Prefix: prefix}
+	for _, obj := range objects {
+		// Folder markers have nothing to download
+		if strings.HasSuffix(obj.Key, "/") {
+			continue
+		}
+		if s3ArchivedClasses[obj.StorageClass] {
+			preview.Archived++
+			continue
+		}
+
+		preview.Objects = append(preview.Objects, obj)
+		preview.TotalBytes += obj.Size
+		if obj.Size > preview.Largest {
+			preview.Largest = obj.Size
+		}
+	}
+
+	return preview, nil
+}
+
+// Download fetches the previewed objects into dir, see ObjectsClient.DownloadObjects
+func (h *S3ObjectsHandler) Download(ctx context.Context, preview *DownloadPreview, dir string, progress func(s3adapter.DownloadProgress)) *s3adapter.DownloadResult {
+	return h.client.DownloadObjects(ctx, preview.Objects, dir, s3adapter.DefaultDownloadConcurrency, 250*time.Millisecond, progress)
+}
+
+// DownloadFile downloads a single object to a local file, see ObjectsClient.DownloadFile
+func (h *S3ObjectsHandler) DownloadFile(ctx context.Context, key, path string, progress func(s3adapter.TransferProgress)) (*s3adapter.TransferResult, error) {
+	return h.client.DownloadFile(ctx, key, path, 250*time.Millisecond, progress)
+}
+
+// UploadFile uploads a local file as key, see ObjectsClient.UploadFile
+func (h *S3ObjectsHandler) UploadFile(ctx context.Context, path, key string, progress func(s3adapter.TransferProgress)) (*s3adapter.TransferResult, error) {
+	return h.client.UploadFile(ctx, path, key, 250*time.Millisecond, progress)
+}
+
+// DeleteObject deletes an object of the bucket
+func (h *S3ObjectsHandler) DeleteObject(ctx context.Context, key string) error {
+	return h.client.DeleteObject(ctx, key)
+}
+
+// parentPrefix returns the prefix one level up, e.g. "logs/" for "logs/2024/"
+func parentPrefix(prefix string) string {
+	parent := path.Dir(strings.TrimSuffix(prefix, "/"))
+	if parent == "." || parent == "/" {
+		return ""
+	}
+	return parent + "/"
+}
+
+// FormatBytes formats a byte count for display, e.g. "1.5 MB"
+func FormatBytes(bytes int64) string {
+	return formatBytes(bytes)
+}
+
+// S3ObjectResource implements Resource interface for S3 objects and folders
+type S3ObjectResource struct {
+	bucket string
+	key    string            // Full key, ending in "/" for folders
+	parent string            // Prefix being browsed
+	object *s3adapter.Object // Nil for folders
+	region string
+}
+
+func (r *S3ObjectResource) GetID() string { return r.key }
+
+func (r *S3ObjectResource) GetName() string {
+	if r.key == s3ParentID {
+		return "../"
+	}
+	return strings.TrimPrefix(r.key, r.parent)
+}
+
+func (r *S3ObjectResource) GetARN() string {
+	if r.key == s3ParentID {
+		return fmt.Sprintf("arn:aws:s3:::%s/%s", r.bucket, r.parent)
+	}
+	return fmt.Sprintf("arn:aws:s3:::%s/%s", r.bucket, r.key)
+}
+
+func (r *S3ObjectResource) GetType() string   { return "s3:objects" }
+func (r *S3ObjectResource) GetRegion() string { return r.region }
+
+func (r *S3ObjectResource) GetCreatedAt() time.Time {
+	if r.object != nil {
+		return r.object.LastModified
+	}
+	return time.Time{}
+}
+
+func (r *S3ObjectResource) GetTags() map[string]string {
+	return nil
+}
+
+func (r *S3ObjectResource) ToTableRow() []string {
+	if r.object == nil {
+		return []string{r.GetName(), "-", "-", "-"}
+	}
+
+	lastMod := "-"
+	if !r.object.LastModified.IsZero() {
+		lastMod = r.object.LastModified.Format("2006-01-02 15:04")
+	}
+
+	return []string{
+		r.GetName(),
+		formatBytes(r.object.Size),
+		r.object.StorageClass,
+		lastMod,
+	}
+}
+
+func (r *S3ObjectResource) ToDetailMap() map[string]interface{} {
+	if r.object == nil {
+		prefix := r.key
+		if r.key == s3ParentID {
+			prefix = r.parent
+		}
+		return map[string]interface{}{
+			"Bucket": r.bucket,
+			"Prefix": prefix,
+			"S3Uri":  fmt.Sprintf("s3://%s/%s", r.bucket, prefix),
+		}
+	}
+
+	details := map[string]interface{}{
+		"Bucket":       r.bucket,
+		"Key":          r.object.Key,
+		"S3Uri":        fmt.Sprintf("s3://%s/%s", r.bucket, r.object.Key),
+		"Size":         formatBytes(r.object.Size),
+		"StorageClass": r.object.StorageClass,
+		"ETag":         r.object.ETag,
+		"LastModified": r.object.LastModified.Format(time.RFC3339),
+	}
+	if s3ArchivedClasses[r.object.StorageClass] {
+		switch {
+		case r.object.Restoring:
+			details["Restore"] = "In progress"
+		case !r.object.RestoredUntil.IsZero():
+			details["Restore"] = fmt.Sprintf("Restored until %s", r.object.RestoredUntil.Format(time.RFC3339))
+		default:
+			details["Restore"] = "Not restored"
+		}
+	}
+	return details
+}
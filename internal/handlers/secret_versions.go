@@ -0,0 +1,297 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	smadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/secretsmanager"
+)
+
+// NavigateToSecretVersionsAction is returned by ExecuteAction to trigger navigation to the
+// version history of a secret
+type NavigateToSecretVersionsAction struct {
+	SecretID   string
+	SecretName string
+}
+
+func (a *NavigateToSecretVersionsAction) Error() string {
+	return fmt.Sprintf("navigate to versions of secret %s", a.SecretName)
+}
+
+func (a *NavigateToSecretVersionsAction) IsActionMsg() {}
+
+// ViewSecretVersionAction triggers the confirmation to view the value of a secret version
+type ViewSecretVersionAction struct {
+	SecretID  string
+	VersionID string
+	Stages    string
+}
+
+func (a *ViewSecretVersionAction) Error() string {
+	return fmt.Sprintf("view version %s of secret %s", a.VersionID, a.SecretID)
+}
+
+func (a *ViewSecretVersionAction) IsActionMsg() {}
+
+// DiffSecretVersionAction triggers the confirmation to diff a secret version against the
+// current one
+type DiffSecretVersionAction struct {
+	SecretID         string
+	VersionID        string
+	CurrentVersionID string
+}
+
+func (a *DiffSecretVersionAction) Error() string {
+	return fmt.Sprintf("diff version %s of secret %s against AWSCURRENT", a.VersionID, a.SecretID)
+}
+
+func (a *DiffSecretVersionAction) IsActionMsg() {}
+
+// PromoteSecretVersionAction triggers the confirmation to make an older secret version
+// current again
+type PromoteSecretVersionAction struct {
+	SecretID         string
+	VersionID        string
+	CurrentVersionID string
+}
+
+func (a *PromoteSecretVersionAction) Error() string {
+	return fmt.Sprintf("promote version %s of secret %s to AWSCURRENT", a.VersionID, a.SecretID)
+}
+
+func (a *PromoteSecretVersionAction) IsActionMsg() {}
+
+// SecretVersionsHandler lists the versions of a secret, newest first
+type SecretVersionsHandler struct {
+	BaseHandler
+	client   *smadapter.SecretsClient
+	region   string
+	secretID string
+}
+
+// NewSecretVersionsHandlerForSecret creates a new handler for the versions of a secret
+func NewSecretVersionsHandlerForSecret(smClient *secretsmanager.Client, region, secretID string) *SecretVersionsHandler {
+	return &SecretVersionsHandler{
+		client:   smadapter.NewSecretsClient(smClient),
+		region:   region,
+		secretID: secretID,
+	}
+}
+
+func (h *SecretVersionsHandler) ResourceType() string { return "secretsmanager:versions" }
+func (h *SecretVersionsHandler) ResourceName() string { return "Secret Versions" }
+func (h *SecretVersionsHandler) ResourceIcon() string { return "🔐" }
+func (h *SecretVersionsHandler) ShortcutKey() string  { return "secret-versions" }
+
+func (h *SecretVersionsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Version", Width: 38, Sortable: false},
+		{Title: "Stages", Width: 30, Sortable: true},
+		{Title: "Created", Width: 20, Sortable: true, SortType: SortDate},
+		{Title: "Last Accessed", Width: 14, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *SecretVersionsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	versions, err := h.client.ListVersions(ctx, h.secretID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", fmt.Sprintf("failed to list versions of secret %s", h.secretID), err)
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Created.After(versions[j].Created)
+	})
+
+	resources := make([]Resource, 0, len(versions))
+	for _, version := range versions {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			id := strings.ToLower(version.ID)
+			stages := strings.ToLower(strings.Join(version.Stages, " "))
+			if !strings.Contains(id, filter) && !strings.Contains(stages, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, &SecretVersionResource{
+			version:  version,
+			secretID: h.secretID,
+			region:   h.region,
+		})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *SecretVersionsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	versions, err := h.client.ListVersions(ctx, h.secretID)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get version %s", id), err)
+	}
+
+	for _, version := range versions {
+		if version.ID == id {
+			return &SecretVersionResource{version: version, secretID: h.secretID, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("version %s not found", id), nil)
+}
+
+func (h *SecretVersionsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe version %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *SecretVersionsHandler) Actions() []Action {
+	return []Action{
+		{Key: "v", Name: "view", Description: "View version value"},
+		{Key: "D", Name: "diff", Description: "Diff against AWSCURRENT"},
+		{Key: "P", Name: "promote", Description: "Make this version current", Dangerous: true, Mutating: true},
+	}
+}
+
+func (h *SecretVersionsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "view":
+		res, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		stages := strings.Join(res.(*SecretVersionResource).version.Stages, ", ")
+		if stages == "" {
+			stages = "no staging labels"
+		}
+		return &ViewSecretVersionAction{
+			SecretID:  h.secretID,
+			VersionID: resourceID,
+			Stages:    stages,
+		}
+	case "diff", "promote":
+		versions, err := h.client.ListVersions(ctx, h.secretID)
+		if err != nil {
+			return err
+		}
+
+		var current string
+		for _, version := range versions {
+			if version.HasStage("AWSCURRENT") {
+				current = version.ID
+			}
+		}
+		if current == "" {
+			return fmt.Errorf("secret %s has no AWSCURRENT version", h.secretID)
+		}
+		if current == resourceID {
+			return fmt.Errorf("version %s is already AWSCURRENT", resourceID)
+		}
+
+		if action == "diff" {
+			return &DiffSecretVersionAction{SecretID: h.secretID, VersionID: resourceID, CurrentVersionID: current}
+		}
+		return &PromoteSecretVersionAction{SecretID: h.secretID, VersionID: resourceID, CurrentVersionID: current}
+	default:
+		return ErrNotSupported
+	}
+}
+
+// GetVersionValue retrieves the value of a version for viewing
+func (h *SecretVersionsHandler) GetVersionValue(ctx context.Context, versionID string) (string, error) {
+	return h.client.GetSecretVersionValue(ctx, h.secretID, versionID)
+}
+
+// VersionValues retrieves the values of two versions to diff them. JSON secrets are
+// diffed key by key.
+func (h *SecretVersionsHandler) VersionValues(ctx context.Context, leftID, rightID string) (map[string]interface{}, map[string]interface{}, error) {
+	left, err := h.client.GetSecretVersionValue(ctx, h.secretID, leftID)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := h.client.GetSecretVersionValue(ctx, h.secretID, rightID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretValueMap(left), secretValueMap(right), nil
+}
+
+// PromoteVersion moves AWSCURRENT from the current version to another one
+func (h *SecretVersionsHandler) PromoteVersion(ctx context.Context, versionID, currentVersionID string) error {
+	if err := h.client.PromoteVersion(ctx, h.secretID, versionID, currentVersionID); err != nil {
+		return NewHandlerError("UPDATE_FAILED", fmt.Sprintf("failed to promote version %s", versionID), err)
+	}
+	return nil
+}
+
+// secretValueMap parses a JSON object secret, or wraps any other value
+func secretValueMap(value string) map[string]interface{} {
+	var object map[string]interface{}
+	if json.Unmarshal([]byte(value), &object) == nil && object != nil {
+		return object
+	}
+	return map[string]interface{}{"SecretString": value}
+}
+
+// SecretVersionResource implements Resource interface for secret versions
+type SecretVersionResource struct {
+	version  smadapter.SecretVersion
+	secretID string
+	region   string
+}
+
+func (r *SecretVersionResource) GetID() string              { return r.version.ID }
+func (r *SecretVersionResource) GetARN() string             { return "" }
+func (r *SecretVersionResource) GetName() string            { return r.version.ID }
+func (r *SecretVersionResource) GetType() string            { return "secretsmanager:versions" }
+func (r *SecretVersionResource) GetRegion() string          { return r.region }
+func (r *SecretVersionResource) GetCreatedAt() time.Time    { return r.version.Created }
+func (r *SecretVersionResource) GetTags() map[string]string { return nil }
+
+func (r *SecretVersionResource) ToTableRow() []string {
+	created := "-"
+	if !r.version.Created.IsZero() {
+		created = r.version.Created.UTC().Format("2006-01-02 15:04")
+	}
+
+	lastAccessed := "-"
+	if !r.version.LastAccessed.IsZero() {
+		lastAccessed = r.version.LastAccessed.UTC().Format("2006-01-02")
+	}
+
+	return []string{
+		r.version.ID,
+		orDash(strings.Join(r.version.Stages, ", ")),
+		created,
+		lastAccessed,
+	}
+}
+
+func (r *SecretVersionResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"Secret":    r.secretID,
+		"VersionId": r.version.ID,
+	}
+	if len(r.version.Stages) > 0 {
+		details["Stages"] = r.version.Stages
+	} else {
+		details["Stages"] = "None; unlabeled versions are removed by Secrets Manager"
+	}
+	if !r.version.Created.IsZero() {
+		details["Created"] = r.version.Created.Format(time.RFC3339)
+	}
+	if !r.version.LastAccessed.IsZero() {
+		details["LastAccessed"] = r.version.LastAccessed.Format(time.RFC3339)
+	}
+	return details
+}
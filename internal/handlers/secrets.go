@@ -4,26 +4,32 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 
+	lambdaadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/lambda"
 	smadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/secretsmanager"
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // SecretsHandler handles Secrets Manager resources
 type SecretsHandler struct {
 	BaseHandler
-	client *smadapter.SecretsClient
-	region string
+	client  *smadapter.SecretsClient
+	lambdas *lambdaadapter.FunctionsClient
+	region  string
 }
 
 // NewSecretsHandler creates a new secrets handler
-func NewSecretsHandler(smClient *secretsmanager.Client, region string) *SecretsHandler {
+func NewSecretsHandler(smClient *secretsmanager.Client, lambdaClient *lambda.Client, region string) *SecretsHandler {
 	return &SecretsHandler{
-		client: smadapter.NewSecretsClient(smClient),
-		region: region,
+		client:  smadapter.NewSecretsClient(smClient),
+		lambdas: lambdaadapter.NewFunctionsClient(lambdaClient),
+		region:  region,
 	}
 }
 
@@ -36,8 +42,8 @@ func (h *SecretsHandler) Columns() []ColumnDef {
 	return []ColumnDef{
 		{Title: "Name", Width: 40, Sortable: true},
 		{Title: "Rotation", Width: 10, Sortable: false},
-		{Title: "Last Changed", Width: 14, Sortable: true},
-		{Title: "Last Accessed", Width: 14, Sortable: true},
+		{Title: "Last Changed", Width: 14, Sortable: true, SortType: SortDate},
+		{Title: "Last Accessed", Width: 14, Sortable: true, SortType: SortDate},
 		{Title: "Description", Width: 35, Sortable: false},
 	}
 }
@@ -140,6 +146,12 @@ func (h *SecretsHandler) Describe(ctx context.Context, id string) (map[string]in
 		if secret.RotationLambdaARN != "" {
 			rotation["LambdaARN"] = secret.RotationLambdaARN
 		}
+		if secret.RotationSchedule != "" {
+			rotation["Schedule"] = secret.RotationSchedule
+		}
+		if !secret.NextRotationDate.IsZero() {
+			rotation["NextRotation"] = secret.NextRotationDate.Format(time.RFC3339)
+		}
 		details["Rotation"] = rotation
 	}
 
@@ -174,10 +186,13 @@ func (h *SecretsHandler) Describe(ctx context.Context, id string) (map[string]in
 func (h *SecretsHandler) Actions() []Action {
 	return []Action{
 		{Key: "v", Name: "view", Description: "View secret value"},
-		{Key: "e", Name: "edit", Description: "Edit secret value"},
-		{Key: "c", Name: "create", Description: "Create new secret"},
-		{Key: "x", Name: "delete", Description: "Delete secret"},
-		{Key: "r", Name: "rotation", Description: "View rotation configuration"},
+		{Key: "e", Name: "edit", Description: "Edit secret value", Mutating: true},
+		{Key: "c", Name: "create", Description: "Create new secret", Mutating: true},
+		{Key: "x", Name: "delete", Description: "Delete secret", Mutating: true, Severity: SeverityHigh},
+		{Key: "r", Name: "rotation", Description: "Configure rotation", Mutating: true},
+		{Key: "R", Name: "rotate-now", Description: "Rotate secret now", Dangerous: true, Mutating: true},
+		{Key: "H", Name: "versions", Description: "Version history"},
+		{Key: "E", Name: "expiry", Description: "Set expiry reminder"},
 	}
 }
 
@@ -195,11 +210,43 @@ func (h *SecretsHandler) ExecuteAction(ctx context.Context, action string, resou
 		}
 	case "create":
 		return &CreateSecretAction{}
+	case "rotation":
+		return h.configureRotationAction(ctx, resourceID)
+	case "rotate-now":
+		secret, err := h.client.GetSecret(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		if !secret.RotationEnabled || secret.RotationLambdaARN == "" {
+			return fmt.Errorf("rotation is not configured for %s; configure it with r first", resourceID)
+		}
+		return &RotateSecretNowAction{
+			SecretID:   resourceID,
+			SecretName: secret.Name,
+			LambdaARN:  secret.RotationLambdaARN,
+		}
+	case "versions":
+		return &NavigateToSecretVersionsAction{
+			SecretID:   resourceID,
+			SecretName: resourceID,
+		}
 	case "delete":
 		return &DeleteSecretAction{
 			SecretID:   resourceID,
 			SecretName: resourceID,
 		}
+	case "expiry":
+		res, err := h.Get(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return &SetReminderAction{
+			ResourceType: h.ResourceType(),
+			ResourceID:   resourceID,
+			Name:         res.GetName(),
+			ARN:          res.GetARN(),
+			Region:       h.region,
+		}
 	default:
 		return ErrNotSupported
 	}
@@ -284,6 +331,18 @@ func (h *SecretsHandler) GetSecretValueForEdit(ctx context.Context, secretID str
 	return h.client.GetSecretValue(ctx, secretID)
 }
 
+func (h *SecretsHandler) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	secret, err := h.client.GetSecret(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return secret.Tags, nil
+}
+
+func (h *SecretsHandler) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	return h.client.UpdateTags(ctx, id, set, remove)
+}
+
 // SecretResource implements Resource interface for Secrets Manager secrets
 type SecretResource struct {
 	secret smadapter.Secret
@@ -326,7 +385,7 @@ func (r *SecretResource) ToTableRow() []string {
 		rotation,
 		lastChanged,
 		lastAccessed,
-		truncateString(r.secret.Description, 35),
+		utils.Truncate(r.secret.Description, 35),
 	}
 }
 
@@ -341,6 +400,137 @@ func (r *SecretResource) ToDetailMap() map[string]interface{} {
 	}
 }
 
+// configureRotationAction collects a secret's rotation configuration and the Lambda
+// functions it can be rotated with
+func (h *SecretsHandler) configureRotationAction(ctx context.Context, secretID string) error {
+	secret, err := h.client.GetSecret(ctx, secretID)
+	if err != nil {
+		return err
+	}
+	functions, err := h.lambdas.ListFunctions(ctx)
+	if err != nil {
+		return err
+	}
+	if len(functions) == 0 {
+		return fmt.Errorf("no Lambda functions in %s to rotate %s with", h.region, secretID)
+	}
+
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].FunctionName < functions[j].FunctionName
+	})
+
+	candidates := make([]RotationLambdaCandidate, 0, len(functions))
+	for _, fn := range functions {
+		description := fn.Runtime
+		if description == "" {
+			description = fn.PackageType
+		}
+		if fn.Description != "" {
+			description += ", " + fn.Description
+		}
+		if fn.FunctionARN == secret.RotationLambdaARN {
+			description = "current, " + description
+		}
+		candidates = append(candidates, RotationLambdaCandidate{
+			Name:        fn.FunctionName,
+			ARN:         fn.FunctionARN,
+			Description: description,
+		})
+	}
+
+	return &ConfigureRotationAction{
+		SecretID:     secretID,
+		SecretName:   secret.Name,
+		Enabled:      secret.RotationEnabled,
+		LambdaARN:    secret.RotationLambdaARN,
+		Expression:   secret.RotationExpression,
+		Window:       secret.RotationWindow,
+		LastRotated:  secret.LastRotatedDate,
+		NextRotation: secret.NextRotationDate,
+		Candidates:   candidates,
+	}
+}
+
+// ConfigureRotation turns on rotation of a secret with a Lambda function and schedule
+// expression, keeping its current rotation window
+func (h *SecretsHandler) ConfigureRotation(ctx context.Context, action *ConfigureRotationAction, lambdaARN, expression string) error {
+	if err := ValidateRotationSchedule(expression); err != nil {
+		return err
+	}
+	if err := h.client.ConfigureRotation(ctx, action.SecretID, lambdaARN, expression, action.Window); err != nil {
+		return NewHandlerError("UPDATE_FAILED", fmt.Sprintf("failed to configure rotation of %s", action.SecretName), err)
+	}
+	return nil
+}
+
+// RotateNow starts a rotation of a secret with its configured Lambda function
+func (h *SecretsHandler) RotateNow(ctx context.Context, secretID string) error {
+	if err := h.client.RotateNow(ctx, secretID); err != nil {
+		return NewHandlerError("UPDATE_FAILED", fmt.Sprintf("failed to rotate %s", secretID), err)
+	}
+	return nil
+}
+
+// ValidateRotationSchedule checks that a schedule is a rate() or cron() expression, as
+// Secrets Manager accepts no other form
+func ValidateRotationSchedule(expression string) error {
+	if expression == "" {
+		return fmt.Errorf("a schedule expression is required")
+	}
+	if !strings.HasSuffix(expression, ")") ||
+		!(strings.HasPrefix(expression, "rate(") || strings.HasPrefix(expression, "cron(")) {
+		return fmt.Errorf("schedule must be rate(N days), rate(N hours) or cron(...), got %q", expression)
+	}
+	return nil
+}
+
+// RotationLambdaCandidate is a Lambda function a secret can be rotated with
+type RotationLambdaCandidate struct {
+	Name        string
+	ARN         string
+	Description string
+}
+
+// ConfigureRotationAction is returned by ExecuteAction to pick the rotation Lambda and
+// schedule of a secret
+type ConfigureRotationAction struct {
+	SecretID     string
+	SecretName   string
+	Enabled      bool
+	LambdaARN    string
+	Expression   string
+	Window       string
+	LastRotated  time.Time
+	NextRotation time.Time
+	Candidates   []RotationLambdaCandidate
+}
+
+func (a *ConfigureRotationAction) Error() string {
+	return fmt.Sprintf("configure rotation of secret %s", a.SecretName)
+}
+
+func (a *ConfigureRotationAction) IsActionMsg() {}
+
+// RotationConfigRequest is a rotation Lambda picked for a secret, waiting for its schedule
+// to be entered and confirmed
+type RotationConfigRequest struct {
+	Action    *ConfigureRotationAction
+	LambdaARN string
+}
+
+// RotateSecretNowAction triggers the confirmation to rotate a secret immediately
+type RotateSecretNowAction struct {
+	SecretID   string
+	SecretName string
+	LambdaARN  string
+}
+
+func (a *RotateSecretNowAction) Error() string {
+	return fmt.Sprintf("rotate secret %s now", a.SecretName)
+}
+
+func (a *RotateSecretNowAction) IsActionMsg() {}
+
 // ViewSecretAction is returned by ExecuteAction to trigger viewing a secret
 type ViewSecretAction struct {
 	SecretID   string
@@ -385,3 +575,18 @@ func (a *DeleteSecretAction) Error() string {
 }
 
 func (a *DeleteSecretAction) IsActionMsg() {}
+
+// SetReminderAction triggers the prompt for a local expiry reminder on a resource
+type SetReminderAction struct {
+	ResourceType string
+	ResourceID   string
+	Name         string
+	ARN          string
+	Region       string
+}
+
+func (a *SetReminderAction) Error() string {
+	return fmt.Sprintf("set expiry reminder on %s", a.Name)
+}
+
+func (a *SetReminderAction) IsActionMsg() {}
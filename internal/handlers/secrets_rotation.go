@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	lambdaadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/lambda"
+	smadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/secretsmanager"
+)
+
+// SecretsRotationHandler reports the rotation state of every secret, stalest first
+type SecretsRotationHandler struct {
+	BaseHandler
+	client  *smadapter.SecretsClient
+	lambdas *lambdaadapter.FunctionsClient
+	region  string
+}
+
+// NewSecretsRotationHandler creates a new secret rotation report handler
+func NewSecretsRotationHandler(smClient *secretsmanager.Client, lambdaClient *lambda.Client, region string) *SecretsRotationHandler {
+	return &SecretsRotationHandler{
+		client:  smadapter.NewSecretsClient(smClient),
+		lambdas: lambdaadapter.NewFunctionsClient(lambdaClient),
+		region:  region,
+	}
+}
+
+func (h *SecretsRotationHandler) ResourceType() string { return "secretsmanager:rotation" }
+func (h *SecretsRotationHandler) ResourceName() string { return "Secret Rotation" }
+func (h *SecretsRotationHandler) ResourceIcon() string { return "🔄" }
+func (h *SecretsRotationHandler) ShortcutKey() string  { return "secrets-rotation" }
+
+func (h *SecretsRotationHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 40, Sortable: true},
+		{Title: "Rotation", Width: 9, Sortable: true},
+		{Title: "Age", Width: 7, Sortable: true, SortType: SortNumber},
+		{Title: "Last Rotated", Width: 13, Sortable: true, SortType: SortDate},
+		{Title: "Next Rotation", Width: 13, Sortable: true, SortType: SortDate},
+		{Title: "Schedule", Width: 20, Sortable: false},
+		{Title: "Lambda", Width: 30, Sortable: true},
+		{Title: "Status", Width: 16, Sortable: true},
+	}
+}
+
+func (h *SecretsRotationHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	secrets, err := h.client.ListSecrets(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list secrets", err)
+	}
+
+	// Secrets often share a rotation function, so each one is only looked up once
+	lambdaStates := make(map[string]string)
+
+	rotations := make([]*SecretRotationResource, 0, len(secrets))
+	for _, secret := range secrets {
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(secret.Name)
+			if !strings.Contains(name, filter) {
+				continue
+			}
+		}
+
+		rotation := &SecretRotationResource{secret: secret, region: h.region}
+		if secret.RotationEnabled {
+			if secret.RotationLambdaARN != "" {
+				state, ok := lambdaStates[secret.RotationLambdaARN]
+				if !ok {
+					state = h.lambdaState(ctx, secret.RotationLambdaARN)
+					lambdaStates[secret.RotationLambdaARN] = state
+				}
+				rotation.lambdaState = state
+			}
+			rotation.pending, _ = h.client.RotationPending(ctx, secret.ARN)
+		}
+		rotations = append(rotations, rotation)
+	}
+
+	// Stalest first, so the secrets due for attention lead the report
+	sort.SliceStable(rotations, func(i, j int) bool {
+		return rotations[i].valueSince().Before(rotations[j].valueSince())
+	})
+
+	resources := make([]Resource, 0, len(rotations))
+	for _, rotation := range rotations {
+		resources = append(resources, rotation)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *SecretsRotationHandler) Get(ctx context.Context, id string) (Resource, error) {
+	secret, err := h.client.GetSecret(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get secret %s", id), err)
+	}
+
+	rotation := &SecretRotationResource{secret: *secret, region: h.region}
+	if secret.RotationEnabled {
+		if secret.RotationLambdaARN != "" {
+			rotation.lambdaState = h.lambdaState(ctx, secret.RotationLambdaARN)
+		}
+		rotation.pending, _ = h.client.RotationPending(ctx, secret.ARN)
+	}
+	return rotation, nil
+}
+
+func (h *SecretsRotationHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe rotation of %s", id), err)
+	}
+	return res.ToDetailMap(), nil
+}
+
+// lambdaState returns the state of a rotation function: Active when it can run, its Lambda
+// state otherwise, or missing when it no longer exists
+func (h *SecretsRotationHandler) lambdaState(ctx context.Context, arn string) string {
+	fn, err := h.lambdas.GetFunction(ctx, arn)
+	if err != nil {
+		var notFound *lambdatypes.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return "missing"
+		}
+		return "unknown"
+	}
+	if fn.State == "" {
+		return "Active"
+	}
+	return fn.State
+}
+
+// SecretRotationResource implements Resource interface for the rotation state of a secret
+type SecretRotationResource struct {
+	secret      smadapter.Secret
+	region      string
+	lambdaState string
+	pending     bool // A rotation left an AWSPENDING version behind
+}
+
+func (r *SecretRotationResource) GetID() string              { return r.secret.Name }
+func (r *SecretRotationResource) GetARN() string             { return r.secret.ARN }
+func (r *SecretRotationResource) GetName() string            { return r.secret.Name }
+func (r *SecretRotationResource) GetType() string            { return "secretsmanager:rotation" }
+func (r *SecretRotationResource) GetRegion() string          { return r.region }
+func (r *SecretRotationResource) GetCreatedAt() time.Time    { return r.secret.LastChangedDate }
+func (r *SecretRotationResource) GetTags() map[string]string { return r.secret.Tags }
+
+// valueSince returns when the secret's value was last rotated, or last changed if it was
+// never rotated
+func (r *SecretRotationResource) valueSince() time.Time {
+	if !r.secret.LastRotatedDate.IsZero() {
+		return r.secret.LastRotatedDate
+	}
+	return r.secret.LastChangedDate
+}
+
+// status sums up whether the secret's rotation needs attention
+func (r *SecretRotationResource) status() string {
+	switch {
+	case !r.secret.RotationEnabled:
+		return "not rotated"
+	case r.lambdaState == "missing":
+		return "lambda missing"
+	case r.lambdaState != "" && r.lambdaState != "Active":
+		return "lambda " + strings.ToLower(r.lambdaState)
+	case r.pending:
+		return "rotation failed"
+	case !r.secret.NextRotationDate.IsZero() && r.secret.NextRotationDate.Before(time.Now()):
+		return "overdue"
+	}
+	return "ok"
+}
+
+func (r *SecretRotationResource) ToTableRow() []string {
+	rotation := "Disabled"
+	if r.secret.RotationEnabled {
+		rotation = "Enabled"
+	}
+
+	age := "-"
+	if since := r.valueSince(); !since.IsZero() {
+		// Padded so ages sort by number
+		age = fmt.Sprintf("%5dd", int(time.Since(since).Hours()/24))
+	}
+
+	lastRotated := "Never"
+	if !r.secret.LastRotatedDate.IsZero() {
+		lastRotated = r.secret.LastRotatedDate.UTC().Format("2006-01-02")
+	}
+
+	nextRotation := "-"
+	if !r.secret.NextRotationDate.IsZero() {
+		nextRotation = r.secret.NextRotationDate.UTC().Format("2006-01-02")
+	}
+
+	lambdaName := "-"
+	if r.secret.RotationLambdaARN != "" {
+		lambdaName = r.secret.RotationLambdaARN[strings.LastIndex(r.secret.RotationLambdaARN, ":")+1:]
+	}
+
+	return []string{
+		r.secret.Name,
+		rotation,
+		age,
+		lastRotated,
+		nextRotation,
+		orDash(r.secret.RotationSchedule),
+		lambdaName,
+		r.status(),
+	}
+}
+
+func (r *SecretRotationResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"Name":            r.secret.Name,
+		"ARN":             r.secret.ARN,
+		"RotationEnabled": r.secret.RotationEnabled,
+		"Status":          r.status(),
+	}
+	if r.secret.RotationSchedule != "" {
+		details["Schedule"] = r.secret.RotationSchedule
+	}
+	if !r.secret.LastRotatedDate.IsZero() {
+		details["LastRotated"] = r.secret.LastRotatedDate.Format(time.RFC3339)
+	}
+	if !r.secret.LastChangedDate.IsZero() {
+		details["LastChanged"] = r.secret.LastChangedDate.Format(time.RFC3339)
+	}
+	if !r.secret.NextRotationDate.IsZero() {
+		details["NextRotation"] = r.secret.NextRotationDate.Format(time.RFC3339)
+	}
+	if r.secret.RotationLambdaARN != "" {
+		details["RotationLambda"] = map[string]interface{}{
+			"ARN":   r.secret.RotationLambdaARN,
+			"State": orDash(r.lambdaState),
+		}
+	}
+	if r.pending {
+		details["PendingVersion"] = "A version is still labeled AWSPENDING; the last rotation didn't finish"
+	}
+	return details
+}
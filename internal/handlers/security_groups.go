@@ -9,11 +9,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
 	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // SecurityGroupsHandler handles EC2 Security Group resources
 type SecurityGroupsHandler struct {
 	BaseHandler
+	ec2Tagging
 	client *ec2adapter.SecurityGroupsClient
 	region string
 }
@@ -21,8 +23,9 @@ type SecurityGroupsHandler struct {
 // NewSecurityGroupsHandler creates a new security groups handler
 func NewSecurityGroupsHandler(ec2Client *ec2.Client, region string) *SecurityGroupsHandler {
 	return &SecurityGroupsHandler{
-		client: ec2adapter.NewSecurityGroupsClient(ec2Client),
-		region: region,
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewSecurityGroupsClient(ec2Client),
+		region:     region,
 	}
 }
 
@@ -216,7 +219,7 @@ func (r *SecurityGroupResource) ToTableRow() []string {
 		r.sg.VpcID,
 		fmt.Sprintf("%d rules", len(r.sg.InboundRules)),
 		fmt.Sprintf("%d rules", len(r.sg.OutboundRules)),
-		truncateString(r.sg.Description, 30),
+		utils.Truncate(r.sg.Description, 30),
 	}
 }
 
@@ -231,10 +234,3 @@ func (r *SecurityGroupResource) ToDetailMap() map[string]interface{} {
 		"OutboundRules": len(r.sg.OutboundRules),
 	}
 }
-
-func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen-3] + "..."
-}
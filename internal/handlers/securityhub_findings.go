@@ -0,0 +1,541 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+
+	shadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/securityhub"
+)
+
+// Standards known by a short name in :securityhub standard=, matched against the
+// standard's ID
+var securityHubStandards = map[string]string{
+	"fsbp":    "aws-foundational-security-best-practices",
+	"cis":     "cis-aws-foundations-benchmark",
+	"pci":     "pci-dss",
+	"nist":    "nist-800-53",
+	"tagging": "aws-resource-tagging-standard",
+}
+
+// defaultWorkflowStatuses leaves suppressed and resolved findings out unless asked for
+var defaultWorkflowStatuses = []string{"NEW", "NOTIFIED"}
+
+// securityHubSeverityRank orders severities, most severe first
+var securityHubSeverityRank = map[string]int{
+	"CRITICAL":      0,
+	"HIGH":          1,
+	"MEDIUM":        2,
+	"LOW":           3,
+	"INFORMATIONAL": 4,
+}
+
+// SecurityHubFilters narrows the findings of :securityhub
+type SecurityHubFilters = shadapter.FindingFilters
+
+// ParseSecurityHubArgs reads the arguments of :securityhub: "controls" to group the
+// findings by control, and key=value filters with comma-separated values, severity=,
+// status= (compliance), workflow=, standard= and control=
+func ParseSecurityHubArgs(args []string) (SecurityHubFilters, bool, error) {
+	filters := SecurityHubFilters{WorkflowStatuses: defaultWorkflowStatuses}
+	byControl := false
+
+	for _, arg := range args {
+		if arg == "controls" {
+			byControl = true
+			continue
+		}
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || value == "" {
+			return filters, false, fmt.Errorf("unknown argument %q, filters are key=value", arg)
+		}
+		values := strings.Split(strings.ToUpper(value), ",")
+
+		switch key {
+		case "severity", "sev":
+			for _, v := range values {
+				if _, ok := securityHubSeverityRank[v]; !ok {
+					return filters, false, fmt.Errorf("unknown severity %q, use critical, high, medium, low or informational", strings.ToLower(v))
+				}
+			}
+			filters.Severities = values
+		case "status", "compliance":
+			for i, v := range values {
+				if v == "NA" {
+					values[i], v = "NOT_AVAILABLE", "NOT_AVAILABLE"
+				}
+				switch v {
+				case "FAILED", "WARNING", "PASSED", "NOT_AVAILABLE":
+				default:
+					return filters, false, fmt.Errorf("unknown compliance status %q, use failed, warning, passed or na", strings.ToLower(v))
+				}
+			}
+			filters.ComplianceStatuses = values
+		case "workflow":
+			if value == "all" {
+				filters.WorkflowStatuses = nil
+				continue
+			}
+			for _, v := range values {
+				switch v {
+				case "NEW", "NOTIFIED", "SUPPRESSED", "RESOLVED":
+				default:
+					return filters, false, fmt.Errorf("unknown workflow status %q, use new, notified, suppressed, resolved or all", strings.ToLower(v))
+				}
+			}
+			filters.WorkflowStatuses = values
+		case "standard":
+			filters.Standard = strings.ToLower(value)
+			if id, ok := securityHubStandards[filters.Standard]; ok {
+				filters.Standard = id
+			}
+		case "control":
+			filters.ControlID = strings.ToUpper(value)
+		default:
+			return filters, false, fmt.Errorf("unknown filter %q, use severity, status, workflow, standard or control", key)
+		}
+	}
+	return filters, byControl, nil
+}
+
+// DescribeSecurityHubFilters summarizes the filters for the breadcrumb
+func DescribeSecurityHubFilters(filters SecurityHubFilters) string {
+	var parts []string
+	if len(filters.Severities) > 0 {
+		parts = append(parts, strings.ToLower(strings.Join(filters.Severities, ",")))
+	}
+	if len(filters.ComplianceStatuses) > 0 {
+		parts = append(parts, strings.ToLower(strings.Join(filters.ComplianceStatuses, ",")))
+	}
+	if filters.Standard != "" {
+		parts = append(parts, filters.Standard)
+	}
+	if filters.ControlID != "" {
+		parts = append(parts, filters.ControlID)
+	}
+	if len(filters.WorkflowStatuses) == 0 {
+		parts = append(parts, "all workflows")
+	} else if strings.Join(filters.WorkflowStatuses, ",") != strings.Join(defaultWorkflowStatuses, ",") {
+		parts = append(parts, strings.ToLower(strings.Join(filters.WorkflowStatuses, ",")))
+	}
+	if len(parts) == 0 {
+		return "Open"
+	}
+	return strings.Join(parts, " ")
+}
+
+// UpdateFindingsWorkflowAction triggers the confirmation for setting the workflow status
+// of the marked findings
+type UpdateFindingsWorkflowAction struct {
+	Findings []shadapter.FindingRef
+	Titles   []string
+	Status   string // NEW, NOTIFIED, SUPPRESSED or RESOLVED
+}
+
+func (a *UpdateFindingsWorkflowAction) Error() string {
+	return fmt.Sprintf("set %d findings to %s", len(a.Findings), a.Status)
+}
+
+func (a *UpdateFindingsWorkflowAction) IsActionMsg() {}
+
+// NavigateToControlFindingsAction triggers navigation to the findings of a control
+type NavigateToControlFindingsAction struct {
+	Filters SecurityHubFilters
+}
+
+func (a *NavigateToControlFindingsAction) Error() string {
+	return fmt.Sprintf("navigate to findings of control %s", a.Filters.ControlID)
+}
+
+func (a *NavigateToControlFindingsAction) IsActionMsg() {}
+
+// SecurityHubFindingsHandler lists active Security Hub findings matching filters
+type SecurityHubFindingsHandler struct {
+	BaseHandler
+	client  *shadapter.FindingsClient
+	region  string
+	filters SecurityHubFilters
+
+	// Findings of the last list, keyed by ID, as GetFindings can't look one up cheaply
+	findings map[string]*shadapter.Finding
+}
+
+// NewSecurityHubFindingsHandler creates a new Security Hub findings handler
+func NewSecurityHubFindingsHandler(client *securityhub.Client, region string, filters SecurityHubFilters) *SecurityHubFindingsHandler {
+	return &SecurityHubFindingsHandler{
+		client:  shadapter.NewFindingsClient(client),
+		region:  region,
+		filters: filters,
+	}
+}
+
+func (h *SecurityHubFindingsHandler) ResourceType() string { return "securityhub:findings" }
+func (h *SecurityHubFindingsHandler) ResourceName() string { return "Security Hub Findings" }
+func (h *SecurityHubFindingsHandler) ResourceIcon() string { return "🛡" }
+func (h *SecurityHubFindingsHandler) ShortcutKey() string  { return "securityhub" }
+
+func (h *SecurityHubFindingsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Severity", Width: 13, Sortable: true},
+		{Title: "Title", Width: 50, Sortable: true},
+		{Title: "Control", Width: 16, Sortable: true},
+		{Title: "Compliance", Width: 13, Sortable: true},
+		{Title: "Workflow", Width: 11, Sortable: true},
+		{Title: "Resource", Width: 40, Sortable: true},
+		{Title: "Updated", Width: 19, Sortable: true, SortType: SortDate},
+	}
+}
+
+func (h *SecurityHubFindingsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	findings, truncated, err := h.client.ListFindings(ctx, h.filters, opts.MaxItems)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list Security Hub findings", err)
+	}
+
+	h.findings = make(map[string]*shadapter.Finding, len(findings))
+	resources := make([]Resource, 0, len(findings))
+	for i := range findings {
+		finding := &findings[i]
+		h.findings[finding.ID] = finding
+
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(finding.Title), filter) &&
+				!strings.Contains(strings.ToLower(finding.ControlID), filter) &&
+				!strings.Contains(strings.ToLower(finding.ResourceID), filter) {
+				continue
+			}
+		}
+		resources = append(resources, &SecurityHubFindingResource{finding: finding})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+		Truncated: truncated,
+	}, nil
+}
+
+func (h *SecurityHubFindingsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	finding, ok := h.findings[id]
+	if !ok {
+		return nil, NewHandlerError("GET_FAILED", "finding is no longer listed, refresh the list", nil)
+	}
+	return &SecurityHubFindingResource{finding: finding}, nil
+}
+
+func (h *SecurityHubFindingsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	finding, ok := h.findings[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", "finding is no longer listed, refresh the list", nil)
+	}
+	return (&SecurityHubFindingResource{finding: finding}).ToDetailMap(), nil
+}
+
+func (h *SecurityHubFindingsHandler) Actions() []Action {
+	return []Action{
+		{Key: "S", Name: "suppress", Description: "Suppress marked (space)", Mutating: true, Batch: true},
+		{Key: "R", Name: "resolve", Description: "Resolve marked (space)", Mutating: true, Batch: true},
+		{Key: "U", Name: "reopen", Description: "Set marked back to new (space)", Mutating: true, Batch: true},
+	}
+}
+
+func (h *SecurityHubFindingsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	return h.ExecuteBatchAction(ctx, action, []string{resourceID})
+}
+
+// ExecuteBatchAction prepares setting the workflow status of the marked findings
+func (h *SecurityHubFindingsHandler) ExecuteBatchAction(ctx context.Context, action string, resourceIDs []string) error {
+	status := ""
+	switch action {
+	case "suppress":
+		status = "SUPPRESSED"
+	case "resolve":
+		status = "RESOLVED"
+	case "reopen":
+		status = "NEW"
+	default:
+		return ErrNotSupported
+	}
+
+	update := &UpdateFindingsWorkflowAction{Status: status}
+	for _, id := range resourceIDs {
+		finding, ok := h.findings[id]
+		if !ok || finding.WorkflowStatus == status {
+			continue
+		}
+		update.Findings = append(update.Findings, finding.FindingRef)
+		update.Titles = append(update.Titles, finding.Title)
+	}
+	if len(update.Findings) == 0 {
+		return fmt.Errorf("the findings are already %s", strings.ToLower(status))
+	}
+	return update
+}
+
+// UpdateWorkflowStatus sets the workflow status of findings, returning the ones that
+// weren't updated
+func (h *SecurityHubFindingsHandler) UpdateWorkflowStatus(ctx context.Context, action *UpdateFindingsWorkflowAction, note string) ([]string, error) {
+	return h.client.UpdateWorkflowStatus(ctx, action.Findings, action.Status, note)
+}
+
+// SecurityHubFindingResource implements Resource for a Security Hub finding
+type SecurityHubFindingResource struct {
+	finding *shadapter.Finding
+}
+
+func (r *SecurityHubFindingResource) GetID() string              { return r.finding.ID }
+func (r *SecurityHubFindingResource) GetARN() string             { return r.finding.ID }
+func (r *SecurityHubFindingResource) GetName() string            { return r.finding.Title }
+func (r *SecurityHubFindingResource) GetType() string            { return "securityhub:findings" }
+func (r *SecurityHubFindingResource) GetRegion() string          { return r.finding.Region }
+func (r *SecurityHubFindingResource) GetCreatedAt() time.Time    { return r.finding.CreatedAt }
+func (r *SecurityHubFindingResource) GetTags() map[string]string { return nil }
+
+func (r *SecurityHubFindingResource) ToTableRow() []string {
+	return []string{
+		r.finding.Severity,
+		r.finding.Title,
+		orDash(r.finding.ControlID),
+		orDash(r.finding.ComplianceStatus),
+		r.finding.WorkflowStatus,
+		orDash(r.finding.ResourceID),
+		r.finding.UpdatedAt.Local().Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (r *SecurityHubFindingResource) ToDetailMap() map[string]interface{} {
+	f := r.finding
+	details := map[string]interface{}{
+		"Id":             f.ID,
+		"Title":          f.Title,
+		"Severity":       f.Severity,
+		"WorkflowStatus": f.WorkflowStatus,
+		"Product":        f.ProductName,
+		"GeneratorId":    f.GeneratorID,
+		"AccountId":      f.AccountID,
+		"Region":         f.Region,
+		"CreatedAt":      f.CreatedAt.Format(time.RFC3339),
+		"UpdatedAt":      f.UpdatedAt.Format(time.RFC3339),
+	}
+	if f.Description != "" {
+		details["Description"] = f.Description
+	}
+	if f.ComplianceStatus != "" {
+		details["ComplianceStatus"] = f.ComplianceStatus
+	}
+	if f.ControlID != "" {
+		details["Control"] = f.ControlID
+	}
+	if len(f.Standards) > 0 {
+		details["Standards"] = f.Standards
+	}
+	if f.ResourceID != "" {
+		details["Resource"] = map[string]interface{}{
+			"Type": f.ResourceType,
+			"Id":   f.ResourceID,
+		}
+	}
+	if f.Remediation != "" || f.RemediationURL != "" {
+		details["Remediation"] = map[string]interface{}{
+			"Recommendation": f.Remediation,
+			"Url":            f.RemediationURL,
+		}
+	}
+	return details
+}
+
+// SecurityHubControlsHandler groups the findings matching filters by security control
+type SecurityHubControlsHandler struct {
+	BaseHandler
+	client  *shadapter.FindingsClient
+	region  string
+	filters SecurityHubFilters
+
+	controls map[string]*SecurityHubControl
+}
+
+// SecurityHubControl is a security control with the findings of it that match the filters
+type SecurityHubControl struct {
+	ID        string
+	Title     string
+	Severity  string // The most severe of its findings
+	Findings  int
+	Failed    int
+	Resources int
+	Standards []string
+}
+
+// NewSecurityHubControlsHandler creates a handler grouping findings by control
+func NewSecurityHubControlsHandler(client *securityhub.Client, region string, filters SecurityHubFilters) *SecurityHubControlsHandler {
+	return &SecurityHubControlsHandler{
+		client:  shadapter.NewFindingsClient(client),
+		region:  region,
+		filters: filters,
+	}
+}
+
+func (h *SecurityHubControlsHandler) ResourceType() string { return "securityhub:controls" }
+func (h *SecurityHubControlsHandler) ResourceName() string { return "Security Hub Controls" }
+func (h *SecurityHubControlsHandler) ResourceIcon() string { return "🛡" }
+func (h *SecurityHubControlsHandler) ShortcutKey() string  { return "securityhub" }
+
+func (h *SecurityHubControlsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Control", Width: 16, Sortable: true},
+		{Title: "Severity", Width: 13, Sortable: true},
+		{Title: "Title", Width: 60, Sortable: true},
+		{Title: "Failed", Width: 8, Sortable: true},
+		{Title: "Findings", Width: 9, Sortable: true},
+		{Title: "Resources", Width: 10, Sortable: true},
+	}
+}
+
+// List groups the matching findings by control, most severe and most failed first.
+// Findings of other products than the security standards have no control and are left out.
+func (h *SecurityHubControlsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	findings, truncated, err := h.client.ListFindings(ctx, h.filters, opts.MaxItems)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list Security Hub findings", err)
+	}
+
+	h.controls = make(map[string]*SecurityHubControl)
+	resourcesByControl := make(map[string]map[string]bool)
+	for _, finding := range findings {
+		if finding.ControlID == "" {
+			continue
+		}
+		control, ok := h.controls[finding.ControlID]
+		if !ok {
+			control = &SecurityHubControl{
+				ID:       finding.ControlID,
+				Title:    strings.TrimSpace(strings.TrimPrefix(finding.Title, finding.ControlID)),
+				Severity: finding.Severity,
+			}
+			h.controls[finding.ControlID] = control
+			resourcesByControl[finding.ControlID] = make(map[string]bool)
+		}
+		control.Findings++
+		if finding.ComplianceStatus == "FAILED" {
+			control.Failed++
+		}
+		if securityHubSeverityRank[finding.Severity] < securityHubSeverityRank[control.Severity] {
+			control.Severity = finding.Severity
+		}
+		if finding.ResourceID != "" {
+			resourcesByControl[finding.ControlID][finding.ResourceID] = true
+		}
+		for _, standard := range finding.Standards {
+			if !slices.Contains(control.Standards, standard) {
+				control.Standards = append(control.Standards, standard)
+			}
+		}
+	}
+
+	controls := make([]*SecurityHubControl, 0, len(h.controls))
+	for id, control := range h.controls {
+		control.Resources = len(resourcesByControl[id])
+		controls = append(controls, control)
+	}
+	sort.Slice(controls, func(i, j int) bool {
+		ri, rj := securityHubSeverityRank[controls[i].Severity], securityHubSeverityRank[controls[j].Severity]
+		if ri != rj {
+			return ri < rj
+		}
+		if controls[i].Failed != controls[j].Failed {
+			return controls[i].Failed > controls[j].Failed
+		}
+		return controls[i].ID < controls[j].ID
+	})
+
+	resources := make([]Resource, 0, len(controls))
+	for _, control := range controls {
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(control.ID), filter) &&
+				!strings.Contains(strings.ToLower(control.Title), filter) {
+				continue
+			}
+		}
+		resources = append(resources, &SecurityHubControlResource{control: control, region: h.region})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+		Truncated: truncated,
+	}, nil
+}
+
+func (h *SecurityHubControlsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	control, ok := h.controls[id]
+	if !ok {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("control %s is no longer listed, refresh the list", id), nil)
+	}
+	return &SecurityHubControlResource{control: control, region: h.region}, nil
+}
+
+func (h *SecurityHubControlsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	control, ok := h.controls[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("control %s is no longer listed, refresh the list", id), nil)
+	}
+	return (&SecurityHubControlResource{control: control, region: h.region}).ToDetailMap(), nil
+}
+
+func (h *SecurityHubControlsHandler) Actions() []Action {
+	return []Action{
+		{Key: "f", Name: "findings", Description: "View the control's findings"},
+	}
+}
+
+func (h *SecurityHubControlsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "findings" {
+		return ErrNotSupported
+	}
+	filters := h.filters
+	filters.ControlID = resourceID
+	return &NavigateToControlFindingsAction{Filters: filters}
+}
+
+// SecurityHubControlResource implements Resource for a control of the grouped findings
+type SecurityHubControlResource struct {
+	control *SecurityHubControl
+	region  string
+}
+
+func (r *SecurityHubControlResource) GetID() string              { return r.control.ID }
+func (r *SecurityHubControlResource) GetARN() string             { return "" }
+func (r *SecurityHubControlResource) GetName() string            { return r.control.ID }
+func (r *SecurityHubControlResource) GetType() string            { return "securityhub:controls" }
+func (r *SecurityHubControlResource) GetRegion() string          { return r.region }
+func (r *SecurityHubControlResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *SecurityHubControlResource) GetTags() map[string]string { return nil }
+
+func (r *SecurityHubControlResource) ToTableRow() []string {
+	return []string{
+		r.control.ID,
+		r.control.Severity,
+		r.control.Title,
+		fmt.Sprintf("%d", r.control.Failed),
+		fmt.Sprintf("%d", r.control.Findings),
+		fmt.Sprintf("%d", r.control.Resources),
+	}
+}
+
+func (r *SecurityHubControlResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"Control":   r.control.ID,
+		"Title":     r.control.Title,
+		"Severity":  r.control.Severity,
+		"Findings":  r.control.Findings,
+		"Failed":    r.control.Failed,
+		"Resources": r.control.Resources,
+		"Standards": r.control.Standards,
+	}
+}
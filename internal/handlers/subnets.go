@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// SubnetsHandler handles VPC subnet resources
+type SubnetsHandler struct {
+	BaseHandler
+	ec2Tagging
+	client *ec2adapter.VPCsClient
+	region string
+	vpcID  string // Empty lists subnets of every VPC
+}
+
+// NewSubnetsHandler creates a new subnets handler
+func NewSubnetsHandler(ec2Client *ec2.Client, region string) *SubnetsHandler {
+	return NewSubnetsHandlerForVPC(ec2Client, region, "")
+}
+
+// NewSubnetsHandlerForVPC creates a new subnets handler for a specific VPC
+func NewSubnetsHandlerForVPC(ec2Client *ec2.Client, region, vpcID string) *SubnetsHandler {
+	return &SubnetsHandler{
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewVPCsClient(ec2Client),
+		region:     region,
+		vpcID:      vpcID,
+	}
+}
+
+func (h *SubnetsHandler) ResourceType() string { return "ec2:subnets" }
+func (h *SubnetsHandler) ResourceName() string { return "Subnets" }
+func (h *SubnetsHandler) ResourceIcon() string { return "🔲" }
+func (h *SubnetsHandler) ShortcutKey() string  { return "subnets" }
+
+func (h *SubnetsHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Name", Width: 30, Sortable: true},
+		{Title: "Subnet ID", Width: 26, Sortable: false},
+		{Title: "VPC ID", Width: 22, Sortable: true},
+		{Title: "CIDR", Width: 18, Sortable: false},
+		{Title: "AZ", Width: 12, Sortable: true},
+		{Title: "Free IPs", Width: 9, Sortable: true},
+		{Title: "Public IP", Width: 9, Sortable: false},
+	}
+}
+
+func (h *SubnetsHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	subnets, err := h.client.ListSubnets(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list subnets", err)
+	}
+
+	resources := make([]Resource, 0, len(subnets))
+	for _, subnet := range subnets {
+		resource := &SubnetResource{
+			subnet: subnet,
+			region: h.region,
+		}
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			name := strings.ToLower(subnet.Name)
+			id := strings.ToLower(subnet.SubnetID)
+			cidr := strings.ToLower(subnet.CidrBlock)
+			az := strings.ToLower(subnet.AvailabilityZone)
+			if !strings.Contains(name, filter) && !strings.Contains(id, filter) &&
+				!strings.Contains(cidr, filter) && !strings.Contains(az, filter) {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *SubnetsHandler) Get(ctx context.Context, id string) (Resource, error) {
+	subnets, err := h.client.ListSubnets(ctx, h.vpcID)
+	if err != nil {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("failed to get subnet %s", id), err)
+	}
+
+	for _, subnet := range subnets {
+		if subnet.SubnetID == id {
+			return &SubnetResource{subnet: subnet, region: h.region}, nil
+		}
+	}
+	return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("subnet %s not found", id), nil)
+}
+
+func (h *SubnetsHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("failed to describe subnet %s", id), err)
+	}
+	subnet := res.(*SubnetResource).subnet
+
+	details := res.ToDetailMap()
+
+	// The subnet uses its explicitly associated route table, or else the VPC's main one
+	tables, err := h.client.ListRouteTables(ctx, subnet.VpcID)
+	if err == nil {
+		var routeTable *ec2adapter.RouteTable
+		for i := range tables {
+			for _, subnetID := range tables[i].SubnetIDs {
+				if subnetID == subnet.SubnetID {
+					routeTable = &tables[i]
+				}
+			}
+		}
+		if routeTable == nil {
+			for i := range tables {
+				if tables[i].Main {
+					routeTable = &tables[i]
+				}
+			}
+		}
+		if routeTable != nil {
+			details["RouteTable"] = map[string]interface{}{
+				"RouteTableId": routeTable.RouteTableID,
+				"Main":         routeTable.Main,
+				"Routes":       routeSummaries(routeTable.Routes),
+			}
+		}
+	}
+
+	if len(subnet.Tags) > 0 {
+		details["Tags"] = subnet.Tags
+	}
+
+	return details, nil
+}
+
+// SubnetResource implements Resource interface for subnets
+type SubnetResource struct {
+	subnet ec2adapter.Subnet
+	region string
+}
+
+func (r *SubnetResource) GetID() string { return r.subnet.SubnetID }
+func (r *SubnetResource) GetName() string {
+	if r.subnet.Name != "" {
+		return r.subnet.Name
+	}
+	return r.subnet.SubnetID
+}
+func (r *SubnetResource) GetARN() string    { return r.subnet.SubnetARN }
+func (r *SubnetResource) GetType() string   { return "ec2:subnets" }
+func (r *SubnetResource) GetRegion() string { return r.region }
+func (r *SubnetResource) GetCreatedAt() time.Time {
+	return time.Time{} // Subnets don't have creation time
+}
+
+func (r *SubnetResource) GetTags() map[string]string {
+	return r.subnet.Tags
+}
+
+func (r *SubnetResource) ToTableRow() []string {
+	name := r.subnet.Name
+	if name == "" {
+		name = "-"
+	}
+
+	publicIP := "No"
+	if r.subnet.MapPublicIP {
+		publicIP = "Yes"
+	}
+
+	return []string{
+		name,
+		r.subnet.SubnetID,
+		r.subnet.VpcID,
+		r.subnet.CidrBlock,
+		r.subnet.AvailabilityZone,
+		fmt.Sprintf("%d", r.subnet.AvailableIPs),
+		publicIP,
+	}
+}
+
+func (r *SubnetResource) ToDetailMap() map[string]interface{} {
+	return map[string]interface{}{
+		"SubnetId":         r.subnet.SubnetID,
+		"Name":             r.subnet.Name,
+		"VpcId":            r.subnet.VpcID,
+		"CidrBlock":        r.subnet.CidrBlock,
+		"AvailabilityZone": r.subnet.AvailabilityZone,
+		"State":            r.subnet.State,
+		"AvailableIPs":     r.subnet.AvailableIPs,
+		"DefaultForAz":     r.subnet.IsDefault,
+		"MapPublicIP":      r.subnet.MapPublicIP,
+	}
+}
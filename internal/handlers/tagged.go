@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	rgt "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+
+	taggingadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/tagging"
+)
+
+// NavigateToTaggedAction is returned to open the list of a tagged resource and select it
+type NavigateToTaggedAction struct {
+	ResourceType string // Type of the list, e.g. ec2:instances
+	ResourceID   string // ID of the resource in that list
+}
+
+func (a *NavigateToTaggedAction) Error() string {
+	return fmt.Sprintf("navigate to %s %s", a.ResourceType, a.ResourceID)
+}
+
+func (a *NavigateToTaggedAction) IsActionMsg() {}
+
+// taggedType is a kind of resource the tagging API returns that has a list of its own
+type taggedType struct {
+	resourceType string                        // Type of the list, e.g. ec2:instances
+	id           func(arn, rest string) string // ID in the list, from the ARN or its resource after the kind
+}
+
+// taggedTypes maps the service and resource kind of an ARN, e.g. ec2:instance, to the
+// list of such resources. S3 bucket ARNs have no kind, only the bucket name.
+var taggedTypes = map[string]taggedType{
+	"ec2:instance":                      {"ec2:instances", taggedRest},
+	"ec2:vpc":                           {"ec2:vpcs", taggedRest},
+	"ec2:subnet":                        {"ec2:subnets", taggedRest},
+	"ec2:route-table":                   {"ec2:route-tables", taggedRest},
+	"ec2:natgateway":                    {"ec2:nat-gateways", taggedRest},
+	"ec2:internet-gateway":              {"ec2:internet-gateways", taggedRest},
+	"ec2:network-interface":             {"ec2:network-interfaces", taggedRest},
+	"ec2:security-group":                {"ec2:security-groups", taggedRest},
+	"ec2:launch-template":               {"ec2:launch-templates", taggedRest},
+	"autoscaling:autoScalingGroup":      {"autoscaling:groups", taggedASGName},
+	"elasticloadbalancing:loadbalancer": {"elb:loadbalancers", taggedARN},
+	"kms:key":                           {"kms:keys", taggedRest},
+	"secretsmanager:secret":             {"secretsmanager:secrets", taggedSecretName},
+	"rds:db":                            {"rds:instances", taggedRest},
+	"rds:snapshot":                      {"rds:snapshots", taggedRest},
+	"rds:pg":                            {"rds:parameter-groups", taggedRest},
+	"rds:og":                            {"rds:option-groups", taggedRest},
+	"ecs:cluster":                       {"ecs:clusters", taggedRest},
+	"lambda:function":                   {"lambda:functions", taggedFirst(":")},
+	"logs:log-group":                    {"logs:loggroups", taggedLogGroupName},
+	"s3:":                               {"s3:buckets", taggedRest},
+	"dynamodb:table":                    {"dynamodb:tables", taggedFirst("/")},
+	"mq:broker":                         {"mq:brokers", taggedLast(":")},
+	"imagebuilder:image-pipeline":       {"imagebuilder:pipelines", taggedARN},
+	"connect:instance":                  {"connect:instances", taggedFirst("/")},
+	"mobiletargeting:apps":              {"pinpoint:projects", taggedFirst("/")},
+	"apigateway:restapis":               {"apigateway:apis", taggedFirst("/")},
+	"apigateway:apis":                   {"apigateway:apis", taggedFirst("/")},
+	"cloudformation:stackset":           {"cloudformation:stacksets", taggedFirst(":")},
+	"iam:user":                          {"iam:users", taggedLast("/")},
+	"iam:role":                          {"iam:roles", taggedLast("/")},
+	"iam:policy":                        {"iam:policies", taggedARN},
+}
+
+func taggedRest(arn, rest string) string { return rest }
+func taggedARN(arn, rest string) string  { return arn }
+
+// taggedFirst returns the part of the resource before sep, as in function:name:alias
+func taggedFirst(sep string) func(arn, rest string) string {
+	return func(arn, rest string) string {
+		first, _, _ := strings.Cut(rest, sep)
+		return first
+	}
+}
+
+// taggedLast returns the part of the resource after the last sep, as in role/path/name
+func taggedLast(sep string) func(arn, rest string) string {
+	return func(arn, rest string) string {
+		return rest[strings.LastIndex(rest, sep)+1:]
+	}
+}
+
+// taggedASGName returns the name in uuid:autoScalingGroupName/name
+func taggedASGName(arn, rest string) string {
+	_, name, _ := strings.Cut(rest, "autoScalingGroupName/")
+	return name
+}
+
+// taggedSecretName drops the six random characters Secrets Manager adds to a secret's ARN
+func taggedSecretName(arn, rest string) string {
+	if len(rest) > 7 && rest[len(rest)-7] == '-' {
+		return rest[:len(rest)-7]
+	}
+	return rest
+}
+
+// taggedLogGroupName drops the :* some log group ARNs end with
+func taggedLogGroupName(arn, rest string) string {
+	return strings.TrimSuffix(rest, ":*")
+}
+
+// TaggedHandler lists the resources of every service carrying a tag, through the
+// Resource Groups Tagging API
+type TaggedHandler struct {
+	BaseHandler
+	client   *taggingadapter.ResourcesClient
+	registry *Registry // Lists the resources open in, for their icons and names
+	region   string
+	filters  []taggingadapter.TagFilter
+
+	// Resources of the last list, keyed by ARN
+	resources map[string]*TaggedResource
+}
+
+// NewTaggedHandler creates a handler for the resources passing every tag filter
+func NewTaggedHandler(client *rgt.Client, registry *Registry, region string, filters []taggingadapter.TagFilter) *TaggedHandler {
+	return &TaggedHandler{
+		client:    taggingadapter.NewResourcesClient(client),
+		registry:  registry,
+		region:    region,
+		filters:   filters,
+		resources: make(map[string]*TaggedResource),
+	}
+}
+
+func (h *TaggedHandler) ResourceType() string { return "tagging:resources" }
+func (h *TaggedHandler) ResourceName() string { return "Tagged" }
+func (h *TaggedHandler) ResourceIcon() string { return "🏷" }
+func (h *TaggedHandler) ShortcutKey() string  { return "tagged" }
+
+func (h *TaggedHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Type", Width: 24, Sortable: true},
+		{Title: "Name", Width: 36, Sortable: true},
+		{Title: "Resource", Width: 40, Sortable: true, Priority: 1},
+		{Title: "Tags", Width: 6, Sortable: true, SortType: SortNumber, Priority: 2},
+	}
+}
+
+func (h *TaggedHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	tagged, truncated, err := h.client.GetResources(ctx, h.filters, opts.MaxItems)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list tagged resources", err)
+	}
+
+	h.resources = make(map[string]*TaggedResource, len(tagged))
+	resources := make([]Resource, 0, len(tagged))
+	for _, t := range tagged {
+		res := h.newResource(t)
+		h.resources[res.GetID()] = res
+
+		// Apply filter if specified
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(strings.Join(res.ToTableRow(), " ")), filter) {
+				continue
+			}
+		}
+		resources = append(resources, res)
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+		Truncated: truncated,
+	}, nil
+}
+
+// newResource works out from its ARN what a tagged resource is and which list has it
+func (h *TaggedHandler) newResource(t taggingadapter.TaggedResource) *TaggedResource {
+	res := &TaggedResource{tagged: t, region: h.region, icon: h.ResourceIcon()}
+
+	// arn:partition:service:region:account:resource, the resource starting with its kind
+	parts := strings.SplitN(t.ARN, ":", 6)
+	if len(parts) < 6 {
+		res.typeName = "-"
+		res.resourceID = t.ARN
+		return res
+	}
+	res.service = parts[2]
+	if parts[3] != "" {
+		res.region = parts[3]
+	}
+	rest := strings.TrimPrefix(parts[5], "/")
+	if i := strings.IndexAny(rest, "/:"); i >= 0 {
+		res.kind, rest = rest[:i], rest[i+1:]
+	}
+	res.resourceID = rest
+	res.typeName = strings.TrimSpace(res.service + " " + res.kind)
+
+	kind, ok := taggedTypes[res.service+":"+res.kind]
+	if !ok {
+		return res
+	}
+	handler, ok := h.registry.Get(kind.resourceType)
+	if !ok {
+		return res
+	}
+	res.listType = kind.resourceType
+	res.resourceID = kind.id(t.ARN, rest)
+	res.typeName = handler.ResourceName()
+	res.icon = handler.ResourceIcon()
+	return res
+}
+
+func (h *TaggedHandler) Get(ctx context.Context, id string) (Resource, error) {
+	res, ok := h.resources[id]
+	if !ok {
+		return nil, NewHandlerError("NOT_FOUND", fmt.Sprintf("no tagged resource %s", id), nil)
+	}
+	return res, nil
+}
+
+func (h *TaggedHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	res, ok := h.resources[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("no tagged resource %s", id), nil)
+	}
+	return res.ToDetailMap(), nil
+}
+
+func (h *TaggedHandler) Actions() []Action {
+	return []Action{
+		{Key: "g", Name: "goto", Description: "Go to the resource"},
+	}
+}
+
+func (h *TaggedHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	res, ok := h.resources[resourceID]
+	if !ok {
+		return NewHandlerError("NOT_FOUND", fmt.Sprintf("no tagged resource %s", resourceID), nil)
+	}
+	switch action {
+	case "goto":
+		if res.listType == "" {
+			return NewHandlerError("NOT_SUPPORTED", fmt.Sprintf("%s resources have no list to go to", res.typeName), nil)
+		}
+		return &NavigateToTaggedAction{ResourceType: res.listType, ResourceID: res.resourceID}
+	}
+	return ErrNotSupported
+}
+
+// TaggedResource is a resource of any service found by its tags
+type TaggedResource struct {
+	tagged     taggingadapter.TaggedResource
+	region     string
+	service    string // From the ARN, e.g. ec2
+	kind       string // From the ARN, e.g. instance
+	typeName   string // Name of its list, or the service and kind without one
+	icon       string
+	listType   string // Type of the list it opens in, empty without one
+	resourceID string // ID in that list, or the resource part of the ARN
+}
+
+func (r *TaggedResource) GetID() string   { return r.tagged.ARN }
+func (r *TaggedResource) GetARN() string  { return r.tagged.ARN }
+func (r *TaggedResource) GetType() string { return "tagging:resources" }
+
+func (r *TaggedResource) GetName() string {
+	if name := r.tagged.Tags["Name"]; name != "" {
+		return name
+	}
+	return r.resourceID
+}
+
+func (r *TaggedResource) GetRegion() string          { return r.region }
+func (r *TaggedResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *TaggedResource) GetTags() map[string]string { return r.tagged.Tags }
+
+func (r *TaggedResource) ToTableRow() []string {
+	return []string{
+		r.icon + " " + r.typeName,
+		r.GetName(),
+		r.resourceID,
+		fmt.Sprintf("%d", len(r.tagged.Tags)),
+	}
+}
+
+func (r *TaggedResource) ToDetailMap() map[string]interface{} {
+	keys := make([]string, 0, len(r.tagged.Tags))
+	for k := range r.tagged.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tags := make([]string, len(keys))
+	for i, k := range keys {
+		tags[i] = k + "=" + r.tagged.Tags[k]
+	}
+
+	return map[string]interface{}{
+		"TaggedResource": map[string]interface{}{
+			"ARN":      r.tagged.ARN,
+			"Service":  orDash(r.service),
+			"Type":     r.typeName,
+			"Resource": r.resourceID,
+			"Region":   r.region,
+			"List":     orDash(r.listType),
+		},
+		"Tags": tags,
+	}
+}
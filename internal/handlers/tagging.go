@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+)
+
+// Limits AWS services share for tags
+const (
+	MaxTagsPerResource = 50
+	maxTagKeyLength    = 128
+	maxTagValueLength  = 256
+)
+
+// Taggable is implemented by handlers whose resources can be tagged. T opens the tag
+// editor on the selected resource, unless the handler uses T for an action of its own.
+type Taggable interface {
+	// ResourceTags reads the current tags of a resource
+	ResourceTags(ctx context.Context, id string) (map[string]string, error)
+	// UpdateTags adds or changes the tags in set and removes the keys in remove
+	UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error
+}
+
+// EditTagsAction opens the tag editor on a resource
+type EditTagsAction struct {
+	ResourceID   string
+	ResourceName string
+}
+
+func (a *EditTagsAction) Error() string {
+	return fmt.Sprintf("edit tags of %s", a.ResourceName)
+}
+
+func (a *EditTagsAction) IsActionMsg() {}
+
+// TagChanges are the tags an edit adds or changes and the keys it removes
+type TagChanges struct {
+	Set    map[string]string
+	Remove []string
+}
+
+// DiffTags compares a resource's tags before and after an edit
+func DiffTags(before, after map[string]string) TagChanges {
+	changes := TagChanges{Set: make(map[string]string)}
+	for key, value := range after {
+		if old, ok := before[key]; !ok || old != value {
+			changes.Set[key] = value
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changes.Remove = append(changes.Remove, key)
+		}
+	}
+	sort.Strings(changes.Remove)
+	return changes
+}
+
+// Empty reports whether the edit changes nothing
+func (c TagChanges) Empty() bool {
+	return len(c.Set) == 0 && len(c.Remove) == 0
+}
+
+// Summary describes the changes for the footer, e.g. "2 set, 1 removed"
+func (c TagChanges) Summary() string {
+	var parts []string
+	if len(c.Set) > 0 {
+		parts = append(parts, fmt.Sprintf("%d set", len(c.Set)))
+	}
+	if len(c.Remove) > 0 {
+		parts = append(parts, fmt.Sprintf("%d removed", len(c.Remove)))
+	}
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ValidateTags checks tags against the limits AWS services share. Keys starting with
+// aws: are reserved and can't be set, but ones already on the resource can be kept.
+func ValidateTags(tags, before map[string]string) error {
+	if len(tags) > MaxTagsPerResource {
+		return fmt.Errorf("a resource can have at most %d tags", MaxTagsPerResource)
+	}
+	for key, value := range tags {
+		switch {
+		case strings.TrimSpace(key) == "":
+			return fmt.Errorf("tag keys can't be empty")
+		case len(key) > maxTagKeyLength:
+			return fmt.Errorf("tag key %q is longer than %d characters", key, maxTagKeyLength)
+		case len(value) > maxTagValueLength:
+			return fmt.Errorf("value of %q is longer than %d characters", key, maxTagValueLength)
+		}
+		if strings.HasPrefix(strings.ToLower(key), "aws:") {
+			if old, ok := before[key]; !ok || old != value {
+				return fmt.Errorf("tag keys starting with aws: are reserved")
+			}
+		}
+	}
+	for key := range before {
+		if _, ok := tags[key]; !ok && strings.HasPrefix(strings.ToLower(key), "aws:") {
+			return fmt.Errorf("tag %s is managed by AWS and can't be removed", key)
+		}
+	}
+	return nil
+}
+
+// ec2Tagging makes the resources of an EC2 handler taggable by their IDs, which EC2's tag
+// APIs take for every resource type
+type ec2Tagging struct {
+	tags *ec2adapter.TagsClient
+}
+
+func newEC2Tagging(client *ec2.Client) ec2Tagging {
+	return ec2Tagging{tags: ec2adapter.NewTagsClient(client)}
+}
+
+func (t ec2Tagging) ResourceTags(ctx context.Context, id string) (map[string]string, error) {
+	return t.tags.Tags(ctx, id)
+}
+
+func (t ec2Tagging) UpdateTags(ctx context.Context, id string, set map[string]string, remove []string) error {
+	return t.tags.UpdateTags(ctx, id, set, remove)
+}
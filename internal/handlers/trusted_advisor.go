@@ -0,0 +1,515 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/aws/support"
+)
+
+// Trusted Advisor categories by the short name :advisor takes
+var advisorCategories = map[string]string{
+	"cost":        "cost_optimizing",
+	"security":    "security",
+	"fault":       "fault_tolerance",
+	"performance": "performance",
+	"limits":      "service_limits",
+}
+
+// advisorCategoryNames are shown in place of the API's category IDs
+var advisorCategoryNames = map[string]string{
+	"cost_optimizing":        "Cost",
+	"security":               "Security",
+	"fault_tolerance":        "Fault Tolerance",
+	"performance":            "Performance",
+	"service_limits":         "Service Limits",
+	"operational_excellence": "Operations",
+}
+
+// advisorStatusRank orders check statuses, the ones needing action first
+var advisorStatusRank = map[string]int{
+	"error":         0,
+	"warning":       1,
+	"ok":            2,
+	"not_available": 3,
+}
+
+// advisorResourceColumns maps metadata columns of flagged resources to the handler that
+// lists the resource, by shortcut. The first column a check has is used.
+var advisorResourceColumns = []struct {
+	column   string
+	shortcut string
+}{
+	{"instance id", "ec2"},
+	{"security group id", "sg"},
+	{"bucket name", "s3"},
+	{"db instance name", "rds"},
+	{"db instance", "rds"},
+	{"iam user", "users"},
+	{"user name", "users"},
+	{"function name", "lambda"},
+	{"function arn", "lambda"},
+	{"auto scaling group name", "asg"},
+	{"vpc id", "vpc"},
+	{"table name", "dynamodb"},
+}
+
+// ParseAdvisorCategory reads the category argument of :advisor, empty for all of them
+func ParseAdvisorCategory(arg string) (string, error) {
+	if arg == "" {
+		return "", nil
+	}
+	category, ok := advisorCategories[strings.ToLower(arg)]
+	if !ok {
+		return "", fmt.Errorf("unknown category %q, use cost, security, fault, performance or limits", arg)
+	}
+	return category, nil
+}
+
+// advisorError explains the error of accounts whose support plan has no Trusted Advisor API
+func advisorError(err error) error {
+	var apiErr *support.APIError
+	if errors.As(err, &apiErr) && apiErr.SubscriptionRequired() {
+		return fmt.Errorf("the Trusted Advisor API needs a Business, Enterprise On-Ramp or Enterprise support plan")
+	}
+	return err
+}
+
+// NavigateToAdvisorFlaggedAction triggers navigation to the resources a check flags
+type NavigateToAdvisorFlaggedAction struct {
+	Check support.Check
+}
+
+func (a *NavigateToAdvisorFlaggedAction) Error() string {
+	return fmt.Sprintf("navigate to resources flagged by %s", a.Check.Name)
+}
+
+func (a *NavigateToAdvisorFlaggedAction) IsActionMsg() {}
+
+// NavigateToAdvisorResourceAction triggers navigation to a flagged resource in the view
+// of its type, in its region
+type NavigateToAdvisorResourceAction struct {
+	Shortcut string
+	ID       string
+	Region   string
+}
+
+func (a *NavigateToAdvisorResourceAction) Error() string {
+	return fmt.Sprintf("navigate to %s %s", a.Shortcut, a.ID)
+}
+
+func (a *NavigateToAdvisorResourceAction) IsActionMsg() {}
+
+// TrustedAdvisorChecksHandler lists the Trusted Advisor checks with their status
+type TrustedAdvisorChecksHandler struct {
+	BaseHandler
+	client   *support.AdvisorClient
+	category string // Empty for every category
+
+	checks map[string]*support.Check
+}
+
+// NewTrustedAdvisorChecksHandler creates a handler for the checks of a category, or all
+// of them when it is empty
+func NewTrustedAdvisorChecksHandler(client *support.Client, category string) *TrustedAdvisorChecksHandler {
+	return &TrustedAdvisorChecksHandler{
+		client:   support.NewAdvisorClient(client),
+		category: category,
+	}
+}
+
+func (h *TrustedAdvisorChecksHandler) ResourceType() string { return "advisor:checks" }
+func (h *TrustedAdvisorChecksHandler) ResourceName() string { return "Trusted Advisor" }
+func (h *TrustedAdvisorChecksHandler) ResourceIcon() string { return "✅" }
+func (h *TrustedAdvisorChecksHandler) ShortcutKey() string  { return "advisor" }
+
+// CategoryName is the category the checks are limited to, for the breadcrumb
+func (h *TrustedAdvisorChecksHandler) CategoryName() string {
+	return advisorCategoryName(h.category)
+}
+
+func (h *TrustedAdvisorChecksHandler) Columns() []ColumnDef {
+	return []ColumnDef{
+		{Title: "Status", Width: 14, Sortable: true},
+		{Title: "Category", Width: 16, Sortable: true},
+		{Title: "Check", Width: 55, Sortable: true},
+		{Title: "Flagged", Width: 9, Sortable: true},
+		{Title: "Savings/mo", Width: 12, Sortable: true},
+		{Title: "Checked", Width: 19, Sortable: true},
+	}
+}
+
+// List lists the checks needing action first, then by category and name
+func (h *TrustedAdvisorChecksHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	checks, err := h.client.ListChecks(ctx)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to list Trusted Advisor checks", advisorError(err))
+	}
+
+	sort.SliceStable(checks, func(i, j int) bool {
+		ri, rj := advisorStatusRank[checks[i].Status], advisorStatusRank[checks[j].Status]
+		if ri != rj {
+			return ri < rj
+		}
+		if checks[i].Category != checks[j].Category {
+			return checks[i].Category < checks[j].Category
+		}
+		return checks[i].Name < checks[j].Name
+	})
+
+	h.checks = make(map[string]*support.Check, len(checks))
+	resources := make([]Resource, 0, len(checks))
+	for i := range checks {
+		check := &checks[i]
+		if h.category != "" && check.Category != h.category {
+			continue
+		}
+		h.checks[check.ID] = check
+
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(check.Name), filter) &&
+				!strings.Contains(strings.ToLower(check.Category), filter) {
+				continue
+			}
+		}
+		resources = append(resources, &TrustedAdvisorCheckResource{check: check})
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *TrustedAdvisorChecksHandler) Get(ctx context.Context, id string) (Resource, error) {
+	check, ok := h.checks[id]
+	if !ok {
+		return nil, NewHandlerError("GET_FAILED", fmt.Sprintf("check %s is not listed, refresh the list", id), nil)
+	}
+	return &TrustedAdvisorCheckResource{check: check}, nil
+}
+
+func (h *TrustedAdvisorChecksHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	check, ok := h.checks[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", fmt.Sprintf("check %s is not listed, refresh the list", id), nil)
+	}
+	return (&TrustedAdvisorCheckResource{check: check}).ToDetailMap(), nil
+}
+
+func (h *TrustedAdvisorChecksHandler) Actions() []Action {
+	return []Action{
+		{Key: "f", Name: "flagged", Description: "View flagged resources"},
+	}
+}
+
+func (h *TrustedAdvisorChecksHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "flagged" {
+		return ErrNotSupported
+	}
+	check, ok := h.checks[resourceID]
+	if !ok {
+		return fmt.Errorf("check is not listed, refresh the list")
+	}
+	if check.Flagged == 0 {
+		return fmt.Errorf("%s flags no resources", check.Name)
+	}
+	return &NavigateToAdvisorFlaggedAction{Check: *check}
+}
+
+// TrustedAdvisorCheckResource implements Resource for a Trusted Advisor check
+type TrustedAdvisorCheckResource struct {
+	check *support.Check
+}
+
+func (r *TrustedAdvisorCheckResource) GetID() string              { return r.check.ID }
+func (r *TrustedAdvisorCheckResource) GetARN() string             { return "" }
+func (r *TrustedAdvisorCheckResource) GetName() string            { return r.check.Name }
+func (r *TrustedAdvisorCheckResource) GetType() string            { return "advisor:checks" }
+func (r *TrustedAdvisorCheckResource) GetRegion() string          { return "global" }
+func (r *TrustedAdvisorCheckResource) GetCreatedAt() time.Time    { return r.check.CheckedAt }
+func (r *TrustedAdvisorCheckResource) GetTags() map[string]string { return nil }
+
+func (r *TrustedAdvisorCheckResource) ToTableRow() []string {
+	savings := "-"
+	if r.check.Savings > 0 {
+		savings = fmt.Sprintf("$%.2f", r.check.Savings)
+	}
+	checked := "-"
+	if !r.check.CheckedAt.IsZero() {
+		checked = r.check.CheckedAt.Local().Format("2006-01-02 15:04:05")
+	}
+	return []string{
+		strings.ReplaceAll(r.check.Status, "_", " "),
+		advisorCategoryName(r.check.Category),
+		r.check.Name,
+		fmt.Sprintf("%d", r.check.Flagged),
+		savings,
+		checked,
+	}
+}
+
+func (r *TrustedAdvisorCheckResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"Id":                 r.check.ID,
+		"Name":               r.check.Name,
+		"Category":           advisorCategoryName(r.check.Category),
+		"Status":             r.check.Status,
+		"ResourcesProcessed": r.check.Processed,
+		"ResourcesFlagged":   r.check.Flagged,
+		"Description":        advisorPlainText(r.check.Description),
+	}
+	if r.check.Suppressed > 0 {
+		details["ResourcesSuppressed"] = r.check.Suppressed
+	}
+	if r.check.Savings > 0 {
+		details["EstimatedMonthlySavings"] = fmt.Sprintf("$%.2f", r.check.Savings)
+	}
+	if !r.check.CheckedAt.IsZero() {
+		details["CheckedAt"] = r.check.CheckedAt.Format(time.RFC3339)
+	}
+	return details
+}
+
+func advisorCategoryName(category string) string {
+	if name, ok := advisorCategoryNames[category]; ok {
+		return name
+	}
+	return category
+}
+
+// advisorPlainText strips the HTML check descriptions are written in
+func advisorPlainText(html string) string {
+	replacer := strings.NewReplacer("<br>", "\n", "<br/>", "\n", "<br />", "\n", "</p>", "\n", "<li>", "\n- ")
+	text := replacer.Replace(html)
+	var sb strings.Builder
+	inTag := false
+	for _, r := range text {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			sb.WriteRune(r)
+		}
+	}
+	lines := strings.Split(sb.String(), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+// TrustedAdvisorFlaggedHandler lists the resources a check flags, with the check's columns
+type TrustedAdvisorFlaggedHandler struct {
+	BaseHandler
+	client *support.AdvisorClient
+	check  support.Check
+
+	resources map[string]*support.FlaggedResource
+}
+
+// NewTrustedAdvisorFlaggedHandler creates a handler for the resources a check flags
+func NewTrustedAdvisorFlaggedHandler(client *support.Client, check support.Check) *TrustedAdvisorFlaggedHandler {
+	return &TrustedAdvisorFlaggedHandler{
+		client: support.NewAdvisorClient(client),
+		check:  check,
+	}
+}
+
+func (h *TrustedAdvisorFlaggedHandler) ResourceType() string { return "advisor:flagged" }
+func (h *TrustedAdvisorFlaggedHandler) ResourceName() string { return "Flagged Resources" }
+func (h *TrustedAdvisorFlaggedHandler) ResourceIcon() string { return "✅" }
+func (h *TrustedAdvisorFlaggedHandler) ShortcutKey() string  { return "advisor-flagged" }
+
+// Columns are the status and region, then the check's own metadata columns
+func (h *TrustedAdvisorFlaggedHandler) Columns() []ColumnDef {
+	columns := []ColumnDef{
+		{Title: "Status", Width: 8, Sortable: true},
+		{Title: "Region", Width: 14, Sortable: true},
+	}
+	for _, i := range h.metadataColumns() {
+		columns = append(columns, ColumnDef{Title: h.check.Metadata[i], Width: 24, Sortable: true})
+	}
+	return columns
+}
+
+// metadataColumns picks the check's metadata columns shown in the table, leaving out the
+// status and region already shown
+func (h *TrustedAdvisorFlaggedHandler) metadataColumns() []int {
+	const maxColumns = 5
+	var indexes []int
+	for i, name := range h.check.Metadata {
+		switch strings.ToLower(name) {
+		case "status", "region", "region/az":
+			continue
+		}
+		indexes = append(indexes, i)
+		if len(indexes) == maxColumns {
+			break
+		}
+	}
+	return indexes
+}
+
+func (h *TrustedAdvisorFlaggedHandler) List(ctx context.Context, opts ListOptions) (*ListResult, error) {
+	flagged, err := h.client.FlaggedResources(ctx, h.check.ID)
+	if err != nil {
+		return nil, NewHandlerError("LIST_FAILED", "failed to get flagged resources", advisorError(err))
+	}
+
+	h.resources = make(map[string]*support.FlaggedResource, len(flagged))
+	resources := make([]Resource, 0, len(flagged))
+	for i := range flagged {
+		resource := &flagged[i]
+		h.resources[resource.ID] = resource
+
+		if opts.Filter != "" {
+			filter := strings.ToLower(opts.Filter)
+			if !strings.Contains(strings.ToLower(strings.Join(resource.Metadata, " ")), filter) {
+				continue
+			}
+		}
+		resources = append(resources, h.newResource(resource))
+	}
+
+	return &ListResult{
+		Resources: resources,
+		NextToken: "",
+	}, nil
+}
+
+func (h *TrustedAdvisorFlaggedHandler) newResource(resource *support.FlaggedResource) *TrustedAdvisorFlaggedResource {
+	return &TrustedAdvisorFlaggedResource{
+		resource: resource,
+		columns:  h.check.Metadata,
+		shown:    h.metadataColumns(),
+	}
+}
+
+func (h *TrustedAdvisorFlaggedHandler) Get(ctx context.Context, id string) (Resource, error) {
+	resource, ok := h.resources[id]
+	if !ok {
+		return nil, NewHandlerError("GET_FAILED", "resource is no longer flagged, refresh the list", nil)
+	}
+	return h.newResource(resource), nil
+}
+
+func (h *TrustedAdvisorFlaggedHandler) Describe(ctx context.Context, id string) (map[string]interface{}, error) {
+	resource, ok := h.resources[id]
+	if !ok {
+		return nil, NewHandlerError("DESCRIBE_FAILED", "resource is no longer flagged, refresh the list", nil)
+	}
+	details := h.newResource(resource).ToDetailMap()
+	details["Check"] = h.check.Name
+	return details, nil
+}
+
+func (h *TrustedAdvisorFlaggedHandler) Actions() []Action {
+	return []Action{
+		{Key: "v", Name: "resource", Description: "Go to the resource"},
+	}
+}
+
+// ExecuteAction goes to a flagged resource in the view of its type, for checks whose
+// columns name a resource a view lists
+func (h *TrustedAdvisorFlaggedHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	if action != "resource" {
+		return ErrNotSupported
+	}
+	resource, ok := h.resources[resourceID]
+	if !ok {
+		return fmt.Errorf("resource is no longer flagged, refresh the list")
+	}
+
+	for _, candidate := range advisorResourceColumns {
+		for i, name := range h.check.Metadata {
+			if strings.ToLower(name) != candidate.column || i >= len(resource.Metadata) || resource.Metadata[i] == "" {
+				continue
+			}
+			id := resource.Metadata[i]
+			if candidate.column == "function arn" {
+				// arn:aws:lambda:region:account:function:name[:version]
+				if parts := strings.Split(id, ":"); len(parts) >= 7 {
+					id = parts[6]
+				}
+			}
+			return &NavigateToAdvisorResourceAction{
+				Shortcut: candidate.shortcut,
+				ID:       id,
+				Region:   resource.Region,
+			}
+		}
+	}
+	return fmt.Errorf("no view lists the resources of %s", h.check.Name)
+}
+
+// TrustedAdvisorFlaggedResource implements Resource for a resource a check flags
+type TrustedAdvisorFlaggedResource struct {
+	resource *support.FlaggedResource
+	columns  []string // The check's metadata column names
+	shown    []int    // Indexes of the columns shown in the table
+}
+
+func (r *TrustedAdvisorFlaggedResource) GetID() string  { return r.resource.ID }
+func (r *TrustedAdvisorFlaggedResource) GetARN() string { return "" }
+
+func (r *TrustedAdvisorFlaggedResource) GetName() string {
+	for _, i := range r.shown {
+		if i < len(r.resource.Metadata) && r.resource.Metadata[i] != "" {
+			return r.resource.Metadata[i]
+		}
+	}
+	return r.resource.ID
+}
+
+func (r *TrustedAdvisorFlaggedResource) GetType() string { return "advisor:flagged" }
+
+func (r *TrustedAdvisorFlaggedResource) GetRegion() string {
+	if r.resource.Region == "" {
+		return "global"
+	}
+	return r.resource.Region
+}
+
+func (r *TrustedAdvisorFlaggedResource) GetCreatedAt() time.Time    { return time.Time{} }
+func (r *TrustedAdvisorFlaggedResource) GetTags() map[string]string { return nil }
+
+func (r *TrustedAdvisorFlaggedResource) ToTableRow() []string {
+	row := []string{r.resource.Status, orDash(r.resource.Region)}
+	for _, i := range r.shown {
+		value := ""
+		if i < len(r.resource.Metadata) {
+			value = r.resource.Metadata[i]
+		}
+		row = append(row, orDash(value))
+	}
+	return row
+}
+
+func (r *TrustedAdvisorFlaggedResource) ToDetailMap() map[string]interface{} {
+	details := map[string]interface{}{
+		"Status": r.resource.Status,
+		"Region": orDash(r.resource.Region),
+	}
+	if r.resource.Suppressed {
+		details["Suppressed"] = true
+	}
+	values := make(map[string]interface{}, len(r.columns))
+	for i, name := range r.columns {
+		if i < len(r.resource.Metadata) && r.resource.Metadata[i] != "" {
+			values[name] = r.resource.Metadata[i]
+		}
+	}
+	details["Details"] = values
+	return details
+}
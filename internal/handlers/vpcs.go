@@ -11,9 +11,22 @@ import (
 	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
 )
 
+// NavigateToVPCResourcesAction triggers navigation to one kind of child resource of a VPC
+type NavigateToVPCResourcesAction struct {
+	VpcID string
+	Kind  string // Shortcut of the child handler, e.g. subnets
+}
+
+func (a *NavigateToVPCResourcesAction) Error() string {
+	return fmt.Sprintf("navigate to %s of %s", a.Kind, a.VpcID)
+}
+
+func (a *NavigateToVPCResourcesAction) IsActionMsg() {}
+
 // VPCsHandler handles VPC resources
 type VPCsHandler struct {
 	BaseHandler
+	ec2Tagging
 	client *ec2adapter.VPCsClient
 	region string
 }
@@ -21,8 +34,9 @@ type VPCsHandler struct {
 // NewVPCsHandler creates a new VPCs handler
 func NewVPCsHandler(ec2Client *ec2.Client, region string) *VPCsHandler {
 	return &VPCsHandler{
-		client: ec2adapter.NewVPCsClient(ec2Client),
-		region: region,
+		ec2Tagging: newEC2Tagging(ec2Client),
+		client:     ec2adapter.NewVPCsClient(ec2Client),
+		region:     region,
 	}
 }
 
@@ -142,8 +156,35 @@ func (h *VPCsHandler) Describe(ctx context.Context, id string) (map[string]inter
 func (h *VPCsHandler) Actions() []Action {
 	return []Action{
 		{Key: "s", Name: "subnets", Description: "View subnets"},
-		{Key: "r", Name: "routes", Description: "View route tables"},
-		{Key: "n", Name: "nacls", Description: "View NACLs"},
+		{Key: "R", Name: "route-tables", Description: "View route tables"},
+		{Key: "N", Name: "nat", Description: "View NAT gateways"},
+		{Key: "I", Name: "igw", Description: "View internet gateways"},
+		{Key: "e", Name: "eni", Description: "View network interfaces"},
+	}
+}
+
+func (h *VPCsHandler) ExecuteAction(ctx context.Context, action string, resourceID string) error {
+	switch action {
+	case "subnets", "route-tables", "nat", "igw", "eni":
+		return &NavigateToVPCResourcesAction{VpcID: resourceID, Kind: action}
+	}
+
+	return ErrNotSupported
+}
+
+// NewVPCResourcesHandler creates the handler for one kind of child resource, scoped to a VPC
+func NewVPCResourcesHandler(ec2Client *ec2.Client, region string, action *NavigateToVPCResourcesAction) ResourceHandler {
+	switch action.Kind {
+	case "route-tables":
+		return NewRouteTablesHandlerForVPC(ec2Client, region, action.VpcID)
+	case "nat":
+		return NewNatGatewaysHandlerForVPC(ec2Client, region, action.VpcID)
+	case "igw":
+		return NewInternetGatewaysHandlerForVPC(ec2Client, region, action.VpcID)
+	case "eni":
+		return NewNetworkInterfacesHandlerForVPC(ec2Client, region, action.VpcID)
+	default:
+		return NewSubnetsHandlerForVPC(ec2Client, region, action.VpcID)
 	}
 }
 
@@ -153,7 +194,7 @@ type VPCResource struct {
 	region string
 }
 
-func (r *VPCResource) GetID() string   { return r.vpc.VpcID }
+func (r *VPCResource) GetID() string { return r.vpc.VpcID }
 func (r *VPCResource) GetName() string {
 	if r.vpc.Name != "" {
 		return r.vpc.Name
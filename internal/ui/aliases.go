@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// macro is an alias running its commands in turn
+type macro struct {
+	name  string
+	steps []string // Commands still to run
+
+	// A command switched profile or region, the rest run once the switch is done
+	waiting bool
+}
+
+// executeCommand runs a command typed after the colon: an alias of config.yaml, which
+// takes precedence, or a built-in command. A command of its own stops an alias that is
+// waiting on a switch.
+func (a *App) executeCommand(input string) (tea.Model, tea.Cmd) {
+	a.macro = nil
+	if fields := strings.Fields(input); len(fields) > 0 {
+		if _, ok := a.aliases[fields[0]]; ok {
+			return a.runAlias(fields[0], fields[1:])
+		}
+	}
+	return a.executeBuiltin(input)
+}
+
+// runAlias starts the commands of an alias. Words after the alias's name are added to
+// its last command, so :prod payments can end in a search.
+func (a *App) runAlias(name string, args []string) (tea.Model, tea.Cmd) {
+	steps := a.aliases.Steps(name)
+	if len(args) > 0 {
+		steps[len(steps)-1] += " " + strings.Join(args, " ")
+	}
+	a.macro = &macro{name: name, steps: steps}
+	return a, a.continueMacro()
+}
+
+// continueMacro runs the running alias's commands until one switches profile or region,
+// whose clients the next commands need
+func (a *App) continueMacro() tea.Cmd {
+	m := a.macro
+	var cmds []tea.Cmd
+	for len(m.steps) > 0 {
+		step := m.steps[0]
+		m.steps = m.steps[1:]
+		_, cmd := a.executeBuiltin(step)
+		cmds = append(cmds, cmd)
+
+		if a.macro != m {
+			return tea.Batch(cmds...) // Stopped by the command
+		}
+		if switchesClients(step) && len(m.steps) > 0 {
+			m.waiting = true
+			return tea.Batch(cmds...)
+		}
+	}
+	a.macro = nil
+	return tea.Batch(cmds...)
+}
+
+// resumeMacro carries on with the running alias once the switch it waited on is done
+func (a *App) resumeMacro(err error) tea.Cmd {
+	if a.macro == nil || !a.macro.waiting {
+		return nil
+	}
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf(":%s stopped: %v", a.macro.name, err), true)
+		a.macro = nil
+		return nil
+	}
+	a.macro.waiting = false
+	return a.continueMacro()
+}
+
+// switchesClients reports whether a command switches profile or region in the background
+func switchesClients(command string) bool {
+	fields := strings.Fields(command)
+	if len(fields) < 2 {
+		return false // Without an argument the selector opens instead
+	}
+	return fields[0] == "profile" || fields[0] == "region"
+}
+
+// aliasesText lists the aliases of config.yaml for :aliases
+func (a *App) aliasesText() string {
+	if len(a.aliases) == 0 {
+		return "No aliases, define them under aliases: in config.yaml, e.g.\n\n" +
+			"aliases:\n  prod:\n    - profile prod-admin\n    - region us-east-1\n    - ecs\n"
+	}
+
+	var sb strings.Builder
+	for _, name := range a.aliases.Names() {
+		fmt.Fprintf(&sb, ":%s\n", name)
+		for _, step := range a.aliases.Steps(name) {
+			fmt.Fprintf(&sb, "  :%s\n", step)
+		}
+	}
+	return sb.String()
+}
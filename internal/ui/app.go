@@ -1,19 +1,31 @@
 package ui
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	awsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws"
+	configadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/configservice"
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+	ibadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/imagebuilder"
+	logsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/logs"
+	rdsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/rds"
+	s3adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/s3"
 	"github.com/aaw-tui/aws-tui/internal/adapters/config"
 	"github.com/aaw-tui/aws-tui/internal/app"
 	"github.com/aaw-tui/aws-tui/internal/handlers"
@@ -23,6 +35,7 @@ import (
 	"github.com/aaw-tui/aws-tui/internal/ui/styles"
 	"github.com/aaw-tui/aws-tui/internal/ui/views"
 	"github.com/aaw-tui/aws-tui/internal/utils"
+	"github.com/aaw-tui/aws-tui/internal/version"
 )
 
 // AppState represents the current application state
@@ -67,6 +80,41 @@ type App struct {
 	// Bookmarks
 	bookmarkStore    *config.BookmarkStore
 	bookmarkSelector *components.BookmarkSelector
+	pendingBookmark  *config.Bookmark // Opened once the switch to its profile is done
+
+	// Resources recently described or acted on
+	recentStore *config.RecentStore
+
+	// Account of each profile, shown in the profile selector
+	accountStore      *config.AccountStore
+	resolvingAccounts bool
+
+	// Set once an operation failed on expired credentials, until L logs in again
+	expired *expiredCredentials
+
+	// When the credentials expire, for the header's countdown
+	credentialsExpiry time.Time
+	sessionTicking    bool
+
+	// View to open once AWS is first initialized, from the command line
+	launch *app.Launch
+
+	// Aliases of config.yaml, nil if they didn't validate, and the one running
+	aliases app.Aliases
+	macro   *macro
+
+	// Local expiry reminders
+	reminderStore *config.ReminderStore
+
+	// Lambda test events
+	testEventStore  *config.TestEventStore
+	testEventPicker *components.TestEventPicker
+
+	// Set while an S3 download or upload runs in the background
+	s3Transferring bool
+
+	// Bucket configuration open in the JSON editor
+	bucketConfig *handlers.EditBucketConfigAction
 
 	// UI Components
 	header       *components.Header
@@ -79,12 +127,89 @@ type App struct {
 	// Input components for modes
 	commandInput textinput.Model
 
+	// Command history, recalled with up and down and searched with ctrl+r
+	historyRecall *components.HistoryRecall
+
 	// Secret editing
-	secretEditor  *components.SecretEditor
-	secretCreator *components.SecretCreator
-	confirmDialog *components.ConfirmDialog
-	infoDialog    *components.InfoDialog
-	pendingAction interface{}
+	secretEditor   *components.SecretEditor
+	secretCreator  *components.SecretCreator
+	confirmDialog  *components.ConfirmDialog
+	infoDialog     *components.InfoDialog
+	diffView       *components.DiffView
+	errorPanel     *components.ErrorPanel
+	policyPicker   *components.PolicyPicker
+	restoreWizard  *components.RestoreWizard
+	capacityEditor *components.CapacityEditor
+	tagEditor      *components.TagEditor
+	itemEditor     *components.ItemEditor
+	objectRestore  *components.RestoreObjectForm
+	logTail        *views.LogTailView
+	dashboard      *views.DashboardView
+	commandOutput  *views.CommandOutputView
+	changelog      *views.ChangelogView
+	pendingAction  interface{}
+
+	// Releases fetched by the update check or :changelog, nil until then
+	releases []version.Release
+
+	// MFA codes asked for by credential providers, the first one is being answered
+	mfaPrompts chan *mfaPrompt
+	mfaQueue   []*mfaPrompt
+	mfaDialog  *components.ConfirmDialog
+
+	// Read-only mode, blocking mutating actions
+	readOnly bool
+
+	// Time-boxed period of changes allowed with :elevate, nil when not elevated, and the
+	// log elevations are recorded in
+	elevation *elevation
+	auditLog  *config.AuditLog
+
+	// Role waiting for its session policy to be picked, and the read-only mode to go back
+	// to once a read-only session policy no longer applies
+	pendingAssumeRole  *handlers.AssumeRoleAction
+	roleReadOnly       bool
+	readOnlyBeforeRole bool
+
+	// Profile switch in progress, the current context stays in use until it completes
+	profileSwitch *profileSwitch
+
+	// List the operation whose result is being handled ran on, see forList
+	resultList *views.ResourceListView
+
+	// Cancels the :jq query in flight; querySeq drops the results of cancelled ones
+	cancelQuery context.CancelFunc
+	querySeq    int
+
+	// Actions at or above this severity ask for the resource name to be typed
+	typedConfirmSeverity handlers.Severity
+
+	// Resource marked as the source of the next Reachability Analyzer path
+	reachabilitySource *handlers.ReachabilityEndpointAction
+
+	// Target group waiting for the target to register to be picked
+	pendingRegisterTarget *handlers.RegisterTargetAction
+
+	// Auto Scaling group waiting for the instance to move to standby to be picked
+	pendingStandby *handlers.EnterStandbyAction
+
+	// Resource waiting for the open_with command to open it with to be picked
+	pendingOpenWith handlers.Resource
+
+	// IAM group waiting for the user to add to be picked
+	pendingAddToGroup *handlers.AddUserToGroupAction
+
+	// Secret waiting for its rotation Lambda to be picked
+	pendingRotation *handlers.ConfigureRotationAction
+
+	// RDS connection waiting for its secret, then how to connect, to be picked
+	pendingDBConnect *dbConnect
+
+	// Identity and pinned counts of the home screen
+	home homeState
+
+	// Second resource list while the content area is split, nil otherwise
+	split *split
 
 	// Theme and keys
 	theme styles.Theme
@@ -104,12 +229,8 @@ type App struct {
 // NewApp creates a new application instance
 func NewApp(cfg *app.Config) (*App, error) {
 	// Load theme from config, fallback to default if not found
-	theme, err := styles.LoadTheme(cfg.Theme, cfg.ConfigDir)
-	if err != nil {
-		// Theme not found, use default (error is non-fatal)
-		theme = styles.DefaultTheme()
-	}
-	keyMap := keys.DefaultKeyMap()
+	theme, themeErr := loadTheme(cfg, cfg.Theme)
+	keyMap, keysErr := keys.DefaultKeyMap().Apply(cfg.Keybindings.Global, cfg.Keybindings.List, cfg.Keybindings.Actions)
 
 	// Initialize command input
 	commandInput := textinput.New()
@@ -117,10 +238,26 @@ func NewApp(cfg *app.Config) (*App, error) {
 	commandInput.Prompt = ":"
 	commandInput.CharLimit = 200
 
+	// Initialize command history
+	commandHistory := config.NewCommandHistory(cfg.HistoryPath())
+	_ = commandHistory.Load() // Ignore error on initial load
+
 	// Initialize bookmark store
 	bookmarkStore := config.NewBookmarkStore()
 	_ = bookmarkStore.Load() // Ignore error on initial load
 
+	// Initialize the recent resources
+	recentStore := config.NewRecentStore(cfg.RecentPath())
+	_ = recentStore.Load() // Ignore error on initial load
+
+	// Initialize the accounts of profiles
+	accountStore := config.NewAccountStore(cfg.AccountsPath())
+	_ = accountStore.Load() // Ignore error on initial load
+
+	// Initialize reminder store
+	reminderStore := config.NewReminderStore()
+	_ = reminderStore.Load() // Ignore error on initial load
+
 	a := &App{
 		config:           cfg,
 		state:            StateHome,
@@ -129,7 +266,10 @@ func NewApp(cfg *app.Config) (*App, error) {
 		profileLoader:    config.NewProfileLoader(),
 		registry:         handlers.NewRegistry(),
 		bookmarkStore:    bookmarkStore,
-		bookmarkSelector: components.NewBookmarkSelector(theme, bookmarkStore),
+		bookmarkSelector: components.NewBookmarkSelector(theme, bookmarkStore, recentStore),
+		recentStore:      recentStore,
+		accountStore:     accountStore,
+		reminderStore:    reminderStore,
 		theme:            theme,
 		keys:             keyMap,
 		header:           components.NewHeader(theme),
@@ -139,24 +279,205 @@ func NewApp(cfg *app.Config) (*App, error) {
 		resourceList:     views.NewResourceListView(theme),
 		autocomplete:     components.NewAutocomplete(),
 		commandInput:     commandInput,
+		historyRecall:    components.NewHistoryRecall(commandHistory),
 		secretEditor:     components.NewSecretEditor(theme),
 		secretCreator:    components.NewSecretCreator(theme),
 		confirmDialog:    components.NewConfirmDialog(theme),
 		infoDialog:       components.NewInfoDialog(theme),
+		diffView:         components.NewDiffView(theme),
+		policyPicker:     components.NewPolicyPicker(theme),
+		testEventPicker:  components.NewTestEventPicker(theme),
+		testEventStore:   config.NewTestEventStore(cfg.SharedTestEventsDir),
+		restoreWizard:    components.NewRestoreWizard(theme),
+		capacityEditor:   components.NewCapacityEditor(theme),
+		tagEditor:        components.NewTagEditor(theme),
+		errorPanel:       components.NewErrorPanel(theme),
+		itemEditor:       components.NewItemEditor(theme),
+		objectRestore:    components.NewRestoreObjectForm(theme),
+		logTail:          views.NewLogTailView(theme),
+		dashboard:        views.NewDashboardView(theme),
+		commandOutput:    views.NewCommandOutputView(theme),
+		changelog:        views.NewChangelogView(theme),
+		mfaPrompts:       make(chan *mfaPrompt),
+		mfaDialog:        components.NewConfirmDialog(theme),
+		auditLog:         config.NewAuditLog(cfg.AuditLogPath()),
 	}
+	a.clientMgr.SetMFATokenFunc(a.promptMFA)
+	a.clientMgr.SetRequestTimeout(cfg.RequestTimeout())
+	a.clientMgr.SetCallRecorder(a.recordCall)
 
 	// Load regions (static)
 	a.regions = a.profileLoader.ListRegions()
 
+	a.setReadOnly(cfg.ReadOnly || cfg.RequireElevation)
+	a.header.SetElevationRequired(cfg.RequireElevation)
+
+	a.typedConfirmSeverity = handlers.SeverityHigh
+	if severity, ok := handlers.ParseSeverity(cfg.TypedConfirmation); ok {
+		a.typedConfirmSeverity = severity
+	} else if cfg.TypedConfirmation == "off" {
+		a.typedConfirmSeverity = handlers.SeverityCritical + 1
+	}
+	a.configureList(a.resourceList)
+	if keysErr != nil {
+		a.footer.SetMessage(fmt.Sprintf("Keybindings: %v, using the defaults", keysErr), true)
+	}
+	if themeErr != nil {
+		// Non-fatal, the default theme is used
+		a.footer.SetMessage(fmt.Sprintf("Theme: %v, using the default", themeErr), true)
+	}
+	if err := cfg.Aliases.Validate(); err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Aliases: %v, none are available", err), true)
+	} else {
+		a.aliases = cfg.Aliases
+		a.autocomplete.AddCommands(cfg.Aliases.Names()...)
+	}
+
+	if err := utils.ConfigureTimeDisplay(cfg.TimeZone, cfg.TimeLocale); err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Config: %v", err), true)
+	}
+	utils.SetRelativeTimes(cfg.Timestamps == "relative")
+	if err := components.SetClipboardMode(cfg.Clipboard); err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Config: %v", err), true)
+	}
+
 	return a, nil
 }
 
+// handlerActions returns the actions of the list's handler with the keys config.yaml
+// gives them, warning when those can't be applied
+func (a *App) handlerActions() []handlers.Action {
+	actions, err := a.resourceList.Actions()
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Keybindings: %v", err), true)
+	}
+	return actions
+}
+
+// tagFilterHint returns the tag filter of the list on screen, for the footer
+func (a *App) tagFilterHint() string {
+	if a.state != StateResourceList {
+		return ""
+	}
+	return a.resourceList.TagFilterExpression()
+}
+
+// translateKey maps a key pressed on the home screen or in a list to the key the views
+// check for, per the keybindings of config.yaml. ok is false for keys to drop. Keys of
+// the handler's actions, and keys typed into a search, are left as they are.
+func (a *App) translateKey(msg tea.KeyMsg) (translated tea.KeyMsg, ok bool) {
+	if a.state != StateResourceList {
+		return a.keys.Translate(msg, keys.ScopeHome)
+	}
+	if a.resourceList.InputActive() || a.resourceList.HasActionKey(msg.String()) {
+		return msg, true
+	}
+	return a.keys.Translate(msg, keys.ScopeList)
+}
+
+// keyBindingsText lists the key bindings in use for :keys, with the actions of the list
+// shown and the action keys config.yaml changes
+func (a *App) keyBindingsText() string {
+	var sb strings.Builder
+	for _, section := range []string{keys.SectionGlobal, keys.SectionList} {
+		fmt.Fprintf(&sb, "%s\n", section)
+		for _, b := range a.keys.Bindings {
+			if b.Section != section {
+				continue
+			}
+			fmt.Fprintf(&sb, "  %-16s %-14s %s", b.Name, keys.KeysText(b.Keys), b.Help)
+			if b.Remapped() {
+				fmt.Fprintf(&sb, " (default %s)", keys.KeysText(b.Defaults))
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	if handler := a.resourceList.Handler(); a.state == StateResourceList && handler != nil {
+		fmt.Fprintf(&sb, "actions of %s\n", handler.ResourceType())
+		for _, action := range a.handlerActions() {
+			fmt.Fprintf(&sb, "  %-16s %-14s %s\n", action.Name, keys.KeysText([]string{action.Key}), action.Description)
+		}
+		sb.WriteString("\n")
+	}
+
+	actionKeys := a.keys.ActionKeys()
+	if len(actionKeys) > 0 {
+		sb.WriteString("remapped actions\n")
+		types := make([]string, 0, len(actionKeys))
+		for resourceType := range actionKeys {
+			types = append(types, resourceType)
+		}
+		slices.Sort(types)
+		for _, resourceType := range types {
+			names := make([]string, 0, len(actionKeys[resourceType]))
+			for name := range actionKeys[resourceType] {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			for _, name := range names {
+				fmt.Fprintf(&sb, "  %s %s: %s\n", resourceType, name, keys.KeysText([]string{actionKeys[resourceType][name]}))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("Remap keys in the keybindings section of config.yaml")
+	return sb.String()
+}
+
+// requireTypedName asks for the resource name to be typed in the confirm dialog when
+// the current handler's action is severe enough, see typed_confirmation
+func (a *App) requireTypedName(actionName, resourceName string) {
+	handler := a.resourceList.Handler()
+	if handler == nil {
+		return
+	}
+	for _, action := range handler.Actions() {
+		if action.Name == actionName && action.Severity > handlers.SeverityNormal && action.Severity >= a.typedConfirmSeverity {
+			a.confirmDialog.RequireTypedName(resourceName)
+			return
+		}
+	}
+}
+
+// reminderMarker flags resources whose expiry reminder is due within the reminder window
+func (a *App) reminderMarker(res handlers.Resource) string {
+	reminder, ok := a.reminderStore.Get(res.GetType(), res.GetARN())
+	if !ok {
+		return ""
+	}
+
+	days := reminder.DaysLeft(time.Now())
+	switch {
+	case days < 0:
+		return "✗"
+	case days <= int(config.DefaultReminderWindow.Hours()/24):
+		return "⏰"
+	}
+	return ""
+}
+
+// setReadOnly switches read-only mode on or off across the components that enforce it
+func (a *App) setReadOnly(readOnly bool) {
+	a.readOnly = readOnly
+	a.header.SetReadOnly(readOnly)
+	a.footer.SetReadOnly(readOnly)
+	a.resourceList.SetReadOnly(readOnly)
+	if a.split != nil {
+		a.split.list.SetReadOnly(readOnly)
+	}
+}
+
 // Init initializes the application
 func (a *App) Init() tea.Cmd {
-	return tea.Batch(
+	return trackAttempts(tea.Batch(
 		a.loadProfiles(),
 		a.initializeAWS(),
-	)
+		a.waitForMFAPrompt(),
+		a.checkForUpdates(),
+	))
 }
 
 // loadProfiles loads AWS profiles
@@ -214,20 +535,43 @@ func (a *App) registerHandlers() {
 	a.registry.Register(handlers.NewIAMUsersHandler(a.clientMgr.IAM()))
 	a.registry.Register(handlers.NewIAMRolesHandler(a.clientMgr.IAM()))
 	a.registry.Register(handlers.NewIAMPoliciesHandler(a.clientMgr.IAM()))
+	a.registry.Register(handlers.NewIAMGroupsHandler(a.clientMgr.IAM()))
+	a.registry.Register(handlers.NewIAMCredentialReportHandler(a.clientMgr.IAM()))
 
 	// Register EC2 handlers
 	a.registry.Register(handlers.NewSecurityGroupsHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
-	a.registry.Register(handlers.NewEC2InstancesHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+	ec2Handler := handlers.NewEC2InstancesHandler(a.clientMgr.EC2(), a.clientMgr.CloudWatch(), a.clientMgr.Region())
+	ec2Handler.SetShowCostEstimate(a.config.ShowCostEstimates)
+	a.registry.Register(ec2Handler)
+	a.registry.Register(handlers.NewEC2IMDSHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewEC2LaunchTemplatesHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
 	a.registry.Register(handlers.NewVPCsHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewSubnetsHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewRouteTablesHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewNatGatewaysHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewInternetGatewaysHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewNetworkInterfacesHandler(a.clientMgr.EC2(), a.clientMgr.Region()))
+
+	// Register Auto Scaling handlers
+	a.registry.Register(handlers.NewAutoScalingGroupsHandler(a.clientMgr.AutoScaling(), a.clientMgr.Region()))
+
+	// Register Elastic Load Balancing handlers
+	a.registry.Register(handlers.NewELBLoadBalancersHandler(a.clientMgr.ELBv2(), a.clientMgr.Region()))
 
 	// Register KMS handlers
 	a.registry.Register(handlers.NewKMSKeysHandler(a.clientMgr.KMS(), a.clientMgr.Region()))
 
 	// Register Secrets Manager handlers
-	a.registry.Register(handlers.NewSecretsHandler(a.clientMgr.SecretsManager(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewSecretsHandler(a.clientMgr.SecretsManager(), a.clientMgr.Lambda(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewSecretsRotationHandler(a.clientMgr.SecretsManager(), a.clientMgr.Lambda(), a.clientMgr.Region()))
 
 	// Register RDS handlers
-	a.registry.Register(handlers.NewRDSInstancesHandler(a.clientMgr.RDS(), a.clientMgr.Region()))
+	rdsHandler := handlers.NewRDSInstancesHandler(a.clientMgr.RDS(), a.clientMgr.Region())
+	rdsHandler.SetShowCostEstimate(a.config.ShowCostEstimates)
+	a.registry.Register(rdsHandler)
+	a.registry.Register(handlers.NewRDSSnapshotsHandler(a.clientMgr.RDS(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewRDSParameterGroupsHandler(a.clientMgr.RDS(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewRDSOptionGroupsHandler(a.clientMgr.RDS(), a.clientMgr.Region()))
 
 	// Register ECS handlers
 	a.registry.Register(handlers.NewECSClustersHandler(a.clientMgr.ECS(), a.clientMgr.Region()))
@@ -237,12 +581,32 @@ func (a *App) registerHandlers() {
 
 	// Register CloudWatch Logs handlers
 	a.registry.Register(handlers.NewCloudWatchLogsHandler(a.clientMgr.CloudWatchLogs(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewCloudWatchSourcesHandler(a.clientMgr.OAM(), a.clientMgr.Region()))
 
 	// Register S3 handlers
 	a.registry.Register(handlers.NewS3BucketsHandler(a.clientMgr.S3(), a.clientMgr.Region()))
 
 	// Register DynamoDB handlers
 	a.registry.Register(handlers.NewDynamoDBTablesHandler(a.clientMgr.DynamoDB(), a.clientMgr.Region()))
+
+	// Register Amazon MQ handlers
+	a.registry.Register(handlers.NewMQBrokersHandler(a.clientMgr.MQ(), a.clientMgr.Region()))
+
+	// Register Image Builder handlers
+	a.registry.Register(handlers.NewImagePipelinesHandler(a.clientMgr.ImageBuilder(), a.clientMgr.Region()))
+
+	// Register Amazon Connect and Pinpoint handlers
+	a.registry.Register(handlers.NewConnectInstancesHandler(a.clientMgr.Connect(), a.clientMgr.Region()))
+	a.registry.Register(handlers.NewPinpointProjectsHandler(a.clientMgr.Pinpoint(), a.clientMgr.Region()))
+
+	// Register API Gateway handlers
+	a.registry.Register(handlers.NewAPIGatewayAPIsHandler(a.clientMgr.APIGateway(), a.clientMgr.APIGatewayV2(), a.clientMgr.Region()))
+
+	// Register CloudFormation handlers
+	a.registry.Register(handlers.NewCloudFormationStackSetsHandler(a.clientMgr.CloudFormation(), a.clientMgr.Region()))
+
+	// Register Cost Explorer handlers
+	a.registry.Register(handlers.NewCostExplorerHandler(a.clientMgr.CostExplorer(), a.clientMgr.Region()))
 }
 
 // Internal messages
@@ -257,12 +621,64 @@ type awsInitializedMsg struct {
 	err       error
 }
 
-// Update handles all messages
+// profileSwitch is a profile being loaded in the background. The last view stays usable,
+// in read-only mode, until it is ready or cancelled.
+type profileSwitch struct {
+	profile        string
+	cancel         context.CancelFunc
+	readOnlyBefore bool
+}
+
+// profileSwitchedMsg is sent when a profile switch has loaded the profile and checked its
+// credentials, or failed to
+type profileSwitchedMsg struct {
+	sw       *profileSwitch
+	prepared *awsadapter.PreparedContext
+	err      error
+}
+
+// Update handles all messages, then animates the footer spinner if a load started.
+// Messages are tracked back to their commands, to retry those that fail on expired
+// credentials once they are back.
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var attempt tea.Cmd
+	if m, ok := msg.(attemptMsg); ok {
+		msg, attempt = m.msg, m.cmd
+	}
+	errorCount := a.footer.ErrorCount()
+	model, cmd := a.update(msg)
+	if attempt != nil && a.footer.ErrorCount() > errorCount {
+		a.noteExpiredCredentials(msg, attempt)
+	}
+	// The list's messages come back to it even if the focus moved to another meanwhile
+	cmd = trackAttempts(a.resourceList.Tag(cmd))
+	a.footer.SetTagFilter(a.tagFilterHint())
+	if tick := a.footer.Animate(); tick != nil {
+		return model, tea.Batch(cmd, tick)
+	}
+	return model, cmd
+}
+
+func (a *App) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case components.LoadingTickMsg:
+		switch msg.Pane {
+		case components.PaneFooter:
+			return a, a.footer.Update(msg)
+		case components.PaneMetrics:
+			var cmd tea.Cmd
+			a.dashboard, cmd = a.dashboard.Update(msg)
+			return a, cmd
+		}
+
 	case tea.KeyMsg:
+		// A credential provider waiting for an MFA code blocks until it gets an answer
+		if len(a.mfaQueue) > 0 {
+			return a.handleMFAPrompt(msg)
+		}
+
 		// Handle selector if active
 		if a.selector.IsActive() {
 			newSelector, cmd := a.selector.Update(msg)
@@ -283,6 +699,69 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, tea.Batch(cmds...)
 		}
 
+		// Handle policy picker if active
+		if a.policyPicker.IsActive() {
+			var cmd tea.Cmd
+			a.policyPicker, cmd = a.policyPicker.Update(msg)
+			return a, cmd
+		}
+
+		// Handle test event picker if active
+		if a.testEventPicker.IsActive() {
+			var cmd tea.Cmd
+			a.testEventPicker, cmd = a.testEventPicker.Update(msg)
+			return a, cmd
+		}
+
+		// Handle restore wizard if active
+		if a.restoreWizard.IsActive() {
+			var cmd tea.Cmd
+			a.restoreWizard, cmd = a.restoreWizard.Update(msg)
+			return a, cmd
+		}
+
+		// Handle capacity editor if active
+		if a.capacityEditor.IsActive() {
+			var cmd tea.Cmd
+			a.capacityEditor, cmd = a.capacityEditor.Update(msg)
+			return a, cmd
+		}
+
+		// Handle tag editor if active
+		if a.tagEditor.IsActive() {
+			var cmd tea.Cmd
+			a.tagEditor, cmd = a.tagEditor.Update(msg)
+			return a, cmd
+		}
+
+		// Handle item editor if active
+		if a.itemEditor.IsActive() {
+			var cmd tea.Cmd
+			a.itemEditor, cmd = a.itemEditor.Update(msg)
+			return a, cmd
+		}
+
+		// Handle object restore form if active
+		if a.objectRestore.IsActive() {
+			var cmd tea.Cmd
+			a.objectRestore, cmd = a.objectRestore.Update(msg)
+			return a, cmd
+		}
+
+		// A pending profile switch can be cancelled from any view
+		if msg.String() == "ctrl+x" && a.profileSwitch != nil {
+			a.cancelProfileSwitch()
+			return a, nil
+		}
+
+		// So can a list or details load that hangs, cancelling its AWS calls. Mutating
+		// calls can't be taken back, so their spinner stays.
+		if msg.String() == "ctrl+x" && (a.resourceList.LoadInFlight() || a.cancelQuery != nil) {
+			a.cancelListLoads()
+			a.footer.SetMessage("Loading cancelled, r to retry", false)
+			return a, nil
+		}
+
 		// Handle mode-specific input
 		switch a.mode {
 		case ModeCommand:
@@ -297,6 +776,48 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return a, cmd
 			}
 
+			// Handle log tail if visible
+			if a.logTail.IsVisible() {
+				var cmd tea.Cmd
+				a.logTail, cmd = a.logTail.Update(msg)
+				return a, cmd
+			}
+
+			// Handle dashboard if visible
+			if a.dashboard.IsVisible() {
+				var cmd tea.Cmd
+				a.dashboard, cmd = a.dashboard.Update(msg)
+				return a, cmd
+			}
+
+			// Handle command output if visible
+			if a.commandOutput.IsVisible() {
+				var cmd tea.Cmd
+				a.commandOutput, cmd = a.commandOutput.Update(msg)
+				return a, cmd
+			}
+
+			// Handle changelog if visible
+			if a.changelog.IsVisible() {
+				var cmd tea.Cmd
+				a.changelog, cmd = a.changelog.Update(msg)
+				return a, cmd
+			}
+
+			// Handle diff view if visible
+			if a.diffView.IsVisible() {
+				var cmd tea.Cmd
+				a.diffView, cmd = a.diffView.Update(msg)
+				return a, cmd
+			}
+
+			// Handle error panel if visible
+			if a.errorPanel.IsVisible() {
+				var cmd tea.Cmd
+				a.errorPanel, cmd = a.errorPanel.Update(msg)
+				return a, cmd
+			}
+
 			// Handle state-specific input in normal mode
 			if a.state == StateSecretEditor {
 				return a.handleSecretEditorMode(msg)
@@ -315,10 +836,22 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.breadcrumb.SetWidth(msg.Width)
 		a.selector.SetSize(msg.Width, msg.Height)
 		a.bookmarkSelector.SetSize(msg.Width, msg.Height)
-
-		// Update resource list size
-		contentHeight := a.calculateContentHeight()
-		a.resourceList.SetSize(msg.Width, contentHeight)
+		a.diffView.SetSize(msg.Width, msg.Height)
+		a.errorPanel.SetSize(msg.Width, msg.Height)
+		a.policyPicker.SetSize(msg.Width, msg.Height)
+		a.testEventPicker.SetSize(msg.Width, msg.Height)
+		a.restoreWizard.SetSize(msg.Width, msg.Height)
+		a.capacityEditor.SetSize(msg.Width, msg.Height)
+		a.tagEditor.SetSize(msg.Width, msg.Height)
+		a.itemEditor.SetSize(msg.Width, msg.Height)
+		a.objectRestore.SetSize(msg.Width, msg.Height)
+		a.logTail.SetSize(msg.Width, msg.Height)
+		a.dashboard.SetSize(msg.Width, msg.Height)
+		a.commandOutput.SetSize(msg.Width, msg.Height)
+		a.changelog.SetSize(msg.Width, msg.Height)
+		a.mfaDialog.SetWidth(msg.Width)
+
+		a.sizeLists()
 		return a, nil
 
 	case profilesLoadedMsg:
@@ -331,126 +864,473 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.header.SetAccountID(msg.accountID)
 		a.header.SetContext("Home")
 		a.initialized = true
+		a.syncAssumedRole()
+		a.expired = nil // Failures of the previous credentials aren't retried with these
+
+		// Loads still running were for the previous credentials or region
+		a.cancelListLoads()
+		if a.split != nil {
+			a.split.list.CancelLoads()
+		}
 
 		// Register handlers now that AWS is configured
 		a.registerHandlers()
 
 		// Show error if credentials failed
+		a.credentialsExpiry = time.Time{}
+		a.updateSessionExpiry()
+		var loadExpiry tea.Cmd
 		if msg.err != nil {
 			a.footer.SetMessage(fmt.Sprintf("AWS Error: %v. Press 'p' to select a profile.", msg.err), true)
+		} else {
+			loadExpiry = a.loadSessionExpiry()
+			a.noteProfileAccount(msg.profile, msg.accountID)
 		}
-		return a, nil
+		return a, tea.Batch(a.refreshHome(msg.err == nil), a.openLaunch(msg.err), a.resumeMacro(msg.err),
+			a.openPendingBookmark(msg.err), loadExpiry)
 
 	case ssoLoginFinishedMsg:
-		if msg.err != nil {
-			a.footer.SetMessage(fmt.Sprintf("SSO login failed: %v", msg.err), true)
-			return a, nil
-		}
-		a.footer.SetMessage("SSO session refreshed successfully", false)
-		// Re-initialize the client to pick up new credentials
-		return a, a.switchProfile(a.clientMgr.Profile())
+		return a, a.ssoLoginFinished(msg)
+
+	case components.SSOLoginRequestedMsg:
+		return a, a.ssoLoginRequested(msg.Profile)
+
+	case accountsResolvedMsg:
+		a.accountsResolved(msg)
+		return a, nil
+
+	case whoamiLoadedMsg:
+		a.showWhoami(msg)
+		return a, nil
+
+	case sessionExpiryMsg:
+		a.sessionExpiryLoaded(msg)
+		return a, nil
+
+	case sessionTickMsg:
+		return a, a.handleSessionTick()
 
 	case components.ProfileSelectedMsg:
 		return a, a.switchProfile(msg.Profile)
 
-	case components.RegionSelectedMsg:
-		return a, a.switchRegion(msg.Region)
+	case elevationStartedMsg:
+		return a.elevationStarted(msg)
 
-	case components.SelectorClosedMsg:
-		return a, nil
+	case elevationTickMsg:
+		return a.handleElevationTick(msg)
 
-	case messages.ErrorMsg:
-		a.lastError = msg.Error
-		a.footer.SetMessage(fmt.Sprintf("Error: %v", msg.Error), true)
+	case views.PaneMsg:
+		return a.handlePaneMsg(msg)
+
+	case listResultMsg:
+		return a.handleListResult(msg)
+
+	case queryResultMsg:
+		return a.handleQueryResult(msg)
+
+	case views.YankPendingMsg:
+		a.footer.SetMessage("Copy: y row, c cell, i ID, a ARN, j JSON", false)
 		return a, nil
 
-	case messages.LoadingMsg:
-		a.loading = msg.Loading
-		a.loadingMsg = msg.Message
-		a.footer.SetLoading(msg.Loading, msg.Message)
+	case views.YankCancelledMsg:
+		a.footer.ClearMessage()
 		return a, nil
 
-	// Resource list messages
-	case views.ResourcesLoadedMsg:
-		var cmd tea.Cmd
-		a.resourceList, cmd = a.resourceList.Update(msg)
-		a.loading = false
-		a.footer.SetLoading(false, "")
-		if msg.Error != nil {
-			a.footer.SetMessage(fmt.Sprintf("Error: %v", msg.Error), true)
-		} else {
-			a.footer.ClearMessage()
-			// Update pagination info
-			page, hasMore, count := a.resourceList.GetPaginationInfo()
-			a.footer.SetPagination(page, hasMore, count)
-		}
-		return a, cmd
+	case homeTickMsg:
+		return a.handleHomeTick(msg)
 
-	case views.ResourceDetailLoadedMsg:
-		var cmd tea.Cmd
-		a.resourceList, cmd = a.resourceList.Update(msg)
-		return a, cmd
+	case homePinMsg:
+		return a.handleHomePin(msg)
 
-	case components.ResourceSelectedMsg:
-		var cmd tea.Cmd
-		a.resourceList, cmd = a.resourceList.Update(msg)
-		return a, cmd
+	case homeIdentityMsg:
+		return a.handleHomeIdentity(msg)
 
-	case components.SearchUpdateMsg, components.SearchClosedMsg:
-		var cmd tea.Cmd
-		a.resourceList, cmd = a.resourceList.Update(msg)
-		return a, cmd
+	case *mfaPrompt:
+		a.queueMFAPrompt(msg)
+		return a, a.waitForMFAPrompt()
 
-	case components.ClipboardCopiedMsg:
-		if msg.Success {
-			a.footer.SetMessage(fmt.Sprintf("Copied %s to clipboard", msg.Label), false)
-		} else {
-			a.footer.SetMessage(fmt.Sprintf("Failed to copy: %v", msg.Error), true)
+	case profileSwitchedMsg:
+		// Ignore switches that were cancelled or replaced by another one
+		if msg.sw != a.profileSwitch {
+			return a, nil
+		}
+		a.endProfileSwitch()
+		if msg.err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Switching to %s failed, still on %s: %v", msg.sw.profile, a.clientMgr.Profile(), msg.err), true)
+			a.macro = nil
+			a.pendingBookmark = nil
+			return a, nil
 		}
+		// An elevation is granted for one account, it ends with a change of profile
+		endElevation := a.endElevation(config.AuditEnd)
+		a.clientMgr.Activate(msg.prepared)
+		a.footer.SetMessage(fmt.Sprintf("Switched to %s", msg.prepared.Profile()), false)
+		model, cmd := a.Update(awsInitializedMsg{
+			profile:   msg.prepared.Profile(),
+			region:    msg.prepared.Region(),
+			accountID: msg.prepared.AccountID(),
+		})
+		return model, tea.Batch(endElevation, cmd)
+
+	case components.RegionSelectedMsg:
+		return a, a.switchRegion(msg.Region)
+
+	case components.SelectorClosedMsg:
+		a.pendingAssumeRole = nil
+		a.pendingRegisterTarget = nil
+		a.pendingStandby = nil
+		a.pendingOpenWith = nil
+		a.pendingAddToGroup = nil
+		a.pendingRotation = nil
+		a.pendingDBConnect = nil
 		return a, nil
 
-	case components.BookmarkAddedMsg:
-		if msg.Success {
-			a.footer.SetMessage(fmt.Sprintf("Bookmarked: %s", msg.Name), false)
-		} else {
-			a.footer.SetMessage(fmt.Sprintf("Failed to bookmark: %v", msg.Error), true)
+	case *handlers.AssumeRoleAction:
+		return a, a.showSessionPolicies(msg)
+
+	case components.SessionPolicySelectedMsg:
+		role := a.pendingAssumeRole
+		a.pendingAssumeRole = nil
+		if role == nil {
+			return a, nil
 		}
-		return a, nil
+		a.footer.SetMessage(fmt.Sprintf("Assuming %s...", role.RoleName), false)
+		return a, a.assumeRole(role, msg.Policy)
 
-	case components.BookmarkRemovedMsg:
-		if msg.Success {
-			a.footer.SetMessage("Bookmark removed", false)
-		} else {
-			a.footer.SetMessage(fmt.Sprintf("Failed to remove bookmark: %v", msg.Error), true)
+	case *handlers.RegisterTargetAction:
+		options := make([]components.TargetOption, 0, len(msg.Candidates))
+		for _, candidate := range msg.Candidates {
+			options = append(options, components.TargetOption{ID: candidate.ID, Description: candidate.Description})
 		}
-		return a, nil
+		a.pendingRegisterTarget = msg
+		return a, a.selector.ShowTargets(msg.TargetGroupName, options)
 
-	case components.BookmarkClosedMsg:
-		return a, nil
+	case components.TargetSelectedMsg:
+		register := a.pendingRegisterTarget
+		a.pendingRegisterTarget = nil
+		if register == nil {
+			return a, nil
+		}
+		a.footer.SetLoading(true, "Registering target...")
+		return a, a.registerTarget(register.TargetGroupARN, msg.ID)
 
-	case components.BookmarkSelectedMsg:
-		// Navigate to the bookmarked resource
-		return a.navigateToBookmark(msg.Bookmark)
+	case *handlers.EnterStandbyAction:
+		options := make([]components.StandbyOption, 0, len(msg.Candidates))
+		for _, candidate := range msg.Candidates {
+			options = append(options, components.StandbyOption{InstanceID: candidate.ID, Description: candidate.Description})
+		}
+		a.pendingStandby = msg
+		return a, a.selector.ShowStandbyInstances(msg.GroupName, options)
 
-	// ECS Navigation actions
-	case *handlers.NavigateToServicesAction:
-		handler := handlers.NewECSServicesHandlerForCluster(
-			a.clientMgr.ECS(),
-			a.clientMgr.Region(),
-			msg.ClusterARN,
-			msg.ClusterName,
+	case components.StandbySelectedMsg:
+		standby := a.pendingStandby
+		a.pendingStandby = nil
+		if standby == nil {
+			return a, nil
+		}
+		a.footer.SetLoading(true, "Moving instance to standby...")
+		return a, a.enterStandby(standby, msg.InstanceID)
+
+	case *handlers.AddUserToGroupAction:
+		options := make([]components.GroupMemberOption, 0, len(msg.Candidates))
+		for _, candidate := range msg.Candidates {
+			options = append(options, components.GroupMemberOption{UserName: candidate.UserName, Description: candidate.Description})
+		}
+		a.pendingAddToGroup = msg
+		return a, a.selector.ShowGroupMemberCandidates(msg.GroupName, options)
+
+	case components.GroupMemberSelectedMsg:
+		add := a.pendingAddToGroup
+		a.pendingAddToGroup = nil
+		if add == nil {
+			return a, nil
+		}
+		a.footer.SetLoading(true, "Adding user to group...")
+		return a, a.addUserToGroup(add.GroupName, msg.UserName)
+
+	case *handlers.ConfigureRotationAction:
+		options := make([]components.RotationLambdaOption, 0, len(msg.Candidates))
+		for _, candidate := range msg.Candidates {
+			options = append(options, components.RotationLambdaOption{Name: candidate.Name, ARN: candidate.ARN, Description: candidate.Description})
+		}
+		a.pendingRotation = msg
+		return a, a.selector.ShowRotationLambdas(msg.SecretName, msg.LambdaARN, options)
+
+	case components.RotationLambdaSelectedMsg:
+		rotation := a.pendingRotation
+		a.pendingRotation = nil
+		if rotation == nil {
+			return a, nil
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = &handlers.RotationConfigRequest{Action: rotation, LambdaARN: msg.ARN}
+		a.confirmDialog.SetMessage(rotationConfigMessage(rotation, msg.ARN))
+		expression := rotation.Expression
+		if expression == "" {
+			expression = "rate(30 days)"
+		}
+		a.confirmDialog.RequireTextInput("Schedule", expression, "rate(30 days) or cron(0 4 ? * SUN *)", 256)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.ConnectDBAction:
+		a.footer.SetLoading(true, "Looking up secrets and tunnel instances...")
+		return a, a.loadDBConnect(msg)
+
+	case dbConnectLoadedMsg:
+		return a.dbConnectLoaded(msg)
+
+	case components.DBSecretSelectedMsg:
+		if a.pendingDBConnect == nil {
+			return a, nil
+		}
+		a.pendingDBConnect.secretARN = msg.ARN
+		return a, a.showDBConnections()
+
+	case components.DBConnectionSelectedMsg:
+		return a, a.connectDB(msg.Value)
+
+	case dbClientReadyMsg:
+		return a, a.launchDBClient(msg)
+
+	case dbClientFinishedMsg:
+		if msg.err != nil {
+			a.footer.SetMessage(fmt.Sprintf("%s exited: %v", msg.client, msg.err), true)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("%s session ended", msg.client), false)
+		}
+		return a, nil
+
+	case *handlers.RotateSecretNowAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to rotate the secret:\n\n%s\n\n"+
+				"%s creates a new value and makes it AWSCURRENT.\n"+
+				"Applications caching the old value fail once the rotation has finished.",
+			msg.SecretName, msg.LambdaARN[strings.LastIndex(msg.LambdaARN, ":")+1:],
+		))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case components.OpenWithSelectedMsg:
+		res := a.pendingOpenWith
+		a.pendingOpenWith = nil
+		if res == nil {
+			return a, nil
+		}
+		for _, command := range a.config.OpenWith {
+			if command.Name == msg.Name {
+				a.footer.SetLoading(true, fmt.Sprintf("Describing %s...", res.GetName()))
+				return a, a.prepareOpenWith(command, res)
+			}
+		}
+		return a, nil
+
+	case openWithReadyMsg:
+		a.footer.SetLoading(false, "")
+		return a, a.runOpenWith(msg)
+
+	case awsCLIReadyMsg:
+		if msg.err != nil {
+			a.footer.SetMessage(msg.err.Error(), true)
+			return a, nil
+		}
+		a.commandOutput.SetSize(a.width, a.height)
+		return a, a.commandOutput.Run(msg.title, msg.cmd, msg.onExit)
+
+	case openWithFinishedMsg:
+		os.Remove(msg.file)
+		if msg.err != nil {
+			a.footer.SetMessage(fmt.Sprintf("%s failed: %v", msg.name, msg.err), true)
+		}
+		return a, nil
+
+	case views.ShowErrorsMsg:
+		a.errorPanel.Show(a.footer.Errors())
+		return a, nil
+
+	case messages.ErrorMsg:
+		a.lastError = msg.Error
+		a.footer.SetMessage(fmt.Sprintf("Error: %v", msg.Error), true)
+		// Such as the region switch an alias waits on
+		a.macro = nil
+		return a, nil
+
+	case messages.LoadingMsg:
+		a.loading = msg.Loading
+		a.loadingMsg = msg.Message
+		a.footer.SetLoading(msg.Loading, msg.Message)
+		return a, nil
+
+	// Resource list messages
+	case views.ResourcesLoadedMsg:
+		// A load cancelled by navigating away must not clear the spinner of the one replacing it
+		if !a.resourceList.IsCurrent(msg) {
+			return a, nil
+		}
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		a.loading = false
+		a.footer.SetLoading(false, "")
+		if msg.Error != nil {
+			a.footer.SetMessage(fmt.Sprintf("Error: %v", msg.Error), true)
+		} else {
+			a.footer.ClearMessage()
+			// Update pagination info
+			page, hasMore, count := a.resourceList.GetPaginationInfo()
+			a.footer.SetPagination(page, hasMore, count)
+		}
+		return a, cmd
+
+	case views.ResourceDetailLoadedMsg:
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		return a, cmd
+
+	case components.ResourceSelectedMsg:
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		return a, cmd
+
+	case components.SearchUpdateMsg, components.SearchClosedMsg, views.ServerSearchMsg:
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		return a, cmd
+
+	case views.ServerSearchResultMsg:
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		if msg.Error != nil {
+			a.footer.SetMessage(fmt.Sprintf("Search on AWS failed: %v", msg.Error), true)
+		}
+		return a, cmd
+
+	case components.ClipboardCopiedMsg:
+		if msg.Success {
+			a.footer.SetMessage(fmt.Sprintf("Copied %s to clipboard", msg.Label), false)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Failed to copy: %v", msg.Error), true)
+		}
+		return a, nil
+
+	case components.BookmarkAddedMsg:
+		if msg.Success {
+			a.footer.SetMessage(fmt.Sprintf("Bookmarked: %s", msg.Name), false)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Failed to bookmark: %v", msg.Error), true)
+		}
+		return a, nil
+
+	case components.BookmarkRemovedMsg:
+		if msg.Success {
+			a.footer.SetMessage("Bookmark removed", false)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Failed to remove bookmark: %v", msg.Error), true)
+		}
+		return a, nil
+
+	case components.BookmarkEditedMsg:
+		if msg.Success {
+			a.footer.SetMessage(fmt.Sprintf("Bookmark %s saved", msg.Field), false)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Failed to save the bookmark's %s: %v", msg.Field, msg.Error), true)
+		}
+		return a, nil
+
+	case components.BookmarkClosedMsg:
+		return a, nil
+
+	case components.BookmarkSelectedMsg:
+		// Navigate to the bookmarked resource
+		return a.navigateToBookmark(msg.Bookmark)
+
+	case components.RecentSelectedMsg:
+		return a.navigateToRecent(msg.Recent)
+
+	case *handlers.NavigateToRecentAction:
+		return a.navigateToRecent(msg.Recent)
+
+	case *handlers.NavigateToTaggedAction:
+		return a.navigateToType(msg.ResourceType, "", msg.ResourceID)
+
+	// ECS Navigation actions
+	case *handlers.NavigateToServicesAction:
+		handler := handlers.NewECSServicesHandlerForCluster(
+			a.clientMgr.ECS(),
+			a.clientMgr.Region(),
+			msg.ClusterARN,
+			msg.ClusterName,
 		)
 		a.state = StateResourceList
 		a.breadcrumb.SetPath("ECS", "Clusters", msg.ClusterName, "Services")
 		a.header.SetContext("ECS")
 		a.resourceList.SetHandler(handler)
-		a.footer.SetHandlerActions(handler.Actions())
+		a.footer.SetHandlerActions(a.handlerActions())
 		a.loading = true
 		a.footer.SetLoading(true, "Loading services...")
-		contentHeight := a.calculateContentHeight()
-		a.resourceList.SetSize(a.width, contentHeight)
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.NavigateToTaskDefinitionsAction:
+		handler := handlers.NewECSTaskDefinitionsHandlerForFamily(
+			a.clientMgr.ECS(),
+			a.clientMgr.Region(),
+			msg.Family,
+		)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("ECS", "Task Definitions", msg.Family)
+		a.header.SetContext("ECS")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading task definition revisions...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.NavigateToLaunchTemplateVersionsAction:
+		handler := handlers.NewEC2LaunchTemplateVersionsHandler(
+			a.clientMgr.EC2(),
+			a.clientMgr.Region(),
+			msg.TemplateID,
+			msg.TemplateName,
+		)
+		name := msg.TemplateName
+		if name == "" {
+			name = msg.TemplateID
+		}
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("EC2", "Launch Templates", name, "Versions")
+		a.header.SetContext("EC2")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		if msg.Version != "" {
+			a.resourceList.SelectOnLoad(msg.TemplateID + ":" + msg.Version)
+		}
+		a.loading = true
+		a.footer.SetLoading(true, "Loading launch template versions...")
+		a.sizeLists()
 		return a, a.resourceList.LoadResources(context.Background(), "")
 
+	case *handlers.RescanPoliciesAction:
+		a.footer.SetLoading(true, "Scanning IAM policies...")
+		return a, a.resourceList.Refresh()
+
+	case *handlers.NavigateToIAMResourceAction:
+		handler, ok := a.registry.Get(msg.Shortcut)
+		if !ok {
+			a.footer.SetMessage(fmt.Sprintf("Handler not found: %s", msg.Shortcut), true)
+			return a, nil
+		}
+		model, cmd := a.navigateToResource(msg.Shortcut, "IAM", strings.TrimPrefix(handler.ResourceName(), "IAM "))
+		a.resourceList.SelectOnLoad(msg.ID)
+		return model, cmd
+
+	case *handlers.ShowDiffAction:
+		a.diffView.SetSize(a.width, a.height)
+		a.diffView.Show(msg.LeftName, msg.RightName, msg.Left, msg.Right)
+		return a, nil
+
 	case *handlers.NavigateToTasksAction:
 		var handler *handlers.ECSTasksHandler
 		if msg.ServiceARN != "" {
@@ -475,29 +1355,201 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.state = StateResourceList
 		a.header.SetContext("ECS")
 		a.resourceList.SetHandler(handler)
-		a.footer.SetHandlerActions(handler.Actions())
+		a.footer.SetHandlerActions(a.handlerActions())
 		a.loading = true
 		a.footer.SetLoading(true, "Loading tasks...")
-		contentHeight := a.calculateContentHeight()
-		a.resourceList.SetSize(a.width, contentHeight)
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	// CloudFormation Navigation actions
+	case *handlers.NavigateToStackInstancesAction:
+		handler := handlers.NewCloudFormationStackInstancesHandler(
+			a.clientMgr.CloudFormation(),
+			a.clientMgr.Region(),
+			msg.StackSetName,
+			msg.CallAs,
+		)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("CloudFormation", "StackSets", msg.StackSetName, "Instances")
+		a.header.SetContext("CloudFormation")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading stack instances...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	// VPC Navigation actions
+	case *handlers.NavigateToVPCResourcesAction:
+		handler := handlers.NewVPCResourcesHandler(a.clientMgr.EC2(), a.clientMgr.Region(), msg)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("VPC", "VPCs", msg.VpcID, handler.ResourceName())
+		a.header.SetContext("VPC")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, fmt.Sprintf("Loading %s...", strings.ToLower(handler.ResourceName())))
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	// Elastic Load Balancing navigation actions
+	case *handlers.NavigateToListenersAction:
+		handler := handlers.NewELBListenersHandlerForLoadBalancer(a.clientMgr.ELBv2(), a.clientMgr.Region(), msg.LoadBalancerARN)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("ELB", "Load Balancers", msg.LoadBalancerName, "Listeners")
+		a.header.SetContext("ELB")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading listeners...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.NavigateToTargetGroupsAction:
+		handler := handlers.NewELBTargetGroupsHandlerForLoadBalancer(a.clientMgr.ELBv2(), a.clientMgr.Region(), msg.LoadBalancerARN)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("ELB", "Load Balancers", msg.LoadBalancerName, "Target Groups")
+		a.header.SetContext("ELB")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading target groups...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.NavigateToTargetsAction:
+		handler := handlers.NewELBTargetsHandlerForTargetGroup(
+			a.clientMgr.ELBv2(),
+			a.clientMgr.EC2(),
+			a.clientMgr.Region(),
+			msg.TargetGroupARN,
+			msg.TargetGroupName,
+		)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("ELB", "Target Groups", msg.TargetGroupName, "Targets")
+		a.header.SetContext("ELB")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading targets...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.DeregisterTargetAction:
+		message := fmt.Sprintf("You are about to deregister the target:\n\n%s", msg.TargetID)
+		if msg.Port > 0 {
+			message += fmt.Sprintf(" (port %d)", msg.Port)
+		}
+		message += fmt.Sprintf("\n\nfrom %s. It stops receiving new requests while in-flight ones drain.", msg.TargetGroupName)
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.NavigateToLambdaVersionsAction:
+		handler := handlers.NewLambdaVersionsHandler(
+			a.clientMgr.Lambda(),
+			a.clientMgr.Region(),
+			msg.FunctionName,
+		)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("Lambda", "Functions", msg.FunctionName, "Versions")
+		a.header.SetContext("Lambda")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading versions...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	// API Gateway Navigation actions
+	case *handlers.NavigateToAPIStagesAction:
+		handler := handlers.NewAPIGatewayStagesHandler(
+			a.clientMgr.APIGateway(),
+			a.clientMgr.APIGatewayV2(),
+			a.clientMgr.Region(),
+			msg.API,
+		)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("API Gateway", "APIs", msg.API.Name, "Stages")
+		a.header.SetContext("API Gateway")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading stages...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.NavigateToAPIRoutesAction:
+		handler := handlers.NewAPIGatewayRoutesHandler(
+			a.clientMgr.APIGateway(),
+			a.clientMgr.APIGatewayV2(),
+			a.clientMgr.Region(),
+			msg.API,
+			msg.Stage,
+		)
+		a.state = StateResourceList
+		if msg.Stage != "" {
+			a.breadcrumb.SetPath("API Gateway", "APIs", msg.API.Name, msg.Stage, "Routes")
+		} else {
+			a.breadcrumb.SetPath("API Gateway", "APIs", msg.API.Name, "Routes")
+		}
+		a.header.SetContext("API Gateway")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading routes...")
+		a.sizeLists()
 		return a, a.resourceList.LoadResources(context.Background(), "")
 
+	case *handlers.InvokeFunctionAction:
+		return a, a.showTestEventPicker(msg.FunctionName, "")
+
+	case *handlers.TestInvokeRouteAction:
+		a.footer.SetLoading(true, fmt.Sprintf("Invoking %s %s...", msg.Method, msg.Path))
+		return a, a.testInvokeRoute(msg)
+
 	// CloudWatch Logs Navigation actions
 	case *handlers.NavigateToLogStreamsAction:
+		// Log groups of linked source accounts can only be addressed by ARN
+		logGroup := msg.LogGroupName
+		if msg.LogGroupARN != "" {
+			logGroup = msg.LogGroupARN
+		}
 		handler := handlers.NewCloudWatchLogStreamsHandlerForGroup(
 			a.clientMgr.CloudWatchLogs(),
 			a.clientMgr.Region(),
-			msg.LogGroupName,
+			logGroup,
 		)
 		a.state = StateResourceList
 		a.breadcrumb.SetPath("CloudWatch Logs", "Log Groups", msg.LogGroupName, "Log Streams")
 		a.header.SetContext("CloudWatch Logs")
 		a.resourceList.SetHandler(handler)
-		a.footer.SetHandlerActions(handler.Actions())
+		a.footer.SetHandlerActions(a.handlerActions())
 		a.loading = true
 		a.footer.SetLoading(true, "Loading log streams...")
-		contentHeight := a.calculateContentHeight()
-		a.resourceList.SetSize(a.width, contentHeight)
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.NavigateToSourceLogGroupsAction:
+		handler := handlers.NewCloudWatchLogsHandlerForAccount(
+			a.clientMgr.CloudWatchLogs(),
+			a.clientMgr.Region(),
+			msg.AccountID,
+		)
+		account := msg.AccountID
+		if msg.Label != "" {
+			account = msg.Label
+		}
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("CloudWatch", "Source Accounts", account, "Log Groups")
+		a.header.SetContext("CloudWatch Logs")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading log groups...")
+		a.sizeLists()
 		return a, a.resourceList.LoadResources(context.Background(), "")
 
 	// DynamoDB Navigation actions
@@ -511,11 +1563,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.breadcrumb.SetPath("DynamoDB", "Tables", msg.TableName, "Items")
 		a.header.SetContext("DynamoDB")
 		a.resourceList.SetHandler(handler)
-		a.footer.SetHandlerActions(handler.Actions())
+		a.footer.SetHandlerActions(a.handlerActions())
 		a.loading = true
 		a.footer.SetLoading(true, "Loading items...")
-		contentHeight := a.calculateContentHeight()
-		a.resourceList.SetSize(a.width, contentHeight)
+		a.sizeLists()
 		return a, a.resourceList.LoadResources(context.Background(), "")
 
 	// Secrets Manager actions
@@ -530,12 +1581,55 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case *handlers.EditSecretAction:
-		// Load secret value and enter editor
-		a.footer.SetLoading(true, "Loading secret...")
-		return a, a.loadSecretForEditing(msg.SecretID, msg.SecretName)
-
-	case *handlers.CreateSecretAction:
+	case *handlers.NavigateToSecretVersionsAction:
+		handler := handlers.NewSecretVersionsHandlerForSecret(a.clientMgr.SecretsManager(), a.clientMgr.Region(), msg.SecretID)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("Secrets Manager", "Secrets", msg.SecretName, "Versions")
+		a.header.SetContext("Secrets Manager")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading secret versions...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.ViewSecretVersionAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to view the value of version:\n\n%s (%s)\n\nof the secret %s.\nThis will display sensitive information.",
+			msg.VersionID, msg.Stages, msg.SecretID,
+		))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.DiffSecretVersionAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to diff version:\n\n%s\n\nof the secret %s against AWSCURRENT.\nThis will display sensitive information.",
+			msg.VersionID, msg.SecretID,
+		))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.PromoteSecretVersionAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to make version:\n\n%s\n\nthe current value of the secret %s.\n"+
+				"AWSCURRENT moves to it and AWSPREVIOUS to %s. Applications read the older value from now on.",
+			msg.VersionID, msg.SecretID, msg.CurrentVersionID,
+		))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.EditSecretAction:
+		// Load secret value and enter editor
+		a.footer.SetLoading(true, "Loading secret...")
+		return a, a.loadSecretForEditing(msg.SecretID, msg.SecretName)
+
+	case *handlers.CreateSecretAction:
 		// Activate secret creator form
 		a.state = StateSecretCreator
 		contentHeight := a.calculateContentHeight()
@@ -553,6 +1647,94 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			msg.SecretName,
 		))
 		a.confirmDialog.RequireInput("Recovery window (days, 7-30)", "30", 7, 30)
+		a.requireTypedName("delete", msg.SecretName)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.DeleteTableAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to delete the table:\n\n%s\n\n"+
+				"Every item in the table is deleted. This action cannot be undone.",
+			msg.TableName,
+		))
+		a.requireTypedName("delete", msg.TableName)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.EditTableCapacityAction:
+		a.footer.SetLoading(true, "Loading table capacity...")
+		return a, a.loadTableCapacity(msg.TableName)
+
+	case TableCapacityLoadedMsg:
+		a.footer.SetLoading(false, "")
+		a.capacityEditor.SetSize(a.width, a.height)
+		return a, a.capacityEditor.Show(msg.capacity)
+
+	case components.CapacityEditorClosedMsg:
+		return a, nil
+
+	case components.CapacityEditorConfirmedMsg:
+		a.mode = ModeConfirm
+		a.pendingAction = msg.Request
+		a.confirmDialog.SetMessage(msg.Request.Summary(time.Now()))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case DynamoDBCapacityOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case DynamoDBCapacityOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Capacity change failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case *handlers.EditTagsAction:
+		a.footer.SetLoading(true, "Loading tags...")
+		return a, a.loadTags(msg)
+
+	case TagsLoadedMsg:
+		a.footer.SetLoading(false, "")
+		a.tagEditor.SetSize(a.width, a.height)
+		return a, a.tagEditor.Show(msg.action.ResourceID, msg.action.ResourceName, msg.tags)
+
+	case components.TagEditorClosedMsg:
+		return a, nil
+
+	case components.TagEditorConfirmedMsg:
+		a.footer.SetLoading(true, fmt.Sprintf("Updating tags of %s...", msg.ResourceName))
+		return a, a.updateTags(msg)
+
+	case TagsUpdatedMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(msg.message, false)
+		if msg.resource != nil && a.resourceList.Handler() == msg.handler {
+			return a, a.resourceList.ReplaceResource(msg.resource)
+		}
+		return a, nil
+
+	case TagsErrorMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Tags: %v", msg.err), true)
+		return a, nil
+
+	case *handlers.SetReminderAction:
+		current := ""
+		if reminder, ok := a.reminderStore.Get(msg.ResourceType, msg.ARN); ok {
+			current = reminder.ExpiresOn.Format(config.ReminderDateFormat)
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"Set a local expiry reminder on:\n\n%s\n\n"+
+				"Nothing is changed in AWS. Resources expiring within 30 days are flagged\n"+
+				"in lists and on the home screen. Leave empty to remove the reminder.",
+			msg.Name,
+		))
+		a.confirmDialog.RequireTextInput("Expires on", current, config.ReminderDateFormat, len(config.ReminderDateFormat))
 		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
@@ -591,1443 +1773,5644 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.footer.SetLoading(true, "Loading MFA devices...")
 		return a, a.loadUserMFA(msg.UserName)
 
-	// EC2 Instance actions
-	case *handlers.StartInstanceAction:
-		a.footer.SetLoading(true, "Starting instance...")
-		return a, a.startEC2Instance(msg.InstanceID)
+	case *handlers.NavigateToAccessAdvisorAction:
+		handler := handlers.NewIAMAccessAdvisorHandlerForPrincipal(a.clientMgr.IAM(), msg.PrincipalARN, msg.PrincipalName)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("IAM", msg.PrincipalKind, msg.PrincipalName, "Access Advisor")
+		a.header.SetContext("IAM")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Running access advisor...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
 
-	case *handlers.StopInstanceAction:
-		a.footer.SetLoading(true, "Stopping instance...")
-		return a, a.stopEC2Instance(msg.InstanceID)
+	case *handlers.NavigateToGroupMembersAction:
+		handler := handlers.NewIAMGroupMembersHandlerForGroup(a.clientMgr.IAM(), msg.GroupName)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("IAM", "Groups", msg.GroupName, "Members")
+		a.header.SetContext("IAM")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading group members...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
 
-	case *handlers.RebootInstanceAction:
-		a.footer.SetLoading(true, "Rebooting instance...")
-		return a, a.rebootEC2Instance(msg.InstanceID)
+	case *handlers.RemoveUserFromGroupAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to remove the user:\n\n%s\n\nfrom the group %s.\n"+
+				"The user loses the permissions granted through the group.",
+			msg.UserName, msg.GroupName,
+		))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	case *handlers.ViewConnectionInfoAction:
-		a.footer.SetLoading(true, "Loading connection info...")
-		return a, a.loadConnectionInfo(msg.InstanceID)
+	case IAMGroupOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
 
-	// S3 Bucket actions
-	case *handlers.ViewBucketPolicyAction:
-		a.footer.SetLoading(true, "Loading bucket policy...")
-		return a, a.loadBucketPolicy(msg.BucketName)
+	case IAMGroupOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
 
-	case *handlers.ExecRequestAction:
-		// For now, auto-select first container (can add picker later)
-		containerName := msg.Containers[0].Name
-		if len(msg.Containers) > 1 {
-			a.footer.SetMessage(fmt.Sprintf("Multiple containers found, using: %s", containerName), false)
-		}
-		return a, a.executeECSExec(msg.ClusterARN, msg.TaskARN, containerName)
+	// IAM policy attachment actions
+	case *handlers.ManagePoliciesAction:
+		a.footer.SetLoading(true, "Loading managed policies...")
+		return a, a.loadPolicyPicker(msg.PrincipalType, msg.PrincipalName)
 
-	case ecsExecFinishedMsg:
-		if msg.err != nil {
-			a.footer.SetMessage(fmt.Sprintf("Exec failed: %v", msg.err), true)
-		} else {
-			a.footer.SetMessage("Exec session completed", false)
-		}
+	case PolicyPickerLoadedMsg:
+		a.footer.SetLoading(false, "")
+		a.policyPicker.SetSize(a.width, a.height)
+		return a, a.policyPicker.Show(msg.principalType, msg.principalName, msg.policies, msg.attached)
+
+	case components.PolicyPickerClosedMsg:
 		return a, nil
 
-	case views.ActionErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Action failed: %v", msg.Error), true)
+	case components.PolicyPickerConfirmedMsg:
+		a.mode = ModeConfirm
+		a.pendingAction = msg.Change
+		a.confirmDialog.SetMessage(policyChangeSummary(msg.Change))
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	// Secret operation messages
-	case SecretLoadedMsg:
-		// Show secret value in detail view (could enhance this with a modal)
-		a.footer.SetMessage(fmt.Sprintf("Secret value: %s", msg.value), false)
+	case PolicyAttachmentsAppliedMsg:
 		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(msg.message, false)
 		return a, nil
 
-	case SecretLoadedForEditMsg:
-		// Enter editor mode
-		a.state = StateSecretEditor
-		a.secretEditor.SetSecret(msg.id, msg.name, msg.value)
-		contentHeight := a.calculateContentHeight()
-		a.secretEditor.SetSize(a.width, contentHeight)
+	case PolicyAttachmentsErrorMsg:
 		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Policy change failed: %v", msg.err), true)
 		return a, nil
 
-	case SecretSavedMsg:
-		// Return to list view
-		a.state = StateResourceList
-		a.footer.SetMessage("Secret updated successfully", false)
-		a.footer.SetLoading(false, "")
-		// Refresh the list
-		return a, a.resourceList.LoadResources(context.Background(), "")
+	// RDS snapshot restore actions
+	case *handlers.RestoreSnapshotAction:
+		a.footer.SetLoading(true, "Loading restore options...")
+		return a, a.loadRestoreOptions(msg)
 
-	case SecretSaveErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to save: %v", msg.err), true)
+	// RDS instance actions
+	case *handlers.StartDBInstanceAction:
+		a.footer.SetLoading(true, "Starting DB instance...")
+		return a, a.rdsInstanceOperation(msg.DBInstanceID, "is starting", (*handlers.RDSInstancesHandler).StartInstance)
+
+	case *handlers.StopDBInstanceAction:
+		a.footer.SetLoading(true, "Stopping DB instance...")
+		return a, a.rdsInstanceOperation(msg.DBInstanceID, "is stopping, RDS starts it again after 7 days", (*handlers.RDSInstancesHandler).StopInstance)
+
+	case *handlers.RebootDBInstanceAction:
+		a.footer.SetLoading(true, "Rebooting DB instance...")
+		return a, a.rdsInstanceOperation(msg.DBInstanceID, "is rebooting", (*handlers.RDSInstancesHandler).RebootInstance)
+
+	case RDSInstanceOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
 		a.footer.SetLoading(false, "")
-		return a, nil
+		return a, a.refreshList()
 
-	case SecretLoadErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to load: %v", msg.err), true)
+	case RDSInstanceOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
 		a.footer.SetLoading(false, "")
-		a.mode = ModeNormal
-		a.pendingAction = nil
 		return a, nil
 
-	case SecretCreatedMsg:
+	case *handlers.NavigateToInstanceSnapshotsAction:
+		handler := handlers.NewRDSSnapshotsHandlerForInstance(a.clientMgr.RDS(), a.clientMgr.Region(), msg.DBInstanceID)
 		a.state = StateResourceList
-		a.footer.SetMessage(fmt.Sprintf("Secret '%s' created successfully", msg.secretName), false)
-		a.footer.SetLoading(false, "")
-		a.secretCreator.Reset()
-		// Refresh the list
+		a.breadcrumb.SetPath("RDS", "Instances", msg.DBInstanceID, "Snapshots")
+		a.header.SetContext("RDS")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading snapshots...")
+		a.sizeLists()
 		return a, a.resourceList.LoadResources(context.Background(), "")
 
-	case SecretCreateErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to create secret: %v", msg.err), true)
-		a.footer.SetLoading(false, "")
+	case *handlers.NavigateToParametersAction:
+		handler := handlers.NewRDSParametersHandlerForGroup(a.clientMgr.RDS(), a.clientMgr.Region(), msg.GroupName)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("RDS", "Parameter Groups", msg.GroupName)
+		a.header.SetContext("RDS")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading parameters...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.NavigateToRDSResourceAction:
+		handler, ok := a.registry.Get(msg.Shortcut)
+		if !ok {
+			a.footer.SetMessage(fmt.Sprintf("Handler not found: %s", msg.Shortcut), true)
+			return a, nil
+		}
+		model, cmd := a.navigateToResource(msg.Shortcut, "RDS", strings.TrimPrefix(handler.ResourceName(), "RDS "))
+		a.resourceList.SelectOnLoad(msg.ID)
+		return model, cmd
+
+	case *handlers.CreateDBSnapshotAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to take a manual snapshot of:\n\n%s\n\n"+
+				"Manual snapshots are kept until they are deleted and their storage is billed.\n"+
+				"I/O on a Single-AZ instance is briefly suspended while the snapshot starts.",
+			msg.DBInstanceID,
+		))
+		a.confirmDialog.RequireTextInput("Snapshot name", msg.DefaultSnapshotID(time.Now()), "", 255)
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case SecretDeletedMsg:
-		a.footer.SetMessage(fmt.Sprintf("Secret '%s' scheduled for deletion", msg.secretID), false)
-		a.footer.SetLoading(false, "")
-		// Refresh the list
+	case *handlers.DeleteDBSnapshotAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to delete the snapshot:\n\n%s\n\n"+
+				"Instances can no longer be restored from it. This action cannot be undone.",
+			msg.SnapshotID,
+		))
+		a.requireTypedName("delete", msg.SnapshotID)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.NavigateToAdvisorFlaggedAction:
+		handler := handlers.NewTrustedAdvisorFlaggedHandler(a.clientMgr.Support(), msg.Check)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("Trusted Advisor", msg.Check.Name)
+		a.header.SetContext("Trusted Advisor")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading flagged resources...")
+		a.sizeLists()
 		return a, a.resourceList.LoadResources(context.Background(), "")
 
-	case SecretDeleteErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to delete secret: %v", msg.err), true)
-		a.footer.SetLoading(false, "")
+	case *handlers.NavigateToAdvisorResourceAction:
+		if msg.Region != "" && msg.Region != a.clientMgr.Region() && msg.Region != "-" {
+			if err := a.switchRegionNow(msg.Region); err != nil {
+				a.footer.SetMessage(fmt.Sprintf("Failed to switch region: %v", err), true)
+				return a, nil
+			}
+		}
+		handler, ok := a.registry.Get(msg.Shortcut)
+		if !ok {
+			a.footer.SetMessage(fmt.Sprintf("Handler not found: %s", msg.Shortcut), true)
+			return a, nil
+		}
+		model, cmd := a.navigateToResource(msg.Shortcut, handler.ResourceName())
+		a.resourceList.SelectOnLoad(msg.ID)
+		return model, cmd
+
+	case *handlers.NavigateToControlFindingsAction:
+		return a.navigateToSecurityHub(msg.Filters, false)
+
+	case *handlers.UpdateFindingsWorkflowAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(updateFindingsMessage(msg))
+		a.confirmDialog.RequireTextInput("Note (optional)", "", "why, kept with the findings", 512)
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	// IAM User data messages
-	case UserDataLoadedMsg:
-		a.footer.SetLoading(false, "")
-		a.infoDialog.SetSize(a.width, a.height)
-		a.infoDialog.Show(msg.title, msg.data)
+	case *handlers.DeleteUnusedAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(deleteUnusedMessage(msg))
+		a.requireTypedName("delete", msg.ConfirmText())
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case UserDataErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to load data: %v", msg.err), true)
-		a.footer.SetLoading(false, "")
+	case *handlers.CopyDBSnapshotAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		encryption := "The copy is unencrypted, like the snapshot."
+		if msg.Encrypted {
+			encryption = fmt.Sprintf("The copy is encrypted with %s in the destination region.", handlers.DefaultCopyKMSKey)
+		}
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to copy the snapshot:\n\n%s (%s)\n\n"+
+				"to another region as %s, with its tags.\n%s\n"+
+				"The transfer between regions and the copy's storage are billed.",
+			msg.SnapshotID, msg.Region, msg.TargetID(), encryption,
+		))
+		a.confirmDialog.RequireTextInput("Destination region", "", "e.g. eu-west-1", 30)
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	// EC2 Instance operation messages
-	case EC2InstanceOperationSuccessMsg:
-		a.footer.SetMessage(msg.message, false)
+	case RDSSnapshotStartedMsg:
 		a.footer.SetLoading(false, "")
-		// Refresh the list to show updated state
-		return a, a.resourceList.Refresh()
+		a.footer.SetMessage(msg.message, false)
+		return a, tea.Batch(a.refreshList(), a.pollRDSSnapshot(msg.snapshotID, msg.region))
+
+	case RDSSnapshotStatusMsg:
+		switch msg.status {
+		case "available":
+			a.footer.SetMessage(fmt.Sprintf("Snapshot %s is available in %s", msg.snapshotID, msg.region), false)
+			if msg.region == a.clientMgr.Region() {
+				return a, a.refreshList()
+			}
+			return a, nil
+		case "failed", "deleted":
+			a.footer.SetMessage(fmt.Sprintf("Snapshot %s %s", msg.snapshotID, msg.status), true)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Snapshot %s is %s (%d%%)", msg.snapshotID, msg.status, msg.progress), false)
+		return a, a.pollRDSSnapshot(msg.snapshotID, msg.region)
 
-	case EC2InstanceOperationErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+	case RDSSnapshotErrorMsg:
 		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Snapshot failed: %v", msg.err), true)
 		return a, nil
 
-	// DynamoDB Item operation messages
-	case ItemLoadedForEditMsg:
-		// Enter editor mode with the item data
-		a.state = StateSecretEditor
-		itemJSON, _ := json.Marshal(msg.itemData)
-		a.secretEditor.SetSecret(msg.itemID, msg.itemKey, string(itemJSON))
-		contentHeight := a.calculateContentHeight()
-		a.secretEditor.SetSize(a.width, contentHeight)
+	case RestoreOptionsLoadedMsg:
 		a.footer.SetLoading(false, "")
+		a.restoreWizard.SetSize(a.width, a.height)
+		return a, a.restoreWizard.Show(msg.options)
+
+	case components.RestoreWizardClosedMsg:
 		return a, nil
 
-	case ItemSavedMsg:
-		// Return to list view
-		a.state = StateResourceList
-		a.footer.SetMessage("Item updated successfully", false)
-		a.footer.SetLoading(false, "")
-		// Refresh the list
-		return a, a.resourceList.LoadResources(context.Background(), "")
+	case components.RestoreWizardConfirmedMsg:
+		a.mode = ModeConfirm
+		a.pendingAction = msg.Request
+		a.confirmDialog.SetMessage(restoreSummary(msg.Request))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	case ItemSaveErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to save item: %v", msg.err), true)
+	case RDSRestoreStartedMsg:
 		a.footer.SetLoading(false, "")
-		return a, nil
-
-	case ItemDeletedMsg:
-		a.footer.SetMessage("Item deleted successfully", false)
-		a.footer.SetLoading(false, "")
-		// Refresh the list
-		return a, a.resourceList.LoadResources(context.Background(), "")
-
-	case ItemDeleteErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to delete item: %v", msg.err), true)
-		a.footer.SetLoading(false, "")
-		return a, nil
+		a.footer.SetMessage(fmt.Sprintf("Restoring %s from %s (%s)", msg.instanceID, msg.snapshotID, msg.status), false)
+		return a, a.pollRestoredInstance(msg.instanceID)
+
+	case RDSRestoreStatusMsg:
+		switch {
+		case msg.status == "available":
+			a.footer.SetMessage(fmt.Sprintf("%s is available at %s:%d", msg.instanceID, msg.endpoint, msg.port), false)
+			a.infoDialog.SetSize(a.width, a.height)
+			a.infoDialog.Show(fmt.Sprintf("Restored %s", msg.instanceID), map[string]interface{}{
+				"DBInstanceIdentifier": msg.instanceID,
+				"Status":               msg.status,
+				"Endpoint":             msg.endpoint,
+				"Port":                 msg.port,
+			})
+			return a, nil
+		case rdsRestoreFailedStatuses[msg.status]:
+			a.footer.SetMessage(fmt.Sprintf("Restore of %s failed: instance is %s", msg.instanceID, msg.status), true)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Restoring %s (%s)", msg.instanceID, msg.status), false)
+		return a, a.pollRestoredInstance(msg.instanceID)
 
-	case ItemLoadErrorMsg:
-		a.footer.SetMessage(fmt.Sprintf("Failed to load item: %v", msg.err), true)
+	case RDSRestoreErrorMsg:
 		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Restore failed: %v", msg.err), true)
 		return a, nil
-	}
 
-	// Route to resource list if in that state
-	if a.state == StateResourceList {
-		var cmd tea.Cmd
-		a.resourceList, cmd = a.resourceList.Update(msg)
-		if cmd != nil {
-			cmds = append(cmds, cmd)
-		}
-	}
+	// EC2 Instance actions
+	case *handlers.StartInstanceAction:
+		a.footer.SetLoading(true, "Starting instance...")
+		return a, a.startEC2Instance(msg.InstanceID)
 
-	return a, tea.Batch(cmds...)
-}
+	case *handlers.StopInstanceAction:
+		a.footer.SetLoading(true, "Stopping instance...")
+		return a, a.stopEC2Instance(msg.InstanceID)
 
-func (a *App) calculateContentHeight() int {
-	if a.height == 0 {
-		return 0
-	}
-	// Header (7 lines) + breadcrumb (1 line) + footer (1 line) = 9 lines
-	return a.height - 9
-}
+	case *handlers.RebootInstanceAction:
+		a.footer.SetLoading(true, "Rebooting instance...")
+		return a, a.rebootEC2Instance(msg.InstanceID)
 
-func (a *App) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// If in resource list state, route navigation to resource list first
-	if a.state == StateResourceList {
-		switch msg.String() {
-		case "esc", "h":
-			// If detail is open, close it first
-			if a.resourceList.HasOpenDetail() {
-				a.resourceList.CloseDetail()
-				return a, nil
-			}
-			// Go back to home
-			a.state = StateHome
-			a.breadcrumb.SetPath("Home")
-			a.header.SetContext("Home")
-			a.footer.ClearPagination()
-			a.footer.ClearHandlerActions()
-			return a, nil
-		case "q":
-			return a, tea.Quit
-		case ":":
-			a.mode = ModeCommand
-			a.commandInput.SetValue("")
-			a.commandInput.Focus()
-			return a, textinput.Blink
-		case "m":
-			// Bookmark current resource
-			if res := a.resourceList.GetSelectedResource(); res != nil {
-				handler := a.resourceList.Handler()
-				return a, components.AddBookmark(
-					a.bookmarkStore,
-					res.GetName(),
-					handler.ResourceType(),
-					res.GetID(),
-					res.GetARN(),
-					a.clientMgr.Region(),
-					a.clientMgr.Profile(),
-				)
-			}
-			return a, nil
-		case "'":
-			// Show bookmarks
-			return a, a.bookmarkSelector.Show()
+	case *handlers.TerminateInstanceAction:
+		label := msg.InstanceID
+		if msg.Name != "" {
+			label = fmt.Sprintf("%s (%s)", msg.Name, msg.InstanceID)
 		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to terminate the %s instance:\n\n%s\n\n"+
+				"Instance store volumes and EBS volumes set to delete on termination are lost.\n"+
+				"This action cannot be undone.",
+			msg.State, label,
+		))
+		a.requireTypedName("terminate", msg.InstanceID)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-		// Route to resource list
-		var cmd tea.Cmd
-		a.resourceList, cmd = a.resourceList.Update(msg)
-		return a, cmd
-	}
+	case *handlers.RequireIMDSv2Action:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(imdsDryRunSummary(msg))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	// Home state key handling
-	switch {
-	case msg.String() == "q" || msg.String() == "ctrl+c":
-		return a, tea.Quit
+	case *handlers.ViewConnectionInfoAction:
+		a.footer.SetLoading(true, "Loading connection info...")
+		return a, a.loadConnectionInfo(msg.InstanceID)
 
-	case msg.String() == ":":
-		a.mode = ModeCommand
-		a.commandInput.SetValue("")
-		a.commandInput.Focus()
-		return a, textinput.Blink
+	// Lambda actions
+	case *handlers.PublishVersionAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to publish a new version of:\n\n%s\n\n"+
+				"The current $LATEST code and configuration will be frozen as the next version number.",
+			msg.FunctionName,
+		))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	case msg.String() == "p":
-		a.selector.ShowProfiles(a.profiles, a.clientMgr.Profile())
+	case *handlers.UpdateAliasAction:
+		message := fmt.Sprintf("You are about to point the alias:\n\n%s:%s\n\nat another version. It currently points at version %s.",
+			msg.FunctionName, msg.AliasName, msg.CurrentVersion)
+		if msg.Weighted {
+			message += "\nThe alias' weighted routing will be removed."
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.RequireInput(fmt.Sprintf("Version (1-%d)", msg.LatestVersion), msg.CurrentVersion, 1, msg.LatestVersion)
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case msg.String() == "R":
-		a.selector.ShowRegions(a.regions, a.clientMgr.Region())
+	case *handlers.SetReservedConcurrencyAction:
+		current := ""
+		if msg.HasReserved {
+			current = strconv.Itoa(int(msg.Reserved))
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to change the reserved concurrency of:\n\n%s\n\n"+
+				"Reserving 0 throttles every invocation. Leave empty to remove the reservation.",
+			msg.FunctionName,
+		))
+		a.confirmDialog.RequireInput(fmt.Sprintf("Reserved concurrency (0-%d)", msg.Max), current, 0, int(msg.Max))
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case msg.String() == "?":
-		a.footer.SetMessage("q:quit  ::command  p:profiles  R:regions  ':bookmarks  :users :roles :policies :logs", false)
+	// Auto Scaling actions
+	case *handlers.SetDesiredCapacityAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to change the desired capacity of:\n\n%s\n\n"+
+				"Instances are launched or terminated to match, and scaling policies may change it again.",
+			msg.GroupName,
+		))
+		a.confirmDialog.RequireInput(fmt.Sprintf("Desired capacity (%d-%d)", msg.Min, msg.Max), strconv.Itoa(int(msg.Desired)), int(msg.Min), int(msg.Max))
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case msg.String() == "'":
-		// Show bookmarks from home
-		return a, a.bookmarkSelector.Show()
-	}
+	case *handlers.StartInstanceRefreshAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to start an instance refresh of:\n\n%s\n\n"+
+				"Its %d instances are replaced on a rolling basis, keeping 90%% healthy.",
+			msg.GroupName, msg.Instances,
+		))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	return a, nil
-}
+	// KMS actions
+	case *handlers.ViewKeyPolicyAction:
+		a.footer.SetLoading(true, "Loading key policy...")
+		return a, a.loadKeyPolicy(msg.KeyID, msg.KeyName)
 
-func (a *App) handleCommandInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
-		a.mode = ModeNormal
-		a.commandInput.Blur()
-		a.commandInput.SetValue("")
-		a.autocomplete.Update("")
+	case *handlers.NavigateToKMSGrantsAction:
+		handler := handlers.NewKMSGrantsHandlerForKey(
+			a.clientMgr.KMS(),
+			a.clientMgr.Region(),
+			msg.KeyID,
+			msg.KeyName,
+		)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("KMS", "Keys", msg.KeyName, "Grants")
+		a.header.SetContext("KMS")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading grants...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.ToggleKeyRotationAction:
+		message := fmt.Sprintf("You are about to enable automatic rotation of:\n\n%s\n\n"+
+			"New key material is generated every year. Data encrypted earlier can still be decrypted.", msg.KeyName)
+		if !msg.Enable {
+			message = fmt.Sprintf("You are about to disable automatic rotation of:\n\n%s\n\n"+
+				"The current key material is kept until rotation is enabled again.", msg.KeyName)
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case "enter":
-		cmd := a.commandInput.Value()
-		a.mode = ModeNormal
-		a.commandInput.Blur()
-		a.commandInput.SetValue("")
-		a.autocomplete.Update("")
-		return a.executeCommand(cmd)
+	case *handlers.ScheduleKeyDeletionAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to schedule the deletion of the key:\n\n%s\n\n"+
+				"The key is disabled right away and deleted when the waiting period ends.\n"+
+				"Data encrypted with it can't be decrypted once it is deleted.",
+			msg.KeyName,
+		))
+		a.confirmDialog.RequireInput("Waiting period (days, 7-30)", "30", 7, 30)
+		a.requireTypedName("delete", msg.KeyName)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	case "tab":
-		// Cycle through autocomplete suggestions
-		if a.autocomplete.HasSuggestions() {
-			a.autocomplete.Next()
-			selected := a.autocomplete.Selected()
-			if selected != "" {
-				a.commandInput.SetValue(selected)
-			}
-		}
+	case *handlers.CancelKeyDeletionAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to cancel the deletion of:\n\n%s\n\n"+
+				"The key stays disabled until it is enabled again.",
+			msg.KeyName,
+		))
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case "shift+tab":
-		// Cycle backwards through autocomplete suggestions
-		if a.autocomplete.HasSuggestions() {
-			a.autocomplete.Previous()
-			selected := a.autocomplete.Selected()
-			if selected != "" {
-				a.commandInput.SetValue(selected)
-			}
+	// Amazon MQ actions
+	case *handlers.RebootBrokerAction:
+		message := fmt.Sprintf("You are about to reboot the broker:\n\n%s\n\n", msg.BrokerName)
+		if msg.DeploymentMode == "SINGLE_INSTANCE" {
+			message += "This is a single-instance broker, it will be unavailable during the reboot."
+		} else {
+			message += "Clients will fail over between broker instances during the reboot."
 		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
-	}
-
-	var cmd tea.Cmd
-	a.commandInput, cmd = a.commandInput.Update(msg)
 
-	// Update autocomplete suggestions based on current input
-	a.autocomplete.Update(a.commandInput.Value())
+	// Image Builder actions
+	case *handlers.StartPipelineAction:
+		message := fmt.Sprintf("You are about to run the image pipeline:\n\n%s\n\n", msg.PipelineName)
+		message += "A new image version is built, tested and distributed as the pipeline is configured."
+		if msg.Schedule != "" {
+			message += fmt.Sprintf("\nThe schedule (%s) is not changed.", msg.Schedule)
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	return a, cmd
-}
+	case ImagePipelineStartedMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Started %s, building %s", msg.pipeline, path.Base(msg.imageARN)), false)
+		return a, tea.Batch(a.refreshList(), a.pollImageBuild(msg.pipeline, msg.imageARN, msg.started))
+
+	case ImageBuildStatusMsg:
+		image := msg.image
+		elapsed := time.Since(msg.started).Round(time.Second)
+		switch image.Status {
+		case "AVAILABLE":
+			a.footer.SetMessage(fmt.Sprintf("%s built %s in %s", msg.pipeline, image.Version, elapsed), false)
+			a.infoDialog.SetSize(a.width, a.height)
+			a.infoDialog.Show(fmt.Sprintf("Built %s %s", msg.pipeline, image.Version), handlers.ImageDetailMap(image))
+			return a, a.refreshList()
+		case "FAILED", "CANCELLED", "DELETED":
+			message := fmt.Sprintf("Build of %s %s: %s after %s", msg.pipeline, image.Version, strings.ToLower(image.Status), elapsed)
+			if image.Reason != "" {
+				message += ": " + image.Reason
+			}
+			a.footer.SetMessage(message, true)
+			return a, a.refreshList()
+		}
+		a.footer.SetMessage(fmt.Sprintf("Building %s %s: %s (%s)", msg.pipeline, image.Version, image.Status, elapsed), false)
+		return a, a.pollImageBuild(msg.pipeline, image.ARN, msg.started)
 
-func (a *App) executeCommand(input string) (tea.Model, tea.Cmd) {
-	parts := strings.Fields(input)
-	if len(parts) == 0 {
+	case ImageBuildErrorMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Image pipeline failed: %v", msg.err), true)
 		return a, nil
-	}
 
-	command := parts[0]
-	args := parts[1:]
+	// S3 bucket configuration actions
+	case *handlers.ToggleBucketVersioningAction:
+		message := fmt.Sprintf("Versioning of bucket %s is %s.\n\n", msg.Bucket, strings.ToLower(msg.Current))
+		if msg.Enable {
+			message += "Enable versioning? Every change keeps the previous version of an object,\n" +
+				"and versioning can only be suspended afterwards, never turned off."
+		} else {
+			message += "Suspend versioning? Existing versions are kept, new writes\n" +
+				"replace the current version."
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
 
-	switch command {
-	case "q", "quit", "exit":
-		return a, tea.Quit
+	case *handlers.EditBucketConfigAction:
+		a.footer.SetLoading(true, fmt.Sprintf("Loading %s rules...", handlers.BucketConfigTitle(msg.Config)))
+		return a, a.loadBucketConfig(msg)
 
-	case "home":
-		a.state = StateHome
-		a.breadcrumb.SetPath("Home")
-		a.header.SetContext("Home")
+	case BucketConfigLoadedMsg:
+		a.footer.SetLoading(false, "")
+		a.bucketConfig = msg.action
+		a.state = StateSecretEditor
+		a.secretEditor.SetDocument(msg.action.Bucket,
+			fmt.Sprintf("Editing %s rules: %s", handlers.BucketConfigTitle(msg.action.Config), msg.action.Bucket),
+			msg.document)
+		a.secretEditor.SetSize(a.width, a.calculateContentHeight())
 		return a, nil
 
-	case "profile":
-		if len(args) > 0 {
-			return a, a.switchProfile(args[0])
+	case *handlers.ApplyBucketConfigAction:
+		title := handlers.BucketConfigTitle(msg.Config)
+		message := fmt.Sprintf("You are about to replace the %s rules of bucket:\n\n%s\n\nwith %d rules.", title, msg.Bucket, msg.Rules)
+		if msg.Rules == 0 {
+			message = fmt.Sprintf("You are about to remove all %s rules from bucket:\n\n%s", title, msg.Bucket)
 		}
-		a.selector.ShowProfiles(a.profiles, a.clientMgr.Profile())
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.SetWidth(a.width)
 		return a, nil
 
-	case "region":
-		if len(args) > 0 {
-			return a, a.switchRegion(args[0])
+	case *handlers.SetBucketEncryptionAction:
+		current := "none"
+		switch {
+		case msg.KMSKeyID != "":
+			current = fmt.Sprintf("%s with key %s", msg.Algorithm, msg.KMSKeyID)
+		case msg.Algorithm != "":
+			current = msg.Algorithm
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"Default encryption of bucket %s: %s\n\n"+
+				"Enter a KMS key ID, alias or ARN to use SSE-KMS, or leave it empty for SSE-S3.\n"+
+				"Only new objects are affected, existing objects keep their encryption.",
+			msg.Bucket, current,
+		))
+		a.confirmDialog.RequireTextInput("KMS key", msg.KMSKeyID, "empty for SSE-S3 (AES256)", 2048)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case S3BucketOperationSuccessMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(msg.message, false)
+		if a.bucketConfig != nil {
+			a.bucketConfig = nil
+			a.state = StateResourceList
+		}
+		return a, a.refreshList()
+
+	case S3BucketOperationErrorMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		return a, nil
+
+	// S3 actions
+	case *handlers.NavigateToS3ObjectsAction:
+		handler := handlers.NewS3ObjectsHandler(
+			a.clientMgr.S3(),
+			a.clientMgr.Region(),
+			msg.Bucket,
+			msg.Prefix,
+		)
+		crumbs := []string{"S3", "Buckets", msg.Bucket}
+		if msg.Prefix != "" {
+			crumbs = append(crumbs, strings.Split(strings.TrimSuffix(msg.Prefix, "/"), "/")...)
+		}
+		a.state = StateResourceList
+		a.breadcrumb.SetPath(crumbs...)
+		a.header.SetContext("S3")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading objects...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case *handlers.DeleteObjectAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to delete the object:\n\ns3://%s/%s\n\n"+
+				"Unless the bucket is versioned, this action cannot be undone.",
+			msg.Bucket, msg.Key,
+		))
+		a.requireTypedName("delete", path.Base(msg.Key))
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.DownloadPrefixAction:
+		if a.s3Transferring {
+			a.footer.SetMessage("A transfer is already running", true)
+			return a, nil
+		}
+		a.footer.SetLoading(true, "Counting objects...")
+		return a, a.previewS3Download(msg.Bucket, msg.Prefix)
+
+	case S3DownloadPreviewMsg:
+		a.footer.SetLoading(false, "")
+		if msg.err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Failed to list objects: %v", msg.err), true)
+			return a, nil
+		}
+		preview := msg.preview
+		if len(preview.Objects) == 0 {
+			a.footer.SetMessage(fmt.Sprintf("Nothing to download under s3://%s/%s", preview.Bucket, preview.Prefix), true)
+			return a, nil
+		}
+
+		message := fmt.Sprintf("You are about to download:\n\ns3://%s/%s\n\n%d objects, %s (largest %s)",
+			preview.Bucket, preview.Prefix, len(preview.Objects),
+			handlers.FormatBytes(preview.TotalBytes), handlers.FormatBytes(preview.Largest))
+		if preview.Archived > 0 {
+			message += fmt.Sprintf("\n%d archived objects (Glacier, Deep Archive) are left out.", preview.Archived)
+		}
+		message += "\n\nKeys are kept as paths under the directory. Files already there with the same\n" +
+			"size are skipped, so an interrupted download resumes when started again."
+
+		defaultDir := preview.Bucket
+		if cwd, err := os.Getwd(); err == nil {
+			defaultDir = filepath.Join(cwd, preview.Bucket)
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = preview
+		a.confirmDialog.SetMessage(message)
+		a.confirmDialog.RequireTextInput("Download to", defaultDir, "directory", 256)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case S3DownloadProgressMsg:
+		if msg.progress.TotalObjects > 0 {
+			a.footer.SetLoading(true, fmt.Sprintf("Downloading %d/%d objects, %s of %s...",
+				msg.progress.Objects, msg.progress.TotalObjects,
+				handlers.FormatBytes(msg.progress.Bytes), handlers.FormatBytes(msg.progress.TotalBytes)))
+		}
+		return a, waitForS3Transfer(msg.events)
+
+	case S3DownloadDoneMsg:
+		a.s3Transferring = false
+		a.footer.SetLoading(false, "")
+		result := msg.result
+		summary := fmt.Sprintf("Downloaded %d objects (%s in %s) to %s",
+			result.Downloaded, handlers.FormatBytes(result.Bytes), result.Elapsed.Round(time.Second), msg.dir)
+		if result.Skipped > 0 {
+			summary += fmt.Sprintf(", %d already present", result.Skipped)
+		}
+		if len(result.Failed) == 0 {
+			a.footer.SetMessage(summary, false)
+			return a, nil
+		}
+
+		failed := make(map[string]interface{}, len(result.Failed))
+		for key, err := range result.Failed {
+			failed[key] = err.Error()
+		}
+		a.footer.SetMessage(fmt.Sprintf("%s, %d failed (start it again to retry)", summary, len(result.Failed)), true)
+		a.infoDialog.SetSize(a.width, a.height)
+		a.infoDialog.Show("Failed downloads", failed)
+		return a, nil
+
+	case *handlers.DownloadObjectAction:
+		if a.s3Transferring {
+			a.footer.SetMessage("A transfer is already running", true)
+			return a, nil
+		}
+		defaultPath := path.Base(msg.Key)
+		if cwd, err := os.Getwd(); err == nil {
+			defaultPath = filepath.Join(cwd, defaultPath)
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"You are about to download:\n\ns3://%s/%s\n\nAn existing file at the path is replaced.",
+			msg.Bucket, msg.Key,
+		))
+		a.confirmDialog.RequireTextInput("Save to", defaultPath, "file", 256)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.RestoreObjectAction:
+		a.objectRestore.SetSize(a.width, a.height)
+		return a, a.objectRestore.Show(msg)
+
+	case components.RestoreObjectClosedMsg:
+		return a, nil
+
+	case components.RestoreObjectConfirmedMsg:
+		a.mode = ModeConfirm
+		a.pendingAction = msg.Request
+		a.confirmDialog.SetMessage(msg.Request.Summary())
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case *handlers.ChangeStorageClassAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"s3://%s/%s is in %s.\n\n"+
+				"The object is copied onto itself in the new class, keeping its metadata and tags.\n"+
+				"In versioned buckets the copy is a new version and the old one is kept.\n"+
+				"Classes: %s",
+			msg.Bucket, msg.Key, msg.Current, strings.Join(handlers.S3StorageClasses, ", "),
+		))
+		a.confirmDialog.RequireTextInput("Storage class", "", "e.g. STANDARD_IA", 20)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case S3ObjectOperationSuccessMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(msg.message, false)
+		return a, a.refreshList()
+
+	case S3ObjectOperationErrorMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		return a, nil
+
+	case *handlers.UploadFileAction:
+		if a.s3Transferring {
+			a.footer.SetMessage("A transfer is already running", true)
+			return a, nil
+		}
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(fmt.Sprintf(
+			"Upload a local file to:\n\ns3://%s/%s\n\n"+
+				"The object is named after the file. An object with the same name is overwritten.",
+			msg.Bucket, msg.Prefix,
+		))
+		a.confirmDialog.RequireTextInput("File", "", "path to a local file", 256)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case S3TransferProgressMsg:
+		if msg.progress.TotalBytes > 0 {
+			a.footer.SetLoading(true, fmt.Sprintf("%s %s, %s of %s (%d%%)...",
+				msg.verb, path.Base(msg.key),
+				handlers.FormatBytes(msg.progress.Bytes), handlers.FormatBytes(msg.progress.TotalBytes),
+				msg.progress.Bytes*100/msg.progress.TotalBytes))
+		}
+		return a, a.forList(waitForS3Transfer(msg.events))
+
+	case S3TransferDoneMsg:
+		a.s3Transferring = false
+		a.footer.SetLoading(false, "")
+		if msg.err != nil {
+			a.footer.SetMessage(msg.err.Error(), true)
+			return a, nil
+		}
+		result := msg.result
+		if !msg.upload {
+			a.footer.SetMessage(fmt.Sprintf("Downloaded s3://%s/%s (%s in %s) to %s",
+				result.Bucket, result.Key, handlers.FormatBytes(result.Bytes), result.Elapsed.Round(time.Second), result.Path), false)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Uploaded %s (%s in %s) to s3://%s/%s",
+			result.Path, handlers.FormatBytes(result.Bytes), result.Elapsed.Round(time.Second), result.Bucket, result.Key), false)
+		if _, ok := a.targetList().Handler().(*handlers.S3ObjectsHandler); ok {
+			return a, a.refreshList()
+		}
+		return a, nil
+
+	// S3 Bucket actions
+	case *handlers.ViewBucketPolicyAction:
+		a.footer.SetLoading(true, "Loading bucket policy...")
+		return a, a.loadBucketPolicy(msg.BucketName)
+
+	case *handlers.ExecRequestAction:
+		// For now, auto-select first container (can add picker later)
+		container := msg.Containers[0]
+		if len(msg.Containers) > 1 {
+			a.footer.SetMessage(fmt.Sprintf("Multiple containers found, using: %s", container.Name), false)
+		}
+		return a, a.executeECSExec(msg.ClusterARN, msg.TaskARN, container)
+
+	case ecsExecStartedMsg:
+		return a, a.joinECSExec(msg)
+
+	case *handlers.ContainerInsightsAction:
+		return a.openContainerInsights(msg)
+
+	case *handlers.ConsoleOutputAction:
+		title := "Console output " + msg.InstanceID
+		if !msg.CapturedAt.IsZero() {
+			title += " (captured " + msg.CapturedAt.Local().Format("2006-01-02 15:04:05") + ")"
+		}
+		a.infoDialog.SetSize(a.width, a.height)
+		a.infoDialog.ShowText(title, msg.Output)
+		return a, nil
+
+	case *handlers.ShowUserDataAction:
+		a.infoDialog.SetSize(a.width, a.height)
+		a.infoDialog.ShowText("User data "+msg.Source, msg.UserData)
+		return a, nil
+
+	case *handlers.ConsoleScreenshotAction:
+		path, err := utils.NewExporter(".").ExportImage(msg.Image, msg.InstanceID, "console")
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Saving the console screenshot failed: %v", err), true)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Console screenshot saved to %s", path), false)
+		return a, nil
+
+	case *handlers.StopTaskAction:
+		a.mode = ModeConfirm
+		a.pendingAction = msg
+		a.confirmDialog.SetMessage(stopTaskMessage(msg))
+		a.confirmDialog.RequireTextInput("Reason", msg.DefaultReason(), "shown in the stopped task's details", 255)
+		a.confirmDialog.SetWidth(a.width)
+		return a, nil
+
+	case ECSTaskOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case ECSTaskOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case ecsExecFinishedMsg:
+		a.ecsExecFinished(msg)
+		return a, nil
+
+	case views.ActionErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Action failed: %v", msg.Error), true)
+		return a, nil
+
+	// Log tail messages
+	case views.LogTailEventsMsg, views.LogTailTickMsg:
+		var cmd tea.Cmd
+		a.logTail, cmd = a.logTail.Update(msg)
+		return a, cmd
+
+	// Dashboard widget refreshes
+	case views.DashboardWidgetMsg, views.DashboardTickMsg:
+		var cmd tea.Cmd
+		a.dashboard, cmd = a.dashboard.Update(msg)
+		return a, cmd
+
+	// AWS CLI command output
+	case releasesFetchedMsg:
+		return a.releasesFetched(msg)
+
+	case views.CommandOutputMsg:
+		var cmd tea.Cmd
+		a.commandOutput, cmd = a.commandOutput.Update(msg)
+		return a, cmd
+
+	case *handlers.TailLogsAction:
+		sources := make([]views.LogTailSource, 0, len(msg.Targets))
+		for _, target := range msg.Targets {
+			sources = append(sources, views.LogTailSource{
+				Label:  target.Label,
+				Group:  target.Group,
+				Stream: target.Stream,
+				Client: a.clientMgr.CloudWatchLogsForRegion(target.Region),
+			})
+		}
+		a.logTail.SetSize(a.width, a.height)
+		return a, a.logTail.Show(msg.Title, sources)
+
+	case *handlers.ViewFlowLogsAction:
+		// A configured log group wins over the ones found from the flow logs
+		groups := msg.LogGroups
+		if a.config.FlowLogGroup != "" {
+			groups = []string{a.config.FlowLogGroup}
+		}
+		if len(groups) == 0 {
+			a.footer.SetMessage(fmt.Sprintf("No flow logs to CloudWatch Logs cover %s, set flow_log_group in the config", msg.Title), true)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Finding flow log streams for %s...", msg.Title), false)
+		return a, a.findFlowLogStreams(msg, groups)
+
+	case *handlers.ReachabilityEndpointAction:
+		source := a.reachabilitySource
+		switch {
+		case source != nil && source.ResourceID == msg.ResourceID:
+			a.reachabilitySource = nil
+			a.footer.SetMessage("Reachability source cleared", false)
+			return a, nil
+		case source == nil || source.Region != msg.Region:
+			a.reachabilitySource = msg
+			a.footer.SetMessage(fmt.Sprintf("Marked %s as the path source, press 'P' on the destination", msg.ResourceID), false)
+			return a, nil
+		}
+		a.reachabilitySource = nil
+		a.footer.SetLoading(true, fmt.Sprintf("Analyzing path from %s to %s...", source.ResourceID, msg.ResourceID))
+		return a, tea.Batch(
+			a.resourceList.OpenDetail(msg.ResourceID),
+			a.analyzeReachability(source.ResourceID, msg.ResourceID),
+		)
+
+	case ReachabilityAnalyzedMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(msg.verdict, false)
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(views.ResourceDetailLoadedMsg{
+			ResourceID: msg.destination,
+			Details:    msg.data,
+		})
+		return a, cmd
+
+	case ReachabilityErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Reachability analysis failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		a.resourceList.CancelDetail(msg.destination)
+		return a, nil
+
+	case ConfigHistoryLoadedMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("%d configuration snapshots of %s", msg.snapshots, msg.name), false)
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(views.ResourceDetailLoadedMsg{
+			ResourceID: msg.resourceID,
+			Details:    msg.data,
+		})
+		return a, cmd
+
+	case ConfigHistoryErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Configuration history failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		a.resourceList.CancelDetail(msg.resourceID)
+		return a, nil
+
+	// Diff messages
+	case views.DiffMarkedMsg:
+		if msg.Cleared {
+			a.footer.SetMessage("Diff mark cleared", false)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Marked %s for diff, press '=' on another resource to compare", msg.Name), false)
+		}
+		return a, nil
+
+	case views.DiffLoadedMsg:
+		if msg.Error != nil {
+			a.footer.SetMessage(fmt.Sprintf("Failed to load diff: %v", msg.Error), true)
+			return a, nil
+		}
+		a.footer.ClearMessage()
+		a.diffView.SetSize(a.width, a.height)
+		a.diffView.Show(msg.LeftName, msg.RightName, msg.Left, msg.Right)
+		return a, nil
+
+	// Secret operation messages
+	case SecretLoadedMsg:
+		// Show secret value in detail view (could enhance this with a modal)
+		a.footer.SetMessage(fmt.Sprintf("Secret value: %s", msg.value), false)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case SecretLoadedForEditMsg:
+		// Enter editor mode
+		a.state = StateSecretEditor
+		a.secretEditor.SetSecret(msg.id, msg.name, msg.value)
+		contentHeight := a.calculateContentHeight()
+		a.secretEditor.SetSize(a.width, contentHeight)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case SecretSavedMsg:
+		// Return to list view
+		a.state = StateResourceList
+		a.footer.SetMessage("Secret updated successfully", false)
+		a.footer.SetLoading(false, "")
+		// Refresh the list
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case SecretSaveErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Failed to save: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case SecretLoadErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Failed to load: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		a.mode = ModeNormal
+		a.pendingAction = nil
+		return a, nil
+
+	case SecretCreatedMsg:
+		a.state = StateResourceList
+		a.footer.SetMessage(fmt.Sprintf("Secret '%s' created successfully", msg.secretName), false)
+		a.footer.SetLoading(false, "")
+		a.secretCreator.Reset()
+		// Refresh the list
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case SecretCreateErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Failed to create secret: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case SecretDeletedMsg:
+		a.footer.SetMessage(fmt.Sprintf("Secret '%s' scheduled for deletion", msg.secretID), false)
+		a.footer.SetLoading(false, "")
+		// Refresh the list
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case SecretDeleteErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Failed to delete secret: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	// IAM User data messages
+	case UserDataLoadedMsg:
+		a.footer.SetLoading(false, "")
+		a.infoDialog.SetSize(a.width, a.height)
+		a.infoDialog.Show(msg.title, msg.data)
+		return a, nil
+
+	case UserDataErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Failed to load data: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	// EC2 Instance operation messages
+	case EC2InstanceOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		// Refresh the list to show updated state
+		return a, a.refreshList()
+
+	case EC2InstanceOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case components.TestEventInvokeMsg:
+		a.footer.SetLoading(true, fmt.Sprintf("Invoking %s...", msg.Function))
+		return a, a.invokeLambdaFunction(msg)
+
+	case components.TestEventSaveMsg:
+		if err := a.testEventStore.Save(msg.Function, msg.Name, msg.Payload, msg.Shared); err != nil {
+			a.testEventPicker.SetError(err.Error())
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Saved test event %s", msg.Name), false)
+		return a, a.showTestEventPicker(msg.Function, msg.Name)
+
+	case components.TestEventPickerClosedMsg:
+		return a, nil
+
+	case RouteInvokedMsg:
+		a.footer.SetLoading(false, "")
+		a.infoDialog.SetSize(a.width, a.height)
+		a.infoDialog.Show(msg.title, msg.data)
+		return a, nil
+
+	case RouteInvokeErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Invoke failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case ResourceDeletedMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case ResourceDeleteErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Delete failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case FindingsUpdatedMsg:
+		a.footer.SetLoading(false, "")
+		if len(msg.failures) > 0 {
+			a.footer.SetMessage(fmt.Sprintf("Set %d findings to %s, %d failed: %s", msg.updated, msg.status, len(msg.failures), strings.Join(msg.failures, "; ")), true)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Set %d findings to %s", msg.updated, msg.status), false)
+		}
+		return a, a.refreshList()
+
+	case FindingsUpdateErrorMsg:
+		a.footer.SetLoading(false, "")
+		a.footer.SetMessage(fmt.Sprintf("Updating findings failed: %v", msg.err), true)
+		return a, nil
+
+	case CleanupDeletedMsg:
+		a.footer.SetLoading(false, "")
+		if len(msg.failures) > 0 {
+			a.footer.SetMessage(fmt.Sprintf("Deleted %d, %d failed: %s", msg.deleted, len(msg.failures), strings.Join(msg.failures, "; ")), true)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Deleted %d unused resources", msg.deleted), false)
+		}
+		return a, a.refreshList()
+
+	case LambdaOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case LambdaOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case MQBrokerOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case MQBrokerOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case AutoScalingOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case AutoScalingOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case SecretRotationOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case SecretRotationOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Rotation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case SecretVersionOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case SecretVersionOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case KMSOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case KMSOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case ELBTargetOperationSuccessMsg:
+		a.footer.SetMessage(msg.message, false)
+		a.footer.SetLoading(false, "")
+		return a, a.refreshList()
+
+	case ELBTargetOperationErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Operation failed: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	// DynamoDB Item operation messages
+	case ItemLoadedForEditMsg:
+		// Open the item editor with the item's typed attributes
+		a.itemEditor.SetSize(a.width, a.height)
+		a.itemEditor.Show(msg.itemID, fmt.Sprintf("Editing item %s in %s", msg.itemKey, msg.tableName), msg.item)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case components.ItemEditorSavedMsg:
+		a.footer.SetLoading(true, "Saving item...")
+		return a, a.saveItem(msg.ItemID, msg.Item)
+
+	case components.ItemEditorClosedMsg:
+		return a, nil
+
+	case ItemSavedMsg:
+		// Return to list view
+		a.itemEditor.Hide()
+		a.footer.SetMessage("Item updated successfully", false)
+		a.footer.SetLoading(false, "")
+		// Refresh the list
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case ItemSaveErrorMsg:
+		// The editor stays open with the error, so the item can be fixed
+		if a.itemEditor.IsActive() {
+			a.itemEditor.SetError(msg.err)
+		}
+		a.footer.SetMessage(fmt.Sprintf("Failed to save item: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case ItemDeletedMsg:
+		a.footer.SetMessage("Item deleted successfully", false)
+		a.footer.SetLoading(false, "")
+		// Refresh the list
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case ItemDeleteErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Failed to delete item: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+
+	case ItemLoadErrorMsg:
+		a.footer.SetMessage(fmt.Sprintf("Failed to load item: %v", msg.err), true)
+		a.footer.SetLoading(false, "")
+		return a, nil
+	}
+
+	// Route to resource list if in that state
+	if a.state == StateResourceList {
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return a, tea.Batch(cmds...)
+}
+
+func (a *App) calculateContentHeight() int {
+	if a.height == 0 {
+		return 0
+	}
+	// Header (7 lines) + breadcrumb (1 line) + footer (1 line) = 9 lines
+	return a.height - 9
+}
+
+func (a *App) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	msg, ok := a.translateKey(msg)
+	if !ok {
+		return a, nil
+	}
+
+	// L logs in again while an operation waits to be retried on expired credentials
+	if a.expired != nil && msg.String() == "L" {
+		return a, a.reloginExpired()
+	}
+
+	// If in resource list state, route navigation to resource list first
+	if a.state == StateResourceList {
+		// The key after y belongs to the list, whatever it does elsewhere
+		if a.resourceList.YankPending() {
+			var cmd tea.Cmd
+			a.resourceList, cmd = a.resourceList.Update(msg)
+			return a, cmd
+		}
+
+		switch msg.String() {
+		case "esc", "h":
+			// If detail is open, close it first
+			if a.resourceList.HasOpenDetail() {
+				a.resourceList.CloseDetail()
+				return a, nil
+			}
+			// Leave the other list of a split on its own
+			if a.split != nil {
+				a.closeFocusedList()
+				return a, nil
+			}
+			// Go back to home
+			a.state = StateHome
+			a.breadcrumb.SetPath("Home")
+			a.header.SetContext("Home")
+			a.footer.ClearPagination()
+			a.footer.ClearHandlerActions()
+			return a, nil
+		case "q":
+			return a, tea.Quit
+		case "ctrl+w":
+			a.focusOtherList()
+			return a, nil
+		case ":":
+			a.mode = ModeCommand
+			a.commandInput.SetValue("")
+			a.commandInput.Focus()
+			a.historyRecall.Reset()
+			return a, textinput.Blink
+		case "m":
+			// Bookmark current resource
+			if res := a.resourceList.GetSelectedResource(); res != nil {
+				handler := a.resourceList.Handler()
+				return a, components.AddBookmark(
+					a.bookmarkStore,
+					res.GetName(),
+					handler.ResourceType(),
+					res.GetID(),
+					res.GetARN(),
+					a.clientMgr.Region(),
+					a.clientMgr.Profile(),
+				)
+			}
+			return a, nil
+		case "'":
+			// Show bookmarks
+			return a, a.bookmarkSelector.Show()
+		case "|":
+			return a, a.showOpenWith()
+		case "~":
+			return a, a.showConfigHistory()
+		}
+
+		// Route to resource list
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		return a, cmd
+	}
+
+	// Home state key handling
+	switch {
+	case msg.String() == "q" || msg.String() == "ctrl+c":
+		return a, tea.Quit
+
+	case msg.String() == ":":
+		a.mode = ModeCommand
+		a.commandInput.SetValue("")
+		a.commandInput.Focus()
+		a.historyRecall.Reset()
+		return a, textinput.Blink
+
+	case msg.String() == "p":
+		return a, a.showProfiles()
+
+	case msg.String() == "R":
+		a.selector.ShowRegions(a.regions, a.clientMgr.Region())
+		return a, nil
+
+	case msg.String() == "?":
+		a.infoDialog.ShowText("Commands and Keys", homeHelp)
+		return a, nil
+
+	case msg.String() == "'":
+		// Show bookmarks from home
+		return a, a.bookmarkSelector.Show()
+
+	case msg.String() == "E":
+		a.errorPanel.Show(a.footer.Errors())
+		return a, nil
+	}
+
+	return a, nil
+}
+
+func (a *App) handleCommandInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.historyRecall.IsSearching() {
+		switch msg.String() {
+		case "ctrl+r":
+			a.historyRecall.Older()
+			return a, nil
+		case "esc", "ctrl+g", "ctrl+c":
+			a.commandInput.SetValue(a.historyRecall.Cancel())
+			a.commandInput.CursorEnd()
+			return a, nil
+		case "backspace":
+			a.historyRecall.Backspace()
+			return a, nil
+		case "enter":
+			a.commandInput.SetValue(a.historyRecall.Accept())
+		default:
+			if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+				a.historyRecall.Type(string(msg.Runes))
+				return a, nil
+			}
+			// Any other key takes the match to edit it, as in a shell
+			a.commandInput.SetValue(a.historyRecall.Accept())
+			a.commandInput.CursorEnd()
+		}
+	}
+
+	switch msg.String() {
+	case "esc":
+		a.mode = ModeNormal
+		a.commandInput.Blur()
+		a.commandInput.SetValue("")
+		a.autocomplete.Update("")
+		return a, nil
+
+	case "enter":
+		cmd := a.commandInput.Value()
+		a.mode = ModeNormal
+		a.commandInput.Blur()
+		a.commandInput.SetValue("")
+		a.autocomplete.Update("")
+		_ = a.historyRecall.Record(cmd) // History is a convenience, a failed save doesn't stop the command
+		return a.executeCommand(cmd)
+
+	case "up", "down":
+		var recalled string
+		var ok bool
+		if msg.String() == "up" {
+			recalled, ok = a.historyRecall.Previous(a.commandInput.Value())
+		} else {
+			recalled, ok = a.historyRecall.Next()
+		}
+		if ok {
+			a.commandInput.SetValue(recalled)
+			a.commandInput.CursorEnd()
+			a.autocomplete.Update("")
+		}
+		return a, nil
+
+	case "ctrl+r":
+		a.historyRecall.StartSearch(a.commandInput.Value())
+		a.autocomplete.Update("")
+		return a, nil
+
+	case "tab":
+		// Cycle through autocomplete suggestions
+		if a.autocomplete.HasSuggestions() {
+			a.autocomplete.Next()
+			selected := a.autocomplete.Selected()
+			if selected != "" {
+				a.commandInput.SetValue(selected)
+			}
+		}
+		return a, nil
+
+	case "shift+tab":
+		// Cycle backwards through autocomplete suggestions
+		if a.autocomplete.HasSuggestions() {
+			a.autocomplete.Previous()
+			selected := a.autocomplete.Selected()
+			if selected != "" {
+				a.commandInput.SetValue(selected)
+			}
+		}
+		return a, nil
+	}
+
+	var cmd tea.Cmd
+	a.commandInput, cmd = a.commandInput.Update(msg)
+
+	// Update autocomplete suggestions based on current input
+	a.autocomplete.Update(a.commandInput.Value())
+
+	return a, cmd
+}
+
+// executeBuiltin runs a built-in command, as typed after the colon
+func (a *App) executeBuiltin(input string) (tea.Model, tea.Cmd) {
+	// ":! <args>" runs the AWS CLI, so it is split with quoting rather than on whitespace
+	if trimmed := strings.TrimSpace(input); strings.HasPrefix(trimmed, "!") {
+		return a.runAWSCLI(strings.TrimPrefix(trimmed, "!"))
+	}
+
+	parts := strings.Fields(input)
+	if len(parts) == 0 {
+		return a, nil
+	}
+
+	command := parts[0]
+	args := parts[1:]
+
+	switch command {
+	case "q", "quit", "exit":
+		// :q with an expression is :jq, only on its own does it quit
+		if command == "q" && len(args) > 0 {
+			return a.queryResource(strings.TrimPrefix(strings.TrimSpace(input), command))
+		}
+		return a, tea.Quit
+
+	case "jq":
+		return a.queryResource(strings.TrimPrefix(strings.TrimSpace(input), command))
+
+	case "ro", "readonly":
+		if a.elevation != nil {
+			cmd := a.endElevation(config.AuditEnd)
+			a.footer.SetMessage("Elevation ended, read-only mode is back on", false)
+			return a, cmd
+		}
+		if a.config.RequireElevation {
+			a.footer.SetMessage("Read-only mode is required, use :elevate [minutes] to make changes", true)
+			return a, nil
+		}
+		if a.profileSwitch != nil {
+			// Applies once the switch ends, read-only stays on until then
+			a.profileSwitch.readOnlyBefore = !a.profileSwitch.readOnlyBefore
+			if a.profileSwitch.readOnlyBefore {
+				a.footer.SetMessage("Read-only mode stays on after the profile switch", false)
+			} else {
+				a.footer.SetMessage("Read-only mode goes off once the profile switch completes", false)
+			}
+			return a, nil
+		}
+		a.setReadOnly(!a.readOnly)
+		if a.readOnly {
+			a.footer.SetMessage("Read-only mode on, mutating actions are blocked", false)
+		} else {
+			a.footer.SetMessage("Read-only mode off", false)
+		}
+		return a, nil
+
+	case "elevate":
+		return a.requestElevation(args)
+
+	case "time":
+		relative := !utils.RelativeTimes()
+		if len(args) > 0 {
+			switch args[0] {
+			case "relative":
+				relative = true
+			case "absolute":
+				relative = false
+			default:
+				a.footer.SetMessage("Usage: :time [relative|absolute]", true)
+				return a, nil
+			}
+		}
+		utils.SetRelativeTimes(relative)
+		a.resourceList.RedrawDetail()
+		if a.split != nil {
+			a.split.list.RedrawDetail()
+		}
+		if relative {
+			a.footer.SetMessage("Showing relative timestamps", false)
+		} else {
+			a.footer.SetMessage(fmt.Sprintf("Showing absolute timestamps (%s)", utils.TimeLocation()), false)
+		}
+		return a, nil
+
+	case "split", "sp":
+		return a.openSplit(args)
+
+	case "only":
+		a.closeSplit()
+		return a, nil
+
+	case "home":
+		a.closeSplit()
+		a.state = StateHome
+		a.breadcrumb.SetPath("Home")
+		a.header.SetContext("Home")
+		return a, nil
+
+	case "profile":
+		if len(args) > 0 {
+			return a, a.switchProfile(args[0])
+		}
+		return a, a.showProfiles()
+
+	case "region":
+		if len(args) > 0 {
+			return a, a.switchRegion(args[0])
+		}
+		a.selector.ShowRegions(a.regions, a.clientMgr.Region())
+		return a, nil
+
+	case "users":
+		return a.navigateToResource("users", "IAM", "Users")
+
+	case "roles":
+		return a.navigateToResource("roles", "IAM", "Roles")
+
+	case "groups":
+		return a.navigateToResource("groups", "IAM", "Groups")
+
+	case "policies":
+		return a.navigateToResource("policies", "IAM", "Policies")
+
+	case "credreport":
+		return a.navigateToResource("credreport", "IAM", "Credential Report")
+
+	case "sg":
+		return a.navigateToResource("sg", "EC2", "Security Groups")
+
+	case "kms":
+		return a.navigateToResource("kms", "KMS", "Keys")
+
+	case "secrets":
+		return a.navigateToResource("secrets", "Secrets Manager", "Secrets")
+
+	case "secrets-rotation":
+		return a.navigateToResource("secrets-rotation", "Secrets Manager", "Rotation")
+
+	case "ec2", "instances":
+		return a.navigateToResource("ec2", "EC2", "Instances")
+
+	case "imds":
+		return a.navigateToResource("imds", "EC2", "IMDSv1 Instances")
+
+	case "launch-templates", "lt":
+		return a.navigateToResource("launch-templates", "EC2", "Launch Templates")
+
+	case "vpc", "vpcs":
+		return a.navigateToResource("vpc", "VPC", "VPCs")
+
+	case "subnets":
+		return a.navigateToResource("subnets", "VPC", "Subnets")
+
+	case "route-tables", "rtb":
+		return a.navigateToResource("route-tables", "VPC", "Route Tables")
+
+	case "nat":
+		return a.navigateToResource("nat", "VPC", "NAT Gateways")
+
+	case "igw":
+		return a.navigateToResource("igw", "VPC", "Internet Gateways")
+
+	case "eni", "enis":
+		return a.navigateToResource("eni", "VPC", "Network Interfaces")
+
+	case "asg":
+		return a.navigateToResource("asg", "Auto Scaling", "Groups")
+
+	case "elb", "alb", "nlb":
+		return a.navigateToResource("elb", "ELB", "Load Balancers")
+
+	case "rds":
+		return a.navigateToResource("rds", "RDS", "Instances")
+
+	case "rds-snapshots":
+		return a.navigateToResource("rds-snapshots", "RDS", "Snapshots")
+
+	case "rds-params", "rds-parameter-groups":
+		return a.navigateToResource("rds-params", "RDS", "Parameter Groups")
+
+	case "rds-options", "rds-option-groups":
+		return a.navigateToResource("rds-options", "RDS", "Option Groups")
+
+	case "ecs":
+		return a.navigateToResource("ecs", "ECS", "Clusters")
+
+	case "lambda":
+		return a.navigateToResource("lambda", "Lambda", "Functions")
+
+	case "logs":
+		return a.navigateToResource("logs", "CloudWatch Logs", "Log Groups")
+
+	case "sources":
+		return a.navigateToResource("sources", "CloudWatch", "Source Accounts")
+
+	case "s3":
+		return a.navigateToResource("s3", "S3", "Buckets")
+
+	case "dynamodb":
+		return a.navigateToResource("dynamodb", "DynamoDB", "Tables")
+
+	case "mq":
+		return a.navigateToResource("mq", "Amazon MQ", "Brokers")
+
+	case "imagebuilder", "pipelines":
+		return a.navigateToResource("imagebuilder", "Image Builder", "Pipelines")
+
+	case "connect":
+		return a.navigateToResource("connect", "Amazon Connect", "Instances")
+
+	case "pinpoint":
+		return a.navigateToResource("pinpoint", "Amazon Pinpoint", "Projects")
+
+	case "stacksets":
+		return a.navigateToResource("stacksets", "CloudFormation", "StackSets")
+
+	case "apigw", "apigateway":
+		return a.navigateToResource("apigw", "API Gateway", "APIs")
+
+	case "cost", "costs":
+		if len(args) >= 2 && args[0] == "tag" {
+			return a.navigateToCostByTag(args[1])
+		}
+		return a.navigateToResource("cost", "Cost Explorer", "By Service")
+
+	case "lookup":
+		if len(args) == 0 {
+			a.footer.SetMessage("Usage: :lookup <ip-or-dns-name>", true)
+			return a, nil
+		}
+		return a.navigateToLookup(args[0])
+
+	case "tagged":
+		return a.openTagged(args)
+
+	case "bookmarks":
+		return a.bookmarksCommand(args)
+
+	case "tail":
+		if len(args) == 0 {
+			a.footer.SetMessage("Usage: :tail <log-group-glob>..., e.g. :tail /aws/lambda/order-*", true)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Finding log groups matching %s...", strings.Join(args, " ")), false)
+		return a, a.tailLogGroups(args)
+
+	case "cleanup":
+		days := handlers.DefaultUnusedRoleDays
+		if len(args) > 0 {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				a.footer.SetMessage("Usage: :cleanup [days], flagging roles unused for that many days", true)
+				return a, nil
+			}
+			days = n
+		}
+		return a.navigateToCleanup(days)
+
+	case "changelog":
+		return a, a.openChangelog()
+
+	case "errors":
+		a.errorPanel.Show(a.footer.Errors())
+		return a, nil
+
+	case "theme":
+		a.switchTheme(args)
+		return a, nil
+
+	case "keys":
+		a.infoDialog.ShowText("Key Bindings", a.keyBindingsText())
+		return a, nil
+
+	case "aliases":
+		a.infoDialog.ShowText("Aliases", a.aliasesText())
+		return a, nil
+
+	case "recent":
+		return a.openRecent()
+
+	case "audit":
+		handler := handlers.NewAuditLogHandler(a.auditLog)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("Audit Log")
+		a.header.SetContext("Audit Log")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		if err := a.auditLog.LastError(); err != nil {
+			a.footer.SetMessage(fmt.Sprintf("The last change couldn't be written to the audit log: %v", err), true)
+		}
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case "orgs", "org":
+		handler := handlers.NewOrganizationsHandler(a.clientMgr.Organizations(), a.config.OrgAccessRole)
+		a.state = StateResourceList
+		a.breadcrumb.SetPath("Organization")
+		a.header.SetContext("Organization")
+		a.resourceList.SetHandler(handler)
+		a.footer.SetHandlerActions(a.handlerActions())
+		a.loading = true
+		a.footer.SetLoading(true, "Loading organization...")
+		a.sizeLists()
+		return a, a.resourceList.LoadResources(context.Background(), "")
+
+	case "advisor", "ta":
+		category := ""
+		if len(args) > 0 {
+			var err error
+			if category, err = handlers.ParseAdvisorCategory(args[0]); err != nil {
+				a.footer.SetMessage(err.Error(), true)
+				return a, nil
+			}
+		}
+		return a.navigateToAdvisor(category)
+
+	case "securityhub", "sechub":
+		filters, byControl, err := handlers.ParseSecurityHubArgs(args)
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("%v, e.g. :securityhub controls severity=critical,high status=failed standard=cis", err), true)
+			return a, nil
+		}
+		return a.navigateToSecurityHub(filters, byControl)
+
+	case "can":
+		if len(args) == 0 {
+			a.footer.SetMessage("Usage: :can <action> [resource-arn], e.g. :can s3:DeleteObject arn:aws:s3:::my-bucket/*", true)
+			return a, nil
+		}
+		resource := ""
+		if len(args) > 1 {
+			resource = args[1]
+		}
+		return a.navigateToPolicySearch(args[0], resource)
+
+	case "export":
+		if len(args) == 0 {
+			a.footer.SetMessage("Usage: :export json|yaml, or :export text|md [clip] for the table", true)
+			return a, nil
+		}
+		switch strings.ToLower(args[0]) {
+		case "text", "txt", "md", "markdown":
+			return a.exportTable(strings.ToLower(args[0]), len(args) > 1 && args[1] == "clip")
+		}
+		return a.exportCurrentResource(args[0])
+
+	case "assume":
+		if len(args) == 0 {
+			a.footer.SetMessage("Usage: :assume <role-arn> [external-id], or press A on a role in :roles", true)
+			return a, nil
+		}
+		role := &handlers.AssumeRoleAction{RoleName: args[0][strings.LastIndex(args[0], "/")+1:], RoleARN: args[0]}
+		if len(args) > 1 {
+			role.ExternalID = args[1]
+		}
+		return a, a.showSessionPolicies(role)
+
+	case "unassume":
+		if a.clientMgr.AssumedRole() == nil {
+			a.footer.SetMessage("No role assumed", true)
+			return a, nil
+		}
+		return a, a.dropAssumedRole()
+
+	case "whoami":
+		return a, a.loadWhoami()
+
+	case "sso", "sso-login":
+		if len(args) > 0 {
+			return a, a.ssoLogin(args[0])
+		}
+		return a, a.ssoLogin("")
+
+	case "dashboard", "dash":
+		return a.openDashboard(strings.Join(args, " "))
+
+	default:
+		a.footer.SetMessage(fmt.Sprintf("Unknown command: %s", command), true)
+		return a, nil
+	}
+}
+
+// runAWSCLI runs an AWS CLI command with the current profile and region, streaming its
+// output into a pane. A leading "aws" is optional.
+func (a *App) runAWSCLI(line string) (tea.Model, tea.Cmd) {
+	args, err := splitCommandLine(line)
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Invalid command: %v", err), true)
+		return a, nil
+	}
+	if len(args) > 0 && args[0] == "aws" {
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		a.footer.SetMessage("Usage: :! <aws cli command>, e.g. :! s3 ls", true)
+		return a, nil
+	}
+	if a.readOnly && !readOnlyCLICommand(args) {
+		a.footer.SetMessage("Only describe, list and get commands can run in read-only mode (:ro to toggle)", true)
+		return a, nil
+	}
+
+	title := "aws " + strings.Join(args, " ")
+	var onExit func(int, error)
+	if !readOnlyCLICommand(args) {
+		onExit = a.recordCommand(args)
+	}
+	return a, func() tea.Msg {
+		env, err := a.commandEnv()
+		if err != nil {
+			return awsCLIReadyMsg{err: err}
+		}
+		cmd := exec.Command("aws", args...)
+		// The pager would wait for input that never comes
+		cmd.Env = append(env, "AWS_PAGER=")
+		return awsCLIReadyMsg{title: title, cmd: cmd, onExit: onExit}
+	}
+}
+
+// awsCLIReadyMsg carries an AWS CLI command given the credentials in use, to run
+type awsCLIReadyMsg struct {
+	title  string
+	cmd    *exec.Cmd
+	onExit func(exitCode int, err error) // Records the command once it exits, if set
+	err    error
+}
+
+// credentialEnvVars are the variables that pick AWS credentials, left out of the
+// environment of external commands in favour of the credentials in use
+var credentialEnvVars = []string{
+	"AWS_PROFILE", "AWS_DEFAULT_PROFILE", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY",
+	"AWS_SESSION_TOKEN", "AWS_SECURITY_TOKEN", "AWS_CREDENTIAL_EXPIRATION",
+}
+
+// commandEnv returns awsCommandEnv within the request timeout. It may refresh expired
+// SSO, assumed-role or credential_process credentials over the network, so it is only
+// called from commands, never from Update.
+func (a *App) commandEnv() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.RequestTimeout())
+	defer cancel()
+	return a.awsCommandEnv(ctx)
+}
+
+// awsCommandEnv returns the environment for external commands, pointing the AWS CLI and SDKs
+// at the current region and handing them the credentials in use rather than the profile's
+// name, so they needn't resolve SSO, role chaining or a credential_process again
+func (a *App) awsCommandEnv(ctx context.Context) ([]string, error) {
+	credentials, err := a.clientMgr.CredentialsEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make([]string, 0, len(os.Environ())+len(credentials)+2)
+	for _, v := range os.Environ() {
+		name, _, _ := strings.Cut(v, "=")
+		if !slices.Contains(credentialEnvVars, name) {
+			env = append(env, v)
+		}
+	}
+	env = append(env,
+		fmt.Sprintf("AWS_REGION=%s", a.clientMgr.Region()),
+		fmt.Sprintf("AWS_DEFAULT_REGION=%s", a.clientMgr.Region()),
+	)
+	return append(env, credentials...), nil
+}
+
+// showOpenWith offers the open_with commands configured for the selected resource's type
+func (a *App) showOpenWith() tea.Cmd {
+	res := a.resourceList.GetSelectedResource()
+	if res == nil {
+		return nil
+	}
+
+	var options []components.OpenWithOption
+	for _, command := range a.config.OpenWith {
+		if command.AppliesTo(res.GetType()) {
+			options = append(options, components.OpenWithOption{Name: command.Name, Command: command.Command})
+		}
+	}
+	if len(options) == 0 {
+		a.footer.SetMessage(fmt.Sprintf("No open_with commands configured for %s", res.GetType()), true)
+		return nil
+	}
+
+	a.pendingOpenWith = res
+	return a.selector.ShowOpenWith(res.GetName(), options)
+}
+
+// openWithReadyMsg carries a described resource to run an open_with command on
+type openWithReadyMsg struct {
+	command app.OpenWithCommand
+	res     handlers.Resource
+	json    []byte
+	env     []string // Environment for the command, with the credentials in use
+}
+
+// openWithFinishedMsg is sent when an interactive open_with command exits
+type openWithFinishedMsg struct {
+	name string
+	file string
+	err  error
+}
+
+// prepareOpenWith describes the resource, as for copying its JSON, to pipe it to a command
+func (a *App) prepareOpenWith(command app.OpenWithCommand, res handlers.Resource) tea.Cmd {
+	handler := a.resourceList.Handler()
+	return func() tea.Msg {
+		details, err := handler.Describe(context.Background(), res.GetID())
+		if err != nil {
+			return messages.ErrorMsg{Error: err, Context: "open with " + command.Name}
+		}
+
+		data, err := json.MarshalIndent(details, "", "  ")
+		if err != nil {
+			return messages.ErrorMsg{Error: err, Context: "open with " + command.Name}
+		}
+		env, err := a.commandEnv()
+		if err != nil {
+			return messages.ErrorMsg{Error: err, Context: "open with " + command.Name}
+		}
+		return openWithReadyMsg{command: command, res: res, json: data, env: env}
+	}
+}
+
+// runOpenWith runs an open_with command with the resource's JSON on stdin and shows its
+// output, or hands it the terminal and a file holding the JSON when it is interactive
+func (a *App) runOpenWith(msg openWithReadyMsg) tea.Cmd {
+	env := append(msg.env,
+		fmt.Sprintf("AWS_TUI_RESOURCE_TYPE=%s", msg.res.GetType()),
+		fmt.Sprintf("AWS_TUI_RESOURCE_ID=%s", msg.res.GetID()),
+		fmt.Sprintf("AWS_TUI_RESOURCE_ARN=%s", msg.res.GetARN()),
+	)
+
+	cmd := exec.Command("sh", "-c", msg.command.Command)
+	cmd.Env = env
+
+	if !msg.command.Interactive {
+		cmd.Stdin = bytes.NewReader(msg.json)
+		a.commandOutput.SetSize(a.width, a.height)
+		return a.commandOutput.Run(fmt.Sprintf("%s: %s", msg.command.Name, msg.res.GetName()), cmd, nil)
+	}
+
+	file, err := os.CreateTemp("", "aws-tui-*.json")
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Failed to write resource JSON: %v", err), true)
+		return nil
+	}
+	_, err = file.Write(msg.json)
+	file.Close()
+	if err != nil {
+		os.Remove(file.Name())
+		a.footer.SetMessage(fmt.Sprintf("Failed to write resource JSON: %v", err), true)
+		return nil
+	}
+
+	cmd.Env = append(cmd.Env, fmt.Sprintf("AWS_TUI_JSON_FILE=%s", file.Name()))
+	name := msg.command.Name
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return openWithFinishedMsg{name: name, file: file.Name(), err: err}
+	})
+}
+
+// readOnlyCLICommand reports whether an AWS CLI command only reads, judged by its operation
+// name. The service and operation must come before any options.
+func readOnlyCLICommand(args []string) bool {
+	if len(args) < 2 {
+		return false
+	}
+	service, operation := args[0], args[1]
+	if service == "s3" {
+		return operation == "ls"
+	}
+	for _, prefix := range []string{"describe-", "list-", "get-"} {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCommandLine splits a command line into arguments, honouring single and double
+// quotes and backslash escapes so JSON and query arguments can be passed through
+func splitCommandLine(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+func (a *App) navigateToResource(shortcut string, breadcrumbParts ...string) (tea.Model, tea.Cmd) {
+	handler, ok := a.registry.Get(shortcut)
+	if !ok {
+		a.footer.SetMessage(fmt.Sprintf("Handler not found: %s", shortcut), true)
+		return a, nil
+	}
+
+	a.state = StateResourceList
+	a.breadcrumb.SetPath(breadcrumbParts...)
+
+	// Set header context to the first breadcrumb part (main resource category)
+	if len(breadcrumbParts) > 0 {
+		a.header.SetContext(breadcrumbParts[0])
+	}
+
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, fmt.Sprintf("Loading %s...", handler.ResourceName()))
+
+	// Update size
+	a.sizeLists()
+
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// navigateToCostByTag shows month-to-date spend grouped by the values of a tag key
+func (a *App) navigateToCostByTag(tagKey string) (tea.Model, tea.Cmd) {
+	handler := handlers.NewCostExplorerHandlerForTag(
+		a.clientMgr.CostExplorer(),
+		a.clientMgr.Region(),
+		tagKey,
+	)
+	a.state = StateResourceList
+	a.breadcrumb.SetPath("Cost Explorer", "By Tag", tagKey)
+	a.header.SetContext("Cost Explorer")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, "Loading costs...")
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// navigateToLookup searches EIPs, ENIs, load balancers and Route 53 for an address
+func (a *App) navigateToLookup(query string) (tea.Model, tea.Cmd) {
+	handler := handlers.NewLookupHandler(
+		a.clientMgr.EC2(),
+		a.clientMgr.ELBv2(),
+		a.clientMgr.Route53(),
+		a.clientMgr.Region(),
+		query,
+	)
+	a.state = StateResourceList
+	a.breadcrumb.SetPath("Lookup", query)
+	a.header.SetContext("Lookup")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, fmt.Sprintf("Looking up %s...", query))
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// navigateToCleanup reports security groups without network interfaces and roles unused
+// for the given days
+func (a *App) navigateToCleanup(days int) (tea.Model, tea.Cmd) {
+	handler := handlers.NewCleanupHandler(a.clientMgr.EC2(), a.clientMgr.IAM(), a.clientMgr.Region(), days)
+	a.state = StateResourceList
+	a.breadcrumb.SetPath("Cleanup", fmt.Sprintf("Unused %d+ days", days))
+	a.header.SetContext("Cleanup")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, "Checking for unused resources...")
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// navigateToAdvisor lists the Trusted Advisor checks of a category, or all of them
+func (a *App) navigateToAdvisor(category string) (tea.Model, tea.Cmd) {
+	handler := handlers.NewTrustedAdvisorChecksHandler(a.clientMgr.Support(), category)
+	a.state = StateResourceList
+	if category != "" {
+		a.breadcrumb.SetPath("Trusted Advisor", handler.CategoryName())
+	} else {
+		a.breadcrumb.SetPath("Trusted Advisor")
+	}
+	a.header.SetContext("Trusted Advisor")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, "Loading Trusted Advisor checks...")
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// navigateToSecurityHub lists the Security Hub findings matching filters, or their
+// controls when grouped
+func (a *App) navigateToSecurityHub(filters handlers.SecurityHubFilters, byControl bool) (tea.Model, tea.Cmd) {
+	var handler handlers.ResourceHandler
+	if byControl {
+		handler = handlers.NewSecurityHubControlsHandler(a.clientMgr.SecurityHub(), a.clientMgr.Region(), filters)
+		a.breadcrumb.SetPath("Security Hub", "Controls", handlers.DescribeSecurityHubFilters(filters))
+	} else {
+		handler = handlers.NewSecurityHubFindingsHandler(a.clientMgr.SecurityHub(), a.clientMgr.Region(), filters)
+		a.breadcrumb.SetPath("Security Hub", "Findings", handlers.DescribeSecurityHubFilters(filters))
+	}
+	a.state = StateResourceList
+	a.header.SetContext("Security Hub")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, "Loading Security Hub findings...")
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// navigateToPolicySearch lists the IAM policies with statements covering an action,
+// optionally on a resource
+func (a *App) navigateToPolicySearch(action, resource string) (tea.Model, tea.Cmd) {
+	handler := handlers.NewIAMPolicySearchHandler(a.clientMgr.IAM(), action, resource)
+	a.state = StateResourceList
+	if resource != "" {
+		a.breadcrumb.SetPath("IAM", "Policy Search", action, resource)
+	} else {
+		a.breadcrumb.SetPath("IAM", "Policy Search", action)
+	}
+	a.header.SetContext("IAM")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, "Scanning IAM policies...")
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// switchProfile loads a profile in the background. The current context stays in use, in
+// read-only mode, until the profile's credentials are checked; ctrl+x cancels the switch.
+func (a *App) switchProfile(profile string) tea.Cmd {
+	readOnlyBefore := a.readOnly
+	if a.profileSwitch != nil {
+		a.profileSwitch.cancel()
+		readOnlyBefore = a.profileSwitch.readOnlyBefore
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sw := &profileSwitch{profile: profile, cancel: cancel, readOnlyBefore: readOnlyBefore}
+	a.profileSwitch = sw
+	a.setReadOnly(true)
+	a.header.SetPendingProfile(profile)
+	a.footer.SetMessage(fmt.Sprintf("Switching to %s... ctrl+x to cancel", profile), false)
+
+	region := a.clientMgr.Region()
+	return func() tea.Msg {
+		prepared, err := a.clientMgr.Prepare(ctx, profile, region)
+		return profileSwitchedMsg{sw: sw, prepared: prepared, err: err}
+	}
+}
+
+// cancelProfileSwitch abandons the profile switch in progress, keeping the current context
+func (a *App) cancelProfileSwitch() {
+	sw := a.profileSwitch
+	if sw == nil {
+		return
+	}
+	sw.cancel()
+	a.endProfileSwitch()
+	a.macro = nil
+	a.pendingBookmark = nil
+	a.footer.SetMessage(fmt.Sprintf("Switch to %s cancelled, still on %s", sw.profile, a.clientMgr.Profile()), false)
+}
+
+// endProfileSwitch clears the pending switch and restores the read-only mode from before it
+func (a *App) endProfileSwitch() {
+	sw := a.profileSwitch
+	a.profileSwitch = nil
+	a.header.SetPendingProfile("")
+	a.setReadOnly(sw.readOnlyBefore)
+}
+
+// cancelListLoads stops the list's AWS calls and any query in flight, and the footer spinner
+// waiting on them
+func (a *App) cancelListLoads() {
+	a.resourceList.CancelLoads()
+	// The footer may be showing another operation's spinner, which is left running
+	if a.stopQuery() || a.loading {
+		a.loading = false
+		a.footer.SetLoading(false, "")
+	}
+}
+
+// switchRegionNow switches region before navigating to a resource in it, re-registering
+// the handlers for the new region
+func (a *App) switchRegionNow(region string) error {
+	if err := a.clientMgr.SwitchRegion(context.Background(), region); err != nil {
+		return err
+	}
+	a.header.SetRegion(region)
+	a.registerHandlers()
+	return nil
+}
+
+func (a *App) switchRegion(region string) tea.Cmd {
+	// Results from the old region would otherwise land in the list once it switches
+	a.cancelListLoads()
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := a.clientMgr.SwitchRegion(ctx, region); err != nil {
+			return messages.ErrorMsg{Error: err, Context: "switching region"}
+		}
+
+		return awsInitializedMsg{
+			profile:   a.clientMgr.Profile(),
+			region:    region,
+			accountID: "",
+		}
+	}
+}
+
+// sessionPolicies returns the built-in session policies followed by those in the config directory
+func (a *App) sessionPolicies() ([]awsadapter.SessionPolicy, error) {
+	policies := awsadapter.BuiltinSessionPolicies()
+	files, err := config.LoadSessionPolicies()
+	for _, file := range files {
+		policies = append(policies, awsadapter.SessionPolicy{
+			Name:        file.Name,
+			Description: "Inline policy from " + filepath.Join(config.SessionPoliciesDir(), file.Name+".json"),
+			Document:    file.Document,
+		})
+	}
+	return policies, err
+}
+
+// showSessionPolicies opens the session policy choice for assuming a role
+func (a *App) showSessionPolicies(role *handlers.AssumeRoleAction) tea.Cmd {
+	policies, err := a.sessionPolicies()
+	if err != nil {
+		a.footer.SetMessage(err.Error(), true)
+	}
+
+	options := make([]components.SessionPolicyOption, 0, len(policies))
+	for _, policy := range policies {
+		options = append(options, components.SessionPolicyOption{Name: policy.Name, Description: policy.Description})
+	}
+
+	a.pendingAssumeRole = role
+	return a.selector.ShowSessionPolicies(role.RoleName, options)
+}
+
+// assumeRoleTimeout bounds assuming a role, leaving time to type an MFA code for the
+// profile's credentials
+const assumeRoleTimeout = 2 * time.Minute
+
+// assumeRole switches every client to the role, scoped by the named session policy if set
+func (a *App) assumeRole(role *handlers.AssumeRoleAction, policyName string) tea.Cmd {
+	return func() tea.Msg {
+		assumed := awsadapter.AssumedRole{
+			RoleARN:     role.RoleARN,
+			SessionName: awsadapter.DefaultSessionName(),
+			ExternalID:  role.ExternalID,
+		}
+		if policyName != "" {
+			policies, err := a.sessionPolicies()
+			if err != nil {
+				return messages.ErrorMsg{Error: err, Context: "assuming role"}
+			}
+			for i := range policies {
+				if policies[i].Name == policyName {
+					assumed.Policy = &policies[i]
+				}
+			}
+			if assumed.Policy == nil {
+				return messages.ErrorMsg{Error: fmt.Errorf("session policy %s not found", policyName), Context: "assuming role"}
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), assumeRoleTimeout)
+		defer cancel()
+		if err := a.clientMgr.AssumeRole(ctx, assumed); err != nil {
+			return messages.ErrorMsg{Error: err, Context: "assuming role"}
+		}
+
+		accountID, _ := a.clientMgr.GetAccountID(ctx)
+		return awsInitializedMsg{
+			profile:   a.clientMgr.Profile(),
+			region:    a.clientMgr.Region(),
+			accountID: accountID,
+		}
+	}
+}
+
+// dropAssumedRole switches back to the profile's own credentials
+func (a *App) dropAssumedRole() tea.Cmd {
+	return func() tea.Msg {
+		a.clientMgr.DropAssumedRole()
+		accountID, _ := a.clientMgr.GetAccountID(context.Background())
+		return awsInitializedMsg{
+			profile:   a.clientMgr.Profile(),
+			region:    a.clientMgr.Region(),
+			accountID: accountID,
+		}
+	}
+}
+
+// syncAssumedRole shows the assumed role in the header, and keeps read-only mode on
+// while a read-only session policy scopes the session
+func (a *App) syncAssumedRole() {
+	role := a.clientMgr.AssumedRole()
+
+	readOnlyPolicy := role != nil && role.Policy != nil && role.Policy.ReadOnly
+	switch {
+	case readOnlyPolicy && !a.roleReadOnly:
+		a.readOnlyBeforeRole = a.readOnly
+		a.roleReadOnly = true
+		a.setReadOnly(true)
+	case !readOnlyPolicy && a.roleReadOnly:
+		a.roleReadOnly = false
+		a.setReadOnly(a.readOnlyBeforeRole)
+	}
+
+	if role == nil {
+		a.header.SetAssumedRole("", "")
+		return
+	}
+	name := role.RoleARN[strings.LastIndex(role.RoleARN, "/")+1:]
+	policy := ""
+	if role.Policy != nil {
+		policy = role.Policy.Name
+	}
+	a.header.SetAssumedRole(name, policy)
+}
+
+// exportCurrentResource exports the selected resource or list to a file
+func (a *App) exportCurrentResource(formatStr string) (tea.Model, tea.Cmd) {
+	if a.state != StateResourceList {
+		a.footer.SetMessage("Export is only available in resource list view", true)
+		return a, nil
+	}
+
+	var format utils.ExportFormat
+	switch strings.ToLower(formatStr) {
+	case "json":
+		format = utils.ExportJSON
+	case "yaml", "yml":
+		format = utils.ExportYAML
+	default:
+		a.footer.SetMessage(fmt.Sprintf("Unknown format: %s. Use json or yaml", formatStr), true)
+		return a, nil
+	}
+
+	handler := a.resourceList.Handler()
+	if handler == nil {
+		a.footer.SetMessage("No resource handler active", true)
+		return a, nil
+	}
+
+	// Get selected resource or export list
+	selected := a.resourceList.GetSelectedResource()
+	exporter := utils.NewExporter(".")
+
+	if selected != nil {
+		// Export single resource detail
+		ctx := context.Background()
+		details, err := handler.Describe(ctx, selected.GetID())
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Failed to get resource details: %v", err), true)
+			return a, nil
+		}
+
+		filepath, err := exporter.Export(details, handler.ResourceType(), selected.GetID(), format)
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Export failed: %v", err), true)
+			return a, nil
+		}
+
+		a.footer.SetMessage(fmt.Sprintf("Exported to %s", filepath), false)
+	} else {
+		a.footer.SetMessage("No resource selected to export", true)
+	}
+
+	return a, nil
+}
+
+// exportTable writes the table as shown, with its sort and filters, as aligned text or
+// Markdown to a file or the clipboard
+func (a *App) exportTable(formatStr string, clipboard bool) (tea.Model, tea.Cmd) {
+	if a.state != StateResourceList || a.resourceList.Handler() == nil {
+		a.footer.SetMessage("Export is only available in resource list view", true)
+		return a, nil
+	}
+
+	format := utils.ExportText
+	if formatStr == "md" || formatStr == "markdown" {
+		format = utils.ExportMarkdown
+	}
+
+	content := a.resourceList.TableText(format == utils.ExportMarkdown)
+	if content == "" {
+		a.footer.SetMessage("No rows to export", true)
+		return a, nil
+	}
+
+	if clipboard {
+		return a, components.CopyToClipboard(content, "table")
+	}
+
+	filepath, err := utils.NewExporter(".").ExportTable(content, a.resourceList.Handler().ResourceType(), format)
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Export failed: %v", err), true)
+		return a, nil
+	}
+
+	a.footer.SetMessage(fmt.Sprintf("Exported table to %s", filepath), false)
+	return a, nil
+}
+
+// handlerForType returns the registered handler listing a resource type
+func (a *App) handlerForType(resourceType string) (handlers.ResourceHandler, bool) {
+	// Get the shortcut key from resource type (e.g., "iam:users" -> "users")
+	shortcut := resourceType
+	parts := strings.Split(resourceType, ":")
+	if len(parts) > 1 {
+		shortcut = parts[1]
+	}
+
+	handler, ok := a.registry.Get(shortcut)
+	if !ok {
+		// Try with full type
+		handler, ok = a.registry.Get(resourceType)
+	}
+	return handler, ok
+}
+
+// navigateToType opens the list of a resource type in region, selecting the resource
+// with selectID once it loads unless that is empty
+func (a *App) navigateToType(resourceType, region, selectID string) (tea.Model, tea.Cmd) {
+	handler, ok := a.handlerForType(resourceType)
+	if !ok {
+		a.footer.SetMessage(fmt.Sprintf("Handler not found for: %s", resourceType), true)
+		return a, nil
+	}
+
+	// Check if we need to switch region
+	if region != "" && region != a.clientMgr.Region() {
+		if err := a.switchRegionNow(region); err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Failed to switch region: %v", err), true)
+			return a, nil
+		}
+
+		// Get handler again after re-registering
+		handler, ok = a.handlerForType(resourceType)
+		if !ok {
+			a.footer.SetMessage(fmt.Sprintf("Handler not found for: %s", resourceType), true)
+			return a, nil
+		}
+	}
+
+	// Navigate to the resource type
+	a.state = StateResourceList
+	a.breadcrumb.SetPath(handler.ResourceName())
+	a.resourceList.SetHandler(handler)
+	if selectID != "" {
+		a.resourceList.SelectOnLoad(selectID)
+	}
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, fmt.Sprintf("Loading %s...", handler.ResourceName()))
+
+	// Update size
+	a.sizeLists()
+
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// View renders the UI
+func (a *App) View() string {
+	if a.width == 0 {
+		return "Loading..."
+	}
+
+	// Build layout
+	header := a.header.View()
+	breadcrumb := a.breadcrumb.View()
+	a.footer.SetCancellable((a.loading && a.resourceList.LoadInFlight()) || a.cancelQuery != nil)
+	footer := a.footer.View()
+
+	// Calculate content height
+	headerHeight := lipgloss.Height(header)
+	breadcrumbHeight := lipgloss.Height(breadcrumb)
+	footerHeight := lipgloss.Height(footer)
+	contentHeight := a.height - headerHeight - breadcrumbHeight - footerHeight
+
+	// Render main content
+	var content string
+	switch a.state {
+	case StateHome:
+		content = a.renderHome(contentHeight)
+	case StateResourceList:
+		content = a.renderLists()
+	case StateSecretEditor:
+		content = a.secretEditor.View()
+	case StateSecretCreator:
+		content = a.secretCreator.View()
+	default:
+		content = a.renderHome(contentHeight)
+	}
+
+	// Add command mode overlay
+	if a.mode == ModeCommand {
+		content = a.overlayCommand(content, contentHeight)
+	}
+
+	// Add confirmation dialog overlay
+	if a.mode == ModeConfirm {
+		content = a.overlayConfirm(content)
+	}
+	if len(a.mfaQueue) > 0 {
+		content = a.overlayMFAPrompt(content)
+	}
+
+	// Compose the view
+	view := lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		breadcrumb,
+		content,
+		footer,
+	)
+
+	// Overlay info dialog if visible
+	if a.infoDialog.IsVisible() {
+		view = a.infoDialog.View()
+	}
+
+	// Overlay log tail if visible
+	if a.logTail.IsVisible() {
+		view = a.logTail.View()
+	}
+
+	// Overlay dashboard if visible
+	if a.dashboard.IsVisible() {
+		view = a.dashboard.View()
+	}
+
+	// Overlay command output if visible
+	if a.commandOutput.IsVisible() {
+		view = a.commandOutput.View()
+	}
+
+	// Overlay changelog if visible
+	if a.changelog.IsVisible() {
+		view = a.changelog.View()
+	}
+
+	// Overlay diff view if visible
+	if a.diffView.IsVisible() {
+		view = a.diffView.View()
+	}
+
+	// Overlay error panel if visible
+	if a.errorPanel.IsVisible() {
+		view = a.errorPanel.View()
+	}
+
+	// Overlay policy picker if active
+	if a.policyPicker.IsActive() {
+		view = a.policyPicker.View()
+	}
+
+	// Overlay test event picker if active
+	if a.testEventPicker.IsActive() {
+		view = a.testEventPicker.View()
+	}
+
+	// Overlay restore wizard if active
+	if a.restoreWizard.IsActive() {
+		view = a.restoreWizard.View()
+	}
+
+	// Overlay capacity editor if active
+	if a.capacityEditor.IsActive() {
+		view = a.capacityEditor.View()
+	}
+
+	// Overlay tag editor if active
+	if a.tagEditor.IsActive() {
+		view = a.tagEditor.View()
+	}
+
+	// Overlay item editor if active
+	if a.itemEditor.IsActive() {
+		view = a.itemEditor.View()
+	}
+
+	// Overlay object restore form if active
+	if a.objectRestore.IsActive() {
+		view = a.objectRestore.View()
+	}
+
+	// Overlay selector if active
+	if a.selector.IsActive() {
+		view = a.selector.View()
+	}
+
+	// Overlay bookmark selector if active
+	if a.bookmarkSelector.IsActive() {
+		view = a.bookmarkSelector.View()
+	}
+
+	return view
+}
+
+// homeReminderLimit caps how many upcoming expirations the home screen lists
+const homeReminderLimit = 5
+
+// renderExpiring lists the reminders expiring soon, or returns "" if there are none
+func (a *App) renderExpiring() string {
+	now := time.Now()
+	upcoming := a.reminderStore.Upcoming(now, config.DefaultReminderWindow)
+	if len(upcoming) == 0 {
+		return ""
+	}
+
+	lines := []string{"Upcoming expirations:"}
+	for i, r := range upcoming {
+		if i == homeReminderLimit {
+			lines = append(lines, fmt.Sprintf("  ... and %d more", len(upcoming)-homeReminderLimit))
+			break
+		}
+
+		days := r.DaysLeft(now)
+		var when string
+		switch {
+		case days < 0:
+			when = fmt.Sprintf("expired %dd ago", -days)
+		case days == 0:
+			when = "expires today"
+		default:
+			when = fmt.Sprintf("in %dd", days)
+		}
+
+		lines = append(lines, fmt.Sprintf("  %s %s  %-16s %s/%s",
+			styles.PadRight(styles.Truncate(r.Name, 30), 30), r.ExpiresOn.Format(config.ReminderDateFormat), when, r.Profile, r.Region))
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(a.theme.Colors.Warning).
+		MarginTop(2).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (a *App) overlayCommand(content string, height int) string {
+	commandBox := a.theme.Command.Width(a.width).Render(a.commandInput.View())
+	if a.historyRecall.IsSearching() {
+		commandBox = a.theme.Command.Width(a.width).Render(a.historyRecall.SearchView())
+	}
+
+	// Get autocomplete suggestions if available
+	var autocompleteBox string
+	if a.autocomplete.HasSuggestions() {
+		autocompleteBox = a.autocomplete.View(a.width)
+	}
+
+	lines := strings.Split(content, "\n")
+	linesToRemove := 1
+	if autocompleteBox != "" {
+		// Count lines in autocomplete box and remove that many additional lines
+		autocompleteLines := strings.Count(autocompleteBox, "\n") + 1
+		linesToRemove += autocompleteLines
+	}
+
+	if len(lines) > linesToRemove {
+		lines = lines[linesToRemove:] // Remove lines to make room for command and autocomplete
+	}
+
+	result := commandBox
+	if autocompleteBox != "" {
+		result += "\n" + autocompleteBox
+	}
+	result += "\n" + strings.Join(lines, "\n")
+
+	return result
+}
+
+func (a *App) overlayConfirm(content string) string {
+	// Center the dialog
+	lines := strings.Split(content, "\n")
+	if len(lines) > 5 {
+		lines = lines[5:]
+	}
+
+	dialog := a.confirmDialog.View()
+	result := dialog + "\n" + strings.Join(lines, "\n")
+
+	return result
+}
+
+// Message types for secret operations
+type SecretLoadedMsg struct {
+	name  string
+	value string
+}
+
+type SecretLoadedForEditMsg struct {
+	id    string
+	name  string
+	value string
+}
+
+type SecretLoadErrorMsg struct {
+	err error
+}
+
+type SecretSavedMsg struct {
+	secretID string
+}
+
+type SecretSaveErrorMsg struct {
+	err error
+}
+
+// Secret creation messages
+type SecretCreatedMsg struct {
+	secretName string
+}
+
+type SecretCreateErrorMsg struct {
+	err error
+}
+
+// Secret deletion messages
+type SecretDeletedMsg struct {
+	secretID string
+}
+
+type SecretDeleteErrorMsg struct {
+	err error
+}
+
+// IAM User data messages
+type UserDataLoadedMsg struct {
+	title string
+	data  interface{}
+}
+
+type UserDataErrorMsg struct {
+	err error
+}
+
+// IAM policy attachment messages
+type PolicyPickerLoadedMsg struct {
+	principalType string
+	principalName string
+	policies      []handlers.ManagedPolicyRef
+	attached      []handlers.ManagedPolicyRef
+}
+
+type PolicyAttachmentsAppliedMsg struct {
+	message string
+}
+
+type PolicyAttachmentsErrorMsg struct {
+	err error
+}
+
+// DynamoDB capacity messages
+type TableCapacityLoadedMsg struct {
+	capacity *handlers.TableCapacity
+}
+
+type DynamoDBCapacityOperationSuccessMsg struct {
+	message string
+}
+
+type DynamoDBCapacityOperationErrorMsg struct {
+	err error
+}
+
+// Tag editor messages
+type TagsLoadedMsg struct {
+	action *handlers.EditTagsAction
+	tags   map[string]string
+}
+
+type TagsUpdatedMsg struct {
+	handler  handlers.ResourceHandler
+	resource handlers.Resource // Reloaded with its new tags, nil if it couldn't be
+	message  string
+}
+
+type TagsErrorMsg struct {
+	err error
+}
+
+// RDS snapshot restore messages
+type RestoreOptionsLoadedMsg struct {
+	options *handlers.RestoreSnapshotOptions
+}
+
+type RDSRestoreStartedMsg struct {
+	instanceID string
+	snapshotID string
+	status     string
+}
+
+type RDSRestoreStatusMsg struct {
+	instanceID string
+	status     string
+	endpoint   string
+	port       int32
+}
+
+type RDSRestoreErrorMsg struct {
+	err error
+}
+
+// RDS instance operation messages
+type RDSInstanceOperationSuccessMsg struct {
+	message string
+}
+
+type RDSInstanceOperationErrorMsg struct {
+	err error
+}
+
+// RDS snapshot messages, for new snapshots and copies tracked until they are available
+type RDSSnapshotStartedMsg struct {
+	message    string
+	snapshotID string
+	region     string
+}
+
+type RDSSnapshotStatusMsg struct {
+	snapshotID string
+	region     string
+	status     string
+	progress   int32
+}
+
+type RDSSnapshotErrorMsg struct {
+	err error
+}
+
+// Image Builder pipeline run messages
+type ImagePipelineStartedMsg struct {
+	pipeline string
+	imageARN string
+	started  time.Time
+}
+
+type ImageBuildStatusMsg struct {
+	pipeline string
+	image    *ibadapter.Image
+	started  time.Time
+}
+
+type ImageBuildErrorMsg struct {
+	err error
+}
+
+// EC2 Instance operation messages
+type EC2InstanceOperationSuccessMsg struct {
+	message string
+}
+
+type EC2InstanceOperationErrorMsg struct {
+	err error
+}
+
+// API Gateway test invocation messages
+type RouteInvokedMsg struct {
+	title string
+	data  map[string]interface{}
+}
+
+type RouteInvokeErrorMsg struct {
+	err error
+}
+
+// Reachability Analyzer messages, keyed by the destination whose detail pane shows the result
+type ReachabilityAnalyzedMsg struct {
+	destination string
+	verdict     string
+	data        map[string]interface{}
+}
+
+type ReachabilityErrorMsg struct {
+	destination string
+	err         error
+}
+
+// AWS Config history messages, keyed by the resource whose detail pane shows the timeline
+type ConfigHistoryLoadedMsg struct {
+	resourceID string
+	name       string
+	snapshots  int
+	data       map[string]interface{}
+}
+
+type ConfigHistoryErrorMsg struct {
+	resourceID string
+	err        error
+}
+
+// ECS task operation messages
+type ECSTaskOperationSuccessMsg struct {
+	message string
+}
+
+type ECSTaskOperationErrorMsg struct {
+	err error
+}
+
+// Messages for deletes confirmed by typing the resource name
+type ResourceDeletedMsg struct {
+	message string
+}
+
+type ResourceDeleteErrorMsg struct {
+	err error
+}
+
+// CleanupDeletedMsg reports a batch delete from the cleanup report
+type CleanupDeletedMsg struct {
+	deleted  int
+	failures []string
+}
+
+// Security Hub workflow status update messages
+type FindingsUpdatedMsg struct {
+	status   string
+	updated  int
+	failures []string
+}
+
+type FindingsUpdateErrorMsg struct {
+	err error
+}
+
+// S3 prefix download messages
+type S3DownloadPreviewMsg struct {
+	preview *handlers.DownloadPreview
+	err     error
+}
+
+type S3DownloadProgressMsg struct {
+	progress s3adapter.DownloadProgress
+	events   <-chan tea.Msg
+}
+
+type S3DownloadDoneMsg struct {
+	result *s3adapter.DownloadResult
+	dir    string
+}
+
+// S3 single object download and upload messages
+type S3TransferProgressMsg struct {
+	progress s3adapter.TransferProgress
+	verb     string // "Downloading" or "Uploading"
+	key      string
+	events   <-chan tea.Msg
+}
+
+type S3TransferDoneMsg struct {
+	result *s3adapter.TransferResult
+	upload bool
+	err    error
+}
+
+type S3ObjectOperationSuccessMsg struct {
+	message string
+}
+
+type S3ObjectOperationErrorMsg struct {
+	err error
+}
+
+type BucketConfigLoadedMsg struct {
+	action   *handlers.EditBucketConfigAction
+	document string
+}
+
+type S3BucketOperationSuccessMsg struct {
+	message string
+}
+
+type S3BucketOperationErrorMsg struct {
+	err error
+}
+
+// Lambda operation messages
+type LambdaOperationSuccessMsg struct {
+	message string
+}
+
+type LambdaOperationErrorMsg struct {
+	err error
+}
+
+// Amazon MQ broker operation messages
+type MQBrokerOperationSuccessMsg struct {
+	message string
+}
+
+type MQBrokerOperationErrorMsg struct {
+	err error
+}
+
+// Auto Scaling operation messages
+type AutoScalingOperationSuccessMsg struct {
+	message string
+}
+
+type AutoScalingOperationErrorMsg struct {
+	err error
+}
+
+// IAM group membership operation messages
+type IAMGroupOperationSuccessMsg struct {
+	message string
+}
+
+type IAMGroupOperationErrorMsg struct {
+	err error
+}
+
+// Secret rotation operation messages
+type SecretRotationOperationSuccessMsg struct {
+	message string
+}
+
+type SecretRotationOperationErrorMsg struct {
+	err error
+}
+
+// Secret version operation messages
+type SecretVersionOperationSuccessMsg struct {
+	message string
+}
+
+type SecretVersionOperationErrorMsg struct {
+	err error
+}
+
+// KMS operation messages
+type KMSOperationSuccessMsg struct {
+	message string
+}
+
+type KMSOperationErrorMsg struct {
+	err error
+}
+
+// Load balancer target operation messages
+type ELBTargetOperationSuccessMsg struct {
+	message string
+}
+
+type ELBTargetOperationErrorMsg struct {
+	err error
+}
+
+// DynamoDB Item operation messages
+type ItemLoadedForEditMsg struct {
+	itemID    string
+	tableName string
+	itemKey   string
+	item      *handlers.EditableItem
+}
+
+type ItemSavedMsg struct {
+	itemID string
+}
+
+type ItemSaveErrorMsg struct {
+	err error
+}
+
+type ItemDeletedMsg struct {
+	itemID string
+}
+
+type ItemDeleteErrorMsg struct {
+	err error
+}
+
+type ItemLoadErrorMsg struct {
+	err error
+}
+
+// handleConfirmMode handles confirmation dialog input
+func (a *App) handleConfirmMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	// Free-form input may contain y and n, so it is confirmed with enter instead
+	if a.confirmDialog.HasTextInput() {
+		switch key {
+		case "enter":
+			key = "y"
+		case "esc":
+			key = "n"
+		default:
+			var cmd tea.Cmd
+			a.confirmDialog, cmd = a.confirmDialog.Update(msg)
+			return a, cmd
+		}
+	}
+
+	switch key {
+	case "y", "Y":
+		// User confirmed, unless the resource name still has to be typed
+		if !a.confirmDialog.NameConfirmed() {
+			a.footer.SetMessage("Type the name exactly as shown to confirm", true)
+			return a, nil
+		}
+		a.mode = ModeNormal
+
+		if deleteAction, ok := a.pendingAction.(*handlers.DeleteSecretAction); ok {
+			// Get recovery window from dialog input
+			recoveryWindow := 30 // default
+			if input := a.confirmDialog.GetInput(); input != "" {
+				if val, err := strconv.Atoi(input); err == nil {
+					if val < 7 || val > 30 {
+						a.footer.SetMessage("Recovery window must be 7-30 days", true)
+						return a, nil
+					}
+					recoveryWindow = val
+				} else {
+					a.footer.SetMessage("Invalid recovery window (must be a number)", true)
+					return a, nil
+				}
+			}
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			return a, a.deleteSecret(deleteAction.SecretID, deleteAction.SecretName, recoveryWindow)
+		}
+
+		if terminateAction, ok := a.pendingAction.(*handlers.TerminateInstanceAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Terminating instance...")
+			return a, a.terminateEC2Instance(terminateAction.InstanceID)
+		}
+
+		if elevate, ok := a.pendingAction.(*elevateRequest); ok {
+			reason, err := elevationReason(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			if err != nil {
+				a.footer.SetMessage(err.Error(), true)
+				return a, nil
+			}
+			return a, a.startElevation(elevate, reason)
+		}
+
+		if createSnapshot, ok := a.pendingAction.(*handlers.CreateDBSnapshotAction); ok {
+			snapshotID := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			if err := handlers.ValidateSnapshotID(snapshotID); err != nil {
+				a.footer.SetMessage(err.Error(), true)
+				return a, nil
+			}
+			a.footer.SetLoading(true, "Starting snapshot...")
+			return a, a.createRDSSnapshot(createSnapshot.DBInstanceID, snapshotID)
+		}
+
+		if stopTask, ok := a.pendingAction.(*handlers.StopTaskAction); ok {
+			reason := strings.TrimSpace(a.confirmDialog.GetInput())
+			if reason == "" {
+				reason = stopTask.DefaultReason()
+			}
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, fmt.Sprintf("Stopping task %s...", stopTask.TaskID()))
+			return a, a.stopECSTask(stopTask, reason)
+		}
+
+		if deleteSnapshot, ok := a.pendingAction.(*handlers.DeleteDBSnapshotAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Deleting snapshot...")
+			return a, a.deleteRDSSnapshot(deleteSnapshot.SnapshotID)
+		}
+
+		if updateFindings, ok := a.pendingAction.(*handlers.UpdateFindingsWorkflowAction); ok {
+			note := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, fmt.Sprintf("Updating %d findings...", len(updateFindings.Findings)))
+			return a, a.updateFindings(updateFindings, note)
+		}
+
+		if deleteUnused, ok := a.pendingAction.(*handlers.DeleteUnusedAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, fmt.Sprintf("Deleting %d unused resources...", len(deleteUnused.Findings)))
+			return a, a.deleteUnused(deleteUnused)
+		}
+
+		if copySnapshot, ok := a.pendingAction.(*handlers.CopyDBSnapshotAction); ok {
+			region := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			known := make([]string, 0, len(a.regions))
+			for _, r := range a.regions {
+				known = append(known, r.Name)
+			}
+			if err := copySnapshot.ValidateCopyRegion(region, known); err != nil {
+				a.footer.SetMessage(err.Error(), true)
+				return a, nil
+			}
+			a.footer.SetLoading(true, fmt.Sprintf("Copying snapshot to %s...", region))
+			return a, a.copyRDSSnapshot(copySnapshot, region)
+		}
+
+		if deleteTable, ok := a.pendingAction.(*handlers.DeleteTableAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Deleting table...")
+			return a, a.deleteDynamoDBTable(deleteTable.TableName)
+		}
+
+		if deleteObject, ok := a.pendingAction.(*handlers.DeleteObjectAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Deleting object...")
+			return a, a.deleteS3Object(deleteObject.Bucket, deleteObject.Key)
+		}
+
+		if preview, ok := a.pendingAction.(*handlers.DownloadPreview); ok {
+			dir := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			if dir == "" {
+				a.footer.SetMessage("A download directory is required", true)
+				return a, nil
+			}
+			return a, a.startS3Download(preview, expandHome(dir))
+		}
+
+		if downloadObject, ok := a.pendingAction.(*handlers.DownloadObjectAction); ok {
+			file := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			if file == "" {
+				a.footer.SetMessage("A file to save to is required", true)
+				return a, nil
+			}
+			return a, a.startS3ObjectDownload(downloadObject, expandHome(file))
+		}
+
+		if restoreReq, ok := a.pendingAction.(*handlers.RestoreObjectRequest); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Requesting restore...")
+			return a, a.restoreS3Object(restoreReq)
+		}
+
+		if changeClass, ok := a.pendingAction.(*handlers.ChangeStorageClassAction); ok {
+			input := a.confirmDialog.GetInput()
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			storageClass, err := handlers.ParseStorageClass(input, changeClass.Current)
+			if err != nil {
+				a.footer.SetMessage(err.Error(), true)
+				return a, nil
+			}
+			a.footer.SetLoading(true, fmt.Sprintf("Copying to %s...", storageClass))
+			return a, a.setS3StorageClass(changeClass.Key, storageClass)
+		}
+
+		if uploadFile, ok := a.pendingAction.(*handlers.UploadFileAction); ok {
+			file := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			if file == "" {
+				a.footer.SetMessage("A file to upload is required", true)
+				return a, nil
+			}
+			return a, a.startS3Upload(uploadFile, expandHome(file))
+		}
+
+		if reminderAction, ok := a.pendingAction.(*handlers.SetReminderAction); ok {
+			input := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			return a, a.setReminder(reminderAction, input)
+		}
+
+		if viewAction, ok := a.pendingAction.(*handlers.ViewSecretAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			return a, a.loadAndViewSecret(viewAction.SecretID, viewAction.SecretName)
+		}
+
+		if viewAction, ok := a.pendingAction.(*handlers.ViewSecretVersionAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Loading secret version...")
+			return a, a.loadSecretVersion(viewAction.SecretID, viewAction.VersionID)
+		}
+
+		if diffAction, ok := a.pendingAction.(*handlers.DiffSecretVersionAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Loading secret versions...")
+			return a, a.diffSecretVersion(diffAction.VersionID, diffAction.CurrentVersionID)
+		}
+
+		if request, ok := a.pendingAction.(*handlers.RotationConfigRequest); ok {
+			expression := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			if err := handlers.ValidateRotationSchedule(expression); err != nil {
+				a.footer.SetMessage(err.Error(), true)
+				return a, nil
+			}
+			a.footer.SetLoading(true, "Configuring rotation...")
+			return a, a.configureRotation(request, expression)
+		}
+
+		if rotateAction, ok := a.pendingAction.(*handlers.RotateSecretNowAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Starting rotation...")
+			return a, a.rotateSecretNow(rotateAction.SecretID, rotateAction.SecretName)
+		}
+
+		if promoteAction, ok := a.pendingAction.(*handlers.PromoteSecretVersionAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Promoting secret version...")
+			return a, a.promoteSecretVersion(promoteAction.SecretID, promoteAction.VersionID, promoteAction.CurrentVersionID)
+		}
+
+		if imdsAction, ok := a.pendingAction.(*handlers.RequireIMDSv2Action); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Requiring IMDSv2...")
+			return a, a.requireIMDSv2(imdsAction.Targets)
+		}
+
+		if remove, ok := a.pendingAction.(*handlers.RemoveUserFromGroupAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Removing user from group...")
+			return a, a.removeUserFromGroup(remove.GroupName, remove.UserName)
+		}
+
+		if change, ok := a.pendingAction.(*handlers.PolicyAttachmentChange); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Updating policy attachments...")
+			return a, a.applyPolicyChange(change)
+		}
+
+		if publishAction, ok := a.pendingAction.(*handlers.PublishVersionAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Publishing version...")
+			return a, a.publishLambdaVersion(publishAction.FunctionName)
+		}
+
+		if aliasAction, ok := a.pendingAction.(*handlers.UpdateAliasAction); ok {
+			version, err := strconv.Atoi(a.confirmDialog.GetInput())
+			if err != nil || version < 1 || version > aliasAction.LatestVersion {
+				a.footer.SetMessage(fmt.Sprintf("Version must be 1-%d", aliasAction.LatestVersion), true)
+				a.pendingAction = nil
+				a.confirmDialog.Reset()
+				return a, nil
+			}
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Updating alias...")
+			return a, a.updateLambdaAlias(aliasAction.FunctionName, aliasAction.AliasName, strconv.Itoa(version))
+		}
+
+		if concurrencyAction, ok := a.pendingAction.(*handlers.SetReservedConcurrencyAction); ok {
+			// An empty input removes the reservation
+			reserved := -1
+			if input := a.confirmDialog.GetInput(); input != "" {
+				val, err := strconv.Atoi(input)
+				if err != nil || val < 0 || val > int(concurrencyAction.Max) {
+					a.footer.SetMessage(fmt.Sprintf("Reserved concurrency must be 0-%d", concurrencyAction.Max), true)
+					a.pendingAction = nil
+					a.confirmDialog.Reset()
+					return a, nil
+				}
+				reserved = val
+			}
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Updating concurrency...")
+			return a, a.setLambdaConcurrency(concurrencyAction.FunctionName, int32(reserved))
+		}
+
+		if desiredAction, ok := a.pendingAction.(*handlers.SetDesiredCapacityAction); ok {
+			desired, err := strconv.Atoi(a.confirmDialog.GetInput())
+			if err != nil || desired < int(desiredAction.Min) || desired > int(desiredAction.Max) {
+				a.footer.SetMessage(fmt.Sprintf("Desired capacity must be %d-%d", desiredAction.Min, desiredAction.Max), true)
+				a.pendingAction = nil
+				a.confirmDialog.Reset()
+				return a, nil
+			}
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Setting desired capacity...")
+			return a, a.setDesiredCapacity(desiredAction.GroupName, int32(desired))
+		}
+
+		if refreshAction, ok := a.pendingAction.(*handlers.StartInstanceRefreshAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Starting instance refresh...")
+			return a, a.startInstanceRefresh(refreshAction.GroupName)
+		}
+
+		if rotationAction, ok := a.pendingAction.(*handlers.ToggleKeyRotationAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Updating key rotation...")
+			return a, a.setKeyRotation(rotationAction.KeyID, rotationAction.KeyName, rotationAction.Enable)
+		}
+
+		if deleteKey, ok := a.pendingAction.(*handlers.ScheduleKeyDeletionAction); ok {
+			window, err := strconv.Atoi(a.confirmDialog.GetInput())
+			if err != nil || window < 7 || window > 30 {
+				a.footer.SetMessage("Waiting period must be 7-30 days", true)
+				return a, nil
+			}
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Scheduling key deletion...")
+			return a, a.scheduleKeyDeletion(deleteKey.KeyID, deleteKey.KeyName, int32(window))
+		}
+
+		if cancelDelete, ok := a.pendingAction.(*handlers.CancelKeyDeletionAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Cancelling key deletion...")
+			return a, a.cancelKeyDeletion(cancelDelete.KeyID, cancelDelete.KeyName)
+		}
+
+		if versioning, ok := a.pendingAction.(*handlers.ToggleBucketVersioningAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Updating versioning...")
+			return a, a.setBucketVersioning(versioning.Bucket, versioning.Enable)
+		}
+
+		if applyConfig, ok := a.pendingAction.(*handlers.ApplyBucketConfigAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, fmt.Sprintf("Applying %s rules...", handlers.BucketConfigTitle(applyConfig.Config)))
+			return a, a.applyBucketConfig(applyConfig)
+		}
+
+		if encryption, ok := a.pendingAction.(*handlers.SetBucketEncryptionAction); ok {
+			kmsKeyID := strings.TrimSpace(a.confirmDialog.GetInput())
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Updating default encryption...")
+			return a, a.setBucketEncryption(encryption.Bucket, kmsKeyID)
+		}
+
+		if startPipeline, ok := a.pendingAction.(*handlers.StartPipelineAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Starting pipeline...")
+			return a, a.startImagePipeline(startPipeline.PipelineARN, startPipeline.PipelineName)
+		}
+
+		if rebootAction, ok := a.pendingAction.(*handlers.RebootBrokerAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Rebooting broker...")
+			return a, a.rebootMQBroker(rebootAction.BrokerID, rebootAction.BrokerName)
+		}
+
+		if deregister, ok := a.pendingAction.(*handlers.DeregisterTargetAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Deregistering target...")
+			return a, a.deregisterTarget(deregister)
+		}
+
+		if capacityReq, ok := a.pendingAction.(*handlers.TableCapacityRequest); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Updating table capacity...")
+			return a, a.updateTableCapacity(capacityReq)
+		}
+
+		if restoreReq, ok := a.pendingAction.(*handlers.RestoreSnapshotRequest); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Starting restore...")
+			return a, a.restoreSnapshot(restoreReq)
+		}
+
+		if deleteItemAction, ok := a.pendingAction.(*handlers.DeleteItemAction); ok {
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+			a.footer.SetLoading(true, "Deleting item...")
+			return a, a.deleteItem(deleteItemAction.ItemID, deleteItemAction.TableName)
+		}
+
+		a.pendingAction = nil
+		a.confirmDialog.Reset()
+		return a, nil
+
+	case "n", "N", "esc":
+		// User cancelled
+		a.mode = ModeNormal
+		a.pendingAction = nil
+		a.confirmDialog.Reset()
+		return a, nil
+
+	default:
+		// Route input to confirm dialog if it has input field
+		if a.confirmDialog.HasInput() {
+			var cmd tea.Cmd
+			a.confirmDialog, cmd = a.confirmDialog.Update(msg)
+			return a, cmd
+		}
+	}
+
+	return a, nil
+}
+
+// handleSecretEditorMode handles secret editor input
+func (a *App) handleSecretEditorMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		// Cancel editing
+		a.state = StateResourceList
+		a.bucketConfig = nil
+		return a, nil
+
+	case "ctrl+s":
+		// Determine what we're editing based on the handler type
+		handler := a.resourceList.Handler()
+		if bucketsHandler, ok := handler.(*handlers.S3BucketsHandler); ok && a.bucketConfig != nil {
+			// Editing bucket lifecycle or CORS rules, confirmed before they are applied
+			document, err := a.secretEditor.Value()
+			if err == nil {
+				var rules int
+				rules, err = bucketsHandler.ParseBucketConfig(a.bucketConfig.Config, document)
+				if err == nil {
+					return a.Update(&handlers.ApplyBucketConfigAction{
+						Bucket:   a.bucketConfig.Bucket,
+						Config:   a.bucketConfig.Config,
+						Document: document,
+						Rules:    rules,
+					})
+				}
+			}
+			a.footer.SetMessage(err.Error(), true)
+			return a, nil
+		}
+		// Editing a secret
+		a.footer.SetLoading(true, "Saving secret...")
+		return a, a.saveSecret()
+	}
+
+	// Pass other keys to editor
+	var cmd tea.Cmd
+	a.secretEditor, cmd = a.secretEditor.Update(msg)
+	return a, cmd
+}
+
+// handleSecretCreatorMode handles secret creator input
+func (a *App) handleSecretCreatorMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		// Cancel creation
+		a.state = StateResourceList
+		a.secretCreator.Reset()
+		return a, nil
+
+	case "ctrl+s":
+		// Submit form
+		if err := a.secretCreator.Validate(); err != nil {
+			a.footer.SetMessage("Please fix validation errors", true)
+			return a, nil
+		}
+		a.footer.SetLoading(true, "Creating secret...")
+		params := a.secretCreator.GetParams()
+		return a, a.createSecret(params)
+	}
+
+	// Pass to creator for field handling
+	var cmd tea.Cmd
+	a.secretCreator, cmd = a.secretCreator.Update(msg)
+	return a, cmd
+}
+
+// loadAndViewSecret loads a secret value for viewing
+func (a *App) loadAndViewSecret(secretID, secretName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("secrets")
+		if !ok {
+			return SecretLoadErrorMsg{err: fmt.Errorf("secrets handler not found")}
+		}
+
+		secretsHandler, ok := handler.(*handlers.SecretsHandler)
+		if !ok {
+			return SecretLoadErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		value, err := secretsHandler.GetSecretValueForView(ctx, secretID)
+		if err != nil {
+			return SecretLoadErrorMsg{err: err}
+		}
+
+		return SecretLoadedMsg{
+			name:  secretName,
+			value: value,
+		}
+	}
+}
+
+// secretVersionsHandler returns the secret versions view being shown
+func (a *App) secretVersionsHandler() (*handlers.SecretVersionsHandler, error) {
+	handler, ok := a.resourceList.Handler().(*handlers.SecretVersionsHandler)
+	if !ok {
+		return nil, fmt.Errorf("not viewing secret versions")
+	}
+	return handler, nil
+}
+
+// loadSecretVersion loads the value of a secret version for viewing
+func (a *App) loadSecretVersion(secretID, versionID string) tea.Cmd {
+	handler, err := a.secretVersionsHandler()
+	return func() tea.Msg {
+		if err != nil {
+			return SecretLoadErrorMsg{err: err}
+		}
+
+		value, err := handler.GetVersionValue(context.Background(), versionID)
+		if err != nil {
+			return SecretLoadErrorMsg{err: err}
+		}
+
+		return SecretLoadedMsg{
+			name:  fmt.Sprintf("%s (%s)", secretID, versionID),
+			value: value,
+		}
+	}
+}
+
+// diffSecretVersion loads a secret version and the current one into the diff view
+func (a *App) diffSecretVersion(versionID, currentVersionID string) tea.Cmd {
+	handler, err := a.secretVersionsHandler()
+	return func() tea.Msg {
+		if err != nil {
+			return views.DiffLoadedMsg{Error: err}
+		}
+
+		left, right, err := handler.VersionValues(context.Background(), versionID, currentVersionID)
+		if err != nil {
+			return views.DiffLoadedMsg{Error: err}
+		}
+
+		return views.DiffLoadedMsg{
+			LeftName:  versionID,
+			RightName: currentVersionID + " (AWSCURRENT)",
+			Left:      left,
+			Right:     right,
+		}
+	}
+}
+
+// promoteSecretVersion makes an older secret version current again
+func (a *App) promoteSecretVersion(secretID, versionID, currentVersionID string) tea.Cmd {
+	handler, err := a.secretVersionsHandler()
+	return a.forList(func() tea.Msg {
+		if err != nil {
+			return SecretVersionOperationErrorMsg{err: err}
+		}
+
+		if err := handler.PromoteVersion(context.Background(), versionID, currentVersionID); err != nil {
+			return SecretVersionOperationErrorMsg{err: err}
+		}
+
+		return SecretVersionOperationSuccessMsg{
+			message: fmt.Sprintf("Version %s is now AWSCURRENT for %s", versionID, secretID),
+		}
+	})
+}
+
+// rotationConfigMessage describes a secret's current rotation and the Lambda picked for it
+func rotationConfigMessage(rotation *handlers.ConfigureRotationAction, lambdaARN string) string {
+	lambdaName := func(arn string) string {
+		return arn[strings.LastIndex(arn, ":")+1:]
+	}
+	formatDate := func(t time.Time) string {
+		if t.IsZero() {
+			return "never"
+		}
+		return t.UTC().Format("2006-01-02 15:04 UTC")
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Rotation of %s\n\n", rotation.SecretName)
+	if rotation.Enabled {
+		fmt.Fprintf(&sb, "Current Lambda:   %s\n", lambdaName(rotation.LambdaARN))
+		fmt.Fprintf(&sb, "Current schedule: %s\n", rotation.Expression)
+		if rotation.Window != "" {
+			fmt.Fprintf(&sb, "Window:           %s\n", rotation.Window)
+		}
+		fmt.Fprintf(&sb, "Last rotated:     %s\n", formatDate(rotation.LastRotated))
+		fmt.Fprintf(&sb, "Next rotation:    %s\n", formatDate(rotation.NextRotation))
+	} else {
+		sb.WriteString("Rotation is currently disabled.\n")
+	}
+	fmt.Fprintf(&sb, "\nRotate with %s on the schedule below.\n", lambdaName(lambdaARN))
+	sb.WriteString("The first rotation runs on the schedule; use R to rotate now.")
+	return sb.String()
+}
+
+// secretsHandler returns the secrets handler being viewed
+func (a *App) secretsHandler() (*handlers.SecretsHandler, error) {
+	handler, ok := a.resourceList.Handler().(*handlers.SecretsHandler)
+	if !ok {
+		return nil, fmt.Errorf("not viewing secrets")
+	}
+	return handler, nil
+}
+
+// configureRotation turns on rotation of a secret with the picked Lambda and schedule
+func (a *App) configureRotation(request *handlers.RotationConfigRequest, expression string) tea.Cmd {
+	handler, err := a.secretsHandler()
+	return a.forList(func() tea.Msg {
+		if err != nil {
+			return SecretRotationOperationErrorMsg{err: err}
+		}
+
+		if err := handler.ConfigureRotation(context.Background(), request.Action, request.LambdaARN, expression); err != nil {
+			return SecretRotationOperationErrorMsg{err: err}
+		}
+
+		return SecretRotationOperationSuccessMsg{
+			message: fmt.Sprintf("Rotation of %s set to %s", request.Action.SecretName, expression),
+		}
+	})
+}
+
+// rotateSecretNow starts a rotation of a secret with its configured Lambda
+func (a *App) rotateSecretNow(secretID, secretName string) tea.Cmd {
+	handler, err := a.secretsHandler()
+	return a.forList(func() tea.Msg {
+		if err != nil {
+			return SecretRotationOperationErrorMsg{err: err}
+		}
+
+		if err := handler.RotateNow(context.Background(), secretID); err != nil {
+			return SecretRotationOperationErrorMsg{err: err}
+		}
+
+		return SecretRotationOperationSuccessMsg{
+			message: fmt.Sprintf("Rotation of %s started", secretName),
+		}
+	})
+}
+
+// loadSecretForEditing loads a secret value for editing
+func (a *App) loadSecretForEditing(secretID, secretName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("secrets")
+		if !ok {
+			return SecretLoadErrorMsg{err: fmt.Errorf("secrets handler not found")}
+		}
+
+		secretsHandler, ok := handler.(*handlers.SecretsHandler)
+		if !ok {
+			return SecretLoadErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		value, err := secretsHandler.GetSecretValueForEdit(ctx, secretID)
+		if err != nil {
+			return SecretLoadErrorMsg{err: err}
+		}
+
+		return SecretLoadedForEditMsg{
+			id:    secretID,
+			name:  secretName,
+			value: value,
+		}
+	}
+}
+
+// saveSecret saves the current secret being edited
+func (a *App) saveSecret() tea.Cmd {
+	return func() tea.Msg {
+		value, err := a.secretEditor.Value()
+		if err != nil {
+			return SecretSaveErrorMsg{err: err}
+		}
+
+		ctx := context.Background()
+		handler, ok := a.registry.Get("secrets")
+		if !ok {
+			return SecretSaveErrorMsg{err: fmt.Errorf("secrets handler not found")}
+		}
+
+		secretsHandler, ok := handler.(*handlers.SecretsHandler)
+		if !ok {
+			return SecretSaveErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		secretID := a.secretEditor.GetSecretID()
+		updates := map[string]interface{}{
+			"SecretValue": value,
+		}
+
+		if err := secretsHandler.Update(ctx, secretID, updates); err != nil {
+			return SecretSaveErrorMsg{err: err}
+		}
+
+		return SecretSavedMsg{secretID: secretID}
+	}
+}
+
+func (a *App) createSecret(params map[string]interface{}) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("secrets")
+		if !ok {
+			return SecretCreateErrorMsg{err: fmt.Errorf("secrets handler not found")}
+		}
+
+		secretsHandler, ok := handler.(*handlers.SecretsHandler)
+		if !ok {
+			return SecretCreateErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		_, err := secretsHandler.Create(ctx, params)
+		if err != nil {
+			return SecretCreateErrorMsg{err: err}
+		}
+
+		secretName, _ := params["Name"].(string)
+		return SecretCreatedMsg{secretName: secretName}
+	}
+}
+
+func (a *App) deleteSecret(secretID, secretName string, recoveryWindowDays int) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("secrets")
+		if !ok {
+			return SecretDeleteErrorMsg{err: fmt.Errorf("secrets handler not found")}
+		}
+
+		secretsHandler, ok := handler.(*handlers.SecretsHandler)
+		if !ok {
+			return SecretDeleteErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		err := secretsHandler.DeleteWithRecoveryWindow(ctx, secretID, recoveryWindowDays)
+		if err != nil {
+			return SecretDeleteErrorMsg{err: err}
+		}
+
+		return SecretDeletedMsg{secretID: secretID}
+	}
+}
+
+// setReminder saves an expiry reminder entered as a date, or removes it when the input is empty
+func (a *App) setReminder(action *handlers.SetReminderAction, input string) tea.Cmd {
+	if input == "" {
+		if err := a.reminderStore.Remove(action.ResourceType, action.ARN); err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Failed to remove reminder: %v", err), true)
+			return nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Removed expiry reminder on %s", action.Name), false)
+		return a.resourceList.Refresh()
+	}
+
+	expiresOn, err := time.Parse(config.ReminderDateFormat, input)
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Invalid date %q (use %s)", input, config.ReminderDateFormat), true)
+		return nil
+	}
+
+	err = a.reminderStore.Set(config.Reminder{
+		Name:         action.Name,
+		ResourceType: action.ResourceType,
+		ResourceID:   action.ResourceID,
+		ARN:          action.ARN,
+		Region:       action.Region,
+		Profile:      a.clientMgr.Profile(),
+		ExpiresOn:    expiresOn,
+	})
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Failed to save reminder: %v", err), true)
+		return nil
+	}
+
+	a.footer.SetMessage(fmt.Sprintf("%s expires on %s", action.Name, input), false)
+	return a.resourceList.Refresh()
+}
+
+// IAM User data loading functions
+
+func (a *App) loadUserPolicies(userName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("users")
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
+		}
+
+		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		data, err := usersHandler.GetUserPolicies(ctx, userName)
+		if err != nil {
+			return UserDataErrorMsg{err: err}
+		}
+
+		return UserDataLoadedMsg{
+			title: fmt.Sprintf("Policies for User: %s", userName),
+			data:  data,
+		}
+	}
+}
+
+func (a *App) loadUserGroups(userName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("users")
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
+		}
+
+		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		data, err := usersHandler.GetUserGroups(ctx, userName)
+		if err != nil {
+			return UserDataErrorMsg{err: err}
+		}
+
+		return UserDataLoadedMsg{
+			title: fmt.Sprintf("Groups for User: %s", userName),
+			data:  data,
+		}
+	}
+}
+
+func (a *App) loadUserAccessKeys(userName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("users")
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
+		}
+
+		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		data, err := usersHandler.GetUserAccessKeys(ctx, userName)
+		if err != nil {
+			return UserDataErrorMsg{err: err}
+		}
+
+		return UserDataLoadedMsg{
+			title: fmt.Sprintf("Access Keys for User: %s", userName),
+			data:  data,
+		}
+	}
+}
+
+func (a *App) loadUserMFA(userName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("users")
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
+		}
+
+		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		data, err := usersHandler.GetUserMFADevices(ctx, userName)
+		if err != nil {
+			return UserDataErrorMsg{err: err}
+		}
+
+		return UserDataLoadedMsg{
+			title: fmt.Sprintf("MFA Devices for User: %s", userName),
+			data:  data,
+		}
+	}
+}
+
+// EC2 Instance operation functions
+
+func (a *App) startEC2Instance(instanceID string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("ec2")
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+		}
+
+		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
+
+		err := ec2Handler.StartInstance(ctx, instanceID)
+		if err != nil {
+			return EC2InstanceOperationErrorMsg{err: err}
 		}
-		a.selector.ShowRegions(a.regions, a.clientMgr.Region())
-		return a, nil
 
-	case "users":
-		return a.navigateToResource("users", "IAM", "Users")
+		return EC2InstanceOperationSuccessMsg{
+			message: fmt.Sprintf("Instance %s is starting", instanceID),
+		}
+	})
+}
 
-	case "roles":
-		return a.navigateToResource("roles", "IAM", "Roles")
+func (a *App) stopEC2Instance(instanceID string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("ec2")
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+		}
 
-	case "policies":
-		return a.navigateToResource("policies", "IAM", "Policies")
+		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
 
-	case "sg":
-		return a.navigateToResource("sg", "EC2", "Security Groups")
+		err := ec2Handler.StopInstance(ctx, instanceID)
+		if err != nil {
+			return EC2InstanceOperationErrorMsg{err: err}
+		}
 
-	case "kms":
-		return a.navigateToResource("kms", "KMS", "Keys")
+		return EC2InstanceOperationSuccessMsg{
+			message: fmt.Sprintf("Instance %s is stopping", instanceID),
+		}
+	})
+}
 
-	case "secrets":
-		return a.navigateToResource("secrets", "Secrets Manager", "Secrets")
+func (a *App) terminateEC2Instance(instanceID string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("ec2")
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+		}
 
-	case "ec2", "instances":
-		return a.navigateToResource("ec2", "EC2", "Instances")
+		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
 
-	case "vpc", "vpcs":
-		return a.navigateToResource("vpc", "VPC", "VPCs")
+		err := ec2Handler.TerminateInstance(ctx, instanceID)
+		if err != nil {
+			return EC2InstanceOperationErrorMsg{err: err}
+		}
 
-	case "rds":
-		return a.navigateToResource("rds", "RDS", "Instances")
+		return EC2InstanceOperationSuccessMsg{
+			message: fmt.Sprintf("Instance %s is shutting down", instanceID),
+		}
+	})
+}
 
-	case "ecs":
-		return a.navigateToResource("ecs", "ECS", "Clusters")
+func (a *App) rebootEC2Instance(instanceID string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("ec2")
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+		}
 
-	case "lambda":
-		return a.navigateToResource("lambda", "Lambda", "Functions")
+		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
+		if !ok {
+			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
 
-	case "logs":
-		return a.navigateToResource("logs", "CloudWatch Logs", "Log Groups")
+		err := ec2Handler.RebootInstance(ctx, instanceID)
+		if err != nil {
+			return EC2InstanceOperationErrorMsg{err: err}
+		}
 
-	case "s3":
-		return a.navigateToResource("s3", "S3", "Buckets")
+		return EC2InstanceOperationSuccessMsg{
+			message: fmt.Sprintf("Instance %s is rebooting", instanceID),
+		}
+	})
+}
 
-	case "dynamodb":
-		return a.navigateToResource("dynamodb", "DynamoDB", "Tables")
+func (a *App) rebootMQBroker(brokerID, brokerName string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("mq")
+		if !ok {
+			return MQBrokerOperationErrorMsg{err: fmt.Errorf("MQ handler not found")}
+		}
 
-	case "export":
-		if len(args) == 0 {
-			a.footer.SetMessage("Usage: :export json|yaml", true)
-			return a, nil
+		mqHandler, ok := handler.(*handlers.MQBrokersHandler)
+		if !ok {
+			return MQBrokerOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
-		return a.exportCurrentResource(args[0])
 
-	case "sso", "sso-login":
-		return a, a.refreshSSOSession()
+		if err := mqHandler.RebootBroker(ctx, brokerID); err != nil {
+			return MQBrokerOperationErrorMsg{err: err}
+		}
 
-	default:
-		a.footer.SetMessage(fmt.Sprintf("Unknown command: %s", command), true)
-		return a, nil
-	}
+		return MQBrokerOperationSuccessMsg{
+			message: fmt.Sprintf("Broker %s is rebooting", brokerName),
+		}
+	})
 }
 
-func (a *App) navigateToResource(shortcut string, breadcrumbParts ...string) (tea.Model, tea.Cmd) {
-	handler, ok := a.registry.Get(shortcut)
+// autoScalingHandler returns the registered Auto Scaling groups handler
+func (a *App) autoScalingHandler() (*handlers.AutoScalingGroupsHandler, error) {
+	handler, ok := a.registry.Get("asg")
 	if !ok {
-		a.footer.SetMessage(fmt.Sprintf("Handler not found: %s", shortcut), true)
-		return a, nil
+		return nil, fmt.Errorf("auto scaling handler not found")
 	}
 
-	a.state = StateResourceList
-	a.breadcrumb.SetPath(breadcrumbParts...)
-
-	// Set header context to the first breadcrumb part (main resource category)
-	if len(breadcrumbParts) > 0 {
-		a.header.SetContext(breadcrumbParts[0])
+	asgHandler, ok := handler.(*handlers.AutoScalingGroupsHandler)
+	if !ok {
+		return nil, fmt.Errorf("invalid handler type")
 	}
+	return asgHandler, nil
+}
 
-	a.resourceList.SetHandler(handler)
-	a.footer.SetHandlerActions(handler.Actions())
-	a.loading = true
-	a.footer.SetLoading(true, fmt.Sprintf("Loading %s...", handler.ResourceName()))
+func (a *App) setDesiredCapacity(groupName string, desired int32) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, err := a.autoScalingHandler()
+		if err != nil {
+			return AutoScalingOperationErrorMsg{err: err}
+		}
 
-	// Update size
-	contentHeight := a.calculateContentHeight()
-	a.resourceList.SetSize(a.width, contentHeight)
+		if err := handler.SetDesiredCapacity(context.Background(), groupName, desired); err != nil {
+			return AutoScalingOperationErrorMsg{err: err}
+		}
 
-	return a, a.resourceList.LoadResources(context.Background(), "")
+		return AutoScalingOperationSuccessMsg{
+			message: fmt.Sprintf("Desired capacity of %s set to %d", groupName, desired),
+		}
+	})
 }
 
-func (a *App) switchProfile(profile string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		if err := a.clientMgr.SwitchProfile(ctx, profile); err != nil {
-			return messages.ErrorMsg{Error: err, Context: "switching profile"}
+func (a *App) startInstanceRefresh(groupName string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, err := a.autoScalingHandler()
+		if err != nil {
+			return AutoScalingOperationErrorMsg{err: err}
 		}
 
-		accountID, _ := a.clientMgr.GetAccountID(ctx)
+		refreshID, err := handler.StartInstanceRefresh(context.Background(), groupName)
+		if err != nil {
+			return AutoScalingOperationErrorMsg{err: err}
+		}
 
-		return awsInitializedMsg{
-			profile:   profile,
-			region:    a.clientMgr.Region(),
-			accountID: accountID,
+		return AutoScalingOperationSuccessMsg{
+			message: fmt.Sprintf("Instance refresh %s started for %s", refreshID, groupName),
 		}
-	}
+	})
 }
 
-func (a *App) switchRegion(region string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		if err := a.clientMgr.SwitchRegion(ctx, region); err != nil {
-			return messages.ErrorMsg{Error: err, Context: "switching region"}
+func (a *App) enterStandby(action *handlers.EnterStandbyAction, instanceID string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, err := a.autoScalingHandler()
+		if err != nil {
+			return AutoScalingOperationErrorMsg{err: err}
 		}
 
-		return awsInitializedMsg{
-			profile:   a.clientMgr.Profile(),
-			region:    region,
-			accountID: "",
+		if err := handler.EnterStandby(context.Background(), action.GroupName, instanceID, action.Decrement); err != nil {
+			return AutoScalingOperationErrorMsg{err: err}
 		}
-	}
-}
-
-// ssoLoginFinishedMsg is sent when the SSO login process completes
-type ssoLoginFinishedMsg struct {
-	err error
-}
 
-func (a *App) refreshSSOSession() tea.Cmd {
-	profile := a.clientMgr.Profile()
-	c := exec.Command("aws", "sso", "login", "--profile", profile)
-	return tea.ExecProcess(c, func(err error) tea.Msg {
-		return ssoLoginFinishedMsg{err: err}
+		message := fmt.Sprintf("%s moved to standby, desired capacity lowered", instanceID)
+		if !action.Decrement {
+			message = fmt.Sprintf("%s moved to standby, a replacement is launched as the group is at its minimum", instanceID)
+		}
+		return AutoScalingOperationSuccessMsg{message: message}
 	})
 }
 
-// ecsExecFinishedMsg is sent when the ECS exec process completes
-type ecsExecFinishedMsg struct {
-	err error
+func (a *App) kmsHandler() (*handlers.KMSKeysHandler, error) {
+	handler, ok := a.registry.Get("kms")
+	if !ok {
+		return nil, fmt.Errorf("KMS handler not found")
+	}
+
+	kmsHandler, ok := handler.(*handlers.KMSKeysHandler)
+	if !ok {
+		return nil, fmt.Errorf("invalid handler type")
+	}
+	return kmsHandler, nil
 }
 
-func (a *App) executeECSExec(clusterARN, taskARN, containerName string) tea.Cmd {
-	cmd := exec.Command(
-		"aws", "ecs", "execute-command",
-		"--cluster", clusterARN,
-		"--task", taskARN,
-		"--container", containerName,
-		"--command", "/bin/bash",
-		"--interactive",
-	)
+func (a *App) loadKeyPolicy(keyID, keyName string) tea.Cmd {
+	return func() tea.Msg {
+		handler, err := a.kmsHandler()
+		if err != nil {
+			return UserDataErrorMsg{err: err}
+		}
 
-	// Set AWS environment variables
-	cmd.Env = append(os.Environ(),
-		fmt.Sprintf("AWS_REGION=%s", a.clientMgr.Region()),
-		fmt.Sprintf("AWS_PROFILE=%s", a.clientMgr.Profile()),
-	)
+		data, err := handler.GetKeyPolicyForView(context.Background(), keyID)
+		if err != nil {
+			return UserDataErrorMsg{err: err}
+		}
 
-	return tea.ExecProcess(cmd, func(err error) tea.Msg {
-		return ecsExecFinishedMsg{err: err}
-	})
+		return UserDataLoadedMsg{
+			title: fmt.Sprintf("Key Policy for: %s", keyName),
+			data:  data,
+		}
+	}
 }
 
-// exportCurrentResource exports the selected resource or list to a file
-func (a *App) exportCurrentResource(formatStr string) (tea.Model, tea.Cmd) {
-	if a.state != StateResourceList {
-		a.footer.SetMessage("Export is only available in resource list view", true)
-		return a, nil
-	}
+func (a *App) setKeyRotation(keyID, keyName string, enable bool) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, err := a.kmsHandler()
+		if err != nil {
+			return KMSOperationErrorMsg{err: err}
+		}
 
-	var format utils.ExportFormat
-	switch strings.ToLower(formatStr) {
-	case "json":
-		format = utils.ExportJSON
-	case "yaml", "yml":
-		format = utils.ExportYAML
-	default:
-		a.footer.SetMessage(fmt.Sprintf("Unknown format: %s. Use json or yaml", formatStr), true)
-		return a, nil
-	}
+		if err := handler.SetKeyRotation(context.Background(), keyID, enable); err != nil {
+			return KMSOperationErrorMsg{err: err}
+		}
 
-	handler := a.resourceList.Handler()
-	if handler == nil {
-		a.footer.SetMessage("No resource handler active", true)
-		return a, nil
-	}
+		if enable {
+			return KMSOperationSuccessMsg{message: fmt.Sprintf("Annual rotation enabled for %s", keyName)}
+		}
+		return KMSOperationSuccessMsg{message: fmt.Sprintf("Rotation disabled for %s", keyName)}
+	})
+}
 
-	// Get selected resource or export list
-	selected := a.resourceList.GetSelectedResource()
-	exporter := utils.NewExporter(".")
+func (a *App) scheduleKeyDeletion(keyID, keyName string, window int32) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, err := a.kmsHandler()
+		if err != nil {
+			return KMSOperationErrorMsg{err: err}
+		}
 
-	if selected != nil {
-		// Export single resource detail
-		ctx := context.Background()
-		details, err := handler.Describe(ctx, selected.GetID())
+		deletionDate, err := handler.ScheduleKeyDeletion(context.Background(), keyID, window)
 		if err != nil {
-			a.footer.SetMessage(fmt.Sprintf("Failed to get resource details: %v", err), true)
-			return a, nil
+			return KMSOperationErrorMsg{err: err}
 		}
 
-		filepath, err := exporter.Export(details, handler.ResourceType(), selected.GetID(), format)
+		return KMSOperationSuccessMsg{
+			message: fmt.Sprintf("%s scheduled for deletion on %s", keyName, deletionDate.Format("2006-01-02")),
+		}
+	})
+}
+
+func (a *App) cancelKeyDeletion(keyID, keyName string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, err := a.kmsHandler()
 		if err != nil {
-			a.footer.SetMessage(fmt.Sprintf("Export failed: %v", err), true)
-			return a, nil
+			return KMSOperationErrorMsg{err: err}
 		}
 
-		a.footer.SetMessage(fmt.Sprintf("Exported to %s", filepath), false)
-	} else {
-		a.footer.SetMessage("No resource selected to export", true)
-	}
+		if err := handler.CancelKeyDeletion(context.Background(), keyID); err != nil {
+			return KMSOperationErrorMsg{err: err}
+		}
 
-	return a, nil
+		return KMSOperationSuccessMsg{message: fmt.Sprintf("Deletion of %s cancelled, the key is disabled", keyName)}
+	})
 }
 
-// navigateToBookmark navigates to a bookmarked resource
-func (a *App) navigateToBookmark(bookmark config.Bookmark) (tea.Model, tea.Cmd) {
-	// Get the shortcut key from resource type (e.g., "iam:users" -> "users")
-	shortcut := bookmark.ResourceType
-	parts := strings.Split(bookmark.ResourceType, ":")
-	if len(parts) > 1 {
-		shortcut = parts[1]
-	}
-
-	handler, ok := a.registry.Get(shortcut)
-	if !ok {
-		// Try with full type
-		handler, ok = a.registry.Get(bookmark.ResourceType)
+// registerTarget registers an instance with the target group of the current targets view
+func (a *App) registerTarget(tgARN, targetID string) tea.Cmd {
+	handler, ok := a.resourceList.Handler().(*handlers.ELBTargetsHandler)
+	return a.forList(func() tea.Msg {
 		if !ok {
-			a.footer.SetMessage(fmt.Sprintf("Handler not found for: %s", bookmark.ResourceType), true)
-			return a, nil
+			return ELBTargetOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
-	}
 
-	// Check if we need to switch region
-	if bookmark.Region != "" && bookmark.Region != a.clientMgr.Region() {
-		ctx := context.Background()
-		if err := a.clientMgr.SwitchRegion(ctx, bookmark.Region); err != nil {
-			a.footer.SetMessage(fmt.Sprintf("Failed to switch region: %v", err), true)
-			return a, nil
+		if err := handler.RegisterTarget(context.Background(), tgARN, targetID); err != nil {
+			return ELBTargetOperationErrorMsg{err: err}
 		}
-		a.header.SetRegion(bookmark.Region)
-		// Re-register handlers for new region
-		a.registerHandlers()
 
-		// Get handler again after re-registering
-		handler, ok = a.registry.Get(shortcut)
-		if !ok {
-			handler, ok = a.registry.Get(bookmark.ResourceType)
-			if !ok {
-				a.footer.SetMessage(fmt.Sprintf("Handler not found for: %s", bookmark.ResourceType), true)
-				return a, nil
-			}
+		return ELBTargetOperationSuccessMsg{
+			message: fmt.Sprintf("Registered %s, waiting for health checks", targetID),
 		}
-	}
+	})
+}
 
-	// Navigate to the resource type
-	a.state = StateResourceList
-	a.breadcrumb.SetPath(handler.ResourceName())
-	a.resourceList.SetHandler(handler)
-	a.footer.SetHandlerActions(handler.Actions())
-	a.loading = true
-	a.footer.SetLoading(true, fmt.Sprintf("Loading %s...", handler.ResourceName()))
+// deregisterTarget deregisters a target from the target group of the current targets view
+func (a *App) deregisterTarget(action *handlers.DeregisterTargetAction) tea.Cmd {
+	handler, ok := a.resourceList.Handler().(*handlers.ELBTargetsHandler)
+	return a.forList(func() tea.Msg {
+		if !ok {
+			return ELBTargetOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
 
-	// Update size
-	contentHeight := a.calculateContentHeight()
-	a.resourceList.SetSize(a.width, contentHeight)
+		if err := handler.DeregisterTarget(context.Background(), action.TargetGroupARN, action.TargetID, action.Port); err != nil {
+			return ELBTargetOperationErrorMsg{err: err}
+		}
 
-	return a, a.resourceList.LoadResources(context.Background(), "")
+		return ELBTargetOperationSuccessMsg{
+			message: fmt.Sprintf("Deregistering %s from %s", action.TargetID, action.TargetGroupName),
+		}
+	})
 }
 
-// View renders the UI
-func (a *App) View() string {
-	if a.width == 0 {
-		return "Loading..."
-	}
+// testInvokeRoute invokes an API Gateway route and shows the status, latency and response
+func (a *App) testInvokeRoute(action *handlers.TestInvokeRouteAction) tea.Cmd {
+	return func() tea.Msg {
+		handler := handlers.NewAPIGatewayRoutesHandler(
+			a.clientMgr.APIGateway(),
+			a.clientMgr.APIGatewayV2(),
+			a.clientMgr.Region(),
+			action.API,
+			action.Stage,
+		)
+		result, err := handler.TestInvoke(context.Background(), action)
+		if err != nil {
+			return RouteInvokeErrorMsg{err: err}
+		}
 
-	// Build layout
-	header := a.header.View()
-	breadcrumb := a.breadcrumb.View()
-	footer := a.footer.View()
+		data := map[string]interface{}{
+			"Status":  result.Status,
+			"Latency": result.Latency.String(),
+			"Headers": result.Headers,
+		}
 
-	// Calculate content height
-	headerHeight := lipgloss.Height(header)
-	breadcrumbHeight := lipgloss.Height(breadcrumb)
-	footerHeight := lipgloss.Height(footer)
-	contentHeight := a.height - headerHeight - breadcrumbHeight - footerHeight
+		// Show JSON bodies structured rather than as an escaped string
+		var body interface{} = result.Body
+		var parsed interface{}
+		if err := json.Unmarshal([]byte(result.Body), &parsed); err == nil {
+			body = parsed
+		}
+		data["Body"] = body
 
-	// Render main content
-	var content string
-	switch a.state {
-	case StateHome:
-		content = a.renderHome(contentHeight)
-	case StateResourceList:
-		content = a.resourceList.View()
-	case StateSecretEditor:
-		content = a.secretEditor.View()
-	case StateSecretCreator:
-		content = a.secretCreator.View()
-	default:
-		content = a.renderHome(contentHeight)
-	}
+		if result.Log != "" {
+			data["Log"] = strings.Split(strings.TrimSpace(result.Log), "\n")
+		}
 
-	// Add command mode overlay
-	if a.mode == ModeCommand {
-		content = a.overlayCommand(content, contentHeight)
+		return RouteInvokedMsg{
+			title: fmt.Sprintf("%s %s → %d", action.Method, action.Path, result.Status),
+			data:  data,
+		}
 	}
+}
 
-	// Add confirmation dialog overlay
-	if a.mode == ModeConfirm {
-		content = a.overlayConfirm(content)
-	}
+// findFlowLogStreams finds the flow log streams of each interface, which are named after
+// the interface, and follows them in the log tail view
+func (a *App) findFlowLogStreams(action *handlers.ViewFlowLogsAction, groups []string) tea.Cmd {
+	client := logsadapter.NewLogsClient(a.clientMgr.CloudWatchLogsForRegion(action.Region))
 
-	// Compose the view
-	view := lipgloss.JoinVertical(
-		lipgloss.Left,
-		header,
-		breadcrumb,
-		content,
-		footer,
-	)
+	return func() tea.Msg {
+		ctx := context.Background()
+		var targets []handlers.LogTailTarget
+		for _, group := range groups {
+			for _, eniID := range action.InterfaceIDs {
+				streams, err := client.ListLogStreamNames(ctx, group, eniID+"-")
+				if err != nil {
+					return messages.ErrorMsg{Error: err, Context: "finding flow log streams"}
+				}
+				for _, stream := range streams {
+					targets = append(targets, handlers.LogTailTarget{
+						Label:  stream,
+						Group:  group,
+						Stream: stream,
+						Region: action.Region,
+					})
+				}
+			}
+		}
 
-	// Overlay info dialog if visible
-	if a.infoDialog.IsVisible() {
-		view = a.infoDialog.View()
-	}
+		if len(targets) == 0 {
+			return messages.ErrorMsg{
+				Error:   fmt.Errorf("no flow log streams for %s in %s", action.Title, strings.Join(groups, ", ")),
+				Context: "finding flow log streams",
+			}
+		}
 
-	// Overlay selector if active
-	if a.selector.IsActive() {
-		view = a.selector.View()
+		return &handlers.TailLogsAction{
+			Title:   fmt.Sprintf("flow logs %s", action.Title),
+			Targets: targets,
+		}
 	}
+}
 
-	// Overlay bookmark selector if active
-	if a.bookmarkSelector.IsActive() {
-		view = a.bookmarkSelector.View()
-	}
+// tailLogGroups finds the log groups matching the glob patterns and tails them together
+func (a *App) tailLogGroups(patterns []string) tea.Cmd {
+	client := logsadapter.NewLogsClient(a.clientMgr.CloudWatchLogs())
+	region := a.clientMgr.Region()
 
-	return view
+	return func() tea.Msg {
+		ctx := context.Background()
+		var groups []string
+		for _, pattern := range patterns {
+			// Only the part before the first wildcard narrows the listing down
+			prefix := pattern
+			if i := strings.IndexAny(pattern, "*?["); i >= 0 {
+				prefix = pattern[:i]
+			}
+			names, _, err := client.ListLogGroupNames(ctx, prefix, a.config.MaxListItems)
+			if err != nil {
+				return messages.ErrorMsg{Error: err, Context: "finding log groups"}
+			}
+			for _, name := range names {
+				if ok, _ := path.Match(pattern, name); ok && !slices.Contains(groups, name) {
+					groups = append(groups, name)
+				}
+			}
+		}
+
+		if len(groups) == 0 {
+			return messages.ErrorMsg{
+				Error:   fmt.Errorf("no log groups match %s", strings.Join(patterns, " ")),
+				Context: "finding log groups",
+			}
+		}
+		if len(groups) > handlers.MaxTailedLogGroups {
+			return messages.ErrorMsg{
+				Error:   fmt.Errorf("%d log groups match, at most %d can be tailed together: %s...", len(groups), handlers.MaxTailedLogGroups, strings.Join(groups[:3], ", ")),
+				Context: "finding log groups",
+			}
+		}
+		return handlers.TailLogGroupsAction(groups, region)
+	}
 }
 
-func (a *App) renderHome(height int) string {
-	title := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("212")).
-		Render("Welcome to aws-tui")
+// analyzeReachability runs a Reachability Analyzer analysis between two resources and shows
+// the path, or what blocks it, in the destination's detail pane
+func (a *App) analyzeReachability(source, destination string) tea.Cmd {
+	client := ec2adapter.NewReachabilityClient(a.clientMgr.EC2())
 
-	subtitle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245")).
-		Render("A terminal UI for AWS resource management")
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
 
-	commands := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252")).
-		MarginTop(2).
-		Render(`Commands:
-  :users      - List IAM Users
-  :roles      - List IAM Roles
-  :policies   - List IAM Policies
-  :ec2        - List EC2 Instances
-  :vpc        - List VPCs
-  :sg         - List Security Groups
-  :rds        - List RDS Instances
-  :ecs        - List ECS Clusters
-  :lambda     - List Lambda Functions
-  :logs       - List CloudWatch Log Groups
-  :s3         - List S3 Buckets
-  :dynamodb   - List DynamoDB Tables
-  :kms        - List KMS Keys
-  :secrets    - List Secrets
-  :profile    - Switch AWS Profile
-  :region     - Switch AWS Region
-  :export     - Export resource (json|yaml)
-  :q          - Quit
-
-Shortcuts:
-  p           - Profile selector
-  R           - Region selector
-  ?           - Help
-
-Navigation:
-  j/k         - Move up/down
-  enter/l     - Select/Enter
-  esc/h       - Back
-  d           - Describe resource
-  /           - Search
-  t           - Filter by tags
-  r           - Refresh list
-  n/]         - Next page
-  N/[         - Previous page
-  m           - Bookmark resource
-  '           - Show bookmarks
-  c           - Copy ARN to clipboard
-  C           - Copy JSON to clipboard`)
-
-	content := lipgloss.JoinVertical(
-		lipgloss.Center,
-		title,
-		subtitle,
-		commands,
-	)
+		analysis, err := client.AnalyzePath(ctx, source, destination)
+		if err != nil {
+			return ReachabilityErrorMsg{destination: destination, err: err}
+		}
 
-	return lipgloss.Place(
-		a.width,
-		height,
-		lipgloss.Center,
-		lipgloss.Center,
-		content,
-	)
-}
+		verdict := "reachable"
+		switch {
+		case analysis.Status == "failed":
+			verdict = "analysis failed"
+		case !analysis.Reachable:
+			verdict = "not reachable"
+		}
 
-func (a *App) overlayCommand(content string, height int) string {
-	commandBox := a.theme.Command.Width(a.width).Render(a.commandInput.View())
+		summary := map[string]interface{}{
+			"Source":      source,
+			"Destination": destination,
+			"Result":      verdict,
+			"Status":      analysis.Status,
+			"PathId":      analysis.PathID,
+			"AnalysisId":  analysis.AnalysisID,
+		}
+		if analysis.Source != source {
+			summary["SourceInterface"] = analysis.Source
+		}
+		if analysis.Destination != destination {
+			summary["DestinationInterface"] = analysis.Destination
+		}
+		if analysis.StatusMessage != "" {
+			summary["StatusMessage"] = analysis.StatusMessage
+		}
 
-	// Get autocomplete suggestions if available
-	var autocompleteBox string
-	if a.autocomplete.HasSuggestions() {
-		autocompleteBox = a.autocomplete.View(a.width)
-	}
+		data := map[string]interface{}{
+			"Reachability": summary,
+		}
+		if len(analysis.Hops) > 0 {
+			hops := make([]string, 0, len(analysis.Hops))
+			for i, hop := range analysis.Hops {
+				hops = append(hops, fmt.Sprintf("%d. %s", i+1, hop))
+			}
+			data["Path"] = hops
+		}
+		if len(analysis.Explanations) > 0 {
+			blocked := make([]map[string]interface{}, 0, len(analysis.Explanations))
+			for _, explanation := range analysis.Explanations {
+				entry := map[string]interface{}{
+					"Component": fmt.Sprintf("%s %s", explanation.Kind, explanation.Component),
+					"Reason":    explanation.Code,
+				}
+				if explanation.Direction != "" {
+					entry["Direction"] = explanation.Direction
+				}
+				if explanation.Detail != "" {
+					entry["Rule"] = explanation.Detail
+				}
+				blocked = append(blocked, entry)
+			}
+			data["BlockedBy"] = blocked
+		}
 
-	lines := strings.Split(content, "\n")
-	linesToRemove := 1
-	if autocompleteBox != "" {
-		// Count lines in autocomplete box and remove that many additional lines
-		autocompleteLines := strings.Count(autocompleteBox, "\n") + 1
-		linesToRemove += autocompleteLines
+		return ReachabilityAnalyzedMsg{
+			destination: destination,
+			verdict:     fmt.Sprintf("%s → %s: %s", source, destination, verdict),
+			data:        data,
+		}
 	}
+}
 
-	if len(lines) > linesToRemove {
-		lines = lines[linesToRemove:] // Remove lines to make room for command and autocomplete
-	}
+// configHistoryLimit is how many configuration snapshots the timeline goes back
+const configHistoryLimit = 25
 
-	result := commandBox
-	if autocompleteBox != "" {
-		result += "\n" + autocompleteBox
+// showConfigHistory fetches the AWS Config history of the selected resource and shows its
+// timeline of configuration changes in the detail pane
+func (a *App) showConfigHistory() tea.Cmd {
+	res := a.resourceList.GetSelectedResource()
+	if res == nil {
+		return nil
 	}
-	result += "\n" + strings.Join(lines, "\n")
-
-	return result
-}
-
-func (a *App) overlayConfirm(content string) string {
-	// Center the dialog
-	lines := strings.Split(content, "\n")
-	if len(lines) > 5 {
-		lines = lines[5:]
+	configTypes := handlers.ConfigResourceTypes(res.GetType())
+	if len(configTypes) == 0 {
+		a.footer.SetMessage(fmt.Sprintf("AWS Config doesn't record %s", res.GetType()), true)
+		return nil
 	}
 
-	dialog := a.confirmDialog.View()
-	result := dialog + "\n" + strings.Join(lines, "\n")
+	var ids []string
+	for _, id := range []string{res.GetID(), res.GetARN()} {
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	resourceID, name := res.GetID(), res.GetName()
+	client := configadapter.NewHistoryClient(a.clientMgr.ConfigService())
 
-	return result
+	a.footer.SetLoading(true, fmt.Sprintf("Fetching configuration history of %s...", name))
+	return tea.Batch(
+		a.resourceList.OpenDetail(resourceID),
+		func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			recorded, err := client.FindResource(ctx, configTypes, ids, name)
+			if err != nil {
+				return ConfigHistoryErrorMsg{resourceID: resourceID, err: err}
+			}
+			items, err := client.History(ctx, recorded, configHistoryLimit)
+			if err != nil {
+				return ConfigHistoryErrorMsg{resourceID: resourceID, err: err}
+			}
+			return ConfigHistoryLoadedMsg{
+				resourceID: resourceID,
+				name:       name,
+				snapshots:  len(items),
+				data:       handlers.ConfigTimeline(recorded, items),
+			}
+		},
+	)
 }
 
-// Message types for secret operations
-type SecretLoadedMsg struct {
-	name  string
-	value string
-}
+// showTestEventPicker opens the test event picker for a function, with the cursor on selected if set
+func (a *App) showTestEventPicker(functionName, selected string) tea.Cmd {
+	events, err := a.testEventStore.List(functionName)
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Failed to load test events: %v", err), true)
+	}
 
-type SecretLoadedForEditMsg struct {
-	id    string
-	name  string
-	value string
+	a.testEventPicker.SetSize(a.width, a.height)
+	a.testEventPicker.Show(functionName, events, a.testEventStore.HasShared())
+	if selected != "" {
+		a.testEventPicker.Select(selected)
+	}
+	return nil
 }
 
-type SecretLoadErrorMsg struct {
-	err error
-}
+// invokeLambdaFunction invokes a function with a test event and shows the response and log tail
+func (a *App) invokeLambdaFunction(msg components.TestEventInvokeMsg) tea.Cmd {
+	return func() tea.Msg {
+		handler := handlers.NewLambdaFunctionsHandler(a.clientMgr.Lambda(), a.clientMgr.Region())
+		result, err := handler.Invoke(context.Background(), msg.Function, msg.Payload)
+		if err != nil {
+			return RouteInvokeErrorMsg{err: err}
+		}
 
-type SecretSavedMsg struct {
-	secretID string
-}
+		data := map[string]interface{}{
+			"Status":   result.StatusCode,
+			"Version":  result.ExecutedVersion,
+			"Duration": result.Duration.Round(time.Millisecond).String(),
+		}
+		if result.FunctionError != "" {
+			data["FunctionError"] = result.FunctionError
+		}
 
-type SecretSaveErrorMsg struct {
-	err error
-}
+		// Show JSON responses structured rather than as an escaped string
+		var response interface{} = result.Payload
+		var parsed interface{}
+		if err := json.Unmarshal([]byte(result.Payload), &parsed); err == nil {
+			response = parsed
+		}
+		data["Response"] = response
 
-// Secret creation messages
-type SecretCreatedMsg struct {
-	secretName string
-}
+		if result.LogTail != "" {
+			data["Log"] = strings.Split(strings.TrimSpace(result.LogTail), "\n")
+		}
 
-type SecretCreateErrorMsg struct {
-	err error
+		event := msg.EventName
+		if event == "" {
+			event = "unsaved event"
+		}
+		title := fmt.Sprintf("%s with %s → %d", msg.Function, event, result.StatusCode)
+		if result.FunctionError != "" {
+			title += " " + result.FunctionError
+		}
+		return RouteInvokedMsg{title: title, data: data}
+	}
 }
 
-// Secret deletion messages
-type SecretDeletedMsg struct {
-	secretID string
+// publishLambdaVersion publishes $LATEST of a function as a new version
+func (a *App) publishLambdaVersion(functionName string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler := handlers.NewLambdaVersionsHandler(a.clientMgr.Lambda(), a.clientMgr.Region(), functionName)
+		version, err := handler.PublishVersion(context.Background())
+		if err != nil {
+			return LambdaOperationErrorMsg{err: err}
+		}
+		return LambdaOperationSuccessMsg{
+			message: fmt.Sprintf("Published version %s of %s", version, functionName),
+		}
+	})
 }
 
-type SecretDeleteErrorMsg struct {
-	err error
+// updateLambdaAlias points an alias of a function at a version
+func (a *App) updateLambdaAlias(functionName, aliasName, version string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler := handlers.NewLambdaVersionsHandler(a.clientMgr.Lambda(), a.clientMgr.Region(), functionName)
+		if err := handler.UpdateAlias(context.Background(), aliasName, version); err != nil {
+			return LambdaOperationErrorMsg{err: err}
+		}
+		return LambdaOperationSuccessMsg{
+			message: fmt.Sprintf("Alias %s now points at version %s", aliasName, version),
+		}
+	})
 }
 
-// IAM User data messages
-type UserDataLoadedMsg struct {
-	title string
-	data  interface{}
+// setLambdaConcurrency sets the reserved concurrency of a function, removing it if reserved is negative
+func (a *App) setLambdaConcurrency(functionName string, reserved int32) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler := handlers.NewLambdaVersionsHandler(a.clientMgr.Lambda(), a.clientMgr.Region(), functionName)
+		if err := handler.SetReservedConcurrency(context.Background(), reserved); err != nil {
+			return LambdaOperationErrorMsg{err: err}
+		}
+		message := fmt.Sprintf("Reserved concurrency of %s set to %d", functionName, reserved)
+		if reserved < 0 {
+			message = fmt.Sprintf("Removed reserved concurrency of %s", functionName)
+		}
+		return LambdaOperationSuccessMsg{message: message}
+	})
 }
 
-type UserDataErrorMsg struct {
-	err error
-}
+func (a *App) loadConnectionInfo(instanceID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		handler, ok := a.registry.Get("ec2")
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+		}
 
-// EC2 Instance operation messages
-type EC2InstanceOperationSuccessMsg struct {
-	message string
-}
+		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
+		if !ok {
+			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
 
-type EC2InstanceOperationErrorMsg struct {
-	err error
-}
+		data, err := ec2Handler.GetConnectionInfo(ctx, instanceID)
+		if err != nil {
+			return UserDataErrorMsg{err: err}
+		}
 
-// DynamoDB Item operation messages
-type ItemLoadedForEditMsg struct {
-	itemID    string
-	tableName string
-	itemKey   string
-	itemData  map[string]interface{}
+		return UserDataLoadedMsg{
+			title: fmt.Sprintf("Connection Info for Instance: %s", instanceID),
+			data:  data,
+		}
+	}
 }
 
-type ItemSavedMsg struct {
-	itemID string
-}
+// S3 Bucket operation functions
 
-type ItemSaveErrorMsg struct {
-	err error
+// deleteS3Object deletes an object from the browsed bucket
+func (a *App) deleteS3Object(bucket, key string) tea.Cmd {
+	handler := handlers.NewS3ObjectsHandler(a.clientMgr.S3(), a.clientMgr.Region(), bucket, "")
+	return a.forList(func() tea.Msg {
+		if err := handler.DeleteObject(context.Background(), key); err != nil {
+			return ResourceDeleteErrorMsg{err: err}
+		}
+		return ResourceDeletedMsg{message: fmt.Sprintf("Deleted s3://%s/%s", bucket, key)}
+	})
 }
 
-type ItemDeletedMsg struct {
-	itemID string
+// previewS3Download lists what a download of the prefix would fetch
+func (a *App) previewS3Download(bucket, prefix string) tea.Cmd {
+	handler := handlers.NewS3ObjectsHandler(a.clientMgr.S3(), a.clientMgr.Region(), bucket, "")
+	return func() tea.Msg {
+		preview, err := handler.PreviewDownload(context.Background(), prefix)
+		return S3DownloadPreviewMsg{preview: preview, err: err}
+	}
 }
 
-type ItemDeleteErrorMsg struct {
-	err error
+// startS3Download downloads the previewed objects in the background, streaming progress
+// until an S3DownloadDoneMsg arrives
+func (a *App) startS3Download(preview *handlers.DownloadPreview, dir string) tea.Cmd {
+	handler := handlers.NewS3ObjectsHandler(a.clientMgr.S3(), a.clientMgr.Region(), preview.Bucket, "")
+	events := make(chan tea.Msg, 1)
+
+	go func() {
+		result := handler.Download(context.Background(), preview, dir, func(progress s3adapter.DownloadProgress) {
+			// Drop the update if the previous one hasn't been rendered yet
+			select {
+			case events <- S3DownloadProgressMsg{progress: progress, events: events}:
+			default:
+			}
+		})
+		events <- S3DownloadDoneMsg{result: result, dir: dir}
+	}()
+
+	a.s3Transferring = true
+	a.footer.SetLoading(true, fmt.Sprintf("Downloading %d objects...", len(preview.Objects)))
+	return waitForS3Transfer(events)
 }
 
-type ItemLoadErrorMsg struct {
-	err error
+// waitForS3Transfer waits for the next progress update or the end of a download or upload
+func waitForS3Transfer(events <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-events
+	}
 }
 
-// handleConfirmMode handles confirmation dialog input
-func (a *App) handleConfirmMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "y", "Y":
-		// User confirmed
-		a.mode = ModeNormal
+// startS3ObjectDownload downloads a single object to file in the background, streaming
+// progress until an S3TransferDoneMsg arrives
+func (a *App) startS3ObjectDownload(action *handlers.DownloadObjectAction, file string) tea.Cmd {
+	handler := handlers.NewS3ObjectsHandler(a.clientMgr.S3(), a.clientMgr.Region(), action.Bucket, "")
+	events := make(chan tea.Msg, 1)
 
-		if deleteAction, ok := a.pendingAction.(*handlers.DeleteSecretAction); ok {
-			// Get recovery window from dialog input
-			recoveryWindow := 30 // default
-			if input := a.confirmDialog.GetInput(); input != "" {
-				if val, err := strconv.Atoi(input); err == nil {
-					if val < 7 || val > 30 {
-						a.footer.SetMessage("Recovery window must be 7-30 days", true)
-						return a, nil
-					}
-					recoveryWindow = val
-				} else {
-					a.footer.SetMessage("Invalid recovery window (must be a number)", true)
-					return a, nil
-				}
-			}
-			a.pendingAction = nil
-			a.confirmDialog.Reset()
-			return a, a.deleteSecret(deleteAction.SecretID, deleteAction.SecretName, recoveryWindow)
+	go func() {
+		result, err := handler.DownloadFile(context.Background(), action.Key, file, s3TransferProgress(events, "Downloading", action.Key))
+		events <- S3TransferDoneMsg{result: result, err: err}
+	}()
+
+	a.s3Transferring = true
+	a.footer.SetLoading(true, fmt.Sprintf("Downloading %s...", path.Base(action.Key)))
+	return waitForS3Transfer(events)
+}
+
+func (a *App) restoreS3Object(req *handlers.RestoreObjectRequest) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		objectsHandler, ok := a.resourceList.Handler().(*handlers.S3ObjectsHandler)
+		if !ok {
+			return S3ObjectOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		if viewAction, ok := a.pendingAction.(*handlers.ViewSecretAction); ok {
-			a.pendingAction = nil
-			a.confirmDialog.Reset()
-			return a, a.loadAndViewSecret(viewAction.SecretID, viewAction.SecretName)
+		if err := objectsHandler.RestoreObject(context.Background(), req); err != nil {
+			return S3ObjectOperationErrorMsg{err: err}
 		}
+		return S3ObjectOperationSuccessMsg{message: fmt.Sprintf("Restore of %s started, %s retrieval takes %s",
+			path.Base(req.Key), req.Tier, handlers.RestoreTime(req.StorageClass, req.Tier))}
+	})
+}
 
-		if deleteItemAction, ok := a.pendingAction.(*handlers.DeleteItemAction); ok {
-			a.pendingAction = nil
-			a.confirmDialog.Reset()
-			a.footer.SetLoading(true, "Deleting item...")
-			return a, a.deleteItem(deleteItemAction.ItemID, deleteItemAction.TableName)
+func (a *App) setS3StorageClass(key, storageClass string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		objectsHandler, ok := a.resourceList.Handler().(*handlers.S3ObjectsHandler)
+		if !ok {
+			return S3ObjectOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		a.pendingAction = nil
-		a.confirmDialog.Reset()
-		return a, nil
+		if err := objectsHandler.SetStorageClass(context.Background(), key, storageClass); err != nil {
+			return S3ObjectOperationErrorMsg{err: err}
+		}
+		return S3ObjectOperationSuccessMsg{message: fmt.Sprintf("Moved %s to %s", path.Base(key), storageClass)}
+	})
+}
 
-	case "n", "N", "esc":
-		// User cancelled
-		a.mode = ModeNormal
-		a.pendingAction = nil
-		a.confirmDialog.Reset()
-		return a, nil
+// loadBucketConfig loads the lifecycle or CORS rules of a bucket for the JSON editor
+func (a *App) loadBucketConfig(action *handlers.EditBucketConfigAction) tea.Cmd {
+	return func() tea.Msg {
+		bucketsHandler, ok := a.resourceList.Handler().(*handlers.S3BucketsHandler)
+		if !ok {
+			return S3BucketOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
 
-	default:
-		// Route input to confirm dialog if it has input field
-		if a.confirmDialog.HasInput() {
-			var cmd tea.Cmd
-			a.confirmDialog, cmd = a.confirmDialog.Update(msg)
-			return a, cmd
+		document, err := bucketsHandler.BucketConfigJSON(context.Background(), action.Bucket, action.Config)
+		if err != nil {
+			return S3BucketOperationErrorMsg{err: err}
 		}
+		return BucketConfigLoadedMsg{action: action, document: document}
 	}
-
-	return a, nil
 }
 
-// handleSecretEditorMode handles secret editor input
-func (a *App) handleSecretEditorMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
-		// Cancel editing
-		a.state = StateResourceList
-		return a, nil
+func (a *App) applyBucketConfig(action *handlers.ApplyBucketConfigAction) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		bucketsHandler, ok := a.resourceList.Handler().(*handlers.S3BucketsHandler)
+		if !ok {
+			return S3BucketOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		}
 
-	case "ctrl+s":
-		// Determine what we're editing based on the handler type
-		handler := a.resourceList.Handler()
-		if _, ok := handler.(*handlers.DynamoDBItemsHandler); ok {
-			// Editing a DynamoDB item
-			a.footer.SetLoading(true, "Saving item...")
-			itemID := a.secretEditor.GetSecretID()
-			// Extract table name from breadcrumb or handler
-			tableName := ""
-			if h, ok := handler.(*handlers.DynamoDBItemsHandler); ok {
-				tableName = h.ResourceType() // This will work if we have the table name available
-			}
-			return a, a.saveItem(itemID, tableName)
-		} else {
-			// Editing a secret
-			a.footer.SetLoading(true, "Saving secret...")
-			return a, a.saveSecret()
+		if err := bucketsHandler.ApplyBucketConfig(context.Background(), action.Bucket, action.Config, action.Document); err != nil {
+			return S3BucketOperationErrorMsg{err: err}
 		}
-	}
 
-	// Pass other keys to editor
-	var cmd tea.Cmd
-	a.secretEditor, cmd = a.secretEditor.Update(msg)
-	return a, cmd
+		title := handlers.BucketConfigTitle(action.Config)
+		if action.Rules == 0 {
+			return S3BucketOperationSuccessMsg{message: fmt.Sprintf("Removed the %s rules of %s", title, action.Bucket)}
+		}
+		return S3BucketOperationSuccessMsg{message: fmt.Sprintf("Applied %d %s rules to %s", action.Rules, title, action.Bucket)}
+	})
 }
 
-// handleSecretCreatorMode handles secret creator input
-func (a *App) handleSecretCreatorMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
-		// Cancel creation
-		a.state = StateResourceList
-		a.secretCreator.Reset()
-		return a, nil
-
-	case "ctrl+s":
-		// Submit form
-		if err := a.secretCreator.Validate(); err != nil {
-			a.footer.SetMessage("Please fix validation errors", true)
-			return a, nil
+func (a *App) setBucketVersioning(bucket string, enable bool) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		bucketsHandler, ok := a.resourceList.Handler().(*handlers.S3BucketsHandler)
+		if !ok {
+			return S3BucketOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
-		a.footer.SetLoading(true, "Creating secret...")
-		params := a.secretCreator.GetParams()
-		return a, a.createSecret(params)
-	}
 
-	// Pass to creator for field handling
-	var cmd tea.Cmd
-	a.secretCreator, cmd = a.secretCreator.Update(msg)
-	return a, cmd
+		if err := bucketsHandler.SetVersioning(context.Background(), bucket, enable); err != nil {
+			return S3BucketOperationErrorMsg{err: err}
+		}
+		if enable {
+			return S3BucketOperationSuccessMsg{message: fmt.Sprintf("Enabled versioning on %s", bucket)}
+		}
+		return S3BucketOperationSuccessMsg{message: fmt.Sprintf("Suspended versioning on %s", bucket)}
+	})
 }
 
-// loadAndViewSecret loads a secret value for viewing
-func (a *App) loadAndViewSecret(secretID, secretName string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("secrets")
+func (a *App) setBucketEncryption(bucket, kmsKeyID string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		bucketsHandler, ok := a.resourceList.Handler().(*handlers.S3BucketsHandler)
 		if !ok {
-			return SecretLoadErrorMsg{err: fmt.Errorf("secrets handler not found")}
+			return S3BucketOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		secretsHandler, ok := handler.(*handlers.SecretsHandler)
-		if !ok {
-			return SecretLoadErrorMsg{err: fmt.Errorf("invalid handler type")}
+		if err := bucketsHandler.SetEncryption(context.Background(), bucket, kmsKeyID); err != nil {
+			return S3BucketOperationErrorMsg{err: err}
 		}
+		if kmsKeyID == "" {
+			return S3BucketOperationSuccessMsg{message: fmt.Sprintf("%s now encrypts new objects with SSE-S3", bucket)}
+		}
+		return S3BucketOperationSuccessMsg{message: fmt.Sprintf("%s now encrypts new objects with KMS key %s", bucket, kmsKeyID)}
+	})
+}
 
-		value, err := secretsHandler.GetSecretValueForView(ctx, secretID)
-		if err != nil {
-			return SecretLoadErrorMsg{err: err}
+// startS3Upload uploads a local file into the prefix in the background, streaming
+// progress until an S3TransferDoneMsg arrives
+func (a *App) startS3Upload(action *handlers.UploadFileAction, file string) tea.Cmd {
+	handler := handlers.NewS3ObjectsHandler(a.clientMgr.S3(), a.clientMgr.Region(), action.Bucket, "")
+	key := action.Prefix + filepath.Base(file)
+	events := make(chan tea.Msg, 1)
+
+	go func() {
+		result, err := handler.UploadFile(context.Background(), file, key, s3TransferProgress(events, "Uploading", key))
+		events <- S3TransferDoneMsg{result: result, upload: true, err: err}
+	}()
+
+	a.s3Transferring = true
+	a.footer.SetLoading(true, fmt.Sprintf("Uploading %s...", filepath.Base(file)))
+	return a.forList(waitForS3Transfer(events))
+}
+
+// s3TransferProgress forwards transfer progress to events, dropping an update if the
+// previous one hasn't been rendered yet
+func s3TransferProgress(events chan tea.Msg, verb, key string) func(s3adapter.TransferProgress) {
+	return func(progress s3adapter.TransferProgress) {
+		select {
+		case events <- S3TransferProgressMsg{progress: progress, verb: verb, key: key, events: events}:
+		default:
 		}
+	}
+}
 
-		return SecretLoadedMsg{
-			name:  secretName,
-			value: value,
+// expandHome replaces a leading ~/ in a local path with the home directory
+func expandHome(p string) string {
+	if rest, ok := strings.CutPrefix(p, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
 		}
 	}
+	return p
 }
 
-// loadSecretForEditing loads a secret value for editing
-func (a *App) loadSecretForEditing(secretID, secretName string) tea.Cmd {
+func (a *App) loadBucketPolicy(bucketName string) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
-		handler, ok := a.registry.Get("secrets")
+		handler, ok := a.registry.Get("s3")
 		if !ok {
-			return SecretLoadErrorMsg{err: fmt.Errorf("secrets handler not found")}
+			return UserDataErrorMsg{err: fmt.Errorf("S3 handler not found")}
 		}
 
-		secretsHandler, ok := handler.(*handlers.SecretsHandler)
+		s3Handler, ok := handler.(*handlers.S3BucketsHandler)
 		if !ok {
-			return SecretLoadErrorMsg{err: fmt.Errorf("invalid handler type")}
+			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		value, err := secretsHandler.GetSecretValueForEdit(ctx, secretID)
+		data, err := s3Handler.GetBucketPolicyForView(ctx, bucketName)
 		if err != nil {
-			return SecretLoadErrorMsg{err: err}
+			return UserDataErrorMsg{err: err}
 		}
 
-		return SecretLoadedForEditMsg{
-			id:    secretID,
-			name:  secretName,
-			value: value,
+		return UserDataLoadedMsg{
+			title: fmt.Sprintf("Bucket Policy for: %s", bucketName),
+			data:  data,
 		}
 	}
 }
 
-// saveSecret saves the current secret being edited
-func (a *App) saveSecret() tea.Cmd {
-	return func() tea.Msg {
-		value, err := a.secretEditor.Value()
-		if err != nil {
-			return SecretSaveErrorMsg{err: err}
-		}
+// DynamoDB Item operation functions
 
+func (a *App) loadItemForEditing(itemID, tableName, itemKey string) tea.Cmd {
+	return func() tea.Msg {
 		ctx := context.Background()
-		handler, ok := a.registry.Get("secrets")
-		if !ok {
-			return SecretSaveErrorMsg{err: fmt.Errorf("secrets handler not found")}
-		}
 
-		secretsHandler, ok := handler.(*handlers.SecretsHandler)
+		// Get the DynamoDB items handler
+		handler := a.resourceList.Handler()
+		itemsHandler, ok := handler.(*handlers.DynamoDBItemsHandler)
 		if !ok {
-			return SecretSaveErrorMsg{err: fmt.Errorf("invalid handler type")}
+			return ItemLoadErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		secretID := a.secretEditor.GetSecretID()
-		updates := map[string]interface{}{
-			"SecretValue": value,
+		// Load the item with its attribute types and the table's key schema
+		item, err := itemsHandler.LoadItem(ctx, itemID)
+		if err != nil {
+			return ItemLoadErrorMsg{err: err}
 		}
 
-		if err := secretsHandler.Update(ctx, secretID, updates); err != nil {
-			return SecretSaveErrorMsg{err: err}
+		return ItemLoadedForEditMsg{
+			itemID:    itemID,
+			tableName: tableName,
+			itemKey:   itemKey,
+			item:      item,
 		}
-
-		return SecretSavedMsg{secretID: secretID}
 	}
 }
 
-func (a *App) createSecret(params map[string]interface{}) tea.Cmd {
+func (a *App) saveItem(itemID string, item map[string]ddbtypes.AttributeValue) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
-		handler, ok := a.registry.Get("secrets")
+		handler := a.resourceList.Handler()
+		itemsHandler, ok := handler.(*handlers.DynamoDBItemsHandler)
 		if !ok {
-			return SecretCreateErrorMsg{err: fmt.Errorf("secrets handler not found")}
+			return ItemSaveErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		secretsHandler, ok := handler.(*handlers.SecretsHandler)
-		if !ok {
-			return SecretCreateErrorMsg{err: fmt.Errorf("invalid handler type")}
+		updates := map[string]interface{}{
+			"item": item,
 		}
 
-		_, err := secretsHandler.Create(ctx, params)
-		if err != nil {
-			return SecretCreateErrorMsg{err: err}
+		if err := itemsHandler.Update(ctx, itemID, updates); err != nil {
+			return ItemSaveErrorMsg{err: err}
 		}
 
-		secretName, _ := params["Name"].(string)
-		return SecretCreatedMsg{secretName: secretName}
+		return ItemSavedMsg{itemID: itemID}
 	}
 }
 
-func (a *App) deleteSecret(secretID, secretName string, recoveryWindowDays int) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("secrets")
+func (a *App) deleteDynamoDBTable(tableName string) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, ok := a.registry.Get("dynamodb")
 		if !ok {
-			return SecretDeleteErrorMsg{err: fmt.Errorf("secrets handler not found")}
+			return ResourceDeleteErrorMsg{err: fmt.Errorf("dynamodb handler not found")}
 		}
 
-		secretsHandler, ok := handler.(*handlers.SecretsHandler)
-		if !ok {
-			return SecretDeleteErrorMsg{err: fmt.Errorf("invalid handler type")}
+		if err := handler.Delete(context.Background(), tableName); err != nil {
+			return ResourceDeleteErrorMsg{err: err}
 		}
 
-		err := secretsHandler.DeleteWithRecoveryWindow(ctx, secretID, recoveryWindowDays)
-		if err != nil {
-			return SecretDeleteErrorMsg{err: err}
-		}
+		return ResourceDeletedMsg{message: fmt.Sprintf("Deleting table %s", tableName)}
+	})
+}
 
-		return SecretDeletedMsg{secretID: secretID}
+// dynamoDBTablesHandler returns the registered DynamoDB tables handler
+func (a *App) dynamoDBTablesHandler() (*handlers.DynamoDBTablesHandler, error) {
+	handler, ok := a.registry.Get("dynamodb")
+	if !ok {
+		return nil, fmt.Errorf("dynamodb handler not found")
 	}
-}
 
-// IAM User data loading functions
+	tablesHandler, ok := handler.(*handlers.DynamoDBTablesHandler)
+	if !ok {
+		return nil, fmt.Errorf("invalid handler type")
+	}
+	return tablesHandler, nil
+}
 
-func (a *App) loadUserPolicies(userName string) tea.Cmd {
+// loadTags reads a resource's tags into the tag editor
+func (a *App) loadTags(action *handlers.EditTagsAction) tea.Cmd {
+	taggable, ok := a.resourceList.Handler().(handlers.Taggable)
+	if !ok {
+		return nil
+	}
 	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("users")
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
+		tags, err := taggable.ResourceTags(context.Background(), action.ResourceID)
+		if err != nil {
+			return TagsErrorMsg{err: err}
 		}
+		return TagsLoadedMsg{action: action, tags: tags}
+	}
+}
 
-		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+// updateTags applies the tag editor's changes, then reloads the resource for its row
+func (a *App) updateTags(msg components.TagEditorConfirmedMsg) tea.Cmd {
+	handler := a.resourceList.Handler()
+	taggable, ok := handler.(handlers.Taggable)
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := taggable.UpdateTags(ctx, msg.ResourceID, msg.Changes.Set, msg.Changes.Remove); err != nil {
+			return TagsErrorMsg{err: err}
 		}
-
-		data, err := usersHandler.GetUserPolicies(ctx, userName)
-		if err != nil {
-			return UserDataErrorMsg{err: err}
+		updated := TagsUpdatedMsg{
+			handler: handler,
+			message: fmt.Sprintf("Updated tags of %s: %s", msg.ResourceName, msg.Changes.Summary()),
 		}
-
-		return UserDataLoadedMsg{
-			title: fmt.Sprintf("Policies for User: %s", userName),
-			data:  data,
+		if res, err := handler.Get(ctx, msg.ResourceID); err == nil {
+			updated.resource = res
 		}
+		return updated
 	}
 }
 
-func (a *App) loadUserGroups(userName string) tea.Cmd {
+// loadTableCapacity loads a table's billing mode and capacity into the capacity editor
+func (a *App) loadTableCapacity(tableName string) tea.Cmd {
 	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("users")
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
+		handler, err := a.dynamoDBTablesHandler()
+		if err != nil {
+			return DynamoDBCapacityOperationErrorMsg{err: err}
 		}
 
-		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		capacity, err := handler.TableCapacity(context.Background(), tableName)
+		if err != nil {
+			return DynamoDBCapacityOperationErrorMsg{err: err}
 		}
+		return TableCapacityLoadedMsg{capacity: capacity}
+	}
+}
 
-		data, err := usersHandler.GetUserGroups(ctx, userName)
+// updateTableCapacity applies a confirmed billing mode or capacity change
+func (a *App) updateTableCapacity(req *handlers.TableCapacityRequest) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, err := a.dynamoDBTablesHandler()
 		if err != nil {
-			return UserDataErrorMsg{err: err}
+			return DynamoDBCapacityOperationErrorMsg{err: err}
 		}
 
-		return UserDataLoadedMsg{
-			title: fmt.Sprintf("Groups for User: %s", userName),
-			data:  data,
+		if err := handler.UpdateCapacity(context.Background(), req); err != nil {
+			return DynamoDBCapacityOperationErrorMsg{err: err}
 		}
-	}
+		return DynamoDBCapacityOperationSuccessMsg{
+			message: fmt.Sprintf("Updating capacity of %s, the table is UPDATING until it applies", req.Current.TableName),
+		}
+	})
 }
 
-func (a *App) loadUserAccessKeys(userName string) tea.Cmd {
+func (a *App) deleteItem(itemID, tableName string) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
-		handler, ok := a.registry.Get("users")
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
-		}
-
-		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
+		handler := a.resourceList.Handler()
+		itemsHandler, ok := handler.(*handlers.DynamoDBItemsHandler)
 		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+			return ItemDeleteErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		data, err := usersHandler.GetUserAccessKeys(ctx, userName)
-		if err != nil {
-			return UserDataErrorMsg{err: err}
+		if err := itemsHandler.Delete(ctx, itemID); err != nil {
+			return ItemDeleteErrorMsg{err: err}
 		}
 
-		return UserDataLoadedMsg{
-			title: fmt.Sprintf("Access Keys for User: %s", userName),
-			data:  data,
-		}
+		return ItemDeletedMsg{itemID: itemID}
 	}
 }
 
-func (a *App) loadUserMFA(userName string) tea.Cmd {
+// loadPolicyPicker fetches the managed policies and current attachments for the policy picker
+func (a *App) loadPolicyPicker(principalType, principalName string) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
-		handler, ok := a.registry.Get("users")
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("users handler not found")}
-		}
+		attacher := handlers.NewPolicyAttacher(a.clientMgr.IAM())
 
-		usersHandler, ok := handler.(*handlers.IAMUsersHandler)
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		attached, err := attacher.ListAttachedPolicies(ctx, principalType, principalName)
+		if err != nil {
+			return PolicyAttachmentsErrorMsg{err: err}
 		}
 
-		data, err := usersHandler.GetUserMFADevices(ctx, userName)
+		policies, err := attacher.ListManagedPolicies(ctx)
 		if err != nil {
-			return UserDataErrorMsg{err: err}
+			return PolicyAttachmentsErrorMsg{err: err}
 		}
 
-		return UserDataLoadedMsg{
-			title: fmt.Sprintf("MFA Devices for User: %s", userName),
-			data:  data,
+		return PolicyPickerLoadedMsg{
+			principalType: principalType,
+			principalName: principalName,
+			policies:      policies,
+			attached:      attached,
 		}
 	}
 }
 
-// EC2 Instance operation functions
-
-func (a *App) startEC2Instance(instanceID string) tea.Cmd {
+// applyPolicyChange attaches and detaches managed policies on a user or role
+func (a *App) applyPolicyChange(change *handlers.PolicyAttachmentChange) tea.Cmd {
 	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("ec2")
-		if !ok {
-			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+		attacher := handlers.NewPolicyAttacher(a.clientMgr.IAM())
+		if err := attacher.Apply(context.Background(), change); err != nil {
+			return PolicyAttachmentsErrorMsg{err: err}
 		}
 
-		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
-		if !ok {
-			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+		return PolicyAttachmentsAppliedMsg{
+			message: fmt.Sprintf("Updated %s %s: %d attached, %d detached",
+				change.PrincipalType, change.PrincipalName, len(change.Attach), len(change.Detach)),
 		}
+	}
+}
 
-		err := ec2Handler.StartInstance(ctx, instanceID)
-		if err != nil {
-			return EC2InstanceOperationErrorMsg{err: err}
+// addUserToGroup adds a user to the group of the current members view
+func (a *App) addUserToGroup(groupName, userName string) tea.Cmd {
+	handler, ok := a.resourceList.Handler().(*handlers.IAMGroupMembersHandler)
+	return a.forList(func() tea.Msg {
+		if !ok {
+			return IAMGroupOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
-
-		return EC2InstanceOperationSuccessMsg{
-			message: fmt.Sprintf("Instance %s is starting", instanceID),
+		if err := handler.AddUserToGroup(context.Background(), groupName, userName); err != nil {
+			return IAMGroupOperationErrorMsg{err: err}
 		}
-	}
+		return IAMGroupOperationSuccessMsg{message: fmt.Sprintf("Added %s to %s", userName, groupName)}
+	})
 }
 
-func (a *App) stopEC2Instance(instanceID string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("ec2")
+// removeUserFromGroup removes a user from the group of the current members view
+func (a *App) removeUserFromGroup(groupName, userName string) tea.Cmd {
+	handler, ok := a.resourceList.Handler().(*handlers.IAMGroupMembersHandler)
+	return a.forList(func() tea.Msg {
 		if !ok {
-			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+			return IAMGroupOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
+		if err := handler.RemoveUserFromGroup(context.Background(), groupName, userName); err != nil {
+			return IAMGroupOperationErrorMsg{err: err}
+		}
+		return IAMGroupOperationSuccessMsg{message: fmt.Sprintf("Removed %s from %s", userName, groupName)}
+	})
+}
 
-		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
+// imdsSummaryLimit caps the instances listed in the IMDSv2 dry run summary
+const imdsSummaryLimit = 12
+
+// imdsDryRunSummary describes the outcome of an IMDSv2 dry run and what may break
+func imdsDryRunSummary(action *handlers.RequireIMDSv2Action) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Dry run passed for %d instances. Requiring IMDSv2 turns off IMDSv1 on:\n\n", len(action.Targets)))
+	for i, target := range action.Targets {
+		if i == imdsSummaryLimit {
+			sb.WriteString(fmt.Sprintf("  ...and %d more\n", len(action.Targets)-imdsSummaryLimit))
+			break
+		}
+		label := target.InstanceID
+		if target.Name != "" {
+			label = fmt.Sprintf("%s (%s)", target.Name, target.InstanceID)
+		}
+		sb.WriteString(fmt.Sprintf("  %s, hop limit %d\n", label, target.HopLimit))
+		for _, warning := range target.Warnings {
+			sb.WriteString(fmt.Sprintf("    ! %s\n", warning))
+		}
+	}
+
+	if len(action.Denied) > 0 {
+		sb.WriteString(fmt.Sprintf("\n%d instances are skipped, the dry run was refused for them.\n", len(action.Denied)))
+	}
+
+	sb.WriteString("\nSoftware calling the metadata service without a token stops getting credentials.\n")
+	sb.WriteString("These are the first releases that use IMDSv2:\n")
+	for _, version := range handlers.IMDSv2MinimumVersions {
+		sb.WriteString(fmt.Sprintf("  %s\n", version))
+	}
+	sb.WriteString("Agents and tools such as the SSM and CloudWatch agents, kube2iam and kiam\nshould be updated first.")
+
+	return sb.String()
+}
+
+// requireIMDSv2 requires IMDSv2 on the instances of the current IMDS report
+func (a *App) requireIMDSv2(targets []handlers.IMDSTarget) tea.Cmd {
+	handler, ok := a.resourceList.Handler().(*handlers.EC2IMDSHandler)
+	return a.forList(func() tea.Msg {
 		if !ok {
 			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		err := ec2Handler.StopInstance(ctx, instanceID)
-		if err != nil {
-			return EC2InstanceOperationErrorMsg{err: err}
+		changed, errs := handler.RequireIMDSv2(context.Background(), targets)
+		if len(errs) > 0 {
+			return EC2InstanceOperationErrorMsg{
+				err: fmt.Errorf("IMDSv2 required on %d of %d instances, %d failed: %w", changed, len(targets), len(errs), errs[0]),
+			}
 		}
 
 		return EC2InstanceOperationSuccessMsg{
-			message: fmt.Sprintf("Instance %s is stopping", instanceID),
+			message: fmt.Sprintf("IMDSv2 required on %d instances", changed),
 		}
+	})
+}
+
+// policyChangeSummary describes the attachment delta shown before applying a policy change
+func policyChangeSummary(change *handlers.PolicyAttachmentChange) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("You are about to change the managed policies of %s:\n\n%s\n\n",
+		change.PrincipalType, change.PrincipalName))
+
+	for _, policy := range change.Attach {
+		sb.WriteString(fmt.Sprintf("  + %s\n", policy.Name))
+	}
+	for _, policy := range change.Detach {
+		sb.WriteString(fmt.Sprintf("  - %s\n", policy.Name))
+	}
+
+	resulting := change.Resulting()
+	sb.WriteString(fmt.Sprintf("\nAttached managed policies: %d -> %d", len(change.Current), len(resulting)))
+
+	if len(resulting) > handlers.DefaultManagedPolicyQuota {
+		sb.WriteString(fmt.Sprintf("\n\nThis exceeds the default quota of %d managed policies per %s\nand will fail unless the quota has been raised.",
+			handlers.DefaultManagedPolicyQuota, change.PrincipalType))
 	}
+
+	return sb.String()
 }
 
-func (a *App) rebootEC2Instance(instanceID string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("ec2")
-		if !ok {
-			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("EC2 handler not found")}
-		}
+// imageBuildPollInterval is how often a started image build is checked until it is done
+const imageBuildPollInterval = 30 * time.Second
 
-		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
-		if !ok {
-			return EC2InstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
+// startImagePipeline starts a run of an image pipeline
+func (a *App) startImagePipeline(pipelineARN, pipelineName string) tea.Cmd {
+	handler := handlers.NewImagePipelinesHandler(a.clientMgr.ImageBuilder(), a.clientMgr.Region())
+	return a.forList(func() tea.Msg {
+		imageARN, err := handler.StartPipeline(context.Background(), pipelineARN)
+		if err != nil {
+			return ImageBuildErrorMsg{err: err}
 		}
+		return ImagePipelineStartedMsg{pipeline: pipelineName, imageARN: imageARN, started: time.Now()}
+	})
+}
 
-		err := ec2Handler.RebootInstance(ctx, instanceID)
+// pollImageBuild checks an image build's status after the poll interval
+func (a *App) pollImageBuild(pipelineName, imageARN string, started time.Time) tea.Cmd {
+	handler := handlers.NewImagePipelinesHandler(a.clientMgr.ImageBuilder(), a.clientMgr.Region())
+	return a.forList(tea.Tick(imageBuildPollInterval, func(time.Time) tea.Msg {
+		image, err := handler.GetImage(context.Background(), imageARN)
 		if err != nil {
-			return EC2InstanceOperationErrorMsg{err: err}
+			return ImageBuildErrorMsg{err: err}
 		}
+		return ImageBuildStatusMsg{pipeline: pipelineName, image: image, started: started}
+	}))
+}
 
-		return EC2InstanceOperationSuccessMsg{
-			message: fmt.Sprintf("Instance %s is rebooting", instanceID),
+// rdsRestorePollInterval is how often a restored instance is checked until it is available
+const rdsRestorePollInterval = 15 * time.Second
+
+// rdsRestoreFailedStatuses are instance statuses a restore will not recover from
+var rdsRestoreFailedStatuses = map[string]bool{
+	"failed":                              true,
+	"incompatible-restore":                true,
+	"incompatible-network":                true,
+	"incompatible-parameters":             true,
+	"inaccessible-encryption-credentials": true,
+	"storage-full":                        true,
+}
+
+func (a *App) loadRestoreOptions(action *handlers.RestoreSnapshotAction) tea.Cmd {
+	return func() tea.Msg {
+		restorer := handlers.NewSnapshotRestorer(a.clientMgr.RDS(), a.clientMgr.EC2())
+		options, err := restorer.LoadOptions(context.Background(), action)
+		if err != nil {
+			return RDSRestoreErrorMsg{err: err}
 		}
+		return RestoreOptionsLoadedMsg{options: options}
 	}
 }
 
-func (a *App) loadConnectionInfo(instanceID string) tea.Cmd {
+// restoreSnapshot starts restoring a snapshot to a new instance
+func (a *App) restoreSnapshot(req *handlers.RestoreSnapshotRequest) tea.Cmd {
 	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("ec2")
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("EC2 handler not found")}
+		restorer := handlers.NewSnapshotRestorer(a.clientMgr.RDS(), a.clientMgr.EC2())
+		inst, err := restorer.Restore(context.Background(), req)
+		if err != nil {
+			return RDSRestoreErrorMsg{err: err}
 		}
-
-		ec2Handler, ok := handler.(*handlers.EC2InstancesHandler)
-		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+		return RDSRestoreStartedMsg{
+			instanceID: inst.DBInstanceID,
+			snapshotID: req.SnapshotID,
+			status:     inst.Status,
 		}
+	}
+}
 
-		data, err := ec2Handler.GetConnectionInfo(ctx, instanceID)
+// pollRestoredInstance checks a restoring instance's status after the poll interval
+func (a *App) pollRestoredInstance(instanceID string) tea.Cmd {
+	restorer := handlers.NewSnapshotRestorer(a.clientMgr.RDS(), a.clientMgr.EC2())
+	return tea.Tick(rdsRestorePollInterval, func(time.Time) tea.Msg {
+		inst, err := restorer.Instance(context.Background(), instanceID)
 		if err != nil {
-			return UserDataErrorMsg{err: err}
+			return RDSRestoreErrorMsg{err: err}
 		}
-
-		return UserDataLoadedMsg{
-			title: fmt.Sprintf("Connection Info for Instance: %s", instanceID),
-			data:  data,
+		return RDSRestoreStatusMsg{
+			instanceID: inst.DBInstanceID,
+			status:     inst.Status,
+			endpoint:   inst.Endpoint,
+			port:       inst.Port,
 		}
-	}
+	})
 }
 
-// S3 Bucket operation functions
-
-func (a *App) loadBucketPolicy(bucketName string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		handler, ok := a.registry.Get("s3")
+// rdsInstanceOperation starts, stops or reboots an RDS instance with the registered handler
+func (a *App) rdsInstanceOperation(instanceID, result string, op func(*handlers.RDSInstancesHandler, context.Context, string) error) tea.Cmd {
+	return a.forList(func() tea.Msg {
+		handler, ok := a.registry.Get("rds")
 		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("S3 handler not found")}
+			return RDSInstanceOperationErrorMsg{err: fmt.Errorf("RDS handler not found")}
 		}
 
-		s3Handler, ok := handler.(*handlers.S3BucketsHandler)
+		rdsHandler, ok := handler.(*handlers.RDSInstancesHandler)
 		if !ok {
-			return UserDataErrorMsg{err: fmt.Errorf("invalid handler type")}
+			return RDSInstanceOperationErrorMsg{err: fmt.Errorf("invalid handler type")}
 		}
 
-		data, err := s3Handler.GetBucketPolicyForView(ctx, bucketName)
-		if err != nil {
-			return UserDataErrorMsg{err: err}
+		if err := op(rdsHandler, context.Background(), instanceID); err != nil {
+			return RDSInstanceOperationErrorMsg{err: err}
 		}
 
-		return UserDataLoadedMsg{
-			title: fmt.Sprintf("Bucket Policy for: %s", bucketName),
-			data:  data,
+		return RDSInstanceOperationSuccessMsg{
+			message: fmt.Sprintf("DB instance %s %s", instanceID, result),
 		}
-	}
+	})
 }
 
-// DynamoDB Item operation functions
-
-func (a *App) loadItemForEditing(itemID, tableName, itemKey string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-
-		// Get the DynamoDB items handler
-		handler := a.resourceList.Handler()
-		itemsHandler, ok := handler.(*handlers.DynamoDBItemsHandler)
-		if !ok {
-			return ItemLoadErrorMsg{err: fmt.Errorf("invalid handler type")}
+// stopECSTask stops a task, which its service then replaces
+func (a *App) stopECSTask(action *handlers.StopTaskAction, reason string) tea.Cmd {
+	handler := handlers.NewECSTasksHandlerForCluster(a.clientMgr.ECS(), a.clientMgr.Region(), action.ClusterARN, "")
+	return a.forList(func() tea.Msg {
+		if err := handler.StopTask(context.Background(), action.ClusterARN, action.TaskARN, reason); err != nil {
+			return ECSTaskOperationErrorMsg{err: err}
 		}
-
-		// Describe the item to get its full data
-		itemData, err := itemsHandler.Describe(ctx, itemID)
-		if err != nil {
-			return ItemLoadErrorMsg{err: err}
+		if action.Service != "" {
+			return ECSTaskOperationSuccessMsg{
+				message: fmt.Sprintf("Task %s is stopping, service %s starts a replacement", action.TaskID(), action.Service),
+			}
 		}
+		return ECSTaskOperationSuccessMsg{message: fmt.Sprintf("Task %s is stopping", action.TaskID())}
+	})
+}
 
-		return ItemLoadedForEditMsg{
-			itemID:    itemID,
-			tableName: tableName,
-			itemKey:   itemKey,
-			itemData:  itemData,
-		}
+// stopTaskMessage describes what stopping or restarting a task does
+func stopTaskMessage(action *handlers.StopTaskAction) string {
+	verb := "stop"
+	if action.Restart {
+		verb = "restart"
+	}
+	replacement := "It isn't part of a service, so nothing replaces it."
+	if action.Service != "" {
+		replacement = fmt.Sprintf("Service %s starts a replacement to keep its desired count,\n"+
+			"a new task with a new ID and address.", action.Service)
 	}
+	return fmt.Sprintf(
+		"You are about to %s the task:\n\n%s\n\n"+
+			"Its containers get SIGTERM, then SIGKILL once their stop timeout passes.\n%s",
+		verb, action.TaskID(), replacement,
+	)
 }
 
-func (a *App) saveItem(itemID, tableName string) tea.Cmd {
-	return func() tea.Msg {
-		value, err := a.secretEditor.Value()
+// createRDSSnapshot starts a manual snapshot of an instance
+func (a *App) createRDSSnapshot(instanceID, snapshotID string) tea.Cmd {
+	region := a.clientMgr.Region()
+	handler := handlers.NewRDSSnapshotsHandler(a.clientMgr.RDS(), region)
+	return a.forList(func() tea.Msg {
+		snap, err := handler.CreateSnapshot(context.Background(), instanceID, snapshotID)
 		if err != nil {
-			return ItemSaveErrorMsg{err: err}
+			return RDSSnapshotErrorMsg{err: err}
 		}
+		return RDSSnapshotStartedMsg{
+			message:    fmt.Sprintf("Creating snapshot %s of %s", snap.SnapshotID, instanceID),
+			snapshotID: snap.SnapshotID,
+			region:     region,
+		}
+	})
+}
 
-		// Parse the JSON value
-		var itemData map[string]interface{}
-		if err := json.Unmarshal([]byte(value), &itemData); err != nil {
-			return ItemSaveErrorMsg{err: fmt.Errorf("invalid JSON: %w", err)}
+// deleteRDSSnapshot deletes a manual snapshot
+func (a *App) deleteRDSSnapshot(snapshotID string) tea.Cmd {
+	handler := handlers.NewRDSSnapshotsHandler(a.clientMgr.RDS(), a.clientMgr.Region())
+	return a.forList(func() tea.Msg {
+		if err := handler.DeleteSnapshot(context.Background(), snapshotID); err != nil {
+			return ResourceDeleteErrorMsg{err: err}
 		}
+		return ResourceDeletedMsg{message: fmt.Sprintf("Deleting snapshot %s", snapshotID)}
+	})
+}
 
-		ctx := context.Background()
-		handler := a.resourceList.Handler()
-		itemsHandler, ok := handler.(*handlers.DynamoDBItemsHandler)
-		if !ok {
-			return ItemSaveErrorMsg{err: fmt.Errorf("invalid handler type")}
+// deleteUnused deletes resources of the cleanup report one at a time, each after its
+// dependencies are checked again
+func (a *App) deleteUnused(action *handlers.DeleteUnusedAction) tea.Cmd {
+	handler := handlers.NewCleanupHandler(a.clientMgr.EC2(), a.clientMgr.IAM(), a.clientMgr.Region(), handlers.DefaultUnusedRoleDays)
+	return a.forList(func() tea.Msg {
+		msg := CleanupDeletedMsg{}
+		for _, finding := range action.Findings {
+			if err := handler.DeleteFinding(context.Background(), finding); err != nil {
+				msg.failures = append(msg.failures, err.Error())
+				continue
+			}
+			msg.deleted++
 		}
+		return msg
+	})
+}
 
-		updates := map[string]interface{}{
-			"item": itemData,
+// updateFindings sets the workflow status of findings
+func (a *App) updateFindings(action *handlers.UpdateFindingsWorkflowAction, note string) tea.Cmd {
+	handler := handlers.NewSecurityHubFindingsHandler(a.clientMgr.SecurityHub(), a.clientMgr.Region(), handlers.SecurityHubFilters{})
+	return a.forList(func() tea.Msg {
+		failures, err := handler.UpdateWorkflowStatus(context.Background(), action, note)
+		if err != nil {
+			return FindingsUpdateErrorMsg{err: err}
+		}
+		return FindingsUpdatedMsg{
+			status:   strings.ToLower(action.Status),
+			updated:  len(action.Findings) - len(failures),
+			failures: failures,
 		}
+	})
+}
 
-		if err := itemsHandler.Update(ctx, itemID, updates); err != nil {
-			return ItemSaveErrorMsg{err: err}
+// updateFindingsMessage lists the findings whose workflow status changes
+func updateFindingsMessage(action *handlers.UpdateFindingsWorkflowAction) string {
+	const maxListed = 10
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "You are about to set %d findings to %s:\n\n", len(action.Findings), action.Status)
+	for i, title := range action.Titles {
+		if i == maxListed {
+			fmt.Fprintf(&sb, "  ...and %d more\n", len(action.Titles)-maxListed)
+			break
 		}
+		fmt.Fprintf(&sb, "  %s\n", title)
+	}
+	switch action.Status {
+	case "SUPPRESSED":
+		sb.WriteString("\nSuppressed findings stay suppressed while the check keeps failing.")
+	case "RESOLVED":
+		sb.WriteString("\nA resolved finding is set back to new if the check fails again.")
+	}
+	return sb.String()
+}
 
-		return ItemSavedMsg{itemID: itemID}
+// deleteUnusedMessage lists what a cleanup delete removes, and what it leaves out
+func deleteUnusedMessage(action *handlers.DeleteUnusedAction) string {
+	const maxListed = 10
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "You are about to delete %d unused resources:\n\n", len(action.Findings))
+	for i, finding := range action.Findings {
+		if i == maxListed {
+			fmt.Fprintf(&sb, "  ...and %d more\n", len(action.Findings)-maxListed)
+			break
+		}
+		fmt.Fprintf(&sb, "  %s %s\n", finding.Kind, finding.Name)
+	}
+	sb.WriteString("\nRoles lose their policies first. Dependencies are checked again before each delete.\n" +
+		"This action cannot be undone.")
+	if len(action.Blocked) > 0 {
+		fmt.Fprintf(&sb, "\n\nSkipped, as they have dependencies:\n  %s", strings.Join(action.Blocked, "\n  "))
 	}
+	return sb.String()
 }
 
-func (a *App) deleteItem(itemID, tableName string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		handler := a.resourceList.Handler()
-		itemsHandler, ok := handler.(*handlers.DynamoDBItemsHandler)
-		if !ok {
-			return ItemDeleteErrorMsg{err: fmt.Errorf("invalid handler type")}
+// copyRDSSnapshot copies a snapshot to another region, through a client in that region
+func (a *App) copyRDSSnapshot(action *handlers.CopyDBSnapshotAction, region string) tea.Cmd {
+	client := rdsadapter.NewSnapshotsClient(a.clientMgr.RDSForRegion(region))
+	return a.forList(func() tea.Msg {
+		snap, err := client.CopyDBSnapshot(context.Background(), action.Input())
+		if err != nil {
+			return RDSSnapshotErrorMsg{err: err}
 		}
+		return RDSSnapshotStartedMsg{
+			message:    fmt.Sprintf("Copying %s to %s as %s", action.SnapshotID, region, snap.SnapshotID),
+			snapshotID: snap.SnapshotID,
+			region:     region,
+		}
+	})
+}
 
-		if err := itemsHandler.Delete(ctx, itemID); err != nil {
-			return ItemDeleteErrorMsg{err: err}
+// pollRDSSnapshot checks a new snapshot or copy's status after the poll interval
+func (a *App) pollRDSSnapshot(snapshotID, region string) tea.Cmd {
+	client := rdsadapter.NewSnapshotsClient(a.clientMgr.RDSForRegion(region))
+	return a.forList(tea.Tick(rdsRestorePollInterval, func(time.Time) tea.Msg {
+		snap, err := client.GetDBSnapshot(context.Background(), snapshotID)
+		if err != nil {
+			return RDSSnapshotErrorMsg{err: err}
 		}
+		return RDSSnapshotStatusMsg{
+			snapshotID: snap.SnapshotID,
+			region:     region,
+			status:     snap.Status,
+			progress:   snap.PercentProgress,
+		}
+	}))
+}
 
-		return ItemDeletedMsg{itemID: itemID}
+// restoreSummary describes the new instance shown before starting a restore
+func restoreSummary(req *handlers.RestoreSnapshotRequest) string {
+	class := req.DBInstanceClass
+	if class == "" {
+		class = "(same as snapshot)"
+	}
+	subnetGroup := req.SubnetGroup
+	if subnetGroup == "" {
+		subnetGroup = "(default)"
 	}
+	securityGroups := strings.Join(req.SecurityGroupIDs, ", ")
+	if securityGroups == "" {
+		securityGroups = "(VPC default)"
+	}
+
+	return fmt.Sprintf("You are about to restore snapshot:\n\n%s\n\n"+
+		"to a new instance:\n\n"+
+		"  Identifier:      %s\n"+
+		"  Instance class:  %s\n"+
+		"  Subnet group:    %s\n"+
+		"  Security groups: %s\n\n"+
+		"The new instance is billed from creation.",
+		req.SnapshotID, req.DBInstanceID, class, subnetGroup, securityGroups)
 }
@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+)
+
+// defaultBookmarksExport is the file :bookmarks export writes without one
+const defaultBookmarksExport = "bookmarks-export.yaml"
+
+// bookmarksCommand handles :bookmarks: alone it opens the bookmark selector, export writes
+// the bookmarks, or those of a folder, to a file to share and import merges such a file
+func (a *App) bookmarksCommand(args []string) (tea.Model, tea.Cmd) {
+	if len(args) == 0 {
+		return a, a.bookmarkSelector.Show()
+	}
+
+	switch args[0] {
+	case "export":
+		path, folder := defaultBookmarksExport, ""
+		if len(args) > 1 {
+			path = expandHome(args[1])
+		}
+		if len(args) > 2 {
+			folder = args[2]
+		}
+		n, err := a.bookmarkStore.Export(path, folder)
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Bookmarks export: %v", err), true)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Exported %d bookmarks to %s", n, path), false)
+
+	case "import":
+		if len(args) < 2 {
+			a.footer.SetMessage("Usage: :bookmarks import <file>", true)
+			return a, nil
+		}
+		added, updated, err := a.bookmarkStore.Import(expandHome(args[1]))
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Bookmarks import: %v", err), true)
+			return a, nil
+		}
+		a.footer.SetMessage(fmt.Sprintf("Imported %d new bookmarks, updated %d", added, updated), false)
+
+	default:
+		a.footer.SetMessage("Usage: :bookmarks, :bookmarks export [file] [folder] or :bookmarks import <file>", true)
+	}
+	return a, nil
+}
+
+// navigateToBookmark opens a bookmarked resource: in the bookmark's profile, switching to
+// it first if needed, then in the list of its type in its region, searched for its name
+// with the resource selected and described
+func (a *App) navigateToBookmark(bookmark config.Bookmark) (tea.Model, tea.Cmd) {
+	if bookmark.Profile != "" && bookmark.Profile != a.clientMgr.Profile() {
+		a.pendingBookmark = &bookmark
+		return a, a.switchProfile(bookmark.Profile)
+	}
+
+	model, cmd := a.navigateToType(bookmark.ResourceType, bookmark.Region, bookmark.ResourceID)
+	if a.state != StateResourceList {
+		return model, cmd
+	}
+	query := bookmark.Name
+	if query == "" {
+		query = bookmark.ResourceID
+	}
+	a.resourceList.FilterOnLoad(query)
+	a.footer.SetMessage(fmt.Sprintf("Opening bookmark %s, / then esc clears the search", query), false)
+	return model, cmd
+}
+
+// openPendingBookmark opens the bookmark that waited on the switch to its profile
+func (a *App) openPendingBookmark(initErr error) tea.Cmd {
+	bookmark := a.pendingBookmark
+	a.pendingBookmark = nil
+	if bookmark == nil || initErr != nil || bookmark.Profile != a.clientMgr.Profile() {
+		return nil
+	}
+	_, cmd := a.navigateToBookmark(*bookmark)
+	return cmd
+}
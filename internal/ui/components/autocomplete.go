@@ -25,24 +25,74 @@ func NewAutocomplete() *Autocomplete {
 		"home",
 		"profile",
 		"region",
+		"ro",
+		"elevate",
+		"time",
 		"users",
 		"roles",
+		"groups",
 		"policies",
+		"credreport",
+		"can",
 		"sg",
 		"kms",
 		"secrets",
+		"secrets-rotation",
 		"ec2",
+		"imds",
+		"launch-templates",
+		"lt",
 		"instances",
+		"asg",
 		"vpc",
 		"vpcs",
+		"subnets",
+		"route-tables",
+		"nat",
+		"igw",
+		"eni",
+		"elb",
 		"rds",
+		"rds-snapshots",
+		"rds-params",
+		"rds-options",
 		"ecs",
 		"lambda",
 		"logs",
+		"sources",
 		"s3",
 		"dynamodb",
+		"mq",
+		"imagebuilder",
+		"connect",
+		"pinpoint",
+		"stacksets",
+		"apigw",
+		"cost",
+		"lookup",
+		"tagged",
+		"tail",
+		"cleanup",
+		"securityhub",
+		"advisor",
+		"orgs",
+		"changelog",
+		"dashboard",
+		"assume",
+		"unassume",
 		"sso",
 		"sso-login",
+		"whoami",
+		"errors",
+		"audit",
+		"recent",
+		"bookmarks",
+		"keys",
+		"aliases",
+		"jq",
+		"theme",
+		"split",
+		"only",
 	}
 
 	return &Autocomplete{
@@ -52,6 +102,11 @@ func NewAutocomplete() *Autocomplete {
 	}
 }
 
+// AddCommands adds commands to suggest, such as the user's aliases
+func (a *Autocomplete) AddCommands(commands ...string) {
+	a.commands = append(a.commands, commands...)
+}
+
 // Update updates the autocomplete suggestions based on current input
 func (a *Autocomplete) Update(input string) {
 	a.input = input
@@ -2,8 +2,11 @@ package components
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
@@ -16,6 +19,11 @@ type BookmarkSelectedMsg struct {
 	Bookmark config.Bookmark
 }
 
+// RecentSelectedMsg is sent when a recent resource is selected
+type RecentSelectedMsg struct {
+	Recent config.RecentResource
+}
+
 // BookmarkClosedMsg is sent when bookmark selector is closed
 type BookmarkClosedMsg struct{}
 
@@ -32,31 +40,142 @@ type BookmarkRemovedMsg struct {
 	Error   error
 }
 
-// BookmarkSelector displays and manages bookmarks
+// BookmarkEditedMsg is sent when the folder or note of a bookmark is changed
+type BookmarkEditedMsg struct {
+	Field   string // folder or note
+	Success bool
+	Error   error
+}
+
+// selectorRecent is how many recent resources the selector lists below the bookmarks
+const selectorRecent = 10
+
+// recentValue is the value of the recent resources' items, telling them from bookmarks
+const recentValue = "recent"
+
+// BookmarkSelector displays and manages bookmarks, grouped by folder or profile,
+// followed by the resources recently viewed
 type BookmarkSelector struct {
-	theme  styles.Theme
-	store  *config.BookmarkStore
-	active bool
-	cursor int
-	width  int
-	height int
+	theme     styles.Theme
+	store     *config.BookmarkStore
+	recent    *config.RecentStore
+	list      pickList
+	filter    textinput.Model
+	filtering bool
+	active    bool
+	width     int
+	height    int
+
+	// Folder or note of a bookmark being edited, empty field when none is
+	edit      textinput.Model
+	editField string
+	editIndex int
 }
 
 // NewBookmarkSelector creates a new bookmark selector
-func NewBookmarkSelector(theme styles.Theme, store *config.BookmarkStore) *BookmarkSelector {
+func NewBookmarkSelector(theme styles.Theme, store *config.BookmarkStore, recent *config.RecentStore) *BookmarkSelector {
+	filter := textinput.New()
+	filter.Prompt = "/ "
+	filter.Placeholder = "filter"
+	filter.CharLimit = 100
+
+	edit := textinput.New()
+	edit.CharLimit = 200
+	edit.ShowSuggestions = true
+
 	return &BookmarkSelector{
-		theme: theme,
-		store: store,
+		theme:  theme,
+		store:  store,
+		recent: recent,
+		filter: filter,
+		edit:   edit,
 	}
 }
 
 // Show activates the bookmark selector
 func (b *BookmarkSelector) Show() tea.Cmd {
 	b.active = true
-	b.cursor = 0
+	b.filtering = false
+	b.filter.Reset()
+	b.filter.Blur()
+	b.editField = ""
+	b.edit.Blur()
+	b.reload()
 	return nil
 }
 
+// reload rebuilds the list from the store, keeping the filter. Folders come first, in
+// order of their names, then the bookmarks outside them grouped by profile.
+func (b *BookmarkSelector) reload() {
+	bookmarks := b.store.List()
+	order := make([]int, len(bookmarks))
+	profiles := make(map[string]bool)
+	folders := false
+	for i, bm := range bookmarks {
+		order[i] = i
+		profiles[bm.Profile] = true
+		folders = folders || bm.Folder != ""
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		fi, fj := bookmarks[order[i]].Folder, bookmarks[order[j]].Folder
+		if (fi == "") != (fj == "") {
+			return fj == ""
+		}
+		return fi < fj
+	})
+
+	// Profile headers only help once bookmarks span profiles
+	recent := b.recentList()
+	unfiled := ""
+	if folders || len(recent) > 0 {
+		unfiled = "Bookmarks"
+	}
+	items := make([]pickItem, len(bookmarks))
+	for n, i := range order {
+		bm := bookmarks[i]
+		group := unfiled
+		switch {
+		case bm.Folder != "":
+			group = "Folder " + bm.Folder
+		case len(profiles) > 1:
+			group = "Profile " + bm.Profile
+		}
+		description := bm.Region
+		if bm.Note != "" {
+			description += " · " + bm.Note
+		}
+		items[n] = pickItem{
+			title:       fmt.Sprintf("[%s] %s", bm.ResourceType, bm.Name),
+			description: description,
+			value:       strconv.Itoa(i),
+			group:       group,
+			index:       i,
+		}
+	}
+
+	for i, r := range recent {
+		items = append(items, pickItem{
+			title:       fmt.Sprintf("[%s] %s", r.ResourceType, r.Name),
+			description: r.Region,
+			value:       recentValue,
+			group:       "Recent",
+			index:       i,
+		})
+	}
+
+	b.list.setItems(items)
+	b.list.setQuery(b.filter.Value())
+}
+
+// recentList returns the recent resources the selector lists
+func (b *BookmarkSelector) recentList() []config.RecentResource {
+	if b.recent == nil {
+		return nil
+	}
+	recent := b.recent.List()
+	return recent[:min(len(recent), selectorRecent)]
+}
+
 // Hide deactivates the bookmark selector
 func (b *BookmarkSelector) Hide() {
 	b.active = false
@@ -67,10 +186,17 @@ func (b *BookmarkSelector) IsActive() bool {
 	return b.active
 }
 
+// SetTheme sets the theme the selector renders with
+func (b *BookmarkSelector) SetTheme(theme styles.Theme) {
+	b.theme = theme
+}
+
 // SetSize sets the dimensions
 func (b *BookmarkSelector) SetSize(width, height int) {
 	b.width = width
 	b.height = height
+	// Less the border, padding, title, filter and help lines, up to 20 bookmarks
+	b.list.setHeight(min(height-14, 20))
 }
 
 // Update handles messages
@@ -79,67 +205,170 @@ func (b *BookmarkSelector) Update(msg tea.Msg) (*BookmarkSelector, tea.Cmd) {
 		return b, nil
 	}
 
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		bookmarks := b.store.List()
-
-		switch msg.String() {
-		case "esc", "q", "'":
-			b.active = false
-			return b, func() tea.Msg {
-				return BookmarkClosedMsg{}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if b.editField != "" {
+		if ok {
+			switch keyMsg.String() {
+			case "enter":
+				return b, b.saveEdit()
+			case "esc":
+				b.editField = ""
+				b.edit.Blur()
+				return b, nil
 			}
+		}
+		var cmd tea.Cmd
+		b.edit, cmd = b.edit.Update(msg)
+		return b, cmd
+	}
+	if !ok {
+		if b.filtering {
+			var cmd tea.Cmd
+			b.filter, cmd = b.filter.Update(msg)
+			return b, cmd
+		}
+		return b, nil
+	}
 
-		case "enter", "l":
-			if len(bookmarks) > 0 && b.cursor < len(bookmarks) {
-				selected := bookmarks[b.cursor]
+	switch keyMsg.String() {
+	case "enter":
+		if item := b.list.selectedItem(); item != nil {
+			if item.value == recentValue {
+				selected := b.recentList()[item.index]
 				b.active = false
 				return b, func() tea.Msg {
-					return BookmarkSelectedMsg{Bookmark: selected}
+					return RecentSelectedMsg{Recent: selected}
 				}
 			}
-			return b, nil
-
-		case "j", "down":
-			if b.cursor < len(bookmarks)-1 {
-				b.cursor++
+			selected := b.store.List()[item.index]
+			b.active = false
+			return b, func() tea.Msg {
+				return BookmarkSelectedMsg{Bookmark: selected}
 			}
-			return b, nil
+		}
+		return b, nil
 
-		case "k", "up":
-			if b.cursor > 0 {
-				b.cursor--
-			}
-			return b, nil
+	case "up", "ctrl+p":
+		b.list.move(-1)
+		return b, nil
+	case "down", "ctrl+n":
+		b.list.move(1)
+		return b, nil
+	case "pgup":
+		b.list.move(-b.list.page())
+		return b, nil
+	case "pgdown":
+		b.list.move(b.list.page())
+		return b, nil
+	}
 
-		case "d", "x":
-			// Delete bookmark
-			if len(bookmarks) > 0 && b.cursor < len(bookmarks) {
-				err := b.store.Remove(b.cursor)
-				if b.cursor >= len(b.store.List()) && b.cursor > 0 {
-					b.cursor--
-				}
-				return b, func() tea.Msg {
-					return BookmarkRemovedMsg{Success: err == nil, Error: err}
-				}
-			}
+	if b.filtering {
+		if keyMsg.String() == "esc" {
+			b.filtering = false
+			b.filter.Reset()
+			b.filter.Blur()
+			b.list.setQuery("")
 			return b, nil
+		}
+		var cmd tea.Cmd
+		b.filter, cmd = b.filter.Update(msg)
+		b.list.setQuery(b.filter.Value())
+		return b, cmd
+	}
 
-		case "g":
-			b.cursor = 0
-			return b, nil
+	switch keyMsg.String() {
+	case "esc", "q", "'":
+		b.active = false
+		return b, func() tea.Msg {
+			return BookmarkClosedMsg{}
+		}
 
-		case "G":
-			if len(bookmarks) > 0 {
-				b.cursor = len(bookmarks) - 1
-			}
+	case "l":
+		return b.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	case "/":
+		b.filtering = true
+		return b, b.filter.Focus()
+
+	case "j":
+		b.list.move(1)
+
+	case "k":
+		b.list.move(-1)
+
+	case "d", "x":
+		// Delete bookmark
+		item := b.list.selectedItem()
+		if item == nil || item.value == recentValue {
 			return b, nil
 		}
+		cursor := b.list.cursor
+		err := b.store.Remove(item.index)
+		b.reload()
+		b.list.move(cursor)
+		return b, func() tea.Msg {
+			return BookmarkRemovedMsg{Success: err == nil, Error: err}
+		}
+
+	case "f":
+		return b, b.startEdit("folder")
+
+	case "n":
+		return b, b.startEdit("note")
+
+	case "g", "home":
+		b.list.move(-b.list.cursor)
+
+	case "G", "end":
+		b.list.move(len(b.list.matches))
 	}
 
 	return b, nil
 }
 
+// startEdit starts editing the folder or note of the bookmark under the cursor
+func (b *BookmarkSelector) startEdit(field string) tea.Cmd {
+	item := b.list.selectedItem()
+	if item == nil || item.value == recentValue {
+		return nil
+	}
+	bm := b.store.List()[item.index]
+	b.editField = field
+	b.editIndex = item.index
+	if field == "folder" {
+		b.edit.Prompt = "Folder: "
+		b.edit.Placeholder = "name, empty to take it out of its folder"
+		b.edit.SetSuggestions(b.store.Folders())
+		b.edit.SetValue(bm.Folder)
+	} else {
+		b.edit.Prompt = "Note: "
+		b.edit.Placeholder = "empty to remove the note"
+		b.edit.SetSuggestions(nil)
+		b.edit.SetValue(bm.Note)
+	}
+	b.edit.CursorEnd()
+	return b.edit.Focus()
+}
+
+// saveEdit saves the folder or note being edited, keeping the bookmark selected
+func (b *BookmarkSelector) saveEdit() tea.Cmd {
+	field, value := b.editField, strings.TrimSpace(b.edit.Value())
+	b.editField = ""
+	b.edit.Blur()
+
+	var err error
+	if field == "folder" {
+		err = b.store.SetFolder(b.editIndex, value)
+	} else {
+		err = b.store.SetNote(b.editIndex, value)
+	}
+	b.reload()
+	b.list.selectValue(strconv.Itoa(b.editIndex))
+	return func() tea.Msg {
+		return BookmarkEditedMsg{Field: field, Success: err == nil, Error: err}
+	}
+}
+
 // View renders the bookmark selector
 func (b *BookmarkSelector) View() string {
 	if !b.active {
@@ -148,8 +377,7 @@ func (b *BookmarkSelector) View() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("212")).
-		MarginBottom(1)
+		Foreground(b.theme.Colors.Accent)
 
 	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -157,69 +385,58 @@ func (b *BookmarkSelector) View() string {
 		Padding(1, 2).
 		Width(70)
 
-	selectedStyle := lipgloss.NewStyle().
-		Background(lipgloss.Color("63")).
-		Foreground(lipgloss.Color("230"))
-
-	normalStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252"))
-
 	dimStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245"))
-
-	typeStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("39"))
+		Foreground(b.theme.Colors.Muted)
+
+	listStyles := pickListStyles{
+		selected: lipgloss.NewStyle().
+			Background(lipgloss.Color("63")).
+			Foreground(lipgloss.Color("230")),
+		normal: lipgloss.NewStyle().
+			Foreground(b.theme.Colors.Foreground),
+		muted: dimStyle,
+		header: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(b.theme.Colors.Primary),
+	}
 
 	var content strings.Builder
 
 	content.WriteString(titleStyle.Render("Bookmarks"))
+	empty := len(b.store.List()) == 0 && len(b.recentList()) == 0
+	if !empty {
+		content.WriteString("  ")
+		content.WriteString(dimStyle.Render(b.list.status()))
+	}
+	content.WriteString("\n")
+	switch {
+	case b.editField != "":
+		content.WriteString(b.edit.View())
+	case b.filtering || b.filter.Value() != "":
+		content.WriteString(b.filter.View())
+	}
 	content.WriteString("\n")
 
-	bookmarks := b.store.List()
-
-	if len(bookmarks) == 0 {
+	if empty {
 		content.WriteString(dimStyle.Render("  (no bookmarks)"))
 		content.WriteString("\n")
 		content.WriteString(dimStyle.Render("  Press 'm' on a resource to bookmark it"))
 	} else {
-		// Calculate visible range
-		maxVisible := 15
-		start := 0
-		if b.cursor >= maxVisible {
-			start = b.cursor - maxVisible + 1
-		}
-		end := start + maxVisible
-		if end > len(bookmarks) {
-			end = len(bookmarks)
-		}
-
-		for i := start; i < end; i++ {
-			bm := bookmarks[i]
-			prefix := "  "
-			style := normalStyle
-			if i == b.cursor {
-				prefix = "> "
-				style = selectedStyle
-			}
-
-			// Format: [type] name (region)
-			typeLabel := typeStyle.Render(fmt.Sprintf("[%s]", bm.ResourceType))
-			name := style.Render(bm.Name)
-			region := dimStyle.Render(fmt.Sprintf("(%s)", bm.Region))
-
-			line := fmt.Sprintf("%s%s %s %s", prefix, typeLabel, name, region)
-			content.WriteString(line)
-			content.WriteString("\n")
-		}
-
-		if len(bookmarks) > maxVisible {
-			content.WriteString(dimStyle.Render(fmt.Sprintf("  ... %d more", len(bookmarks)-maxVisible)))
-			content.WriteString("\n")
-		}
+		// Less the box's padding
+		content.WriteString(b.list.view(64, listStyles))
 	}
 
-	content.WriteString("\n")
-	content.WriteString(dimStyle.Render("enter:jump  d:delete  esc:close"))
+	content.WriteString("\n\n")
+	switch {
+	case b.editField == "folder":
+		content.WriteString(dimStyle.Render("enter:save  tab:complete  esc:cancel"))
+	case b.editField != "":
+		content.WriteString(dimStyle.Render("enter:save  esc:cancel"))
+	case b.filtering:
+		content.WriteString(dimStyle.Render("type to filter  enter:jump  esc:clear filter"))
+	default:
+		content.WriteString(dimStyle.Render("enter:jump  /:filter  f:folder  n:note  d:delete  esc:close"))
+	}
 
 	box := boxStyle.Render(content.String())
 
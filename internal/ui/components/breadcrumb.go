@@ -1,6 +1,7 @@
 package components
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -23,6 +24,11 @@ func NewBreadcrumb(theme styles.Theme) *Breadcrumb {
 	}
 }
 
+// SetTheme sets the theme the breadcrumb renders with
+func (b *Breadcrumb) SetTheme(theme styles.Theme) {
+	b.theme = theme
+}
+
 // SetPath sets the breadcrumb path
 func (b *Breadcrumb) SetPath(path ...string) {
 	if len(path) == 0 {
@@ -32,6 +38,11 @@ func (b *Breadcrumb) SetPath(path ...string) {
 	}
 }
 
+// Path returns the items of the path
+func (b *Breadcrumb) Path() []string {
+	return slices.Clone(b.path)
+}
+
 // Push adds an item to the path
 func (b *Breadcrumb) Push(item string) {
 	b.path = append(b.path, item)
@@ -67,13 +78,13 @@ func (b *Breadcrumb) View() string {
 	}
 
 	separatorStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("240"))
+		Foreground(b.theme.Colors.Border)
 
 	itemStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245"))
+		Foreground(b.theme.Colors.Muted)
 
 	currentStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("212")).
+		Foreground(b.theme.Colors.Accent).
 		Bold(true)
 
 	separator := separatorStyle.Render(" › ")
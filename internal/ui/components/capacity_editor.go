@@ -0,0 +1,328 @@
+package components
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// CapacityEditorConfirmedMsg is sent when the editor is submitted with a valid change
+type CapacityEditorConfirmedMsg struct {
+	Request *handlers.TableCapacityRequest
+}
+
+// CapacityEditorClosedMsg is sent when the editor is cancelled
+type CapacityEditorClosedMsg struct{}
+
+// CapacityEditor is a form for switching a DynamoDB table between on-demand and
+// provisioned billing and setting the read and write capacity of the table and its
+// global secondary indexes
+type CapacityEditor struct {
+	theme  styles.Theme
+	active bool
+	width  int
+	height int
+
+	current     *handlers.TableCapacity
+	provisioned bool
+
+	// Read and write inputs of the table, then of each index
+	inputs []textinput.Model
+
+	// Field 0 is the billing mode, then the inputs
+	focusedField int
+	offset       int // First index shown
+	err          string
+}
+
+// NewCapacityEditor creates a new capacity editor
+func NewCapacityEditor(theme styles.Theme) *CapacityEditor {
+	return &CapacityEditor{theme: theme}
+}
+
+// Show opens the editor with a table's current capacity
+func (e *CapacityEditor) Show(current *handlers.TableCapacity) tea.Cmd {
+	e.current = current
+	e.provisioned = current.BillingMode == handlers.BillingModeProvisioned
+
+	e.inputs = nil
+	e.addInputs(handlers.DefaultCapacity(current.Table))
+	for _, index := range current.Indexes {
+		e.addInputs(handlers.DefaultCapacity(index.Capacity))
+	}
+
+	e.focusedField = 0
+	e.offset = 0
+	e.err = ""
+	e.active = true
+	return nil
+}
+
+func (e *CapacityEditor) addInputs(c handlers.Capacity) {
+	for _, units := range []int64{c.Read, c.Write} {
+		ti := textinput.New()
+		ti.CharLimit = 6
+		ti.Width = 8
+		ti.Prompt = ""
+		ti.SetValue(strconv.FormatInt(units, 10))
+		e.inputs = append(e.inputs, ti)
+	}
+}
+
+// Hide closes the editor
+func (e *CapacityEditor) Hide() {
+	e.active = false
+}
+
+// IsActive returns whether the editor is open
+func (e *CapacityEditor) IsActive() bool {
+	return e.active
+}
+
+// SetTheme sets the theme the editor renders with
+func (e *CapacityEditor) SetTheme(theme styles.Theme) {
+	e.theme = theme
+}
+
+// SetSize sets the editor dimensions
+func (e *CapacityEditor) SetSize(width, height int) {
+	e.width = width
+	e.height = height
+}
+
+// fieldCount returns the number of fields, as capacity is only entered for provisioned
+func (e *CapacityEditor) fieldCount() int {
+	if e.provisioned {
+		return 1 + len(e.inputs)
+	}
+	return 1
+}
+
+// maxVisibleIndexes returns how many indexes fit below the table's capacity
+func (e *CapacityEditor) maxVisibleIndexes() int {
+	max := (e.height - 24) / 2
+	if max < 2 {
+		max = 2
+	}
+	return max
+}
+
+func (e *CapacityEditor) focus(field int) tea.Cmd {
+	count := e.fieldCount()
+	e.focusedField = (field + count) % count
+
+	for i := range e.inputs {
+		e.inputs[i].Blur()
+	}
+	if e.focusedField == 0 {
+		return nil
+	}
+
+	// Inputs 0 and 1 are the table's, then two per index
+	if index := (e.focusedField-1)/2 - 1; index >= 0 {
+		if index < e.offset {
+			e.offset = index
+		}
+		if index >= e.offset+e.maxVisibleIndexes() {
+			e.offset = index - e.maxVisibleIndexes() + 1
+		}
+	}
+	return e.inputs[e.focusedField-1].Focus()
+}
+
+// request builds the change from the form, or returns a validation error
+func (e *CapacityEditor) request() (*handlers.TableCapacityRequest, error) {
+	req := &handlers.TableCapacityRequest{
+		Current:     e.current,
+		BillingMode: handlers.BillingModeOnDemand,
+	}
+
+	if e.provisioned {
+		req.BillingMode = handlers.BillingModeProvisioned
+
+		units := make([]int64, len(e.inputs))
+		for i, input := range e.inputs {
+			n, err := strconv.ParseInt(strings.TrimSpace(input.Value()), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("capacity must be a whole number of units")
+			}
+			units[i] = n
+		}
+
+		req.Table = handlers.Capacity{Read: units[0], Write: units[1]}
+		for i, index := range e.current.Indexes {
+			req.Indexes = append(req.Indexes, handlers.IndexCapacity{
+				Name:     index.Name,
+				Capacity: handlers.Capacity{Read: units[2+2*i], Write: units[3+2*i]},
+			})
+		}
+	}
+
+	if err := req.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// Update handles messages
+func (e *CapacityEditor) Update(msg tea.Msg) (*CapacityEditor, tea.Cmd) {
+	if !e.active {
+		return e, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return e, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		e.Hide()
+		return e, func() tea.Msg { return CapacityEditorClosedMsg{} }
+
+	case "enter":
+		req, err := e.request()
+		if err != nil {
+			e.err = err.Error()
+			return e, nil
+		}
+		e.Hide()
+		return e, func() tea.Msg { return CapacityEditorConfirmedMsg{Request: req} }
+
+	case "tab", "down":
+		return e, e.focus(e.focusedField + 1)
+
+	case "shift+tab", "up":
+		return e, e.focus(e.focusedField - 1)
+	}
+
+	if e.focusedField == 0 {
+		switch keyMsg.String() {
+		case "left", "right", "h", "l", " ":
+			e.provisioned = !e.provisioned
+			e.err = ""
+		}
+		return e, nil
+	}
+
+	var cmd tea.Cmd
+	e.inputs[e.focusedField-1], cmd = e.inputs[e.focusedField-1].Update(msg)
+	e.err = ""
+	return e, cmd
+}
+
+// View renders the editor
+func (e *CapacityEditor) View() string {
+	if !e.active {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(e.theme.Colors.Primary)
+	labelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(e.theme.Colors.Foreground)
+	focusedLabelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(e.theme.Colors.Accent)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Foreground).
+		Background(e.theme.Colors.Secondary).
+		Bold(true)
+	normalStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Foreground)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Muted)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Error)
+
+	// capacityRow renders the read and write inputs starting at input i
+	capacityRow := func(name string, i int, current handlers.Capacity) string {
+		cursor := "  "
+		if e.focusedField == i+1 || e.focusedField == i+2 {
+			cursor = "▸ "
+		}
+		row := fmt.Sprintf("%s%s RCU %s  WCU %s", cursor, styles.PadRight(styles.Truncate(name, 30), 30), e.inputs[i].View(), e.inputs[i+1].View())
+		if e.current.BillingMode == handlers.BillingModeProvisioned {
+			row += mutedStyle.Render(fmt.Sprintf("  now %d/%d", current.Read, current.Write))
+		}
+		return row
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Capacity of table %s", e.current.TableName)))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("Status: %s", e.current.Status)))
+	sb.WriteString("\n\n")
+
+	// Billing mode
+	mode := "◀ On-demand ▶"
+	if e.provisioned {
+		mode = "◀ Provisioned ▶"
+	}
+	if e.focusedField == 0 {
+		sb.WriteString(focusedLabelStyle.Render("▸ Billing mode"))
+		sb.WriteString("\n    " + selectedStyle.Render(mode))
+	} else {
+		sb.WriteString(labelStyle.Render("  Billing mode"))
+		sb.WriteString("\n    " + normalStyle.Render(mode))
+	}
+	sb.WriteString("\n\n")
+
+	if e.provisioned {
+		sb.WriteString(labelStyle.Render("  Capacity"))
+		sb.WriteString("\n")
+		sb.WriteString(capacityRow("Table", 0, e.current.Table))
+		sb.WriteString("\n")
+
+		end := e.offset + e.maxVisibleIndexes()
+		if end > len(e.current.Indexes) {
+			end = len(e.current.Indexes)
+		}
+		for i := e.offset; i < end; i++ {
+			index := e.current.Indexes[i]
+			sb.WriteString(capacityRow(index.Name, 2+2*i, index.Capacity))
+			sb.WriteString("\n")
+		}
+		if len(e.current.Indexes) > end-e.offset {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  indexes %d-%d of %d", e.offset+1, end, len(e.current.Indexes))))
+			sb.WriteString("\n")
+		}
+	} else {
+		sb.WriteString(mutedStyle.Render("  Requests are billed as they are made, for the table and its indexes"))
+		sb.WriteString("\n")
+	}
+
+	if e.err != "" {
+		sb.WriteString("\n")
+		sb.WriteString(errorStyle.Render(e.err))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render("tab: next field | ←/→: billing mode | enter: review | esc: cancel"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(e.theme.Colors.Primary).
+		Padding(1, 2).
+		Width(e.width - 10).
+		Render(sb.String())
+
+	return lipgloss.Place(
+		e.width,
+		e.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
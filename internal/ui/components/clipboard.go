@@ -2,13 +2,46 @@ package components
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 
+	"github.com/aymanbagabas/go-osc52/v2"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// Clipboard modes, set by SetClipboardMode
+const (
+	ClipboardAuto   = "auto"   // A clipboard tool, or tmux or OSC52 over SSH or without one
+	ClipboardSystem = "system" // A clipboard tool only
+	ClipboardOSC52  = "osc52"  // The terminal's clipboard only
+	ClipboardTmux   = "tmux"   // tmux's paste buffer, passed on to the terminal's clipboard
+)
+
+var clipboardMode = ClipboardAuto
+
+// errNoClipboardTool is returned when none of the clipboard tools is installed
+var errNoClipboardTool = errors.New("no clipboard tool found (install xclip, xsel, or wl-copy, or set clipboard: osc52)")
+
+// errNotInTmux is returned by the tmux clipboard mode outside tmux
+var errNotInTmux = errors.New("not running inside tmux (set clipboard: auto or osc52)")
+
+// SetClipboardMode sets how copies reach the clipboard, auto when empty
+func SetClipboardMode(mode string) error {
+	switch mode {
+	case "":
+		clipboardMode = ClipboardAuto
+	case ClipboardAuto, ClipboardSystem, ClipboardOSC52, ClipboardTmux:
+		clipboardMode = mode
+	default:
+		return fmt.Errorf("clipboard must be auto, system, osc52 or tmux, not %q", mode)
+	}
+	return nil
+}
+
 // ClipboardCopiedMsg is sent when content is copied to clipboard
 type ClipboardCopiedMsg struct {
 	Content string
@@ -67,8 +100,75 @@ func CopyJSONToClipboard(data map[string]interface{}, label string) tea.Cmd {
 	}
 }
 
-// writeToClipboard writes content to the system clipboard using OS-specific tools
+// writeToClipboard writes content to the clipboard as the clipboard mode says. Over SSH
+// the tools would reach the remote host's clipboard, if any, so auto uses the terminal's.
 func writeToClipboard(content string) error {
+	switch clipboardMode {
+	case ClipboardOSC52:
+		return writeOSC52(content)
+	case ClipboardSystem:
+		return writeToSystemClipboard(content)
+	case ClipboardTmux:
+		return writeToTmux(content)
+	}
+
+	if os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != "" {
+		return writeToTerminal(content)
+	}
+	err := writeToSystemClipboard(content)
+	if errors.Is(err, errNoClipboardTool) {
+		return writeToTerminal(content)
+	}
+	return err
+}
+
+// writeToTerminal writes content to the clipboard of the terminal the app runs in. Inside
+// tmux it goes through tmux, which by default passes on its own copies to the terminal
+// but drops OSC52 sequences from applications; OSC52 is the fallback for older tmux.
+func writeToTerminal(content string) error {
+	if os.Getenv("TMUX") != "" && writeToTmux(content) == nil {
+		return nil
+	}
+	return writeOSC52(content)
+}
+
+// writeToTmux loads content into tmux's paste buffer. With -w, from tmux 3.2, tmux also
+// sets the terminal's clipboard when set-clipboard is on or external, the default.
+func writeToTmux(content string) error {
+	if os.Getenv("TMUX") == "" {
+		return errNotInTmux
+	}
+	cmd := exec.Command("tmux", "load-buffer", "-w", "-")
+	cmd.Stdin = strings.NewReader(content)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("tmux load-buffer failed: %s", msg)
+		}
+		return fmt.Errorf("tmux load-buffer failed: %w", err)
+	}
+	return nil
+}
+
+// writeOSC52 asks the terminal to put content on its clipboard with an OSC52 escape
+// sequence, which works over SSH. Terminals that don't support it ignore it, so this
+// can't tell whether the copy worked. Inside tmux and screen the sequence is wrapped to
+// pass through to the terminal, which tmux 3.3 and later only do with allow-passthrough on.
+func writeOSC52(content string) error {
+	seq := osc52.New(content)
+	switch {
+	case os.Getenv("TMUX") != "":
+		seq = seq.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		seq = seq.Screen()
+	}
+	if _, err := seq.WriteTo(os.Stdout); err != nil {
+		return fmt.Errorf("failed to write to the terminal's clipboard: %w", err)
+	}
+	return nil
+}
+
+// writeToSystemClipboard writes content to the system clipboard using OS-specific tools
+func writeToSystemClipboard(content string) error {
 	var cmd *exec.Cmd
 
 	switch runtime.GOOS {
@@ -83,7 +183,7 @@ func writeToClipboard(content string) error {
 		} else if _, err := exec.LookPath("wl-copy"); err == nil {
 			cmd = exec.Command("wl-copy")
 		} else {
-			return fmt.Errorf("no clipboard tool found (install xclip, xsel, or wl-copy)")
+			return errNoClipboardTool
 		}
 	case "windows":
 		cmd = exec.Command("clip")
@@ -0,0 +1,161 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+)
+
+// HistoryRecall steps through the command history with up and down, and searches it
+// backwards like a shell's Ctrl+R
+type HistoryRecall struct {
+	history *config.CommandHistory
+
+	// Position in the history while recalling, len(entries) at the command being typed
+	index int
+	draft string
+
+	searching bool
+	query     string
+	match     int // Entry matching the query, -1 when none does
+}
+
+// NewHistoryRecall creates a history recall over a command history
+func NewHistoryRecall(history *config.CommandHistory) *HistoryRecall {
+	r := &HistoryRecall{history: history}
+	r.Reset()
+	return r
+}
+
+// Reset starts recalling from the newest command, when command mode is entered
+func (r *HistoryRecall) Reset() {
+	r.index = len(r.history.Entries())
+	r.draft = ""
+	r.searching = false
+	r.query = ""
+	r.match = -1
+}
+
+// Record adds an executed command to the history
+func (r *HistoryRecall) Record(command string) error {
+	err := r.history.Add(command)
+	r.Reset()
+	return err
+}
+
+// Previous returns the command before the one shown, keeping what was typed so far to come
+// back to
+func (r *HistoryRecall) Previous(current string) (string, bool) {
+	entries := r.history.Entries()
+	if r.index >= len(entries) {
+		r.index = len(entries)
+		r.draft = current
+	}
+	if r.index == 0 {
+		return "", false
+	}
+	r.index--
+	return entries[r.index], true
+}
+
+// Next returns the command after the one shown, or what was typed once past the newest
+func (r *HistoryRecall) Next() (string, bool) {
+	entries := r.history.Entries()
+	if r.index >= len(entries) {
+		return "", false
+	}
+	r.index++
+	if r.index == len(entries) {
+		return r.draft, true
+	}
+	return entries[r.index], true
+}
+
+// StartSearch starts a reverse search, keeping the command being typed to restore if it
+// is cancelled
+func (r *HistoryRecall) StartSearch(current string) {
+	r.searching = true
+	r.query = ""
+	r.match = -1
+	r.draft = current
+}
+
+// IsSearching returns whether a reverse search is in progress
+func (r *HistoryRecall) IsSearching() bool {
+	return r.searching
+}
+
+// Type adds text to the search query, matching from the newest command again
+func (r *HistoryRecall) Type(text string) {
+	r.query += text
+	r.search(len(r.history.Entries()) - 1)
+}
+
+// Backspace removes the last character of the search query
+func (r *HistoryRecall) Backspace() {
+	if r.query == "" {
+		return
+	}
+	runes := []rune(r.query)
+	r.query = string(runes[:len(runes)-1])
+	r.search(len(r.history.Entries()) - 1)
+}
+
+// Older moves to the next older command matching the query, staying on the current
+// match when there is none
+func (r *HistoryRecall) Older() {
+	if r.query == "" || r.match <= 0 {
+		return
+	}
+	current := r.match
+	r.search(r.match - 1)
+	if r.match == -1 {
+		r.match = current
+	}
+}
+
+// search finds the newest command at or before from containing the query
+func (r *HistoryRecall) search(from int) {
+	r.match = -1
+	if r.query == "" {
+		return
+	}
+	entries := r.history.Entries()
+	for i := from; i >= 0; i-- {
+		if strings.Contains(entries[i], r.query) {
+			r.match = i
+			return
+		}
+	}
+}
+
+// Accept ends the search and returns the matching command, or what was typed before the
+// search if nothing matches
+func (r *HistoryRecall) Accept() string {
+	r.searching = false
+	if r.match == -1 {
+		return r.draft
+	}
+	r.index = r.match
+	return r.history.Entries()[r.match]
+}
+
+// Cancel ends the search and returns what was typed before it
+func (r *HistoryRecall) Cancel() string {
+	r.searching = false
+	r.match = -1
+	return r.draft
+}
+
+// SearchView renders the search prompt in place of the command input
+func (r *HistoryRecall) SearchView() string {
+	if r.query != "" && r.match == -1 {
+		return fmt.Sprintf("(failed reverse-i-search)`%s': ", r.query)
+	}
+	match := ""
+	if r.match != -1 {
+		match = r.history.Entries()[r.match]
+	}
+	return fmt.Sprintf("(reverse-i-search)`%s': %s", r.query, match)
+}
@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -20,6 +21,11 @@ type ConfirmDialog struct {
 	input        textinput.Model
 	inputMin     int
 	inputMax     int
+	textInput    bool // Free-form input, confirmed with enter since it may contain y and n
+
+	// Resource name that must be typed to confirm, empty if not required
+	confirmName string
+	nameInput   textinput.Model
 }
 
 // NewConfirmDialog creates a new confirmation dialog
@@ -27,6 +33,11 @@ func NewConfirmDialog(theme styles.Theme) *ConfirmDialog {
 	return &ConfirmDialog{theme: theme}
 }
 
+// SetTheme sets the theme the dialog renders with
+func (c *ConfirmDialog) SetTheme(theme styles.Theme) {
+	c.theme = theme
+}
+
 // SetMessage sets the confirmation message
 func (c *ConfirmDialog) SetMessage(message string) {
 	c.message = message
@@ -40,6 +51,7 @@ func (c *ConfirmDialog) SetWidth(width int) {
 // RequireInput enables input field in the dialog
 func (c *ConfirmDialog) RequireInput(label string, defaultVal string, min, max int) {
 	c.requireInput = true
+	c.textInput = false
 	c.inputLabel = label
 	c.inputMin = min
 	c.inputMax = max
@@ -47,11 +59,45 @@ func (c *ConfirmDialog) RequireInput(label string, defaultVal string, min, max i
 	c.input = textinput.New()
 	c.input.Placeholder = defaultVal
 	c.input.SetValue(defaultVal)
-	c.input.CharLimit = 3
+	c.input.CharLimit = len(strconv.Itoa(max))
 	c.input.Width = 10
 	c.input.Focus()
 }
 
+// RequireTextInput enables a free-form input field limited to charLimit characters
+func (c *ConfirmDialog) RequireTextInput(label string, defaultVal, placeholder string, charLimit int) {
+	c.requireInput = true
+	c.textInput = true
+	c.inputLabel = label
+	c.inputMin = 0
+	c.inputMax = 0
+
+	c.input = textinput.New()
+	c.input.Placeholder = placeholder
+	c.input.SetValue(defaultVal)
+	c.input.CharLimit = charLimit
+	c.input.Width = min(charLimit, 40)
+	c.input.Focus()
+}
+
+// RequireTypedName makes the user type the resource name before confirming. It works
+// alongside RequireInput, tab moves between the two fields.
+func (c *ConfirmDialog) RequireTypedName(name string) {
+	c.confirmName = name
+
+	c.nameInput = textinput.New()
+	c.nameInput.Placeholder = name
+	c.nameInput.CharLimit = len(name)
+	c.nameInput.Width = min(len(name), 40)
+	c.nameInput.Focus()
+	c.input.Blur()
+}
+
+// NameConfirmed returns whether the typed name matches, or no name is required
+func (c *ConfirmDialog) NameConfirmed() bool {
+	return c.confirmName == "" || c.nameInput.Value() == c.confirmName
+}
+
 // GetInput returns the current input value
 func (c *ConfirmDialog) GetInput() string {
 	return c.input.Value()
@@ -62,14 +108,39 @@ func (c *ConfirmDialog) HasInput() bool {
 	return c.requireInput
 }
 
+// HasTextInput returns whether the dialog has a free-form input field
+func (c *ConfirmDialog) HasTextInput() bool {
+	return (c.requireInput && c.textInput) || c.confirmName != ""
+}
+
 // Reset clears the input state
 func (c *ConfirmDialog) Reset() {
 	c.requireInput = false
+	c.textInput = false
 	c.input.SetValue("")
+	c.confirmName = ""
+	c.nameInput.SetValue("")
 }
 
 // Update handles messages for the input field
 func (c *ConfirmDialog) Update(msg tea.Msg) (*ConfirmDialog, tea.Cmd) {
+	if c.confirmName != "" {
+		// Tab switches between the input and the name field
+		if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "tab" && c.requireInput {
+			if c.nameInput.Focused() {
+				c.nameInput.Blur()
+				return c, c.input.Focus()
+			}
+			c.input.Blur()
+			return c, c.nameInput.Focus()
+		}
+		if c.nameInput.Focused() {
+			var cmd tea.Cmd
+			c.nameInput, cmd = c.nameInput.Update(msg)
+			return c, cmd
+		}
+	}
+
 	if !c.requireInput {
 		return c, nil
 	}
@@ -104,9 +175,29 @@ func (c *ConfirmDialog) View() string {
 		inputSection = "\n\n" + inputLabel + c.input.View()
 	}
 
+	if c.confirmName != "" {
+		nameColor := c.theme.Colors.Error
+		if c.NameConfirmed() {
+			nameColor = c.theme.Colors.Success
+		}
+		nameLabel := lipgloss.NewStyle().
+			Foreground(c.theme.Colors.Foreground).
+			Render("Type ") +
+			lipgloss.NewStyle().Bold(true).Foreground(nameColor).Render(c.confirmName) +
+			lipgloss.NewStyle().Foreground(c.theme.Colors.Foreground).Render(" to confirm: ")
+		inputSection += "\n\n" + nameLabel + c.nameInput.View()
+	}
+
+	helpText := "\n\nPress 'y' to confirm or 'n' to cancel"
+	if c.HasTextInput() {
+		helpText = "\n\nPress enter to confirm or esc to cancel"
+		if c.confirmName != "" && c.requireInput {
+			helpText += ", tab to switch fields"
+		}
+	}
 	help := lipgloss.NewStyle().
 		Foreground(c.theme.Colors.Muted).
-		Render("\n\nPress 'y' to confirm or 'n' to cancel")
+		Render(helpText)
 
 	content := fmt.Sprintf("%s\n\n%s%s%s", title, message, inputSection, help)
 
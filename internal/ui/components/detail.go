@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -12,7 +13,9 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"gopkg.in/yaml.v3"
 
+	"github.com/aaw-tui/aws-tui/internal/handlers"
 	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // Detail displays resource details in a scrollable view
@@ -22,6 +25,10 @@ type Detail struct {
 	yamlView bool
 	rawJSON  string
 
+	// Links to other resources, followed with enter
+	links      []handlers.DetailLink
+	linkCursor int
+
 	// Dimensions
 	width  int
 	height int
@@ -31,6 +38,9 @@ type Detail struct {
 
 	// Focus
 	focused bool
+
+	// Loading state while details are being fetched
+	loader *LoadingIndicator
 }
 
 // NewDetail creates a new detail component
@@ -41,9 +51,16 @@ func NewDetail(theme styles.Theme) *Detail {
 		viewport: vp,
 		theme:    theme,
 		focused:  false,
+		loader:   NewLoadingIndicator(PaneDetail),
 	}
 }
 
+// SetTheme sets the theme the detail view renders with
+func (d *Detail) SetTheme(theme styles.Theme) {
+	d.theme = theme
+	d.renderContent()
+}
+
 // SetSize sets the detail view dimensions
 func (d *Detail) SetSize(width, height int) {
 	d.width = width
@@ -59,10 +76,35 @@ func (d *Detail) SetContent(content map[string]interface{}) {
 	d.renderContent()
 }
 
+// SetLinks sets the links to other resources shown above the content
+func (d *Detail) SetLinks(links []handlers.DetailLink) {
+	d.links = links
+	d.linkCursor = 0
+	d.renderContent()
+}
+
 // Clear clears the detail view
 func (d *Detail) Clear() {
 	d.content = nil
+	d.links = nil
+	d.linkCursor = 0
 	d.viewport.SetContent("")
+	d.loader.Stop()
+}
+
+// StartLoading shows a spinner in place of the content until it arrives
+func (d *Detail) StartLoading() tea.Cmd {
+	return d.loader.Start("Loading details...")
+}
+
+// StopLoading hides the loading spinner
+func (d *Detail) StopLoading() {
+	d.loader.Stop()
+}
+
+// IsLoading returns whether details are being fetched
+func (d *Detail) IsLoading() bool {
+	return d.loader.IsActive()
 }
 
 // ToggleYAML switches between formatted and YAML view
@@ -71,6 +113,11 @@ func (d *Detail) ToggleYAML() {
 	d.renderContent()
 }
 
+// Redraw re-renders the content, e.g. after the timestamp display changed
+func (d *Detail) Redraw() {
+	d.renderContent()
+}
+
 // IsYAMLView returns whether YAML view is active
 func (d *Detail) IsYAMLView() bool {
 	return d.yamlView
@@ -105,6 +152,11 @@ func (d *Detail) IsFocused() bool {
 
 // Update handles messages
 func (d *Detail) Update(msg tea.Msg) (*Detail, tea.Cmd) {
+	// Loading ticks animate the spinner regardless of focus
+	if _, ok := msg.(LoadingTickMsg); ok {
+		return d, d.loader.Update(msg)
+	}
+
 	if !d.focused {
 		return d, nil
 	}
@@ -115,6 +167,17 @@ func (d *Detail) Update(msg tea.Msg) (*Detail, tea.Cmd) {
 		case key.Matches(msg, key.NewBinding(key.WithKeys("y"))):
 			d.ToggleYAML()
 			return d, nil
+		case key.Matches(msg, key.NewBinding(key.WithKeys("J"))) && len(d.links) > 0:
+			d.linkCursor = (d.linkCursor + 1) % len(d.links)
+			d.renderContent()
+			return d, nil
+		case key.Matches(msg, key.NewBinding(key.WithKeys("K"))) && len(d.links) > 0:
+			d.linkCursor = (d.linkCursor - 1 + len(d.links)) % len(d.links)
+			d.renderContent()
+			return d, nil
+		case key.Matches(msg, key.NewBinding(key.WithKeys("enter"))) && len(d.links) > 0:
+			action := d.links[d.linkCursor].Action
+			return d, func() tea.Msg { return action }
 		case key.Matches(msg, key.NewBinding(key.WithKeys("j", "down"))):
 			d.viewport.LineDown(1)
 		case key.Matches(msg, key.NewBinding(key.WithKeys("k", "up"))):
@@ -148,7 +211,34 @@ func (d *Detail) renderContent() {
 		content = d.renderFormatted()
 	}
 
-	d.viewport.SetContent(content)
+	d.viewport.SetContent(d.renderLinks() + content)
+}
+
+// renderLinks lists the links with the selected one highlighted
+func (d *Detail) renderLinks() string {
+	if len(d.links) == 0 {
+		return ""
+	}
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(d.theme.Colors.Accent)
+	linkStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Info)
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(d.theme.Colors.SelectionFg).Background(d.theme.Colors.Selection)
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	var sb strings.Builder
+	sb.WriteString(headerStyle.Render("Links"))
+	sb.WriteString(helpStyle.Render("  J/K: select, enter: open"))
+	sb.WriteString("\n")
+	for i, link := range d.links {
+		if i == d.linkCursor {
+			sb.WriteString(selectedStyle.Render("▸ " + link.Label))
+		} else {
+			sb.WriteString(linkStyle.Render("  " + link.Label))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
 }
 
 func (d *Detail) renderYAML() string {
@@ -161,9 +251,9 @@ func (d *Detail) renderYAML() string {
 	lines := strings.Split(string(data), "\n")
 	var highlighted []string
 
-	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("81"))
-	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
-	stringStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("78"))
+	keyStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Info)
+	valueStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Foreground)
+	stringStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Success)
 
 	for _, line := range lines {
 		if strings.TrimSpace(line) == "" {
@@ -210,15 +300,15 @@ func (d *Detail) renderFormatted() string {
 
 	sectionStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("212")).
+		Foreground(d.theme.Colors.Accent).
 		MarginTop(1)
 
 	keyStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("81")).
+		Foreground(d.theme.Colors.Info).
 		Width(25)
 
 	valueStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252"))
+		Foreground(d.theme.Colors.Foreground)
 
 	// Sort sections for consistent ordering
 	sections := make([]string, 0, len(d.content))
@@ -241,7 +331,7 @@ func (d *Detail) renderFormatted() string {
 			for k, val := range v {
 				sb.WriteString("  ")
 				sb.WriteString(keyStyle.Render(k + ":"))
-				sb.WriteString(valueStyle.Render(val))
+				sb.WriteString(valueStyle.Render(utils.FormatTimeValue(val)))
 				sb.WriteString("\n")
 			}
 
@@ -253,7 +343,7 @@ func (d *Detail) renderFormatted() string {
 				for k, val := range item {
 					sb.WriteString("  ")
 					sb.WriteString(keyStyle.Render(k + ":"))
-					sb.WriteString(valueStyle.Render(val))
+					sb.WriteString(valueStyle.Render(utils.FormatTimeValue(val)))
 					sb.WriteString("\n")
 				}
 				sb.WriteString("\n")
@@ -268,13 +358,13 @@ func (d *Detail) renderFormatted() string {
 		case []string:
 			for _, s := range v {
 				sb.WriteString("  • ")
-				sb.WriteString(valueStyle.Render(s))
+				sb.WriteString(valueStyle.Render(utils.FormatTimeValue(s)))
 				sb.WriteString("\n")
 			}
 
 		default:
 			sb.WriteString("  ")
-			sb.WriteString(valueStyle.Render(fmt.Sprintf("%v", v)))
+			sb.WriteString(valueStyle.Render(displayValue(v)))
 			sb.WriteString("\n")
 		}
 
@@ -305,7 +395,7 @@ func (d *Detail) renderMap(sb *strings.Builder, m map[string]interface{}, keySty
 			sb.WriteString("\n")
 			d.renderSlice(sb, val, keyStyle, valueStyle, indent+"  ")
 		default:
-			sb.WriteString(valueStyle.Render(fmt.Sprintf("%v", val)))
+			sb.WriteString(valueStyle.Render(displayValue(val)))
 			sb.WriteString("\n")
 		}
 	}
@@ -319,12 +409,23 @@ func (d *Detail) renderSlice(sb *strings.Builder, s []interface{}, keyStyle, val
 			d.renderMap(sb, v, keyStyle, valueStyle, indent+"  ")
 		default:
 			sb.WriteString(indent + "• ")
-			sb.WriteString(valueStyle.Render(fmt.Sprintf("%v", v)))
+			sb.WriteString(valueStyle.Render(displayValue(v)))
 			sb.WriteString("\n")
 		}
 	}
 }
 
+// displayValue formats a scalar value, showing timestamps in the configured display
+func displayValue(v interface{}) string {
+	switch val := v.(type) {
+	case time.Time:
+		return utils.FormatTimestamp(val)
+	case string:
+		return utils.FormatTimeValue(val)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
 // View renders the detail view
 func (d *Detail) View() string {
 	if d.width == 0 || d.height == 0 {
@@ -334,7 +435,7 @@ func (d *Detail) View() string {
 	// Title bar
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("252")).
+		Foreground(d.theme.Colors.Foreground).
 		Background(lipgloss.Color("237")).
 		Padding(0, 1).
 		Width(d.width)
@@ -345,11 +446,14 @@ func (d *Detail) View() string {
 	}
 
 	title := fmt.Sprintf("Details (%s) - Press 'y' to toggle", viewMode)
+	if d.loader.IsActive() {
+		title = fmt.Sprintf("Details %s", d.loader.Spinner())
+	}
 
 	// Border style based on focus
-	borderColor := lipgloss.Color("240")
+	borderColor := d.theme.Colors.Border
 	if d.focused {
-		borderColor = lipgloss.Color("62")
+		borderColor = d.theme.Colors.Secondary
 	}
 
 	contentStyle := lipgloss.NewStyle().
@@ -358,9 +462,14 @@ func (d *Detail) View() string {
 		Width(d.width - 2).
 		Height(d.height - 3)
 
+	body := d.viewport.View()
+	if d.loader.IsActive() {
+		body = d.loader.View(d.width-4, d.height-3)
+	}
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		titleStyle.Render(title),
-		contentStyle.Render(d.viewport.View()),
+		contentStyle.Render(body),
 	)
 }
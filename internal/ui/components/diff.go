@@ -0,0 +1,384 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// diffOp is the kind of change for a line in a diff
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffRemoved
+	diffAdded
+	diffChanged
+)
+
+// diffRow is one side-by-side row of a diff
+type diffRow struct {
+	op    diffOp
+	left  string
+	right string
+}
+
+// DiffView displays a side-by-side diff of two resources
+type DiffView struct {
+	theme      styles.Theme
+	visible    bool
+	leftTitle  string
+	rightTitle string
+	rows       []diffRow
+	changes    int
+	scroll     int
+	onlyDiffs  bool
+	width      int
+	height     int
+}
+
+// NewDiffView creates a new diff view
+func NewDiffView(theme styles.Theme) *DiffView {
+	return &DiffView{theme: theme}
+}
+
+// Show computes and displays the diff between two describe outputs
+func (d *DiffView) Show(leftTitle, rightTitle string, left, right interface{}) {
+	d.leftTitle = leftTitle
+	d.rightTitle = rightTitle
+	d.rows = diffLines(toDiffLines(left), toDiffLines(right))
+	d.scroll = 0
+	d.onlyDiffs = false
+	d.visible = true
+
+	d.changes = 0
+	for _, row := range d.rows {
+		if row.op != diffEqual {
+			d.changes++
+		}
+	}
+}
+
+// ShowText displays the diff between two blocks of text
+func (d *DiffView) ShowText(leftTitle, rightTitle, left, right string) {
+	d.leftTitle = leftTitle
+	d.rightTitle = rightTitle
+	d.rows = diffLines(strings.Split(left, "\n"), strings.Split(right, "\n"))
+	d.scroll = 0
+	d.onlyDiffs = false
+	d.visible = true
+
+	d.changes = 0
+	for _, row := range d.rows {
+		if row.op != diffEqual {
+			d.changes++
+		}
+	}
+}
+
+// Hide closes the diff view
+func (d *DiffView) Hide() {
+	d.visible = false
+	d.rows = nil
+	d.scroll = 0
+}
+
+// IsVisible returns whether the diff view is visible
+func (d *DiffView) IsVisible() bool {
+	return d.visible
+}
+
+// SetTheme sets the theme the view renders with
+func (d *DiffView) SetTheme(theme styles.Theme) {
+	d.theme = theme
+}
+
+// SetSize sets the view dimensions
+func (d *DiffView) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+}
+
+// toDiffLines renders a value as YAML lines, which gives stable key ordering
+func toDiffLines(v interface{}) []string {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return []string{fmt.Sprintf("error rendering: %v", err)}
+	}
+	return strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+}
+
+// diffLines computes a side-by-side line diff using the longest common subsequence.
+// Adjacent removals and additions are paired up as changed rows.
+func diffLines(left, right []string) []diffRow {
+	n, m := len(left), len(right)
+
+	// lcs[i][j] is the LCS length of left[i:] and right[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if left[i] == right[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var rows []diffRow
+	var removed, added []string
+
+	flush := func() {
+		for len(removed) > 0 && len(added) > 0 {
+			rows = append(rows, diffRow{op: diffChanged, left: removed[0], right: added[0]})
+			removed, added = removed[1:], added[1:]
+		}
+		for _, l := range removed {
+			rows = append(rows, diffRow{op: diffRemoved, left: l})
+		}
+		for _, r := range added {
+			rows = append(rows, diffRow{op: diffAdded, right: r})
+		}
+		removed, added = nil, nil
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case left[i] == right[j]:
+			flush()
+			rows = append(rows, diffRow{op: diffEqual, left: left[i], right: right[j]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			removed = append(removed, left[i])
+			i++
+		default:
+			added = append(added, right[j])
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		removed = append(removed, left[i])
+	}
+	for ; j < m; j++ {
+		added = append(added, right[j])
+	}
+	flush()
+
+	return rows
+}
+
+// visibleRows returns the rows to display, honoring the changes-only toggle
+func (d *DiffView) visibleRows() []diffRow {
+	if !d.onlyDiffs {
+		return d.rows
+	}
+	var rows []diffRow
+	for _, row := range d.rows {
+		if row.op != diffEqual {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (d *DiffView) pageSize() int {
+	return d.height - 10
+}
+
+// Update handles messages
+func (d *DiffView) Update(msg tea.Msg) (*DiffView, tea.Cmd) {
+	if !d.visible {
+		return d, nil
+	}
+
+	rows := d.visibleRows()
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q":
+			d.Hide()
+		case "j", "down":
+			if d.scroll < len(rows)-1 {
+				d.scroll++
+			}
+		case "k", "up":
+			if d.scroll > 0 {
+				d.scroll--
+			}
+		case "ctrl+d":
+			d.scroll += d.pageSize() / 2
+			if d.scroll >= len(rows) {
+				d.scroll = len(rows) - 1
+			}
+			if d.scroll < 0 {
+				d.scroll = 0
+			}
+		case "ctrl+u":
+			d.scroll -= d.pageSize() / 2
+			if d.scroll < 0 {
+				d.scroll = 0
+			}
+		case "g":
+			d.scroll = 0
+		case "G":
+			d.scroll = len(rows) - 1
+			if d.scroll < 0 {
+				d.scroll = 0
+			}
+		case "x":
+			// Toggle showing only changed lines
+			d.onlyDiffs = !d.onlyDiffs
+			d.scroll = 0
+		case "n":
+			// Jump to next change
+			for i := d.scroll + 1; i < len(rows); i++ {
+				if rows[i].op != diffEqual {
+					d.scroll = i
+					break
+				}
+			}
+		case "N":
+			// Jump to previous change
+			for i := d.scroll - 1; i >= 0; i-- {
+				if rows[i].op != diffEqual {
+					d.scroll = i
+					break
+				}
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// View renders the diff view
+func (d *DiffView) View() string {
+	if !d.visible {
+		return ""
+	}
+
+	width := d.width - 4
+	if width < 40 {
+		width = 40
+	}
+	colWidth := (width - 7) / 2
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(d.theme.Colors.Primary)
+
+	removedStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Error)
+	addedStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Success)
+	changedStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Warning)
+	equalStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Muted)
+	sepStyle := lipgloss.NewStyle().Foreground(d.theme.Colors.Border)
+
+	var sb strings.Builder
+
+	header := fmt.Sprintf("  %s │ %s",
+		titleStyle.Render(padDiffCell(d.leftTitle, colWidth)),
+		titleStyle.Render(padDiffCell(d.rightTitle, colWidth)),
+	)
+	sb.WriteString(header)
+	sb.WriteString("\n")
+	sb.WriteString(sepStyle.Render(strings.Repeat("─", width)))
+	sb.WriteString("\n")
+
+	rows := d.visibleRows()
+	pageSize := d.pageSize()
+	if pageSize < 1 {
+		pageSize = 1
+	}
+
+	end := d.scroll + pageSize
+	if end > len(rows) {
+		end = len(rows)
+	}
+
+	for i := d.scroll; i < end; i++ {
+		row := rows[i]
+		left := padDiffCell(row.left, colWidth)
+		right := padDiffCell(row.right, colWidth)
+
+		var marker string
+		switch row.op {
+		case diffRemoved:
+			marker = removedStyle.Render("-")
+			left = removedStyle.Render(left)
+			right = equalStyle.Render(right)
+		case diffAdded:
+			marker = addedStyle.Render("+")
+			left = equalStyle.Render(left)
+			right = addedStyle.Render(right)
+		case diffChanged:
+			marker = changedStyle.Render("~")
+			left = removedStyle.Render(left)
+			right = addedStyle.Render(right)
+		default:
+			marker = " "
+			left = equalStyle.Render(left)
+			right = equalStyle.Render(right)
+		}
+
+		sb.WriteString(fmt.Sprintf("%s %s %s %s", marker, left, sepStyle.Render("│"), right))
+		sb.WriteString("\n")
+	}
+
+	for i := end - d.scroll; i < pageSize; i++ {
+		sb.WriteString("\n")
+	}
+
+	summary := "No differences"
+	if d.changes > 0 {
+		summary = fmt.Sprintf("%d changed line(s)", d.changes)
+	}
+	mode := "all lines"
+	if d.onlyDiffs {
+		mode = "changes only"
+	}
+
+	help := lipgloss.NewStyle().
+		Foreground(d.theme.Colors.Muted).
+		Render(fmt.Sprintf("%s (%s) | j/k: scroll | n/N: next/prev change | x: toggle changes only | esc: close", summary, mode))
+	sb.WriteString(help)
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(d.theme.Colors.Primary).
+		Width(width).
+		Render(sb.String())
+
+	return lipgloss.Place(
+		d.width,
+		d.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
+
+// padDiffCell truncates or pads a line to the column width
+func padDiffCell(s string, width int) string {
+	s = strings.ReplaceAll(s, "\t", "  ")
+	if lipgloss.Width(s) > width {
+		runes := []rune(s)
+		for len(runes) > 0 && lipgloss.Width(string(runes)) > width-1 {
+			runes = runes[:len(runes)-1]
+		}
+		return string(runes) + "…"
+	}
+	return s + strings.Repeat(" ", width-lipgloss.Width(s))
+}
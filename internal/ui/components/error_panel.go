@@ -0,0 +1,388 @@
+package components
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// ErrorEntry is an error the footer showed, with what could be read out of the AWS SDK's
+// error text
+type ErrorEntry struct {
+	Time       time.Time
+	Message    string
+	Service    string // e.g. "EC2"
+	Operation  string // e.g. "DescribeInstances"
+	StatusCode string
+	Code       string // AWS error code, e.g. "AccessDeniedException"
+	RequestID  string
+	// IAM actions the error says were denied, e.g. "ec2:DescribeInstances"
+	Permissions []string
+	Hints       []string
+}
+
+var (
+	operationPattern  = regexp.MustCompile(`operation error ([^:,]+): (\w+)`)
+	statusCodePattern = regexp.MustCompile(`StatusCode: (\d+)`)
+	requestIDPattern  = regexp.MustCompile(`RequestID: ([\w-]+)`)
+	apiErrorPattern   = regexp.MustCompile(`api error (\w+):`)
+	deniedPattern     = regexp.MustCompile(`not authorized to perform:? ([\w-]+:[\w*-]+)`)
+)
+
+// iamPrefixes maps the SDK's service IDs, as error messages name them, to IAM action
+// prefixes where the two differ beyond case and spaces
+var iamPrefixes = map[string]string{
+	"CloudWatch Logs":             "logs",
+	"CloudWatch":                  "cloudwatch",
+	"Auto Scaling":                "autoscaling",
+	"Elastic Load Balancing v2":   "elasticloadbalancing",
+	"Elastic Load Balancing":      "elasticloadbalancing",
+	"Secrets Manager":             "secretsmanager",
+	"Cost Explorer":               "ce",
+	"Config Service":              "config",
+	"API Gateway":                 "apigateway",
+	"ApiGatewayV2":                "apigateway",
+	"Route 53":                    "route53",
+	"SSO":                         "sso",
+	"Resource Groups Tagging API": "tag",
+	"imagebuilder":                "imagebuilder",
+	"Pinpoint":                    "mobiletargeting",
+}
+
+// iamActionsByOperation lists the operations whose IAM action isn't named after them
+var iamActionsByOperation = map[string]string{
+	"S3:ListObjectsV2":          "s3:ListBucket",
+	"S3:ListObjects":            "s3:ListBucket",
+	"S3:ListObjectVersions":     "s3:ListBucketVersions",
+	"S3:HeadBucket":             "s3:ListBucket",
+	"S3:HeadObject":             "s3:GetObject",
+	"Lambda:Invoke":             "lambda:InvokeFunction",
+	"DynamoDB:ExecuteStatement": "dynamodb:PartiQLSelect",
+}
+
+// ParseError reads the service, operation, error code and request ID out of an error as
+// the footer shows it, and suggests how to fix it
+func ParseError(message string) ErrorEntry {
+	e := ErrorEntry{Time: time.Now(), Message: message}
+	if m := operationPattern.FindStringSubmatch(message); m != nil {
+		e.Service, e.Operation = m[1], m[2]
+	}
+	if m := statusCodePattern.FindStringSubmatch(message); m != nil {
+		e.StatusCode = m[1]
+	}
+	if m := requestIDPattern.FindStringSubmatch(message); m != nil {
+		e.RequestID = m[1]
+	}
+	if m := apiErrorPattern.FindStringSubmatch(message); m != nil {
+		e.Code = m[1]
+	}
+
+	seen := make(map[string]bool)
+	for _, m := range deniedPattern.FindAllStringSubmatch(message, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			e.Permissions = append(e.Permissions, m[1])
+		}
+	}
+	// Some services, S3 among them, don't say which action was denied, but it is
+	// usually the operation's own
+	if len(e.Permissions) == 0 && isAccessDenied(e.Code, message) && e.Operation != "" {
+		e.Permissions = []string{iamAction(e.Service, e.Operation)}
+	}
+
+	e.Hints = errorHints(e)
+	return e
+}
+
+// iamAction guesses the IAM action an operation needs, e.g. "ec2:DescribeInstances"
+func iamAction(service, operation string) string {
+	if action, ok := iamActionsByOperation[service+":"+operation]; ok {
+		return action
+	}
+	prefix, ok := iamPrefixes[service]
+	if !ok {
+		prefix = strings.ToLower(strings.ReplaceAll(service, " ", ""))
+	}
+	return prefix + ":" + operation
+}
+
+// CredentialsExpired reports whether an error says the credentials or the SSO session
+// behind them expired, rather than that they lack a permission
+func CredentialsExpired(e ErrorEntry) bool {
+	if e.Code == "ExpiredToken" || e.Code == "ExpiredTokenException" {
+		return true
+	}
+	msg := strings.ToLower(e.Message)
+	return strings.Contains(msg, "token has expired") || strings.Contains(msg, "token is expired") ||
+		strings.Contains(msg, "sso session") || strings.Contains(msg, "sso token") ||
+		strings.Contains(msg, "security token included in the request is expired") ||
+		(strings.Contains(msg, "getrolecredentials") && strings.Contains(msg, "unauthorized"))
+}
+
+func isAccessDenied(code, message string) bool {
+	switch code {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation", "AuthorizationError",
+		"UnauthorizedException", "Forbidden":
+		return true
+	}
+	return code == "" && strings.Contains(message, "not authorized to perform")
+}
+
+// errorHints suggests fixes for the errors seen most often
+func errorHints(e ErrorEntry) []string {
+	msg := strings.ToLower(e.Message)
+	var hints []string
+
+	switch {
+	case CredentialsExpired(e):
+		hints = append(hints, "The credentials expired: press L or run :sso to log in again, or press p to pick another profile")
+
+	case isAccessDenied(e.Code, e.Message):
+		if len(e.Permissions) > 0 {
+			hints = append(hints, fmt.Sprintf("Grant %s to the role or user of this profile", strings.Join(e.Permissions, ", ")))
+		}
+		switch {
+		case strings.Contains(msg, "service control policy"):
+			hints = append(hints, "An organization service control policy denies it; ask the organization's administrators")
+		case strings.Contains(msg, "permissions boundary"):
+			hints = append(hints, "The permissions boundary of the role or user denies it")
+		case strings.Contains(msg, "explicit deny"):
+			hints = append(hints, "A policy explicitly denies it, and a deny wins over any allow")
+		}
+		if strings.Contains(msg, "encoded authorization failure message") {
+			hints = append(hints, "Run aws sts decode-authorization-message --encoded-message <message> for the details")
+		}
+		hints = append(hints, "Check what the profile may do with :can <action> [resource]")
+
+	case e.Code == "InvalidClientTokenId" || e.Code == "UnrecognizedClientException" ||
+		strings.Contains(msg, "security token included in the request is invalid"):
+		hints = append(hints, "The credentials aren't valid here: the access key may be deleted, or the region not enabled for the account")
+
+	case strings.Contains(msg, "failed to refresh cached credentials") || strings.Contains(msg, "no ec2 imds role found") ||
+		strings.Contains(msg, "failed to retrieve credentials"):
+		hints = append(hints, "No credentials could be loaded: press p to pick a profile, or run :sso for SSO profiles")
+
+	case e.Code == "TargetNotConnectedException" || strings.Contains(msg, "execute command was not enabled"):
+		hints = append(hints, "ECS Exec needs the task started with execute command enabled, e.g. a service updated with "+
+			"--enable-execute-command and redeployed, and a task role allowing ssmmessages:*")
+
+	case strings.Contains(msg, "built-in session manager client"):
+		hints = append(hints, "Install the session-manager-plugin, which ECS Exec uses when it is on the PATH; "+
+			"sessions encrypted with a KMS key need it")
+
+	case strings.Contains(e.Code, "Throttl") || e.Code == "TooManyRequestsException" ||
+		e.Code == "RequestLimitExceeded" || e.Code == "SlowDown":
+		hints = append(hints, "AWS is throttling the calls: wait a moment and press r to retry")
+
+	case strings.Contains(msg, "context deadline exceeded"):
+		hints = append(hints, "The call timed out: press r to retry, or raise request_timeout_seconds in config.yaml")
+
+	case strings.Contains(msg, "context canceled"):
+		hints = append(hints, "The call was cancelled; press r to load again")
+
+	case e.Code == "OptInRequired" || e.Code == "SubscriptionRequiredException":
+		hints = append(hints, "The account isn't subscribed to this service in the region; enable it or press R for another region")
+
+	case strings.Contains(e.Code, "NotFound") || strings.HasPrefix(e.Code, "NoSuch"):
+		hints = append(hints, "The resource no longer exists or is in another region; press r to refresh the list")
+
+	case e.Code == "ValidationException" || e.Code == "InvalidParameterValue" || e.Code == "InvalidParameterException":
+		hints = append(hints, "AWS rejected a parameter of the call; the message says which")
+
+	case strings.Contains(msg, "no such host") || strings.Contains(msg, "dial tcp") ||
+		strings.Contains(msg, "connection refused") || strings.Contains(msg, "connection reset"):
+		hints = append(hints, "AWS couldn't be reached: check the network, proxy and VPN, and that the region exists")
+	}
+
+	if e.RequestID != "" {
+		hints = append(hints, "Quote the request ID when asking AWS Support or searching CloudTrail")
+	}
+	return hints
+}
+
+// ErrorPanel shows the errors of the session, newest first, with their details and hints
+type ErrorPanel struct {
+	theme   styles.Theme
+	width   int
+	height  int
+	visible bool
+	entries []ErrorEntry
+	index   int // Entry shown, 0 the newest
+	scroll  int
+	lines   int // Lines of the entry shown, for scrolling
+}
+
+// NewErrorPanel creates a new error panel
+func NewErrorPanel(theme styles.Theme) *ErrorPanel {
+	return &ErrorPanel{theme: theme}
+}
+
+// Show opens the panel on the newest of the errors, which are oldest first
+func (p *ErrorPanel) Show(entries []ErrorEntry) {
+	p.entries = entries
+	p.index = 0
+	p.scroll = 0
+	p.visible = true
+}
+
+// Hide closes the panel
+func (p *ErrorPanel) Hide() {
+	p.visible = false
+	p.entries = nil
+}
+
+// IsVisible returns whether the panel is open
+func (p *ErrorPanel) IsVisible() bool {
+	return p.visible
+}
+
+// SetTheme sets the theme the panel renders with
+func (p *ErrorPanel) SetTheme(theme styles.Theme) {
+	p.theme = theme
+}
+
+// SetSize sets the panel dimensions
+func (p *ErrorPanel) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+}
+
+// Update handles messages
+func (p *ErrorPanel) Update(msg tea.Msg) (*ErrorPanel, tea.Cmd) {
+	if !p.visible {
+		return p, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return p, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q", "E":
+		p.Hide()
+	case "j", "down":
+		if p.scroll < p.lines-1 {
+			p.scroll++
+		}
+	case "k", "up":
+		if p.scroll > 0 {
+			p.scroll--
+		}
+	case "l", "right", "]":
+		// Older
+		if p.index < len(p.entries)-1 {
+			p.index++
+			p.scroll = 0
+		}
+	case "h", "left", "[":
+		// Newer
+		if p.index > 0 {
+			p.index--
+			p.scroll = 0
+		}
+	}
+	return p, nil
+}
+
+// View renders the panel
+func (p *ErrorPanel) View() string {
+	if !p.visible {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(p.theme.Colors.Error)
+	labelStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Muted).
+		Width(12)
+	headingStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(p.theme.Colors.Primary)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Muted)
+
+	boxWidth := max(p.width-10, 40)
+	textWidth := boxWidth - 6
+
+	var title string
+	var body []string
+	if len(p.entries) == 0 {
+		title = titleStyle.Render("Errors")
+		body = []string{mutedStyle.Render("No errors this session")}
+	} else {
+		e := p.entries[len(p.entries)-1-p.index]
+		title = titleStyle.Render(fmt.Sprintf("Error %d of %d", p.index+1, len(p.entries))) +
+			mutedStyle.Render("  "+e.Time.Format("15:04:05"))
+
+		wrap := lipgloss.NewStyle().Width(textWidth)
+		body = append(body, strings.Split(wrap.Render(e.Message), "\n")...)
+		body = append(body, "")
+
+		fields := []struct{ label, value string }{
+			{"Service", e.Service},
+			{"Operation", e.Operation},
+			{"Status", e.StatusCode},
+			{"Code", e.Code},
+			{"Request ID", e.RequestID},
+		}
+		for _, field := range fields {
+			if field.value != "" {
+				body = append(body, labelStyle.Render(field.label)+field.value)
+			}
+		}
+
+		if len(e.Permissions) > 0 {
+			body = append(body, "", headingStyle.Render("Missing permissions"))
+			for _, permission := range e.Permissions {
+				body = append(body, "  "+permission)
+			}
+		}
+		if len(e.Hints) > 0 {
+			body = append(body, "", headingStyle.Render("Suggestions"))
+			hintStyle := lipgloss.NewStyle().Width(textWidth - 4)
+			for _, hint := range e.Hints {
+				for i, line := range strings.Split(hintStyle.Render(hint), "\n") {
+					prefix := "    "
+					if i == 0 {
+						prefix = "  • "
+					}
+					body = append(body, prefix+line)
+				}
+			}
+		}
+	}
+
+	p.lines = len(body)
+	visible := max(p.height-12, 5)
+	p.scroll = min(p.scroll, max(len(body)-visible, 0))
+	end := min(p.scroll+visible, len(body))
+	shown := body[p.scroll:end]
+
+	help := "j/k: scroll | h/l: newer/older | esc: close"
+	if len(body) > visible {
+		help += fmt.Sprintf(" (Line %d/%d)", p.scroll+1, len(body))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(p.theme.Colors.Error).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(title + "\n\n" + strings.Join(shown, "\n") + "\n\n" + mutedStyle.Render(help))
+
+	return lipgloss.Place(
+		p.width,
+		p.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
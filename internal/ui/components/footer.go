@@ -3,7 +3,9 @@ package components
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/aaw-tui/aws-tui/internal/handlers"
@@ -20,31 +22,79 @@ type Footer struct {
 	messageErr bool
 	loading    bool
 	loadingMsg string
+	// Spinner of the load in progress, when it started, and the tick that starts the
+	// spinner's animation until the app picks it up with Animate
+	spinner      *LoadingIndicator
+	loadingSince time.Time
+	startTick    tea.Cmd
+	cancellable  bool // ctrl+x can cancel the load, which mutating calls can't be
 	// Pagination
 	page    int
 	hasMore bool
 	count   int
 	// Handler actions for context-specific hints
 	handlerActions []handlers.Action
+	readOnly       bool // Hide hints for mutating actions
+	// Newer release than the running version, shown until the app exits
+	update string
+	// Tag filter of the list, shown while one is applied
+	tagFilter string
+	// Errors shown this session, oldest first, for the error panel, and how many there
+	// were including those dropped from it
+	errors     []ErrorEntry
+	errorCount int
 }
 
+// maxErrorHistory is how many errors the footer keeps for the error panel
+const maxErrorHistory = 100
+
 // NewFooter creates a new footer component
 func NewFooter(theme styles.Theme, keyMap keys.KeyMap) *Footer {
 	return &Footer{
-		theme: theme,
-		keys:  keyMap,
+		theme:   theme,
+		keys:    keyMap,
+		spinner: NewLoadingIndicator(PaneFooter),
 	}
 }
 
+// SetTheme sets the theme the footer renders with
+func (f *Footer) SetTheme(theme styles.Theme) {
+	f.theme = theme
+}
+
 // SetWidth sets the footer width
 func (f *Footer) SetWidth(width int) {
 	f.width = width
 }
 
-// SetMessage sets a status message
+// SetMessage sets a status message. Errors are kept for the error panel.
 func (f *Footer) SetMessage(msg string, isError bool) {
 	f.message = msg
 	f.messageErr = isError
+	if isError && msg != "" {
+		f.errorCount++
+		f.errors = append(f.errors, ParseError(msg))
+		if len(f.errors) > maxErrorHistory {
+			f.errors = f.errors[len(f.errors)-maxErrorHistory:]
+		}
+	}
+}
+
+// Errors returns the errors shown this session, oldest first
+func (f *Footer) Errors() []ErrorEntry {
+	return f.errors
+}
+
+// ErrorCount returns how many errors were shown this session, so a caller can tell
+// whether one was shown since it last looked
+func (f *Footer) ErrorCount() int {
+	return f.errorCount
+}
+
+// RewordMessage replaces the text of the message shown, keeping it an error or not,
+// without recording it in the errors again
+func (f *Footer) RewordMessage(msg string) {
+	f.message = msg
 }
 
 // ClearMessage clears the status message
@@ -53,12 +103,44 @@ func (f *Footer) ClearMessage() {
 	f.messageErr = false
 }
 
-// SetLoading sets the loading state
+// SetLoading sets the loading state. A load starting animates the spinner once the app
+// calls Animate.
+// SetCancellable sets whether the load in progress can be cancelled with ctrl+x
+func (f *Footer) SetCancellable(cancellable bool) {
+	f.cancellable = cancellable
+}
+
 func (f *Footer) SetLoading(loading bool, msg string) {
+	switch {
+	case loading && !f.loading:
+		f.loadingSince = time.Now()
+		f.startTick = f.spinner.Start(msg)
+	case !loading:
+		f.spinner.Stop()
+		f.startTick = nil
+	}
 	f.loading = loading
 	f.loadingMsg = msg
 }
 
+// IsLoading returns whether the footer shows a load in progress
+func (f *Footer) IsLoading() bool {
+	return f.loading
+}
+
+// Animate returns the command that starts the spinner after SetLoading started a load,
+// nil otherwise. The app calls it after each update.
+func (f *Footer) Animate() tea.Cmd {
+	cmd := f.startTick
+	f.startTick = nil
+	return cmd
+}
+
+// Update advances the spinner on its ticks
+func (f *Footer) Update(msg LoadingTickMsg) tea.Cmd {
+	return f.spinner.Update(msg)
+}
+
 // SetPagination sets the pagination info
 func (f *Footer) SetPagination(page int, hasMore bool, count int) {
 	f.page = page
@@ -78,6 +160,21 @@ func (f *Footer) SetHandlerActions(actions []handlers.Action) {
 	f.handlerActions = actions
 }
 
+// SetReadOnly hides the hints of mutating actions while read-only mode is on
+func (f *Footer) SetReadOnly(readOnly bool) {
+	f.readOnly = readOnly
+}
+
+// SetUpdateAvailable shows that a newer release is out, pointing to :changelog
+func (f *Footer) SetUpdateAvailable(tag string) {
+	f.update = tag
+}
+
+// SetTagFilter shows the tag filter expression of the list, none when empty
+func (f *Footer) SetTagFilter(expr string) {
+	f.tagFilter = expr
+}
+
 // ClearHandlerActions clears the handler actions
 func (f *Footer) ClearHandlerActions() {
 	f.handlerActions = nil
@@ -87,23 +184,34 @@ func (f *Footer) ClearHandlerActions() {
 func (f *Footer) View() string {
 	// If loading, show loading indicator
 	if f.loading {
-		spinner := "⣾⣽⣻⢿⡿⣟⣯⣷"
 		loadingStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")).
+			Foreground(f.theme.Colors.Warning).
 			Bold(true)
+		mutedStyle := lipgloss.NewStyle().
+			Foreground(f.theme.Colors.Muted)
 		msg := f.loadingMsg
 		if msg == "" {
 			msg = "Loading..."
 		}
-		content := loadingStyle.Render(string(spinner[0])) + " " + msg
+		content := loadingStyle.Render(f.spinner.Spinner()) + " " + msg
+		// Quick loads finish before the elapsed time would be worth showing
+		if elapsed := time.Since(f.loadingSince); elapsed >= time.Second {
+			hint := " " + formatElapsed(elapsed)
+			if f.cancellable {
+				hint += " · ctrl+x to cancel"
+			}
+			content += mutedStyle.Render(hint)
+		}
 		return f.theme.Footer.Width(f.width).Render(content)
 	}
 
 	// If there's a message, show it
 	if f.message != "" {
-		style := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+		style := lipgloss.NewStyle().Foreground(f.theme.Colors.Foreground)
 		if f.messageErr {
-			style = style.Foreground(lipgloss.Color("196"))
+			style = style.Foreground(f.theme.Colors.Error)
+			hint := lipgloss.NewStyle().Foreground(f.theme.Colors.Muted).Render(" · E for details")
+			return f.theme.Footer.Width(f.width).Render(style.Render(f.message) + hint)
 		}
 		return f.theme.Footer.Width(f.width).Render(style.Render(f.message))
 	}
@@ -115,36 +223,51 @@ func (f *Footer) View() string {
 
 func (f *Footer) buildHelpHints() string {
 	keyStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("39")).
+		Foreground(f.theme.Colors.Primary).
 		Bold(true)
 
 	descStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245"))
+		Foreground(f.theme.Colors.Muted)
 
 	sepStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("240"))
+		Foreground(f.theme.Colors.Border)
 
-	hints := []string{
-		fmt.Sprintf("%s %s", keyStyle.Render("j/k"), descStyle.Render("nav")),
-		fmt.Sprintf("%s %s", keyStyle.Render("Ctrl+R"), descStyle.Render("refresh")),
+	var hints []string
+	if f.update != "" {
+		updateStyle := lipgloss.NewStyle().
+			Foreground(f.theme.Colors.Warning).
+			Bold(true)
+		hints = append(hints, updateStyle.Render(fmt.Sprintf("update available %s", f.update))+" "+descStyle.Render("(:changelog)"))
+	}
+	if f.tagFilter != "" {
+		filterStyle := lipgloss.NewStyle().
+			Foreground(f.theme.Colors.Accent)
+		hints = append(hints, descStyle.Render("tags ")+filterStyle.Render(f.tagFilter))
 	}
+	hints = append(hints,
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("down")+"/"+f.keys.Key("up")), descStyle.Render("nav")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("refresh")), descStyle.Render("refresh")),
+	)
 
 	// Add handler-specific action hints if available
 	if len(f.handlerActions) > 0 {
 		for _, action := range f.handlerActions {
+			if f.readOnly && action.Mutating {
+				continue
+			}
 			hints = append(hints, fmt.Sprintf("%s %s", keyStyle.Render(action.Key), descStyle.Render(action.Description)))
 		}
 	}
 
-	// Add common hints
+	// Add common hints, with the keys config.yaml may have remapped
 	hints = append(hints,
-		fmt.Sprintf("%s %s", keyStyle.Render("/"), descStyle.Render("search")),
-		fmt.Sprintf("%s %s", keyStyle.Render("o"), descStyle.Render("sort")),
-		fmt.Sprintf("%s %s", keyStyle.Render(":"), descStyle.Render("cmd")),
-		fmt.Sprintf("%s %s", keyStyle.Render("d"), descStyle.Render("describe")),
-		fmt.Sprintf("%s %s", keyStyle.Render("c"), descStyle.Render("copy")),
-		fmt.Sprintf("%s %s", keyStyle.Render("?"), descStyle.Render("help")),
-		fmt.Sprintf("%s %s", keyStyle.Render("q"), descStyle.Render("quit")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("search")), descStyle.Render("search")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("sort")), descStyle.Render("sort")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("command")), descStyle.Render("cmd")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("describe")), descStyle.Render("describe")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("copy_arn")), descStyle.Render("copy")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("help")), descStyle.Render("help")),
+		fmt.Sprintf("%s %s", keyStyle.Render(f.keys.Key("quit")), descStyle.Render("quit")),
 	)
 
 	helpHints := strings.Join(hints, sepStyle.Render(" │ "))
@@ -152,7 +275,7 @@ func (f *Footer) buildHelpHints() string {
 	// Add pagination info if present
 	if f.page > 0 {
 		pageStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")).
+			Foreground(f.theme.Colors.Warning).
 			Bold(true)
 
 		pageInfo := fmt.Sprintf("Page %d", f.page)
@@ -190,3 +313,12 @@ func (f *Footer) buildHelpHints() string {
 
 	return helpHints
 }
+
+// formatElapsed shows how long a load has run, e.g. 7s or 1m05s
+func formatElapsed(d time.Duration) string {
+	seconds := int(d.Seconds())
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+	return fmt.Sprintf("%dm%02ds", seconds/60, seconds%60)
+}
@@ -3,6 +3,7 @@ package components
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
@@ -11,12 +12,19 @@ import (
 
 // Header displays the top bar with profile, region, and account info
 type Header struct {
-	profile     string
-	region      string
-	accountID   string
-	context     string // Current resource context (e.g., "EC2", "DynamoDB", "Home")
-	width       int
-	theme       styles.Theme
+	profile        string
+	region         string
+	accountID      string
+	context        string // Current resource context (e.g., "EC2", "DynamoDB", "Home")
+	readOnly       bool
+	role           string        // Role assumed from the TUI, empty for the profile's credentials
+	policy         string        // Session policy scoping the role, empty if unscoped
+	pending        string        // Profile being switched to, empty when no switch is in progress
+	elevated       time.Duration // Time left of an elevation, 0 when not elevated
+	needsElevation bool          // Read-only mode is left with :elevate rather than :ro
+	session        time.Time     // When the session of the credentials ends, zero if it doesn't
+	width          int
+	theme          styles.Theme
 }
 
 // NewHeader creates a new header component
@@ -28,6 +36,11 @@ func NewHeader(theme styles.Theme) *Header {
 	}
 }
 
+// SetTheme sets the theme the header renders with
+func (h *Header) SetTheme(theme styles.Theme) {
+	h.theme = theme
+}
+
 // SetProfile updates the displayed profile
 func (h *Header) SetProfile(profile string) {
 	h.profile = profile
@@ -46,6 +59,23 @@ func (h *Header) SetAccountID(accountID string) {
 	h.accountID = accountID
 }
 
+// SetSessionExpiry shows how long the session of the credentials has left, zero to hide it
+func (h *Header) SetSessionExpiry(expiry time.Time) {
+	h.session = expiry
+}
+
+// sessionLeft describes the time left of the session, e.g. 42m or 3h05m
+func (h *Header) sessionLeft() string {
+	left := time.Until(h.session)
+	switch {
+	case left <= 0:
+		return "expired"
+	case left < time.Hour:
+		return fmt.Sprintf("%dm left", int(left.Minutes())+1)
+	}
+	return fmt.Sprintf("%dh%02dm left", int(left.Hours()), int(left.Minutes())%60)
+}
+
 // SetWidth sets the header width
 func (h *Header) SetWidth(width int) {
 	h.width = width
@@ -56,11 +86,42 @@ func (h *Header) SetContext(context string) {
 	h.context = context
 }
 
+// Context returns the current resource context
+func (h *Header) Context() string {
+	return h.context
+}
+
+// SetReadOnly toggles the read-only banner
+func (h *Header) SetReadOnly(readOnly bool) {
+	h.readOnly = readOnly
+}
+
+// SetAssumedRole shows the assumed role and the session policy scoping it, empty to clear
+func (h *Header) SetAssumedRole(role, policy string) {
+	h.role = role
+	h.policy = policy
+}
+
+// SetElevated shows the time left of an elevation, 0 once it ends
+func (h *Header) SetElevated(left time.Duration) {
+	h.elevated = left
+}
+
+// SetElevationRequired points to :elevate rather than :ro in the read-only banner
+func (h *Header) SetElevationRequired(required bool) {
+	h.needsElevation = required
+}
+
+// SetPendingProfile shows the profile being switched to, empty once the switch ends
+func (h *Header) SetPendingProfile(profile string) {
+	h.pending = profile
+}
+
 // View renders the header
 func (h *Header) View() string {
 	// Define styles
 	boxStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("240"))
+		Foreground(h.theme.Colors.Border)
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -95,6 +156,9 @@ func (h *Header) View() string {
 		labelStyle.Render("Profile:"),
 		valueStyle.Render(h.profile),
 	)
+	if arrow := " → " + h.pending + "…"; h.pending != "" && len(" Profile: "+h.profile+arrow) <= 36 {
+		line1 += labelStyle.Render(arrow)
+	}
 
 	line2 := fmt.Sprintf(" %s %s",
 		labelStyle.Render("Region: "),
@@ -107,14 +171,21 @@ func (h *Header) View() string {
 			labelStyle.Render("Account:"),
 			valueStyle.Render(h.accountID),
 		)
+		if !h.session.IsZero() {
+			sessionStyle := labelStyle
+			if time.Until(h.session) < 15*time.Minute {
+				sessionStyle = lipgloss.NewStyle().Bold(true).Foreground(h.theme.Colors.Warning)
+			}
+			line3 += sessionStyle.Render(" · " + h.sessionLeft())
+		}
 	} else {
 		line3 = " "
 	}
 
 	// Calculate widths for layout (accounting for borders: 4 x "│")
-	logoWidth := 6     // Logo is 6 chars
-	infoWidth := 36    // Info section width
-	contextWidth := h.width - logoWidth - infoWidth - 4  // 4 borders
+	logoWidth := 6                                      // Logo is 6 chars
+	infoWidth := 36                                     // Info section width
+	contextWidth := h.width - logoWidth - infoWidth - 4 // 4 borders
 
 	// Create the top border
 	topBorder := "┌" + strings.Repeat("─", logoWidth) + "┬" +
@@ -147,14 +218,47 @@ func (h *Header) View() string {
 		lipgloss.NewStyle().Width(infoWidth).Render(line3) + "│" +
 		strings.Repeat(" ", contextWidth) + "│"
 
-	// Build title bar
-	titleBar := lipgloss.NewStyle().
+	// Build title bar, turned into a banner while in read-only mode
+	barStyle := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color("229")).
 		Background(lipgloss.Color("235")).
 		Width(h.width).
-		Align(lipgloss.Center).
-		Render("AWS Terminal UI")
+		Align(lipgloss.Center)
+	title := "AWS Terminal UI"
+	if h.policy != "" {
+		barStyle = barStyle.
+			Foreground(lipgloss.Color("232")).
+			Background(h.theme.Colors.Info)
+	}
+	if h.readOnly {
+		barStyle = barStyle.
+			Foreground(lipgloss.Color("232")).
+			Background(h.theme.Colors.Warning)
+		title = "AWS Terminal UI  ·  READ-ONLY (:ro to toggle)"
+		if h.needsElevation {
+			title = "AWS Terminal UI  ·  READ-ONLY (:elevate to make changes)"
+		}
+	}
+	if h.elevated > 0 {
+		barStyle = barStyle.
+			Foreground(lipgloss.Color("232")).
+			Background(h.theme.Colors.Error)
+		left := h.elevated.Round(time.Second)
+		title = fmt.Sprintf("AWS Terminal UI  ·  ELEVATED %d:%02d left (:ro to end)", int(left.Minutes()), int(left.Seconds())%60)
+	}
+	if h.role != "" {
+		title += "  ·  ROLE " + h.role
+		if h.policy != "" {
+			title += "  ·  SCOPED: " + h.policy
+		} else {
+			title += " (unscoped)"
+		}
+	}
+	if h.pending != "" {
+		title += "  ·  SWITCHING TO " + h.pending + " (ctrl+x to cancel)"
+	}
+	titleBar := barStyle.Render(title)
 
 	// Combine all parts
 	return lipgloss.JoinVertical(
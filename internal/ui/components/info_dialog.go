@@ -49,6 +49,15 @@ func (d *InfoDialog) Show(title string, data interface{}) {
 	d.lines = strings.Split(d.content, "\n")
 }
 
+// ShowText displays the dialog with text as it is, rather than as JSON
+func (d *InfoDialog) ShowText(title, text string) {
+	d.title = title
+	d.visible = true
+	d.scroll = 0
+	d.content = text
+	d.lines = strings.Split(text, "\n")
+}
+
 // Hide closes the dialog
 func (d *InfoDialog) Hide() {
 	d.visible = false
@@ -63,6 +72,11 @@ func (d *InfoDialog) IsVisible() bool {
 	return d.visible
 }
 
+// SetTheme sets the theme the dialog renders with
+func (d *InfoDialog) SetTheme(theme styles.Theme) {
+	d.theme = theme
+}
+
 // SetSize sets the dialog dimensions
 func (d *InfoDialog) SetSize(width, height int) {
 	d.width = width
@@ -171,9 +185,7 @@ func (d *InfoDialog) View() string {
 	for i := startLine; i < endLine; i++ {
 		line := d.lines[i]
 		// Truncate long lines
-		if len(line) > dialogWidth-6 {
-			line = line[:dialogWidth-6] + "..."
-		}
+		line = styles.Truncate(line, dialogWidth-3)
 		visibleLines = append(visibleLines, line)
 	}
 
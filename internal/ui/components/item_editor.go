@@ -0,0 +1,422 @@
+package components
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	ddbadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/dynamodb"
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// ItemEditorSavedMsg is sent when the item is saved with ctrl+s and its values are valid
+type ItemEditorSavedMsg struct {
+	ItemID string
+	Item   map[string]types.AttributeValue
+}
+
+// ItemEditorClosedMsg is sent when the editor is cancelled
+type ItemEditorClosedMsg struct{}
+
+// itemField is an attribute of the item as a row of the editor
+type itemField struct {
+	name  textinput.Model
+	typ   string
+	value textinput.Model
+	key   bool // Part of the table's key, so its name and type are fixed
+}
+
+// ItemEditor edits a DynamoDB item attribute by attribute, each with its type, or as raw
+// DynamoDB JSON, so numbers, binary values, sets, maps and lists keep their types
+type ItemEditor struct {
+	theme  styles.Theme
+	active bool
+	width  int
+	height int
+
+	itemID string
+	title  string
+	keys   []handlers.ItemKeyAttribute
+
+	fields []itemField
+	focus  int // Index into the name and value inputs, two per field
+	offset int // First field shown
+
+	raw      bool // Editing the DynamoDB JSON
+	textarea textarea.Model
+
+	err    string
+	saving bool
+}
+
+// NewItemEditor creates a new item editor
+func NewItemEditor(theme styles.Theme) *ItemEditor {
+	ta := textarea.New()
+	ta.CharLimit = 0
+	ta.ShowLineNumbers = false
+	ta.KeyMap.Paste.SetEnabled(false)
+	return &ItemEditor{theme: theme, textarea: ta}
+}
+
+// Show opens the editor on an item, its key attributes first
+func (e *ItemEditor) Show(itemID, title string, item *handlers.EditableItem) {
+	e.itemID = itemID
+	e.title = title
+	e.keys = item.Keys
+	e.raw = false
+	e.err = ""
+	e.saving = false
+	e.setFields(item.Attributes)
+	e.active = true
+}
+
+// Hide closes the editor
+func (e *ItemEditor) Hide() {
+	e.active = false
+	e.fields = nil
+}
+
+// IsActive returns whether the editor is open
+func (e *ItemEditor) IsActive() bool {
+	return e.active
+}
+
+// SetError shows why saving failed, keeping the edits
+func (e *ItemEditor) SetError(err error) {
+	e.err = err.Error()
+	e.saving = false
+}
+
+// SetTheme sets the theme the editor renders with
+func (e *ItemEditor) SetTheme(theme styles.Theme) {
+	e.theme = theme
+}
+
+// SetSize sets the editor dimensions
+func (e *ItemEditor) SetSize(width, height int) {
+	e.width = width
+	e.height = height
+	e.textarea.SetWidth(width - 8)
+	e.textarea.SetHeight(max(height-12, 3))
+	for i := range e.fields {
+		e.sizeField(&e.fields[i])
+	}
+}
+
+// setFields lays the attributes out as rows, the key attributes first in key order and
+// the others by name
+func (e *ItemEditor) setFields(attributes map[string]types.AttributeValue) {
+	e.fields = nil
+	for _, key := range e.keys {
+		typ, text := key.Type, ""
+		if av, ok := attributes[key.Name]; ok {
+			typ, text = ddbadapter.AttributeText(av)
+		}
+		field := e.newField(key.Name, typ, text)
+		field.key = true
+		e.fields = append(e.fields, field)
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		if !slices.ContainsFunc(e.keys, func(k handlers.ItemKeyAttribute) bool { return k.Name == name }) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		typ, text := ddbadapter.AttributeText(attributes[name])
+		e.fields = append(e.fields, e.newField(name, typ, text))
+	}
+
+	e.offset = 0
+	e.setFocus(1)
+}
+
+func (e *ItemEditor) newField(name, typ, value string) itemField {
+	nameInput := textinput.New()
+	nameInput.Placeholder = "attribute"
+	nameInput.Prompt = ""
+	nameInput.SetValue(name)
+
+	valueInput := textinput.New()
+	valueInput.Placeholder = "value"
+	valueInput.Prompt = ""
+	valueInput.SetValue(value)
+
+	field := itemField{name: nameInput, typ: typ, value: valueInput}
+	e.sizeField(&field)
+	return field
+}
+
+func (e *ItemEditor) sizeField(field *itemField) {
+	nameWidth := min(e.width/4, 30)
+	field.name.Width = nameWidth
+	field.value.Width = max(e.width-nameWidth-24, 10)
+}
+
+// visibleFields returns how many rows fit in the editor
+func (e *ItemEditor) visibleFields() int {
+	return max(e.height-12, 1)
+}
+
+// setFocus focuses a name or value input, keeping its row in view. The names of key
+// attributes are skipped, as they can't be edited.
+func (e *ItemEditor) setFocus(focus int) {
+	if len(e.fields) == 0 {
+		e.focus = 0
+		return
+	}
+	prev := e.focus
+	e.focus = min(max(focus, 0), 2*len(e.fields)-1)
+	if e.focus%2 == 0 && e.fields[e.focus/2].key {
+		if e.focus < prev && e.focus > 0 {
+			e.focus--
+		} else {
+			e.focus++
+		}
+	}
+
+	for i := range e.fields {
+		e.fields[i].name.Blur()
+		e.fields[i].value.Blur()
+	}
+	field := &e.fields[e.focus/2]
+	if e.focus%2 == 0 {
+		field.name.Focus()
+	} else {
+		field.value.Focus()
+	}
+
+	row := e.focus / 2
+	if row < e.offset {
+		e.offset = row
+	}
+	if row >= e.offset+e.visibleFields() {
+		e.offset = row - e.visibleFields() + 1
+	}
+}
+
+// item builds the item from the rows, or the raw JSON, checking every value is valid
+// for its type. Rows without a name or a value are dropped.
+func (e *ItemEditor) item() (map[string]types.AttributeValue, error) {
+	if e.raw {
+		return ddbadapter.UnmarshalItemJSON([]byte(e.textarea.Value()))
+	}
+
+	item := make(map[string]types.AttributeValue, len(e.fields))
+	for i, field := range e.fields {
+		name, value := strings.TrimSpace(field.name.Value()), field.value.Value()
+		if name == "" && value == "" && !field.key {
+			continue
+		}
+		if name == "" {
+			return nil, fmt.Errorf("attribute %d has no name", i+1)
+		}
+		if _, ok := item[name]; ok {
+			return nil, fmt.Errorf("attribute %s is there twice", name)
+		}
+		av, err := ddbadapter.ParseAttribute(name, field.typ, value)
+		if err != nil {
+			return nil, err
+		}
+		item[name] = av
+	}
+	return item, nil
+}
+
+// toggleRaw switches between the rows and the DynamoDB JSON, carrying the item over
+func (e *ItemEditor) toggleRaw() {
+	item, err := e.item()
+	if err != nil {
+		e.err = err.Error()
+		return
+	}
+	e.err = ""
+
+	if e.raw {
+		e.raw = false
+		e.textarea.Blur()
+		e.setFields(item)
+		return
+	}
+	document, err := ddbadapter.MarshalItemJSON(item, true)
+	if err != nil {
+		e.err = err.Error()
+		return
+	}
+	e.raw = true
+	e.textarea.SetValue(string(document))
+	e.textarea.Focus()
+}
+
+// Update handles messages
+func (e *ItemEditor) Update(msg tea.Msg) (*ItemEditor, tea.Cmd) {
+	if !e.active {
+		return e, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return e, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		e.Hide()
+		return e, func() tea.Msg { return ItemEditorClosedMsg{} }
+
+	case "ctrl+s":
+		if e.saving {
+			return e, nil
+		}
+		item, err := e.item()
+		if err != nil {
+			e.err = err.Error()
+			return e, nil
+		}
+		e.err = ""
+		e.saving = true
+		itemID := e.itemID
+		return e, func() tea.Msg { return ItemEditorSavedMsg{ItemID: itemID, Item: item} }
+
+	case "ctrl+t":
+		e.toggleRaw()
+		return e, nil
+	}
+
+	if e.raw {
+		var cmd tea.Cmd
+		e.textarea, cmd = e.textarea.Update(msg)
+		e.err = ""
+		return e, cmd
+	}
+
+	switch keyMsg.String() {
+	case "tab", "down":
+		e.setFocus(e.focus + 1)
+		return e, nil
+	case "shift+tab", "up":
+		e.setFocus(e.focus - 1)
+		return e, nil
+	case "ctrl+n":
+		e.fields = append(e.fields, e.newField("", "S", ""))
+		e.setFocus(2 * (len(e.fields) - 1))
+		return e, nil
+	}
+
+	if len(e.fields) == 0 {
+		return e, nil
+	}
+	field := &e.fields[e.focus/2]
+
+	switch keyMsg.String() {
+	case "ctrl+d":
+		if field.key {
+			e.err = "key attributes can't be removed"
+			return e, nil
+		}
+		i := e.focus / 2
+		e.fields = append(e.fields[:i], e.fields[i+1:]...)
+		e.setFocus(e.focus)
+		return e, nil
+	case "ctrl+o":
+		if field.key {
+			e.err = "the table's key schema fixes the type of key attributes"
+			return e, nil
+		}
+		i := slices.Index(ddbadapter.AttributeTypes, field.typ)
+		field.typ = ddbadapter.AttributeTypes[(i+1)%len(ddbadapter.AttributeTypes)]
+		e.err = ""
+		return e, nil
+	}
+
+	e.err = ""
+	var cmd tea.Cmd
+	if e.focus%2 == 0 {
+		field.name, cmd = field.name.Update(msg)
+	} else {
+		field.value, cmd = field.value.Update(msg)
+	}
+	return e, cmd
+}
+
+// View renders the editor
+func (e *ItemEditor) View() string {
+	if !e.active {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(e.theme.Colors.Primary)
+	mutedStyle := lipgloss.NewStyle().Foreground(e.theme.Colors.Muted)
+	typeStyle := lipgloss.NewStyle().Bold(true).Foreground(e.theme.Colors.Accent)
+	keyStyle := lipgloss.NewStyle().Foreground(e.theme.Colors.Foreground)
+	errorStyle := lipgloss.NewStyle().Foreground(e.theme.Colors.Error)
+
+	var keyNames []string
+	for _, key := range e.keys {
+		keyNames = append(keyNames, fmt.Sprintf("%s (%s, %s)", key.Name, key.Type, strings.ToLower(key.KeyType)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(e.title))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render("Key: " + strings.Join(keyNames, ", ")))
+	sb.WriteString("\n\n")
+
+	if e.raw {
+		sb.WriteString(e.textarea.View())
+	} else {
+		end := min(e.offset+e.visibleFields(), len(e.fields))
+		for i := e.offset; i < end; i++ {
+			field := e.fields[i]
+			cursor := "  "
+			if i == e.focus/2 {
+				cursor = "▸ "
+			}
+			name := field.name.View()
+			if field.key {
+				name = keyStyle.Bold(true).Width(field.name.Width + 1).Render(field.name.Value())
+			}
+			sb.WriteString(fmt.Sprintf("%s%s %s %s", cursor, name, typeStyle.Render(fmt.Sprintf("%-4s", field.typ)), field.value.View()))
+			sb.WriteString("\n")
+		}
+		if len(e.fields) == 0 {
+			sb.WriteString(mutedStyle.Render("No attributes, press ctrl+n to add one"))
+			sb.WriteString("\n")
+		}
+		if len(e.fields) > end-e.offset {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  %d-%d of %d attributes", e.offset+1, end, len(e.fields))))
+			sb.WriteString("\n")
+		}
+	}
+
+	if e.err != "" {
+		sb.WriteString("\n")
+		sb.WriteString(errorStyle.Render(e.err))
+	}
+
+	help := "tab: next | ctrl+o: type | ctrl+n: add | ctrl+d: remove | ctrl+t: DynamoDB JSON | ctrl+s: save | esc: cancel"
+	if e.raw {
+		help = "ctrl+t: attributes | ctrl+s: save | esc: cancel"
+	}
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(help))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(e.theme.Colors.Primary).
+		Padding(0, 1).
+		Width(e.width - 2).
+		Height(e.height - 2).
+		Render(sb.String())
+}
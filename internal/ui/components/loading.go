@@ -0,0 +1,155 @@
+package components
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Pane names used to route loading ticks to the right indicator
+const (
+	PaneTable   = "table"
+	PaneDetail  = "detail"
+	PaneMetrics = "metrics"
+	PaneFooter  = "footer"
+)
+
+// spinnerFrames are the animation frames of the loading spinner
+var spinnerFrames = []rune("⣾⣽⣻⢿⡿⣟⣯⣷")
+
+// loadingTickInterval is how often loading animations advance
+const loadingTickInterval = 100 * time.Millisecond
+
+// LoadingTickMsg advances the animation of a single pane's loading indicator
+type LoadingTickMsg struct {
+	Pane string
+	ID   int
+}
+
+// LoadingIndicator is an animated loading state owned by one pane, so several
+// panes can load independently without sharing a global loading flag
+type LoadingIndicator struct {
+	pane   string
+	label  string
+	active bool
+	frame  int
+	id     int // Incremented on each Start so ticks from older loads are dropped
+}
+
+// NewLoadingIndicator creates a loading indicator for the given pane
+func NewLoadingIndicator(pane string) *LoadingIndicator {
+	return &LoadingIndicator{pane: pane}
+}
+
+// Start activates the indicator and returns the command that animates it
+func (l *LoadingIndicator) Start(label string) tea.Cmd {
+	l.label = label
+	l.active = true
+	l.frame = 0
+	l.id++
+	return l.tick()
+}
+
+// Stop deactivates the indicator
+func (l *LoadingIndicator) Stop() {
+	l.active = false
+}
+
+// IsActive returns whether the indicator is animating
+func (l *LoadingIndicator) IsActive() bool {
+	return l.active
+}
+
+// Update advances the animation on ticks addressed to this indicator
+func (l *LoadingIndicator) Update(msg tea.Msg) tea.Cmd {
+	tick, ok := msg.(LoadingTickMsg)
+	if !ok || !l.active || tick.Pane != l.pane || tick.ID != l.id {
+		return nil
+	}
+	l.frame++
+	return l.tick()
+}
+
+func (l *LoadingIndicator) tick() tea.Cmd {
+	pane, id := l.pane, l.id
+	return tea.Tick(loadingTickInterval, func(time.Time) tea.Msg {
+		return LoadingTickMsg{Pane: pane, ID: id}
+	})
+}
+
+// Spinner returns the current spinner frame
+func (l *LoadingIndicator) Spinner() string {
+	return string(spinnerFrames[l.frame%len(spinnerFrames)])
+}
+
+// View renders a centered spinner with the loading label
+func (l *LoadingIndicator) View(width, height int) string {
+	style := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("214")).
+		Bold(true)
+
+	label := l.label
+	if label == "" {
+		label = "Loading..."
+	}
+
+	return lipgloss.Place(
+		width,
+		height,
+		lipgloss.Center,
+		lipgloss.Center,
+		style.Render(l.Spinner()+" "+label),
+	)
+}
+
+// Shimmer renders placeholder bars with a highlight sweeping across them,
+// used by panes whose layout is known before their data arrives
+func (l *LoadingIndicator) Shimmer(width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	baseStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("236"))
+	glowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	// The highlight band moves two columns per frame and wraps around
+	band := width / 6
+	if band < 4 {
+		band = 4
+	}
+	pos := (l.frame * 2) % (width + band)
+
+	var lines []string
+	for row := 0; row < height; row++ {
+		// Vary bar lengths so the placeholder looks like rows of data
+		barWidth := width - (row*7)%(width/3+1)
+		if row%2 == 1 {
+			lines = append(lines, "")
+			continue
+		}
+
+		// Offset the band per row to give the sweep a slant
+		end := clampInt(pos-row, 0, barWidth)
+		start := clampInt(end-band, 0, barWidth)
+
+		lines = append(lines,
+			baseStyle.Render(strings.Repeat("█", start))+
+				glowStyle.Render(strings.Repeat("█", end-start))+
+				baseStyle.Render(strings.Repeat("█", barWidth-end)),
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
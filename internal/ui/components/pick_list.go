@@ -0,0 +1,301 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// pickItem is an entry of a pickList. Items of a group are shown under a header with the
+// group's name; index refers back to the slice the items were built from.
+type pickItem struct {
+	title       string
+	description string
+	value       string
+	group       string
+	index       int
+
+	key []rune // Lowercased title, description and group, matched against the query
+}
+
+// pickMatch is an item matching the query, with its score
+type pickMatch struct {
+	item  int
+	score int
+}
+
+// pickRow is a line of the list, a group header or a match
+type pickRow struct {
+	header string
+	match  int // Index into matches, -1 for a header
+}
+
+// pickListStyles are the styles a pickList renders with
+type pickListStyles struct {
+	selected lipgloss.Style
+	normal   lipgloss.Style
+	muted    lipgloss.Style
+	header   lipgloss.Style
+}
+
+// pickList is a fuzzy-filtered list for selectors with hundreds or thousands of
+// entries. Typing a longer query only re-matches the entries the previous one matched,
+// and only the visible lines are rendered.
+type pickList struct {
+	items   []pickItem
+	matches []pickMatch
+	rows    []pickRow
+	rowOf   []int // Row of each match
+	grouped bool
+
+	query  string
+	cursor int // Index into matches
+	offset int // First visible row
+	height int // Visible rows
+}
+
+// setItems replaces the entries, keeping the order within each group. Groups are shown
+// in the order they first appear.
+func (l *pickList) setItems(items []pickItem) {
+	order := make(map[string]int)
+	l.grouped = false
+	for i := range items {
+		item := &items[i]
+		item.key = []rune(strings.ToLower(item.title + " " + item.description + " " + item.group))
+		if _, ok := order[item.group]; !ok {
+			order[item.group] = len(order)
+		}
+		if item.group != "" {
+			l.grouped = true
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return order[items[i].group] < order[items[j].group]
+	})
+
+	l.items = items
+	l.matches = nil
+	l.query = ""
+	l.refilter(nil)
+}
+
+// setQuery filters the entries by a fuzzy match of the query
+func (l *pickList) setQuery(query string) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == l.query {
+		return
+	}
+
+	// A query extending the previous one can only match a subset of its matches
+	var candidates []pickMatch
+	if l.query != "" && strings.HasPrefix(query, l.query) {
+		candidates = l.matches
+	}
+	l.query = query
+	l.refilter(candidates)
+}
+
+// refilter matches the query against the candidates, or every item when candidates is nil
+func (l *pickList) refilter(candidates []pickMatch) {
+	query := []rune(l.query)
+	if candidates == nil {
+		candidates = make([]pickMatch, len(l.items))
+		for i := range l.items {
+			candidates[i] = pickMatch{item: i}
+		}
+	}
+	matches := make([]pickMatch, 0, len(candidates))
+	for _, candidate := range candidates {
+		if score, ok := fuzzyScore(l.items[candidate.item].key, query); ok {
+			matches = append(matches, pickMatch{item: candidate.item, score: score})
+		}
+	}
+
+	// Best matches first, groups ordered by their best match
+	if len(query) > 0 {
+		best := make(map[string]int)
+		for _, m := range matches {
+			group := l.items[m.item].group
+			if score, ok := best[group]; !ok || m.score > score {
+				best[group] = m.score
+			}
+		}
+		sort.SliceStable(matches, func(i, j int) bool {
+			gi, gj := l.items[matches[i].item].group, l.items[matches[j].item].group
+			if gi != gj {
+				if best[gi] != best[gj] {
+					return best[gi] > best[gj]
+				}
+				return gi < gj
+			}
+			return matches[i].score > matches[j].score
+		})
+	}
+	l.matches = matches
+
+	// Lay out the rows, headers included, without rendering them
+	l.rows = l.rows[:0]
+	l.rowOf = make([]int, len(matches))
+	group := "\x00"
+	for i, m := range matches {
+		if item := l.items[m.item]; l.grouped && item.group != group {
+			group = item.group
+			header := group
+			if header == "" {
+				header = "Other"
+			}
+			l.rows = append(l.rows, pickRow{header: header, match: -1})
+		}
+		l.rowOf[i] = len(l.rows)
+		l.rows = append(l.rows, pickRow{match: i})
+	}
+
+	// The best match is selected as the query changes
+	l.cursor = 0
+	l.offset = 0
+	l.scroll()
+}
+
+// fuzzyScore reports whether the runes of query appear in order in key, scoring runes
+// that follow the previous match or start a word higher
+func fuzzyScore(key, query []rune) (int, bool) {
+	return fuzzyMatch(key, query, nil)
+}
+
+// fuzzyMatch is fuzzyScore that also records the index in key of each matched rune of
+// query in positions, when it isn't nil
+func fuzzyMatch(key, query []rune, positions []int) (int, bool) {
+	if len(query) == 0 {
+		return 0, true
+	}
+
+	score, q, prev := 0, 0, -2
+	for i := 0; i < len(key) && q < len(query); i++ {
+		if key[i] != query[q] {
+			continue
+		}
+		score++
+		if i == prev+1 {
+			score += 3
+		}
+		if i == 0 || !unicode.IsLetter(key[i-1]) && !unicode.IsDigit(key[i-1]) {
+			score += 2
+		}
+		if positions != nil {
+			positions[q] = i
+		}
+		prev = i
+		q++
+	}
+	if q < len(query) {
+		return 0, false
+	}
+	return score, true
+}
+
+// setHeight sets how many rows are visible
+func (l *pickList) setHeight(height int) {
+	l.height = max(height, 1)
+	l.scroll()
+}
+
+// selectedItem returns the item under the cursor, nil when nothing matches
+func (l *pickList) selectedItem() *pickItem {
+	if l.cursor >= len(l.matches) {
+		return nil
+	}
+	return &l.items[l.matches[l.cursor].item]
+}
+
+// selectValue moves the cursor to the match with a value
+func (l *pickList) selectValue(value string) {
+	for i, m := range l.matches {
+		if l.items[m.item].value == value {
+			l.cursor = i
+			l.scroll()
+			return
+		}
+	}
+}
+
+// move moves the cursor by delta matches, stopping at either end
+func (l *pickList) move(delta int) {
+	if len(l.matches) == 0 {
+		return
+	}
+	l.cursor = min(max(l.cursor+delta, 0), len(l.matches)-1)
+	l.scroll()
+}
+
+// page returns how many matches a page down moves
+func (l *pickList) page() int {
+	return max(l.height-1, 1)
+}
+
+// scroll keeps the cursor visible, along with the header of its group
+func (l *pickList) scroll() {
+	if len(l.matches) == 0 {
+		l.offset = 0
+		return
+	}
+	row := l.rowOf[l.cursor]
+	top := row
+	if row > 0 && l.rows[row-1].match == -1 {
+		top = row - 1
+	}
+	if top < l.offset {
+		l.offset = top
+	}
+	if row >= l.offset+l.height {
+		l.offset = row - l.height + 1
+	}
+}
+
+// view renders the visible rows at most width wide
+func (l *pickList) view(width int, st pickListStyles) string {
+	width = max(width, 10)
+	if len(l.matches) == 0 {
+		if l.query != "" {
+			return st.muted.Render(fmt.Sprintf("  No matches for %q", l.query))
+		}
+		return st.muted.Render("  (empty)")
+	}
+
+	end := min(l.offset+l.height, len(l.rows))
+	lines := make([]string, 0, end-l.offset)
+	for _, row := range l.rows[l.offset:end] {
+		if row.match == -1 {
+			lines = append(lines, st.header.Render(styles.Truncate(row.header, width)))
+			continue
+		}
+
+		item := l.items[l.matches[row.match].item]
+		prefix, style := "  ", st.normal
+		if row.match == l.cursor {
+			prefix, style = "> ", st.selected
+		}
+		title := styles.Truncate(item.title, width-2)
+		line := style.Render(prefix + title)
+		if room := width - 2 - lipgloss.Width(title) - 2; item.description != "" && room > 3 {
+			line += "  " + st.muted.Render(styles.Truncate(item.description, room))
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// status describes the position in the matches, e.g. 12/340
+func (l *pickList) status() string {
+	if len(l.matches) == 0 {
+		return fmt.Sprintf("0/%d", len(l.items))
+	}
+	if len(l.matches) == len(l.items) {
+		return fmt.Sprintf("%d/%d", l.cursor+1, len(l.items))
+	}
+	return fmt.Sprintf("%d/%d of %d", l.cursor+1, len(l.matches), len(l.items))
+}
@@ -0,0 +1,290 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// PolicyPickerConfirmedMsg is sent when the picker is confirmed with pending changes
+type PolicyPickerConfirmedMsg struct {
+	Change *handlers.PolicyAttachmentChange
+}
+
+// PolicyPickerClosedMsg is sent when the picker is cancelled
+type PolicyPickerClosedMsg struct{}
+
+// PolicyPicker is a searchable multi-select list of managed policies
+type PolicyPicker struct {
+	theme  styles.Theme
+	active bool
+	width  int
+	height int
+
+	principalType string
+	principalName string
+
+	policies []handlers.ManagedPolicyRef
+	filtered []handlers.ManagedPolicyRef
+	attached map[string]bool // ARN -> attached before any change
+	selected map[string]bool // ARN -> attached after the change
+
+	input      textinput.Model
+	cursor     int
+	offset     int
+	maxVisible int
+}
+
+// NewPolicyPicker creates a new policy picker
+func NewPolicyPicker(theme styles.Theme) *PolicyPicker {
+	ti := textinput.New()
+	ti.Placeholder = "type to search policies..."
+	ti.Prompt = "/ "
+	ti.CharLimit = 128
+
+	return &PolicyPicker{
+		theme:      theme,
+		input:      ti,
+		maxVisible: 15,
+	}
+}
+
+// Show opens the picker for a principal with the available and currently attached policies
+func (p *PolicyPicker) Show(principalType, principalName string, policies, attached []handlers.ManagedPolicyRef) tea.Cmd {
+	p.principalType = principalType
+	p.principalName = principalName
+	p.attached = make(map[string]bool, len(attached))
+	p.selected = make(map[string]bool, len(attached))
+
+	// Attached policies go first, and are included even if the listing missed them
+	p.policies = nil
+	for _, policy := range attached {
+		p.attached[policy.ARN] = true
+		p.selected[policy.ARN] = true
+		p.policies = append(p.policies, policy)
+	}
+	for _, policy := range policies {
+		if !p.attached[policy.ARN] {
+			p.policies = append(p.policies, policy)
+		}
+	}
+
+	p.input.SetValue("")
+	p.applyFilter()
+	p.active = true
+	return p.input.Focus()
+}
+
+// Hide closes the picker
+func (p *PolicyPicker) Hide() {
+	p.active = false
+	p.input.Blur()
+}
+
+// IsActive returns whether the picker is open
+func (p *PolicyPicker) IsActive() bool {
+	return p.active
+}
+
+// SetTheme sets the theme the picker renders with
+func (p *PolicyPicker) SetTheme(theme styles.Theme) {
+	p.theme = theme
+}
+
+// SetSize sets the picker dimensions
+func (p *PolicyPicker) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+	p.maxVisible = height - 14
+	if p.maxVisible < 5 {
+		p.maxVisible = 5
+	}
+}
+
+func (p *PolicyPicker) applyFilter() {
+	query := strings.ToLower(strings.TrimSpace(p.input.Value()))
+	p.filtered = p.filtered[:0]
+	for _, policy := range p.policies {
+		if query == "" || strings.Contains(strings.ToLower(policy.Name), query) {
+			p.filtered = append(p.filtered, policy)
+		}
+	}
+	p.cursor = 0
+	p.offset = 0
+}
+
+// change builds the attachment change from the current selection
+func (p *PolicyPicker) change() *handlers.PolicyAttachmentChange {
+	change := &handlers.PolicyAttachmentChange{
+		PrincipalType: p.principalType,
+		PrincipalName: p.principalName,
+	}
+	for _, policy := range p.policies {
+		switch {
+		case p.attached[policy.ARN]:
+			change.Current = append(change.Current, policy)
+			if !p.selected[policy.ARN] {
+				change.Detach = append(change.Detach, policy)
+			}
+		case p.selected[policy.ARN]:
+			change.Attach = append(change.Attach, policy)
+		}
+	}
+	return change
+}
+
+// Update handles messages
+func (p *PolicyPicker) Update(msg tea.Msg) (*PolicyPicker, tea.Cmd) {
+	if !p.active {
+		return p, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return p, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		p.Hide()
+		return p, func() tea.Msg { return PolicyPickerClosedMsg{} }
+
+	case "enter":
+		change := p.change()
+		p.Hide()
+		if change.IsEmpty() {
+			return p, func() tea.Msg { return PolicyPickerClosedMsg{} }
+		}
+		return p, func() tea.Msg { return PolicyPickerConfirmedMsg{Change: change} }
+
+	case "up", "ctrl+p":
+		if p.cursor > 0 {
+			p.cursor--
+			if p.cursor < p.offset {
+				p.offset = p.cursor
+			}
+		}
+		return p, nil
+
+	case "down", "ctrl+n":
+		if p.cursor < len(p.filtered)-1 {
+			p.cursor++
+			if p.cursor >= p.offset+p.maxVisible {
+				p.offset = p.cursor - p.maxVisible + 1
+			}
+		}
+		return p, nil
+
+	case " ", "tab":
+		// Policy names never contain spaces, so space toggles instead of typing
+		if p.cursor < len(p.filtered) {
+			arn := p.filtered[p.cursor].ARN
+			p.selected[arn] = !p.selected[arn]
+		}
+		return p, nil
+	}
+
+	var cmd tea.Cmd
+	prev := p.input.Value()
+	p.input, cmd = p.input.Update(msg)
+	if p.input.Value() != prev {
+		p.applyFilter()
+	}
+	return p, cmd
+}
+
+// View renders the picker
+func (p *PolicyPicker) View() string {
+	if !p.active {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(p.theme.Colors.Primary)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Foreground).
+		Background(p.theme.Colors.Secondary).
+		Bold(true)
+	normalStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Foreground)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Muted)
+	addStyle := lipgloss.NewStyle().Foreground(p.theme.Colors.Success)
+	removeStyle := lipgloss.NewStyle().Foreground(p.theme.Colors.Error)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Managed policies for %s %s", p.principalType, p.principalName)))
+	sb.WriteString("\n\n")
+	sb.WriteString(p.input.View())
+	sb.WriteString("\n\n")
+
+	if len(p.filtered) == 0 {
+		sb.WriteString(mutedStyle.Render("No matching policies"))
+		sb.WriteString("\n")
+	}
+
+	end := p.offset + p.maxVisible
+	if end > len(p.filtered) {
+		end = len(p.filtered)
+	}
+
+	for i := p.offset; i < end; i++ {
+		policy := p.filtered[i]
+
+		check := "[ ]"
+		if p.selected[policy.ARN] {
+			check = "[x]"
+		}
+
+		scope := "customer"
+		if policy.AWS {
+			scope = "aws"
+		}
+
+		// Mark pending changes against what is attached now
+		marker := " "
+		switch {
+		case p.selected[policy.ARN] && !p.attached[policy.ARN]:
+			marker = addStyle.Render("+")
+		case !p.selected[policy.ARN] && p.attached[policy.ARN]:
+			marker = removeStyle.Render("-")
+		}
+
+		line := fmt.Sprintf("%s %s %s %s", check, marker, styles.PadRight(policy.Name, 60), mutedStyle.Render(scope))
+		if i == p.cursor {
+			sb.WriteString(selectedStyle.Render(line))
+		} else {
+			sb.WriteString(normalStyle.Render(line))
+		}
+		sb.WriteString("\n")
+	}
+
+	change := p.change()
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf(
+		"%d/%d shown | %d to attach, %d to detach | space: toggle | enter: review | esc: cancel",
+		len(p.filtered), len(p.policies), len(change.Attach), len(change.Detach),
+	)))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(p.theme.Colors.Primary).
+		Padding(1, 2).
+		Width(p.width - 10).
+		Render(sb.String())
+
+	return lipgloss.Place(
+		p.width,
+		p.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
@@ -0,0 +1,253 @@
+package components
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// RestoreObjectConfirmedMsg is sent when the form is submitted with a valid restore
+type RestoreObjectConfirmedMsg struct {
+	Request *handlers.RestoreObjectRequest
+}
+
+// RestoreObjectClosedMsg is sent when the form is cancelled
+type RestoreObjectClosedMsg struct{}
+
+// RestoreObjectForm is a form for restoring an archived S3 object: the retrieval tier
+// and how many days the restored copy is kept
+type RestoreObjectForm struct {
+	theme  styles.Theme
+	active bool
+	width  int
+	height int
+
+	action *handlers.RestoreObjectAction
+	tiers  []string
+	tier   int
+	days   textinput.Model
+
+	// Field 0 is the tier, 1 the days
+	focusedField int
+	err          string
+}
+
+// NewRestoreObjectForm creates a new restore form
+func NewRestoreObjectForm(theme styles.Theme) *RestoreObjectForm {
+	return &RestoreObjectForm{theme: theme}
+}
+
+// Show opens the form for an archived object, with the Standard tier selected
+func (f *RestoreObjectForm) Show(action *handlers.RestoreObjectAction) tea.Cmd {
+	f.action = action
+	f.tiers = handlers.RestoreTiers(action.StorageClass)
+	f.tier = 0
+	for i, tier := range f.tiers {
+		if tier == "Standard" {
+			f.tier = i
+		}
+	}
+
+	f.days = textinput.New()
+	f.days.CharLimit = 5
+	f.days.Width = 8
+	f.days.Prompt = ""
+	f.days.SetValue(strconv.Itoa(handlers.DefaultRestoreDays))
+
+	f.focusedField = 0
+	f.err = ""
+	f.active = true
+	return nil
+}
+
+// Hide closes the form
+func (f *RestoreObjectForm) Hide() {
+	f.active = false
+}
+
+// IsActive returns whether the form is open
+func (f *RestoreObjectForm) IsActive() bool {
+	return f.active
+}
+
+// SetTheme sets the theme the form renders with
+func (f *RestoreObjectForm) SetTheme(theme styles.Theme) {
+	f.theme = theme
+}
+
+// SetSize sets the form dimensions
+func (f *RestoreObjectForm) SetSize(width, height int) {
+	f.width = width
+	f.height = height
+}
+
+func (f *RestoreObjectForm) focus(field int) tea.Cmd {
+	f.focusedField = (field + 2) % 2
+	if f.focusedField == 1 {
+		return f.days.Focus()
+	}
+	f.days.Blur()
+	return nil
+}
+
+// request builds the restore from the form, or returns a validation error
+func (f *RestoreObjectForm) request() (*handlers.RestoreObjectRequest, error) {
+	days, err := strconv.ParseInt(strings.TrimSpace(f.days.Value()), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("days must be a whole number")
+	}
+
+	req := &handlers.RestoreObjectRequest{
+		Bucket:       f.action.Bucket,
+		Key:          f.action.Key,
+		StorageClass: f.action.StorageClass,
+		Tier:         f.tiers[f.tier],
+		Days:         int32(days),
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// Update handles messages
+func (f *RestoreObjectForm) Update(msg tea.Msg) (*RestoreObjectForm, tea.Cmd) {
+	if !f.active {
+		return f, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return f, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		f.Hide()
+		return f, func() tea.Msg { return RestoreObjectClosedMsg{} }
+
+	case "enter":
+		req, err := f.request()
+		if err != nil {
+			f.err = err.Error()
+			return f, nil
+		}
+		f.Hide()
+		return f, func() tea.Msg { return RestoreObjectConfirmedMsg{Request: req} }
+
+	case "tab", "down":
+		return f, f.focus(f.focusedField + 1)
+
+	case "shift+tab", "up":
+		return f, f.focus(f.focusedField - 1)
+	}
+
+	if f.focusedField == 0 {
+		switch keyMsg.String() {
+		case "left", "h":
+			f.tier = (f.tier + len(f.tiers) - 1) % len(f.tiers)
+			f.err = ""
+		case "right", "l", " ":
+			f.tier = (f.tier + 1) % len(f.tiers)
+			f.err = ""
+		}
+		return f, nil
+	}
+
+	var cmd tea.Cmd
+	f.days, cmd = f.days.Update(msg)
+	f.err = ""
+	return f, cmd
+}
+
+// View renders the form
+func (f *RestoreObjectForm) View() string {
+	if !f.active {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(f.theme.Colors.Primary)
+	labelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(f.theme.Colors.Foreground)
+	focusedLabelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(f.theme.Colors.Accent)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(f.theme.Colors.Foreground).
+		Background(f.theme.Colors.Secondary).
+		Bold(true)
+	normalStyle := lipgloss.NewStyle().
+		Foreground(f.theme.Colors.Foreground)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(f.theme.Colors.Muted)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(f.theme.Colors.Error)
+
+	label := func(field int, text string) string {
+		if f.focusedField == field {
+			return focusedLabelStyle.Render("▸ " + text)
+		}
+		return labelStyle.Render("  " + text)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Restore s3://%s/%s", f.action.Bucket, f.action.Key)))
+	sb.WriteString("\n")
+	status := fmt.Sprintf("Storage class: %s", f.action.StorageClass)
+	if !f.action.RestoredUntil.IsZero() {
+		status += fmt.Sprintf(", restored until %s", f.action.RestoredUntil.Format("2006-01-02 15:04"))
+	}
+	sb.WriteString(mutedStyle.Render(status))
+	sb.WriteString("\n\n")
+
+	// Retrieval tier
+	tier := f.tiers[f.tier]
+	choice := fmt.Sprintf("◀ %s ▶", tier)
+	sb.WriteString(label(0, "Retrieval tier"))
+	if f.focusedField == 0 {
+		sb.WriteString("\n    " + selectedStyle.Render(choice))
+	} else {
+		sb.WriteString("\n    " + normalStyle.Render(choice))
+	}
+	sb.WriteString(mutedStyle.Render("  " + handlers.RestoreTime(f.action.StorageClass, tier)))
+	sb.WriteString("\n\n")
+
+	// Days
+	sb.WriteString(label(1, "Keep restored copy for (days)"))
+	sb.WriteString("\n    " + f.days.View())
+	sb.WriteString("\n")
+
+	if f.err != "" {
+		sb.WriteString("\n")
+		sb.WriteString(errorStyle.Render(f.err))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render("tab: next field | ←/→: tier | enter: review | esc: cancel"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(f.theme.Colors.Primary).
+		Padding(1, 2).
+		Width(f.width - 10).
+		Render(sb.String())
+
+	return lipgloss.Place(
+		f.width,
+		f.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
@@ -0,0 +1,430 @@
+package components
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// RestoreWizardConfirmedMsg is sent when the wizard is submitted with a valid request
+type RestoreWizardConfirmedMsg struct {
+	Request *handlers.RestoreSnapshotRequest
+}
+
+// RestoreWizardClosedMsg is sent when the wizard is cancelled
+type RestoreWizardClosedMsg struct{}
+
+const (
+	restoreFieldIdentifier = iota
+	restoreFieldClass
+	restoreFieldSubnetGroup
+	restoreFieldSecurityGroups
+	restoreFieldCount
+)
+
+// dbInstanceIDPattern matches valid RDS instance identifiers: a letter followed by letters,
+// digits and single hyphens, not ending in a hyphen
+var dbInstanceIDPattern = regexp.MustCompile(`^[a-zA-Z](-?[a-zA-Z0-9])*$`)
+
+// RestoreWizard is a form for restoring an RDS snapshot to a new instance
+type RestoreWizard struct {
+	theme  styles.Theme
+	active bool
+	width  int
+	height int
+
+	options *handlers.RestoreSnapshotOptions
+
+	identifier   textinput.Model
+	classIndex   int
+	subnetIndex  int
+	sgCursor     int
+	sgOffset     int
+	selectedSGs  map[string]bool
+	focusedField int
+	err          string
+}
+
+// NewRestoreWizard creates a new restore wizard
+func NewRestoreWizard(theme styles.Theme) *RestoreWizard {
+	ti := textinput.New()
+	ti.Placeholder = "new-db-identifier"
+	ti.CharLimit = 63
+	ti.Width = 50
+
+	return &RestoreWizard{
+		theme:      theme,
+		identifier: ti,
+	}
+}
+
+// Show opens the wizard with the options loaded for a snapshot
+func (w *RestoreWizard) Show(options *handlers.RestoreSnapshotOptions) tea.Cmd {
+	w.options = options
+	w.identifier.SetValue(options.DefaultIdentifier)
+	w.identifier.CursorEnd()
+
+	w.classIndex = indexOf(options.InstanceClasses, options.DefaultClass)
+	w.subnetIndex = 0
+	for i, group := range options.SubnetGroups {
+		if group.Name == options.DefaultSubnetGroup {
+			w.subnetIndex = i
+			break
+		}
+	}
+
+	w.selectedSGs = make(map[string]bool)
+	for _, id := range options.DefaultSecurityGroups {
+		w.selectedSGs[id] = true
+	}
+	w.sgCursor = 0
+	w.sgOffset = 0
+	w.err = ""
+
+	w.focusedField = restoreFieldIdentifier
+	w.active = true
+	return w.identifier.Focus()
+}
+
+// Hide closes the wizard
+func (w *RestoreWizard) Hide() {
+	w.active = false
+	w.identifier.Blur()
+}
+
+// IsActive returns whether the wizard is open
+func (w *RestoreWizard) IsActive() bool {
+	return w.active
+}
+
+// SetTheme sets the theme the wizard renders with
+func (w *RestoreWizard) SetTheme(theme styles.Theme) {
+	w.theme = theme
+}
+
+// SetSize sets the wizard dimensions
+func (w *RestoreWizard) SetSize(width, height int) {
+	w.width = width
+	w.height = height
+}
+
+// vpcID returns the VPC of the selected subnet group
+func (w *RestoreWizard) vpcID() string {
+	if w.subnetIndex < len(w.options.SubnetGroups) {
+		return w.options.SubnetGroups[w.subnetIndex].VpcID
+	}
+	return ""
+}
+
+// visibleSecurityGroups returns the security groups in the selected subnet group's VPC
+func (w *RestoreWizard) visibleSecurityGroups() []int {
+	vpc := w.vpcID()
+	var indexes []int
+	for i, sg := range w.options.SecurityGroups {
+		if vpc == "" || sg.VpcID == vpc {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func (w *RestoreWizard) maxVisibleSGs() int {
+	max := w.height - 24
+	if max < 3 {
+		max = 3
+	}
+	return max
+}
+
+// request builds the restore request from the form, or returns a validation error
+func (w *RestoreWizard) request() (*handlers.RestoreSnapshotRequest, error) {
+	id := strings.TrimSpace(w.identifier.Value())
+	if id == "" {
+		return nil, fmt.Errorf("identifier is required")
+	}
+	if !dbInstanceIDPattern.MatchString(id) {
+		return nil, fmt.Errorf("identifier must start with a letter and contain only letters, digits and single hyphens")
+	}
+
+	req := &handlers.RestoreSnapshotRequest{
+		SnapshotID:   w.options.SnapshotID,
+		DBInstanceID: id,
+	}
+
+	if w.classIndex < len(w.options.InstanceClasses) {
+		req.DBInstanceClass = w.options.InstanceClasses[w.classIndex]
+	}
+
+	if w.subnetIndex < len(w.options.SubnetGroups) {
+		req.SubnetGroup = w.options.SubnetGroups[w.subnetIndex].Name
+	}
+
+	// Only send groups in the chosen VPC, selections from another VPC would be rejected
+	for _, i := range w.visibleSecurityGroups() {
+		sg := w.options.SecurityGroups[i]
+		if w.selectedSGs[sg.GroupID] {
+			req.SecurityGroupIDs = append(req.SecurityGroupIDs, sg.GroupID)
+		}
+	}
+
+	return req, nil
+}
+
+func (w *RestoreWizard) focus(field int) tea.Cmd {
+	w.focusedField = (field + restoreFieldCount) % restoreFieldCount
+	if w.focusedField == restoreFieldIdentifier {
+		return w.identifier.Focus()
+	}
+	w.identifier.Blur()
+	return nil
+}
+
+// cycle moves a choice index forwards or backwards, wrapping around
+func cycle(index, delta, count int) int {
+	if count == 0 {
+		return index
+	}
+	return ((index+delta)%count + count) % count
+}
+
+// Update handles messages
+func (w *RestoreWizard) Update(msg tea.Msg) (*RestoreWizard, tea.Cmd) {
+	if !w.active {
+		return w, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return w, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		w.Hide()
+		return w, func() tea.Msg { return RestoreWizardClosedMsg{} }
+
+	case "enter":
+		req, err := w.request()
+		if err != nil {
+			w.err = err.Error()
+			return w, nil
+		}
+		w.Hide()
+		return w, func() tea.Msg { return RestoreWizardConfirmedMsg{Request: req} }
+
+	case "tab":
+		return w, w.focus(w.focusedField + 1)
+
+	case "shift+tab":
+		return w, w.focus(w.focusedField - 1)
+	}
+
+	switch w.focusedField {
+	case restoreFieldIdentifier:
+		var cmd tea.Cmd
+		w.identifier, cmd = w.identifier.Update(msg)
+		w.err = ""
+		return w, cmd
+
+	case restoreFieldClass:
+		switch keyMsg.String() {
+		case "left", "h":
+			w.classIndex = cycle(w.classIndex, -1, len(w.options.InstanceClasses))
+		case "right", "l":
+			w.classIndex = cycle(w.classIndex, 1, len(w.options.InstanceClasses))
+		}
+
+	case restoreFieldSubnetGroup:
+		switch keyMsg.String() {
+		case "left", "h":
+			w.subnetIndex = cycle(w.subnetIndex, -1, len(w.options.SubnetGroups))
+		case "right", "l":
+			w.subnetIndex = cycle(w.subnetIndex, 1, len(w.options.SubnetGroups))
+		}
+		w.sgCursor = 0
+		w.sgOffset = 0
+
+	case restoreFieldSecurityGroups:
+		visible := w.visibleSecurityGroups()
+		switch keyMsg.String() {
+		case "up", "k":
+			if w.sgCursor > 0 {
+				w.sgCursor--
+				if w.sgCursor < w.sgOffset {
+					w.sgOffset = w.sgCursor
+				}
+			}
+		case "down", "j":
+			if w.sgCursor < len(visible)-1 {
+				w.sgCursor++
+				if w.sgCursor >= w.sgOffset+w.maxVisibleSGs() {
+					w.sgOffset = w.sgCursor - w.maxVisibleSGs() + 1
+				}
+			}
+		case " ":
+			if w.sgCursor < len(visible) {
+				id := w.options.SecurityGroups[visible[w.sgCursor]].GroupID
+				w.selectedSGs[id] = !w.selectedSGs[id]
+			}
+		}
+	}
+
+	return w, nil
+}
+
+// View renders the wizard
+func (w *RestoreWizard) View() string {
+	if !w.active {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(w.theme.Colors.Primary)
+	labelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(w.theme.Colors.Foreground)
+	focusedLabelStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(w.theme.Colors.Accent)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(w.theme.Colors.Foreground).
+		Background(w.theme.Colors.Secondary).
+		Bold(true)
+	normalStyle := lipgloss.NewStyle().
+		Foreground(w.theme.Colors.Foreground)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(w.theme.Colors.Muted)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(w.theme.Colors.Error)
+
+	label := func(field int, text string) string {
+		if w.focusedField == field {
+			return focusedLabelStyle.Render("▸ " + text)
+		}
+		return labelStyle.Render("  " + text)
+	}
+
+	choice := func(field int, value string, index, count int) string {
+		if count == 0 {
+			return mutedStyle.Render("    (none available, AWS default will be used)")
+		}
+		text := fmt.Sprintf("◀ %s ▶", value)
+		counter := mutedStyle.Render(fmt.Sprintf("  %d/%d", index+1, count))
+		if w.focusedField == field {
+			return "    " + selectedStyle.Render(text) + counter
+		}
+		return "    " + normalStyle.Render(text) + counter
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Restore snapshot %s", w.options.SnapshotID)))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("Source instance: %s (%s)", w.options.SourceInstanceID, w.options.Engine)))
+	sb.WriteString("\n\n")
+
+	// Identifier
+	sb.WriteString(label(restoreFieldIdentifier, "New DB identifier"))
+	sb.WriteString("\n    ")
+	sb.WriteString(w.identifier.View())
+	sb.WriteString("\n\n")
+
+	// Instance class
+	class := ""
+	if w.classIndex < len(w.options.InstanceClasses) {
+		class = w.options.InstanceClasses[w.classIndex]
+	}
+	sb.WriteString(label(restoreFieldClass, "Instance class"))
+	sb.WriteString("\n")
+	sb.WriteString(choice(restoreFieldClass, class, w.classIndex, len(w.options.InstanceClasses)))
+	sb.WriteString("\n\n")
+
+	// Subnet group
+	subnet := ""
+	if w.subnetIndex < len(w.options.SubnetGroups) {
+		group := w.options.SubnetGroups[w.subnetIndex]
+		subnet = fmt.Sprintf("%s (%s)", group.Name, group.VpcID)
+	}
+	sb.WriteString(label(restoreFieldSubnetGroup, "Subnet group"))
+	sb.WriteString("\n")
+	sb.WriteString(choice(restoreFieldSubnetGroup, subnet, w.subnetIndex, len(w.options.SubnetGroups)))
+	sb.WriteString("\n\n")
+
+	// Security groups in the subnet group's VPC
+	visible := w.visibleSecurityGroups()
+	selected := 0
+	for _, i := range visible {
+		if w.selectedSGs[w.options.SecurityGroups[i].GroupID] {
+			selected++
+		}
+	}
+	sb.WriteString(label(restoreFieldSecurityGroups, fmt.Sprintf("Security groups (%d selected)", selected)))
+	sb.WriteString("\n")
+
+	if len(visible) == 0 {
+		sb.WriteString(mutedStyle.Render("    (none in this VPC, the default group will be used)"))
+		sb.WriteString("\n")
+	}
+
+	end := w.sgOffset + w.maxVisibleSGs()
+	if end > len(visible) {
+		end = len(visible)
+	}
+	for pos := w.sgOffset; pos < end; pos++ {
+		sg := w.options.SecurityGroups[visible[pos]]
+
+		check := "[ ]"
+		if w.selectedSGs[sg.GroupID] {
+			check = "[x]"
+		}
+
+		line := fmt.Sprintf("%s %-22s %s", check, sg.GroupID, sg.GroupName)
+		if w.focusedField == restoreFieldSecurityGroups && pos == w.sgCursor {
+			sb.WriteString("    " + selectedStyle.Render(line))
+		} else {
+			sb.WriteString("    " + normalStyle.Render(line))
+		}
+		sb.WriteString("\n")
+	}
+
+	if w.err != "" {
+		sb.WriteString("\n")
+		sb.WriteString(errorStyle.Render(w.err))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render("tab: next field | ←/→: change | space: toggle group | enter: review | esc: cancel"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(w.theme.Colors.Primary).
+		Padding(1, 2).
+		Width(w.width - 10).
+		Render(sb.String())
+
+	return lipgloss.Place(
+		w.width,
+		w.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
+
+// indexOf returns the index of value in values, or 0 if it is not present
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return 0
+}
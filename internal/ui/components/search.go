@@ -28,6 +28,9 @@ type Search struct {
 	total   int
 	width   int
 	theme   styles.Theme
+
+	// How the search matches on the server while it searches AWS, empty otherwise
+	serverSearch string
 }
 
 // NewSearch creates a new search component
@@ -44,12 +47,26 @@ func NewSearch(theme styles.Theme) *Search {
 	}
 }
 
+// SetTheme sets the theme the search bar renders with
+func (s *Search) SetTheme(theme styles.Theme) {
+	s.theme = theme
+}
+
 // SetWidth sets the search box width
 func (s *Search) SetWidth(width int) {
 	s.width = width
 	s.input.Width = width - 20
 }
 
+// SetFuzzy tells the user whether the search matches fuzzily or by substring
+func (s *Search) SetFuzzy(fuzzy bool) {
+	if fuzzy {
+		s.input.Placeholder = "Type to fuzzy search..."
+	} else {
+		s.input.Placeholder = "Type to search..."
+	}
+}
+
 // Activate activates the search
 func (s *Search) Activate() tea.Cmd {
 	s.active = true
@@ -75,11 +92,22 @@ func (s *Search) IsActive() bool {
 	return s.active
 }
 
+// SetValue sets the search value, as if typed
+func (s *Search) SetValue(value string) {
+	s.input.SetValue(value)
+}
+
 // Value returns the current search value
 func (s *Search) Value() string {
 	return s.input.Value()
 }
 
+// SetServerSearch shows that the search is running on the server, matching as hint
+// describes, until it is called with an empty hint
+func (s *Search) SetServerSearch(hint string) {
+	s.serverSearch = hint
+}
+
 // SetResults sets the result count
 func (s *Search) SetResults(results, total int) {
 	s.results = results
@@ -128,12 +156,12 @@ func (s *Search) View() string {
 
 	searchStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("39")).
+		BorderForeground(s.theme.Colors.Primary).
 		Padding(0, 1).
 		Background(lipgloss.Color("236"))
 
 	resultStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245"))
+		Foreground(s.theme.Colors.Muted)
 
 	input := s.input.View()
 
@@ -141,6 +169,9 @@ func (s *Search) View() string {
 	if s.total > 0 {
 		status = resultStyle.Render(fmt.Sprintf(" (%d/%d)", s.results, s.total))
 	}
+	if s.serverSearch != "" {
+		status += resultStyle.Render(fmt.Sprintf(" searching AWS by %s...", s.serverSearch))
+	}
 
 	return searchStyle.Render(input + status)
 }
@@ -90,6 +90,11 @@ func NewSecretCreator(theme styles.Theme) *SecretCreator {
 	}
 }
 
+// SetTheme sets the theme the form renders with
+func (s *SecretCreator) SetTheme(theme styles.Theme) {
+	s.theme = theme
+}
+
 func (s *SecretCreator) Activate() tea.Cmd {
 	s.focusedField = fieldName
 	s.nameInput.Focus()
@@ -1,21 +1,34 @@
 package components
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/aaw-tui/aws-tui/internal/ui/styles"
 )
 
-// SecretEditor provides a textarea-based editor for secrets
+// secretField is a key/value pair of a JSON object secret in the structured editor
+type secretField struct {
+	key   textinput.Model
+	value textinput.Model
+	json  bool // The value is a JSON literal such as a number, boolean or object, not a string
+}
+
+// SecretEditor provides a textarea-based editor for secrets. JSON object secrets can
+// also be edited field by field, with their values masked.
 type SecretEditor struct {
 	textarea     textarea.Model
 	secretID     string
 	secretName   string
+	title        string // Replaces the "Editing Secret" title for other JSON documents
 	secretValue  string
 	initialValue string
 	isJSON       bool
@@ -23,6 +36,13 @@ type SecretEditor struct {
 	width        int
 	height       int
 	theme        styles.Theme
+
+	// Structured mode
+	structured bool
+	fields     []secretField
+	focus      int // Index into the key and value inputs, two per field
+	offset     int // First field shown
+	err        string
 }
 
 // NewSecretEditor creates a new secret editor
@@ -41,13 +61,17 @@ func NewSecretEditor(theme styles.Theme) *SecretEditor {
 	}
 }
 
-// SetSecret sets the secret to edit
+// SetSecret sets the secret to edit. JSON objects open in structured mode.
 func (e *SecretEditor) SetSecret(id, name, value string) {
 	e.secretID = id
 	e.secretName = name
+	e.title = ""
 	e.secretValue = value
 	e.initialValue = value
 	e.modified = false
+	e.structured = false
+	e.fields = nil
+	e.err = ""
 
 	// Try to format as JSON if valid
 	var jsonData interface{}
@@ -59,10 +83,25 @@ func (e *SecretEditor) SetSecret(id, name, value string) {
 		e.isJSON = false
 		e.textarea.SetValue(value)
 	}
+
+	if fields, ok := parseSecretFields(value); ok {
+		e.setFields(fields)
+	}
+}
+
+// SetDocument opens a JSON document that isn't a secret, such as a bucket configuration,
+// under its own title
+func (e *SecretEditor) SetDocument(id, title, value string) {
+	e.SetSecret(id, title, value)
+	e.title = title
 }
 
 // Value returns the current value, validating JSON if needed
 func (e *SecretEditor) Value() (string, error) {
+	if e.structured {
+		return e.fieldsJSON()
+	}
+
 	value := e.textarea.Value()
 
 	// If it was JSON, validate it's still valid JSON
@@ -89,54 +128,335 @@ func (e *SecretEditor) IsModified() bool {
 	return e.modified
 }
 
+// SetTheme sets the theme the editor renders with
+func (e *SecretEditor) SetTheme(theme styles.Theme) {
+	e.theme = theme
+}
+
 // SetSize sets the editor dimensions
 func (e *SecretEditor) SetSize(width, height int) {
 	e.width = width
 	e.height = height
 	e.textarea.SetWidth(width - 4)
 	e.textarea.SetHeight(height - 10) // Leave room for title and help text
+	for i := range e.fields {
+		e.sizeField(&e.fields[i])
+	}
 }
 
 // Update handles messages for the editor
 func (e *SecretEditor) Update(msg tea.Msg) (*SecretEditor, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "ctrl+t" {
+		e.toggleMode()
+		return e, nil
+	}
+
 	var cmd tea.Cmd
-	e.textarea, cmd = e.textarea.Update(msg)
+	if e.structured {
+		cmd = e.updateFields(msg)
+	} else {
+		e.textarea, cmd = e.textarea.Update(msg)
+	}
 
-	// Track if modified
-	currentValue := e.textarea.Value()
-	// Format for comparison if JSON
-	compareValue := currentValue
-	if e.isJSON {
-		var jsonData interface{}
-		if json.Unmarshal([]byte(currentValue), &jsonData) == nil {
-			minified, _ := json.Marshal(jsonData)
-			compareValue = string(minified)
+	e.modified = normalizedJSON(e.currentValue()) != normalizedJSON(e.initialValue)
+
+	return e, cmd
+}
+
+// updateFields handles keys in structured mode
+func (e *SecretEditor) updateFields(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch keyMsg.String() {
+	case "tab", "down":
+		e.setFocus(e.focus + 1)
+		return nil
+	case "shift+tab", "up":
+		e.setFocus(e.focus - 1)
+		return nil
+	case "ctrl+n":
+		field := newSecretField("", "", false)
+		e.sizeField(&field)
+		e.fields = append(e.fields, field)
+		e.setFocus(2 * (len(e.fields) - 1))
+		return nil
+	case "ctrl+d":
+		if len(e.fields) > 0 {
+			i := e.focus / 2
+			e.fields = append(e.fields[:i], e.fields[i+1:]...)
+			e.setFocus(e.focus)
+		}
+		return nil
+	case "ctrl+r":
+		if len(e.fields) > 0 {
+			value := &e.fields[e.focus/2].value
+			if value.EchoMode == textinput.EchoPassword {
+				value.EchoMode = textinput.EchoNormal
+			} else {
+				value.EchoMode = textinput.EchoPassword
+			}
+		}
+		return nil
+	case "ctrl+o":
+		if len(e.fields) > 0 {
+			e.fields[e.focus/2].json = !e.fields[e.focus/2].json
 		}
+		return nil
 	}
 
-	initialCompare := e.initialValue
-	if e.isJSON {
-		var jsonData interface{}
-		if json.Unmarshal([]byte(e.initialValue), &jsonData) == nil {
-			minified, _ := json.Marshal(jsonData)
-			initialCompare = string(minified)
+	if len(e.fields) == 0 {
+		return nil
+	}
+
+	e.err = ""
+	var cmd tea.Cmd
+	field := &e.fields[e.focus/2]
+	if e.focus%2 == 0 {
+		field.key, cmd = field.key.Update(msg)
+	} else {
+		field.value, cmd = field.value.Update(msg)
+	}
+	return cmd
+}
+
+// toggleMode switches between structured and raw text editing, carrying the value over
+func (e *SecretEditor) toggleMode() {
+	e.err = ""
+
+	if e.structured {
+		value, err := e.fieldsJSON()
+		if err != nil {
+			e.err = err.Error()
+			return
 		}
+		var formatted bytes.Buffer
+		_ = json.Indent(&formatted, []byte(value), "", "  ")
+		e.textarea.SetValue(formatted.String())
+		e.textarea.Focus()
+		e.isJSON = true
+		e.structured = false
+		return
 	}
 
-	e.modified = compareValue != initialCompare
+	fields, ok := parseSecretFields(e.textarea.Value())
+	if !ok {
+		e.err = "Only a valid JSON object can be edited as fields"
+		return
+	}
+	e.setFields(fields)
+}
 
-	return e, cmd
+// setFields switches to structured mode with the given fields
+func (e *SecretEditor) setFields(fields []secretField) {
+	e.fields = fields
+	for i := range e.fields {
+		e.sizeField(&e.fields[i])
+	}
+	e.structured = true
+	e.offset = 0
+	e.textarea.Blur()
+	e.setFocus(1)
+}
+
+// setFocus focuses a key or value input, keeping it within the fields shown
+func (e *SecretEditor) setFocus(focus int) {
+	if len(e.fields) == 0 {
+		e.focus = 0
+		return
+	}
+
+	last := 2*len(e.fields) - 1
+	if focus > last {
+		focus = last
+	}
+	if focus < 0 {
+		focus = 0
+	}
+	e.focus = focus
+
+	for i := range e.fields {
+		e.fields[i].key.Blur()
+		e.fields[i].value.Blur()
+	}
+	field := &e.fields[focus/2]
+	if focus%2 == 0 {
+		field.key.Focus()
+	} else {
+		field.value.Focus()
+	}
+
+	visible := e.visibleFields()
+	if focus/2 < e.offset {
+		e.offset = focus / 2
+	}
+	if focus/2 >= e.offset+visible {
+		e.offset = focus/2 - visible + 1
+	}
+}
+
+// visibleFields returns how many fields fit in the editor
+func (e *SecretEditor) visibleFields() int {
+	visible := e.height - 10
+	if visible < 1 {
+		visible = 1
+	}
+	return visible
+}
+
+// newSecretField creates the inputs for a field, with the value masked
+func newSecretField(key, value string, isJSON bool) secretField {
+	keyInput := textinput.New()
+	keyInput.Placeholder = "key"
+	keyInput.Prompt = ""
+	keyInput.SetValue(key)
+
+	valueInput := textinput.New()
+	valueInput.Placeholder = "value"
+	valueInput.Prompt = ""
+	valueInput.EchoMode = textinput.EchoPassword
+	valueInput.EchoCharacter = '•'
+	valueInput.SetValue(value)
+
+	return secretField{key: keyInput, value: valueInput, json: isJSON}
+}
+
+func (e *SecretEditor) sizeField(field *secretField) {
+	keyWidth := e.width / 3
+	if keyWidth > 30 {
+		keyWidth = 30
+	}
+	field.key.Width = keyWidth
+	field.value.Width = e.width - keyWidth - 16
+}
+
+// parseSecretFields splits a JSON object into fields, in the order of its keys. Values
+// that aren't strings are kept as compact JSON.
+func parseSecretFields(value string) ([]secretField, bool) {
+	dec := json.NewDecoder(strings.NewReader(value))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, false
+	}
+
+	var fields []secretField
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+		key, _ := tok.(string)
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, false
+		}
+
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			fields = append(fields, newSecretField(key, s, false))
+			continue
+		}
+		var compact bytes.Buffer
+		_ = json.Compact(&compact, raw)
+		fields = append(fields, newSecretField(key, compact.String(), true))
+	}
+
+	// The closing brace, and nothing after it
+	if _, err := dec.Token(); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false
+	}
+	return fields, true
+}
+
+// fieldsJSON builds the secret's JSON from the fields, in their order, and validates them.
+// Fields without a key or a value are dropped.
+func (e *SecretEditor) fieldsJSON() (string, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+
+	seen := make(map[string]bool)
+	written := 0
+	for i, f := range e.fields {
+		key, value := f.key.Value(), f.value.Value()
+		if key == "" && value == "" {
+			continue
+		}
+		if key == "" {
+			return "", fmt.Errorf("field %d has no key", i+1)
+		}
+		if seen[key] {
+			return "", fmt.Errorf("duplicate key %q", key)
+		}
+		seen[key] = true
+
+		var valueJSON []byte
+		if f.json {
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, []byte(value)); err != nil {
+				return "", fmt.Errorf("value of %q is not valid JSON: %w", key, err)
+			}
+			valueJSON = compact.Bytes()
+		} else {
+			valueJSON, _ = json.Marshal(value)
+		}
+
+		keyJSON, _ := json.Marshal(key)
+		if written > 0 {
+			b.WriteByte(',')
+		}
+		b.Write(keyJSON)
+		b.WriteByte(':')
+		b.Write(valueJSON)
+		written++
+	}
+
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// currentValue returns the value as edited, even if it isn't valid
+func (e *SecretEditor) currentValue() string {
+	if !e.structured {
+		return e.textarea.Value()
+	}
+	value, err := e.fieldsJSON()
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// normalizedJSON minifies a JSON value so formatting doesn't count as a change. Other
+// values are returned as they are.
+func normalizedJSON(value string) string {
+	var jsonData interface{}
+	if json.Unmarshal([]byte(value), &jsonData) == nil {
+		minified, _ := json.Marshal(jsonData)
+		return string(minified)
+	}
+	return value
 }
 
 // View renders the editor
 func (e *SecretEditor) View() string {
+	heading := fmt.Sprintf("Editing Secret: %s", e.secretName)
+	if e.title != "" {
+		heading = e.title
+	}
 	title := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(e.theme.Colors.Primary).
-		Render(fmt.Sprintf("Editing Secret: %s", e.secretName))
+		Render(heading)
 
 	formatIndicator := "Plain Text"
-	if e.isJSON {
+	if e.structured {
+		formatIndicator = "JSON fields"
+	} else if e.isJSON {
 		formatIndicator = "JSON"
 	}
 
@@ -149,11 +469,55 @@ func (e *SecretEditor) View() string {
 		Foreground(e.theme.Colors.Muted).
 		Render(fmt.Sprintf("Format: %s%s", formatIndicator, modifiedIndicator))
 
+	help := "Ctrl+S: Save | Esc: Cancel"
+	if e.structured {
+		help = "Tab: Next | Ctrl+R: Reveal | Ctrl+N: Add | Ctrl+D: Delete | Ctrl+O: String/JSON | Ctrl+T: Raw text | " + help
+	} else if e.isJSON {
+		help = "Ctrl+T: Fields | " + help
+	}
 	helpText := lipgloss.NewStyle().
 		Foreground(e.theme.Colors.Muted).
-		Render("Ctrl+S: Save | Esc: Cancel")
+		Render(help)
 
 	editor := e.textarea.View()
+	if e.structured {
+		editor = e.fieldsView()
+	}
+
+	if e.err != "" {
+		helpText = lipgloss.NewStyle().Foreground(e.theme.Colors.Error).Render(e.err) + "\n" + helpText
+	}
 
 	return fmt.Sprintf("%s\n%s\n\n%s\n\n%s", title, subtitle, editor, helpText)
 }
+
+// fieldsView renders the fields shown in structured mode
+func (e *SecretEditor) fieldsView() string {
+	if len(e.fields) == 0 {
+		return lipgloss.NewStyle().Foreground(e.theme.Colors.Muted).Render("No fields, press Ctrl+N to add one")
+	}
+
+	muted := lipgloss.NewStyle().Foreground(e.theme.Colors.Muted)
+	end := e.offset + e.visibleFields()
+	if end > len(e.fields) {
+		end = len(e.fields)
+	}
+
+	var lines []string
+	for i := e.offset; i < end; i++ {
+		field := e.fields[i]
+		cursor := "  "
+		if i == e.focus/2 {
+			cursor = "▸ "
+		}
+		kind := ""
+		if field.json {
+			kind = muted.Render(" (json)")
+		}
+		lines = append(lines, cursor+field.key.View()+muted.Render(" : ")+field.value.View()+kind)
+	}
+	if len(e.fields) > end-e.offset {
+		lines = append(lines, muted.Render(fmt.Sprintf("  %d-%d of %d fields", e.offset+1, end, len(e.fields))))
+	}
+	return strings.Join(lines, "\n")
+}
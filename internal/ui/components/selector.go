@@ -1,9 +1,11 @@
 package components
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
-	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
@@ -17,6 +19,14 @@ type SelectorMode int
 const (
 	SelectProfile SelectorMode = iota
 	SelectRegion
+	SelectSessionPolicy
+	SelectTarget
+	SelectStandby
+	SelectOpenWith
+	SelectGroupMember
+	SelectRotationLambda
+	SelectDBSecret
+	SelectDBConnection
 )
 
 // ProfileSelectedMsg is sent when a profile is selected
@@ -24,135 +34,448 @@ type ProfileSelectedMsg struct {
 	Profile string
 }
 
+// SSOLoginRequestedMsg is sent when an SSO login is asked for from the profile selector
+type SSOLoginRequestedMsg struct {
+	Profile string
+}
+
+// ProfileStatus is what is known of a profile's account and SSO session
+type ProfileStatus struct {
+	AccountID string
+	Alias     string
+	SSOExpiry time.Time // When its cached SSO token expires, zero without one
+}
+
 // RegionSelectedMsg is sent when a region is selected
 type RegionSelectedMsg struct {
 	Region string
 }
 
-// SelectorClosedMsg is sent when the selector is closed without selection
-type SelectorClosedMsg struct{}
+// SessionPolicySelectedMsg is sent when a session policy is picked for assuming a role.
+// Policy is empty for the role's full permissions.
+type SessionPolicySelectedMsg struct {
+	Policy string
+}
+
+// SessionPolicyOption is a session policy offered when assuming a role
+type SessionPolicyOption struct {
+	Name        string
+	Description string
+}
+
+// TargetSelectedMsg is sent when a target is picked for registering with a target group
+type TargetSelectedMsg struct {
+	ID string
+}
+
+// TargetOption is a target offered for registering with a target group
+type TargetOption struct {
+	ID          string
+	Description string
+}
+
+// StandbySelectedMsg is sent when an instance is picked to move to standby
+type StandbySelectedMsg struct {
+	InstanceID string
+}
+
+// StandbyOption is an instance offered to move to standby
+type StandbyOption struct {
+	InstanceID  string
+	Description string
+}
+
+// OpenWithSelectedMsg is sent when an open_with command is picked for the selected resource
+type OpenWithSelectedMsg struct {
+	Name string
+}
+
+// OpenWithOption is an open_with command offered for the selected resource
+type OpenWithOption struct {
+	Name    string
+	Command string
+}
+
+// GroupMemberSelectedMsg is sent when a user is picked to add to a group
+type GroupMemberSelectedMsg struct {
+	UserName string
+}
+
+// GroupMemberOption is a user offered for adding to a group
+type GroupMemberOption struct {
+	UserName    string
+	Description string
+}
+
+// RotationLambdaSelectedMsg is sent when a Lambda function is picked to rotate a secret with
+type RotationLambdaSelectedMsg struct {
+	ARN string
+}
+
+// RotationLambdaOption is a Lambda function offered for rotating a secret
+type RotationLambdaOption struct {
+	Name        string
+	ARN         string
+	Description string
+}
+
+// DBSecretSelectedMsg is sent when a secret is picked to log in to a database with. ARN
+// is empty to have the client ask for the password.
+type DBSecretSelectedMsg struct {
+	ARN string
+}
+
+// DBSecretOption is a secret offered for logging in to a database
+type DBSecretOption struct {
+	Name        string
+	ARN         string
+	Description string
+	Group       string
+}
+
+// DBConnectionSelectedMsg is sent when a way to connect to a database is picked
+type DBConnectionSelectedMsg struct {
+	Value string
+}
 
-// selectorItem implements list.Item
-type selectorItem struct {
-	title       string
-	description string
-	value       string
+// DBConnectionOption is a way of connecting to a database, directly or through a tunnel
+type DBConnectionOption struct {
+	Title       string
+	Description string
+	Group       string
+	Value       string
 }
 
-func (i selectorItem) Title() string       { return i.title }
-func (i selectorItem) Description() string { return i.description }
-func (i selectorItem) FilterValue() string { return i.title }
+// SelectorClosedMsg is sent when the selector is closed without selection
+type SelectorClosedMsg struct{}
 
 // Selector provides profile and region selection
 type Selector struct {
-	list     list.Model
-	mode     SelectorMode
-	active   bool
-	width    int
-	height   int
-	theme    styles.Theme
-	selected string
+	list      pickList
+	filter    textinput.Model
+	filtering bool
+	title     string
+	mode      SelectorMode
+	active    bool
+	width     int
+	height    int
+	theme     styles.Theme
+	selected  string
+
+	// The profiles shown, so their status can be updated while the selector is open
+	profiles []config.Profile
 }
 
 // NewSelector creates a new selector component
 func NewSelector(theme styles.Theme) *Selector {
-	delegate := list.NewDefaultDelegate()
-	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
-		Bold(true)
-	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.
-		Foreground(lipgloss.Color("252")).
-		Background(lipgloss.Color("57"))
-
-	l := list.New([]list.Item{}, delegate, 0, 0)
-	l.SetShowTitle(true)
-	l.SetShowHelp(false)
-	l.SetShowStatusBar(false)
-	l.SetFilteringEnabled(true)
-	l.Styles.Title = lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("212")).
-		MarginLeft(2)
+	filter := textinput.New()
+	filter.Prompt = "/ "
+	filter.Placeholder = "filter"
+	filter.CharLimit = 100
 
 	return &Selector{
-		list:  l,
-		theme: theme,
+		filter: filter,
+		theme:  theme,
 	}
 }
 
+// SetTheme sets the theme the selector renders with
+func (s *Selector) SetTheme(theme styles.Theme) {
+	s.theme = theme
+}
+
 // SetSize sets the selector dimensions
 func (s *Selector) SetSize(width, height int) {
 	s.width = width
 	s.height = height
-	s.list.SetSize(width-4, height-4)
+	// Less the border, padding, title, filter and help lines
+	s.list.setHeight(height - 15)
+	s.filter.Width = width - 20
 }
 
-// ShowProfiles shows the profile selector
-func (s *Selector) ShowProfiles(profiles []config.Profile, current string) tea.Cmd {
-	s.mode = SelectProfile
+// open shows the items, selecting the one with the current value
+func (s *Selector) open(mode SelectorMode, title string, items []pickItem, current string) {
+	s.mode = mode
 	s.active = true
 	s.selected = current
-	s.list.Title = "Select AWS Profile"
+	s.title = title
+	s.filtering = false
+	s.filter.Reset()
+	s.filter.Blur()
+	s.list.setItems(items)
+	s.list.selectValue(current)
+}
+
+// profileGroup groups SSO profiles by session and account and role profiles by account
+func profileGroup(p config.Profile) string {
+	if p.IsSSO {
+		session := p.SSOSession
+		if session == "" {
+			session = strings.TrimPrefix(strings.TrimPrefix(p.SSOStartURL, "https://"), "http://")
+		}
+		if p.SSOAccount == "" {
+			return "SSO " + session
+		}
+		return fmt.Sprintf("SSO %s · %s", session, p.SSOAccount)
+	}
+	if p.RoleARN != "" {
+		if parts := strings.Split(p.RoleARN, ":"); len(parts) > 4 && parts[4] != "" {
+			return "Roles · " + parts[4]
+		}
+		return "Roles"
+	}
+	return "Credentials"
+}
+
+// ShowProfiles shows the profile selector, grouped by SSO session and account, with the
+// account and SSO session of each profile known so far
+func (s *Selector) ShowProfiles(profiles []config.Profile, current string, status map[string]ProfileStatus) tea.Cmd {
+	s.profiles = profiles
+	s.open(SelectProfile, "Select AWS Profile", profileItems(profiles, status), current)
+	return nil
+}
+
+// SetProfileStatus updates the account and SSO session shown for each profile, keeping
+// the filter and the profile under the cursor
+func (s *Selector) SetProfileStatus(status map[string]ProfileStatus) {
+	if !s.active || s.mode != SelectProfile {
+		return
+	}
+	var selected string
+	if item := s.list.selectedItem(); item != nil {
+		selected = item.value
+	}
+	s.list.setItems(profileItems(s.profiles, status))
+	s.list.setQuery(s.filter.Value())
+	s.list.selectValue(selected)
+}
 
-	items := make([]list.Item, len(profiles))
+// profileItems builds the profile selector's entries, only grouped when there is more
+// than one kind of profile
+func profileItems(profiles []config.Profile, status map[string]ProfileStatus) []pickItem {
+	items := make([]pickItem, len(profiles))
 	for i, p := range profiles {
 		desc := p.Region
 		if p.IsSSO {
-			desc = "SSO: " + p.SSOStartURL
+			desc = p.SSORoleName
+			if p.Region != "" {
+				desc += " " + p.Region
+			}
 		} else if p.RoleARN != "" {
 			desc = "Role: " + p.RoleARN
+			var notes []string
+			if p.MFASerial != "" {
+				notes = append(notes, "MFA")
+			}
+			if p.ExternalID != "" {
+				notes = append(notes, "external ID")
+			}
+			if d := p.DurationSeconds; d > 0 && d%3600 == 0 {
+				notes = append(notes, fmt.Sprintf("%dh sessions", d/3600))
+			} else if d > 0 {
+				notes = append(notes, fmt.Sprintf("%dm sessions", d/60))
+			}
+			if len(notes) > 0 {
+				desc += " (" + strings.Join(notes, ", ") + ")"
+			}
 		}
 		if desc == "" {
 			desc = "Static credentials"
 		}
+		if notes := profileStatusNotes(p, status[p.Name]); notes != "" {
+			desc = notes + " · " + desc
+		}
 
-		items[i] = selectorItem{
+		items[i] = pickItem{
 			title:       p.Name,
 			description: desc,
 			value:       p.Name,
+			group:       profileGroup(p),
 		}
 	}
 
-	s.list.SetItems(items)
-
-	// Select current item
-	for i, item := range items {
-		if item.(selectorItem).value == current {
-			s.list.Select(i)
-			break
+	// Only group when there is more than one kind of profile
+	groups := make(map[string]bool)
+	for _, item := range items {
+		groups[item.group] = true
+	}
+	if len(groups) < 2 {
+		for i := range items {
+			items[i].group = ""
 		}
 	}
+	return items
+}
 
-	return nil
+// profileStatusNotes describes a profile's account, e.g. prod (123456789012), and for SSO
+// profiles how long their session has left
+func profileStatusNotes(p config.Profile, status ProfileStatus) string {
+	var notes []string
+	switch {
+	case status.Alias != "":
+		notes = append(notes, fmt.Sprintf("%s (%s)", status.Alias, status.AccountID))
+	case status.AccountID != "":
+		notes = append(notes, status.AccountID)
+	case p.SSOAccount != "":
+		notes = append(notes, p.SSOAccount)
+	}
+	if p.IsSSO {
+		switch left := time.Until(status.SSOExpiry); {
+		case status.SSOExpiry.IsZero():
+			notes = append(notes, "⚠ SSO not logged in")
+		case left <= 0:
+			notes = append(notes, "⚠ SSO expired")
+		case left < time.Hour:
+			notes = append(notes, fmt.Sprintf("SSO %dm left", int(left.Minutes())))
+		default:
+			notes = append(notes, fmt.Sprintf("SSO %dh%02dm left", int(left.Hours()), int(left.Minutes())%60))
+		}
+	}
+	return strings.Join(notes, " · ")
 }
 
 // ShowRegions shows the region selector
 func (s *Selector) ShowRegions(regions []config.Region, current string) tea.Cmd {
-	s.mode = SelectRegion
-	s.active = true
-	s.selected = current
-	s.list.Title = "Select AWS Region"
-
-	items := make([]list.Item, len(regions))
+	items := make([]pickItem, len(regions))
 	for i, r := range regions {
-		items[i] = selectorItem{
+		items[i] = pickItem{
 			title:       r.Name,
 			description: r.Description,
 			value:       r.Name,
 		}
 	}
 
-	s.list.SetItems(items)
+	s.open(SelectRegion, "Select AWS Region", items, current)
+	return nil
+}
 
-	// Select current item
-	for i, item := range items {
-		if item.(selectorItem).value == current {
-			s.list.Select(i)
-			break
-		}
+// ShowSessionPolicies shows the session policies a role can be assumed with, after the
+// option to use the role's full permissions
+func (s *Selector) ShowSessionPolicies(roleName string, options []SessionPolicyOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options)+1)
+	items = append(items, pickItem{
+		title:       "none",
+		description: "Full permissions of the role",
+		value:       "",
+	})
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.Name,
+			description: option.Description,
+			value:       option.Name,
+		})
+	}
+
+	s.open(SelectSessionPolicy, fmt.Sprintf("Assume %s with session policy", roleName), items, "")
+	return nil
+}
+
+// ShowTargets shows the targets that can be registered with a target group
+func (s *Selector) ShowTargets(targetGroupName string, options []TargetOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options))
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.ID,
+			description: option.Description,
+			value:       option.ID,
+		})
+	}
+
+	s.open(SelectTarget, fmt.Sprintf("Register target with %s", targetGroupName), items, "")
+	return nil
+}
+
+// ShowStandbyInstances shows the instances of an Auto Scaling group that can be moved to standby
+func (s *Selector) ShowStandbyInstances(groupName string, options []StandbyOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options))
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.InstanceID,
+			description: option.Description,
+			value:       option.InstanceID,
+		})
+	}
+
+	s.open(SelectStandby, fmt.Sprintf("Move instance of %s to standby", groupName), items, "")
+	return nil
+}
+
+// ShowOpenWith shows the commands a resource can be opened with
+func (s *Selector) ShowOpenWith(resourceName string, options []OpenWithOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options))
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.Name,
+			description: option.Command,
+			value:       option.Name,
+		})
+	}
+
+	s.open(SelectOpenWith, fmt.Sprintf("Open %s with", resourceName), items, "")
+	return nil
+}
+
+// ShowGroupMemberCandidates shows the users that can be added to a group
+func (s *Selector) ShowGroupMemberCandidates(groupName string, options []GroupMemberOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options))
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.UserName,
+			description: option.Description,
+			value:       option.UserName,
+		})
+	}
+
+	s.open(SelectGroupMember, fmt.Sprintf("Add user to %s", groupName), items, "")
+	return nil
+}
+
+// ShowRotationLambdas shows the Lambda functions a secret can be rotated with, the current
+// one selected
+func (s *Selector) ShowRotationLambdas(secretName, current string, options []RotationLambdaOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options))
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.Name,
+			description: option.Description,
+			value:       option.ARN,
+		})
 	}
 
+	s.open(SelectRotationLambda, fmt.Sprintf("Rotation Lambda for %s", secretName), items, current)
+	return nil
+}
+
+// ShowDBSecrets shows the secrets a database can be logged in to with, grouped by
+// whether they look like they belong to it
+func (s *Selector) ShowDBSecrets(dbName string, options []DBSecretOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options))
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.Name,
+			description: option.Description,
+			value:       option.ARN,
+			group:       option.Group,
+		})
+	}
+
+	s.open(SelectDBSecret, fmt.Sprintf("Log in to %s with", dbName), items, "")
+	return nil
+}
+
+// ShowDBConnections shows the ways of connecting to a database
+func (s *Selector) ShowDBConnections(dbName string, options []DBConnectionOption) tea.Cmd {
+	items := make([]pickItem, 0, len(options))
+	for _, option := range options {
+		items = append(items, pickItem{
+			title:       option.Title,
+			description: option.Description,
+			value:       option.Value,
+			group:       option.Group,
+		})
+	}
+
+	s.open(SelectDBConnection, fmt.Sprintf("Connect to %s", dbName), items, "")
 	return nil
 }
 
@@ -166,43 +489,122 @@ func (s *Selector) Close() {
 	s.active = false
 }
 
+// selectedMsg returns the message for picking the value in the current mode
+func (s *Selector) selectedMsg(value string) tea.Msg {
+	switch s.mode {
+	case SelectProfile:
+		return ProfileSelectedMsg{Profile: value}
+	case SelectSessionPolicy:
+		return SessionPolicySelectedMsg{Policy: value}
+	case SelectTarget:
+		return TargetSelectedMsg{ID: value}
+	case SelectStandby:
+		return StandbySelectedMsg{InstanceID: value}
+	case SelectOpenWith:
+		return OpenWithSelectedMsg{Name: value}
+	case SelectGroupMember:
+		return GroupMemberSelectedMsg{UserName: value}
+	case SelectRotationLambda:
+		return RotationLambdaSelectedMsg{ARN: value}
+	case SelectDBSecret:
+		return DBSecretSelectedMsg{ARN: value}
+	case SelectDBConnection:
+		return DBConnectionSelectedMsg{Value: value}
+	}
+	return RegionSelectedMsg{Region: value}
+}
+
 // Update handles messages
 func (s *Selector) Update(msg tea.Msg) (*Selector, tea.Cmd) {
 	if !s.active {
 		return s, nil
 	}
 
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "enter":
-			selected := s.list.SelectedItem()
-			if selected == nil {
-				return s, nil
-			}
-			item := selected.(selectorItem)
-			s.active = false
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		if s.filtering {
+			var cmd tea.Cmd
+			s.filter, cmd = s.filter.Update(msg)
+			return s, cmd
+		}
+		return s, nil
+	}
 
-			if s.mode == SelectProfile {
-				return s, func() tea.Msg {
-					return ProfileSelectedMsg{Profile: item.value}
-				}
-			}
-			return s, func() tea.Msg {
-				return RegionSelectedMsg{Region: item.value}
-			}
+	switch keyMsg.String() {
+	case "enter":
+		item := s.list.selectedItem()
+		if item == nil {
+			return s, nil
+		}
+		s.active = false
+		msg := s.selectedMsg(item.value)
+		return s, func() tea.Msg { return msg }
 
-		case "esc", "q":
-			s.active = false
-			return s, func() tea.Msg {
-				return SelectorClosedMsg{}
-			}
+	case "up", "ctrl+p":
+		s.list.move(-1)
+		return s, nil
+	case "down", "ctrl+n":
+		s.list.move(1)
+		return s, nil
+	case "pgup":
+		s.list.move(-s.list.page())
+		return s, nil
+	case "pgdown":
+		s.list.move(s.list.page())
+		return s, nil
+	}
+
+	// Typing narrows the list as it goes; esc clears the filter before closing
+	if s.filtering {
+		if keyMsg.String() == "esc" {
+			s.filtering = false
+			s.filter.Reset()
+			s.filter.Blur()
+			s.list.setQuery("")
+			return s, nil
 		}
+		var cmd tea.Cmd
+		s.filter, cmd = s.filter.Update(msg)
+		s.list.setQuery(s.filter.Value())
+		return s, cmd
 	}
 
-	var cmd tea.Cmd
-	s.list, cmd = s.list.Update(msg)
-	return s, cmd
+	switch keyMsg.String() {
+	case "esc", "q":
+		s.active = false
+		return s, func() tea.Msg {
+			return SelectorClosedMsg{}
+		}
+	case "/":
+		s.filtering = true
+		return s, s.filter.Focus()
+	case "l":
+		if s.mode != SelectProfile {
+			break
+		}
+		item := s.list.selectedItem()
+		if item == nil {
+			break
+		}
+		s.active = false
+		profile := item.value
+		return s, func() tea.Msg {
+			return SSOLoginRequestedMsg{Profile: profile}
+		}
+	case "j":
+		s.list.move(1)
+	case "k":
+		s.list.move(-1)
+	case "ctrl+d":
+		s.list.move(s.list.page() / 2)
+	case "ctrl+u":
+		s.list.move(-s.list.page() / 2)
+	case "g", "home":
+		s.list.move(-s.list.cursor)
+	case "G", "end":
+		s.list.move(len(s.list.matches))
+	}
+	return s, nil
 }
 
 // View renders the selector
@@ -211,12 +613,47 @@ func (s *Selector) View() string {
 		return ""
 	}
 
-	// Create a modal box
-	content := s.list.View()
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(s.theme.Colors.Accent)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(s.theme.Colors.Muted)
+	listStyles := pickListStyles{
+		selected: lipgloss.NewStyle().
+			Foreground(s.theme.Colors.SelectionFg).
+			Background(s.theme.Colors.Selection).
+			Bold(true),
+		normal: lipgloss.NewStyle().
+			Foreground(s.theme.Colors.Foreground),
+		muted: mutedStyle,
+		header: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(s.theme.Colors.Primary),
+	}
 
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(s.title))
+	content.WriteString("  ")
+	content.WriteString(mutedStyle.Render(s.list.status()))
+	content.WriteString("\n")
+	if s.filtering || s.filter.Value() != "" {
+		content.WriteString(s.filter.View())
+	}
+	content.WriteString("\n\n")
+	content.WriteString(s.list.view(s.width-14, listStyles))
+	content.WriteString("\n\n")
+	if s.filtering {
+		content.WriteString(mutedStyle.Render("type to filter | ↑/↓: move | enter: select | esc: clear filter"))
+	} else if s.mode == SelectProfile {
+		content.WriteString(mutedStyle.Render("j/k: move | /: filter | enter: select | l: sso login | esc: close"))
+	} else {
+		content.WriteString(mutedStyle.Render("j/k: move | /: filter | enter: select | esc: close"))
+	}
+
+	// Create a modal box
 	modal := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("62")).
+		BorderForeground(s.theme.Colors.Secondary).
 		Padding(1, 2).
 		Width(s.width - 10).
 		Height(s.height - 6)
@@ -227,7 +664,7 @@ func (s *Selector) View() string {
 		s.height,
 		lipgloss.Center,
 		lipgloss.Center,
-		modal.Render(content),
+		modal.Render(content.String()),
 	)
 }
 
@@ -2,7 +2,11 @@ package components
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
@@ -10,6 +14,7 @@ import (
 
 	"github.com/aaw-tui/aws-tui/internal/handlers"
 	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // ResourceSelectedMsg is sent when a resource is selected
@@ -29,10 +34,19 @@ type Table struct {
 	filter     string
 	filtered   []int // Indices of filtered rows
 
+	// Fuzzy filtering, and the matched runes of each filtered row's cells by row index
+	fuzzy   bool
+	matches map[int][][]int
+
 	// Sort state
 	sortColumn    int  // -1 for no sort, otherwise column index
 	sortAscending bool
 
+	// Column of the selected row whose cell yc copies, shown in the status line once
+	// moved with left or right
+	column      int
+	columnMoved bool
+
 	// Dimensions
 	width  int
 	height int
@@ -42,6 +56,25 @@ type Table struct {
 
 	// Focus
 	focused bool
+
+	// Optional prefix for a resource's first cell, e.g. to flag expiring resources
+	marker func(handlers.Resource) string
+
+	// IDs of the marked resources when rows can be marked, nil otherwise
+	checked map[string]bool
+
+	// Columns shown at the current width, as laid out by layoutColumns
+	layout []shownColumn
+
+	// Whether more rows stream in as the table is scrolled, and whether they are loading
+	more        bool
+	loadingMore bool
+}
+
+// shownColumn is a column fitted to the table's width
+type shownColumn struct {
+	index int // Into the column definitions and row cells
+	width int
 }
 
 // NewTable creates a new table component
@@ -55,6 +88,11 @@ func NewTable(theme styles.Theme) *Table {
 	}
 }
 
+// SetTheme sets the theme the table renders with
+func (t *Table) SetTheme(theme styles.Theme) {
+	t.theme = theme
+}
+
 // SetSize sets the table dimensions
 func (t *Table) SetSize(width, height int) {
 	t.width = width
@@ -64,6 +102,122 @@ func (t *Table) SetSize(width, height int) {
 // SetColumns sets the column definitions
 func (t *Table) SetColumns(columns []handlers.ColumnDef) {
 	t.columns = columns
+	t.column = 0
+	t.columnMoved = false
+}
+
+// MoveColumn moves the column cursor by delta among the columns shown
+func (t *Table) MoveColumn(delta int) {
+	layout := t.layout
+	if len(layout) == 0 {
+		layout = t.layoutColumns()
+	}
+	if len(layout) == 0 {
+		return
+	}
+	pos := 0
+	for i, col := range layout {
+		if col.index == t.column {
+			pos = i
+		}
+	}
+	pos = min(max(pos+delta, 0), len(layout)-1)
+	t.column = layout[pos].index
+	t.columnMoved = true
+}
+
+// SelectedCell returns the title of the column cursor's column and the selected row's
+// value in it, as shown but not truncated
+func (t *Table) SelectedCell() (title, value string, ok bool) {
+	idx := t.SelectedIndex()
+	if idx < 0 || idx >= len(t.rows) || t.column >= len(t.columns) || t.column >= len(t.rows[idx]) {
+		return "", "", false
+	}
+	return t.columns[t.column].Title, utils.FormatTimeValue(t.rows[idx][t.column]), true
+}
+
+// SelectedRowTSV returns every cell of the selected row, hidden columns included, as
+// tab-separated values
+func (t *Table) SelectedRowTSV() (string, bool) {
+	idx := t.SelectedIndex()
+	if idx < 0 || idx >= len(t.rows) {
+		return "", false
+	}
+	cells := make([]string, len(t.rows[idx]))
+	for i, cell := range t.rows[idx] {
+		cells[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(utils.FormatTimeValue(cell))
+	}
+	return strings.Join(cells, "\t"), true
+}
+
+// layoutColumns fits the columns to the table's width. Columns are hidden by priority
+// until the rest fit at their defined widths, then the space left over is shared among
+// them in proportion to those widths.
+func (t *Table) layoutColumns() []shownColumn {
+	shown := make([]int, len(t.columns))
+	for i := range shown {
+		shown[i] = i
+	}
+	needed := func() int {
+		total := max(len(shown)-1, 0) // Separators
+		for _, i := range shown {
+			total += t.columns[i].Width
+		}
+		return total
+	}
+
+	for needed() > t.width && len(shown) > 1 {
+		victim := 1
+		for pos := 2; pos < len(shown); pos++ {
+			if t.columns[shown[pos]].Priority <= t.columns[shown[victim]].Priority {
+				victim = pos
+			}
+		}
+		shown = append(shown[:victim], shown[victim+1:]...)
+	}
+
+	layout := make([]shownColumn, len(shown))
+	defined := 0
+	for pos, i := range shown {
+		layout[pos] = shownColumn{index: i, width: t.columns[i].Width}
+		defined += t.columns[i].Width
+	}
+	if len(layout) == 0 || defined == 0 {
+		return layout
+	}
+
+	extra := t.width - needed()
+	if extra < 0 {
+		// Only the first column is left and it is still too wide
+		layout[0].width = max(t.width, 1)
+		return layout
+	}
+	given := 0
+	for pos := range layout {
+		share := extra * t.columns[layout[pos].index].Width / defined
+		layout[pos].width += share
+		given += share
+	}
+	// Rounding leaves a few cells, which go to the first column
+	layout[0].width += extra - given
+	return layout
+}
+
+// SetRowMarker sets a function whose non-empty result is prefixed to a row's first cell
+func (t *Table) SetRowMarker(marker func(handlers.Resource) string) {
+	t.marker = marker
+}
+
+// SetChecked shows a check box in front of each row, ticked for the IDs in checked.
+// A nil map hides the boxes.
+func (t *Table) SetChecked(checked map[string]bool) {
+	t.checked = checked
+}
+
+// SetFuzzy switches the filter from substring to fuzzy matching, where a row matches when
+// the characters of each word of the filter appear in one of its cells in order
+func (t *Table) SetFuzzy(fuzzy bool) {
+	t.fuzzy = fuzzy
 }
 
 // SetResources updates the table with new resources
@@ -72,10 +226,11 @@ func (t *Table) SetResources(resources []handlers.Resource) {
 	t.rows = make([][]string, len(resources))
 
 	for i, res := range resources {
-		t.rows[i] = res.ToTableRow()
+		t.rows[i] = t.resourceRow(res)
 	}
 
 	// Reset filter
+	t.matches = nil
 	t.filtered = make([]int, len(resources))
 	for i := range resources {
 		t.filtered[i] = i
@@ -88,12 +243,96 @@ func (t *Table) SetResources(resources []handlers.Resource) {
 	t.offset = 0
 }
 
+// ReplaceResource swaps in a reloaded resource for the one with the same ID, keeping the
+// filter, sort and cursor. It returns false if the table doesn't have it.
+func (t *Table) ReplaceResource(res handlers.Resource) bool {
+	for i, existing := range t.resources {
+		if existing.GetID() != res.GetID() {
+			continue
+		}
+		t.resources[i] = res
+		t.rows[i] = t.resourceRow(res)
+		return true
+	}
+	return false
+}
+
+// AppendResources adds resources after the loaded ones, such as a page streamed in as
+// the table is scrolled, keeping the filter, sort and selection
+func (t *Table) AppendResources(resources []handlers.Resource) {
+	start := len(t.rows)
+	for _, res := range resources {
+		t.resources = append(t.resources, res)
+		t.rows = append(t.rows, t.resourceRow(res))
+	}
+
+	// A sort or fuzzy ranking can place the new rows anywhere, so the rows are laid out
+	// again and the selection followed
+	if t.sortColumn != -1 || (t.filter != "" && t.fuzzy) {
+		selected := t.SelectedResource()
+		offset := t.offset
+		if t.sortColumn != -1 {
+			t.Sort()
+		} else {
+			t.ApplyFilter(t.filter)
+		}
+		if selected != nil {
+			t.offset = offset
+			t.SelectByID(selected.GetID())
+		}
+		return
+	}
+
+	for i := start; i < len(t.rows); i++ {
+		if t.filter == "" || rowContains(t.rows[i], t.filter) {
+			t.filtered = append(t.filtered, i)
+		}
+	}
+}
+
+// SetMore tells the status line whether more rows stream in as the table is scrolled,
+// and whether they are loading
+func (t *Table) SetMore(more, loading bool) {
+	t.more = more
+	t.loadingMore = loading
+}
+
+// NearEnd reports whether the cursor is within a screen of the last row, where the next
+// page of a streamed list is loaded
+func (t *Table) NearEnd() bool {
+	return t.cursor >= len(t.filtered)-max(t.visibleRows(), 1)
+}
+
+// resourceRow returns a resource's cells, with the marker prefixed to the first
+func (t *Table) resourceRow(res handlers.Resource) []string {
+	row := res.ToTableRow()
+	if t.marker != nil && len(row) > 0 {
+		if mark := t.marker(res); mark != "" {
+			row[0] = mark + " " + row[0]
+		}
+	}
+	return row
+}
+
+// rowContains reports whether any cell of a row contains the lower-cased filter
+func rowContains(row []string, filter string) bool {
+	for _, cell := range row {
+		if strings.Contains(strings.ToLower(cell), filter) {
+			return true
+		}
+	}
+	return false
+}
+
 // ApplyFilter filters the displayed rows
 func (t *Table) ApplyFilter(filter string) {
 	t.filter = strings.ToLower(filter)
 	t.filtered = make([]int, 0)
+	t.matches = nil
 
-	if t.filter == "" {
+	if t.filter != "" && t.fuzzy {
+		t.applyFuzzyFilter()
+	} else if t.filter == "" {
 		// No filter, show all
 		for i := range t.rows {
 			t.filtered = append(t.filtered, i)
@@ -101,11 +340,8 @@ func (t *Table) ApplyFilter(filter string) {
 	} else {
 		// Filter rows
 		for i, row := range t.rows {
-			for _, cell := range row {
-				if strings.Contains(strings.ToLower(cell), t.filter) {
-					t.filtered = append(t.filtered, i)
-					break
-				}
+			if rowContains(row, t.filter) {
+				t.filtered = append(t.filtered, i)
 			}
 		}
 	}
@@ -117,6 +353,76 @@ func (t *Table) ApplyFilter(filter string) {
 	t.offset = 0
 }
 
+// applyFuzzyFilter keeps the rows where every word of the filter fuzzy matches a cell,
+// ranked best match first unless the table is sorted by a column
+func (t *Table) applyFuzzyFilter() {
+	terms := strings.Fields(t.filter)
+	scores := make(map[int]int)
+	t.matches = make(map[int][][]int)
+
+	for i, row := range t.rows {
+		total := 0
+		cells := make([][]int, len(row))
+		matched := true
+		for _, term := range terms {
+			best, bestCell := 0, -1
+			var bestPositions []int
+			query := []rune(term)
+			for c, cell := range row {
+				// Match what the cell shows, so highlights line up with formatted timestamps
+				positions := make([]int, len(query))
+				score, ok := fuzzyMatch(lowerRunes(utils.FormatTimeValue(cell)), query, positions)
+				if ok && (bestCell < 0 || score > best) {
+					best, bestCell, bestPositions = score, c, positions
+				}
+			}
+			if bestCell < 0 {
+				matched = false
+				break
+			}
+			total += best
+			cells[bestCell] = append(cells[bestCell], bestPositions...)
+		}
+		if !matched {
+			continue
+		}
+		t.filtered = append(t.filtered, i)
+		scores[i] = total
+		t.matches[i] = cells
+	}
+
+	if t.sortColumn == -1 {
+		sort.SliceStable(t.filtered, func(a, b int) bool {
+			return scores[t.filtered[a]] > scores[t.filtered[b]]
+		})
+	}
+}
+
+// lowerRunes lower-cases s rune by rune, so rune indices still line up with s
+func lowerRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+// rowMatches returns the matched runes of a row's cells as rendered, shifted past the
+// check box of the first cell
+func (t *Table) rowMatches(idx int) [][]int {
+	matches := t.matches[idx]
+	if matches == nil || t.checked == nil || len(matches[0]) == 0 {
+		return matches
+	}
+	shifted := make([][]int, len(matches))
+	copy(shifted, matches)
+	shifted[0] = make([]int, len(matches[0]))
+	for i, pos := range matches[0] {
+		shifted[0][i] = pos + len([]rune("[ ] "))
+	}
+	return shifted
+}
+
 // SelectedResource returns the currently selected resource
 func (t *Table) SelectedResource() handlers.Resource {
 	if len(t.filtered) == 0 || t.cursor >= len(t.filtered) {
@@ -129,6 +435,19 @@ func (t *Table) SelectedResource() handlers.Resource {
 	return t.resources[idx]
 }
 
+// SelectByID moves the cursor to the resource with the given ID, returning false if no
+// shown row has it
+func (t *Table) SelectByID(id string) bool {
+	for pos, idx := range t.filtered {
+		if idx < len(t.resources) && t.resources[idx].GetID() == id {
+			t.cursor = pos
+			t.ensureVisible()
+			return true
+		}
+	}
+	return false
+}
+
 // SelectedIndex returns the index of the selected resource
 func (t *Table) SelectedIndex() int {
 	if len(t.filtered) == 0 || t.cursor >= len(t.filtered) {
@@ -208,45 +527,23 @@ func (t *Table) Sort() {
 		return
 	}
 
-	// Create a slice of indices to sort
+	// Parse each row's cell once, rather than on every comparison
+	sortType := t.columns[t.sortColumn].SortType
+	keys := make([]sortKey, len(t.resources))
+	for i := range keys {
+		if i < len(t.rows) && t.sortColumn < len(t.rows[i]) {
+			keys[i] = newSortKey(t.rows[i][t.sortColumn], sortType)
+		}
+	}
+
 	indices := make([]int, len(t.resources))
 	for i := range indices {
 		indices[i] = i
 	}
-
-	// Sort indices based on the row values
-	sortColumn := t.sortColumn
 	ascending := t.sortAscending
-
-	// Use a stable sort
-	// Compare function
-	less := func(i, j int) bool {
-		if i >= len(t.rows) || j >= len(t.rows) {
-			return false
-		}
-		rowI := t.rows[i]
-		rowJ := t.rows[j]
-		if sortColumn >= len(rowI) || sortColumn >= len(rowJ) {
-			return false
-		}
-
-		valI := strings.ToLower(rowI[sortColumn])
-		valJ := strings.ToLower(rowJ[sortColumn])
-
-		if ascending {
-			return valI < valJ
-		}
-		return valI > valJ
-	}
-
-	// Sort using bubble sort to maintain stability
-	for i := 0; i < len(indices)-1; i++ {
-		for j := 0; j < len(indices)-i-1; j++ {
-			if less(indices[j+1], indices[j]) {
-				indices[j], indices[j+1] = indices[j+1], indices[j]
-			}
-		}
-	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return keys[indices[a]].less(keys[indices[b]], ascending)
+	})
 
 	// Reorder resources and rows based on sorted indices
 	newResources := make([]handlers.Resource, len(t.resources))
@@ -263,6 +560,89 @@ func (t *Table) Sort() {
 	t.ApplyFilter(t.filter)
 }
 
+// sortKey is a cell's value as its column's sort type compares it
+type sortKey struct {
+	text  string
+	value float64
+	typed bool // The value parsed as the column's sort type
+}
+
+func newSortKey(cell string, sortType handlers.SortType) sortKey {
+	key := sortKey{text: strings.ToLower(cell)}
+	switch sortType {
+	case handlers.SortNumber:
+		key.value, _, key.typed = parseLeadingNumber(cell)
+	case handlers.SortSize:
+		key.value, key.typed = parseSize(cell)
+	case handlers.SortDate:
+		if ts, ok := utils.ParseTimeValue(strings.TrimSpace(cell)); ok {
+			key.value, key.typed = float64(ts.Unix()), true
+		}
+	}
+	return key
+}
+
+// less orders two keys, keeping values that didn't parse last in either direction
+func (k sortKey) less(other sortKey, ascending bool) bool {
+	switch {
+	case k.typed && other.typed:
+		if ascending {
+			return k.value < other.value
+		}
+		return k.value > other.value
+	case k.typed != other.typed:
+		return k.typed
+	}
+	if ascending {
+		return k.text < other.text
+	}
+	return k.text > other.text
+}
+
+// parseLeadingNumber reads the number a cell starts with, skipping a currency sign and
+// thousands separators, and returns the rest of the cell after it
+func parseLeadingNumber(cell string) (float64, string, bool) {
+	s := strings.TrimSpace(cell)
+	s = strings.TrimPrefix(s, "$")
+	end := 0
+	for end < len(s) {
+		c := s[end]
+		if c >= '0' && c <= '9' || c == '.' || c == ',' || (end == 0 && (c == '-' || c == '+')) {
+			end++
+			continue
+		}
+		break
+	}
+	value, err := strconv.ParseFloat(strings.ReplaceAll(s[:end], ",", ""), 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return value, strings.TrimSpace(s[end:]), true
+}
+
+// sizeUnits are the multipliers of the units sizes are shown in
+var sizeUnits = map[string]float64{
+	"": 1, "b": 1,
+	"k": 1 << 10, "kb": 1 << 10, "kib": 1 << 10,
+	"m": 1 << 20, "mb": 1 << 20, "mib": 1 << 20,
+	"g": 1 << 30, "gb": 1 << 30, "gib": 1 << 30,
+	"t": 1 << 40, "tb": 1 << 40, "tib": 1 << 40,
+	"p": 1 << 50, "pb": 1 << 50, "pib": 1 << 50,
+}
+
+// parseSize reads a size such as "3.4 MB" as bytes; a bare number is bytes
+func parseSize(cell string) (float64, bool) {
+	value, unit, ok := parseLeadingNumber(cell)
+	if !ok {
+		return 0, false
+	}
+	multiplier, ok := sizeUnits[strings.ToLower(unit)]
+	if !ok {
+		return 0, false
+	}
+	return value * multiplier, true
+}
+
 // GetSortInfo returns current sort information for display
 func (t *Table) GetSortInfo() (columnName string, ascending bool, active bool) {
 	if t.sortColumn == -1 || t.sortColumn >= len(t.columns) {
@@ -292,6 +672,10 @@ func (t *Table) Update(msg tea.Msg) (*Table, tea.Cmd) {
 			t.moveHalfPageDown()
 		case key.Matches(msg, key.NewBinding(key.WithKeys("ctrl+u"))):
 			t.moveHalfPageUp()
+		case key.Matches(msg, key.NewBinding(key.WithKeys("left"))):
+			t.MoveColumn(-1)
+		case key.Matches(msg, key.NewBinding(key.WithKeys("right"))):
+			t.MoveColumn(1)
 		case key.Matches(msg, key.NewBinding(key.WithKeys("enter", "l"))):
 			if res := t.SelectedResource(); res != nil {
 				return t, func() tea.Msg {
@@ -361,7 +745,15 @@ func (t *Table) moveHalfPageUp() {
 }
 
 func (t *Table) visibleRows() int {
-	return t.height - 3 // Account for header and borders
+	return (t.height - 3) / t.rowHeight() // Account for header and borders
+}
+
+// rowHeight returns the number of lines each row takes at the theme's density
+func (t *Table) rowHeight() int {
+	if t.theme.Table.Density == styles.DensityComfortable {
+		return 2
+	}
+	return 1
 }
 
 func (t *Table) ensureVisible() {
@@ -388,6 +780,7 @@ func (t *Table) View() string {
 	}
 
 	var sb strings.Builder
+	t.layout = t.layoutColumns()
 
 	// Render header
 	sb.WriteString(t.renderHeader())
@@ -404,21 +797,30 @@ func (t *Table) View() string {
 	}
 
 	// Render rows
+	emptyRow := strings.Repeat(" ", t.width)
 	for i := 0; i < visible; i++ {
 		rowIdx := t.offset + i
 		if rowIdx >= len(t.filtered) {
 			// Empty row
-			sb.WriteString(strings.Repeat(" ", t.width))
+			sb.WriteString(emptyRow)
+			for j := 1; j < t.rowHeight(); j++ {
+				sb.WriteString("\n" + emptyRow)
+			}
 		} else {
 			actualIdx := t.filtered[rowIdx]
 			isSelected := rowIdx == t.cursor
-			sb.WriteString(t.renderRow(t.rows[actualIdx], isSelected))
+			sb.WriteString(t.renderRow(t.checkedRow(actualIdx), rowIdx, isSelected, t.rowMatches(actualIdx)))
 		}
 		if i < visible-1 {
 			sb.WriteString("\n")
 		}
 	}
 
+	// Keep the table at full height when the rows don't divide it evenly
+	for i := visible * t.rowHeight(); i < t.height-3; i++ {
+		sb.WriteString("\n" + emptyRow)
+	}
+
 	// Status line
 	sb.WriteString("\n")
 	sb.WriteString(t.renderStatus())
@@ -432,11 +834,11 @@ func (t *Table) renderHeader() string {
 	var cells []string
 	totalWidth := 0
 
-	for i, col := range t.columns {
-		title := col.Title
+	for _, col := range t.layout {
+		title := t.columns[col.index].Title
 
 		// Add sort indicator if this column is sorted
-		if i == t.sortColumn {
+		if col.index == t.sortColumn {
 			indicator := "↑"
 			if !t.sortAscending {
 				indicator = "↓"
@@ -445,9 +847,9 @@ func (t *Table) renderHeader() string {
 			title = title + " " + indicator
 		}
 
-		cell := truncateOrPad(title, col.Width)
+		cell := truncateOrPad(title, col.width)
 		cells = append(cells, cell)
-		totalWidth += col.Width + 1
+		totalWidth += col.width + 1
 	}
 
 	// Fill remaining width
@@ -459,35 +861,57 @@ func (t *Table) renderHeader() string {
 }
 
 func (t *Table) renderSeparator() string {
-	sepStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	sepStyle := lipgloss.NewStyle().Foreground(t.theme.Colors.Border)
 
 	var parts []string
-	for _, col := range t.columns {
-		parts = append(parts, strings.Repeat("─", col.Width))
+	for _, col := range t.layout {
+		parts = append(parts, strings.Repeat("─", col.width))
 	}
 
 	return sepStyle.Render(strings.Join(parts, "─"))
 }
 
-func (t *Table) renderRow(row []string, selected bool) string {
+// checkedRow returns a row with its check box, when rows can be marked
+func (t *Table) checkedRow(idx int) []string {
+	row := t.rows[idx]
+	if t.checked == nil || len(row) == 0 || idx >= len(t.resources) {
+		return row
+	}
+	box := "[ ] "
+	if t.checked[t.resources[idx].GetID()] {
+		box = "[x] "
+	}
+	return append([]string{box + row[0]}, row[1:]...)
+}
+
+// renderRow renders a row, highlighting the characters of each cell a fuzzy filter matched
+func (t *Table) renderRow(row []string, rowIdx int, selected bool, matches [][]int) string {
 	var style lipgloss.Style
 	if selected && t.focused {
 		style = t.theme.Table.Selected
+	} else if t.theme.Table.Zebra && rowIdx%2 == 1 {
+		// Stripe by position in the list so stripes don't shift while scrolling
+		style = t.theme.Table.AltRow
 	} else {
 		style = t.theme.Table.Row
 	}
 
+	if len(matches) > 0 {
+		return t.renderHighlightedRow(row, style, matches)
+	}
+
 	var cells []string
 	totalWidth := 0
 
-	for i, col := range t.columns {
+	for _, col := range t.layout {
 		var cellValue string
-		if i < len(row) {
-			cellValue = row[i]
+		if col.index < len(row) {
+			// Rows keep the handler's timestamps so sorting stays chronological
+			cellValue = utils.FormatTimeValue(row[col.index])
 		}
-		cell := truncateOrPad(cellValue, col.Width)
+		cell := truncateOrPad(cellValue, col.width)
 		cells = append(cells, cell)
-		totalWidth += col.Width + 1
+		totalWidth += col.width + 1
 	}
 
 	content := strings.Join(cells, " ")
@@ -497,12 +921,91 @@ func (t *Table) renderRow(row []string, selected bool) string {
 		content += strings.Repeat(" ", t.width-totalWidth)
 	}
 
+	// Comfortable rows carry a spacer line in the row's style, so stripes and the
+	// selection cover the whole row
+	if t.rowHeight() > 1 {
+		content += "\n" + strings.Repeat(" ", t.width)
+	}
+
 	return style.Width(t.width).Render(content)
 }
 
+// renderHighlightedRow renders a row piece by piece in the row's style, so the matched
+// characters can be styled without breaking the row's background
+func (t *Table) renderHighlightedRow(row []string, style lipgloss.Style, matches [][]int) string {
+	highlight := style.Foreground(t.theme.Colors.Accent).Underline(true)
+
+	var sb strings.Builder
+	totalWidth := 0
+	for n, col := range t.layout {
+		if n > 0 {
+			sb.WriteString(style.Render(" "))
+		}
+		var cellValue string
+		if col.index < len(row) {
+			cellValue = utils.FormatTimeValue(row[col.index])
+		}
+		cell := truncateOrPad(cellValue, col.width)
+		totalWidth += col.width + 1
+
+		var positions []int
+		if col.index < len(matches) {
+			positions = matches[col.index]
+		}
+		if len(positions) == 0 {
+			sb.WriteString(style.Render(cell))
+			continue
+		}
+
+		// Runes cut off by the truncation's "..." aren't highlighted
+		shown := utf8.RuneCountInString(cell)
+		if utils.TextWidth(cellValue) > col.width {
+			shown = utf8.RuneCountInString(utils.Truncate(cellValue, col.width))
+			if col.width > 3 {
+				shown -= 3
+			}
+		}
+		matched := make(map[int]bool, len(positions))
+		for _, pos := range positions {
+			if pos < shown {
+				matched[pos] = true
+			}
+		}
+		var run strings.Builder
+		runMatched := false
+		flush := func() {
+			if run.Len() == 0 {
+				return
+			}
+			if runMatched {
+				sb.WriteString(highlight.Render(run.String()))
+			} else {
+				sb.WriteString(style.Render(run.String()))
+			}
+			run.Reset()
+		}
+		for pos, r := range []rune(cell) {
+			if matched[pos] != runMatched {
+				flush()
+				runMatched = matched[pos]
+			}
+			run.WriteRune(r)
+		}
+		flush()
+	}
+
+	if totalWidth < t.width {
+		sb.WriteString(style.Render(strings.Repeat(" ", t.width-totalWidth)))
+	}
+	if t.rowHeight() > 1 {
+		sb.WriteString("\n" + style.Render(strings.Repeat(" ", t.width)))
+	}
+	return lipgloss.NewStyle().Width(t.width).Render(sb.String())
+}
+
 func (t *Table) renderStatus() string {
 	statusStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245"))
+		Foreground(t.theme.Colors.Muted)
 
 	total := len(t.resources)
 	filtered := len(t.filtered)
@@ -514,17 +1017,110 @@ func (t *Table) renderStatus() string {
 	} else {
 		status = fmt.Sprintf(" %d/%d ", current, total)
 	}
+	switch {
+	case t.loadingMore:
+		status += "· loading more… "
+	case t.more:
+		status += "· more load as you scroll "
+	}
+	if t.columnMoved && t.column < len(t.columns) {
+		status += fmt.Sprintf("· column %s ", t.columns[t.column].Title)
+	}
+	switch hidden := len(t.columns) - len(t.layout); {
+	case hidden == 1:
+		status += "· 1 column hidden, widen the terminal to show it "
+	case hidden > 1:
+		status += fmt.Sprintf("· %d columns hidden, widen the terminal to show them ", hidden)
+	}
 
 	return statusStyle.Render(status)
 }
 
-// Helper to truncate or pad a string to a specific width
-func truncateOrPad(s string, width int) string {
-	if len(s) > width {
-		if width <= 3 {
-			return s[:width]
+// headerTitles returns the column titles as shown, with the sort indicator
+func (t *Table) headerTitles() []string {
+	titles := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		titles[i] = col.Title
+		if i == t.sortColumn {
+			if t.sortAscending {
+				titles[i] += " ↑"
+			} else {
+				titles[i] += " ↓"
+			}
+		}
+	}
+	return titles
+}
+
+// shownRows returns the cells of every row passing the filter, in display order,
+// truncated to their column widths
+func (t *Table) shownRows() [][]string {
+	rows := make([][]string, 0, len(t.filtered))
+	for _, idx := range t.filtered {
+		row := t.rows[idx]
+		cells := make([]string, len(t.columns))
+		for i, col := range t.columns {
+			if i < len(row) {
+				cells[i] = strings.TrimRight(truncateOrPad(utils.FormatTimeValue(row[i]), col.Width), " ")
+			}
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
+
+// PlainText renders every filtered row, not just those scrolled into view, as aligned
+// text with the same column widths and truncation as the table
+func (t *Table) PlainText() string {
+	var sb strings.Builder
+
+	cells := make([]string, len(t.columns))
+	separator := make([]string, len(t.columns))
+	for i, title := range t.headerTitles() {
+		cells[i] = truncateOrPad(title, t.columns[i].Width)
+		separator[i] = strings.Repeat("-", t.columns[i].Width)
+	}
+	sb.WriteString(strings.TrimRight(strings.Join(cells, " "), " ") + "\n")
+	sb.WriteString(strings.Join(separator, " ") + "\n")
+
+	for _, row := range t.shownRows() {
+		for i, cell := range row {
+			cells[i] = truncateOrPad(cell, t.columns[i].Width)
 		}
-		return s[:width-3] + "..."
+		sb.WriteString(strings.TrimRight(strings.Join(cells, " "), " ") + "\n")
 	}
-	return s + strings.Repeat(" ", width-len(s))
+
+	return sb.String()
+}
+
+// Markdown renders every filtered row as a Markdown table, with the cells as shown
+func (t *Table) Markdown() string {
+	var sb strings.Builder
+
+	escape := strings.NewReplacer("|", "\\|")
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for _, cell := range cells {
+			sb.WriteString(" " + escape.Replace(cell) + " |")
+		}
+		sb.WriteString("\n")
+	}
+
+	writeRow(t.headerTitles())
+	separator := make([]string, len(t.columns))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeRow(separator)
+
+	for _, row := range t.shownRows() {
+		writeRow(row)
+	}
+
+	return sb.String()
+}
+
+// Helper to truncate or pad a string to a specific width
+func truncateOrPad(s string, width int) string {
+	return utils.TruncateOrPad(s, width)
 }
@@ -0,0 +1,352 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// TagEditorConfirmedMsg is sent when the editor is saved with valid changes
+type TagEditorConfirmedMsg struct {
+	ResourceID   string
+	ResourceName string
+	Changes      handlers.TagChanges
+}
+
+// TagEditorClosedMsg is sent when the editor is cancelled
+type TagEditorClosedMsg struct{}
+
+// tagRow is a key/value pair of the tag editor
+type tagRow struct {
+	key   textinput.Model
+	value textinput.Model
+}
+
+// TagEditor is a key/value form for adding, changing and removing a resource's tags
+type TagEditor struct {
+	theme  styles.Theme
+	active bool
+	width  int
+	height int
+
+	resourceID   string
+	resourceName string
+	before       map[string]string
+
+	rows   []tagRow
+	focus  int // Index into the key and value inputs, two per row
+	offset int // First row shown
+	err    string
+}
+
+// NewTagEditor creates a new tag editor
+func NewTagEditor(theme styles.Theme) *TagEditor {
+	return &TagEditor{theme: theme}
+}
+
+// Show opens the editor with a resource's current tags, ordered by key
+func (e *TagEditor) Show(resourceID, resourceName string, tags map[string]string) tea.Cmd {
+	e.resourceID = resourceID
+	e.resourceName = resourceName
+	e.before = tags
+
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	e.rows = nil
+	for _, key := range keys {
+		e.rows = append(e.rows, e.newRow(key, tags[key]))
+	}
+	if len(e.rows) == 0 {
+		e.rows = append(e.rows, e.newRow("", ""))
+	}
+
+	e.offset = 0
+	e.err = ""
+	e.active = true
+	return e.setFocus(0)
+}
+
+// Hide closes the editor
+func (e *TagEditor) Hide() {
+	e.active = false
+}
+
+// IsActive returns whether the editor is open
+func (e *TagEditor) IsActive() bool {
+	return e.active
+}
+
+// SetTheme sets the theme the editor renders with
+func (e *TagEditor) SetTheme(theme styles.Theme) {
+	e.theme = theme
+}
+
+// SetSize sets the editor dimensions
+func (e *TagEditor) SetSize(width, height int) {
+	e.width = width
+	e.height = height
+	for i := range e.rows {
+		e.sizeRow(&e.rows[i])
+	}
+}
+
+func (e *TagEditor) newRow(key, value string) tagRow {
+	keyInput := textinput.New()
+	keyInput.Placeholder = "key"
+	keyInput.Prompt = ""
+	keyInput.CharLimit = 128
+	keyInput.SetValue(key)
+
+	valueInput := textinput.New()
+	valueInput.Placeholder = "value"
+	valueInput.Prompt = ""
+	valueInput.CharLimit = 256
+	valueInput.SetValue(value)
+
+	row := tagRow{key: keyInput, value: valueInput}
+	e.sizeRow(&row)
+	return row
+}
+
+func (e *TagEditor) sizeRow(row *tagRow) {
+	keyWidth := min(e.width/3, 30)
+	row.key.Width = keyWidth
+	row.value.Width = max(e.width-keyWidth-30, 10)
+}
+
+// visibleRows returns how many rows fit in the editor
+func (e *TagEditor) visibleRows() int {
+	return max(e.height-16, 3)
+}
+
+// setFocus focuses a key or value input, keeping its row within the rows shown
+func (e *TagEditor) setFocus(focus int) tea.Cmd {
+	last := 2*len(e.rows) - 1
+	e.focus = max(min(focus, last), 0)
+
+	for i := range e.rows {
+		e.rows[i].key.Blur()
+		e.rows[i].value.Blur()
+	}
+
+	row := e.focus / 2
+	if row < e.offset {
+		e.offset = row
+	}
+	if row >= e.offset+e.visibleRows() {
+		e.offset = row - e.visibleRows() + 1
+	}
+
+	if e.focus%2 == 0 {
+		return e.rows[row].key.Focus()
+	}
+	return e.rows[row].value.Focus()
+}
+
+// tags collects the edited tags, dropping rows left empty
+func (e *TagEditor) tags() (map[string]string, error) {
+	tags := make(map[string]string)
+	for i, row := range e.rows {
+		key := strings.TrimSpace(row.key.Value())
+		value := row.value.Value()
+		if key == "" && value == "" {
+			continue
+		}
+		if key == "" {
+			return nil, fmt.Errorf("tag %d has a value but no key", i+1)
+		}
+		if _, ok := tags[key]; ok {
+			return nil, fmt.Errorf("duplicate key %q", key)
+		}
+		tags[key] = value
+	}
+	if err := handlers.ValidateTags(tags, e.before); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// Update handles messages
+func (e *TagEditor) Update(msg tea.Msg) (*TagEditor, tea.Cmd) {
+	if !e.active {
+		return e, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return e, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		e.Hide()
+		return e, func() tea.Msg { return TagEditorClosedMsg{} }
+
+	case "enter", "ctrl+s":
+		tags, err := e.tags()
+		if err != nil {
+			e.err = err.Error()
+			return e, nil
+		}
+		changes := handlers.DiffTags(e.before, tags)
+		if changes.Empty() {
+			e.err = "No changes to save"
+			return e, nil
+		}
+		e.Hide()
+		id, name := e.resourceID, e.resourceName
+		return e, func() tea.Msg {
+			return TagEditorConfirmedMsg{ResourceID: id, ResourceName: name, Changes: changes}
+		}
+
+	case "tab", "down":
+		return e, e.setFocus(e.focus + 1)
+
+	case "shift+tab", "up":
+		return e, e.setFocus(e.focus - 1)
+
+	case "ctrl+n":
+		e.rows = append(e.rows, e.newRow("", ""))
+		e.err = ""
+		return e, e.setFocus(2 * (len(e.rows) - 1))
+
+	case "ctrl+d":
+		row := e.focus / 2
+		e.rows = append(e.rows[:row], e.rows[row+1:]...)
+		if len(e.rows) == 0 {
+			e.rows = append(e.rows, e.newRow("", ""))
+		}
+		e.err = ""
+		return e, e.setFocus(e.focus)
+	}
+
+	var cmd tea.Cmd
+	row := &e.rows[e.focus/2]
+	if e.focus%2 == 0 {
+		row.key, cmd = row.key.Update(msg)
+	} else {
+		row.value, cmd = row.value.Update(msg)
+	}
+	e.err = ""
+	return e, cmd
+}
+
+// rowState marks a row as added or changed compared to the resource's tags
+func (e *TagEditor) rowState(row tagRow) string {
+	key := strings.TrimSpace(row.key.Value())
+	if key == "" {
+		return ""
+	}
+	old, ok := e.before[key]
+	switch {
+	case !ok:
+		return "new"
+	case old != row.value.Value():
+		return "changed"
+	}
+	return ""
+}
+
+// removedKeys lists the resource's tags that no row has anymore
+func (e *TagEditor) removedKeys() []string {
+	kept := make(map[string]bool, len(e.rows))
+	for _, row := range e.rows {
+		kept[strings.TrimSpace(row.key.Value())] = true
+	}
+	var removed []string
+	for key := range e.before {
+		if !kept[key] {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// View renders the editor
+func (e *TagEditor) View() string {
+	if !e.active {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(e.theme.Colors.Primary)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Muted)
+	addedStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Success)
+	changedStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Warning)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(e.theme.Colors.Error)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Tags of %s", e.resourceName)))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("%d of %d tags", len(e.rows), handlers.MaxTagsPerResource)))
+	sb.WriteString("\n\n")
+
+	end := min(e.offset+e.visibleRows(), len(e.rows))
+	for i := e.offset; i < end; i++ {
+		row := e.rows[i]
+		cursor := "  "
+		if i == e.focus/2 {
+			cursor = "▸ "
+		}
+		line := cursor + row.key.View() + mutedStyle.Render(" = ") + row.value.View()
+		switch e.rowState(row) {
+		case "new":
+			line += addedStyle.Render("  + new")
+		case "changed":
+			line += changedStyle.Render("  ~ changed")
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	if len(e.rows) > end-e.offset {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  tags %d-%d of %d", e.offset+1, end, len(e.rows))))
+		sb.WriteString("\n")
+	}
+
+	if removed := e.removedKeys(); len(removed) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(errorStyle.Render("  - removed: " + strings.Join(removed, ", ")))
+		sb.WriteString("\n")
+	}
+
+	if e.err != "" {
+		sb.WriteString("\n")
+		sb.WriteString(errorStyle.Render(e.err))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render("tab: next | ctrl+n: add | ctrl+d: delete | enter: save | esc: cancel"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(e.theme.Colors.Primary).
+		Padding(1, 2).
+		Width(e.width - 10).
+		Render(sb.String())
+
+	return lipgloss.Place(
+		e.width,
+		e.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -15,12 +16,79 @@ import (
 
 // TagFilterUpdateMsg is sent when tag filter changes
 type TagFilterUpdateMsg struct {
-	Tags map[string]string // Selected tag filters
+	Tags TagConditions // Selected tag filters
 }
 
 // TagFilterClosedMsg is sent when tag filter is closed
 type TagFilterClosedMsg struct {
-	Tags map[string]string
+	Tags TagConditions
+}
+
+// TagMatch is how a tag filter matches the tag of its key
+type TagMatch int
+
+const (
+	TagEquals    TagMatch = iota // The tag has one of the values
+	TagNotEquals                 // The tag is missing or has none of the values
+	TagAbsent                    // The tag is missing
+)
+
+// TagCondition is the filter on one tag key
+type TagCondition struct {
+	Match  TagMatch
+	Values []string // Any of them matches, as part of the tag's value ignoring case
+}
+
+// TagConditions maps tag keys to their filters, all of which a resource must pass
+type TagConditions map[string]TagCondition
+
+// Matches reports whether a resource's tag, ok being false when it hasn't got it,
+// passes the condition
+func (c TagCondition) Matches(value string, ok bool) bool {
+	switch c.Match {
+	case TagAbsent:
+		return !ok
+	case TagNotEquals:
+		return !ok || !c.hasValue(value)
+	}
+	return ok && c.hasValue(value)
+}
+
+// hasValue reports whether value contains one of the condition's values
+func (c TagCondition) hasValue(value string) bool {
+	value = strings.ToLower(value)
+	for _, v := range c.Values {
+		if strings.Contains(value, strings.ToLower(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// format writes the condition on key as an expression, e.g. env=prod|staging,
+// team!=ops or !owner
+func (c TagCondition) format(key string) string {
+	switch c.Match {
+	case TagAbsent:
+		return "!" + key
+	case TagNotEquals:
+		return key + "!=" + strings.Join(c.Values, "|")
+	}
+	return key + "=" + strings.Join(c.Values, "|")
+}
+
+// String returns the conditions as an expression, ordered by key
+func (c TagConditions) String() string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = c[k].format(k)
+	}
+	return strings.Join(parts, " ")
 }
 
 // TagFilter provides tag-based filtering
@@ -32,7 +100,7 @@ type TagFilter struct {
 	availableTags map[string][]string // key -> []values
 
 	// Current filter state
-	selectedTags map[string]string // key -> value
+	selectedTags TagConditions
 
 	// UI state
 	mode       tagFilterMode
@@ -42,6 +110,10 @@ type TagFilter struct {
 	cursor     int
 	currentKey string
 
+	// Values marked for the current key, and whether the filter excludes them
+	marked map[string]bool
+	negate bool
+
 	// Dimensions
 	width  int
 	height int
@@ -64,7 +136,7 @@ func NewTagFilter(theme styles.Theme) *TagFilter {
 	return &TagFilter{
 		theme:         theme,
 		availableTags: make(map[string][]string),
-		selectedTags:  make(map[string]string),
+		selectedTags:  make(TagConditions),
 		input:         input,
 		mode:          modeSelectKey,
 	}
@@ -114,13 +186,18 @@ func (t *TagFilter) IsActive() bool {
 }
 
 // GetSelectedTags returns the currently selected tag filters
-func (t *TagFilter) GetSelectedTags() map[string]string {
+func (t *TagFilter) GetSelectedTags() TagConditions {
 	return t.selectedTags
 }
 
 // ClearFilters clears all tag filters
 func (t *TagFilter) ClearFilters() {
-	t.selectedTags = make(map[string]string)
+	t.selectedTags = make(TagConditions)
+}
+
+// SetTheme sets the theme the filter renders with
+func (t *TagFilter) SetTheme(theme styles.Theme) {
+	t.theme = theme
 }
 
 // SetSize sets the dimensions
@@ -160,13 +237,24 @@ func (t *TagFilter) handleKeySelection(msg tea.KeyMsg) (*TagFilter, tea.Cmd) {
 
 	case "enter", "l":
 		if len(t.tagKeys) > 0 && t.cursor < len(t.tagKeys) {
-			t.currentKey = t.tagKeys[t.cursor]
-			t.tagValues = t.availableTags[t.currentKey]
-			t.mode = modeSelectValue
-			t.cursor = 0
+			t.selectKey(t.tagKeys[t.cursor])
 		}
 		return t, nil
 
+	case "!":
+		// Toggle filtering for resources without the key
+		if len(t.tagKeys) > 0 && t.cursor < len(t.tagKeys) {
+			key := t.tagKeys[t.cursor]
+			if t.selectedTags[key].Match == TagAbsent {
+				delete(t.selectedTags, key)
+			} else {
+				t.selectedTags[key] = TagCondition{Match: TagAbsent}
+			}
+			return t, func() tea.Msg {
+				return TagFilterUpdateMsg{Tags: t.selectedTags}
+			}
+		}
+
 	case "j", "down":
 		if t.cursor < len(t.tagKeys)-1 {
 			t.cursor++
@@ -181,7 +269,7 @@ func (t *TagFilter) handleKeySelection(msg tea.KeyMsg) (*TagFilter, tea.Cmd) {
 
 	case "c":
 		// Clear all filters
-		t.selectedTags = make(map[string]string)
+		t.selectedTags = make(TagConditions)
 		return t, func() tea.Msg {
 			return TagFilterUpdateMsg{Tags: t.selectedTags}
 		}
@@ -208,17 +296,19 @@ func (t *TagFilter) handleValueSelection(msg tea.KeyMsg) (*TagFilter, tea.Cmd) {
 		return t, nil
 
 	case "enter", "l":
+		return t, t.applyValues()
+
+	case " ":
 		if len(t.tagValues) > 0 && t.cursor < len(t.tagValues) {
 			value := t.tagValues[t.cursor]
-			t.selectedTags[t.currentKey] = value
-			t.mode = modeSelectKey
-			t.cursor = 0
-			return t, func() tea.Msg {
-				return TagFilterUpdateMsg{Tags: t.selectedTags}
-			}
+			t.marked[value] = !t.marked[value]
 		}
 		return t, nil
 
+	case "!":
+		t.negate = !t.negate
+		return t, nil
+
 	case "j", "down":
 		if t.cursor < len(t.tagValues)-1 {
 			t.cursor++
@@ -250,16 +340,19 @@ func (t *TagFilter) handleInput(msg tea.KeyMsg) (*TagFilter, tea.Cmd) {
 		return t, nil
 
 	case "enter":
-		value := t.input.Value()
-		if value != "" {
-			t.selectedTags[t.currentKey] = value
-		}
-		t.mode = modeSelectKey
-		t.cursor = 0
 		t.input.Blur()
-		return t, func() tea.Msg {
-			return TagFilterUpdateMsg{Tags: t.selectedTags}
+		if value := t.input.Value(); value != "" {
+			if !slices.Contains(t.tagValues, value) {
+				t.tagValues = append(t.tagValues, value)
+			}
+			t.marked[value] = true
+		}
+		if !t.hasMarks() {
+			// Nothing to filter on, the value under the cursor isn't what was asked for
+			t.mode = modeSelectValue
+			return t, nil
 		}
+		return t, t.applyValues()
 	}
 
 	var cmd tea.Cmd
@@ -267,6 +360,64 @@ func (t *TagFilter) handleInput(msg tea.KeyMsg) (*TagFilter, tea.Cmd) {
 	return t, cmd
 }
 
+// selectKey lists the values of key, marked as its filter has them
+func (t *TagFilter) selectKey(key string) {
+	t.currentKey = key
+	t.tagValues = slices.Clone(t.availableTags[key])
+	t.marked = make(map[string]bool)
+	t.negate = false
+	if cond, ok := t.selectedTags[key]; ok && cond.Match != TagAbsent {
+		t.negate = cond.Match == TagNotEquals
+		for _, v := range cond.Values {
+			// Custom values aren't among the tags, list them so they can be unmarked
+			if !slices.Contains(t.tagValues, v) {
+				t.tagValues = append(t.tagValues, v)
+			}
+			t.marked[v] = true
+		}
+	}
+	t.mode = modeSelectValue
+	t.cursor = 0
+}
+
+// hasMarks reports whether any value of the current key is marked
+func (t *TagFilter) hasMarks() bool {
+	for _, marked := range t.marked {
+		if marked {
+			return true
+		}
+	}
+	return false
+}
+
+// applyValues sets the filter of the current key to the marked values, or the value
+// under the cursor when none is marked, and goes back to the keys
+func (t *TagFilter) applyValues() tea.Cmd {
+	var values []string
+	for _, v := range t.tagValues {
+		if t.marked[v] {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		if t.cursor >= len(t.tagValues) {
+			return nil
+		}
+		values = []string{t.tagValues[t.cursor]}
+	}
+
+	match := TagEquals
+	if t.negate {
+		match = TagNotEquals
+	}
+	t.selectedTags[t.currentKey] = TagCondition{Match: match, Values: values}
+	t.mode = modeSelectKey
+	t.cursor = max(slices.Index(t.tagKeys, t.currentKey), 0)
+	return func() tea.Msg {
+		return TagFilterUpdateMsg{Tags: t.selectedTags}
+	}
+}
+
 // View renders the tag filter
 func (t *TagFilter) View() string {
 	if !t.active {
@@ -275,7 +426,7 @@ func (t *TagFilter) View() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("212")).
+		Foreground(t.theme.Colors.Accent).
 		MarginBottom(1)
 
 	boxStyle := lipgloss.NewStyle().
@@ -289,10 +440,10 @@ func (t *TagFilter) View() string {
 		Foreground(lipgloss.Color("230"))
 
 	normalStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252"))
+		Foreground(t.theme.Colors.Foreground)
 
 	dimStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245"))
+		Foreground(t.theme.Colors.Muted)
 
 	activeFilterStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("114"))
@@ -308,11 +459,8 @@ func (t *TagFilter) View() string {
 		if len(t.selectedTags) > 0 {
 			content.WriteString(dimStyle.Render("Active filters:"))
 			content.WriteString("\n")
-			for k, v := range t.selectedTags {
-				content.WriteString(activeFilterStyle.Render(fmt.Sprintf("  %s = %s", k, v)))
-				content.WriteString("\n")
-			}
-			content.WriteString("\n")
+			content.WriteString(activeFilterStyle.Render("  " + t.selectedTags.String()))
+			content.WriteString("\n\n")
 		}
 
 		content.WriteString(dimStyle.Render("Select tag key:"))
@@ -331,8 +479,8 @@ func (t *TagFilter) View() string {
 
 				// Show if this key has an active filter
 				suffix := ""
-				if val, ok := t.selectedTags[key]; ok {
-					suffix = dimStyle.Render(fmt.Sprintf(" [=%s]", val))
+				if cond, ok := t.selectedTags[key]; ok {
+					suffix = dimStyle.Render(fmt.Sprintf(" [%s]", cond.format(key)))
 				}
 
 				content.WriteString(prefix + style.Render(key) + suffix + "\n")
@@ -340,12 +488,16 @@ func (t *TagFilter) View() string {
 		}
 
 		content.WriteString("\n")
-		content.WriteString(dimStyle.Render("enter:select  c:clear all  x:remove  esc:close"))
+		content.WriteString(dimStyle.Render("enter:select  !:absent  c:clear all  x:remove  esc:close"))
 
 	case modeSelectValue:
-		content.WriteString(titleStyle.Render(fmt.Sprintf("Filter: %s", t.currentKey)))
+		operator := "="
+		if t.negate {
+			operator = "!="
+		}
+		content.WriteString(titleStyle.Render(fmt.Sprintf("Filter: %s %s", t.currentKey, operator)))
 		content.WriteString("\n")
-		content.WriteString(dimStyle.Render("Select value:"))
+		content.WriteString(dimStyle.Render("Select values, any of them matches:"))
 		content.WriteString("\n")
 
 		for i, val := range t.tagValues {
@@ -355,11 +507,15 @@ func (t *TagFilter) View() string {
 				prefix = "> "
 				style = selectedStyle
 			}
-			content.WriteString(prefix + style.Render(val) + "\n")
+			mark := "[ ] "
+			if t.marked[val] {
+				mark = "[x] "
+			}
+			content.WriteString(prefix + mark + style.Render(val) + "\n")
 		}
 
 		content.WriteString("\n")
-		content.WriteString(dimStyle.Render("enter:select  /:custom value  esc:back"))
+		content.WriteString(dimStyle.Render("space:mark  !:negate  enter:apply  /:custom value  esc:back"))
 
 	case modeInput:
 		content.WriteString(titleStyle.Render(fmt.Sprintf("Filter: %s", t.currentKey)))
@@ -383,7 +539,7 @@ func (t *TagFilter) View() string {
 }
 
 // FilterResources filters resources based on selected tags
-func FilterByTags(resources []handlers.Resource, tags map[string]string) []handlers.Resource {
+func FilterByTags(resources []handlers.Resource, tags TagConditions) []handlers.Resource {
 	if len(tags) == 0 {
 		return resources
 	}
@@ -393,9 +549,9 @@ func FilterByTags(resources []handlers.Resource, tags map[string]string) []handl
 		resTags := res.GetTags()
 		matches := true
 
-		for filterKey, filterVal := range tags {
+		for filterKey, cond := range tags {
 			resVal, ok := resTags[filterKey]
-			if !ok || !strings.Contains(strings.ToLower(resVal), strings.ToLower(filterVal)) {
+			if !cond.Matches(resVal, ok) {
 				matches = false
 				break
 			}
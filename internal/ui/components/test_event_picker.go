@@ -0,0 +1,388 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// TestEventInvokeMsg is sent when a payload is picked to invoke a function with
+type TestEventInvokeMsg struct {
+	Function  string
+	EventName string
+	Payload   string
+}
+
+// TestEventSaveMsg is sent when an edited event is saved
+type TestEventSaveMsg struct {
+	Function string
+	Name     string
+	Payload  string
+	Shared   bool
+}
+
+// TestEventPickerClosedMsg is sent when the picker is closed without invoking
+type TestEventPickerClosedMsg struct{}
+
+// TestEventPicker lists the saved test events and templates of a function and edits them
+type TestEventPicker struct {
+	theme  styles.Theme
+	active bool
+	width  int
+	height int
+
+	function  string
+	events    []config.TestEvent // Saved events, then templates
+	hasShared bool
+	cursor    int
+	offset    int
+
+	// Edit stage
+	editing   bool
+	nameInput textinput.Model
+	payload   textarea.Model
+	shared    bool
+	err       string
+}
+
+// NewTestEventPicker creates a new test event picker
+func NewTestEventPicker(theme styles.Theme) *TestEventPicker {
+	ni := textinput.New()
+	ni.Placeholder = "event-name"
+	ni.CharLimit = 64
+	ni.Width = 40
+
+	ta := textarea.New()
+	ta.CharLimit = 0
+	ta.ShowLineNumbers = false
+	// Disable paste to avoid clipboard tool requirement
+	ta.KeyMap.Paste.SetEnabled(false)
+
+	return &TestEventPicker{
+		theme:     theme,
+		nameInput: ni,
+		payload:   ta,
+	}
+}
+
+// Show opens the picker for a function with its saved events. Templates are listed after them.
+func (p *TestEventPicker) Show(function string, saved []config.TestEvent, hasShared bool) {
+	p.function = function
+	p.events = append(append([]config.TestEvent{}, saved...), config.TestEventTemplates()...)
+	p.hasShared = hasShared
+	p.cursor = 0
+	p.offset = 0
+	p.editing = false
+	p.err = ""
+	p.active = true
+}
+
+// Select moves the cursor to the saved event with the given name, if present
+func (p *TestEventPicker) Select(name string) {
+	for i, event := range p.events {
+		if event.Name == name && !event.Template {
+			p.cursor = i
+			p.scrollToCursor()
+			return
+		}
+	}
+}
+
+// Hide closes the picker
+func (p *TestEventPicker) Hide() {
+	p.active = false
+	p.editing = false
+	p.nameInput.Blur()
+	p.payload.Blur()
+}
+
+// IsActive returns whether the picker is open
+func (p *TestEventPicker) IsActive() bool {
+	return p.active
+}
+
+// SetError shows an error below the editor, e.g. when saving failed
+func (p *TestEventPicker) SetError(err string) {
+	p.err = err
+}
+
+// SetTheme sets the theme the picker renders with
+func (p *TestEventPicker) SetTheme(theme styles.Theme) {
+	p.theme = theme
+}
+
+// SetSize sets the picker dimensions
+func (p *TestEventPicker) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+	p.payload.SetWidth(max(width-20, 20))
+	p.payload.SetHeight(max(height-18, 5))
+}
+
+func (p *TestEventPicker) maxVisible() int {
+	return max(p.height-14, 5)
+}
+
+func (p *TestEventPicker) scrollToCursor() {
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	}
+	if p.cursor >= p.offset+p.maxVisible() {
+		p.offset = p.cursor - p.maxVisible() + 1
+	}
+}
+
+// edit opens the editor on an event, templates start without a name so they aren't saved over
+func (p *TestEventPicker) edit(event config.TestEvent) tea.Cmd {
+	p.editing = true
+	p.err = ""
+	p.shared = event.Shared
+
+	name := event.Name
+	if event.Template {
+		name = ""
+	}
+	p.nameInput.SetValue(name)
+	p.payload.SetValue(event.Payload)
+
+	if name == "" {
+		p.payload.Blur()
+		return p.nameInput.Focus()
+	}
+	p.nameInput.Blur()
+	return p.payload.Focus()
+}
+
+// editedPayload returns the payload being edited, or an error if it isn't valid JSON
+func (p *TestEventPicker) editedPayload() (string, error) {
+	value := p.payload.Value()
+	if !json.Valid([]byte(value)) {
+		return "", fmt.Errorf("payload is not valid JSON")
+	}
+	return value, nil
+}
+
+// Update handles messages
+func (p *TestEventPicker) Update(msg tea.Msg) (*TestEventPicker, tea.Cmd) {
+	if !p.active {
+		return p, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return p, nil
+	}
+
+	if p.editing {
+		return p.updateEditor(keyMsg)
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q":
+		p.Hide()
+		return p, func() tea.Msg { return TestEventPickerClosedMsg{} }
+
+	case "up", "k":
+		if p.cursor > 0 {
+			p.cursor--
+			p.scrollToCursor()
+		}
+
+	case "down", "j":
+		if p.cursor < len(p.events)-1 {
+			p.cursor++
+			p.scrollToCursor()
+		}
+
+	case "enter":
+		if p.cursor < len(p.events) {
+			event := p.events[p.cursor]
+			function := p.function
+			p.Hide()
+			return p, func() tea.Msg {
+				return TestEventInvokeMsg{Function: function, EventName: event.Name, Payload: event.Payload}
+			}
+		}
+
+	case "e":
+		if p.cursor < len(p.events) {
+			return p, p.edit(p.events[p.cursor])
+		}
+	}
+
+	return p, nil
+}
+
+// updateEditor handles keys while an event is being edited
+func (p *TestEventPicker) updateEditor(msg tea.KeyMsg) (*TestEventPicker, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		p.editing = false
+		p.err = ""
+		p.nameInput.Blur()
+		p.payload.Blur()
+		return p, nil
+
+	case "tab":
+		if p.nameInput.Focused() {
+			p.nameInput.Blur()
+			return p, p.payload.Focus()
+		}
+		p.payload.Blur()
+		return p, p.nameInput.Focus()
+
+	case "ctrl+t":
+		if p.hasShared {
+			p.shared = !p.shared
+		}
+		return p, nil
+
+	case "ctrl+s":
+		payload, err := p.editedPayload()
+		if err != nil {
+			p.err = err.Error()
+			return p, nil
+		}
+		name := strings.TrimSpace(p.nameInput.Value())
+		if name == "" {
+			p.err = "name the event to save it"
+			return p, nil
+		}
+		save := TestEventSaveMsg{Function: p.function, Name: name, Payload: payload, Shared: p.shared}
+		return p, func() tea.Msg { return save }
+
+	case "ctrl+r":
+		payload, err := p.editedPayload()
+		if err != nil {
+			p.err = err.Error()
+			return p, nil
+		}
+		invoke := TestEventInvokeMsg{Function: p.function, EventName: strings.TrimSpace(p.nameInput.Value()), Payload: payload}
+		p.Hide()
+		return p, func() tea.Msg { return invoke }
+	}
+
+	var cmd tea.Cmd
+	if p.nameInput.Focused() {
+		p.nameInput, cmd = p.nameInput.Update(msg)
+	} else {
+		p.payload, cmd = p.payload.Update(msg)
+	}
+	return p, cmd
+}
+
+// View renders the picker
+func (p *TestEventPicker) View() string {
+	if !p.active {
+		return ""
+	}
+
+	var content string
+	if p.editing {
+		content = p.editorView()
+	} else {
+		content = p.listView()
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(p.theme.Colors.Primary).
+		Padding(1, 2).
+		Width(p.width - 10).
+		Render(content)
+
+	return lipgloss.Place(
+		p.width,
+		p.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
+
+func (p *TestEventPicker) listView() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(p.theme.Colors.Primary)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Foreground).
+		Background(p.theme.Colors.Secondary).
+		Bold(true)
+	normalStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Foreground)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Test events for %s", p.function)))
+	sb.WriteString("\n\n")
+
+	end := min(p.offset+p.maxVisible(), len(p.events))
+	for i := p.offset; i < end; i++ {
+		event := p.events[i]
+
+		// Show the first line of the payload to tell similar events apart
+		preview := styles.Truncate(strings.Join(strings.Fields(event.Payload), " "), 50)
+
+		line := fmt.Sprintf("%s %-9s %s", styles.PadRight(event.Name, 30), event.Source(), preview)
+		if i == p.cursor {
+			sb.WriteString(selectedStyle.Render(line))
+		} else {
+			sb.WriteString(normalStyle.Render(line))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render("enter: invoke | e: edit / save as | esc: cancel"))
+	return sb.String()
+}
+
+func (p *TestEventPicker) editorView() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(p.theme.Colors.Primary)
+	labelStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Foreground)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Muted)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(p.theme.Colors.Error)
+
+	destination := "local"
+	if p.shared {
+		destination = "shared"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Edit test event for %s", p.function)))
+	sb.WriteString("\n\n")
+	sb.WriteString(labelStyle.Render("Name: "))
+	sb.WriteString(p.nameInput.View())
+	sb.WriteString(mutedStyle.Render("  saves to " + destination))
+	sb.WriteString("\n\n")
+	sb.WriteString(p.payload.View())
+	sb.WriteString("\n")
+
+	if p.err != "" {
+		sb.WriteString("\n")
+		sb.WriteString(errorStyle.Render(p.err))
+		sb.WriteString("\n")
+	}
+
+	help := "ctrl+s: save | ctrl+r: invoke | tab: switch field | esc: back"
+	if p.hasShared {
+		help = "ctrl+s: save | ctrl+t: local/shared | ctrl+r: invoke | tab: switch field | esc: back"
+	}
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(help))
+	return sb.String()
+}
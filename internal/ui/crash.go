@@ -0,0 +1,200 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// crashHistorySize is how many of the last messages a crash report lists
+const crashHistorySize = 20
+
+func (s AppState) String() string {
+	switch s {
+	case StateHome:
+		return "Home"
+	case StateResourceList:
+		return "ResourceList"
+	case StateResourceDetail:
+		return "ResourceDetail"
+	case StateSecretEditor:
+		return "SecretEditor"
+	case StateSecretCreator:
+		return "SecretCreator"
+	}
+	return fmt.Sprintf("AppState(%d)", int(s))
+}
+
+func (m Mode) String() string {
+	switch m {
+	case ModeNormal:
+		return "Normal"
+	case ModeSearch:
+		return "Search"
+	case ModeCommand:
+		return "Command"
+	case ModeConfirm:
+		return "Confirm"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// CrashGuard wraps the app so a panic writes a crash report before Bubbletea restores
+// the terminal. Panics in Update, View and commands are recorded and then re-raised,
+// leaving the shutdown itself to Bubbletea.
+type CrashGuard struct {
+	app *App
+	dir string
+
+	mu       sync.Mutex
+	recent   []string // Last messages, oldest first
+	state    string   // State when the last message was handled
+	report   string   // Path of the written report
+	reported bool
+}
+
+// NewCrashGuard creates a guard writing crash reports to dir
+func NewCrashGuard(app *App, dir string) *CrashGuard {
+	return &CrashGuard{app: app, dir: dir}
+}
+
+// ReportPath returns the path of the crash report, empty if there was no crash or
+// the report couldn't be written
+func (g *CrashGuard) ReportPath() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.report
+}
+
+func (g *CrashGuard) Init() tea.Cmd {
+	defer g.recover("Init")
+	cmd := g.app.Init()
+	g.snapshot()
+	return g.guardCmd(cmd)
+}
+
+func (g *CrashGuard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	g.record(msg)
+	defer g.recover("Update")
+
+	_, cmd := g.app.Update(msg)
+	g.snapshot()
+	return g, g.guardCmd(cmd)
+}
+
+func (g *CrashGuard) View() string {
+	defer g.recover("View")
+	return g.app.View()
+}
+
+// guardCmd wraps a command, and those of a batch it returns, so a panic in it is reported
+func (g *CrashGuard) guardCmd(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		defer g.recover("command")
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			guarded := make(tea.BatchMsg, len(batch))
+			for i, c := range batch {
+				guarded[i] = g.guardCmd(c)
+			}
+			return guarded
+		}
+		return msg
+	}
+}
+
+// record remembers a message for the report. Only its type is kept, plus the name of
+// special keys for key presses, so no resource data or secret ends up in the file.
+func (g *CrashGuard) record(msg tea.Msg) {
+	entry := fmt.Sprintf("%s %T", time.Now().Format("15:04:05.000"), msg)
+	if key, ok := msg.(tea.KeyMsg); ok {
+		entry += " " + crashKeyName(key)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.recent = append(g.recent, entry)
+	if len(g.recent) > crashHistorySize {
+		g.recent = g.recent[len(g.recent)-crashHistorySize:]
+	}
+}
+
+// crashKeyName names a key press for the report. Typed characters and pastes could be
+// a secret, an MFA code or a typed confirmation, so only their length is kept.
+func crashKeyName(key tea.KeyMsg) string {
+	switch {
+	case key.Paste:
+		return fmt.Sprintf("<paste len=%d>", len(key.Runes))
+	case key.Type == tea.KeyRunes || key.Type == tea.KeySpace:
+		name := fmt.Sprintf("<runes len=%d>", max(len(key.Runes), 1))
+		if key.Alt {
+			name = "alt+" + name
+		}
+		return name
+	default:
+		return key.String()
+	}
+}
+
+// snapshot keeps the app's state, so a panic in a command doesn't read it while Update runs
+func (g *CrashGuard) snapshot() {
+	resourceType := "-"
+	if handler := g.app.resourceList.Handler(); handler != nil {
+		resourceType = handler.ResourceType()
+	}
+	profile, region := g.app.clientMgr.GetCurrentContext()
+	state := fmt.Sprintf("state=%s mode=%s resource=%s profile=%s region=%s",
+		g.app.state, g.app.mode, resourceType, profile, region)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.state = state
+}
+
+// recover writes a report for a panic and re-raises it
+func (g *CrashGuard) recover(where string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	g.writeReport(where, r, debug.Stack())
+	panic(r)
+}
+
+// writeReport writes the report for the first panic, later ones are a consequence of it
+func (g *CrashGuard) writeReport(where string, r interface{}, stack []byte) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.reported {
+		return
+	}
+	g.reported = true
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "aws-tui crash report, %s\n\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Panic in %s: %v\n\n", where, r)
+	fmt.Fprintf(&b, "Last state: %s\n\n", g.state)
+	fmt.Fprintf(&b, "Last %d messages, oldest first:\n", len(g.recent))
+	for _, entry := range g.recent {
+		fmt.Fprintf(&b, "  %s\n", entry)
+	}
+	fmt.Fprintf(&b, "\nStack:\n%s", stack)
+
+	if err := os.MkdirAll(g.dir, 0755); err != nil {
+		return
+	}
+	path := filepath.Join(g.dir, fmt.Sprintf("crash-%s.log", time.Now().Format("20060102-150405")))
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return
+	}
+	g.report = path
+}
@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/components"
+	"github.com/aaw-tui/aws-tui/internal/ui/views"
+)
+
+// maxExpiredRetries is how many operations that failed on expired credentials are kept to
+// run again once they are back
+const maxExpiredRetries = 10
+
+// attemptMsg carries a message with the command that returned it, so the command can be
+// run again if the message reports that the credentials expired
+type attemptMsg struct {
+	cmd tea.Cmd
+	msg tea.Msg
+}
+
+// expiredCredentials is the prompt to log in again, shown once an operation failed on
+// expired credentials, with the operations to retry afterwards
+type expiredCredentials struct {
+	profile string
+	sso     bool
+	retries []expiredRetry
+}
+
+// expiredRetry is an operation to run again once the credentials are back
+type expiredRetry struct {
+	run   func() tea.Cmd
+	write bool // May change something: it failed while writes were allowed and isn't a load
+}
+
+// trackAttempts wraps the app's own messages among the results of cmd in attemptMsgs,
+// leaving Bubble Tea's and those of other packages as they are
+func trackAttempts(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			tracked := make(tea.BatchMsg, len(batch))
+			for i, c := range batch {
+				tracked[i] = trackAttempts(c)
+			}
+			return tracked
+		}
+		if !ownMsg(msg) {
+			return msg
+		}
+		return attemptMsg{cmd: cmd, msg: msg}
+	}
+}
+
+// ownMsg reports whether a message is one of this module's types
+func ownMsg(msg tea.Msg) bool {
+	t := reflect.TypeOf(msg)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return strings.HasPrefix(t.PkgPath(), "github.com/aaw-tui/aws-tui/")
+}
+
+// noteExpiredCredentials turns the error an attempt just showed into the prompt to log in
+// again if it says the credentials expired, keeping the attempt to retry afterwards
+func (a *App) noteExpiredCredentials(msg tea.Msg, attempt tea.Cmd) {
+	errs := a.footer.Errors()
+	if len(errs) == 0 || !components.CredentialsExpired(errs[len(errs)-1]) {
+		return
+	}
+
+	profile := a.clientMgr.Profile()
+	retry := expiredRetry{run: func() tea.Cmd { return trackAttempts(attempt) }, write: !a.readOnly}
+	switch m := msg.(type) {
+	case awsInitializedMsg:
+		profile = m.profile
+		retry.write = false
+	case profileSwitchedMsg:
+		// The switch that failed is over, so a new one starts
+		profile = m.sw.profile
+		retry = expiredRetry{run: func() tea.Cmd { return a.switchProfile(profile) }}
+	case views.PaneMsg:
+		// A list only takes the result of its latest load, so it loads again instead
+		switch m.Msg.(type) {
+		case views.ResourcesLoadedMsg:
+			retry = expiredRetry{run: func() tea.Cmd { return m.List.Tag(m.List.Refresh()) }}
+		case views.ResourceDetailLoadedMsg:
+			retry.write = false
+		}
+	}
+
+	if a.expired == nil || a.expired.profile != profile {
+		a.expired = &expiredCredentials{profile: profile, sso: a.isSSOProfile(profile)}
+	}
+	if len(a.expired.retries) < maxExpiredRetries {
+		a.expired.retries = append(a.expired.retries, retry)
+	}
+	if a.expired.sso {
+		a.footer.RewordMessage(fmt.Sprintf("SSO session of %s expired — press L to log in again and retry", profile))
+	} else {
+		a.footer.RewordMessage(fmt.Sprintf("Credentials of %s expired — press L to reload them and retry", profile))
+	}
+}
+
+// isSSOProfile reports whether a profile gets its credentials from an SSO session
+func (a *App) isSSOProfile(profile string) bool {
+	for _, p := range a.profiles {
+		if p.Name == profile {
+			return p.IsSSO
+		}
+	}
+	return false
+}
+
+// reloginExpired handles L after credentials expired: it logs in to the SSO session again,
+// or reloads the credentials of other profiles, and retries what failed
+func (a *App) reloginExpired() tea.Cmd {
+	if a.expired.sso {
+		return a.ssoLogin(a.expired.profile)
+	}
+	a.footer.SetMessage(fmt.Sprintf("Reloading the credentials of %s and retrying", a.expired.profile), false)
+	return a.retryExpired()
+}
+
+// retryExpired drops the cached credentials and runs again the operations that failed on
+// expired ones. Those that may change something are dropped if read-only mode came on
+// meanwhile, through :ro, the end of an elevation or a read-only session policy.
+func (a *App) retryExpired() tea.Cmd {
+	expired := a.expired
+	a.expired = nil
+	a.clientMgr.InvalidateCredentials()
+
+	cmds := make([]tea.Cmd, 0, len(expired.retries)+1)
+	cmds = append(cmds, a.loadSessionExpiry())
+	dropped := 0
+	for _, retry := range expired.retries {
+		if retry.write && a.readOnly {
+			dropped++
+			continue
+		}
+		cmds = append(cmds, retry.run())
+	}
+	if dropped > 0 {
+		a.footer.SetMessage(fmt.Sprintf("Not retrying %d operation(s) that may change something, read-only mode is on", dropped), true)
+	}
+	return tea.Batch(cmds...)
+}
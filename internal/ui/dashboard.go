@@ -0,0 +1,232 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	cwadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/cloudwatch"
+	cpadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/codepipeline"
+	"github.com/aaw-tui/aws-tui/internal/app"
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/views"
+	"github.com/aaw-tui/aws-tui/internal/utils"
+)
+
+// dashboardMaxColumns is how many of a resource list's columns a widget shows
+const dashboardMaxColumns = 4
+
+// dashboardMaxItems caps the resources a widget lists, it only shows a screenful
+const dashboardMaxItems = 200
+
+// openDashboard opens a dashboard from the config by name. Without a name the only
+// dashboard opens, or the names are listed when there are several.
+func (a *App) openDashboard(name string) (tea.Model, tea.Cmd) {
+	names := a.config.DashboardNames()
+	if len(names) == 0 {
+		a.footer.SetMessage("No dashboards configured, add them under dashboards in the config", true)
+		return a, nil
+	}
+	if name == "" {
+		if len(names) > 1 {
+			a.footer.SetMessage("Usage: :dashboard <name>, one of "+strings.Join(names, ", "), true)
+			return a, nil
+		}
+		name = names[0]
+	}
+
+	dashboard, ok := a.config.FindDashboard(name)
+	if !ok {
+		a.footer.SetMessage(fmt.Sprintf("Unknown dashboard %q, use one of %s", name, strings.Join(names, ", ")), true)
+		return a, nil
+	}
+	widgets, err := a.dashboardWidgets(dashboard)
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Invalid dashboard: %v", err), true)
+		return a, nil
+	}
+	if len(widgets) == 0 {
+		a.footer.SetMessage(fmt.Sprintf("Dashboard %s has no widgets", dashboard.Name), true)
+		return a, nil
+	}
+
+	a.dashboard.SetSize(a.width, a.height)
+	return a, a.dashboard.Show(dashboard.Name, dashboard.GridColumns(), widgets)
+}
+
+// dashboardWidgets builds the widgets of a dashboard. Clients are looked up on each
+// refresh, so a dashboard follows profile and region switches.
+func (a *App) dashboardWidgets(dashboard *app.Dashboard) ([]views.DashboardWidget, error) {
+	widgets := make([]views.DashboardWidget, 0, len(dashboard.Widgets))
+	for i := range dashboard.Widgets {
+		w := dashboard.Widgets[i]
+		if err := w.Validate(); err != nil {
+			return nil, fmt.Errorf("widget %d of %s: %w", i+1, dashboard.Name, err)
+		}
+
+		var fetch func(ctx context.Context) (*views.WidgetContent, error)
+		switch w.Type {
+		case app.WidgetResources:
+			fetch = a.resourcesWidget(w)
+		case app.WidgetServices:
+			fetch = a.servicesWidget(w)
+		case app.WidgetAlarms:
+			fetch = a.alarmsWidget(w)
+		case app.WidgetMetric:
+			fetch = a.metricWidget(w)
+		case app.WidgetPipeline:
+			fetch = a.pipelineWidget(w)
+		}
+
+		widgets = append(widgets, views.DashboardWidget{
+			Title:   w.DisplayTitle(),
+			Refresh: time.Duration(w.RefreshSeconds()) * time.Second,
+			Fetch:   fetch,
+		})
+	}
+	return widgets, nil
+}
+
+// resourcesWidget lists any registered resource type, as its list would show it
+func (a *App) resourcesWidget(w app.Widget) func(ctx context.Context) (*views.WidgetContent, error) {
+	return func(ctx context.Context) (*views.WidgetContent, error) {
+		handler, ok := a.registry.Get(w.Resource)
+		if !ok {
+			return nil, fmt.Errorf("unknown resource %q", w.Resource)
+		}
+		return handlerWidgetContent(ctx, handler, w.Filter)
+	}
+}
+
+// servicesWidget lists the ECS services of a cluster through the services handler
+func (a *App) servicesWidget(w app.Widget) func(ctx context.Context) (*views.WidgetContent, error) {
+	return func(ctx context.Context) (*views.WidgetContent, error) {
+		handler := handlers.NewECSServicesHandlerForCluster(a.clientMgr.ECS(), a.clientMgr.Region(), w.Cluster, w.Cluster)
+		return handlerWidgetContent(ctx, handler, w.Filter)
+	}
+}
+
+func handlerWidgetContent(ctx context.Context, handler handlers.ResourceHandler, filter string) (*views.WidgetContent, error) {
+	result, err := handler.List(ctx, handlers.ListOptions{Filter: filter, MaxItems: dashboardMaxItems})
+	if err != nil {
+		return nil, err
+	}
+
+	content := &views.WidgetContent{Empty: "No " + handler.ResourceName()}
+	for i, column := range handler.Columns() {
+		if i == dashboardMaxColumns {
+			break
+		}
+		content.Columns = append(content.Columns, column.Title)
+	}
+	for _, resource := range result.Resources {
+		row := resource.ToTableRow()
+		if len(row) > dashboardMaxColumns {
+			row = row[:dashboardMaxColumns]
+		}
+		content.Rows = append(content.Rows, row)
+	}
+	return content, nil
+}
+
+// alarmsWidget lists the alarms in a state, most recently changed first
+func (a *App) alarmsWidget(w app.Widget) func(ctx context.Context) (*views.WidgetContent, error) {
+	return func(ctx context.Context) (*views.WidgetContent, error) {
+		alarms, err := cwadapter.NewAlarmsClient(a.clientMgr.CloudWatch()).ListAlarms(ctx, w.AlarmState())
+		if err != nil {
+			return nil, err
+		}
+
+		content := &views.WidgetContent{
+			Columns: []string{"Alarm", "State", "Since"},
+			Empty:   "No alarms",
+		}
+		if state := w.AlarmState(); state != "" {
+			content.Empty = fmt.Sprintf("No alarms in %s", state)
+		}
+		now := time.Now()
+		for _, alarm := range alarms {
+			content.Rows = append(content.Rows, []string{alarm.Name, alarm.State, utils.RelativeTime(alarm.UpdatedTime, now)})
+		}
+		return content, nil
+	}
+}
+
+// metricWidget plots a metric statistic over the last hours as a sparkline
+func (a *App) metricWidget(w app.Widget) func(ctx context.Context) (*views.WidgetContent, error) {
+	return func(ctx context.Context) (*views.WidgetContent, error) {
+		end := time.Now()
+		points, err := cwadapter.NewMetricsClient(a.clientMgr.CloudWatch()).GetSeries(ctx, cwadapter.MetricQuery{
+			Namespace:  w.Namespace,
+			MetricName: w.Metric,
+			Dimensions: w.Dimensions,
+			Stat:       w.MetricStat(),
+			Period:     time.Duration(w.MetricPeriod()) * time.Second,
+			Start:      end.Add(-time.Duration(w.MetricHours()) * time.Hour),
+			End:        end,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		content := &views.WidgetContent{
+			Series: make([]float64, 0, len(points)),
+			Empty:  fmt.Sprintf("No datapoints in the last %dh", w.MetricHours()),
+		}
+		if len(points) == 0 {
+			return content, nil
+		}
+
+		low, high := points[0].Value, points[0].Value
+		for _, point := range points {
+			content.Series = append(content.Series, point.Value)
+			low = min(low, point.Value)
+			high = max(high, point.Value)
+		}
+		latest := points[len(points)-1]
+		content.Summary = fmt.Sprintf("latest %s (%s)  min %s  max %s  last %dh",
+			formatMetricValue(latest.Value), utils.RelativeTime(latest.Timestamp, end),
+			formatMetricValue(low), formatMetricValue(high), w.MetricHours())
+		return content, nil
+	}
+}
+
+// pipelineWidget shows each stage of a pipeline's latest execution
+func (a *App) pipelineWidget(w app.Widget) func(ctx context.Context) (*views.WidgetContent, error) {
+	return func(ctx context.Context) (*views.WidgetContent, error) {
+		stages, err := cpadapter.NewPipelinesClient(a.clientMgr.CodePipeline()).GetPipelineState(ctx, w.Pipeline)
+		if err != nil {
+			return nil, err
+		}
+
+		content := &views.WidgetContent{
+			Columns: []string{"Stage", "Status", "Updated", "Revision"},
+			Empty:   "The pipeline has no stages",
+		}
+		now := time.Now()
+		for _, stage := range stages {
+			status, updated := stage.Status, "-"
+			if status == "" {
+				status = "-"
+			}
+			if !stage.UpdatedTime.IsZero() {
+				updated = utils.RelativeTime(stage.UpdatedTime, now)
+			}
+			revision := stage.Revision
+			if len(revision) > 8 {
+				revision = revision[:8] // Commit IDs are recognisable from their start
+			}
+			content.Rows = append(content.Rows, []string{stage.Name, status, updated, revision})
+		}
+		return content, nil
+	}
+}
+
+// formatMetricValue keeps metric values short, e.g. 12.3 or 1.5e+06
+func formatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
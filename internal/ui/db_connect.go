@@ -0,0 +1,289 @@
+package ui
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	ec2adapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ec2"
+	smadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/secretsmanager"
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/components"
+)
+
+// dbTunnelTimeout is how long a Session Manager tunnel gets to start listening
+const dbTunnelTimeout = 30 * time.Second
+
+// dbTunnelReady is what the Session Manager plugin prints once the local port listens
+const dbTunnelReady = "Waiting for connections"
+
+// dbConnect is a connection to an RDS instance being set up through the selectors
+type dbConnect struct {
+	action    *handlers.ConnectDBAction
+	secretARN string
+	bastions  []ec2adapter.Instance // Running instances in the database's VPC, for tunnels
+}
+
+// dbConnectLoadedMsg carries the secrets and tunnel instances a connection can use
+type dbConnectLoadedMsg struct {
+	connect *dbConnect
+	secrets []smadapter.Secret
+	err     error
+}
+
+// dbClientReadyMsg has the client to launch, with its tunnel already listening
+type dbClientReadyMsg struct {
+	client *exec.Cmd
+	tunnel *exec.Cmd
+	err    error
+}
+
+// dbClientFinishedMsg is sent when the database client exits
+type dbClientFinishedMsg struct {
+	client string
+	err    error
+}
+
+// loadDBConnect lists the instances a tunnel can go through and, without a secret RDS
+// manages, the secrets to pick the login from
+func (a *App) loadDBConnect(action *handlers.ConnectDBAction) tea.Cmd {
+	instances := ec2adapter.NewInstancesClient(a.clientMgr.EC2())
+	secrets := smadapter.NewSecretsClient(a.clientMgr.SecretsManager())
+	return func() tea.Msg {
+		ctx := context.Background()
+		connect := &dbConnect{action: action, secretARN: action.SecretARN}
+
+		all, err := instances.ListInstances(ctx)
+		if err != nil {
+			return dbConnectLoadedMsg{err: err}
+		}
+		for _, inst := range all {
+			if inst.State == "running" && inst.VpcID == action.VpcID {
+				connect.bastions = append(connect.bastions, inst)
+			}
+		}
+
+		msg := dbConnectLoadedMsg{connect: connect}
+		if connect.secretARN == "" {
+			if msg.secrets, err = secrets.ListSecrets(ctx); err != nil {
+				return dbConnectLoadedMsg{err: err}
+			}
+		}
+		return msg
+	}
+}
+
+// dbConnectLoaded asks for the secret to log in with, unless RDS manages one
+func (a *App) dbConnectLoaded(msg dbConnectLoadedMsg) (tea.Model, tea.Cmd) {
+	a.footer.SetLoading(false, "")
+	if msg.err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Connect failed: %v", msg.err), true)
+		return a, nil
+	}
+
+	a.pendingDBConnect = msg.connect
+	if msg.connect.secretARN != "" {
+		return a, a.showDBConnections()
+	}
+
+	id := strings.ToLower(msg.connect.action.DBInstanceID)
+	matching := fmt.Sprintf("Mentioning %s", msg.connect.action.DBInstanceID)
+	options := []components.DBSecretOption{{
+		Name:        "No secret",
+		Description: "the client asks for the password",
+		Group:       "Password prompt",
+	}}
+	for _, secret := range msg.secrets {
+		group := "Other secrets"
+		if strings.Contains(strings.ToLower(secret.Name+" "+secret.Description), id) {
+			group = matching
+		}
+		options = append(options, components.DBSecretOption{
+			Name:        secret.Name,
+			ARN:         secret.ARN,
+			Description: secret.Description,
+			Group:       group,
+		})
+	}
+	// Secrets mentioning the instance come right after the prompt option
+	ordered := options[:1:1]
+	for _, group := range []string{matching, "Other secrets"} {
+		for _, option := range options[1:] {
+			if option.Group == group {
+				ordered = append(ordered, option)
+			}
+		}
+	}
+	return a, a.selector.ShowDBSecrets(msg.connect.action.DBInstanceID, ordered)
+}
+
+// showDBConnections offers launching the client or copying its command, directly or
+// through a tunnel via one of the running instances in the database's VPC
+func (a *App) showDBConnections() tea.Cmd {
+	connect := a.pendingDBConnect
+	client := connect.action.Client
+	endpoint := fmt.Sprintf("%s:%d", connect.action.Host, connect.action.Port)
+
+	options := []components.DBConnectionOption{
+		{Title: "Launch " + client, Description: endpoint, Group: "Direct", Value: "launch"},
+		{Title: "Copy " + client + " command", Description: endpoint, Group: "Direct", Value: "copy"},
+	}
+	for _, inst := range connect.bastions {
+		group := "SSM tunnel via " + inst.InstanceID
+		if inst.Name != "" {
+			group = fmt.Sprintf("SSM tunnel via %s (%s)", inst.Name, inst.InstanceID)
+		}
+		options = append(options,
+			components.DBConnectionOption{Title: "Launch " + client + " through the tunnel", Description: inst.PrivateIP, Group: group, Value: "launch:" + inst.InstanceID},
+			components.DBConnectionOption{Title: "Copy tunnel and " + client + " commands", Description: inst.PrivateIP, Group: group, Value: "copy:" + inst.InstanceID},
+		)
+	}
+	return a.selector.ShowDBConnections(connect.action.DBInstanceID, options)
+}
+
+// connectDB launches the client or copies its command, as picked
+func (a *App) connectDB(value string) tea.Cmd {
+	connect := a.pendingDBConnect
+	a.pendingDBConnect = nil
+	if connect == nil {
+		return nil
+	}
+
+	mode, instanceID, _ := strings.Cut(value, ":")
+	var tunnel *handlers.DBTunnel
+	if instanceID != "" {
+		port, err := freeLocalPort()
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("No local port for the tunnel: %v", err), true)
+			return nil
+		}
+		tunnel = &handlers.DBTunnel{InstanceID: instanceID, LocalPort: port}
+	}
+
+	if mode == "copy" {
+		command := connect.action.ShellCommand(connect.secretARN, a.clientMgr.Profile(), a.clientMgr.Region(), tunnel)
+		return components.CopyToClipboard(command, connect.action.Client+" command")
+	}
+
+	if _, err := exec.LookPath(connect.action.Client); err != nil {
+		a.footer.SetMessage(fmt.Sprintf("%s isn't installed, or not on the PATH", connect.action.Client), true)
+		return nil
+	}
+	a.footer.SetLoading(true, fmt.Sprintf("Connecting to %s...", connect.action.DBInstanceID))
+	return a.prepareDBClient(connect, tunnel)
+}
+
+// prepareDBClient reads the login from the secret and starts the tunnel, so the client
+// can be launched once it listens
+func (a *App) prepareDBClient(connect *dbConnect, tunnel *handlers.DBTunnel) tea.Cmd {
+	secrets := smadapter.NewSecretsClient(a.clientMgr.SecretsManager())
+	return func() tea.Msg {
+		env, err := a.commandEnv()
+		if err != nil {
+			return dbClientReadyMsg{err: err}
+		}
+
+		login := handlers.DBLogin{}
+		if connect.secretARN != "" {
+			value, err := secrets.GetSecretValue(context.Background(), connect.secretARN)
+			if err != nil {
+				return dbClientReadyMsg{err: err}
+			}
+			login = handlers.ParseDBSecret(value)
+		}
+
+		msg := dbClientReadyMsg{}
+		if tunnel != nil {
+			if msg.tunnel, err = startDBTunnel(connect.action.TunnelArgs(*tunnel), env); err != nil {
+				return dbClientReadyMsg{err: err}
+			}
+		}
+
+		args := connect.action.ClientArgs(login.User, tunnel)
+		msg.client = exec.Command(args[0], args[1:]...)
+		msg.client.Env = env
+		if login.Password != "" {
+			msg.client.Env = append(msg.client.Env, connect.action.PasswordEnv()+"="+login.Password)
+		}
+		return msg
+	}
+}
+
+// startDBTunnel starts a Session Manager port forwarding session and waits until its
+// local port listens
+func startDBTunnel(args, env []string) (*exec.Cmd, error) {
+	cmd := exec.Command("aws", args...)
+	cmd.Env = env
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting the tunnel: %w", err)
+	}
+
+	ready := make(chan bool, 1)
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			if strings.Contains(scanner.Text(), dbTunnelReady) {
+				ready <- true
+				// Keep reading, the plugin blocks once its output fills up
+				for scanner.Scan() {
+				}
+				return
+			}
+		}
+		ready <- false
+	}()
+
+	select {
+	case ok := <-ready:
+		if ok {
+			return cmd, nil
+		}
+		cmd.Wait()
+		return nil, fmt.Errorf("the tunnel closed: %s", strings.TrimSpace(stderr.String()))
+	case <-time.After(dbTunnelTimeout):
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, fmt.Errorf("the tunnel didn't start within %s, is the SSM agent running on the instance?", dbTunnelTimeout)
+	}
+}
+
+// launchDBClient hands the terminal to the client, closing the tunnel once it exits
+func (a *App) launchDBClient(msg dbClientReadyMsg) tea.Cmd {
+	a.footer.SetLoading(false, "")
+	if msg.err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Connect failed: %v", msg.err), true)
+		return nil
+	}
+
+	client, tunnel := msg.client.Args[0], msg.tunnel
+	return tea.ExecProcess(msg.client, func(err error) tea.Msg {
+		if tunnel != nil {
+			tunnel.Process.Kill()
+			tunnel.Wait()
+		}
+		return dbClientFinishedMsg{client: client, err: err}
+	})
+}
+
+// freeLocalPort finds a local port nothing listens on, for a tunnel
+func freeLocalPort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
@@ -0,0 +1,193 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/term"
+	"github.com/muesli/cancelreader"
+
+	ecsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ecs"
+	"github.com/aaw-tui/aws-tui/internal/adapters/aws/ssmsession"
+	"github.com/aaw-tui/aws-tui/internal/ui/messages"
+)
+
+// sessionManagerPlugin is the AWS binary that joins Session Manager sessions
+const sessionManagerPlugin = "session-manager-plugin"
+
+// ecsExecCommand is run in the container
+const ecsExecCommand = "/bin/bash"
+
+// ecsExecStartedMsg is sent when ECS has started the exec session of a container
+type ecsExecStartedMsg struct {
+	container string
+	session   *ecsadapter.ExecSession
+	env       []string // Environment for the plugin, with the credentials in use
+	err       error
+}
+
+// ecsExecFinishedMsg is sent when the ECS exec session ends
+type ecsExecFinishedMsg struct {
+	builtin bool // Joined with the built-in client rather than the plugin
+	err     error
+}
+
+// executeECSExec starts a shell in a container through the ECS ExecuteCommand API. The
+// terminal then joins its session once it has started.
+func (a *App) executeECSExec(clusterARN, taskARN string, container messages.ECSContainer) tea.Cmd {
+	plugin, err := a.ecsExecPlugin()
+	if err != nil {
+		a.footer.SetMessage(err.Error(), true)
+		return nil
+	}
+
+	a.footer.SetLoading(true, fmt.Sprintf("Starting a shell in %s...", container.Name))
+	client := ecsadapter.NewTasksClient(a.clientMgr.ECS())
+	return func() tea.Msg {
+		session, err := client.ExecuteCommand(context.Background(), clusterARN, taskARN, container.Name, container.RuntimeId, ecsExecCommand)
+		if err != nil {
+			return ecsExecStartedMsg{container: container.Name, err: err}
+		}
+		msg := ecsExecStartedMsg{container: container.Name, session: session}
+		// Only the plugin is handed the credentials; the built-in client has the session
+		if plugin != "" {
+			msg.env, msg.err = a.commandEnv()
+		}
+		return msg
+	}
+}
+
+// ecsExecPlugin returns the path of the session-manager-plugin, or an empty path when
+// the built-in client is to be used. It fails when ecs_exec_client asks for the plugin
+// and it isn't installed.
+func (a *App) ecsExecPlugin() (string, error) {
+	switch a.config.ECSExecClient {
+	case "builtin":
+		return "", nil
+	case "", "auto", "plugin":
+	default:
+		return "", fmt.Errorf("ecs_exec_client must be auto, plugin or builtin, not %q", a.config.ECSExecClient)
+	}
+
+	path, err := exec.LookPath(sessionManagerPlugin)
+	if err != nil && a.config.ECSExecClient == "plugin" {
+		return "", fmt.Errorf("ECS Exec: %s isn't on the PATH; install it from "+
+			"https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html "+
+			"or set ecs_exec_client: auto", sessionManagerPlugin)
+	}
+	return path, nil
+}
+
+// joinECSExec hands the terminal to the started session, through the plugin when there
+// is one and the built-in client otherwise
+func (a *App) joinECSExec(msg ecsExecStartedMsg) tea.Cmd {
+	a.footer.SetLoading(false, "")
+	if msg.err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Exec failed: %v", msg.err), true)
+		return nil
+	}
+
+	plugin, err := a.ecsExecPlugin()
+	if err != nil {
+		a.footer.SetMessage(err.Error(), true)
+		return nil
+	}
+	if plugin == "" {
+		session := &builtinSession{session: ssmsession.Session{
+			ID:        msg.session.SessionID,
+			StreamURL: msg.session.StreamURL,
+			Token:     msg.session.TokenValue,
+		}}
+		return tea.Exec(session, func(err error) tea.Msg {
+			return ecsExecFinishedMsg{builtin: true, err: err}
+		})
+	}
+
+	sessionJSON, err := json.Marshal(msg.session)
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Exec failed: %v", err), true)
+		return nil
+	}
+	target, err := json.Marshal(map[string]string{"Target": msg.session.Target})
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Exec failed: %v", err), true)
+		return nil
+	}
+	region := a.clientMgr.Region()
+	// The same arguments the AWS CLI passes; the empty profile leaves the credentials to
+	// the environment
+	cmd := exec.Command(plugin, string(sessionJSON), region, "StartSession", "", string(target),
+		fmt.Sprintf("https://ecs.%s.amazonaws.com", region))
+
+	cmd.Env = msg.env
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return ecsExecFinishedMsg{err: err}
+	})
+}
+
+// ecsExecFinished reports how the exec session ended
+func (a *App) ecsExecFinished(msg ecsExecFinishedMsg) {
+	switch {
+	case msg.err != nil && msg.builtin:
+		a.footer.SetMessage(fmt.Sprintf("Exec failed in the built-in Session Manager client: %v", msg.err), true)
+	case msg.err != nil:
+		a.footer.SetMessage(fmt.Sprintf("Exec failed: %v", msg.err), true)
+	default:
+		a.footer.SetMessage("Exec session completed", false)
+	}
+}
+
+// builtinSession joins a Session Manager session with the built-in client, in the
+// terminal the program releases for it
+type builtinSession struct {
+	session ssmsession.Session
+	stdin   io.Reader
+	stdout  io.Writer
+}
+
+func (s *builtinSession) SetStdin(r io.Reader)  { s.stdin = r }
+func (s *builtinSession) SetStdout(w io.Writer) { s.stdout = w }
+func (s *builtinSession) SetStderr(io.Writer)   {}
+
+// Run puts the terminal in raw mode, so keys such as ctrl+c reach the container's shell,
+// and relays it to the session until the session ends
+func (s *builtinSession) Run() error {
+	if s.stdin == nil {
+		s.stdin = os.Stdin
+	}
+	if s.stdout == nil {
+		s.stdout = os.Stdout
+	}
+
+	if f, ok := s.stdin.(*os.File); ok && term.IsTerminal(f.Fd()) {
+		state, err := term.MakeRaw(f.Fd())
+		if err != nil {
+			return err
+		}
+		defer term.Restore(f.Fd(), state)
+	}
+
+	// The read pending when the session ends is cancelled, so it doesn't take the next
+	// key from the program
+	in, err := cancelreader.NewReader(s.stdin)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	defer in.Cancel()
+
+	var size ssmsession.SizeFunc
+	if f, ok := s.stdout.(*os.File); ok && term.IsTerminal(f.Fd()) {
+		size = func() (int, int, bool) {
+			cols, rows, err := term.GetSize(f.Fd())
+			return cols, rows, err == nil
+		}
+	}
+	return ssmsession.Run(context.Background(), s.session, in, s.stdout, size)
+}
@@ -0,0 +1,231 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	awsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws"
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+	"github.com/aaw-tui/aws-tui/internal/app"
+	"github.com/aaw-tui/aws-tui/internal/ui/messages"
+)
+
+// elevation is a time-boxed period where changes are allowed, after which the app goes
+// back to read-only mode on its own
+type elevation struct {
+	reason  string
+	minutes int
+	until   time.Time
+}
+
+// elevateRequest asks for the reason of an elevation before it starts
+type elevateRequest struct {
+	minutes int
+}
+
+// elevationStartedMsg is sent once an elevation is recorded in the audit log, or failed to be
+type elevationStartedMsg struct {
+	elevation *elevation
+	err       error
+}
+
+// elevationTickMsg counts an elevation down. Ticks of an elevation that has since ended
+// or been replaced are dropped.
+type elevationTickMsg struct {
+	elevation *elevation
+}
+
+// requestElevation opens the reason prompt of :elevate [minutes]
+func (a *App) requestElevation(args []string) (tea.Model, tea.Cmd) {
+	minutes := a.config.ElevationLength()
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 || n > app.MaxElevationMinutes {
+			a.footer.SetMessage(fmt.Sprintf("Usage: :elevate [minutes], from 1 to %d", app.MaxElevationMinutes), true)
+			return a, nil
+		}
+		minutes = n
+	}
+
+	switch {
+	case a.profileSwitch != nil:
+		a.footer.SetMessage("Wait for the profile switch to complete before elevating", true)
+		return a, nil
+	case a.roleReadOnly:
+		a.footer.SetMessage("The assumed role's session policy is read-only, :unassume before elevating", true)
+		return a, nil
+	}
+
+	message := fmt.Sprintf("Allow changes to %s for %d minutes?\n\n"+
+		"The reason is recorded in %s. Read-only mode comes back on by itself afterwards.",
+		a.clientMgr.Profile(), minutes, a.auditLog.Path())
+	if a.elevation != nil {
+		message += "\n\nThis replaces the current elevation."
+	}
+
+	a.mode = ModeConfirm
+	a.pendingAction = &elevateRequest{minutes: minutes}
+	a.confirmDialog.SetMessage(message)
+	a.confirmDialog.RequireTextInput("Reason", "", "e.g. CHG-1234 resize the reporting database", 200)
+	a.confirmDialog.SetWidth(a.width)
+	return a, nil
+}
+
+// startElevation records the elevation in the audit log. Changes are only allowed once
+// it is written.
+func (a *App) startElevation(req *elevateRequest, reason string) tea.Cmd {
+	e := &elevation{reason: reason, minutes: req.minutes}
+	entry := a.auditEntry(config.AuditElevate)
+	entry.Reason = reason
+	entry.Minutes = req.minutes
+
+	return func() tea.Msg {
+		entry.Account, _ = a.clientMgr.GetAccountID(context.Background())
+		if err := a.auditLog.Append(entry); err != nil {
+			return elevationStartedMsg{err: err}
+		}
+		e.until = time.Now().Add(time.Duration(e.minutes) * time.Minute)
+		return elevationStartedMsg{elevation: e}
+	}
+}
+
+// elevationStarted allows changes until the elevation runs out
+func (a *App) elevationStarted(msg elevationStartedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Not elevated, the audit log couldn't be written: %v", msg.err), true)
+		return a, nil
+	}
+
+	a.elevation = msg.elevation
+	a.setReadOnly(false)
+	a.header.SetElevated(time.Until(msg.elevation.until))
+	a.footer.SetMessage(fmt.Sprintf("Elevated for %d minutes: %s", msg.elevation.minutes, msg.elevation.reason), false)
+	return a, a.elevationTick(msg.elevation)
+}
+
+// elevationTick schedules the next countdown tick of an elevation
+func (a *App) elevationTick(e *elevation) tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return elevationTickMsg{elevation: e}
+	})
+}
+
+// handleElevationTick updates the countdown and ends the elevation once it runs out
+func (a *App) handleElevationTick(msg elevationTickMsg) (tea.Model, tea.Cmd) {
+	if msg.elevation != a.elevation {
+		return a, nil
+	}
+
+	left := time.Until(a.elevation.until)
+	if left <= 0 {
+		// A change waiting for confirmation would otherwise still go through
+		if _, ok := a.pendingAction.(*elevateRequest); a.mode == ModeConfirm && !ok {
+			a.mode = ModeNormal
+			a.pendingAction = nil
+			a.confirmDialog.Reset()
+		}
+		cmd := a.endElevation(config.AuditExpire)
+		a.footer.SetMessage("Elevation expired, read-only mode is back on", false)
+		return a, cmd
+	}
+	a.header.SetElevated(left)
+	return a, a.elevationTick(a.elevation)
+}
+
+// endElevation goes back to read-only mode and records why in the audit log. A profile
+// switch or read-only session policy in progress gets read-only mode once it ends.
+func (a *App) endElevation(event string) tea.Cmd {
+	if a.elevation == nil {
+		return nil
+	}
+	a.elevation = nil
+	a.header.SetElevated(0)
+
+	switch {
+	case a.profileSwitch != nil:
+		a.profileSwitch.readOnlyBefore = true
+	case a.roleReadOnly:
+		a.readOnlyBeforeRole = true
+	default:
+		a.setReadOnly(true)
+	}
+
+	entry := a.auditEntry(event)
+	return func() tea.Msg {
+		entry.Account, _ = a.clientMgr.GetAccountID(context.Background())
+		if err := a.auditLog.Append(entry); err != nil {
+			return messages.ErrorMsg{Error: err, Context: "writing the audit log"}
+		}
+		return nil
+	}
+}
+
+// auditEntry starts an audit log entry for the current profile and region
+func (a *App) auditEntry(event string) config.AuditEntry {
+	return config.AuditEntry{
+		Event:   event,
+		Profile: a.clientMgr.Profile(),
+		Region:  a.clientMgr.Region(),
+	}
+}
+
+// recordCall writes an AWS call that may have changed something to the audit log. It
+// runs on the goroutine of the call, so a failed write is only kept for :audit to show.
+func (a *App) recordCall(call awsadapter.Call) {
+	entry := config.AuditEntry{
+		Time:      call.Time.UTC(),
+		Event:     config.AuditCall,
+		Profile:   call.Profile,
+		Account:   call.Account,
+		Region:    call.Region,
+		Service:   call.Service,
+		Operation: call.Operation,
+		Resource:  call.Resource,
+		Outcome:   config.AuditSucceeded,
+	}
+	if call.Err != nil {
+		entry.Outcome = config.AuditFailed
+		entry.Error = call.Err.Error()
+	}
+	_ = a.auditLog.Append(entry) // Kept by the log for LastError
+}
+
+// recordCommand returns the function that writes an AWS CLI command that may have changed
+// something to the audit log once it exits, with the profile and region it runs with. It
+// is called on the goroutine waiting for the command, so a failed write is only kept for
+// :audit to show.
+func (a *App) recordCommand(args []string) func(exitCode int, err error) {
+	entry := a.auditEntry(config.AuditCommand)
+	entry.Command = args
+	return func(exitCode int, err error) {
+		entry.Time = time.Now().UTC()
+		entry.Account, _ = a.clientMgr.GetAccountID(context.Background())
+		entry.Outcome = config.AuditSucceeded
+		switch {
+		case err != nil:
+			entry.Outcome = config.AuditFailed
+			entry.Error = err.Error()
+		case exitCode < 0:
+			entry.Outcome = config.AuditFailed
+			entry.Error = "killed before it exited"
+		case exitCode != 0:
+			entry.Outcome = config.AuditFailed
+			entry.Error = fmt.Sprintf("exit status %d", exitCode)
+		}
+		_ = a.auditLog.Append(entry) // Kept by the log for LastError
+	}
+}
+
+// elevationReason checks the reason entered for an elevation
+func elevationReason(input string) (string, error) {
+	reason := strings.TrimSpace(input)
+	if reason == "" {
+		return "", fmt.Errorf("enter a reason to elevate, such as a change ticket")
+	}
+	return reason, nil
+}
@@ -0,0 +1,432 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	cwadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/cloudwatch"
+	ecsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/ecs"
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+	"github.com/aaw-tui/aws-tui/internal/app"
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// homeMaxItems caps the resources a pin counts, more show as "1000+"
+const homeMaxItems = 1000
+
+// homePinWidth is the width of a pin's box on the home screen
+const homePinWidth = 24
+
+// homeHelp lists the commands and keys, shown by ? on the home screen
+const homeHelp = `Commands:
+  :users      - List IAM Users
+  :roles      - List IAM Roles
+  :groups     - List IAM Groups
+  :policies   - List IAM Policies
+  :credreport - IAM credential report (password, key age, MFA)
+  :can        - Find policies covering an action (:can <action> [resource])
+  :ec2        - List EC2 Instances
+  :imds       - Instances still allowing IMDSv1
+  :lt         - List EC2 Launch Templates (also :launch-templates)
+  :asg        - List Auto Scaling Groups
+  :vpc        - List VPCs
+  :subnets    - List Subnets (also :route-tables, :nat, :igw, :eni)
+  :sg         - List Security Groups
+  :elb        - List Load Balancers
+  :rds        - List RDS Instances
+  :rds-snapshots - List RDS Snapshots
+  :rds-params - List RDS Parameter Groups
+  :rds-options - List RDS Option Groups
+  :ecs        - List ECS Clusters
+  :lambda     - List Lambda Functions
+  :logs       - List CloudWatch Log Groups
+  :sources    - List linked CloudWatch source accounts
+  :s3         - List S3 Buckets
+  :dynamodb   - List DynamoDB Tables
+  :mq         - List Amazon MQ Brokers
+  :imagebuilder - List Image Builder Pipelines
+  :connect    - List Amazon Connect Instances
+  :pinpoint   - List Amazon Pinpoint Projects
+  :stacksets  - List CloudFormation StackSets
+  :apigw      - List API Gateway APIs
+  :kms        - List KMS Keys
+  :secrets    - List Secrets
+  :secrets-rotation - Rotation status of every secret
+  :cost       - Month-to-date spend (:cost tag <key>)
+  :lookup     - Find what owns an IP or DNS name
+  :tagged     - Resources of every service with a tag
+  :tail       - Tail log groups matching globs (:tail /aws/lambda/order-*)
+  :cleanup    - Unused security groups and IAM roles (:cleanup [days])
+  :orgs       - Organization accounts and OUs with their SCPs (A assumes into an account)
+  :advisor    - Trusted Advisor checks (:advisor cost|security|fault|performance|limits)
+  :securityhub - Security Hub findings (:securityhub controls severity=high status=failed)
+  :changelog  - Release notes, marking releases newer than this one
+  :dashboard  - Open a configured dashboard (:dash <name>)
+  :errors     - Details of the errors of this session
+  :audit      - Audit log of elevations and changes
+  :recent     - Resources recently described or acted on (g goes to one)
+  :bookmarks  - Bookmarks, or :bookmarks export [file] [folder] and import <file>
+  :keys       - Key bindings, as remapped in config.yaml
+  :aliases    - Aliases of config.yaml, run as :<name>
+  :jq         - Query the selected resource's details (:jq .Endpoint.Address, also :q <expr>)
+  :theme      - Switch the theme (:theme <name>)
+  :split      - Show a second list beside this one (:split h below it, :only closes it)
+  :profile    - Switch AWS Profile
+  :region     - Switch AWS Region
+  :assume     - Assume a role (:unassume to drop it)
+  :whoami     - Identity of the credentials and where they came from
+  :ro         - Toggle read-only mode
+  :elevate    - Allow changes for a while (:elevate [minutes])
+  :time       - Toggle relative/absolute timestamps
+  :export     - Export resource (json|yaml) or the table (text|md [clip])
+  :! <cmd>    - Run an AWS CLI command
+  :q          - Quit
+
+Shortcuts:
+  p           - Profile selector
+  R           - Region selector
+  E           - Error details
+  ?           - Help
+
+Navigation:
+  j/k         - Move up/down
+  enter/l     - Select/Enter
+  esc/h       - Back
+  ctrl+w      - Focus the other list of a split
+  d           - Describe resource
+  J/K, enter  - Pick and follow a detail link
+  /           - Search
+  t           - Filter by tags
+  T           - Edit tags of the resource
+  r           - Refresh list
+  n/]         - Next page
+  N/[         - Previous page
+  m           - Bookmark resource
+  '           - Show bookmarks
+  c           - Copy ARN to clipboard
+  C           - Copy JSON to clipboard
+  |           - Open with an external command (open_with)
+  ~           - AWS Config history of the resource
+  =           - Mark resource, then diff with another
+  space       - Mark for a batch action (:cleanup, log groups, findings)`
+
+// homePin is the latest count of a pin on the home screen
+type homePin struct {
+	pin    app.Pin
+	count  int
+	more   bool // The count reached homeMaxItems or the list has more pages
+	err    error
+	loaded bool
+}
+
+// homeState is what the home screen shows besides the static text
+type homeState struct {
+	gen      int // Incremented on each profile or region change, so stale results are dropped
+	pins     []homePin
+	identity string // ARN the credentials act as
+	updated  time.Time
+	pending  int // Fetches still running, a refresh waits for them
+}
+
+// homeTickMsg triggers the next background refresh of the home screen
+type homeTickMsg struct {
+	gen int
+}
+
+// homePinMsg carries the count of a pin
+type homePinMsg struct {
+	gen   int
+	index int
+	count int
+	more  bool
+	err   error
+}
+
+// homeIdentityMsg carries the ARN the credentials act as
+type homeIdentityMsg struct {
+	gen int
+	arn string
+	err error
+}
+
+// refreshHome starts over with the pins of the config once the profile or region changed,
+// fetching them and refreshing them in the background when the credentials work
+func (a *App) refreshHome(fetch bool) tea.Cmd {
+	a.home = homeState{gen: a.home.gen + 1}
+	for _, pin := range a.config.Home.Pins {
+		a.home.pins = append(a.home.pins, homePin{pin: pin, err: pin.Validate()})
+	}
+	if !fetch {
+		return nil
+	}
+	return tea.Batch(a.fetchHome(), a.homeTick())
+}
+
+func (a *App) homeTick() tea.Cmd {
+	gen := a.home.gen
+	return tea.Tick(time.Duration(a.config.Home.RefreshSeconds())*time.Second, func(time.Time) tea.Msg {
+		return homeTickMsg{gen: gen}
+	})
+}
+
+// handleHomeTick refreshes the home screen while it shows, skipping the fetches while it
+// is hidden or the last ones are still running
+func (a *App) handleHomeTick(msg homeTickMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != a.home.gen {
+		return a, nil
+	}
+	if a.state != StateHome || a.home.pending > 0 {
+		return a, a.homeTick()
+	}
+	return a, tea.Batch(a.fetchHome(), a.homeTick())
+}
+
+// fetchHome looks up the identity and counts the pins in the background. Handlers are
+// picked from the registry here, as it is only safe to read on the update loop.
+func (a *App) fetchHome() tea.Cmd {
+	gen := a.home.gen
+	cmds := []tea.Cmd{func() tea.Msg {
+		arn, err := a.clientMgr.CallerARN(context.Background())
+		return homeIdentityMsg{gen: gen, arn: arn, err: err}
+	}}
+
+	for i, p := range a.home.pins {
+		if p.pin.Validate() != nil {
+			continue
+		}
+		count := a.pinCounter(p.pin)
+		index := i
+		cmds = append(cmds, func() tea.Msg {
+			n, more, err := count(context.Background())
+			return homePinMsg{gen: gen, index: index, count: n, more: more, err: err}
+		})
+	}
+	a.home.pending = len(cmds)
+	return tea.Batch(cmds...)
+}
+
+// pinCounter returns what counts a pin
+func (a *App) pinCounter(pin app.Pin) func(ctx context.Context) (int, bool, error) {
+	switch pin.PinType() {
+	case app.PinUnhealthyServices:
+		client := ecsadapter.NewClustersClient(a.clientMgr.ECS())
+		return func(ctx context.Context) (int, bool, error) {
+			return countUnhealthyServices(ctx, client, pin.Cluster)
+		}
+	case app.PinAlarms:
+		client := cwadapter.NewAlarmsClient(a.clientMgr.CloudWatch())
+		return func(ctx context.Context) (int, bool, error) {
+			alarms, err := client.ListAlarms(ctx, pin.AlarmState())
+			return len(alarms), false, err
+		}
+	}
+
+	handler, ok := a.registry.Get(pin.Resource)
+	return func(ctx context.Context) (int, bool, error) {
+		if !ok {
+			return 0, false, fmt.Errorf("unknown resource %q", pin.Resource)
+		}
+		result, err := handler.List(ctx, handlers.ListOptions{Filter: pin.Filter, MaxItems: homeMaxItems})
+		if err != nil {
+			return 0, false, err
+		}
+		return len(result.Resources), result.Truncated || result.NextToken != "", nil
+	}
+}
+
+// countUnhealthyServices counts the active ECS services running fewer tasks than they
+// want, in one cluster or in all of them
+func countUnhealthyServices(ctx context.Context, client *ecsadapter.ClustersClient, cluster string) (int, bool, error) {
+	clusters := []string{cluster}
+	if cluster == "" {
+		all, err := client.ListClusters(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		clusters = clusters[:0]
+		for _, c := range all {
+			clusters = append(clusters, c.ClusterARN)
+		}
+	}
+
+	count := 0
+	for _, c := range clusters {
+		services, err := client.ListServices(ctx, c)
+		if err != nil {
+			return 0, false, err
+		}
+		for _, s := range services {
+			if s.Status == "ACTIVE" && s.RunningCount < s.DesiredCount {
+				count++
+			}
+		}
+	}
+	return count, false, nil
+}
+
+// handleHomePin records the count of a pin
+func (a *App) handleHomePin(msg homePinMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != a.home.gen || msg.index >= len(a.home.pins) {
+		return a, nil
+	}
+	p := &a.home.pins[msg.index]
+	p.loaded = true
+	p.err = msg.err
+	if msg.err == nil {
+		p.count = msg.count
+		p.more = msg.more
+	}
+	a.homeFetched()
+	return a, nil
+}
+
+// handleHomeIdentity records the ARN the credentials act as
+func (a *App) handleHomeIdentity(msg homeIdentityMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != a.home.gen {
+		return a, nil
+	}
+	if msg.err == nil {
+		a.home.identity = msg.arn
+	}
+	a.homeFetched()
+	return a, nil
+}
+
+func (a *App) homeFetched() {
+	if a.home.pending > 0 {
+		a.home.pending--
+	}
+	if a.home.pending == 0 {
+		a.home.updated = time.Now()
+	}
+}
+
+func (a *App) renderHome(height int) string {
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(a.theme.Colors.Accent).
+		Render("Welcome to aws-tui")
+
+	subtitle := lipgloss.NewStyle().
+		Foreground(a.theme.Colors.Muted).
+		Render("A terminal UI for AWS resource management")
+
+	sections := []string{title, subtitle}
+	if identity := a.renderIdentity(); identity != "" {
+		sections = append(sections, identity)
+	}
+	if pins := a.renderPins(); pins != "" {
+		sections = append(sections, pins)
+	}
+	if bookmarks := a.renderRecentBookmarks(); bookmarks != "" {
+		sections = append(sections, bookmarks)
+	}
+	if expiring := a.renderExpiring(); expiring != "" {
+		sections = append(sections, expiring)
+	}
+
+	keys := lipgloss.NewStyle().
+		Foreground(a.theme.Colors.Muted).
+		MarginTop(2).
+		Render("? commands and keys · : command · ' bookmarks · p profile · R region · q quit")
+	sections = append(sections, keys)
+
+	content := lipgloss.JoinVertical(lipgloss.Center, sections...)
+
+	return lipgloss.Place(
+		a.width,
+		height,
+		lipgloss.Center,
+		lipgloss.Center,
+		content,
+	)
+}
+
+// renderIdentity shows who the credentials act as, once known
+func (a *App) renderIdentity() string {
+	if a.home.identity == "" {
+		return ""
+	}
+	label := lipgloss.NewStyle().Foreground(a.theme.Colors.Muted).Render("Signed in as ")
+	arn := lipgloss.NewStyle().Foreground(a.theme.Colors.Info).Render(a.home.identity)
+	return lipgloss.NewStyle().MarginTop(1).Render(label + arn)
+}
+
+// renderPins lays out the pinned counts in boxes, as many to a row as fit
+func (a *App) renderPins() string {
+	if len(a.home.pins) == 0 {
+		return ""
+	}
+
+	box := lipgloss.NewStyle().
+		Width(homePinWidth).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(a.theme.Colors.Border).
+		Align(lipgloss.Center)
+	countStyle := lipgloss.NewStyle().Bold(true).Foreground(a.theme.Colors.Primary)
+	mutedStyle := lipgloss.NewStyle().Foreground(a.theme.Colors.Muted)
+	errStyle := lipgloss.NewStyle().Foreground(a.theme.Colors.Error)
+
+	boxes := make([]string, 0, len(a.home.pins))
+	for _, p := range a.home.pins {
+		var count string
+		switch {
+		case p.err != nil:
+			count = errStyle.Render(styles.Truncate(p.err.Error(), homePinWidth))
+		case !p.loaded:
+			count = mutedStyle.Render("…")
+		case p.more:
+			count = countStyle.Render(fmt.Sprintf("%d+", p.count))
+		default:
+			count = countStyle.Render(fmt.Sprintf("%d", p.count))
+		}
+		title := mutedStyle.Render(styles.Truncate(p.pin.DisplayTitle(), homePinWidth))
+		boxes = append(boxes, box.Render(count+"\n"+title))
+	}
+
+	perRow := max(1, a.width/(homePinWidth+2))
+	var rows []string
+	for start := 0; start < len(boxes); start += perRow {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, boxes[start:min(start+perRow, len(boxes))]...))
+	}
+	if !a.home.updated.IsZero() {
+		rows = append(rows, mutedStyle.Render(fmt.Sprintf("Updated %s, every %ds",
+			a.home.updated.Format("15:04:05"), a.config.Home.RefreshSeconds())))
+	}
+	return lipgloss.NewStyle().MarginTop(1).Render(lipgloss.JoinVertical(lipgloss.Center, rows...))
+}
+
+// renderRecentBookmarks lists the newest bookmarks, or returns "" if there are none
+func (a *App) renderRecentBookmarks() string {
+	limit := a.config.Home.BookmarkCount()
+	bookmarks := a.bookmarkStore.List()
+	if limit == 0 || len(bookmarks) == 0 {
+		return ""
+	}
+
+	recent := slices.Clone(bookmarks)
+	slices.SortStableFunc(recent, func(x, y config.Bookmark) int {
+		return y.CreatedAt.Compare(x.CreatedAt)
+	})
+	if len(recent) > limit {
+		recent = recent[:limit]
+	}
+
+	lines := []string{"Recent bookmarks:"}
+	for _, b := range recent {
+		lines = append(lines, fmt.Sprintf("  %s %-22s %s/%s",
+			styles.PadRight(styles.Truncate(b.Name, 30), 30), b.ResourceType, b.Profile, b.Region))
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(a.theme.Colors.Foreground).
+		MarginTop(1).
+		Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,219 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	awsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws"
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+)
+
+// sessionTickInterval is how often the session countdown of the header is updated
+const sessionTickInterval = 30 * time.Second
+
+// whoamiLoadedMsg carries the identity :whoami shows
+type whoamiLoadedMsg struct {
+	identity *awsadapter.CallerIdentity
+	err      error
+}
+
+// sessionExpiryMsg carries when the credentials of a profile expire, zero if they don't
+type sessionExpiryMsg struct {
+	profile string
+	expiry  time.Time
+}
+
+// sessionTickMsg counts down the session shown in the header
+type sessionTickMsg struct{}
+
+// loadWhoami looks up the identity of the current credentials for :whoami
+func (a *App) loadWhoami() tea.Cmd {
+	a.footer.SetLoading(true, "Getting caller identity...")
+	return func() tea.Msg {
+		identity, err := a.clientMgr.Identity(context.Background())
+		return whoamiLoadedMsg{identity: identity, err: err}
+	}
+}
+
+// showWhoami shows who the current credentials act as, and so who changes are made as
+func (a *App) showWhoami(msg whoamiLoadedMsg) {
+	a.footer.SetLoading(false, "")
+	if msg.err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Who am I: %v", msg.err), true)
+		return
+	}
+	a.infoDialog.SetSize(a.width, a.height)
+	a.infoDialog.ShowText("Who Am I", a.whoamiText(msg.identity))
+}
+
+// whoamiText lays the identity out in aligned lines
+func (a *App) whoamiText(identity *awsadapter.CallerIdentity) string {
+	profile := a.clientMgr.Profile()
+	account := identity.Account
+	if stored, ok := a.accountStore.Get(profile); ok && stored.Alias != "" && stored.AccountID == identity.Account {
+		account += " (" + stored.Alias + ")"
+	}
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	row := func(label, value string) {
+		fmt.Fprintf(w, "%s\t%s\n", label, value)
+	}
+	row("Account", account)
+	row("ARN", identity.ARN)
+	row("User ID", identity.UserID)
+	row("Type", identityType(identity.ARN))
+	row("", "")
+	row("Profile", profile)
+	row("Region", a.clientMgr.Region())
+	if role := identity.AssumedRole; role != nil {
+		row("Assumed role", role.RoleARN)
+		policy := "none, the role's full permissions"
+		if role.Policy != nil {
+			policy = role.Policy.Name
+		}
+		row("Session policy", policy)
+		row("Assumed with", credentialChainText(identity.BaseChain, ""))
+	}
+	row("Credentials", credentialChainText(identity.Chain, identity.Source))
+	row("Expire", expiryText(identity.Expires))
+	for _, p := range a.profiles {
+		if p.Name == profile && p.IsSSO {
+			row("SSO session", expiryText(config.SSOExpiry(p)))
+		}
+	}
+	row("", "")
+	row("Changes", a.changesText())
+	w.Flush()
+	return sb.String()
+}
+
+// identityType tells from an STS ARN what kind of identity it is
+func identityType(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return "-"
+	}
+	switch resource := parts[5]; {
+	case resource == "root":
+		return "root user"
+	case strings.HasPrefix(resource, "user/"):
+		return "IAM user"
+	case strings.HasPrefix(resource, "assumed-role/AWSReservedSSO_"):
+		return "SSO permission set role"
+	case strings.HasPrefix(resource, "assumed-role/"):
+		return "assumed role"
+	case strings.HasPrefix(resource, "federated-user/"):
+		return "federated user"
+	}
+	return parts[5]
+}
+
+// credentialChainText joins the steps of a credential chain, e.g. profile's SSO session → SSO
+func credentialChainText(chain []string, source string) string {
+	if len(chain) == 0 {
+		if source == "" {
+			return "-"
+		}
+		return source
+	}
+	return strings.Join(chain, " → ")
+}
+
+// expiryText describes when something expires, e.g. 15:04 (42m left)
+func expiryText(expiry time.Time) string {
+	if expiry.IsZero() {
+		return "never"
+	}
+	left := time.Until(expiry)
+	if left <= 0 {
+		return fmt.Sprintf("%s (expired)", expiry.Local().Format("2006-01-02 15:04"))
+	}
+	return fmt.Sprintf("%s (%s left)", expiry.Local().Format("2006-01-02 15:04"), left.Round(time.Minute))
+}
+
+// changesText says whether changes can be made with the identity right now
+func (a *App) changesText() string {
+	switch {
+	case a.elevation != nil:
+		return fmt.Sprintf("allowed until %s, elevated: %s", a.elevation.until.Format("15:04"), a.elevation.reason)
+	case a.roleReadOnly:
+		return "blocked, the assumed role's session policy is read-only"
+	case a.readOnly:
+		return "blocked, read-only mode is on"
+	}
+	return "allowed"
+}
+
+// loadSessionExpiry looks up when the current credentials expire for the header's
+// countdown, starting the countdown if it isn't running yet
+func (a *App) loadSessionExpiry() tea.Cmd {
+	profile := a.clientMgr.Profile()
+	load := func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), accountResolveTimeout)
+		defer cancel()
+		expiry, _ := a.clientMgr.CredentialsExpiry(ctx)
+		return sessionExpiryMsg{profile: profile, expiry: expiry}
+	}
+	if a.sessionTicking {
+		return load
+	}
+	a.sessionTicking = true
+	return tea.Batch(load, a.sessionTick())
+}
+
+// sessionTick schedules the next update of the header's session countdown
+func (a *App) sessionTick() tea.Cmd {
+	return tea.Tick(sessionTickInterval, func(time.Time) tea.Msg {
+		return sessionTickMsg{}
+	})
+}
+
+// sessionExpiryLoaded keeps when the credentials expire, if they are still the profile's
+func (a *App) sessionExpiryLoaded(msg sessionExpiryMsg) {
+	if msg.profile != a.clientMgr.Profile() {
+		return
+	}
+	a.credentialsExpiry = msg.expiry
+	a.updateSessionExpiry()
+}
+
+// handleSessionTick updates the countdown. Credentials past their expiry are looked up
+// again, as they may have been refreshed since, unless refreshing them asks for an MFA code.
+func (a *App) handleSessionTick() tea.Cmd {
+	a.updateSessionExpiry()
+	cmds := []tea.Cmd{a.sessionTick()}
+	if expiry := a.credentialsExpiry; !expiry.IsZero() && time.Now().After(expiry) && !a.needsMFA(a.clientMgr.Profile()) {
+		cmds = append(cmds, a.loadSessionExpiry())
+	}
+	return tea.Batch(cmds...)
+}
+
+// updateSessionExpiry shows in the header when the session ends: the SSO session for SSO
+// profiles, whose credentials are refreshed until it ends, or else the credentials
+func (a *App) updateSessionExpiry() {
+	expiry := a.credentialsExpiry
+	for _, p := range a.profiles {
+		if p.Name == a.clientMgr.Profile() && p.IsSSO {
+			if sso := config.SSOExpiry(p); !sso.IsZero() {
+				expiry = sso
+			}
+		}
+	}
+	a.header.SetSessionExpiry(expiry)
+}
+
+// needsMFA reports whether refreshing a profile's credentials asks for an MFA code
+func (a *App) needsMFA(profile string) bool {
+	for _, p := range a.profiles {
+		if p.Name == profile {
+			return p.MFASerial != ""
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	cwadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/cloudwatch"
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+	"github.com/aaw-tui/aws-tui/internal/ui/views"
+)
+
+// Container Insights charts cover the last hours at its one-minute resolution, and
+// refresh as often as new datapoints arrive
+const (
+	insightsNamespace = "ECS/ContainerInsights"
+	insightsHours     = 3
+	insightsPeriod    = time.Minute
+	insightsRefresh   = time.Minute
+)
+
+// insightsChart is a chart of the Container Insights view. A second metric combines
+// with the first, dividing it into a utilization or adding to it.
+type insightsChart struct {
+	title   string
+	metric  string
+	stat    string
+	combine func(first, second float64) (float64, bool)
+	second  string
+	unit    string
+}
+
+// utilization divides what is used by what is reserved, as a percentage
+func utilization(used, reserved float64) (float64, bool) {
+	if reserved <= 0 {
+		return 0, false
+	}
+	return used / reserved * 100, true
+}
+
+// addition adds the two values, as for traffic in and out
+func addition(first, second float64) (float64, bool) { return first + second, true }
+
+// insightsCharts returns the charts of a cluster, or of a service without one. Task
+// counts are only published per cluster as TaskCount, per service as RunningTaskCount.
+func insightsCharts(service string) []insightsChart {
+	tasks := insightsChart{title: "Running tasks", metric: "RunningTaskCount", stat: "Average"}
+	if service == "" {
+		tasks.metric = "TaskCount"
+	}
+	return []insightsChart{
+		{title: "CPU utilization", metric: "CpuUtilized", stat: "Sum", second: "CpuReserved", combine: utilization, unit: "%"},
+		{title: "Memory utilization", metric: "MemoryUtilized", stat: "Sum", second: "MemoryReserved", combine: utilization, unit: "%"},
+		tasks,
+		{title: "Network in + out (bytes/s)", metric: "NetworkRxBytes", stat: "Average", second: "NetworkTxBytes", combine: addition},
+	}
+}
+
+// openContainerInsights charts the Container Insights metrics of a cluster or service,
+// as a dashboard refreshing every minute
+func (a *App) openContainerInsights(action *handlers.ContainerInsightsAction) (tea.Model, tea.Cmd) {
+	dimensions := map[string]string{"ClusterName": action.ClusterName}
+	title := "Container Insights " + action.ClusterName
+	if action.ServiceName != "" {
+		dimensions["ServiceName"] = action.ServiceName
+		title += " / " + action.ServiceName
+	}
+
+	charts := insightsCharts(action.ServiceName)
+	widgets := make([]views.DashboardWidget, 0, len(charts))
+	for _, chart := range charts {
+		widgets = append(widgets, views.DashboardWidget{
+			Title:   chart.title,
+			Refresh: insightsRefresh,
+			Fetch:   a.insightsWidget(chart, dimensions),
+		})
+	}
+
+	a.dashboard.SetSize(a.width, a.height)
+	return a, a.dashboard.Show(title, 2, widgets)
+}
+
+// insightsWidget fetches a chart's metrics over the last hours
+func (a *App) insightsWidget(chart insightsChart, dimensions map[string]string) func(ctx context.Context) (*views.WidgetContent, error) {
+	return func(ctx context.Context) (*views.WidgetContent, error) {
+		client := cwadapter.NewMetricsClient(a.clientMgr.CloudWatch())
+		end := time.Now()
+		query := cwadapter.MetricQuery{
+			Namespace:  insightsNamespace,
+			MetricName: chart.metric,
+			Dimensions: dimensions,
+			Stat:       chart.stat,
+			Period:     insightsPeriod,
+			Start:      end.Add(-insightsHours * time.Hour),
+			End:        end,
+		}
+		points, err := client.GetSeries(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		if chart.second != "" {
+			query.MetricName = chart.second
+			second, err := client.GetSeries(ctx, query)
+			if err != nil {
+				return nil, err
+			}
+			points = combineSeries(points, second, chart.combine)
+		}
+
+		content := &views.WidgetContent{
+			Series: make([]float64, 0, len(points)),
+			Chart:  true,
+			Unit:   chart.unit,
+			Empty:  fmt.Sprintf("No data in the last %dh, is Container Insights enabled on the cluster?", insightsHours),
+		}
+		if len(points) == 0 {
+			return content, nil
+		}
+
+		high, total := points[0].Value, 0.0
+		for _, point := range points {
+			content.Series = append(content.Series, point.Value)
+			high = max(high, point.Value)
+			total += point.Value
+		}
+		latest := points[len(points)-1]
+		content.Summary = fmt.Sprintf("latest %s%s  avg %s%s  max %s%s  last %dh",
+			formatMetricValue(latest.Value), chart.unit,
+			formatMetricValue(total/float64(len(points))), chart.unit,
+			formatMetricValue(high), chart.unit, insightsHours)
+		return content, nil
+	}
+}
+
+// combineSeries combines the datapoints two series have at the same time
+func combineSeries(first, second []cwadapter.Datapoint, combine func(first, second float64) (float64, bool)) []cwadapter.Datapoint {
+	seconds := make(map[int64]float64, len(second))
+	for _, point := range second {
+		seconds[point.Timestamp.Unix()] = point.Value
+	}
+
+	combined := make([]cwadapter.Datapoint, 0, len(first))
+	for _, point := range first {
+		other, ok := seconds[point.Timestamp.Unix()]
+		if !ok {
+			continue
+		}
+		if value, ok := combine(point.Value, other); ok {
+			combined = append(combined, cwadapter.Datapoint{Timestamp: point.Timestamp, Value: value})
+		}
+	}
+	return combined
+}
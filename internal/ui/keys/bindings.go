@@ -1,117 +1,308 @@
 package keys
 
 import (
-	"github.com/charmbracelet/bubbles/key"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+)
+
+// Scope is where a binding applies
+type Scope int
+
+const (
+	ScopeHome Scope = 1 << iota // The home screen
+	ScopeList                   // Resource lists and their detail pane
+	ScopeAll  = ScopeHome | ScopeList
+)
+
+// Sections of keybindings in config.yaml
+const (
+	SectionGlobal = "global"
+	SectionList   = "list"
 )
 
-// KeyMap defines all application key bindings
+// Binding is a key binding that the keybindings section of config.yaml can remap by name
+type Binding struct {
+	Name    string // e.g. "describe"
+	Section string // SectionGlobal or SectionList
+	Help    string
+	Scope   Scope
+
+	// Keys the views check for; the first stands for the binding when it is remapped
+	Defaults []string
+	// Keys that trigger the binding, the defaults unless remapped
+	Keys []string
+}
+
+// Remapped reports whether config.yaml changed the binding's keys
+func (b Binding) Remapped() bool {
+	return !slices.Equal(b.Keys, b.Defaults)
+}
+
+// KeyMap is the key bindings in use: the defaults with the keybindings of config.yaml
+// applied, and the keys given to handler actions
 type KeyMap struct {
-	// Navigation
-	Up           key.Binding
-	Down         key.Binding
-	Left         key.Binding
-	Right        key.Binding
-	HalfPageUp   key.Binding
-	HalfPageDown key.Binding
-	Top          key.Binding
-	Bottom       key.Binding
-
-	// Mode switching
-	Search  key.Binding
-	Command key.Binding
-	Escape  key.Binding
-
-	// Actions
-	Enter    key.Binding
-	Describe key.Binding
-	Edit     key.Binding
-	Delete   key.Binding
-	Refresh  key.Binding
-	Back     key.Binding
-	Quit     key.Binding
-	Help     key.Binding
-
-	// View toggles
-	ToggleYAML key.Binding
-	ToggleWrap key.Binding
-
-	// Clipboard
-	CopyID   key.Binding
-	CopyJSON key.Binding
-
-	// Bookmarks
-	Bookmark     key.Binding
-	GoToBookmark key.Binding
-
-	// Tags
-	FilterByTag key.Binding
-
-	// Tab
-	Tab key.Binding
+	Bindings []Binding
+
+	// Resource type to action name to key
+	actions map[string]map[string]string
+}
+
+func binding(section, name, help string, scope Scope, defaults ...string) Binding {
+	return Binding{
+		Name:     name,
+		Section:  section,
+		Help:     help,
+		Scope:    scope,
+		Defaults: defaults,
+		Keys:     defaults,
+	}
 }
 
 // DefaultKeyMap returns the default vim-style key bindings
 func DefaultKeyMap() KeyMap {
 	return KeyMap{
-		// Navigation (vim-style)
-		Up:           key.NewBinding(key.WithKeys("k", "up"), key.WithHelp("k/↑", "up")),
-		Down:         key.NewBinding(key.WithKeys("j", "down"), key.WithHelp("j/↓", "down")),
-		Left:         key.NewBinding(key.WithKeys("h", "left"), key.WithHelp("h/←", "back")),
-		Right:        key.NewBinding(key.WithKeys("l", "right"), key.WithHelp("l/→", "enter")),
-		HalfPageUp:   key.NewBinding(key.WithKeys("ctrl+u"), key.WithHelp("C-u", "½ page up")),
-		HalfPageDown: key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("C-d", "½ page down")),
-		Top:          key.NewBinding(key.WithKeys("g", "home"), key.WithHelp("g", "top")),
-		Bottom:       key.NewBinding(key.WithKeys("G", "end"), key.WithHelp("G", "bottom")),
-
-		// Mode switching
-		Search:  key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search")),
-		Command: key.NewBinding(key.WithKeys(":"), key.WithHelp(":", "command")),
-		Escape:  key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
-
-		// Actions
-		Enter:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
-		Describe: key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "describe")),
-		Edit:     key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit")),
-		Delete:   key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("C-d", "delete")),
-		Refresh:  key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
-		Back:     key.NewBinding(key.WithKeys("esc", "backspace"), key.WithHelp("esc", "back")),
-		Quit:     key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
-		Help:     key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "help")),
-
-		// View toggles
-		ToggleYAML: key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "yaml")),
-		ToggleWrap: key.NewBinding(key.WithKeys("w"), key.WithHelp("w", "wrap")),
-
-		// Clipboard
-		CopyID:   key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "copy ARN")),
-		CopyJSON: key.NewBinding(key.WithKeys("C"), key.WithHelp("C", "copy JSON")),
-
-		// Bookmarks
-		Bookmark:     key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "bookmark")),
-		GoToBookmark: key.NewBinding(key.WithKeys("'"), key.WithHelp("'", "go to mark")),
-
-		// Tags
-		FilterByTag: key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "filter tags")),
-
-		// Tab navigation
-		Tab: key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "switch pane")),
+		Bindings: []Binding{
+			// Global
+			binding(SectionGlobal, "quit", "quit", ScopeAll, "q"),
+			binding(SectionGlobal, "command", "command mode", ScopeAll, ":"),
+			binding(SectionGlobal, "bookmarks", "go to a bookmark", ScopeAll, "'"),
+			binding(SectionGlobal, "errors", "error details", ScopeAll, "E"),
+			binding(SectionGlobal, "profile", "switch profile", ScopeHome, "p"),
+			binding(SectionGlobal, "region", "switch region", ScopeHome, "R"),
+			binding(SectionGlobal, "help", "help", ScopeHome, "?"),
+
+			// Navigation (vim-style)
+			binding(SectionList, "up", "up", ScopeList, "k", "up"),
+			binding(SectionList, "down", "down", ScopeList, "j", "down"),
+			binding(SectionList, "top", "top", ScopeList, "g", "home"),
+			binding(SectionList, "bottom", "bottom", ScopeList, "G", "end"),
+			binding(SectionList, "half_page_up", "½ page up", ScopeList, "ctrl+u"),
+			binding(SectionList, "half_page_down", "½ page down", ScopeList, "ctrl+d"),
+			binding(SectionList, "select", "select", ScopeList, "enter", "l"),
+			binding(SectionList, "back", "back", ScopeList, "esc", "h"),
+			binding(SectionList, "switch_pane", "switch pane", ScopeList, "tab"),
+			binding(SectionList, "switch_split", "focus the other list of a split", ScopeList, "ctrl+w"),
+			binding(SectionList, "next_link", "next link in the details", ScopeList, "J"),
+			binding(SectionList, "prev_link", "previous link in the details", ScopeList, "K"),
+			binding(SectionList, "next_page", "next page", ScopeList, "n", "]"),
+			binding(SectionList, "prev_page", "previous page", ScopeList, "N", "["),
+			binding(SectionList, "column_left", "column cursor left, for yc", ScopeList, "left"),
+			binding(SectionList, "column_right", "column cursor right, for yc", ScopeList, "right"),
+
+			// Actions
+			binding(SectionList, "describe", "describe", ScopeList, "d"),
+			binding(SectionList, "search", "search", ScopeList, "/"),
+			binding(SectionList, "refresh", "refresh", ScopeList, "ctrl+r", "r"),
+			binding(SectionList, "sort", "sort", ScopeList, "o"),
+			binding(SectionList, "reverse_sort", "reverse the sort", ScopeList, "O"),
+			binding(SectionList, "toggle_yaml", "details as YAML; in the table, copy (yy row, yc cell, yi ID, ya ARN, yj JSON)", ScopeList, "y"),
+			binding(SectionList, "copy_arn", "copy ARN", ScopeList, "c"),
+			binding(SectionList, "copy_json", "copy JSON", ScopeList, "C"),
+			binding(SectionList, "bookmark", "bookmark", ScopeList, "m"),
+			binding(SectionList, "filter_tags", "filter by tags", ScopeList, "t"),
+			binding(SectionList, "edit_tags", "edit tags", ScopeList, "T"),
+			binding(SectionList, "diff", "mark for diff / diff", ScopeList, "="),
+			binding(SectionList, "mark", "mark for a batch action", ScopeList, " "),
+			binding(SectionList, "open_with", "open with", ScopeList, "|"),
+			binding(SectionList, "config_history", "configuration history", ScopeList, "~"),
+		},
+		actions: make(map[string]map[string]string),
+	}
+}
+
+// Apply remaps the bindings named in the global and list sections of keybindings, and
+// the actions of resource types in the actions section. A binding's value is its keys,
+// separated by spaces, such as "ctrl+r f5"; "space" stands for the space bar.
+func (k KeyMap) Apply(global, list map[string]string, actions map[string]map[string]string) (KeyMap, error) {
+	remapped := KeyMap{
+		Bindings: slices.Clone(k.Bindings),
+		actions:  make(map[string]map[string]string),
 	}
+
+	for section, values := range map[string]map[string]string{SectionGlobal: global, SectionList: list} {
+		for name, value := range values {
+			i := slices.IndexFunc(remapped.Bindings, func(b Binding) bool {
+				return b.Name == name && b.Section == section
+			})
+			if i < 0 {
+				return k, fmt.Errorf("%s has no binding %q", section, name)
+			}
+			keys, err := parseKeys(value)
+			if err != nil {
+				return k, fmt.Errorf("%s.%s: %w", section, name, err)
+			}
+			remapped.Bindings[i].Keys = keys
+		}
+	}
+
+	// A key can only trigger one binding where both apply
+	for i, a := range remapped.Bindings {
+		for _, b := range remapped.Bindings[i+1:] {
+			if a.Scope&b.Scope == 0 {
+				continue
+			}
+			for _, key := range a.Keys {
+				if slices.Contains(b.Keys, key) {
+					return k, fmt.Errorf("%s is bound to both %s and %s", displayKey(key), a.Name, b.Name)
+				}
+			}
+		}
+	}
+
+	for resourceType, names := range actions {
+		remapped.actions[resourceType] = make(map[string]string, len(names))
+		for name, value := range names {
+			keys, err := parseKeys(value)
+			if err != nil {
+				return k, fmt.Errorf("actions.%s.%s: %w", resourceType, name, err)
+			}
+			if len(keys) > 1 {
+				return k, fmt.Errorf("actions.%s.%s: an action takes a single key", resourceType, name)
+			}
+			remapped.actions[resourceType][name] = keys[0]
+		}
+	}
+	return remapped, nil
 }
 
-// ShortHelp returns keybindings to show in the help view
-func (k KeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Up, k.Down, k.Enter, k.Search, k.Command, k.Describe, k.Quit, k.Help}
+// parseKeys reads the space-separated keys of a binding
+func parseKeys(value string) ([]string, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no key given")
+	}
+	keys := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field == "space" {
+			field = " "
+		}
+		if !validKey(field) {
+			return nil, fmt.Errorf("unknown key %q", field)
+		}
+		if !slices.Contains(keys, field) {
+			keys = append(keys, field)
+		}
+	}
+	return keys, nil
+}
+
+// Translate maps a key pressed where scope applies to the key the views check for. A
+// remapped binding's keys stand for its first default key, and the defaults it no longer
+// uses are dropped: ok is false for them.
+func (k KeyMap) Translate(msg tea.KeyMsg, scope Scope) (translated tea.KeyMsg, ok bool) {
+	pressed := msg.String()
+	freed := false
+	for _, b := range k.Bindings {
+		if b.Scope&scope == 0 {
+			continue
+		}
+		if slices.Contains(b.Keys, pressed) {
+			if slices.Contains(b.Defaults, pressed) {
+				return msg, true
+			}
+			return keyMsg(b.Defaults[0]), true
+		}
+		if slices.Contains(b.Defaults, pressed) {
+			freed = true
+		}
+	}
+	return msg, !freed
+}
+
+// Key returns the first key of a binding as the footer shows it, e.g. "ctrl+r"
+func (k KeyMap) Key(name string) string {
+	for _, b := range k.Bindings {
+		if b.Name == name {
+			return displayKey(b.Keys[0])
+		}
+	}
+	return ""
+}
+
+// RemapActions gives a resource type's actions the keys the actions section of
+// keybindings sets. It fails, leaving the actions as they are, when an action named
+// there doesn't exist or two actions would share a key.
+func (k KeyMap) RemapActions(resourceType string, actions []handlers.Action) ([]handlers.Action, error) {
+	names := k.actions[resourceType]
+	if len(names) == 0 {
+		return actions, nil
+	}
+
+	remapped := slices.Clone(actions)
+	for name, key := range names {
+		i := slices.IndexFunc(remapped, func(a handlers.Action) bool { return a.Name == name })
+		if i < 0 {
+			return actions, fmt.Errorf("%s has no action %q", resourceType, name)
+		}
+		remapped[i].Key = key
+	}
+	for i, a := range remapped {
+		for _, b := range remapped[i+1:] {
+			if a.Key == b.Key && !(a.Batch && b.Batch) {
+				return actions, fmt.Errorf("%s is bound to both %s and %s of %s", displayKey(a.Key), a.Name, b.Name, resourceType)
+			}
+		}
+	}
+	return remapped, nil
+}
+
+// ActionKeys returns the action keys keybindings sets, by resource type and action name
+func (k KeyMap) ActionKeys() map[string]map[string]string {
+	return k.actions
+}
+
+// keyTypes are the named keys, such as "enter" or "ctrl+r", by name
+var keyTypes = func() map[string]tea.KeyType {
+	types := make(map[string]tea.KeyType)
+	for t := tea.KeyType(-256); t < 256; t++ {
+		if name := t.String(); name != "" && t != tea.KeyRunes {
+			types[name] = t
+		}
+	}
+	return types
+}()
+
+// validKey reports whether a key can be pressed: a single character or a named key,
+// either one optionally with alt+
+func validKey(key string) bool {
+	key = strings.TrimPrefix(key, "alt+")
+	if _, ok := keyTypes[key]; ok {
+		return true
+	}
+	return len([]rune(key)) == 1
+}
+
+// keyMsg builds the message of a key being pressed
+func keyMsg(key string) tea.KeyMsg {
+	alt := false
+	if len(key) > len("alt+") && strings.HasPrefix(key, "alt+") {
+		alt = true
+		key = strings.TrimPrefix(key, "alt+")
+	}
+	if t, ok := keyTypes[key]; ok {
+		return tea.KeyMsg{Type: t, Alt: alt}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key), Alt: alt}
+}
+
+// KeysText lists keys the way config.yaml takes them, e.g. "k up"
+func KeysText(keys []string) string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = displayKey(key)
+	}
+	return strings.Join(names, " ")
 }
 
-// FullHelp returns all keybindings for the help view
-func (k KeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{
-		{k.Up, k.Down, k.Left, k.Right},
-		{k.HalfPageUp, k.HalfPageDown, k.Top, k.Bottom},
-		{k.Search, k.Command, k.Escape},
-		{k.Enter, k.Describe, k.Edit, k.Refresh},
-		{k.CopyID, k.CopyJSON, k.ToggleYAML},
-		{k.Bookmark, k.GoToBookmark, k.FilterByTag},
-		{k.Quit, k.Help},
+// displayKey names a key for help text, spelling out the space bar
+func displayKey(key string) string {
+	if key == " " {
+		return "space"
 	}
+	return key
 }
@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/app"
+)
+
+// SetLaunch sets the view to open, with its search, once AWS is initialized. The launch's
+// profile and region are applied to the config before the app is created.
+func (a *App) SetLaunch(launch app.Launch) {
+	if launch.View == "" {
+		a.launch = nil
+		return
+	}
+	a.launch = &launch
+}
+
+// openLaunch opens the view the app was started on. It runs only after the first
+// initialization, so switching profile later doesn't open it again. The view is run as
+// the built-in command, as an alias of the same name could do anything.
+func (a *App) openLaunch(initErr error) tea.Cmd {
+	launch := a.launch
+	a.launch = nil
+	if launch == nil || initErr != nil {
+		return nil
+	}
+
+	_, cmd := a.executeBuiltin(launch.View)
+	if launch.Filter != "" && a.state == StateResourceList {
+		a.resourceList.FilterOnLoad(launch.Filter)
+		a.footer.SetMessage(fmt.Sprintf("Searching for %q, / then esc clears it", launch.Filter), false)
+	}
+	return cmd
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// mfaCodePattern is the six digit code an MFA device shows
+var mfaCodePattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+// errMFACancelled is what loading the credentials fails with when the MFA prompt is dismissed
+var errMFACancelled = errors.New("MFA code entry cancelled")
+
+// mfaPrompt is a credential provider waiting for the code of an MFA device. It comes from
+// the goroutine loading the credentials, which blocks until reply gets an answer.
+type mfaPrompt struct {
+	serial string
+	reply  chan mfaReply
+}
+
+// mfaReply answers an mfaPrompt with a code, or the error to fail with
+type mfaReply struct {
+	code string
+	err  error
+}
+
+// promptMFA asks for the code of an MFA device from a credential provider. The prompt
+// is handed to Update, and the provider waits until it is answered.
+func (a *App) promptMFA(serial string) (string, error) {
+	prompt := &mfaPrompt{serial: serial, reply: make(chan mfaReply, 1)}
+	a.mfaPrompts <- prompt
+	reply := <-prompt.reply
+	return reply.code, reply.err
+}
+
+// waitForMFAPrompt delivers the next MFA prompt to Update
+func (a *App) waitForMFAPrompt() tea.Cmd {
+	return func() tea.Msg {
+		return <-a.mfaPrompts
+	}
+}
+
+// queueMFAPrompt shows an MFA prompt, or queues it behind the one being answered
+func (a *App) queueMFAPrompt(prompt *mfaPrompt) {
+	a.mfaQueue = append(a.mfaQueue, prompt)
+	if len(a.mfaQueue) == 1 {
+		a.openMFADialog()
+	}
+}
+
+// openMFADialog asks for the code of the first queued prompt
+func (a *App) openMFADialog() {
+	prompt := a.mfaQueue[0]
+	a.mfaDialog.Reset()
+	a.mfaDialog.SetMessage(fmt.Sprintf("The credentials need a code from the MFA device\n%s", prompt.serial))
+	a.mfaDialog.RequireTextInput("MFA code", "", "123456", 6)
+	a.mfaDialog.SetWidth(a.width)
+}
+
+// handleMFAPrompt handles keys while an MFA prompt is open. It takes precedence over every
+// other view, as a credential provider is blocked on it.
+func (a *App) handleMFAPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		code := strings.TrimSpace(a.mfaDialog.GetInput())
+		if !mfaCodePattern.MatchString(code) {
+			a.footer.SetMessage("Enter the 6 digit code shown by the MFA device", true)
+			return a, nil
+		}
+		a.answerMFAPrompt(mfaReply{code: code})
+		a.footer.SetMessage("Checking MFA code...", false)
+	case "esc", "ctrl+c":
+		a.answerMFAPrompt(mfaReply{err: errMFACancelled})
+	default:
+		var cmd tea.Cmd
+		a.mfaDialog, cmd = a.mfaDialog.Update(msg)
+		return a, cmd
+	}
+	return a, nil
+}
+
+// answerMFAPrompt unblocks the first queued prompt and moves on to the next
+func (a *App) answerMFAPrompt(reply mfaReply) {
+	a.mfaQueue[0].reply <- reply
+	a.mfaQueue = a.mfaQueue[1:]
+	a.mfaDialog.Reset()
+	if len(a.mfaQueue) > 0 {
+		a.openMFADialog()
+	}
+}
+
+// overlayMFAPrompt draws the MFA prompt over the content
+func (a *App) overlayMFAPrompt(content string) string {
+	lines := strings.Split(content, "\n")
+	if len(lines) > 5 {
+		lines = lines[5:]
+	}
+	return a.mfaDialog.View() + "\n" + strings.Join(lines, "\n")
+}
@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+	"github.com/aaw-tui/aws-tui/internal/ui/components"
+)
+
+// Resolving the accounts of profiles
+const (
+	accountResolveWorkers = 4
+	accountResolveTimeout = 15 * time.Second
+)
+
+// accountsResolvedMsg is sent when the accounts of profiles the selector lacked are resolved
+type accountsResolvedMsg struct {
+	accounts map[string]config.ProfileAccount
+	failed   []string // Profiles whose account couldn't be resolved
+}
+
+// ssoLoginFinishedMsg is sent when the SSO login process completes
+type ssoLoginFinishedMsg struct {
+	profile string
+	err     error
+}
+
+// showProfiles opens the profile selector with the accounts and SSO sessions known so far,
+// resolving in the background the accounts it doesn't know yet
+func (a *App) showProfiles() tea.Cmd {
+	a.selector.ShowProfiles(a.profiles, a.clientMgr.Profile(), a.profileStatus())
+	return a.resolveAccounts()
+}
+
+// profileStatus returns the account and SSO session of each profile
+func (a *App) profileStatus() map[string]components.ProfileStatus {
+	status := make(map[string]components.ProfileStatus, len(a.profiles))
+	for _, p := range a.profiles {
+		account, _ := a.accountStore.Get(p.Name)
+		status[p.Name] = components.ProfileStatus{
+			AccountID: account.AccountID,
+			Alias:     account.Alias,
+			SSOExpiry: config.SSOExpiry(p),
+		}
+	}
+	return status
+}
+
+// resolveAccounts looks up the account of each profile not resolved yet or resolved too
+// long ago, backing off from those that failed. Profiles that would need an MFA code or an
+// SSO login are left for later, and those that would run a credential_process, which may
+// be slow or interactive, until they are used.
+func (a *App) resolveAccounts() tea.Cmd {
+	if a.resolvingAccounts {
+		return nil
+	}
+	var profiles []string
+	for _, p := range a.profiles {
+		if account, ok := a.accountStore.Get(p.Name); ok && !account.Due() {
+			continue
+		}
+		if p.MFASerial != "" || (p.IsSSO && !config.SSOExpiry(p).After(time.Now())) {
+			continue
+		}
+		if a.runsCredentialProcess(p.Name) {
+			continue
+		}
+		profiles = append(profiles, p.Name)
+	}
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	a.resolvingAccounts = true
+	return func() tea.Msg {
+		var mu sync.Mutex
+		accounts := make(map[string]config.ProfileAccount, len(profiles))
+		var failed []string
+		names := make(chan string)
+		var wg sync.WaitGroup
+		for range min(accountResolveWorkers, len(profiles)) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for name := range names {
+					ctx, cancel := context.WithTimeout(context.Background(), accountResolveTimeout)
+					accountID, alias, err := a.clientMgr.ResolveAccount(ctx, name)
+					cancel()
+					if err != nil {
+						mu.Lock()
+						failed = append(failed, name)
+						mu.Unlock()
+						continue
+					}
+					mu.Lock()
+					accounts[name] = config.ProfileAccount{AccountID: accountID, Alias: alias, ResolvedAt: time.Now()}
+					mu.Unlock()
+				}
+			}()
+		}
+		for _, name := range profiles {
+			names <- name
+		}
+		close(names)
+		wg.Wait()
+		return accountsResolvedMsg{accounts: accounts, failed: failed}
+	}
+}
+
+// runsCredentialProcess reports whether loading a profile's credentials runs a
+// credential_process, its own or that of a profile it takes its source credentials from
+func (a *App) runsCredentialProcess(name string) bool {
+	seen := make(map[string]bool)
+	for name != "" && !seen[name] {
+		seen[name] = true
+		next := ""
+		for _, p := range a.profiles {
+			if p.Name == name {
+				if p.CredentialProcess != "" {
+					return true
+				}
+				next = p.SourceProf
+			}
+		}
+		name = next
+	}
+	return false
+}
+
+// accountsResolved caches the resolved accounts, and the failures to back off from, and
+// shows them if the selector is open
+func (a *App) accountsResolved(msg accountsResolvedMsg) {
+	a.resolvingAccounts = false
+	if len(msg.accounts) == 0 && len(msg.failed) == 0 {
+		return
+	}
+	for profile, account := range msg.accounts {
+		a.accountStore.Set(profile, account)
+	}
+	for _, profile := range msg.failed {
+		a.accountStore.Fail(profile)
+	}
+	_ = a.accountStore.Save() // The accounts are resolved again next time if it fails
+	a.selector.SetProfileStatus(a.profileStatus())
+}
+
+// noteProfileAccount records the account of the profile now in use, so profiles left out
+// of the background lookups still show theirs in the selector once used. An assumed role's
+// account isn't the profile's, so it is left out.
+func (a *App) noteProfileAccount(profile, accountID string) {
+	if accountID == "" || a.clientMgr.AssumedRole() != nil {
+		return
+	}
+	stored, ok := a.accountStore.Get(profile)
+	if ok && stored.AccountID == accountID && !stored.Stale() {
+		return
+	}
+	account := config.ProfileAccount{AccountID: accountID}
+	if stored.AccountID == accountID {
+		account.Alias = stored.Alias
+	}
+	a.accountStore.Set(profile, account)
+	_ = a.accountStore.Save() // The account is recorded again next time if it fails
+}
+
+// ssoLogin runs aws sso login for a profile, the current one when empty
+func (a *App) ssoLogin(profile string) tea.Cmd {
+	if profile == "" {
+		profile = a.clientMgr.Profile()
+	}
+	c := exec.Command("aws", "sso", "login", "--profile", profile)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return ssoLoginFinishedMsg{profile: profile, err: err}
+	})
+}
+
+// ssoLoginRequested logs in to the SSO session of a profile picked in the selector
+func (a *App) ssoLoginRequested(profile string) tea.Cmd {
+	for _, p := range a.profiles {
+		if p.Name == profile && !p.IsSSO {
+			a.footer.SetMessage(fmt.Sprintf("%s isn't an SSO profile", profile), true)
+			return a.showProfiles()
+		}
+	}
+	return a.ssoLogin(profile)
+}
+
+// ssoLoginFinished retries what failed on the expired session after logging in to it
+// again, otherwise it reloads the current profile after logging in to its session, and
+// goes back to the profile selector after logging in to another one's
+func (a *App) ssoLoginFinished(msg ssoLoginFinishedMsg) tea.Cmd {
+	if msg.err != nil {
+		a.footer.SetMessage(fmt.Sprintf("SSO login of %s failed: %v", msg.profile, msg.err), true)
+		return nil
+	}
+	// Operations that failed on the expired session are retried in place
+	if a.expired != nil && a.expired.profile == msg.profile {
+		a.footer.SetMessage(fmt.Sprintf("SSO session of %s refreshed, retrying", msg.profile), false)
+		return a.retryExpired()
+	}
+	if msg.profile == a.clientMgr.Profile() {
+		a.footer.SetMessage("SSO session refreshed successfully", false)
+		// Re-initialize the client to pick up new credentials
+		return a.switchProfile(msg.profile)
+	}
+	a.footer.SetMessage(fmt.Sprintf("SSO session of %s refreshed", msg.profile), false)
+	return a.showProfiles()
+}
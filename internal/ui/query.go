@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/components"
+	"github.com/aaw-tui/aws-tui/internal/utils"
+)
+
+// queryTimeout bounds a query, describing the resource included, so an expression that
+// never ends, such as repeat(.), gives up
+const queryTimeout = 30 * time.Second
+
+// queryResultMsg carries the result of a jq query over a resource's details
+type queryResultMsg struct {
+	seq    int
+	expr   string
+	name   string // Resource queried
+	result string
+	err    error
+}
+
+// queryResource handles :jq <expression> and :q <expression>, running the query over
+// the details of the selected resource, as C copies them
+func (a *App) queryResource(expr string) (tea.Model, tea.Cmd) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		a.footer.SetMessage("Usage: :jq <expression>, e.g. :jq .Endpoint.Address", true)
+		return a, nil
+	}
+	handler := a.resourceList.Handler()
+	res := a.resourceList.GetSelectedResource()
+	if a.state != StateResourceList || handler == nil || res == nil {
+		a.footer.SetMessage("Select a resource to query first", true)
+		return a, nil
+	}
+	query, err := utils.ParseQuery(expr)
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Query: %v", err), true)
+		return a, nil
+	}
+
+	// ctrl+x cancels the query like a load, and a new query the one before
+	a.stopQuery()
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	a.cancelQuery = cancel
+	a.querySeq++
+	seq := a.querySeq
+
+	id, name := res.GetID(), res.GetName()
+	a.footer.SetLoading(true, fmt.Sprintf("Querying %s...", name))
+	return a, func() tea.Msg {
+		defer cancel()
+		details, err := handler.Describe(ctx, id)
+		if err != nil {
+			return queryResultMsg{seq: seq, expr: expr, name: name, err: err}
+		}
+		result, err := utils.RunQuery(ctx, query, details)
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("gave up after %s, does the expression end?", queryTimeout)
+		}
+		return queryResultMsg{seq: seq, expr: expr, name: name, result: result, err: err}
+	}
+}
+
+// stopQuery cancels the query in flight, if any, and drops its result
+func (a *App) stopQuery() bool {
+	if a.cancelQuery == nil {
+		return false
+	}
+	a.cancelQuery()
+	a.cancelQuery = nil
+	a.querySeq++
+	return true
+}
+
+// handleQueryResult shows the result of a query and copies it to the clipboard
+func (a *App) handleQueryResult(msg queryResultMsg) (tea.Model, tea.Cmd) {
+	if msg.seq != a.querySeq {
+		return a, nil
+	}
+	a.cancelQuery = nil
+	a.footer.SetLoading(false, "")
+	if msg.err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Query of %s failed: %v", msg.name, msg.err), true)
+		return a, nil
+	}
+	if msg.result == "" {
+		a.footer.SetMessage(fmt.Sprintf("%s of %s has no results", msg.expr, msg.name), false)
+		return a, nil
+	}
+	a.infoDialog.ShowText(fmt.Sprintf("%s of %s", msg.expr, msg.name), msg.result)
+	return a, components.CopyToClipboard(msg.result, "query result")
+}
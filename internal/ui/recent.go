@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/adapters/config"
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+)
+
+// recordRecent adds a resource described or acted on to the recent list. Only resources
+// of lists that can be opened again by their type are kept, which leaves out views such
+// as :audit and :recent itself.
+func (a *App) recordRecent(handler handlers.ResourceHandler, res handlers.Resource, action string) {
+	if _, ok := a.handlerForType(handler.ResourceType()); !ok {
+		return
+	}
+	err := a.recentStore.Add(config.RecentResource{
+		Name:         res.GetName(),
+		ResourceType: handler.ResourceType(),
+		ResourceID:   res.GetID(),
+		ARN:          res.GetARN(),
+		Region:       a.clientMgr.Region(),
+		Profile:      a.clientMgr.Profile(),
+		Action:       action,
+	})
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Couldn't save the recent resources: %v", err), true)
+	}
+}
+
+// openRecent handles :recent, listing the resources recently described or acted on
+func (a *App) openRecent() (tea.Model, tea.Cmd) {
+	handler := handlers.NewRecentHandler(a.recentStore)
+	a.state = StateResourceList
+	a.breadcrumb.SetPath("Recent")
+	a.header.SetContext("Recent")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// navigateToRecent opens the list of a recent resource and selects it
+func (a *App) navigateToRecent(recent config.RecentResource) (tea.Model, tea.Cmd) {
+	model, cmd := a.navigateToType(recent.ResourceType, recent.Region, recent.ResourceID)
+	if recent.Profile != "" && recent.Profile != a.clientMgr.Profile() {
+		a.footer.SetMessage(fmt.Sprintf("%s was viewed with profile %s, :profile %s to switch",
+			recent.Name, recent.Profile, recent.Profile), true)
+	}
+	return model, cmd
+}
@@ -0,0 +1,254 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/views"
+)
+
+// splitLayout is how the two resource lists of a split share the content area
+type splitLayout int
+
+const (
+	splitVertical   splitLayout = iota // Side by side
+	splitHorizontal                    // One above the other
+)
+
+// split is the resource list out of focus while the content area is split. The list in
+// focus stays a.resourceList, so commands and keys act on it as they do without a split.
+type split struct {
+	list    *views.ResourceListView
+	layout  splitLayout
+	path    []string // Breadcrumb of the list
+	context string   // Header context of the list
+
+	// The list in focus is the right or bottom one
+	focusSecond bool
+}
+
+// configureList applies the settings every resource list shares
+func (a *App) configureList(list *views.ResourceListView) {
+	list.SetRowMarker(a.reminderMarker)
+	list.SetOnVisit(a.recordRecent)
+	list.SetListLimits(a.config.MaxListItems, a.config.ListLimits)
+	list.SetFuzzySearch(a.config.FuzzySearch)
+	list.SetKeyMap(a.keys)
+	list.SetReadOnly(a.readOnly)
+}
+
+// openSplit handles :split [v|h]. It opens a second list showing the current one, beside
+// it or below it, and focuses it; with a split open it changes the layout.
+func (a *App) openSplit(args []string) (tea.Model, tea.Cmd) {
+	layout := splitVertical
+	if len(args) > 0 {
+		switch args[0] {
+		case "v", "vertical":
+		case "h", "horizontal":
+			layout = splitHorizontal
+		default:
+			a.footer.SetMessage("Usage: :split [v|h], side by side or one above the other", true)
+			return a, nil
+		}
+	}
+
+	if a.split != nil {
+		a.split.layout = layout
+		a.sizeLists()
+		return a, nil
+	}
+	if a.state != StateResourceList || a.resourceList.Handler() == nil {
+		a.footer.SetMessage("Open a resource list first, then :split it", true)
+		return a, nil
+	}
+
+	list := views.NewResourceListView(a.theme)
+	a.configureList(list)
+	list.SetHandler(a.resourceList.Handler())
+	a.split = &split{
+		list:        a.resourceList,
+		layout:      layout,
+		path:        a.breadcrumb.Path(),
+		context:     a.header.Context(),
+		focusSecond: true,
+	}
+	a.resourceList = list
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.footer.SetMessage(fmt.Sprintf("Split, %s focuses the other list and :only closes it", a.keys.Key("switch_split")), false)
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
+
+// focusOtherList swaps the focus between the lists of the split
+func (a *App) focusOtherList() {
+	if a.split == nil {
+		return
+	}
+	path, context := a.split.path, a.split.context
+	a.split.path, a.split.context = a.breadcrumb.Path(), a.header.Context()
+	a.resourceList, a.split.list = a.split.list, a.resourceList
+	a.split.focusSecond = !a.split.focusSecond
+
+	a.breadcrumb.SetPath(path...)
+	a.header.SetContext(context)
+	a.footer.SetHandlerActions(a.handlerActions())
+	page, hasMore, count := a.resourceList.GetPaginationInfo()
+	a.footer.SetPagination(page, hasMore, count)
+}
+
+// closeFocusedList closes the list in focus, leaving the other one on its own
+func (a *App) closeFocusedList() {
+	a.resourceList.CancelLoads()
+	a.focusOtherList()
+	a.closeSplit()
+}
+
+// closeSplit closes the list out of focus, handling :only
+func (a *App) closeSplit() {
+	if a.split == nil {
+		return
+	}
+	a.split.list.CancelLoads()
+	a.split = nil
+	a.sizeLists()
+}
+
+// handlePaneMsg hands a list's message to that list. The one in focus gets it as any
+// other message; the other one only updates itself.
+func (a *App) handlePaneMsg(msg views.PaneMsg) (tea.Model, tea.Cmd) {
+	if msg.List == a.resourceList {
+		return a.update(msg.Msg)
+	}
+	if a.split == nil || msg.List != a.split.list {
+		return a, nil // The list was closed since
+	}
+
+	if loaded, ok := msg.Msg.(views.ResourcesLoadedMsg); ok {
+		if !a.split.list.IsCurrent(loaded) {
+			return a, nil
+		}
+		if loaded.Error != nil {
+			a.footer.SetMessage(fmt.Sprintf("Error in the other list: %v", loaded.Error), true)
+		}
+	}
+	var cmd tea.Cmd
+	a.split.list, cmd = a.split.list.Update(msg.Msg)
+	return a, a.split.list.Tag(cmd)
+}
+
+// listResultMsg carries the result of an operation started on a list, so it refreshes
+// that list even when the focus has moved to the other list of a split since
+type listResultMsg struct {
+	list *views.ResourceListView
+	msg  tea.Msg
+}
+
+// forList ties the result of cmd to the list the operation runs on: the list in focus,
+// or while a result is handled, the list of that result, so follow-up polls keep it
+func (a *App) forList(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	list := a.targetList()
+	return func() tea.Msg {
+		return listResultMsg{list: list, msg: cmd()}
+	}
+}
+
+// handleListResult handles the result of an operation with its list as the target
+func (a *App) handleListResult(msg listResultMsg) (tea.Model, tea.Cmd) {
+	previous := a.resultList
+	a.resultList = msg.list
+	defer func() { a.resultList = previous }()
+	return a.update(msg.msg)
+}
+
+// targetList returns the list of the result being handled, or else the list in focus
+func (a *App) targetList() *views.ResourceListView {
+	if a.resultList != nil {
+		return a.resultList
+	}
+	return a.resourceList
+}
+
+// refreshList reloads the list of the result being handled. The other list of a split
+// gets its load tagged so it reaches it, and a list closed since isn't reloaded.
+func (a *App) refreshList() tea.Cmd {
+	switch list := a.targetList(); {
+	case list == a.resourceList:
+		return a.resourceList.Refresh()
+	case a.split != nil && list == a.split.list:
+		return a.split.list.Tag(a.split.list.Refresh())
+	default:
+		return nil
+	}
+}
+
+// sizeLists fits the resource list, or both lists of a split, into the content area. A
+// split gives each list a title line and divides the rest.
+func (a *App) sizeLists() {
+	width, height := a.width, a.calculateContentHeight()
+	if a.split == nil {
+		a.resourceList.SetSize(width, height)
+		return
+	}
+
+	first, second := a.splitLists()
+	switch a.split.layout {
+	case splitVertical:
+		firstWidth := (width - 1) / 2
+		first.SetSize(firstWidth, height-1)
+		second.SetSize(width-1-firstWidth, height-1)
+	case splitHorizontal:
+		firstHeight := height / 2
+		first.SetSize(width, firstHeight-1)
+		second.SetSize(width, height-firstHeight-1)
+	}
+}
+
+// splitLists returns the lists of the split in the order they show
+func (a *App) splitLists() (first, second *views.ResourceListView) {
+	if a.split.focusSecond {
+		return a.split.list, a.resourceList
+	}
+	return a.resourceList, a.split.list
+}
+
+// renderLists renders the resource list, or both lists of a split under their titles
+func (a *App) renderLists() string {
+	if a.split == nil {
+		return a.resourceList.View()
+	}
+
+	focusedTitle := lipgloss.NewStyle().Bold(true).Foreground(a.theme.Colors.Accent)
+	otherTitle := lipgloss.NewStyle().Foreground(a.theme.Colors.Muted)
+	pane := func(list *views.ResourceListView, path []string, focused bool) string {
+		style := otherTitle
+		if focused {
+			style = focusedTitle
+		}
+		view := list.View()
+		width := lipgloss.Width(view)
+		title := style.Width(width).MaxWidth(width).Render(" " + strings.Join(path, " › "))
+		return lipgloss.JoinVertical(lipgloss.Left, title, view)
+	}
+
+	focused := pane(a.resourceList, a.breadcrumb.Path(), true)
+	other := pane(a.split.list, a.split.path, false)
+	first, second := focused, other
+	if a.split.focusSecond {
+		first, second = other, focused
+	}
+
+	if a.split.layout == splitHorizontal {
+		return lipgloss.JoinVertical(lipgloss.Left, first, second)
+	}
+	separator := lipgloss.NewStyle().
+		Foreground(a.theme.Colors.Border).
+		Render(strings.TrimSuffix(strings.Repeat("│\n", a.calculateContentHeight()), "\n"))
+	return lipgloss.JoinHorizontal(lipgloss.Top, first, separator, second)
+}
@@ -2,6 +2,8 @@ package styles
 
 import (
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/utils"
 )
 
 // Colors defines the color palette
@@ -19,6 +21,7 @@ type Colors struct {
 	Border      lipgloss.Color
 	Selection   lipgloss.Color
 	SelectionFg lipgloss.Color
+	Stripe      lipgloss.Color // Background of alternate rows when zebra striping is on
 }
 
 // Theme defines the visual theme
@@ -39,12 +42,34 @@ type Theme struct {
 	ErrorMessage lipgloss.Style
 }
 
+// Density controls the vertical spacing of table rows
+type Density string
+
+const (
+	// DensityCompact renders one line per row
+	DensityCompact Density = "compact"
+	// DensityComfortable adds a spacer line below each row
+	DensityComfortable Density = "comfortable"
+)
+
+// ParseDensity returns the density named by s, defaulting to compact
+func ParseDensity(s string) Density {
+	if Density(s) == DensityComfortable {
+		return DensityComfortable
+	}
+	return DensityCompact
+}
+
 // TableStyles defines table-specific styles
 type TableStyles struct {
 	Header   lipgloss.Style
 	Row      lipgloss.Style
+	AltRow   lipgloss.Style // Style of every other row when Zebra is set
 	Selected lipgloss.Style
 	Cell     lipgloss.Style
+
+	Density Density
+	Zebra   bool
 }
 
 // DetailStyles defines detail view styles
@@ -71,6 +96,7 @@ func DefaultColors() Colors {
 		Border:      lipgloss.Color("240"), // Medium gray
 		Selection:   lipgloss.Color("57"),  // Dark purple
 		SelectionFg: lipgloss.Color("229"), // Light yellow
+		Stripe:      lipgloss.Color("236"), // Slightly lighter than background
 	}
 }
 
@@ -105,12 +131,16 @@ func DefaultTheme() Theme {
 				BorderBottom(true),
 			Row: lipgloss.NewStyle().
 				Foreground(c.Foreground),
+			AltRow: lipgloss.NewStyle().
+				Foreground(c.Foreground).
+				Background(c.Stripe),
 			Selected: lipgloss.NewStyle().
 				Bold(true).
 				Foreground(c.SelectionFg).
 				Background(c.Selection),
 			Cell: lipgloss.NewStyle().
 				Padding(0, 1),
+			Density: DensityCompact,
 		},
 
 		Detail: DetailStyles{
@@ -160,23 +190,14 @@ func DefaultTheme() Theme {
 
 // Helpers for common styling operations
 
-// Truncate truncates a string to a maximum width with ellipsis
+// Truncate truncates a string to a maximum display width with ellipsis
 func Truncate(s string, maxWidth int) string {
-	if len(s) <= maxWidth {
-		return s
-	}
-	if maxWidth <= 3 {
-		return s[:maxWidth]
-	}
-	return s[:maxWidth-3] + "..."
+	return utils.Truncate(s, maxWidth)
 }
 
-// PadRight pads a string to a minimum width
+// PadRight pads a string to a minimum display width
 func PadRight(s string, width int) string {
-	if len(s) >= width {
-		return s
-	}
-	return s + lipgloss.NewStyle().Width(width-len(s)).Render("")
+	return utils.PadRight(s, width)
 }
 
 // StatusIcon returns an icon based on status
@@ -1,8 +1,14 @@
 package styles
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"gopkg.in/yaml.v3"
@@ -12,9 +18,17 @@ import (
 type ThemeConfig struct {
 	Name   string       `yaml:"name"`
 	Colors ColorsConfig `yaml:"colors"`
+	Table  TableConfig  `yaml:"table"`
 }
 
-// ColorsConfig represents color configuration in YAML
+// TableConfig represents table display options in YAML
+type TableConfig struct {
+	Density string `yaml:"density"` // compact or comfortable
+	Zebra   bool   `yaml:"zebra"`
+}
+
+// ColorsConfig represents color configuration in YAML. A color is a 256-color ANSI code
+// such as "39" or a truecolor hex value such as "#268bd2".
 type ColorsConfig struct {
 	Primary     string `yaml:"primary"`
 	Secondary   string `yaml:"secondary"`
@@ -29,6 +43,7 @@ type ColorsConfig struct {
 	Border      string `yaml:"border"`
 	Selection   string `yaml:"selection"`
 	SelectionFg string `yaml:"selection_fg"`
+	Stripe      string `yaml:"stripe"`
 }
 
 // Built-in theme color palettes
@@ -47,6 +62,7 @@ var builtinThemes = map[string]ColorsConfig{
 		Border:      "240",
 		Selection:   "57",
 		SelectionFg: "229",
+		Stripe:      "236",
 	},
 	"dark": {
 		Primary:     "75",
@@ -62,6 +78,7 @@ var builtinThemes = map[string]ColorsConfig{
 		Border:      "238",
 		Selection:   "24",
 		SelectionFg: "255",
+		Stripe:      "234",
 	},
 	"light": {
 		Primary:     "27",
@@ -77,6 +94,7 @@ var builtinThemes = map[string]ColorsConfig{
 		Border:      "250",
 		Selection:   "153",
 		SelectionFg: "235",
+		Stripe:      "254",
 	},
 	"nord": {
 		Primary:     "110",
@@ -92,6 +110,7 @@ var builtinThemes = map[string]ColorsConfig{
 		Border:      "239",
 		Selection:   "60",
 		SelectionFg: "253",
+		Stripe:      "237",
 	},
 	"dracula": {
 		Primary:     "141",
@@ -107,6 +126,23 @@ var builtinThemes = map[string]ColorsConfig{
 		Border:      "239",
 		Selection:   "61",
 		SelectionFg: "253",
+		Stripe:      "236",
+	},
+	"solarized": {
+		Primary:     "#268bd2",
+		Secondary:   "#6c71c4",
+		Accent:      "#d33682",
+		Background:  "#002b36",
+		Foreground:  "#eee8d5",
+		Muted:       "#839496",
+		Success:     "#859900",
+		Warning:     "#b58900",
+		Error:       "#dc322f",
+		Info:        "#2aa198",
+		Border:      "#586e75",
+		Selection:   "#073642",
+		SelectionFg: "#fdf6e3",
+		Stripe:      "#073642",
 	},
 }
 
@@ -122,6 +158,9 @@ func LoadTheme(name string, configDir string) (Theme, error) {
 	themePath := filepath.Join(themesDir, name+".yaml")
 
 	theme, err := LoadThemeFromFile(themePath)
+	if os.IsNotExist(err) {
+		return DefaultTheme(), fmt.Errorf("no theme %q, the themes are %s", name, strings.Join(ThemeNames(configDir), ", "))
+	}
 	if err != nil {
 		// Fallback to default theme
 		return DefaultTheme(), err
@@ -141,8 +180,35 @@ func LoadThemeFromFile(path string) (Theme, error) {
 	if err := yaml.Unmarshal(data, &config); err != nil {
 		return Theme{}, err
 	}
+	if err := config.Colors.validate(); err != nil {
+		return Theme{}, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+
+	theme := NewThemeFromColors(config.Colors)
+	theme.Table.Density = ParseDensity(config.Table.Density)
+	theme.Table.Zebra = config.Table.Zebra
+	return theme, nil
+}
 
-	return NewThemeFromColors(config.Colors), nil
+// hexColor matches truecolor values, "#rgb" or "#rrggbb"
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// validate checks that each color set is an ANSI code from 0 to 255 or a hex value
+func (c ColorsConfig) validate() error {
+	v := reflect.ValueOf(c)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		color := v.Field(i).String()
+		if color == "" || hexColor.MatchString(color) {
+			continue
+		}
+		if n, err := strconv.Atoi(color); err == nil && n >= 0 && n <= 255 {
+			continue
+		}
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		return fmt.Errorf("%s: %q is neither an ANSI code from 0 to 255 nor a hex color like #268bd2", name, color)
+	}
+	return nil
 }
 
 // NewThemeFromColors creates a Theme from a ColorsConfig
@@ -161,6 +227,12 @@ func NewThemeFromColors(cfg ColorsConfig) Theme {
 		Border:      lipgloss.Color(cfg.Border),
 		Selection:   lipgloss.Color(cfg.Selection),
 		SelectionFg: lipgloss.Color(cfg.SelectionFg),
+		Stripe:      lipgloss.Color(cfg.Stripe),
+	}
+
+	// Themes written before zebra striping have no stripe color
+	if cfg.Stripe == "" {
+		c.Stripe = lipgloss.Color("236")
 	}
 
 	return Theme{
@@ -190,12 +262,16 @@ func NewThemeFromColors(cfg ColorsConfig) Theme {
 				BorderBottom(true),
 			Row: lipgloss.NewStyle().
 				Foreground(c.Foreground),
+			AltRow: lipgloss.NewStyle().
+				Foreground(c.Foreground).
+				Background(c.Stripe),
 			Selected: lipgloss.NewStyle().
 				Bold(true).
 				Foreground(c.SelectionFg).
 				Background(c.Selection),
 			Cell: lipgloss.NewStyle().
 				Padding(0, 1),
+			Density: DensityCompact,
 		},
 
 		Detail: DetailStyles{
@@ -249,5 +325,21 @@ func AvailableThemes() []string {
 	for name := range builtinThemes {
 		themes = append(themes, name)
 	}
+	slices.Sort(themes)
+	return themes
+}
+
+// ThemeNames returns the built-in themes and the custom ones in the themes directory
+// of configDir, sorted by name
+func ThemeNames(configDir string) []string {
+	themes := AvailableThemes()
+	files, _ := filepath.Glob(filepath.Join(configDir, "themes", "*.yaml"))
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".yaml")
+		if !slices.Contains(themes, name) {
+			themes = append(themes, name)
+		}
+	}
+	slices.Sort(themes)
 	return themes
 }
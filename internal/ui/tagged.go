@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	taggingadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/tagging"
+	"github.com/aaw-tui/aws-tui/internal/handlers"
+)
+
+// openTagged handles :tagged key=value..., listing the resources of every service in the
+// region that carry all the tags
+func (a *App) openTagged(args []string) (tea.Model, tea.Cmd) {
+	if len(args) == 0 {
+		a.footer.SetMessage("Usage: :tagged key=value..., with key=a,b for any of the values or key for any value", true)
+		return a, nil
+	}
+	filters := make([]taggingadapter.TagFilter, 0, len(args))
+	for _, arg := range args {
+		filter, err := taggingadapter.ParseTagFilter(arg)
+		if err != nil {
+			a.footer.SetMessage(fmt.Sprintf("Tagged: %v", err), true)
+			return a, nil
+		}
+		filters = append(filters, filter)
+	}
+
+	handler := handlers.NewTaggedHandler(a.clientMgr.Tagging(), a.registry, a.clientMgr.Region(), filters)
+	a.state = StateResourceList
+	a.breadcrumb.SetPath("Tagged", strings.Join(args, " "))
+	a.header.SetContext("Tagged")
+	a.resourceList.SetHandler(handler)
+	a.footer.SetHandlerActions(a.handlerActions())
+	a.loading = true
+	a.footer.SetLoading(true, fmt.Sprintf("Finding resources tagged %s...", strings.Join(args, " ")))
+	a.sizeLists()
+	return a, a.resourceList.LoadResources(context.Background(), "")
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aaw-tui/aws-tui/internal/app"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// loadTheme loads a built-in or custom theme, with the table settings of config.yaml
+// applied over the theme's own
+func loadTheme(cfg *app.Config, name string) (styles.Theme, error) {
+	theme, err := styles.LoadTheme(name, cfg.ConfigDir)
+	if cfg.TableDensity != "" {
+		theme.Table.Density = styles.ParseDensity(cfg.TableDensity)
+	}
+	if cfg.ZebraStripes != nil {
+		theme.Table.Zebra = *cfg.ZebraStripes
+	}
+	return theme, err
+}
+
+// switchTheme handles :theme [name]: without a name it lists the themes, with one it
+// restyles every view. Naming the current theme again reloads its file, so a custom
+// theme can be tried out while it is edited.
+func (a *App) switchTheme(args []string) {
+	if len(args) == 0 {
+		a.footer.SetMessage(fmt.Sprintf("Theme %s, the themes are %s", a.config.Theme,
+			strings.Join(styles.ThemeNames(a.config.ConfigDir), ", ")), false)
+		return
+	}
+
+	theme, err := loadTheme(a.config, args[0])
+	if err != nil {
+		a.footer.SetMessage(fmt.Sprintf("Theme: %v", err), true)
+		return
+	}
+	a.config.Theme = args[0]
+	a.setTheme(theme)
+	a.footer.SetMessage(fmt.Sprintf("Theme %s, set theme: %s in config.yaml to keep it", args[0], args[0]), false)
+}
+
+// setTheme restyles the app and every component with theme
+func (a *App) setTheme(theme styles.Theme) {
+	a.theme = theme
+	a.header.SetTheme(theme)
+	a.footer.SetTheme(theme)
+	a.breadcrumb.SetTheme(theme)
+	a.selector.SetTheme(theme)
+	a.bookmarkSelector.SetTheme(theme)
+	a.resourceList.SetTheme(theme)
+	a.secretEditor.SetTheme(theme)
+	a.secretCreator.SetTheme(theme)
+	a.confirmDialog.SetTheme(theme)
+	a.mfaDialog.SetTheme(theme)
+	a.infoDialog.SetTheme(theme)
+	a.diffView.SetTheme(theme)
+	a.policyPicker.SetTheme(theme)
+	a.testEventPicker.SetTheme(theme)
+	a.restoreWizard.SetTheme(theme)
+	a.capacityEditor.SetTheme(theme)
+	a.tagEditor.SetTheme(theme)
+	a.errorPanel.SetTheme(theme)
+	a.itemEditor.SetTheme(theme)
+	a.objectRestore.SetTheme(theme)
+	a.logTail.SetTheme(theme)
+	a.dashboard.SetTheme(theme)
+	a.commandOutput.SetTheme(theme)
+	a.changelog.SetTheme(theme)
+
+	if a.split != nil {
+		a.split.list.SetTheme(theme)
+	}
+
+	// Density changes how many rows fit
+	a.sizeLists()
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/aaw-tui/aws-tui/internal/version"
+)
+
+// releasesFetchedMsg carries the published releases, for the footer or :changelog
+type releasesFetchedMsg struct {
+	releases []version.Release
+	err      error
+	open     bool // Show them in the changelog
+}
+
+// fetchReleases gets the releases from GitHub in the background
+func fetchReleases(open bool) tea.Cmd {
+	return func() tea.Msg {
+		releases, err := version.FetchReleases(context.Background())
+		return releasesFetchedMsg{releases: releases, err: err, open: open}
+	}
+}
+
+// checkForUpdates looks for a newer release on startup, when check_for_updates is set.
+// Development builds aren't checked, as every release would look older or newer.
+func (a *App) checkForUpdates() tea.Cmd {
+	if !a.config.CheckForUpdates || !version.IsRelease() {
+		return nil
+	}
+	return fetchReleases(false)
+}
+
+// openChangelog shows the release notes, fetching them unless the update check already did
+func (a *App) openChangelog() tea.Cmd {
+	a.changelog.SetSize(a.width, a.height)
+	if a.releases != nil {
+		a.changelog.Show(a.releases, nil)
+		return nil
+	}
+	a.changelog.ShowLoading()
+	return fetchReleases(true)
+}
+
+// releasesFetched keeps the releases and shows an available update in the footer. A
+// failed startup check stays quiet, it isn't something the user asked for.
+func (a *App) releasesFetched(msg releasesFetchedMsg) (tea.Model, tea.Cmd) {
+	if msg.err == nil {
+		a.releases = msg.releases
+		if latest := version.LatestUpdate(msg.releases); latest != nil {
+			a.footer.SetUpdateAvailable(latest.Tag)
+		}
+	}
+	if msg.open && a.changelog.IsVisible() {
+		a.changelog.Show(msg.releases, msg.err)
+	}
+	return a, nil
+}
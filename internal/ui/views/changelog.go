@@ -0,0 +1,266 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+	"github.com/aaw-tui/aws-tui/internal/version"
+)
+
+// ChangelogView shows the release notes of the published releases, marking the ones newer
+// than the running version
+type ChangelogView struct {
+	theme   styles.Theme
+	visible bool
+
+	releases []version.Release
+	loading  bool
+	err      error
+
+	lines  []string // Rendered for the current width
+	scroll int
+
+	width  int
+	height int
+}
+
+// NewChangelogView creates a new changelog view
+func NewChangelogView(theme styles.Theme) *ChangelogView {
+	return &ChangelogView{theme: theme}
+}
+
+// ShowLoading opens the view while the releases are fetched
+func (v *ChangelogView) ShowLoading() {
+	v.visible = true
+	v.loading = true
+	v.err = nil
+	v.releases = nil
+	v.scroll = 0
+	v.render()
+}
+
+// Show opens the view on the releases, or the error fetching them
+func (v *ChangelogView) Show(releases []version.Release, err error) {
+	v.visible = true
+	v.loading = false
+	v.releases = releases
+	v.err = err
+	v.scroll = 0
+	v.render()
+}
+
+// Hide closes the view
+func (v *ChangelogView) Hide() {
+	v.visible = false
+	v.lines = nil
+}
+
+// IsVisible returns whether the view is open
+func (v *ChangelogView) IsVisible() bool {
+	return v.visible
+}
+
+// SetTheme sets the theme the view renders with
+func (v *ChangelogView) SetTheme(theme styles.Theme) {
+	v.theme = theme
+}
+
+// SetSize sets the view dimensions
+func (v *ChangelogView) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	if v.visible {
+		v.render()
+	}
+}
+
+func (v *ChangelogView) pageSize() int {
+	return max(v.height-6, 1)
+}
+
+func (v *ChangelogView) maxScroll() int {
+	return max(len(v.lines)-v.pageSize(), 0)
+}
+
+// render lays the release notes out for the current width
+func (v *ChangelogView) render() {
+	headStyle := lipgloss.NewStyle().Bold(true).Foreground(v.theme.Colors.Primary)
+	newStyle := lipgloss.NewStyle().Bold(true).Foreground(v.theme.Colors.Success)
+	mutedStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+	textStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Foreground)
+	headingStyle := lipgloss.NewStyle().Bold(true).Foreground(v.theme.Colors.Foreground)
+	errStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Error)
+
+	v.lines = nil
+	switch {
+	case v.loading:
+		v.lines = append(v.lines, mutedStyle.Render("Fetching releases..."))
+		return
+	case v.err != nil:
+		v.lines = append(v.lines, errStyle.Render(fmt.Sprintf("Couldn't fetch the releases: %v", v.err)))
+		return
+	case len(v.releases) == 0:
+		v.lines = append(v.lines, mutedStyle.Render("No releases have been published yet"))
+		return
+	}
+
+	width := max(v.width-6, 20)
+	current := version.Current()
+	for i, release := range v.releases {
+		if i > 0 {
+			v.lines = append(v.lines, "")
+		}
+
+		head := headStyle.Render(release.Tag)
+		if release.Name != "" && release.Name != release.Tag {
+			head += headStyle.Render(" " + release.Name)
+		}
+		if !release.PublishedAt.IsZero() {
+			head += mutedStyle.Render("  " + release.PublishedAt.Format("2006-01-02"))
+		}
+		switch {
+		case release.Tag == current:
+			head += mutedStyle.Render("  (running)")
+		case version.Newer(release.Tag, current):
+			tag := "  NEW"
+			if release.Prerelease {
+				tag = "  NEW PRE-RELEASE"
+			}
+			head += newStyle.Render(tag)
+		}
+		v.lines = append(v.lines, head)
+
+		notes := strings.TrimSpace(strings.ReplaceAll(release.Notes, "\r\n", "\n"))
+		if notes == "" {
+			v.lines = append(v.lines, mutedStyle.Render("  No release notes"))
+			continue
+		}
+		for _, line := range strings.Split(notes, "\n") {
+			line = strings.TrimRight(line, " ")
+			style, indent := textStyle, "  "
+			switch trimmed := strings.TrimLeft(line, " "); {
+			case strings.HasPrefix(trimmed, "#"):
+				line = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+				style = headingStyle
+			case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+				depth := (len(line) - len(trimmed)) / 2
+				line = strings.Repeat("  ", depth) + "• " + trimmed[2:]
+				indent = "    " + strings.Repeat("  ", depth)
+			}
+			for j, wrapped := range wrapText(line, width-2) {
+				prefix := "  "
+				if j > 0 {
+					prefix = indent
+				}
+				v.lines = append(v.lines, style.Render(prefix+wrapped))
+			}
+		}
+		if release.URL != "" {
+			v.lines = append(v.lines, mutedStyle.Render("  "+release.URL))
+		}
+	}
+}
+
+// wrapText breaks a line at spaces so it fits the width, cutting words longer than it
+func wrapText(line string, width int) []string {
+	if lipgloss.Width(line) <= width {
+		return []string{line}
+	}
+	var wrapped []string
+	current := ""
+	for _, word := range strings.Fields(line) {
+		for width > 0 && lipgloss.Width(word) > width {
+			if current != "" {
+				wrapped = append(wrapped, current)
+				current = ""
+			}
+			head := runewidth.Truncate(word, width, "")
+			if head == "" {
+				head = string([]rune(word)[:1])
+			}
+			wrapped = append(wrapped, head)
+			word = word[len(head):]
+		}
+		switch {
+		case current == "":
+			current = word
+		case lipgloss.Width(current)+1+lipgloss.Width(word) <= width:
+			current += " " + word
+		default:
+			wrapped = append(wrapped, current)
+			current = word
+		}
+	}
+	if current != "" {
+		wrapped = append(wrapped, current)
+	}
+	return wrapped
+}
+
+// Update handles messages
+func (v *ChangelogView) Update(msg tea.Msg) (*ChangelogView, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !v.visible {
+		return v, nil
+	}
+	switch keyMsg.String() {
+	case "esc", "q":
+		v.Hide()
+	case "j", "down":
+		v.scroll = min(v.scroll+1, v.maxScroll())
+	case "k", "up":
+		v.scroll = max(v.scroll-1, 0)
+	case "ctrl+d", "pgdown":
+		v.scroll = min(v.scroll+v.pageSize()/2, v.maxScroll())
+	case "ctrl+u", "pgup":
+		v.scroll = max(v.scroll-v.pageSize()/2, 0)
+	case "g":
+		v.scroll = 0
+	case "G":
+		v.scroll = v.maxScroll()
+	}
+	return v, nil
+}
+
+// View renders the changelog
+func (v *ChangelogView) View() string {
+	if !v.visible {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(v.theme.Colors.Primary)
+	helpStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+
+	running := "running " + version.Current()
+	if !version.IsRelease() {
+		running += ", a development build"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("Changelog"))
+	sb.WriteString(helpStyle.Render(fmt.Sprintf("  [%s]", running)))
+	sb.WriteString("\n")
+
+	end := min(v.scroll+v.pageSize(), len(v.lines))
+	for i := v.scroll; i < end; i++ {
+		sb.WriteString(v.lines[i])
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(helpStyle.Render("j/k: scroll | g/G: top/bottom | esc: close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(v.theme.Colors.Primary).
+		Width(v.width - 2).
+		Height(v.height - 2).
+		Render(sb.String())
+}
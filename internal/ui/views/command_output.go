@@ -0,0 +1,390 @@
+package views
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+)
+
+// commandOutputMaxLines caps the buffered output so chatty commands stay bounded
+const commandOutputMaxLines = 10000
+
+// CommandOutputMsg carries output read from a running command, and its result once it exits
+type CommandOutputMsg struct {
+	ID       int
+	Lines    []commandLine
+	Done     bool
+	ExitCode int
+	Err      error
+}
+
+// commandLine is a line of output and whether it was written to stderr
+type commandLine struct {
+	text   string
+	stderr bool
+}
+
+// commandEvent is sent from the reader goroutines to the view
+type commandEvent struct {
+	line     commandLine
+	done     bool
+	exitCode int
+	err      error
+}
+
+// CommandOutputView runs an external command and streams its output into a pane
+type CommandOutputView struct {
+	theme   styles.Theme
+	visible bool
+	title   string
+
+	cmd     *exec.Cmd
+	events  chan commandEvent
+	id      int // Incremented on each Run so output from a closed command is dropped
+	running bool
+	killed  bool
+	started time.Time
+	elapsed time.Duration
+
+	lines    []commandLine
+	exitCode int
+	err      error
+
+	follow bool
+	scroll int
+
+	width  int
+	height int
+}
+
+// NewCommandOutputView creates a new command output view
+func NewCommandOutputView(theme styles.Theme) *CommandOutputView {
+	return &CommandOutputView{theme: theme}
+}
+
+// Run starts the command and opens the pane to stream its output. onExit, if set, is called
+// once the command has exited or failed to start, from another goroutine, with its exit
+// code, -1 if it was killed.
+func (v *CommandOutputView) Run(title string, cmd *exec.Cmd, onExit func(exitCode int, err error)) tea.Cmd {
+	if onExit == nil {
+		onExit = func(int, error) {}
+	}
+
+	v.detach()
+	v.id++
+	v.title = title
+	v.cmd = cmd
+	v.lines = nil
+	v.exitCode = 0
+	v.err = nil
+	v.killed = false
+	v.follow = true
+	v.scroll = 0
+	v.visible = true
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		v.err = err
+		onExit(-1, err)
+		return nil
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		v.err = err
+		onExit(-1, err)
+		return nil
+	}
+
+	if err := cmd.Start(); err != nil {
+		v.err = err
+		onExit(-1, err)
+		return nil
+	}
+
+	v.running = true
+	v.started = time.Now()
+	v.events = make(chan commandEvent, 256)
+
+	events := v.events
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go readCommandLines(stdout, false, events, &wg)
+	go readCommandLines(stderr, true, events, &wg)
+
+	go func() {
+		// Pipes must be drained before Wait, which closes them
+		wg.Wait()
+		err := cmd.Wait()
+
+		exitCode := 0
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			exitCode = exitErr.ExitCode()
+			err = nil
+		}
+		onExit(exitCode, err)
+
+		events <- commandEvent{done: true, exitCode: exitCode, err: err}
+		close(events)
+	}()
+
+	return v.waitForOutput()
+}
+
+func readCommandLines(r io.Reader, stderr bool, events chan<- commandEvent, wg *sync.WaitGroup) {
+	defer wg.Done()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		events <- commandEvent{line: commandLine{text: scanner.Text(), stderr: stderr}}
+	}
+}
+
+// waitForOutput blocks until the command writes output or exits, then drains whatever
+// else is already buffered so fast output is rendered in batches
+func (v *CommandOutputView) waitForOutput() tea.Cmd {
+	id := v.id
+	events := v.events
+
+	return func() tea.Msg {
+		msg := CommandOutputMsg{ID: id}
+
+		event, ok := <-events
+		for {
+			if !ok {
+				msg.Done = true
+				return msg
+			}
+			if event.done {
+				msg.Done = true
+				msg.ExitCode = event.exitCode
+				msg.Err = event.err
+				return msg
+			}
+			msg.Lines = append(msg.Lines, event.line)
+
+			select {
+			case event, ok = <-events:
+			default:
+				return msg
+			}
+		}
+	}
+}
+
+// kill stops the running command, if any
+func (v *CommandOutputView) kill() {
+	if v.running && v.cmd != nil && v.cmd.Process != nil {
+		_ = v.cmd.Process.Kill()
+		v.killed = true
+	}
+}
+
+// detach kills a running command and drains its output in the background, so the reader
+// goroutines can finish and the process is reaped once nothing is listening anymore
+func (v *CommandOutputView) detach() {
+	if !v.running {
+		return
+	}
+	v.kill()
+	v.running = false
+	go func(events chan commandEvent) {
+		for range events {
+		}
+	}(v.events)
+}
+
+// Hide closes the pane, killing the command if it is still running
+func (v *CommandOutputView) Hide() {
+	v.detach()
+	v.visible = false
+	v.id++
+	v.lines = nil
+}
+
+// IsVisible returns whether the pane is open
+func (v *CommandOutputView) IsVisible() bool {
+	return v.visible
+}
+
+// SetTheme sets the theme the view renders with
+func (v *CommandOutputView) SetTheme(theme styles.Theme) {
+	v.theme = theme
+}
+
+// SetSize sets the view dimensions
+func (v *CommandOutputView) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+}
+
+func (v *CommandOutputView) pageSize() int {
+	size := v.height - 6
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+func (v *CommandOutputView) maxScroll() int {
+	max := len(v.lines) - v.pageSize()
+	if max < 0 {
+		return 0
+	}
+	return max
+}
+
+// Update handles messages
+func (v *CommandOutputView) Update(msg tea.Msg) (*CommandOutputView, tea.Cmd) {
+	switch msg := msg.(type) {
+	case CommandOutputMsg:
+		if msg.ID != v.id {
+			return v, nil
+		}
+		v.lines = append(v.lines, msg.Lines...)
+		if len(v.lines) > commandOutputMaxLines {
+			dropped := len(v.lines) - commandOutputMaxLines
+			v.lines = v.lines[dropped:]
+			v.scroll -= dropped
+			if v.scroll < 0 {
+				v.scroll = 0
+			}
+		}
+		if v.follow {
+			v.scroll = v.maxScroll()
+		}
+		if msg.Done {
+			v.running = false
+			v.elapsed = time.Since(v.started)
+			v.exitCode = msg.ExitCode
+			v.err = msg.Err
+			return v, nil
+		}
+		return v, v.waitForOutput()
+
+	case tea.KeyMsg:
+		if !v.visible {
+			return v, nil
+		}
+		switch msg.String() {
+		case "esc", "q":
+			v.Hide()
+		case "ctrl+c", "x":
+			v.kill()
+		case "j", "down":
+			if v.scroll < v.maxScroll() {
+				v.scroll++
+			}
+			v.follow = v.scroll >= v.maxScroll()
+		case "k", "up":
+			if v.scroll > 0 {
+				v.scroll--
+			}
+			v.follow = false
+		case "ctrl+d":
+			v.scroll += v.pageSize() / 2
+			if v.scroll > v.maxScroll() {
+				v.scroll = v.maxScroll()
+			}
+			v.follow = v.scroll >= v.maxScroll()
+		case "ctrl+u":
+			v.scroll -= v.pageSize() / 2
+			if v.scroll < 0 {
+				v.scroll = 0
+			}
+			v.follow = false
+		case "g":
+			v.scroll = 0
+			v.follow = false
+		case "G":
+			v.scroll = v.maxScroll()
+			v.follow = true
+		}
+	}
+
+	return v, nil
+}
+
+// View renders the pane
+func (v *CommandOutputView) View() string {
+	if !v.visible {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(v.theme.Colors.Primary)
+	outStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Foreground)
+	errStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Error)
+	okStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Success)
+	helpStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+
+	var status string
+	switch {
+	case v.running:
+		status = helpStyle.Render(fmt.Sprintf("  [running %s, %d lines]", time.Since(v.started).Round(time.Second), len(v.lines)))
+	case v.err != nil:
+		status = errStyle.Render(fmt.Sprintf("  [failed: %v]", v.err))
+	case v.killed:
+		status = errStyle.Render(fmt.Sprintf("  [killed after %s]", v.elapsed.Round(time.Millisecond)))
+	case v.exitCode != 0:
+		status = errStyle.Render(fmt.Sprintf("  [exit %d after %s]", v.exitCode, v.elapsed.Round(time.Millisecond)))
+	default:
+		status = okStyle.Render(fmt.Sprintf("  [done in %s]", v.elapsed.Round(time.Millisecond)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("$ %s", v.title)))
+	sb.WriteString(status)
+	sb.WriteString("\n")
+
+	if len(v.lines) == 0 && v.running {
+		sb.WriteString(helpStyle.Render("Waiting for output..."))
+		sb.WriteString("\n")
+	}
+
+	end := v.scroll + v.pageSize()
+	if end > len(v.lines) {
+		end = len(v.lines)
+	}
+	for i := v.scroll; i < end; i++ {
+		line := v.lines[i]
+
+		text := strings.ReplaceAll(line.text, "\t", "    ")
+		if v.width > 6 && lipgloss.Width(text) > v.width-6 {
+			text = runewidth.Truncate(text, v.width-6, "…")
+		}
+
+		if line.stderr {
+			sb.WriteString(errStyle.Render(text))
+		} else {
+			sb.WriteString(outStyle.Render(text))
+		}
+		sb.WriteString("\n")
+	}
+
+	help := "j/k: scroll | g/G: top/bottom | esc: close"
+	if v.running {
+		help = "j/k: scroll | g/G: top/bottom | x: kill | esc: kill and close"
+	}
+	sb.WriteString("\n")
+	sb.WriteString(helpStyle.Render(help))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(v.theme.Colors.Primary).
+		Width(v.width - 2).
+		Height(v.height - 2).
+		Render(sb.String())
+}
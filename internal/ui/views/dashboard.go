@@ -0,0 +1,577 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/aaw-tui/aws-tui/internal/ui/components"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+	"github.com/aaw-tui/aws-tui/internal/utils"
+)
+
+// sparkBlocks are the bar heights of a sparkline, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// chartBlocks fill a line of a chart's bar from the bottom, by eighths
+var chartBlocks = []rune(" ▁▂▃▄▅▆▇█")
+
+// DashboardWidget is a cell of the dashboard, refreshed on its own interval
+type DashboardWidget struct {
+	Title   string
+	Refresh time.Duration
+	Fetch   func(ctx context.Context) (*WidgetContent, error)
+}
+
+// WidgetContent is what a widget shows: rows of a table, or a metric series drawn
+// as a sparkline or a chart, with an optional summary line
+type WidgetContent struct {
+	Columns []string
+	Rows    [][]string
+	Series  []float64
+	Chart   bool   // Draw the series as a chart filling the widget
+	Unit    string // Unit of the chart's axis labels, e.g. %
+	Summary string
+	Empty   string // Shown when there are no rows or datapoints
+}
+
+// DashboardWidgetMsg carries the result of one widget fetch
+type DashboardWidgetMsg struct {
+	ID      int
+	Index   int
+	Gen     int
+	Content *WidgetContent
+	Err     error
+}
+
+// DashboardTickMsg triggers the next refresh of a widget
+type DashboardTickMsg struct {
+	ID    int
+	Index int
+	Gen   int
+}
+
+// widgetState is the latest result of a widget
+type widgetState struct {
+	content *WidgetContent
+	err     error
+	updated time.Time
+	loading bool
+	gen     int // Incremented on each fetch so refresh chains replaced by a manual one stop
+}
+
+// DashboardView shows a grid of widgets combining resource lists, alarms, metrics
+// and pipelines, each refreshing on its own interval
+type DashboardView struct {
+	theme   styles.Theme
+	visible bool
+	title   string
+	columns int
+
+	widgets []DashboardWidget
+	states  []widgetState
+	focus   int
+	id      int // Incremented on each Show so results for a closed dashboard are dropped
+
+	// Shimmers the widgets still waiting for their first result
+	loader *components.LoadingIndicator
+
+	width  int
+	height int
+}
+
+// NewDashboardView creates a new dashboard view
+func NewDashboardView(theme styles.Theme) *DashboardView {
+	return &DashboardView{
+		theme:  theme,
+		loader: components.NewLoadingIndicator(components.PaneMetrics),
+	}
+}
+
+// Show opens a dashboard and fetches every widget
+func (v *DashboardView) Show(title string, columns int, widgets []DashboardWidget) tea.Cmd {
+	v.id++
+	v.title = title
+	v.columns = columns
+	if v.columns < 1 {
+		v.columns = 1
+	}
+	v.widgets = widgets
+	v.states = make([]widgetState, len(widgets))
+	v.focus = 0
+	v.visible = true
+
+	cmds := make([]tea.Cmd, 0, len(widgets)+1)
+	for i := range widgets {
+		cmds = append(cmds, v.fetch(i))
+	}
+	if len(widgets) > 0 {
+		cmds = append(cmds, v.loader.Start(""))
+	}
+	return tea.Batch(cmds...)
+}
+
+// Hide closes the dashboard and stops its refreshes
+func (v *DashboardView) Hide() {
+	v.visible = false
+	v.id++
+	v.widgets = nil
+	v.states = nil
+	v.loader.Stop()
+}
+
+// IsVisible returns whether the dashboard is open
+func (v *DashboardView) IsVisible() bool {
+	return v.visible
+}
+
+// SetTheme sets the theme the view renders with
+func (v *DashboardView) SetTheme(theme styles.Theme) {
+	v.theme = theme
+}
+
+// SetSize sets the view dimensions
+func (v *DashboardView) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+}
+
+// fetch refreshes a widget, replacing any scheduled refresh of it
+func (v *DashboardView) fetch(index int) tea.Cmd {
+	state := &v.states[index]
+	state.gen++
+	state.loading = true
+
+	id, gen := v.id, state.gen
+	widget := v.widgets[index]
+	return func() tea.Msg {
+		content, err := widget.Fetch(context.Background())
+		return DashboardWidgetMsg{ID: id, Index: index, Gen: gen, Content: content, Err: err}
+	}
+}
+
+// waiting returns whether a widget has yet to get its first result
+func (v *DashboardView) waiting() bool {
+	for _, state := range v.states {
+		if state.loading && state.content == nil && state.err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *DashboardView) scheduleTick(index, gen int) tea.Cmd {
+	id := v.id
+	return tea.Tick(v.widgets[index].Refresh, func(time.Time) tea.Msg {
+		return DashboardTickMsg{ID: id, Index: index, Gen: gen}
+	})
+}
+
+// Update handles messages
+func (v *DashboardView) Update(msg tea.Msg) (*DashboardView, tea.Cmd) {
+	if !v.visible {
+		return v, nil
+	}
+
+	switch msg := msg.(type) {
+	case components.LoadingTickMsg:
+		return v, v.loader.Update(msg)
+
+	case DashboardWidgetMsg:
+		if msg.ID != v.id || msg.Gen != v.states[msg.Index].gen {
+			return v, nil
+		}
+		state := &v.states[msg.Index]
+		state.loading = false
+		state.err = msg.Err
+		if msg.Err == nil {
+			state.content = msg.Content
+			state.updated = time.Now()
+		}
+		if !v.waiting() {
+			v.loader.Stop()
+		}
+		return v, v.scheduleTick(msg.Index, msg.Gen)
+
+	case DashboardTickMsg:
+		if msg.ID != v.id || msg.Gen != v.states[msg.Index].gen {
+			return v, nil
+		}
+		return v, v.fetch(msg.Index)
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q":
+			v.Hide()
+		case "tab", "l", "right":
+			v.focus = (v.focus + 1) % len(v.widgets)
+		case "shift+tab", "h", "left":
+			v.focus = (v.focus + len(v.widgets) - 1) % len(v.widgets)
+		case "j", "down":
+			if v.focus+v.columns < len(v.widgets) {
+				v.focus += v.columns
+			}
+		case "k", "up":
+			if v.focus-v.columns >= 0 {
+				v.focus -= v.columns
+			}
+		case "r":
+			return v, v.fetch(v.focus)
+		case "R":
+			cmds := make([]tea.Cmd, 0, len(v.widgets))
+			for i := range v.widgets {
+				cmds = append(cmds, v.fetch(i))
+			}
+			return v, tea.Batch(cmds...)
+		}
+	}
+
+	return v, nil
+}
+
+// View renders the dashboard
+func (v *DashboardView) View() string {
+	if !v.visible {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(v.theme.Colors.Primary)
+	helpStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Dashboard: %s", v.title)))
+	sb.WriteString(helpStyle.Render(fmt.Sprintf("  [%d widgets]", len(v.widgets))))
+	sb.WriteString("\n")
+
+	if len(v.widgets) == 0 {
+		sb.WriteString(helpStyle.Render("This dashboard has no widgets"))
+		sb.WriteString("\n")
+	} else {
+		rows := (len(v.widgets) + v.columns - 1) / v.columns
+		cellWidth := v.width / v.columns
+		cellHeight := (v.height - 3) / rows
+		if cellHeight < 4 {
+			cellHeight = 4
+		}
+
+		for row := 0; row < rows; row++ {
+			cells := make([]string, 0, v.columns)
+			for col := 0; col < v.columns; col++ {
+				i := row*v.columns + col
+				if i >= len(v.widgets) {
+					break
+				}
+				cells = append(cells, v.renderWidget(i, cellWidth, cellHeight))
+			}
+			sb.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString(helpStyle.Render("tab/arrows: select widget | r: refresh widget | R: refresh all | esc: close"))
+
+	return sb.String()
+}
+
+// renderWidget renders a widget as a bordered cell of the given outer size
+func (v *DashboardView) renderWidget(index, width, height int) string {
+	widget := v.widgets[index]
+	state := v.states[index]
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(v.theme.Colors.Foreground)
+	mutedStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+	errStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Error)
+	border := v.theme.Colors.Border
+	if index == v.focus {
+		border = v.theme.Colors.Primary
+		titleStyle = titleStyle.Foreground(v.theme.Colors.Accent)
+	}
+
+	// Inside the border
+	innerWidth := width - 2
+	innerHeight := height - 2
+	if innerWidth < 10 {
+		innerWidth = 10
+	}
+	if innerHeight < 2 {
+		innerHeight = 2
+	}
+
+	status := "loading..."
+	if !state.updated.IsZero() {
+		status = utils.RelativeTime(state.updated, time.Now())
+		if state.loading {
+			status += ", refreshing"
+		}
+	}
+	header := titleStyle.Render(styles.Truncate(widget.Title, innerWidth-lipgloss.Width(status)-1))
+	gap := innerWidth - lipgloss.Width(header) - lipgloss.Width(status)
+	if gap < 1 {
+		gap = 1
+	}
+	lines := []string{header + strings.Repeat(" ", gap) + mutedStyle.Render(status)}
+
+	if state.err != nil {
+		lines = append(lines, errStyle.Render(styles.Truncate(state.err.Error(), innerWidth)))
+	}
+	if content := state.content; content != nil {
+		lines = append(lines, v.renderContent(content, innerWidth, innerHeight-len(lines))...)
+	} else if state.loading && state.err == nil && v.loader.IsActive() {
+		if shimmer := v.loader.Shimmer(innerWidth, innerHeight-len(lines)); shimmer != "" {
+			lines = append(lines, strings.Split(shimmer, "\n")...)
+		}
+	}
+	if len(lines) > innerHeight {
+		lines = lines[:innerHeight]
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(border).
+		Width(innerWidth).
+		Height(innerHeight).
+		Render(strings.Join(lines, "\n"))
+}
+
+// renderContent renders a widget's rows, sparkline or chart into at most height lines
+func (v *DashboardView) renderContent(content *WidgetContent, width, height int) []string {
+	mutedStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(v.theme.Colors.Muted)
+
+	var lines []string
+	if content.Summary != "" {
+		lines = append(lines, styles.Truncate(content.Summary, width))
+	}
+
+	if content.Series != nil {
+		if len(content.Series) == 0 {
+			return append(lines, mutedStyle.Render(content.Empty))
+		}
+		style := lipgloss.NewStyle().Foreground(v.theme.Colors.Primary)
+		if chartHeight := height - len(lines); content.Chart && chartHeight > 1 {
+			for _, line := range Chart(content.Series, width, chartHeight, content.Unit) {
+				lines = append(lines, style.Render(line))
+			}
+			return lines
+		}
+		return append(lines, style.Render(Sparkline(content.Series, width)))
+	}
+
+	if len(content.Rows) == 0 {
+		return append(lines, mutedStyle.Render(content.Empty))
+	}
+
+	widths := columnWidths(content.Columns, content.Rows, width)
+	if len(content.Columns) > 0 {
+		lines = append(lines, headerStyle.Render(formatRow(content.Columns, widths)))
+	}
+
+	// Leave a line to say how many rows didn't fit
+	available := height - len(lines)
+	shown := len(content.Rows)
+	if shown > available {
+		shown = available - 1
+		if shown < 0 {
+			shown = 0
+		}
+	}
+	for _, row := range content.Rows[:shown] {
+		lines = append(lines, v.renderRow(row, widths))
+	}
+	if hidden := len(content.Rows) - shown; hidden > 0 {
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf("... %d more", hidden)))
+	}
+	return lines
+}
+
+// renderRow renders a row with status values colored
+func (v *DashboardView) renderRow(row []string, widths []int) string {
+	cells := make([]string, 0, len(widths))
+	for i, width := range widths {
+		cell := ""
+		if i < len(row) {
+			cell = row[i]
+		}
+		text := styles.PadRight(styles.Truncate(cell, width), width)
+		if color, ok := dashboardStatusColor(v.theme, cell); ok {
+			text = lipgloss.NewStyle().Foreground(color).Render(text)
+		}
+		cells = append(cells, text)
+	}
+	return strings.Join(cells, " ")
+}
+
+// dashboardStatusColor colors states of alarms, services, instances and pipeline stages
+func dashboardStatusColor(theme styles.Theme, value string) (lipgloss.Color, bool) {
+	switch strings.ToUpper(value) {
+	case "ALARM", "FAILED", "STOPPED", "INACTIVE", "CANCELLED", "ABANDONED":
+		return theme.Colors.Error, true
+	case "OK", "SUCCEEDED", "ACTIVE", "RUNNING", "AVAILABLE":
+		return theme.Colors.Success, true
+	case "INSUFFICIENT_DATA", "INPROGRESS", "PENDING", "DRAINING", "STOPPING", "SUPERSEDED":
+		return theme.Colors.Warning, true
+	}
+	return "", false
+}
+
+// columnWidths fits the columns into width, shrinking the widest first
+func columnWidths(columns []string, rows [][]string, width int) []int {
+	count := len(columns)
+	for _, row := range rows {
+		if len(row) > count {
+			count = len(row)
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+
+	widths := make([]int, count)
+	measure := func(row []string) {
+		for i, cell := range row {
+			if w := utils.TextWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	measure(columns)
+	for _, row := range rows {
+		measure(row)
+	}
+
+	// Separators take one character between columns
+	available := width - (count - 1)
+	for {
+		total := 0
+		widest := 0
+		for i, w := range widths {
+			total += w
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if total <= available || widths[widest] <= 4 {
+			break
+		}
+		widths[widest]--
+	}
+	return widths
+}
+
+func formatRow(cells []string, widths []int) string {
+	parts := make([]string, 0, len(widths))
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		parts = append(parts, styles.PadRight(styles.Truncate(cell, width), width))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Sparkline draws values as a row of bars at most width wide. Longer series are
+// averaged into width buckets.
+func Sparkline(values []float64, width int) string {
+	if len(values) == 0 || width < 1 {
+		return ""
+	}
+
+	values = fitValues(values, width)
+	low, high := valueRange(values)
+
+	var sb strings.Builder
+	for _, value := range values {
+		level := 0
+		if high > low {
+			level = int((value - low) / (high - low) * float64(len(sparkBlocks)-1))
+		}
+		sb.WriteRune(sparkBlocks[level])
+	}
+	return sb.String()
+}
+
+// Chart draws values as bars height lines tall, with the axis labelled by the highest
+// and lowest values and at most width wide in all. Series without negative values are
+// drawn from zero, so the bars compare as the values do.
+func Chart(values []float64, width, height int, unit string) []string {
+	if len(values) == 0 || height < 1 {
+		return nil
+	}
+
+	bottom, top := valueRange(values)
+	bottom = math.Min(bottom, 0)
+	topLabel := strconv.FormatFloat(top, 'g', 4, 64) + unit
+	bottomLabel := strconv.FormatFloat(bottom, 'g', 4, 64) + unit
+	labelWidth := max(len(topLabel), len(bottomLabel))
+
+	plotWidth := width - labelWidth - 1
+	if plotWidth < 1 {
+		return []string{Sparkline(values, width)}
+	}
+	values = fitValues(values, plotWidth)
+
+	// Each bar's height in eighths of a line
+	eighths := make([]int, len(values))
+	if top > bottom {
+		for i, value := range values {
+			eighths[i] = int(math.Round((value - bottom) / (top - bottom) * float64(height*8)))
+		}
+	}
+
+	lines := make([]string, height)
+	for row := range lines {
+		label := ""
+		switch row {
+		case 0:
+			label = topLabel
+		case height - 1:
+			label = bottomLabel
+		}
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("%*s│", labelWidth, label))
+		base := (height - 1 - row) * 8 // Eighths below this line
+		for _, e := range eighths {
+			sb.WriteRune(chartBlocks[min(max(e-base, 0), 8)])
+		}
+		lines[row] = sb.String()
+	}
+	return lines
+}
+
+// fitValues averages values into width buckets when there are more of them
+func fitValues(values []float64, width int) []float64 {
+	if len(values) <= width {
+		return values
+	}
+	buckets := make([]float64, width)
+	for i := range buckets {
+		start := i * len(values) / width
+		end := (i + 1) * len(values) / width
+		sum := 0.0
+		for _, value := range values[start:end] {
+			sum += value
+		}
+		buckets[i] = sum / float64(end-start)
+	}
+	return buckets
+}
+
+// valueRange returns the lowest and highest of values
+func valueRange(values []float64) (low, high float64) {
+	low, high = math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		low = math.Min(low, value)
+		high = math.Max(high, value)
+	}
+	return low, high
+}
@@ -0,0 +1,506 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	logsadapter "github.com/aaw-tui/aws-tui/internal/adapters/aws/logs"
+	"github.com/aaw-tui/aws-tui/internal/ui/styles"
+	"github.com/aaw-tui/aws-tui/internal/utils"
+)
+
+// logTailPollInterval is how often the tail view polls for new events
+const logTailPollInterval = 2 * time.Second
+
+// logTailGroupPollInterval is how often whole log groups are polled. Filtering a group's
+// events is throttled to a few calls a second per account, shared by every tailed group.
+const logTailGroupPollInterval = 5 * time.Second
+
+// logTailMaxLines caps the buffered lines so long-running tails stay bounded
+const logTailMaxLines = 5000
+
+// logTailGroupBacklog is how far back a whole log group's tail starts
+const logTailGroupBacklog = time.Minute
+
+// logTailLateWindow is how far before the newest event a group is read again, for events
+// that are ingested late. Events already shown are skipped by ID.
+const logTailLateWindow = 30 * time.Second
+
+// LogTailSource is a single log stream, or every stream of a log group when Stream is
+// empty, followed by the tail view
+type LogTailSource struct {
+	Label  string // Prefix shown on each line, e.g. the container name
+	Group  string
+	Stream string
+	Client *cloudwatchlogs.Client
+}
+
+// LogTailEventsMsg carries events fetched by one poll of the tail view
+type LogTailEventsMsg struct {
+	ID      int
+	Events  []logTailLine
+	Cursors []logTailCursor
+	Errors  []error
+}
+
+// logTailCursor is where the next poll of a source continues from: the forward token of
+// a stream, or the newest event time of a group with the IDs of the events read since
+// shortly before it
+type logTailCursor struct {
+	token string
+	since time.Time
+	seen  map[string]time.Time
+}
+
+// LogTailTickMsg triggers the next poll of the tail view
+type LogTailTickMsg struct {
+	ID int
+}
+
+// logTailLine is a log event with the label of the source it came from
+type logTailLine struct {
+	source    int
+	timestamp time.Time
+	message   string
+}
+
+// LogTailView follows one or more log streams, merging their events by timestamp
+type LogTailView struct {
+	theme   styles.Theme
+	visible bool
+	title   string
+
+	sources []LogTailSource
+	cursors []logTailCursor
+	muted   []bool // Sources whose lines are hidden, toggled with the number keys
+	lines   []logTailLine
+	errs    []error
+	id      int // Incremented on each Show so polls from a closed tail are dropped
+
+	follow bool
+	paused bool
+	scroll int
+
+	width  int
+	height int
+}
+
+// NewLogTailView creates a new log tail view
+func NewLogTailView(theme styles.Theme) *LogTailView {
+	return &LogTailView{theme: theme}
+}
+
+// Show opens the tail view and starts polling the given sources
+func (v *LogTailView) Show(title string, sources []LogTailSource) tea.Cmd {
+	v.id++
+	v.title = title
+	v.sources = sources
+	v.cursors = make([]logTailCursor, len(sources))
+	v.muted = make([]bool, len(sources))
+	for i, src := range sources {
+		if src.Stream == "" {
+			v.cursors[i].since = time.Now().Add(-logTailGroupBacklog)
+		}
+	}
+	v.lines = nil
+	v.errs = nil
+	v.follow = true
+	v.paused = false
+	v.scroll = 0
+	v.visible = true
+	return v.poll()
+}
+
+// Hide closes the tail view and stops polling
+func (v *LogTailView) Hide() {
+	v.visible = false
+	v.id++
+	v.sources = nil
+	v.lines = nil
+}
+
+// IsVisible returns whether the tail view is open
+func (v *LogTailView) IsVisible() bool {
+	return v.visible
+}
+
+// SetTheme sets the theme the view renders with
+func (v *LogTailView) SetTheme(theme styles.Theme) {
+	v.theme = theme
+}
+
+// SetSize sets the view dimensions
+func (v *LogTailView) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+}
+
+// poll fetches new events from every source
+func (v *LogTailView) poll() tea.Cmd {
+	id := v.id
+	sources := v.sources
+	cursors := append([]logTailCursor(nil), v.cursors...)
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		msg := LogTailEventsMsg{
+			ID:      id,
+			Cursors: make([]logTailCursor, len(sources)),
+			Errors:  make([]error, len(sources)),
+		}
+
+		for i, src := range sources {
+			client := logsadapter.NewLogsClient(src.Client)
+			if src.Stream == "" {
+				var events []logsadapter.LogEvent
+				events, msg.Cursors[i], msg.Errors[i] = pollLogGroup(ctx, client, src.Group, cursors[i])
+				for _, event := range events {
+					msg.Events = append(msg.Events, logTailLine{
+						source:    i,
+						timestamp: event.Timestamp,
+						message:   strings.TrimRight(event.Message, "\n"),
+					})
+				}
+				continue
+			}
+
+			events, token, err := client.TailLogEvents(ctx, src.Group, src.Stream, cursors[i].token, 100)
+			msg.Cursors[i] = logTailCursor{token: token}
+			msg.Errors[i] = err
+			for _, event := range events {
+				msg.Events = append(msg.Events, logTailLine{
+					source:    i,
+					timestamp: event.Timestamp,
+					message:   strings.TrimRight(event.Message, "\n"),
+				})
+			}
+		}
+
+		// Merge sources into a single timeline
+		sort.SliceStable(msg.Events, func(a, b int) bool {
+			return msg.Events[a].timestamp.Before(msg.Events[b].timestamp)
+		})
+
+		return msg
+	}
+}
+
+// pollLogGroup reads the events of every stream in a group since the cursor, rereading a
+// window before it for late events and skipping the ones already read
+func pollLogGroup(ctx context.Context, client *logsadapter.LogsClient, group string, cursor logTailCursor) ([]logsadapter.LogEvent, logTailCursor, error) {
+	events, err := client.FilterLogEventsSince(ctx, group, cursor.since.Add(-logTailLateWindow), 500)
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	next := logTailCursor{since: cursor.since, seen: make(map[string]time.Time, len(cursor.seen))}
+	var fresh []logsadapter.LogEvent
+	for _, event := range events {
+		if _, ok := cursor.seen[event.EventID]; ok {
+			continue
+		}
+		fresh = append(fresh, event)
+		next.seen[event.EventID] = event.Timestamp
+		if event.Timestamp.After(next.since) {
+			next.since = event.Timestamp
+		}
+	}
+	// Only the IDs inside the window are needed to skip events read again
+	for eventID, timestamp := range cursor.seen {
+		if !timestamp.Before(next.since.Add(-logTailLateWindow)) {
+			next.seen[eventID] = timestamp
+		}
+	}
+	return fresh, next, nil
+}
+
+func (v *LogTailView) scheduleTick() tea.Cmd {
+	id := v.id
+	interval := logTailPollInterval
+	for _, src := range v.sources {
+		if src.Stream == "" {
+			interval = logTailGroupPollInterval
+			break
+		}
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return LogTailTickMsg{ID: id}
+	})
+}
+
+func (v *LogTailView) pageSize() int {
+	size := v.height - 6
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// shown returns the lines of the sources that aren't muted
+func (v *LogTailView) shown() []logTailLine {
+	if !slices.Contains(v.muted, true) {
+		return v.lines
+	}
+	var lines []logTailLine
+	for _, line := range v.lines {
+		if line.source >= len(v.muted) || !v.muted[line.source] {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func (v *LogTailView) maxScroll() int {
+	max := len(v.shown()) - v.pageSize()
+	if max < 0 {
+		return 0
+	}
+	return max
+}
+
+// Update handles messages
+func (v *LogTailView) Update(msg tea.Msg) (*LogTailView, tea.Cmd) {
+	if !v.visible {
+		return v, nil
+	}
+
+	switch msg := msg.(type) {
+	case LogTailEventsMsg:
+		if msg.ID != v.id {
+			return v, nil
+		}
+		v.cursors = msg.Cursors
+		v.errs = msg.Errors
+		late := len(v.lines) > 0 && len(msg.Events) > 0 && msg.Events[0].timestamp.Before(v.lines[len(v.lines)-1].timestamp)
+		v.lines = append(v.lines, msg.Events...)
+		if late {
+			// Events ingested late, or from a slower group, go back in their place
+			sort.SliceStable(v.lines, func(a, b int) bool {
+				return v.lines[a].timestamp.Before(v.lines[b].timestamp)
+			})
+		}
+		if len(v.lines) > logTailMaxLines {
+			dropped := len(v.lines) - logTailMaxLines
+			v.lines = v.lines[dropped:]
+			v.scroll -= dropped
+			if v.scroll < 0 {
+				v.scroll = 0
+			}
+		}
+		if v.follow {
+			v.scroll = v.maxScroll()
+		}
+		return v, v.scheduleTick()
+
+	case LogTailTickMsg:
+		if msg.ID != v.id {
+			return v, nil
+		}
+		if v.paused {
+			return v, v.scheduleTick()
+		}
+		return v, v.poll()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q":
+			v.Hide()
+		case "j", "down":
+			if v.scroll < v.maxScroll() {
+				v.scroll++
+			}
+			v.follow = v.scroll >= v.maxScroll()
+		case "k", "up":
+			if v.scroll > 0 {
+				v.scroll--
+			}
+			v.follow = false
+		case "ctrl+d":
+			v.scroll += v.pageSize() / 2
+			if v.scroll > v.maxScroll() {
+				v.scroll = v.maxScroll()
+			}
+			v.follow = v.scroll >= v.maxScroll()
+		case "ctrl+u":
+			v.scroll -= v.pageSize() / 2
+			if v.scroll < 0 {
+				v.scroll = 0
+			}
+			v.follow = false
+		case "g":
+			v.scroll = 0
+			v.follow = false
+		case "G", "f":
+			// Jump to the end and follow new events
+			v.scroll = v.maxScroll()
+			v.follow = true
+		case "p", " ":
+			v.paused = !v.paused
+		case "c":
+			v.lines = nil
+			v.scroll = 0
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9", "0":
+			// Mute or unmute a source, 0 being the tenth
+			i := int(msg.String()[0]-'0') - 1
+			if i < 0 {
+				i = 9
+			}
+			if len(v.sources) > 1 && i < len(v.muted) {
+				v.muted[i] = !v.muted[i]
+				v.clampScroll()
+			}
+		case "u":
+			clear(v.muted)
+			v.clampScroll()
+		}
+	}
+
+	return v, nil
+}
+
+// clampScroll keeps the scroll position inside the shown lines after muting changes them
+func (v *LogTailView) clampScroll() {
+	if v.follow || v.scroll > v.maxScroll() {
+		v.scroll = v.maxScroll()
+	}
+}
+
+// labelColors are used to tell sources apart when several are tailed together
+var labelColors = []string{"39", "212", "78", "214", "141", "81", "204", "226"}
+
+// View renders the tail view
+func (v *LogTailView) View() string {
+	if !v.visible {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(v.theme.Colors.Primary)
+	timeStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+	msgStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Foreground)
+	errStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Error)
+	helpStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+
+	status := "following"
+	switch {
+	case v.paused:
+		status = "paused"
+	case !v.follow:
+		status = "scrolled"
+	}
+
+	lines := v.shown()
+	multi := len(v.sources) > 1
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Tail: %s", v.title)))
+	sb.WriteString(helpStyle.Render(fmt.Sprintf("  [%s, %d lines]", status, len(lines))))
+	sb.WriteString("\n")
+
+	// The legend numbers the first sources for muting, dimming the muted ones
+	legendLines := 0
+	if multi {
+		sb.WriteString(v.legend())
+		sb.WriteString("\n")
+		legendLines++
+	}
+
+	// Show the latest error per source, the tail keeps polling regardless
+	errLines := 0
+	for i, err := range v.errs {
+		if err != nil && i < len(v.sources) {
+			sb.WriteString(errStyle.Render(fmt.Sprintf("%s: %v", v.sources[i].Label, err)))
+			sb.WriteString("\n")
+			errLines++
+		}
+	}
+
+	pageSize := v.pageSize() - errLines - legendLines
+	if pageSize < 1 {
+		pageSize = 1
+	}
+
+	if len(lines) == 0 {
+		sb.WriteString(helpStyle.Render("Waiting for log events..."))
+		sb.WriteString("\n")
+	}
+
+	end := v.scroll + pageSize
+	if end > len(lines) {
+		end = len(lines)
+	}
+	for i := v.scroll; i < end; i++ {
+		line := lines[i]
+
+		var prefix string
+		if multi && line.source < len(v.sources) {
+			color := labelColors[line.source%len(labelColors)]
+			prefix = lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(v.sources[line.source].Label) + " "
+		}
+
+		// Keep each event on one line; the time and label take up the front of it
+		message := strings.ReplaceAll(line.message, "\t", "  ")
+		available := v.width - 6 - 9 - lipgloss.Width(prefix)
+		if available > 0 && lipgloss.Width(message) > available {
+			message = runewidth.Truncate(message, available, "…")
+		}
+
+		sb.WriteString(timeStyle.Render(line.timestamp.In(utils.TimeLocation()).Format("15:04:05")))
+		sb.WriteString(" ")
+		sb.WriteString(prefix)
+		sb.WriteString(msgStyle.Render(message))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	help := "j/k: scroll | G/f: follow | p: pause | c: clear | esc: close"
+	if multi {
+		help = "j/k: scroll | G/f: follow | p: pause | c: clear | 1-9,0: mute | u: unmute all | esc: close"
+	}
+	sb.WriteString(helpStyle.Render(help))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(v.theme.Colors.Primary).
+		Width(v.width - 2).
+		Height(v.height - 2).
+		Render(sb.String())
+}
+
+// legend lists the sources in their colors, numbered for the mute keys, as far as they fit
+func (v *LogTailView) legend() string {
+	mutedStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted).Strikethrough(true)
+	moreStyle := lipgloss.NewStyle().Foreground(v.theme.Colors.Muted)
+
+	var entries []string
+	width := 0
+	for i, src := range v.sources {
+		label := src.Label
+		if i < 10 {
+			label = fmt.Sprintf("%d %s", (i+1)%10, label)
+		}
+		entry := lipgloss.NewStyle().Foreground(lipgloss.Color(labelColors[i%len(labelColors)])).Render(label)
+		if v.muted[i] {
+			entry = mutedStyle.Render(label)
+		}
+
+		more := moreStyle.Render(fmt.Sprintf("+%d more", len(v.sources)-i))
+		if width > 0 && width+lipgloss.Width(entry)+2+lipgloss.Width(more)+2 > v.width-6 && i < len(v.sources)-1 {
+			entries = append(entries, more)
+			break
+		}
+		entries = append(entries, entry)
+		width += lipgloss.Width(entry) + 2
+	}
+	return strings.Join(entries, "  ")
+}
@@ -2,27 +2,85 @@ package views
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/aaw-tui/aws-tui/internal/handlers"
 	"github.com/aaw-tui/aws-tui/internal/ui/components"
+	"github.com/aaw-tui/aws-tui/internal/ui/keys"
 	"github.com/aaw-tui/aws-tui/internal/ui/styles"
 )
 
 // ResourcesLoadedMsg indicates resources have been loaded
 type ResourcesLoadedMsg struct {
+	Resources   []handlers.Resource
+	NextToken   string
+	TruncatedAt int // The item cap, if it cut the list short
+	Error       error
+
+	// Append marks a page streamed in after the loaded resources. Seq drops a result whose
+	// list has since been reloaded or replaced by another handler's.
+	Append bool
+	Seq    int
+}
+
+// serverSearchDelay is how long typing in a search pauses before AWS is searched too
+const serverSearchDelay = 400 * time.Millisecond
+
+// ServerSearchMsg fires once typing in a search pauses, to search AWS for the query
+type ServerSearchMsg struct {
+	Seq   int
+	Query string
+}
+
+// ServerSearchResultMsg carries the resources a search found on the server
+type ServerSearchResultMsg struct {
+	Seq       int
 	Resources []handlers.Resource
-	NextToken string
 	Error     error
 }
 
 // ResourceDetailLoadedMsg indicates resource details have been loaded
 type ResourceDetailLoadedMsg struct {
-	Details map[string]interface{}
-	Error   error
+	ResourceID string
+	Details    map[string]interface{}
+	Error      error
+}
+
+// DiffMarkedMsg indicates a resource was marked (or unmarked) for diffing
+type DiffMarkedMsg struct {
+	Name    string
+	Cleared bool
+}
+
+// DiffLoadedMsg carries the describe output of two resources to compare
+type DiffLoadedMsg struct {
+	LeftName  string
+	RightName string
+	Left      map[string]interface{}
+	Right     map[string]interface{}
+	Error     error
+}
+
+// ShowErrorsMsg asks the app to open the error panel
+type ShowErrorsMsg struct{}
+
+// YankPendingMsg tells the app that y was pressed and the next key picks what to copy
+type YankPendingMsg struct{}
+
+// YankCancelledMsg tells the app that the key after y didn't pick anything to copy
+type YankCancelledMsg struct{}
+
+// PaneMsg carries a message of one resource list of a split, so it reaches that list
+// whichever one has the focus when it arrives
+type PaneMsg struct {
+	List *ResourceListView
+	Msg  tea.Msg
 }
 
 // ActionMsg is a message returned by ExecuteAction to trigger navigation
@@ -31,6 +89,9 @@ type ActionMsg interface {
 	IsActionMsg()
 }
 
+// ErrReadOnly is returned for mutating actions while read-only mode is on
+var ErrReadOnly = errors.New("blocked in read-only mode (:ro to toggle)")
+
 // ActionErrorMsg indicates an action failed
 type ActionErrorMsg struct {
 	Error  error
@@ -48,11 +109,32 @@ type ResourceListView struct {
 	// State
 	resources       []handlers.Resource
 	filteredByTags  []handlers.Resource
-	activeTags      map[string]string
-	loading         bool
+	activeTags      components.TagConditions
+	tableLoader     *components.LoadingIndicator
 	error           error
 	showDetail      bool
 	detailFocus     bool
+	detailID        string // Resource whose details were last requested
+	selectOnLoad    string // Resource to select and describe once the next load completes
+	filterOnLoad    string // Search to apply once the next load completes
+	yankPending     bool   // y was pressed, the next key picks what to copy
+
+	// Called when a resource is described or has an action run on it
+	onVisit func(handler handlers.ResourceHandler, res handlers.Resource, action string)
+
+	// Diff mark, kept across handler changes so resources can be compared
+	// between drill-downs of the same type
+	diffMark        handlers.Resource
+	diffMarkHandler handlers.ResourceHandler
+
+	// IDs marked with space for batch actions, nil unless the handler supports them
+	marked map[string]bool
+
+	// Resources a search found on the server beyond the loaded list, the query searched
+	// for, and a sequence number that drops server searches superseded by later typing
+	searchExtra []handlers.Resource
+	serverQuery string
+	searchSeq   int
 
 	// Pagination state
 	nextToken    string
@@ -61,10 +143,33 @@ type ResourceListView struct {
 	hasMore      bool
 	totalLoaded  int
 
+	// Incremental handlers stream their pages in as the table is scrolled. loadSeq counts
+	// the full loads, so a result requested for an earlier one is dropped.
+	loadingMore bool
+	loadSeq     int
+
+	// Cancel the AWS calls in flight for the list, the details and a server search, so
+	// navigating away stops them rather than letting them finish for nothing
+	cancelList   context.CancelFunc
+	cancelDetail context.CancelFunc
+	cancelSearch context.CancelFunc
+	listLoading  bool // A full load of the list is in flight
+
 	// Dimensions
 	width  int
 	height int
 
+	// Block mutating handler actions
+	readOnly bool
+
+	// Key bindings, for the keys config.yaml gives handler actions
+	keys keys.KeyMap
+
+	// Caps on the items fetched per list, by handler shortcut with a default
+	maxItems        int
+	handlerMaxItems map[string]int
+	truncatedAt     int
+
 	// Theme
 	theme styles.Theme
 }
@@ -76,27 +181,181 @@ func NewResourceListView(theme styles.Theme) *ResourceListView {
 		detail:     components.NewDetail(theme),
 		search:     components.NewSearch(theme),
 		tagFilter:  components.NewTagFilter(theme),
-		activeTags: make(map[string]string),
-		theme:      theme,
+		activeTags:  make(components.TagConditions),
+		tableLoader: components.NewLoadingIndicator(components.PaneTable),
+		theme:       theme,
 	}
 }
 
-// SetHandler sets the resource handler
+// SetHandler sets the resource handler, cancelling the loads of the previous one
 func (v *ResourceListView) SetHandler(handler handlers.ResourceHandler) {
+	v.CancelLoads()
 	v.handler = handler
 	v.table.SetColumns(handler.Columns())
+	v.table.SetResources(nil)
 	v.resources = nil
 	v.filteredByTags = nil
-	v.activeTags = make(map[string]string)
+	v.activeTags = make(components.TagConditions)
 	v.tagFilter.ClearFilters()
 	v.detail.Clear()
 	v.showDetail = false
+	v.detailFocus = false
+	v.detail.Blur()
+	v.table.Focus()
+	v.selectOnLoad = ""
+	v.filterOnLoad = ""
+	v.marked = nil
+	if _, ok := handler.(handlers.MultiSelectHandler); ok {
+		v.marked = make(map[string]bool)
+	}
+	v.table.SetChecked(v.marked)
 	// Reset pagination
 	v.nextToken = ""
 	v.prevTokens = nil
 	v.currentPage = 1
 	v.hasMore = false
 	v.totalLoaded = 0
+	v.setTruncatedAt(0)
+	v.table.SetMore(false, false)
+	v.searchExtra = nil
+	v.serverQuery = ""
+	v.searchSeq++
+	v.search.SetServerSearch("")
+}
+
+// CancelLoads cancels the list load, streamed page, details and server search in flight,
+// and drops their results should they still arrive
+func (v *ResourceListView) CancelLoads() {
+	v.listLoading = false
+	stopCall(&v.cancelList)
+	stopCall(&v.cancelDetail)
+	stopCall(&v.cancelSearch)
+	v.loadSeq++
+	v.searchSeq++
+	v.loadingMore = false
+	v.tableLoader.Stop()
+	v.detailID = ""
+}
+
+// IsCurrent reports whether a load result belongs to the list shown, rather than one
+// that has since been reloaded or navigated away from
+func (v *ResourceListView) IsCurrent(msg ResourcesLoadedMsg) bool {
+	return msg.Seq == v.loadSeq
+}
+
+// LoadInFlight reports whether a load of the list or of the details is in flight, which
+// CancelLoads can stop
+func (v *ResourceListView) LoadInFlight() bool {
+	return v.listLoading || (v.cancelDetail != nil && v.detail.IsLoading())
+}
+
+// restartCall cancels the call in flight that cancel belongs to, and returns a context
+// for the call replacing it
+func restartCall(cancel *context.CancelFunc, parent context.Context) context.Context {
+	stopCall(cancel)
+	ctx, next := context.WithCancel(parent)
+	*cancel = next
+	return ctx
+}
+
+// stopCall cancels the call in flight that cancel belongs to, if any
+func stopCall(cancel *context.CancelFunc) {
+	if *cancel != nil {
+		(*cancel)()
+		*cancel = nil
+	}
+}
+
+// SetReadOnly blocks mutating handler actions while read-only mode is on
+func (v *ResourceListView) SetReadOnly(readOnly bool) {
+	v.readOnly = readOnly
+}
+
+// SetListLimits caps how many items a list fetches, overridden per handler shortcut.
+// A cap of 0 means no cap.
+func (v *ResourceListView) SetListLimits(maxItems int, perHandler map[string]int) {
+	v.maxItems = maxItems
+	v.handlerMaxItems = perHandler
+}
+
+// maxItemsFor returns the item cap for a handler
+func (v *ResourceListView) maxItemsFor(handler handlers.ResourceHandler) int {
+	if limit, ok := v.handlerMaxItems[handler.ShortcutKey()]; ok {
+		return limit
+	}
+	return v.maxItems
+}
+
+// SetKeyMap sets the key bindings, whose action keys override the handlers' own
+func (v *ResourceListView) SetKeyMap(keyMap keys.KeyMap) {
+	v.keys = keyMap
+}
+
+// Actions returns the handler's actions with the keys config.yaml gives them, or with
+// their own keys and the reason when those can't be applied
+func (v *ResourceListView) Actions() ([]handlers.Action, error) {
+	if v.handler == nil {
+		return nil, nil
+	}
+	return v.keys.RemapActions(v.handler.ResourceType(), v.handler.Actions())
+}
+
+// actions returns the handler's actions with the keys they are triggered by
+func (v *ResourceListView) actions() []handlers.Action {
+	actions, _ := v.Actions()
+	return actions
+}
+
+// TagFilterExpression returns the tag filter applied to the list as an expression, such
+// as env=prod|staging team!=ops !owner, empty without one
+func (v *ResourceListView) TagFilterExpression() string {
+	return v.activeTags.String()
+}
+
+// InputActive reports whether keys are being typed into the search or tag filter
+func (v *ResourceListView) InputActive() bool {
+	return v.search.IsActive() || v.tagFilter.IsActive()
+}
+
+// SetFuzzySearch switches / from substring to fuzzy matching, see Table.SetFuzzy
+func (v *ResourceListView) SetFuzzySearch(fuzzy bool) {
+	v.table.SetFuzzy(fuzzy)
+	v.search.SetFuzzy(fuzzy)
+}
+
+// SetRowMarker flags resources in the table, see Table.SetRowMarker
+func (v *ResourceListView) SetRowMarker(marker func(handlers.Resource) string) {
+	v.table.SetRowMarker(marker)
+}
+
+// SetOnVisit sets what is called when a resource is described, with an empty action, or
+// has an action run on it
+func (v *ResourceListView) SetOnVisit(onVisit func(handler handlers.ResourceHandler, res handlers.Resource, action string)) {
+	v.onVisit = onVisit
+}
+
+// visit reports a visit of the resource with id, if the list has it
+func (v *ResourceListView) visit(id, action string) {
+	if v.onVisit == nil || v.handler == nil {
+		return
+	}
+	for _, list := range [][]handlers.Resource{v.resources, v.searchExtra} {
+		for _, res := range list {
+			if res.GetID() == id {
+				v.onVisit(v.handler, res, action)
+				return
+			}
+		}
+	}
+}
+
+// SetTheme sets the theme the view renders with
+func (v *ResourceListView) SetTheme(theme styles.Theme) {
+	v.theme = theme
+	v.table.SetTheme(theme)
+	v.detail.SetTheme(theme)
+	v.search.SetTheme(theme)
+	v.tagFilter.SetTheme(theme)
 }
 
 // SetSize sets the view dimensions
@@ -107,6 +366,8 @@ func (v *ResourceListView) SetSize(width, height int) {
 	v.search.SetWidth(width)
 	v.tagFilter.SetSize(width, height)
 
+	// The truncation banner takes a line from the panes
+	height -= v.bannerHeight()
 	if v.showDetail {
 		// Split view: 60% table, 40% detail
 		tableWidth := width * 6 / 10
@@ -119,6 +380,24 @@ func (v *ResourceListView) SetSize(width, height int) {
 	}
 }
 
+// bannerHeight returns the lines the truncation banner takes, when it shows
+func (v *ResourceListView) bannerHeight() int {
+	if v.truncatedAt > 0 {
+		return 1
+	}
+	return 0
+}
+
+// setTruncatedAt records where the list was cut short, resizing the panes around the
+// banner that says so
+func (v *ResourceListView) setTruncatedAt(items int) {
+	if items == v.truncatedAt {
+		return
+	}
+	v.truncatedAt = items
+	v.SetSize(v.width, v.height)
+}
+
 // LoadResources loads resources from the handler
 func (v *ResourceListView) LoadResources(ctx context.Context, filter string) tea.Cmd {
 	return v.loadResourcesWithToken(ctx, filter, "")
@@ -130,22 +409,135 @@ func (v *ResourceListView) loadResourcesWithToken(ctx context.Context, filter, t
 		return nil
 	}
 
-	v.loading = true
+	v.error = nil
+	v.loadingMore = false
+	v.loadSeq++
+	ctx = restartCall(&v.cancelList, ctx)
+	v.listLoading = true
+	seq := v.loadSeq
+	handler := v.handler
+	maxItems := v.maxItemsFor(handler)
+	pageSize := 50 // Default page size
+	if size := v.incrementalPageSize(); size > 0 {
+		pageSize = size
+	}
 
-	return func() tea.Msg {
-		result, err := v.handler.List(ctx, handlers.ListOptions{
+	fetch := func() tea.Msg {
+		result, err := handler.List(ctx, handlers.ListOptions{
 			Filter:    filter,
 			NextToken: token,
-			PageSize:  50, // Default page size
+			PageSize:  pageSize,
+			MaxItems:  maxItems,
 		})
 		if err != nil {
-			return ResourcesLoadedMsg{Error: err}
+			return ResourcesLoadedMsg{Error: err, Seq: seq}
 		}
-		return ResourcesLoadedMsg{
+
+		msg := ResourcesLoadedMsg{
 			Resources: result.Resources,
 			NextToken: result.NextToken,
+			Seq:       seq,
+		}
+		// Handlers that can't stop early still fetch everything, but the table stays bounded
+		if maxItems > 0 && len(msg.Resources) > maxItems {
+			msg.Resources = msg.Resources[:maxItems]
+			result.Truncated = true
+		}
+		if result.Truncated {
+			msg.TruncatedAt = maxItems
 		}
+		return msg
 	}
+
+	return tea.Batch(v.tableLoader.Start("Loading..."), fetch)
+}
+
+// incrementalPageSize returns the page size of a handler that streams its pages in, or 0
+func (v *ResourceListView) incrementalPageSize() int {
+	if incremental, ok := v.handler.(handlers.IncrementalHandler); ok {
+		return incremental.IncrementalPageSize()
+	}
+	return 0
+}
+
+// loadMore streams the next page of an incremental handler into the table once the
+// cursor nears the end of the loaded rows
+func (v *ResourceListView) loadMore() tea.Cmd {
+	if v.incrementalPageSize() == 0 || !v.hasMore || v.nextToken == "" || v.loadingMore || v.error != nil {
+		return nil
+	}
+	if !v.table.NearEnd() {
+		return nil
+	}
+
+	v.loadingMore = true
+	v.table.SetMore(true, true)
+	ctx := restartCall(&v.cancelList, context.Background())
+	handler := v.handler
+	token := v.nextToken
+	pageSize := v.incrementalPageSize()
+	seq := v.loadSeq
+	return func() tea.Msg {
+		result, err := handler.List(ctx, handlers.ListOptions{
+			NextToken: token,
+			PageSize:  pageSize,
+		})
+		if err != nil {
+			return ResourcesLoadedMsg{Error: err, Append: true, Seq: seq}
+		}
+		return ResourcesLoadedMsg{Resources: result.Resources, NextToken: result.NextToken, Append: true, Seq: seq}
+	}
+}
+
+// appendPage adds a streamed page to the loaded resources, up to the item cap
+func (v *ResourceListView) appendPage(msg ResourcesLoadedMsg) {
+	v.loadingMore = false
+	if msg.Error != nil {
+		// The list stays as loaded, and scrolling tries the page again
+		v.table.SetMore(v.hasMore, false)
+		return
+	}
+
+	page := msg.Resources
+	v.nextToken = msg.NextToken
+	v.hasMore = msg.NextToken != ""
+	if maxItems := v.maxItemsFor(v.handler); maxItems > 0 && len(v.resources)+len(page) >= maxItems {
+		page = page[:min(len(page), maxItems-len(v.resources))]
+		if v.hasMore || len(v.resources)+len(msg.Resources) > maxItems {
+			v.setTruncatedAt(maxItems)
+		}
+		v.hasMore = false
+	}
+	v.resources = append(v.resources, page...)
+	v.totalLoaded = len(v.resources)
+	v.table.SetMore(v.hasMore, false)
+	v.tagFilter.SetResources(v.resources)
+
+	shown := page
+	if len(v.activeTags) > 0 {
+		shown = components.FilterByTags(page, v.activeTags)
+	}
+	if len(v.searchExtra) > 0 {
+		// Resources a server search found may have been streamed in since, so the rows
+		// are laid out again without them
+		seen := make(map[string]bool, len(page))
+		for _, res := range page {
+			seen[res.GetID()] = true
+		}
+		v.searchExtra = slices.DeleteFunc(v.searchExtra, func(res handlers.Resource) bool {
+			return seen[res.GetID()]
+		})
+		selected := v.table.SelectedResource()
+		v.showListed()
+		v.table.ApplyFilter(v.serverQuery)
+		if selected != nil {
+			v.table.SelectByID(selected.GetID())
+		}
+	} else {
+		v.filteredByTags = append(v.filteredByTags, shown...)
+		v.table.AppendResources(shown)
+	}
+	v.search.SetResults(v.table.Len(), len(v.resources)+len(v.searchExtra))
 }
 
 // LoadNextPage loads the next page of resources
@@ -200,12 +592,82 @@ func (v *ResourceListView) LoadResourceDetail(ctx context.Context) tea.Cmd {
 		return nil
 	}
 
-	return func() tea.Msg {
-		details, err := v.handler.Describe(ctx, selected.GetID())
+	return v.loadDetail(ctx, selected.GetID())
+}
+
+// SelectOnLoad selects and describes a resource once the next load completes. If the
+// list doesn't show it, e.g. because it is on another page, it is still described.
+func (v *ResourceListView) SelectOnLoad(id string) {
+	v.selectOnLoad = id
+}
+
+// FilterOnLoad searches the list for query once the next load completes, as if it had
+// been typed after /. With SelectOnLoad the search is dropped if it hides every row.
+func (v *ResourceListView) FilterOnLoad(query string) {
+	v.filterOnLoad = query
+}
+
+// loadDetail describes a resource into the detail pane, cancelling the describe of the
+// one selected before
+func (v *ResourceListView) loadDetail(ctx context.Context, id string) tea.Cmd {
+	ctx = restartCall(&v.cancelDetail, ctx)
+	handler := v.handler
+	fetch := func() tea.Msg {
+		details, err := handler.Describe(ctx, id)
 		if err != nil {
-			return ResourceDetailLoadedMsg{Error: err}
+			return ResourceDetailLoadedMsg{ResourceID: id, Error: err}
 		}
-		return ResourceDetailLoadedMsg{Details: details}
+		return ResourceDetailLoadedMsg{ResourceID: id, Details: details}
+	}
+
+	return tea.Batch(v.OpenDetail(id), fetch)
+}
+
+// OpenDetail opens the detail pane with a spinner until a ResourceDetailLoadedMsg for id
+// arrives, so the table stays usable while the details load
+func (v *ResourceListView) OpenDetail(id string) tea.Cmd {
+	v.detailID = id
+	v.detail.Clear()
+	if !v.showDetail {
+		v.showDetail = true
+		v.SetSize(v.width, v.height)
+	}
+	return v.detail.StartLoading()
+}
+
+// CancelDetail closes the detail pane if it is still waiting for the details of id
+func (v *ResourceListView) CancelDetail(id string) {
+	if v.detailID == id && v.detail.IsLoading() {
+		v.CloseDetail()
+	}
+}
+
+// Tag wraps the list's own messages among the results of cmd in PaneMsgs for v, leaving
+// the others, such as actions, as they are
+func (v *ResourceListView) Tag(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := cmd()
+		switch m := msg.(type) {
+		case tea.BatchMsg:
+			tagged := make(tea.BatchMsg, len(m))
+			for i, c := range m {
+				tagged[i] = v.Tag(c)
+			}
+			return tagged
+		case components.LoadingTickMsg:
+			if m.Pane != components.PaneTable && m.Pane != components.PaneDetail {
+				return msg
+			}
+		case ResourcesLoadedMsg, ResourceDetailLoadedMsg, ServerSearchMsg, ServerSearchResultMsg,
+			components.SearchUpdateMsg, components.SearchClosedMsg, components.ResourceSelectedMsg,
+			components.TagFilterUpdateMsg, components.TagFilterClosedMsg:
+		default:
+			return msg
+		}
+		return PaneMsg{List: v, Msg: msg}
 	}
 }
 
@@ -214,16 +676,46 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case components.LoadingTickMsg:
+		switch msg.Pane {
+		case components.PaneTable:
+			return v, v.tableLoader.Update(msg)
+		case components.PaneDetail:
+			var cmd tea.Cmd
+			v.detail, cmd = v.detail.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
 	case ResourcesLoadedMsg:
-		v.loading = false
+		if !v.IsCurrent(msg) {
+			return v, nil
+		}
+		if msg.Append {
+			v.appendPage(msg)
+			// Keep filling the screen while no search narrows the rows
+			if v.search.Value() == "" {
+				return v, v.loadMore()
+			}
+			return v, nil
+		}
+		v.tableLoader.Stop()
+		v.listLoading = false
 		if msg.Error != nil {
 			v.error = msg.Error
 		} else {
 			v.error = nil
 			v.resources = msg.Resources
+			v.setTruncatedAt(msg.TruncatedAt)
 			v.totalLoaded = len(msg.Resources)
 			v.nextToken = msg.NextToken
 			v.hasMore = msg.NextToken != ""
+			v.table.SetMore(v.hasMore && v.incrementalPageSize() > 0, false)
+			v.searchExtra = nil
+			v.serverQuery = ""
+			v.searchSeq++
+			v.search.SetServerSearch("")
+			v.pruneMarks()
 
 			v.tagFilter.SetResources(msg.Resources)
 			// Apply any existing tag filters
@@ -236,18 +728,37 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 				v.table.SetResources(msg.Resources)
 				v.search.SetResults(len(msg.Resources), len(msg.Resources))
 			}
+
+			query := v.filterOnLoad
+			v.filterOnLoad = ""
+			if query != "" {
+				v.search.SetValue(query)
+				v.table.ApplyFilter(query)
+				v.search.SetResults(v.table.Len(), len(v.resources))
+			}
+			if id := v.selectOnLoad; id != "" {
+				v.selectOnLoad = ""
+				if !v.table.SelectByID(id) && query != "" && v.table.Len() == 0 {
+					// The rows don't show what was searched for, so the search is dropped
+					query = ""
+					v.search.Clear()
+					v.table.ApplyFilter("")
+					v.search.SetResults(v.table.Len(), len(v.resources))
+					v.table.SelectByID(id)
+				}
+				return v, tea.Batch(v.loadDetail(context.Background(), id), v.scheduleServerSearch(query))
+			}
+			if query != "" {
+				return v, v.scheduleServerSearch(query)
+			}
+			// A first page shorter than the screen streams the next in straight away
+			return v, v.loadMore()
 		}
 		return v, nil
 
 	case components.TagFilterUpdateMsg:
 		v.activeTags = msg.Tags
-		if len(msg.Tags) > 0 {
-			v.filteredByTags = components.FilterByTags(v.resources, msg.Tags)
-		} else {
-			v.filteredByTags = v.resources
-		}
-		v.table.SetResources(v.filteredByTags)
-		v.search.SetResults(len(v.filteredByTags), len(v.resources))
+		v.showListed()
 		return v, nil
 
 	case components.TagFilterClosedMsg:
@@ -260,19 +771,50 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 		return v, nil
 
 	case ResourceDetailLoadedMsg:
+		// Drop responses for a selection that has since changed or been closed
+		if msg.ResourceID != v.detailID || !v.showDetail {
+			return v, nil
+		}
+		v.detail.StopLoading()
 		if msg.Error != nil {
 			v.error = msg.Error
+			v.CloseDetail()
 		} else {
 			v.error = nil
+			var links []handlers.DetailLink
+			if linker, ok := v.handler.(handlers.DetailLinker); ok {
+				links = linker.DetailLinks(msg.Details)
+			}
+			v.detail.SetLinks(links)
 			v.detail.SetContent(msg.Details)
 			v.showDetail = true
 			v.SetSize(v.width, v.height) // Recalculate sizes
+			v.visit(msg.ResourceID, "")
 		}
 		return v, nil
 
 	case components.SearchUpdateMsg:
+		if msg.Query == "" {
+			v.clearServerSearch()
+		}
 		v.table.ApplyFilter(msg.Query)
-		v.search.SetResults(v.table.Len(), len(v.resources))
+		v.search.SetResults(v.table.Len(), len(v.resources)+len(v.searchExtra))
+		return v, v.scheduleServerSearch(msg.Query)
+
+	case ServerSearchMsg:
+		if msg.Seq != v.searchSeq {
+			return v, nil
+		}
+		return v, v.serverSearch(msg.Seq, msg.Query)
+
+	case ServerSearchResultMsg:
+		if msg.Seq != v.searchSeq {
+			return v, nil
+		}
+		v.search.SetServerSearch("")
+		if msg.Error == nil {
+			v.mergeServerResults(msg.Resources)
+		}
 		return v, nil
 
 	case components.SearchClosedMsg:
@@ -282,6 +824,7 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 			v.table.ApplyFilter(msg.Query)
 		} else {
 			// Clear filter if query is empty
+			v.clearServerSearch()
 			v.table.ApplyFilter("")
 		}
 		v.table.Focus()
@@ -289,11 +832,23 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 
 	case components.ResourceSelectedMsg:
 		// Resource selected, load details
-		v.showDetail = true
-		v.SetSize(v.width, v.height)
 		return v, v.LoadResourceDetail(context.Background())
 
 	case tea.KeyMsg:
+		// The key after y picks what to copy
+		if v.yankPending {
+			v.yankPending = false
+			return v, v.yank(msg.String())
+		}
+
+		// y starts a copy in the table (only if handler doesn't use 'y' for an action),
+		// in the details it switches to YAML
+		if msg.String() == "y" && !v.search.IsActive() && !v.tagFilter.IsActive() && !v.detailFocus &&
+			v.handler != nil && !v.HasActionKey("y") {
+			v.yankPending = true
+			return v, func() tea.Msg { return YankPendingMsg{} }
+		}
+
 		// Handle search activation
 		if msg.String() == "/" && !v.search.IsActive() {
 			v.table.Blur()
@@ -305,7 +860,7 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 			// Check if handler has a 'c' action
 			hasCreateAction := false
 			if v.handler != nil {
-				for _, action := range v.handler.Actions() {
+				for _, action := range v.actions() {
 					if action.Key == "c" {
 						hasCreateAction = true
 						break
@@ -368,6 +923,7 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 		if msg.String() == "esc" && v.search.IsActive() {
 			v.search.Deactivate()
 			v.search.Clear()
+			v.clearServerSearch()
 			v.table.Focus()
 			return v, nil
 		}
@@ -393,7 +949,7 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 			// Check if handler has an 'r' action
 			hasRotationAction := false
 			if v.handler != nil {
-				for _, action := range v.handler.Actions() {
+				for _, action := range v.actions() {
 					if action.Key == "r" {
 						hasRotationAction = true
 						break
@@ -407,13 +963,37 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 			}
 		}
 
+		// Handle marking for batch actions
+		if msg.String() == " " && v.marked != nil && !v.search.IsActive() && !v.tagFilter.IsActive() {
+			if res := v.table.SelectedResource(); res != nil {
+				if v.marked[res.GetID()] {
+					delete(v.marked, res.GetID())
+				} else {
+					v.marked[res.GetID()] = true
+				}
+			}
+			return v, nil
+		}
+
 		// Handle actions (s, t, x, etc.) - check handler actions first
 		if !v.search.IsActive() && !v.tagFilter.IsActive() && v.handler != nil {
-			actions := v.handler.Actions()
+			actions := v.actions()
 			for _, action := range actions {
 				if msg.String() == action.Key {
+					if v.readOnly && action.Mutating {
+						return v, func() tea.Msg {
+							return ActionErrorMsg{Error: ErrReadOnly, Action: action.Name}
+						}
+					}
+
+					if action.Batch {
+						return v, v.executeBatchAction(action.Name)
+					}
+
 					// Get selected resource
 					if res := v.table.SelectedResource(); res != nil {
+						v.visit(res.GetID(), action.Name)
+
 						// Execute action on handler
 						ctx := context.Background()
 						err := v.handler.ExecuteAction(ctx, action.Name, res.GetID())
@@ -433,6 +1013,11 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 			}
 		}
 
+		// Handle diff mark/compare
+		if msg.String() == "=" && !v.search.IsActive() && !v.tagFilter.IsActive() {
+			return v, v.markOrDiff()
+		}
+
 		// Handle sorting
 		if msg.String() == "o" && !v.search.IsActive() && !v.tagFilter.IsActive() {
 			v.table.CycleSortColumn()
@@ -449,7 +1034,7 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 			// Check if 't' is used by an action first
 			hasTagsAction := false
 			if v.handler != nil {
-				for _, action := range v.handler.Actions() {
+				for _, action := range v.actions() {
 					if action.Key == "t" {
 						hasTagsAction = true
 						break
@@ -462,9 +1047,31 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 			}
 		}
 
+		// Handle the tag editor, for taggable resources when no action uses 'T'
+		if msg.String() == "T" && !v.search.IsActive() && !v.tagFilter.IsActive() {
+			if _, ok := v.handler.(handlers.Taggable); ok {
+				if res := v.table.SelectedResource(); res != nil {
+					if v.readOnly {
+						return v, func() tea.Msg {
+							return ActionErrorMsg{Error: ErrReadOnly, Action: "tags"}
+						}
+					}
+					action := &handlers.EditTagsAction{ResourceID: res.GetID(), ResourceName: res.GetName()}
+					return v, func() tea.Msg { return action }
+				}
+				return v, nil
+			}
+		}
+
+		// Handle the error panel, unless an action uses 'E'
+		if msg.String() == "E" && !v.search.IsActive() && !v.tagFilter.IsActive() && !v.HasActionKey("E") {
+			return v, func() tea.Msg { return ShowErrorsMsg{} }
+		}
+
 		// Handle pagination - next page
 		if (msg.String() == "n" || msg.String() == "]") && !v.search.IsActive() && !v.tagFilter.IsActive() {
-			if v.hasMore {
+			// Streamed lists have no pages to flip
+			if v.hasMore && v.incrementalPageSize() == 0 {
 				return v, v.LoadNextPage()
 			}
 			return v, nil
@@ -505,36 +1112,82 @@ func (v *ResourceListView) Update(msg tea.Msg) (*ResourceListView, tea.Cmd) {
 		// Route to table
 		var cmd tea.Cmd
 		v.table, cmd = v.table.Update(msg)
-		cmds = append(cmds, cmd)
+		cmds = append(cmds, cmd, v.loadMore())
 	}
 
 	return v, tea.Batch(cmds...)
 }
 
+// yank copies what the key pressed after y picks from the selected resource: y the row
+// as tab-separated values, c the cell under the column cursor, i the ID, a the ARN and j
+// the details as JSON. Any other key cancels.
+func (v *ResourceListView) yank(key string) tea.Cmd {
+	cancel := func() tea.Msg { return YankCancelledMsg{} }
+	res := v.table.SelectedResource()
+	if res == nil {
+		return cancel
+	}
+	switch key {
+	case "y":
+		if row, ok := v.table.SelectedRowTSV(); ok {
+			return components.CopyToClipboard(row, "row")
+		}
+	case "c":
+		if title, value, ok := v.table.SelectedCell(); ok {
+			return components.CopyToClipboard(value, title)
+		}
+	case "i":
+		return components.CopyToClipboard(res.GetID(), "ID")
+	case "a":
+		if res.GetARN() == "" {
+			return func() tea.Msg {
+				return components.ClipboardCopiedMsg{Label: "ARN", Error: fmt.Errorf("%s has no ARN", res.GetName())}
+			}
+		}
+		return components.CopyToClipboard(res.GetARN(), "ARN")
+	case "j":
+		if v.showDetail && v.detailID == res.GetID() {
+			if text := v.detail.GetJSON(); text != "" {
+				return components.CopyToClipboard(text, "JSON")
+			}
+		}
+		handler := v.handler
+		return func() tea.Msg {
+			details, err := handler.Describe(context.Background(), res.GetID())
+			if err != nil {
+				return components.ClipboardCopiedMsg{Label: "JSON", Error: err}
+			}
+			return components.CopyJSONToClipboard(details, "JSON")()
+		}
+	}
+	return cancel
+}
+
+// YankPending reports whether y was pressed and the next key picks what to copy
+func (v *ResourceListView) YankPending() bool {
+	return v.yankPending
+}
+
+// HasActionKey reports whether one of the handler's actions uses a key
+func (v *ResourceListView) HasActionKey(key string) bool {
+	for _, action := range v.actions() {
+		if action.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
 // View renders the view
 func (v *ResourceListView) View() string {
 	if v.width == 0 || v.height == 0 {
 		return ""
 	}
 
-	// Loading state
-	if v.loading {
-		loadingStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")).
-			Bold(true)
-		return lipgloss.Place(
-			v.width,
-			v.height,
-			lipgloss.Center,
-			lipgloss.Center,
-			loadingStyle.Render("Loading..."),
-		)
-	}
-
 	// Error state
 	if v.error != nil {
 		errorStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(v.theme.Colors.Error).
 			Bold(true)
 		return lipgloss.Place(
 			v.width,
@@ -548,13 +1201,26 @@ func (v *ResourceListView) View() string {
 	// Build content
 	var content string
 
+	// Each pane shows its own loading state so one can load while the other is in use
+	tableView := v.table.View()
+	if v.tableLoader.IsActive() {
+		tableWidth := v.width
+		if v.showDetail {
+			tableWidth = v.width * 6 / 10
+		}
+		tableView = lipgloss.JoinVertical(
+			lipgloss.Left,
+			v.tableLoader.View(tableWidth, 1),
+			v.tableLoader.Shimmer(tableWidth, v.height-3-v.bannerHeight()),
+		)
+	}
+
 	if v.showDetail {
 		// Split view
-		tableView := v.table.View()
 		detailView := v.detail.View()
 
 		separator := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240")).
+			Foreground(v.theme.Colors.Border).
 			Render("│")
 
 		content = lipgloss.JoinHorizontal(
@@ -564,7 +1230,16 @@ func (v *ResourceListView) View() string {
 			detailView,
 		)
 	} else {
-		content = v.table.View()
+		content = tableView
+	}
+
+	if v.truncatedAt > 0 {
+		banner := lipgloss.NewStyle().
+			Foreground(v.theme.Colors.Warning).
+			Bold(true).
+			MaxWidth(v.width).
+			Render(fmt.Sprintf("⚠ Truncated at %d items, use / to narrow the list or raise max_list_items", v.truncatedAt))
+		content = lipgloss.JoinVertical(lipgloss.Left, banner, content)
 	}
 
 	// Overlay search if active
@@ -594,9 +1269,256 @@ func (v *ResourceListView) View() string {
 	return content
 }
 
+// markOrDiff marks the selected resource, or diffs it against the marked one
+func (v *ResourceListView) markOrDiff() tea.Cmd {
+	if v.handler == nil {
+		return nil
+	}
+
+	selected := v.table.SelectedResource()
+	if selected == nil {
+		return nil
+	}
+
+	// Pressing '=' on the marked resource clears the mark
+	if v.diffMark != nil && v.diffMark.GetID() == selected.GetID() &&
+		v.diffMarkHandler.ResourceType() == v.handler.ResourceType() {
+		v.ClearDiffMark()
+		return func() tea.Msg { return DiffMarkedMsg{Cleared: true} }
+	}
+
+	// No mark yet, or the mark is a different resource type: mark this one
+	if v.diffMark == nil || v.diffMarkHandler.ResourceType() != v.handler.ResourceType() {
+		v.diffMark = selected
+		v.diffMarkHandler = v.handler
+		name := resourceLabel(selected)
+		return func() tea.Msg { return DiffMarkedMsg{Name: name} }
+	}
+
+	left, leftHandler := v.diffMark, v.diffMarkHandler
+	right, rightHandler := selected, v.handler
+	v.ClearDiffMark()
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		leftDetails, err := leftHandler.Describe(ctx, left.GetID())
+		if err != nil {
+			return DiffLoadedMsg{Error: err}
+		}
+		rightDetails, err := rightHandler.Describe(ctx, right.GetID())
+		if err != nil {
+			return DiffLoadedMsg{Error: err}
+		}
+		return DiffLoadedMsg{
+			LeftName:  resourceLabel(left),
+			RightName: resourceLabel(right),
+			Left:      leftDetails,
+			Right:     rightDetails,
+		}
+	}
+}
+
+// executeBatchAction runs a batch action on the marked resources, or the selected one
+// when none are marked
+func (v *ResourceListView) executeBatchAction(name string) tea.Cmd {
+	batch, ok := v.handler.(handlers.MultiSelectHandler)
+	if !ok {
+		return nil
+	}
+	ids := v.MarkedIDs()
+	if len(ids) == 0 {
+		res := v.table.SelectedResource()
+		if res == nil {
+			return nil
+		}
+		ids = []string{res.GetID()}
+	}
+
+	err := batch.ExecuteBatchAction(context.Background(), name, ids)
+	if err == nil {
+		return nil
+	}
+	if navAction, ok := err.(ActionMsg); ok {
+		return func() tea.Msg { return navAction }
+	}
+	return func() tea.Msg {
+		return ActionErrorMsg{Error: err, Action: name}
+	}
+}
+
+// showListed shows the loaded resources, and those a server search found, through the
+// tag filter
+func (v *ResourceListView) showListed() {
+	listed := v.resources
+	if len(v.searchExtra) > 0 {
+		listed = append(slices.Clip(v.resources), v.searchExtra...)
+	}
+	if len(v.activeTags) > 0 {
+		v.filteredByTags = components.FilterByTags(listed, v.activeTags)
+	} else {
+		v.filteredByTags = listed
+	}
+	v.table.SetResources(v.filteredByTags)
+	v.search.SetResults(len(v.filteredByTags), len(listed))
+}
+
+// scheduleServerSearch searches AWS for the query once typing pauses, when the handler
+// can filter on the server and the loaded list was cut short, so matches past it are found
+func (v *ResourceListView) scheduleServerSearch(query string) tea.Cmd {
+	if query == v.serverQuery {
+		return nil
+	}
+	v.serverQuery = query
+	v.searchSeq++
+	stopCall(&v.cancelSearch)
+	v.search.SetServerSearch("")
+	if _, ok := v.handler.(handlers.ServerFilterHandler); !ok || query == "" {
+		return nil
+	}
+	if v.truncatedAt == 0 && !v.hasMore {
+		return nil
+	}
+
+	seq := v.searchSeq
+	return tea.Tick(serverSearchDelay, func(time.Time) tea.Msg {
+		return ServerSearchMsg{Seq: seq, Query: query}
+	})
+}
+
+// serverSearch lists the handler's resources with the query as its server filter
+func (v *ResourceListView) serverSearch(seq int, query string) tea.Cmd {
+	filterer, ok := v.handler.(handlers.ServerFilterHandler)
+	if !ok {
+		return nil
+	}
+	v.search.SetServerSearch(filterer.ServerFilterHint())
+
+	ctx := restartCall(&v.cancelSearch, context.Background())
+	handler := v.handler
+	maxItems := v.maxItemsFor(handler)
+	return func() tea.Msg {
+		result, err := handler.List(ctx, handlers.ListOptions{
+			ServerFilter: query,
+			MaxItems:     maxItems,
+		})
+		if err != nil {
+			return ServerSearchResultMsg{Seq: seq, Error: err}
+		}
+		resources := result.Resources
+		if maxItems > 0 && len(resources) > maxItems {
+			resources = resources[:maxItems]
+		}
+		return ServerSearchResultMsg{Seq: seq, Resources: resources}
+	}
+}
+
+// mergeServerResults adds the resources a server search found that the loaded list
+// doesn't have, and filters the table again so they show among the matches
+func (v *ResourceListView) mergeServerResults(found []handlers.Resource) {
+	seen := make(map[string]bool, len(v.resources))
+	for _, res := range v.resources {
+		seen[res.GetID()] = true
+	}
+	v.searchExtra = nil
+	for _, res := range found {
+		if !seen[res.GetID()] {
+			seen[res.GetID()] = true
+			v.searchExtra = append(v.searchExtra, res)
+		}
+	}
+
+	v.showListed()
+	v.table.ApplyFilter(v.serverQuery)
+	v.search.SetResults(v.table.Len(), len(v.resources)+len(v.searchExtra))
+}
+
+// clearServerSearch cancels a pending server search and drops the resources it found
+func (v *ResourceListView) clearServerSearch() {
+	v.serverQuery = ""
+	v.searchSeq++
+	stopCall(&v.cancelSearch)
+	v.search.SetServerSearch("")
+	if v.searchExtra != nil {
+		v.searchExtra = nil
+		v.showListed()
+	}
+}
+
+// ReplaceResource shows a reloaded resource in place of the listed one with the same ID,
+// such as after its tags changed, and reloads its details if they are open
+func (v *ResourceListView) ReplaceResource(res handlers.Resource) tea.Cmd {
+	for i := range v.resources {
+		if v.resources[i].GetID() == res.GetID() {
+			v.resources[i] = res
+		}
+	}
+	for i := range v.filteredByTags {
+		if v.filteredByTags[i].GetID() == res.GetID() {
+			v.filteredByTags[i] = res
+		}
+	}
+	v.table.ReplaceResource(res)
+
+	if v.showDetail && v.detailID == res.GetID() {
+		return v.loadDetail(context.Background(), res.GetID())
+	}
+	return nil
+}
+
+// MarkedIDs returns the IDs of the marked resources in list order
+func (v *ResourceListView) MarkedIDs() []string {
+	var ids []string
+	for _, res := range v.resources {
+		if v.marked[res.GetID()] {
+			ids = append(ids, res.GetID())
+		}
+	}
+	return ids
+}
+
+// pruneMarks drops the marks of resources that are no longer listed, such as deleted ones
+func (v *ResourceListView) pruneMarks() {
+	if len(v.marked) == 0 {
+		return
+	}
+	listed := make(map[string]bool, len(v.resources))
+	for _, res := range v.resources {
+		listed[res.GetID()] = true
+	}
+	for id := range v.marked {
+		if !listed[id] {
+			delete(v.marked, id)
+		}
+	}
+}
+
+// ClearDiffMark removes the resource marked for diffing
+func (v *ResourceListView) ClearDiffMark() {
+	v.diffMark = nil
+	v.diffMarkHandler = nil
+}
+
+// DiffMark returns the resource marked for diffing, or nil
+func (v *ResourceListView) DiffMark() handlers.Resource {
+	return v.diffMark
+}
+
+// resourceLabel returns the name of a resource, falling back to its ID
+func resourceLabel(res handlers.Resource) string {
+	if name := res.GetName(); name != "" {
+		return name
+	}
+	return res.GetID()
+}
+
 // IsLoading returns whether the view is loading
 func (v *ResourceListView) IsLoading() bool {
-	return v.loading
+	return v.tableLoader.IsActive()
+}
+
+// IsDetailLoading returns whether the detail pane is loading
+func (v *ResourceListView) IsDetailLoading() bool {
+	return v.detail.IsLoading()
 }
 
 // GetError returns any error
@@ -609,6 +1531,23 @@ func (v *ResourceListView) GetSelectedResource() handlers.Resource {
 	return v.table.SelectedResource()
 }
 
+// TableText renders the table as shown, filtered and sorted, as aligned text or Markdown.
+// It is empty when no rows are shown.
+func (v *ResourceListView) TableText(markdown bool) string {
+	if v.table.Len() == 0 {
+		return ""
+	}
+	if markdown {
+		return v.table.Markdown()
+	}
+	return v.table.PlainText()
+}
+
+// RedrawDetail re-renders the detail pane, e.g. after the timestamp display changed
+func (v *ResourceListView) RedrawDetail() {
+	v.detail.Redraw()
+}
+
 // Handler returns the current handler
 func (v *ResourceListView) Handler() handlers.ResourceHandler {
 	return v.handler
@@ -619,7 +1558,6 @@ func (v *ResourceListView) Refresh() tea.Cmd {
 	if v.handler == nil {
 		return nil
 	}
-	v.loading = true
 	v.detailFocus = false
 	v.detail.Clear()
 	v.showDetail = false
@@ -640,6 +1578,7 @@ func (v *ResourceListView) HasOpenDetail() bool {
 
 // CloseDetail closes the detail pane and returns focus to the table
 func (v *ResourceListView) CloseDetail() {
+	stopCall(&v.cancelDetail)
 	if v.showDetail {
 		v.showDetail = false
 		v.detailFocus = false
@@ -650,12 +1589,13 @@ func (v *ResourceListView) CloseDetail() {
 	}
 }
 
-// GetPaginationInfo returns current page, hasMore, and count for display
+// GetPaginationInfo returns current page, hasMore, and count for display. Streamed lists
+// are one page that grows, so they never have more pages.
 func (v *ResourceListView) GetPaginationInfo() (page int, hasMore bool, count int) {
-	return v.currentPage, v.hasMore, v.totalLoaded
+	return v.currentPage, v.hasMore && v.incrementalPageSize() == 0, v.totalLoaded
 }
 
 // HasPagination returns true if there's more than one page of data
 func (v *ResourceListView) HasPagination() bool {
-	return v.hasMore || v.currentPage > 1
+	return (v.hasMore && v.incrementalPageSize() == 0) || v.currentPage > 1
 }
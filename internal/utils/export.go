@@ -15,8 +15,10 @@ import (
 type ExportFormat string
 
 const (
-	ExportJSON ExportFormat = "json"
-	ExportYAML ExportFormat = "yaml"
+	ExportJSON     ExportFormat = "json"
+	ExportYAML     ExportFormat = "yaml"
+	ExportText     ExportFormat = "txt"
+	ExportMarkdown ExportFormat = "md"
 )
 
 // Exporter handles exporting data to files
@@ -105,6 +107,33 @@ func (e *Exporter) ExportList(data interface{}, resourceType string, count int,
 	return filepath, nil
 }
 
+// ExportTable writes an already rendered table to a file
+func (e *Exporter) ExportTable(content, resourceType string, format ExportFormat) (string, error) {
+	timestamp := time.Now().Format("20060102-150405")
+	filename := fmt.Sprintf("%s-table-%s.%s", sanitizeFilename(resourceType), timestamp, format)
+	filepath := filepath.Join(e.outputDir, filename)
+
+	if err := os.WriteFile(filepath, []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return filepath, nil
+}
+
+// ExportImage writes a JPG image of a resource to a file, such as an instance's
+// console screenshot
+func (e *Exporter) ExportImage(image []byte, resourceID, kind string) (string, error) {
+	timestamp := time.Now().Format("20060102-150405")
+	filename := fmt.Sprintf("%s-%s-%s.jpg", sanitizeFilename(resourceID), kind, timestamp)
+	filepath := filepath.Join(e.outputDir, filename)
+
+	if err := os.WriteFile(filepath, image, 0644); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return filepath, nil
+}
+
 // ToJSON converts data to JSON string
 func ToJSON(data interface{}) (string, error) {
 	content, err := json.MarshalIndent(data, "", "  ")
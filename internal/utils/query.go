@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/itchyny/gojq"
+)
+
+// ParseQuery parses a jq expression, such as .Endpoint.Address
+func ParseQuery(expr string) (*gojq.Query, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %w", err)
+	}
+	return query, nil
+}
+
+// QueryMaxResults caps the results a query collects, so one such as range(1e12) fails
+// rather than filling memory
+const QueryMaxResults = 10000
+
+// RunQuery runs a jq query over data, as its JSON encoding, and returns the results one
+// per line. Strings are written as they are, like jq -r, so a single value can be pasted
+// straight into a shell; anything else is indented JSON.
+func RunQuery(ctx context.Context, query *gojq.Query, data interface{}) (string, error) {
+	// gojq only takes the types JSON decodes to, which also turns times into strings
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the input: %w", err)
+	}
+	var input interface{}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		return "", fmt.Errorf("failed to decode the input: %w", err)
+	}
+
+	var results []string
+	iter := query.RunWithContext(ctx, input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			if halt, ok := err.(*gojq.HaltError); ok && halt.Value() == nil {
+				break
+			}
+			return "", err
+		}
+
+		if len(results) == QueryMaxResults {
+			return "", fmt.Errorf("the query gives more than %d results", QueryMaxResults)
+		}
+
+		if s, ok := v.(string); ok {
+			results = append(results, s)
+			continue
+		}
+		out, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode a result: %w", err)
+		}
+		results = append(results, string(out))
+	}
+	return strings.Join(results, "\n"), nil
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// TextWidth returns how many terminal cells a string takes up. CJK characters and most
+// emoji take two cells, combining marks none.
+func TextWidth(s string) int {
+	return runewidth.StringWidth(s)
+}
+
+// Truncate shortens a string to at most width cells, ending it with "..." when it was
+// cut. It never splits a multibyte character.
+func Truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if runewidth.StringWidth(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		return runewidth.Truncate(s, width, "")
+	}
+	return runewidth.Truncate(s, width, "...")
+}
+
+// PadRight pads a string with spaces to width cells
+func PadRight(s string, width int) string {
+	if gap := width - runewidth.StringWidth(s); gap > 0 {
+		return s + strings.Repeat(" ", gap)
+	}
+	return s
+}
+
+// TruncateOrPad fits a string to exactly width cells
+func TruncateOrPad(s string, width int) string {
+	return PadRight(Truncate(s, width), width)
+}
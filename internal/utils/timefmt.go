@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TimeLocale is a layout family for absolute timestamps
+type TimeLocale string
+
+const (
+	TimeLocaleISO TimeLocale = "iso" // 2006-01-02 15:04:05
+	TimeLocaleUS  TimeLocale = "us"  // 01/02/2006 3:04:05 PM
+	TimeLocaleEU  TimeLocale = "eu"  // 02/01/2006 15:04:05
+)
+
+// timePrecision is how much of a timestamp a value carries, kept when it is reformatted
+type timePrecision int
+
+const (
+	precisionDate timePrecision = iota
+	precisionMinute
+	precisionSecond
+)
+
+var timeLayouts = map[TimeLocale][3]string{
+	TimeLocaleISO: {"2006-01-02", "2006-01-02 15:04", "2006-01-02 15:04:05"},
+	TimeLocaleUS:  {"01/02/2006", "01/02/2006 3:04 PM", "01/02/2006 3:04:05 PM"},
+	TimeLocaleEU:  {"02/01/2006", "02/01/2006 15:04", "02/01/2006 15:04:05"},
+}
+
+// parseLayouts are the layouts handlers format timestamps with. Timestamps without a zone
+// are UTC, as the adapters keep them.
+var parseLayouts = []struct {
+	layout    string
+	precision timePrecision
+}{
+	{time.RFC3339Nano, precisionSecond},
+	{"2006-01-02 15:04:05", precisionSecond},
+	{"2006-01-02 15:04", precisionMinute},
+	{"2006-01-02", precisionDate},
+}
+
+// timeDisplay holds how timestamps are shown across tables and detail views
+var timeDisplay = struct {
+	sync.RWMutex
+	relative bool
+	location *time.Location
+	locale   TimeLocale
+}{
+	location: time.UTC,
+	locale:   TimeLocaleISO,
+}
+
+// ConfigureTimeDisplay sets the time zone (UTC, Local or an IANA name such as
+// Europe/Paris) and the locale (iso, us or eu) of absolute timestamps. Empty values
+// keep UTC and iso.
+func ConfigureTimeDisplay(zone, locale string) error {
+	location := time.UTC
+	switch strings.ToLower(zone) {
+	case "", "utc":
+	case "local":
+		location = time.Local
+	default:
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return fmt.Errorf("unknown time zone %q: %w", zone, err)
+		}
+		location = loc
+	}
+
+	l := TimeLocale(strings.ToLower(locale))
+	if l == "" {
+		l = TimeLocaleISO
+	}
+	if _, ok := timeLayouts[l]; !ok {
+		return fmt.Errorf("unknown time locale %q, expected iso, us or eu", locale)
+	}
+
+	timeDisplay.Lock()
+	defer timeDisplay.Unlock()
+	timeDisplay.location = location
+	timeDisplay.locale = l
+	return nil
+}
+
+// SetRelativeTimes switches between relative ("3d ago") and absolute timestamps
+func SetRelativeTimes(relative bool) {
+	timeDisplay.Lock()
+	defer timeDisplay.Unlock()
+	timeDisplay.relative = relative
+}
+
+// RelativeTimes reports whether timestamps are shown relative to now
+func RelativeTimes() bool {
+	timeDisplay.RLock()
+	defer timeDisplay.RUnlock()
+	return timeDisplay.relative
+}
+
+// TimeLocation returns the time zone absolute timestamps are shown in
+func TimeLocation() *time.Location {
+	timeDisplay.RLock()
+	defer timeDisplay.RUnlock()
+	return timeDisplay.location
+}
+
+// FormatTimestamp formats a timestamp in the configured display
+func FormatTimestamp(t time.Time) string {
+	return formatTimestamp(t, precisionSecond)
+}
+
+func formatTimestamp(t time.Time, precision timePrecision) string {
+	timeDisplay.RLock()
+	defer timeDisplay.RUnlock()
+
+	if timeDisplay.relative {
+		if precision == precisionDate {
+			return RelativeDate(t, time.Now())
+		}
+		return RelativeTime(t, time.Now())
+	}
+
+	// Days have no time of day to move to another zone
+	if precision != precisionDate {
+		t = t.In(timeDisplay.location)
+	}
+	return t.Format(timeLayouts[timeDisplay.locale][precision])
+}
+
+// FormatTimeValue reformats a value that is a timestamp, as handlers put in table cells and
+// detail maps, in the configured display. Other values are returned as they are.
+func FormatTimeValue(s string) string {
+	if !looksLikeTimestamp(s) {
+		return s
+	}
+	for _, p := range parseLayouts {
+		if t, err := time.Parse(p.layout, s); err == nil {
+			return formatTimestamp(t, p.precision)
+		}
+	}
+	return s
+}
+
+// ParseTimeValue parses a timestamp as handlers put in table cells and detail maps,
+// reporting false for other values
+func ParseTimeValue(s string) (time.Time, bool) {
+	if !looksLikeTimestamp(s) {
+		return time.Time{}, false
+	}
+	for _, p := range parseLayouts {
+		if t, err := time.Parse(p.layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// looksLikeTimestamp cheaply rules out most values before trying to parse them
+func looksLikeTimestamp(s string) bool {
+	if len(s) < 10 || len(s) > 35 || s[4] != '-' || s[7] != '-' {
+		return false
+	}
+	for _, i := range []int{0, 1, 2, 3, 5, 6, 8, 9} {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// RelativeTime describes how long ago (or from now) t is, e.g. "3d ago" or "in 2h"
+func RelativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var amount string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		amount = fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		amount = fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		amount = fmt.Sprintf("%dd", int(d.Hours()/24))
+	case d < 365*24*time.Hour:
+		amount = fmt.Sprintf("%dmo", int(d.Hours()/(24*30)))
+	default:
+		amount = fmt.Sprintf("%dy", int(d.Hours()/(24*365)))
+	}
+
+	if future {
+		return "in " + amount
+	}
+	return amount + " ago"
+}
+
+// RelativeDate is RelativeTime for a day without a time of day
+func RelativeDate(day, now time.Time) string {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	switch days := int(today.Sub(day).Hours() / 24); days {
+	case 0:
+		return "today"
+	case 1:
+		return "yesterday"
+	case -1:
+		return "tomorrow"
+	}
+	return RelativeTime(day, today)
+}
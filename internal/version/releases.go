@@ -0,0 +1,75 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// releasesTimeout keeps a slow or unreachable GitHub from holding up the check
+const releasesTimeout = 10 * time.Second
+
+// Release is a published release with its notes
+type Release struct {
+	Tag         string    `json:"tag_name"`
+	Name        string    `json:"name"`
+	Notes       string    `json:"body"`
+	URL         string    `json:"html_url"`
+	Draft       bool      `json:"draft"`
+	Prerelease  bool      `json:"prerelease"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// FetchReleases gets the latest releases from the GitHub releases feed, newest first.
+// Drafts are left out.
+func FetchReleases(ctx context.Context) ([]Release, error) {
+	client := &http.Client{Timeout: releasesTimeout}
+	url := fmt.Sprintf("https://api.github.com/repos/%s/releases?per_page=30", Repository)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", "aws-tui/"+Current())
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch releases: %s", resp.Status)
+	}
+
+	var all []Release
+	if err := json.NewDecoder(resp.Body).Decode(&all); err != nil {
+		return nil, fmt.Errorf("failed to read releases: %w", err)
+	}
+	releases := all[:0]
+	for _, release := range all {
+		if !release.Draft {
+			releases = append(releases, release)
+		}
+	}
+	return releases, nil
+}
+
+// LatestUpdate returns the newest stable release newer than the running version, or nil
+// when it is up to date or a development build
+func LatestUpdate(releases []Release) *Release {
+	current := Current()
+	var latest *Release
+	for i := range releases {
+		release := &releases[i]
+		if release.Prerelease || !Newer(release.Tag, current) {
+			continue
+		}
+		if latest == nil || Newer(release.Tag, latest.Tag) {
+			latest = release
+		}
+	}
+	return latest
+}
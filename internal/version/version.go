@@ -0,0 +1,75 @@
+// Package version knows which build of aws-tui is running and which releases are newer.
+package version
+
+import (
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+// Version is the release the binary was built from, set by make build with
+// -ldflags "-X github.com/aaw-tui/aws-tui/internal/version.Version=v1.2.3"
+var Version = "dev"
+
+// Repository is the GitHub repository releases are published in
+var Repository = "abreed05/aws-tui"
+
+// Current returns the running version. Builds without the linker flag fall back to the
+// module version go install records, and stay "dev" when built from a checkout.
+func Current() string {
+	if Version != "dev" {
+		return Version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return Version
+}
+
+// IsRelease reports whether the running version is a release, which can be compared
+// against newer ones. Development builds never report an update.
+func IsRelease() bool {
+	_, ok := parse(Current())
+	return ok
+}
+
+// Newer reports whether version a is newer than version b. Versions that aren't
+// vMAJOR.MINOR.PATCH are never newer, nor anything newer than them.
+func Newer(a, b string) bool {
+	va, ok := parse(a)
+	if !ok {
+		return false
+	}
+	vb, ok := parse(b)
+	if !ok {
+		return false
+	}
+	for i := range va {
+		if va[i] != vb[i] {
+			return va[i] > vb[i]
+		}
+	}
+	return false
+}
+
+// parse reads the major, minor and patch numbers of a version such as v1.2.3. A suffix, as
+// in v1.2.3-rc1 or the v1.2.3-4-gabcdef of git describe, is ignored.
+func parse(v string) ([3]int, bool) {
+	var parts [3]int
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	fields := strings.Split(v, ".")
+	if len(fields) != 3 {
+		return parts, false
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}